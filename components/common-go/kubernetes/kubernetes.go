@@ -75,8 +75,24 @@ const (
 
 	// workspacePressureStallInfo indicates if pressure stall information should be retrieved for the workspace
 	WorkspacePressureStallInfoAnnotation = "gitpod.io/psi"
+
+	// WorkspaceMemoryLimitAnnotation denotes the memory limit of a workspace. ws-daemon applies it to the workspace's cgroup,
+	// which allows changing the limit of a running workspace.
+	WorkspaceMemoryLimitAnnotation = "gitpod.io/memoryLimit"
+
+	// WorkspaceIOLimitAnnotation contains the IO limits of a workspace as JSON serialised WorkspaceIOLimit. If present,
+	// ws-daemon applies these limits instead of the IO limits it applies to all workspaces.
+	WorkspaceIOLimitAnnotation = "gitpod.io/ioLimit"
 )
 
+// WorkspaceIOLimit is the value of the WorkspaceIOLimitAnnotation. Zero values do not limit IO.
+type WorkspaceIOLimit struct {
+	WriteBytesPerSecond int64 `json:"writeBytesPerSecond,omitempty"`
+	ReadBytesPerSecond  int64 `json:"readBytesPerSecond,omitempty"`
+	WriteIOPS           int64 `json:"writeIOPS,omitempty"`
+	ReadIOPS            int64 `json:"readIOPS,omitempty"`
+}
+
 // GetOWIFromObject finds the owner, workspace and instance information on a Kubernetes object using labels
 func GetOWIFromObject(pod *metav1.ObjectMeta) logrus.Fields {
 	owner := pod.Labels[OwnerLabel]
@@ -8,6 +8,7 @@ import (
 	"os"
 
 	"github.com/gitpod-io/gitpod/common-go/baseserver"
+	"github.com/gitpod-io/gitpod/common-go/util"
 )
 
 // StorageConfig configures the remote storage we use
@@ -24,6 +25,19 @@ type StorageConfig struct {
 	// MinIOConfig configures the MinIO remote storage
 	MinIOConfig MinIOConfig `json:"minio,omitempty"`
 
+	// FileSystemConfig configures the filesystem remote storage
+	FileSystemConfig FileSystemConfig `json:"filesystem,omitempty"`
+
+	// S3Config configures the S3 remote storage
+	S3Config S3Config `json:"s3,omitempty"`
+
+	// Encryption enables client-side envelope encryption of the content we upload.
+	// If nil, content is stored as is.
+	Encryption *EncryptionConfig `json:"encryption,omitempty"`
+
+	// Download configures how workspace content is downloaded when it is restored
+	Download DownloadConfig `json:"download,omitempty"`
+
 	BlobQuota int64 `json:"blobQuota"`
 }
 
@@ -51,6 +65,12 @@ const (
 	// MinIOStorage stores workspaces in a MinIO/S3 storage
 	MinIOStorage RemoteStorageType = "minio"
 
+	// FileSystemStorage stores workspaces in a directory on a mounted filesystem
+	FileSystemStorage RemoteStorageType = "filesystem"
+
+	// S3Storage stores workspaces in a single S3 bucket
+	S3Storage RemoteStorageType = "s3"
+
 	// NullStorage does not synchronize workspaces at all
 	NullStorage RemoteStorageType = ""
 )
@@ -102,6 +122,136 @@ type MinIOConfig struct {
 	BucketName string `json:"bucket,omitempty"`
 }
 
+// FileSystemConfig configures the filesystem remote storage backend
+type FileSystemConfig struct {
+	// Root is the directory in which buckets and their objects are stored.
+	// All components using this storage must mount the same directory.
+	Root string `json:"root"`
+
+	// BaseURL is the externally reachable URL under which presigned URLs are served
+	BaseURL string `json:"baseURL,omitempty"`
+
+	// Address is the address content-service serves presigned URLs on
+	Address string `json:"address,omitempty"`
+
+	// SigningKey is the secret used to sign presigned URLs
+	SigningKey     string `json:"signingKey,omitempty"`
+	SigningKeyFile string `json:"signingKeyFile,omitempty"`
+}
+
+// DownloadConfig configures how objects are downloaded from the remote storage. Objects are downloaded
+// in byte ranges, several of them in parallel, and each range is retried on its own.
+type DownloadConfig struct {
+	// RangeSize is the size of the byte ranges in bytes. Defaults to 16 MiB.
+	RangeSize int64 `json:"rangeSize,omitempty"`
+
+	// Parallelism is the number of ranges downloaded in parallel. Defaults to 4.
+	Parallelism int `json:"parallelism,omitempty"`
+
+	// Attempts is the number of times a range is attempted before the download fails. Defaults to 3.
+	Attempts int `json:"attempts,omitempty"`
+}
+
+// S3Config configures the S3 remote storage backend. All content is stored in a single bucket,
+// in which the per-owner buckets of the other backends become prefixes.
+type S3Config struct {
+	// Bucket is the bucket in which all content is stored. It must exist already.
+	Bucket string `json:"bucket"`
+
+	// Region is the region of the bucket
+	Region string `json:"region"`
+
+	// Endpoint is the S3 endpoint. Defaults to AWS S3.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Insecure talks plain HTTP to the endpoint
+	Insecure bool `json:"insecure,omitempty"`
+
+	// Credentials are the credential sources which are tried in order until one provides credentials.
+	// Defaults to env, webIdentity and instanceProfile.
+	Credentials []S3CredentialSource `json:"credentials,omitempty"`
+
+	// AccessKeyIdFile and SecretAccessKeyFile contain the keys of the static credential source
+	AccessKeyIdFile     string `json:"accessKeyFile,omitempty"`
+	SecretAccessKeyFile string `json:"secretKeyFile,omitempty"`
+
+	// RoleARN is the role the webIdentity credential source assumes. Defaults to $AWS_ROLE_ARN.
+	RoleARN string `json:"roleArn,omitempty"`
+
+	// WebIdentityTokenFile contains the token of the webIdentity credential source. Defaults to $AWS_WEB_IDENTITY_TOKEN_FILE.
+	WebIdentityTokenFile string `json:"webIdentityTokenFile,omitempty"`
+
+	// ServerSideEncryption determines how S3 encrypts the objects we upload
+	ServerSideEncryption S3ServerSideEncryption `json:"sse,omitempty"`
+
+	// KMSKeyID is the KMS key used for aws:kms server-side encryption
+	KMSKeyID string `json:"kmsKeyId,omitempty"`
+
+	// PartSize is the size of the parts of multipart uploads in bytes. Defaults to 64 MiB.
+	PartSize uint64 `json:"partSize,omitempty"`
+
+	// ParallelUpload is the number of parts of a multipart upload which are uploaded in parallel. Defaults to 4.
+	ParallelUpload uint `json:"parallelUpload,omitempty"`
+}
+
+// S3CredentialSource is a source of S3 credentials
+type S3CredentialSource string
+
+const (
+	// S3CredentialsStatic reads the access keys from the files configured in S3Config
+	S3CredentialsStatic S3CredentialSource = "static"
+
+	// S3CredentialsEnv reads the access keys from the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN env vars
+	S3CredentialsEnv S3CredentialSource = "env"
+
+	// S3CredentialsWebIdentity assumes a role using a web identity token file, e.g. one of a Kubernetes service account
+	S3CredentialsWebIdentity S3CredentialSource = "webIdentity"
+
+	// S3CredentialsInstanceProfile uses the role of the EC2 instance or ECS task we run on
+	S3CredentialsInstanceProfile S3CredentialSource = "instanceProfile"
+)
+
+// S3ServerSideEncryption is a kind of S3 server-side encryption
+type S3ServerSideEncryption string
+
+const (
+	// S3SSENone leaves encryption to the bucket's default
+	S3SSENone S3ServerSideEncryption = ""
+
+	// S3SSES3 encrypts objects with keys managed by S3
+	S3SSES3 S3ServerSideEncryption = "AES256"
+
+	// S3SSEKMS encrypts objects with a KMS key
+	S3SSEKMS S3ServerSideEncryption = "aws:kms"
+)
+
+// KeyProviderType is a kind of key provider which manages the key-encryption keys of workspace owners
+type KeyProviderType string
+
+const (
+	// KeyFileProvider keeps the key-encryption keys in a directory, sealed with a master key read from a file
+	KeyFileProvider KeyProviderType = "keyfile"
+)
+
+// EncryptionConfig configures the envelope encryption of remote storage content
+type EncryptionConfig struct {
+	// KeyProvider determines where the key-encryption keys of workspace owners come from
+	KeyProvider KeyProviderType `json:"keyProvider"`
+
+	// KeyFileConfig configures the keyfile key provider
+	KeyFileConfig KeyFileConfig `json:"keyfile,omitempty"`
+}
+
+// KeyFileConfig configures the keyfile key provider
+type KeyFileConfig struct {
+	// MasterKeyFile is the path to a file containing the hex-encoded 256 bit master key
+	MasterKeyFile string `json:"masterKeyFile"`
+
+	// KeyStore is the directory in which the sealed per-owner keys are stored.
+	// All components using the same storage must mount the same directory.
+	KeyStore string `json:"keyStore"`
+}
+
 type PProf struct {
 	Addr string `json:"address"`
 }
@@ -111,9 +261,43 @@ type UsageReportConfig struct {
 	BucketName string `json:"bucketName"`
 }
 
+// RetentionConfig configures the retention job which prunes old workspace content from remote storage
+type RetentionConfig struct {
+	// Interval is the time between two retention runs
+	Interval util.Duration `json:"interval"`
+
+	// DryRun makes the retention job log and count what it would delete, without deleting anything
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Policies are the retention policies per stage. Owners on a stage without policy are left alone.
+	Policies map[Stage]RetentionPolicy `json:"policies,omitempty"`
+
+	// Owners overrides the stage's retention policy for individual owners
+	Owners map[string]RetentionPolicy `json:"owners,omitempty"`
+}
+
+// RetentionPolicy determines which content of an owner is pruned. Zero values disable the respective rule.
+type RetentionPolicy struct {
+	// KeepBackups is the number of full workspace backups kept per workspace
+	KeepBackups int `json:"keepBackups,omitempty"`
+
+	// SnapshotMaxAge is the age after which snapshots are deleted, unless they stem from a prebuild
+	SnapshotMaxAge util.Duration `json:"snapshotMaxAge,omitempty"`
+
+	// HeadlessLogMaxAge is the age after which headless workspace logs are deleted
+	HeadlessLogMaxAge util.Duration `json:"headlessLogMaxAge,omitempty"`
+
+	// MaxBytes caps the total size of an owner's workspace content. If exceeded, the oldest
+	// deletable content is pruned until the owner is below the cap.
+	MaxBytes int64 `json:"maxBytes,omitempty"`
+}
+
 type ServiceConfig struct {
 	Service baseserver.ServerConfiguration `json:"service"`
 	Storage StorageConfig                  `json:"storage"`
+
+	// Retention enables the retention job. If nil, content is only deleted on explicit request.
+	Retention *RetentionConfig `json:"retention,omitempty"`
 	// Deprecated
 	_ UsageReportConfig `json:"usageReport"`
 }
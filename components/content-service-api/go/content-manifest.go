@@ -22,6 +22,18 @@ type WorkspaceContentManifest struct {
 	Type WorkspaceContentType `json:"type"`
 
 	Layers []WorkspaceContentLayer `json:"layers"`
+
+	// Generations lists the backups which make up an incremental workspace backup, oldest first.
+	// Only set if Type is TypeIncrementalWorkspaceContentV1.
+	Generations []WorkspaceContentGeneration `json:"generations,omitempty"`
+}
+
+// LatestGeneration returns the most recent generation of an incremental backup, or nil if there is none
+func (mf *WorkspaceContentManifest) LatestGeneration() *WorkspaceContentGeneration {
+	if len(mf.Generations) == 0 {
+		return nil
+	}
+	return &mf.Generations[len(mf.Generations)-1]
 }
 
 // WorkspaceContentType is the type of workspace content this manifest describes
@@ -30,6 +42,9 @@ type WorkspaceContentType string
 const (
 	// TypeFullWorkspaceContentV1 is the content type for a v1 full workspace backup manifest
 	TypeFullWorkspaceContentV1 WorkspaceContentType = "application/vnd.gitpod.wsfull.v1"
+
+	// TypeIncrementalWorkspaceContentV1 is the content type for a v1 incremental workspace backup manifest
+	TypeIncrementalWorkspaceContentV1 WorkspaceContentType = "application/vnd.gitpod.wsincremental.v1"
 )
 
 // WorkspaceContentLayer describes the disposition of a single content layer.
@@ -46,3 +61,28 @@ type WorkspaceContentLayer struct {
 	// Workspace instance ID this content layer came from
 	InstanceID string `json:"instanceID"`
 }
+
+// WorkspaceContentGeneration describes a single backup of an incremental workspace backup.
+// The backup's tar stream is the concatenation of its chunks.
+type WorkspaceContentGeneration struct {
+	// Generation counts the backups of a workspace, starting at 1
+	Generation int `json:"generation"`
+	// InstanceID is the workspace instance this backup was taken from
+	InstanceID string `json:"instanceID"`
+	// DiffID is the digest of the uncompressed tar stream
+	DiffID digest.Digest `json:"diffID"`
+	// Size is the size of the uncompressed tar stream
+	Size int64 `json:"size"`
+	// Chunks make up the tar stream, in order
+	Chunks []WorkspaceContentChunk `json:"chunks"`
+}
+
+// WorkspaceContentChunk is a content-addressed piece of an incremental backup
+type WorkspaceContentChunk struct {
+	// Digest is the digest of the uncompressed chunk content
+	Digest digest.Digest `json:"digest"`
+	// Size is the size of the uncompressed chunk content
+	Size int64 `json:"size"`
+	// Object is the fully qualified name (object@bucket) of the chunk in remote storage
+	Object string `json:"object"`
+}
@@ -50,21 +50,16 @@ func extractSecretsFromInitializer(init *WorkspaceInitializer, replaceValue bool
 	res := make(map[string]string)
 
 	_ = WalkInitializer([]string{"initializer"}, init, func(path []string, init *WorkspaceInitializer) error {
-		git, ok := init.Spec.(*WorkspaceInitializer_Git)
-		if !ok {
-			return nil
-		}
-
-		pwd := git.Git.Config.AuthPassword
-		if pwd == "" || strings.HasPrefix(pwd, extractedSecretPrefix) {
+		pwd := initializerSecret(init)
+		if pwd == nil || *pwd == "" || strings.HasPrefix(*pwd, extractedSecretPrefix) {
 			return nil
 		}
 
 		name := strings.Join(path, ".")
-		res[name] = pwd
+		res[name] = *pwd
 
 		if replaceValue {
-			git.Git.Config.AuthPassword = extractedSecretPrefix + name
+			*pwd = extractedSecretPrefix + name
 		}
 
 		return nil
@@ -76,28 +71,35 @@ func extractSecretsFromInitializer(init *WorkspaceInitializer, replaceValue bool
 // InjectSecretsToInitializer injects secrets to the initializer. This is the counterpart of ExtractSecretsFromInitializer.
 func InjectSecretsToInitializer(init *WorkspaceInitializer, secrets map[string][]byte) error {
 	return WalkInitializer([]string{"initializer"}, init, func(path []string, init *WorkspaceInitializer) error {
-		git, ok := init.Spec.(*WorkspaceInitializer_Git)
-		if !ok {
-			return nil
-		}
-
-		pwd := git.Git.Config.AuthPassword
-		if !strings.HasPrefix(pwd, extractedSecretPrefix) {
+		pwd := initializerSecret(init)
+		if pwd == nil || !strings.HasPrefix(*pwd, extractedSecretPrefix) {
 			return nil
 		}
 
-		name := strings.TrimPrefix(pwd, extractedSecretPrefix)
+		name := strings.TrimPrefix(*pwd, extractedSecretPrefix)
 		val, ok := secrets[name]
 		if !ok {
 			return xerrors.Errorf("secret %s not found", name)
 		}
 
-		git.Git.Config.AuthPassword = string(val)
+		*pwd = string(val)
 
 		return nil
 	})
 }
 
+// initializerSecret returns the secret an initializer carries, or nil if it carries none
+func initializerSecret(init *WorkspaceInitializer) *string {
+	switch spec := init.Spec.(type) {
+	case *WorkspaceInitializer_Git:
+		return &spec.Git.Config.AuthPassword
+	case *WorkspaceInitializer_OciArtifact:
+		return &spec.OciArtifact.AuthPassword
+	default:
+		return nil
+	}
+}
+
 // WalkInitializer walks the initializer structure
 func WalkInitializer(path []string, init *WorkspaceInitializer, visitor func(path []string, init *WorkspaceInitializer) error) error {
 	if init == nil {
@@ -141,6 +143,8 @@ func WalkInitializer(path []string, init *WorkspaceInitializer, visitor func(pat
 		return visitor(append(path, "download"), init)
 	case *WorkspaceInitializer_Backup:
 		return visitor(append(path, "backup"), init)
+	case *WorkspaceInitializer_OciArtifact:
+		return visitor(append(path, "oci"), init)
 
 	default:
 		return fmt.Errorf("unsupported workspace initializer in walkInitializer - this is a bug in Gitpod")
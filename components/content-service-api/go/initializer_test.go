@@ -185,6 +185,39 @@ func TestExtractInjectSecretsFromInitializer(t *testing.T) {
 				"initializer.prebuild.1.git": "some value",
 			},
 		},
+		{
+			Name: "composite initializer with OCI artifact",
+			Input: &api.WorkspaceInitializer{
+				Spec: &api.WorkspaceInitializer_Composite{
+					Composite: &api.CompositeInitializer{
+						Initializer: []*api.WorkspaceInitializer{
+							{
+								Spec: &api.WorkspaceInitializer_Git{
+									Git: &api.GitInitializer{
+										Config: &api.GitConfig{
+											AuthPassword: "foobar",
+										},
+									},
+								},
+							},
+							{
+								Spec: &api.WorkspaceInitializer_OciArtifact{
+									OciArtifact: &api.OCIArtifactInitializer{
+										Ref:          "registry.example.com/datasets/foo:v1",
+										AuthUser:     "user",
+										AuthPassword: "registry token",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Expectation: map[string]string{
+				"initializer.composite.0.git": "foobar",
+				"initializer.composite.1.oci": "registry token",
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -201,6 +234,9 @@ func TestExtractInjectSecretsFromInitializer(t *testing.T) {
 				api.GitInitializer{},
 				api.GitConfig{},
 				api.PrebuildInitializer{},
+				api.CompositeInitializer{},
+				api.WorkspaceInitializer_OciArtifact{},
+				api.OCIArtifactInitializer{},
 			}
 			if diff := cmp.Diff(original, test.Input, cmpopts.IgnoreUnexported(ignoreUnexported...)); diff != "" {
 				t.Errorf("unexpected alteration from GatherSecretsFromInitializer (-want +got):\n%s", diff)
@@ -212,11 +248,14 @@ func TestExtractInjectSecretsFromInitializer(t *testing.T) {
 			}
 
 			_ = api.WalkInitializer(nil, test.Input, func(path []string, init *api.WorkspaceInitializer) error {
-				git, ok := init.Spec.(*api.WorkspaceInitializer_Git)
-				if !ok {
-					return nil
+				var pwd string
+				switch spec := init.Spec.(type) {
+				case *api.WorkspaceInitializer_Git:
+					pwd = spec.Git.Config.AuthPassword
+				case *api.WorkspaceInitializer_OciArtifact:
+					pwd = spec.OciArtifact.AuthPassword
 				}
-				if pwd := git.Git.Config.AuthPassword; pwd != "" && !strings.HasPrefix(pwd, "extracted-secret/") {
+				if pwd != "" && !strings.HasPrefix(pwd, "extracted-secret/") {
 					t.Errorf("expected authPassword to be extracted, but got %s at %s", pwd, filepath.Join(path...))
 				}
 
@@ -234,11 +273,14 @@ func TestExtractInjectSecretsFromInitializer(t *testing.T) {
 			}
 
 			_ = api.WalkInitializer(nil, test.Input, func(path []string, init *api.WorkspaceInitializer) error {
-				git, ok := init.Spec.(*api.WorkspaceInitializer_Git)
-				if !ok {
-					return nil
+				var pwd string
+				switch spec := init.Spec.(type) {
+				case *api.WorkspaceInitializer_Git:
+					pwd = spec.Git.Config.AuthPassword
+				case *api.WorkspaceInitializer_OciArtifact:
+					pwd = spec.OciArtifact.AuthPassword
 				}
-				if pwd := git.Git.Config.AuthPassword; pwd != "" && strings.HasPrefix(pwd, "extracted-secret/") {
+				if pwd != "" && strings.HasPrefix(pwd, "extracted-secret/") {
 					t.Errorf("expected authPassword to be injected, but got %s at %s", pwd, filepath.Join(path...))
 				}
 
@@ -24,3 +24,9 @@ const (
 type WorkspaceReadyMessage struct {
 	Source WorkspaceInitSource `json:"source"`
 }
+
+// WorkspaceInitProgress describes the content of the progress file in a workspace while it is initialized
+type WorkspaceInitProgress struct {
+	Phase   string `json:"phase"`
+	Percent int    `json:"percent"`
+}
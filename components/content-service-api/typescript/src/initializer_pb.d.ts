@@ -49,6 +49,11 @@ export class WorkspaceInitializer extends jspb.Message {
     getBackup(): FromBackupInitializer | undefined;
     setBackup(value?: FromBackupInitializer): WorkspaceInitializer;
 
+    hasOciArtifact(): boolean;
+    clearOciArtifact(): void;
+    getOciArtifact(): OCIArtifactInitializer | undefined;
+    setOciArtifact(value?: OCIArtifactInitializer): WorkspaceInitializer;
+
     getSpecCase(): WorkspaceInitializer.SpecCase;
 
     serializeBinary(): Uint8Array;
@@ -70,6 +75,7 @@ export namespace WorkspaceInitializer {
         composite?: CompositeInitializer.AsObject,
         download?: FileDownloadInitializer.AsObject,
         backup?: FromBackupInitializer.AsObject,
+        ociArtifact?: OCIArtifactInitializer.AsObject,
     }
 
     export enum SpecCase {
@@ -81,6 +87,7 @@ export namespace WorkspaceInitializer {
         COMPOSITE = 5,
         DOWNLOAD = 6,
         BACKUP = 7,
+        OCI_ARTIFACT = 8,
     }
 
 }
@@ -177,6 +184,35 @@ export namespace EmptyInitializer {
     }
 }
 
+export class OCIArtifactInitializer extends jspb.Message {
+    getRef(): string;
+    setRef(value: string): OCIArtifactInitializer;
+    getTargetLocation(): string;
+    setTargetLocation(value: string): OCIArtifactInitializer;
+    getAuthUser(): string;
+    setAuthUser(value: string): OCIArtifactInitializer;
+    getAuthPassword(): string;
+    setAuthPassword(value: string): OCIArtifactInitializer;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): OCIArtifactInitializer.AsObject;
+    static toObject(includeInstance: boolean, msg: OCIArtifactInitializer): OCIArtifactInitializer.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: OCIArtifactInitializer, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): OCIArtifactInitializer;
+    static deserializeBinaryFromReader(message: OCIArtifactInitializer, reader: jspb.BinaryReader): OCIArtifactInitializer;
+}
+
+export namespace OCIArtifactInitializer {
+    export type AsObject = {
+        ref: string,
+        targetLocation: string,
+        authUser: string,
+        authPassword: string,
+    }
+}
+
 export class GitInitializer extends jspb.Message {
     getRemoteUri(): string;
     setRemoteUri(value: string): GitInitializer;
@@ -193,6 +229,22 @@ export class GitInitializer extends jspb.Message {
     clearConfig(): void;
     getConfig(): GitConfig | undefined;
     setConfig(value?: GitConfig): GitInitializer;
+    getCloneDepth(): number;
+    setCloneDepth(value: number): GitInitializer;
+    getCloneFilter(): string;
+    setCloneFilter(value: string): GitInitializer;
+    clearSparseCheckoutList(): void;
+    getSparseCheckoutList(): Array<string>;
+    setSparseCheckoutList(value: Array<string>): GitInitializer;
+    addSparseCheckout(value: string, index?: number): string;
+    clearLfsIncludeList(): void;
+    getLfsIncludeList(): Array<string>;
+    setLfsIncludeList(value: Array<string>): GitInitializer;
+    addLfsInclude(value: string, index?: number): string;
+    clearLfsExcludeList(): void;
+    getLfsExcludeList(): Array<string>;
+    setLfsExcludeList(value: Array<string>): GitInitializer;
+    addLfsExclude(value: string, index?: number): string;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): GitInitializer.AsObject;
@@ -212,6 +264,11 @@ export namespace GitInitializer {
         cloneTaget: string,
         checkoutLocation: string,
         config?: GitConfig.AsObject,
+        cloneDepth: number,
+        cloneFilter: string,
+        sparseCheckoutList: Array<string>,
+        lfsIncludeList: Array<string>,
+        lfsExcludeList: Array<string>,
     }
 }
 
@@ -346,6 +403,18 @@ export class GitStatus extends jspb.Message {
     addUnpushedCommits(value: string, index?: number): string;
     getTotalUnpushedCommits(): number;
     setTotalUnpushedCommits(value: number): GitStatus;
+    clearUnpushedLfsObjectsList(): void;
+    getUnpushedLfsObjectsList(): Array<string>;
+    setUnpushedLfsObjectsList(value: Array<string>): GitStatus;
+    addUnpushedLfsObjects(value: string, index?: number): string;
+    getTotalUnpushedLfsObjects(): number;
+    setTotalUnpushedLfsObjects(value: number): GitStatus;
+    clearMissingLfsObjectsList(): void;
+    getMissingLfsObjectsList(): Array<string>;
+    setMissingLfsObjectsList(value: Array<string>): GitStatus;
+    addMissingLfsObjects(value: string, index?: number): string;
+    getTotalMissingLfsObjects(): number;
+    setTotalMissingLfsObjects(value: number): GitStatus;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): GitStatus.AsObject;
@@ -367,6 +436,10 @@ export namespace GitStatus {
         totalUntrackedFiles: number,
         unpushedCommitsList: Array<string>,
         totalUnpushedCommits: number,
+        unpushedLfsObjectsList: Array<string>,
+        totalUnpushedLfsObjects: number,
+        missingLfsObjectsList: Array<string>,
+        totalMissingLfsObjects: number,
     }
 }
 
@@ -31,6 +31,7 @@ goog.exportSymbol('proto.contentservice.GitAuthMethod', null, global);
 goog.exportSymbol('proto.contentservice.GitConfig', null, global);
 goog.exportSymbol('proto.contentservice.GitInitializer', null, global);
 goog.exportSymbol('proto.contentservice.GitStatus', null, global);
+goog.exportSymbol('proto.contentservice.OCIArtifactInitializer', null, global);
 goog.exportSymbol('proto.contentservice.PrebuildInitializer', null, global);
 goog.exportSymbol('proto.contentservice.SnapshotInitializer', null, global);
 goog.exportSymbol('proto.contentservice.WorkspaceInitializer', null, global);
@@ -150,9 +151,30 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.contentservice.GitInitializer = function(opt_data) {
+proto.contentservice.OCIArtifactInitializer = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.contentservice.OCIArtifactInitializer, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.contentservice.OCIArtifactInitializer.displayName = 'proto.contentservice.OCIArtifactInitializer';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.contentservice.GitInitializer = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.contentservice.GitInitializer.repeatedFields_, null);
+};
 goog.inherits(proto.contentservice.GitInitializer, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
@@ -275,7 +297,7 @@ if (goog.DEBUG && !COMPILED) {
  * @private {!Array<!Array<number>>}
  * @const
  */
-proto.contentservice.WorkspaceInitializer.oneofGroups_ = [[1,2,3,4,5,6,7]];
+proto.contentservice.WorkspaceInitializer.oneofGroups_ = [[1,2,3,4,5,6,7,8]];
 
 /**
  * @enum {number}
@@ -288,7 +310,8 @@ proto.contentservice.WorkspaceInitializer.SpecCase = {
   PREBUILD: 4,
   COMPOSITE: 5,
   DOWNLOAD: 6,
-  BACKUP: 7
+  BACKUP: 7,
+  OCI_ARTIFACT: 8
 };
 
 /**
@@ -335,7 +358,8 @@ proto.contentservice.WorkspaceInitializer.toObject = function(includeInstance, m
     prebuild: (f = msg.getPrebuild()) && proto.contentservice.PrebuildInitializer.toObject(includeInstance, f),
     composite: (f = msg.getComposite()) && proto.contentservice.CompositeInitializer.toObject(includeInstance, f),
     download: (f = msg.getDownload()) && proto.contentservice.FileDownloadInitializer.toObject(includeInstance, f),
-    backup: (f = msg.getBackup()) && proto.contentservice.FromBackupInitializer.toObject(includeInstance, f)
+    backup: (f = msg.getBackup()) && proto.contentservice.FromBackupInitializer.toObject(includeInstance, f),
+    ociArtifact: (f = msg.getOciArtifact()) && proto.contentservice.OCIArtifactInitializer.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -407,6 +431,11 @@ proto.contentservice.WorkspaceInitializer.deserializeBinaryFromReader = function
       reader.readMessage(value,proto.contentservice.FromBackupInitializer.deserializeBinaryFromReader);
       msg.setBackup(value);
       break;
+    case 8:
+      var value = new proto.contentservice.OCIArtifactInitializer;
+      reader.readMessage(value,proto.contentservice.OCIArtifactInitializer.deserializeBinaryFromReader);
+      msg.setOciArtifact(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -492,6 +521,14 @@ proto.contentservice.WorkspaceInitializer.serializeBinaryToWriter = function(mes
       proto.contentservice.FromBackupInitializer.serializeBinaryToWriter
     );
   }
+  f = message.getOciArtifact();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.contentservice.OCIArtifactInitializer.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -754,6 +791,43 @@ proto.contentservice.WorkspaceInitializer.prototype.hasBackup = function() {
 };
 
 
+/**
+ * optional OCIArtifactInitializer oci_artifact = 8;
+ * @return {?proto.contentservice.OCIArtifactInitializer}
+ */
+proto.contentservice.WorkspaceInitializer.prototype.getOciArtifact = function() {
+  return /** @type{?proto.contentservice.OCIArtifactInitializer} */ (
+    jspb.Message.getWrapperField(this, proto.contentservice.OCIArtifactInitializer, 8));
+};
+
+
+/**
+ * @param {?proto.contentservice.OCIArtifactInitializer|undefined} value
+ * @return {!proto.contentservice.WorkspaceInitializer} returns this
+*/
+proto.contentservice.WorkspaceInitializer.prototype.setOciArtifact = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 8, proto.contentservice.WorkspaceInitializer.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.contentservice.WorkspaceInitializer} returns this
+ */
+proto.contentservice.WorkspaceInitializer.prototype.clearOciArtifact = function() {
+  return this.setOciArtifact(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.contentservice.WorkspaceInitializer.prototype.hasOciArtifact = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
 
 /**
  * List of repeated fields within this message type.
@@ -1398,6 +1472,233 @@ proto.contentservice.EmptyInitializer.serializeBinaryToWriter = function(message
 
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.toObject = function(opt_includeInstance) {
+  return proto.contentservice.OCIArtifactInitializer.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.contentservice.OCIArtifactInitializer} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.contentservice.OCIArtifactInitializer.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    ref: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    targetLocation: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    authUser: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    authPassword: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.contentservice.OCIArtifactInitializer}
+ */
+proto.contentservice.OCIArtifactInitializer.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.contentservice.OCIArtifactInitializer;
+  return proto.contentservice.OCIArtifactInitializer.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.contentservice.OCIArtifactInitializer} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.contentservice.OCIArtifactInitializer}
+ */
+proto.contentservice.OCIArtifactInitializer.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRef(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTargetLocation(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAuthUser(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAuthPassword(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.contentservice.OCIArtifactInitializer.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.contentservice.OCIArtifactInitializer} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.contentservice.OCIArtifactInitializer.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRef();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTargetLocation();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getAuthUser();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getAuthPassword();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string ref = 1;
+ * @return {string}
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.getRef = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.OCIArtifactInitializer} returns this
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.setRef = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string target_location = 2;
+ * @return {string}
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.getTargetLocation = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.OCIArtifactInitializer} returns this
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.setTargetLocation = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string auth_user = 3;
+ * @return {string}
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.getAuthUser = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.OCIArtifactInitializer} returns this
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.setAuthUser = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string auth_password = 4;
+ * @return {string}
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.getAuthPassword = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.OCIArtifactInitializer} returns this
+ */
+proto.contentservice.OCIArtifactInitializer.prototype.setAuthPassword = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.contentservice.GitInitializer.repeatedFields_ = [9,10,11];
+
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -1432,7 +1733,12 @@ proto.contentservice.GitInitializer.toObject = function(includeInstance, msg) {
     targetMode: jspb.Message.getFieldWithDefault(msg, 3, 0),
     cloneTaget: jspb.Message.getFieldWithDefault(msg, 4, ""),
     checkoutLocation: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    config: (f = msg.getConfig()) && proto.contentservice.GitConfig.toObject(includeInstance, f)
+    config: (f = msg.getConfig()) && proto.contentservice.GitConfig.toObject(includeInstance, f),
+    cloneDepth: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    cloneFilter: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    sparseCheckoutList: (f = jspb.Message.getRepeatedField(msg, 9)) == null ? undefined : f,
+    lfsIncludeList: (f = jspb.Message.getRepeatedField(msg, 10)) == null ? undefined : f,
+    lfsExcludeList: (f = jspb.Message.getRepeatedField(msg, 11)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -1494,6 +1800,26 @@ proto.contentservice.GitInitializer.deserializeBinaryFromReader = function(msg,
       reader.readMessage(value,proto.contentservice.GitConfig.deserializeBinaryFromReader);
       msg.setConfig(value);
       break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setCloneDepth(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCloneFilter(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addSparseCheckout(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addLfsInclude(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addLfsExclude(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1566,6 +1892,41 @@ proto.contentservice.GitInitializer.serializeBinaryToWriter = function(message,
       proto.contentservice.GitConfig.serializeBinaryToWriter
     );
   }
+  f = message.getCloneDepth();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+  f = message.getCloneFilter();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getSparseCheckoutList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      9,
+      f
+    );
+  }
+  f = message.getLfsIncludeList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      10,
+      f
+    );
+  }
+  f = message.getLfsExcludeList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      11,
+      f
+    );
+  }
 };
 
 
@@ -1696,6 +2057,153 @@ proto.contentservice.GitInitializer.prototype.hasConfig = function() {
 };
 
 
+/**
+ * optional int32 clone_depth = 7;
+ * @return {number}
+ */
+proto.contentservice.GitInitializer.prototype.getCloneDepth = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.setCloneDepth = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional string clone_filter = 8;
+ * @return {string}
+ */
+proto.contentservice.GitInitializer.prototype.getCloneFilter = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.setCloneFilter = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+/**
+ * repeated string sparse_checkout = 9;
+ * @return {!Array<string>}
+ */
+proto.contentservice.GitInitializer.prototype.getSparseCheckoutList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 9));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.setSparseCheckoutList = function(value) {
+  return jspb.Message.setField(this, 9, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.addSparseCheckout = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 9, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.clearSparseCheckoutList = function() {
+  return this.setSparseCheckoutList([]);
+};
+
+
+/**
+ * repeated string lfs_include = 10;
+ * @return {!Array<string>}
+ */
+proto.contentservice.GitInitializer.prototype.getLfsIncludeList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 10));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.setLfsIncludeList = function(value) {
+  return jspb.Message.setField(this, 10, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.addLfsInclude = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 10, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.clearLfsIncludeList = function() {
+  return this.setLfsIncludeList([]);
+};
+
+
+/**
+ * repeated string lfs_exclude = 11;
+ * @return {!Array<string>}
+ */
+proto.contentservice.GitInitializer.prototype.getLfsExcludeList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 11));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.setLfsExcludeList = function(value) {
+  return jspb.Message.setField(this, 11, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.addLfsExclude = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 11, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.clearLfsExcludeList = function() {
+  return this.setLfsExcludeList([]);
+};
+
+
 
 
 
@@ -2486,7 +2994,7 @@ proto.contentservice.FromBackupInitializer.prototype.setFromVolumeSnapshot = fun
  * @private {!Array<number>}
  * @const
  */
-proto.contentservice.GitStatus.repeatedFields_ = [3,4,5];
+proto.contentservice.GitStatus.repeatedFields_ = [3,4,5,9,11];
 
 
 
@@ -2526,7 +3034,11 @@ proto.contentservice.GitStatus.toObject = function(includeInstance, msg) {
     untrackedFilesList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
     totalUntrackedFiles: jspb.Message.getFieldWithDefault(msg, 7, 0),
     unpushedCommitsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
-    totalUnpushedCommits: jspb.Message.getFieldWithDefault(msg, 8, 0)
+    totalUnpushedCommits: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    unpushedLfsObjectsList: (f = jspb.Message.getRepeatedField(msg, 9)) == null ? undefined : f,
+    totalUnpushedLfsObjects: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    missingLfsObjectsList: (f = jspb.Message.getRepeatedField(msg, 11)) == null ? undefined : f,
+    totalMissingLfsObjects: jspb.Message.getFieldWithDefault(msg, 12, 0)
   };
 
   if (includeInstance) {
@@ -2595,6 +3107,22 @@ proto.contentservice.GitStatus.deserializeBinaryFromReader = function(msg, reade
       var value = /** @type {number} */ (reader.readInt64());
       msg.setTotalUnpushedCommits(value);
       break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addUnpushedLfsObjects(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTotalUnpushedLfsObjects(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMissingLfsObjects(value);
+      break;
+    case 12:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTotalMissingLfsObjects(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2680,6 +3208,34 @@ proto.contentservice.GitStatus.serializeBinaryToWriter = function(message, write
       f
     );
   }
+  f = message.getUnpushedLfsObjectsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      9,
+      f
+    );
+  }
+  f = message.getTotalUnpushedLfsObjects();
+  if (f !== 0) {
+    writer.writeInt64(
+      10,
+      f
+    );
+  }
+  f = message.getMissingLfsObjectsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      11,
+      f
+    );
+  }
+  f = message.getTotalMissingLfsObjects();
+  if (f !== 0) {
+    writer.writeInt64(
+      12,
+      f
+    );
+  }
 };
 
 
@@ -2884,6 +3440,116 @@ proto.contentservice.GitStatus.prototype.setTotalUnpushedCommits = function(valu
 };
 
 
+/**
+ * repeated string unpushed_lfs_objects = 9;
+ * @return {!Array<string>}
+ */
+proto.contentservice.GitStatus.prototype.getUnpushedLfsObjectsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 9));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.contentservice.GitStatus} returns this
+ */
+proto.contentservice.GitStatus.prototype.setUnpushedLfsObjectsList = function(value) {
+  return jspb.Message.setField(this, 9, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.contentservice.GitStatus} returns this
+ */
+proto.contentservice.GitStatus.prototype.addUnpushedLfsObjects = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 9, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.contentservice.GitStatus} returns this
+ */
+proto.contentservice.GitStatus.prototype.clearUnpushedLfsObjectsList = function() {
+  return this.setUnpushedLfsObjectsList([]);
+};
+
+
+/**
+ * optional int64 total_unpushed_lfs_objects = 10;
+ * @return {number}
+ */
+proto.contentservice.GitStatus.prototype.getTotalUnpushedLfsObjects = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.contentservice.GitStatus} returns this
+ */
+proto.contentservice.GitStatus.prototype.setTotalUnpushedLfsObjects = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * repeated string missing_lfs_objects = 11;
+ * @return {!Array<string>}
+ */
+proto.contentservice.GitStatus.prototype.getMissingLfsObjectsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 11));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.contentservice.GitStatus} returns this
+ */
+proto.contentservice.GitStatus.prototype.setMissingLfsObjectsList = function(value) {
+  return jspb.Message.setField(this, 11, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.contentservice.GitStatus} returns this
+ */
+proto.contentservice.GitStatus.prototype.addMissingLfsObjects = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 11, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.contentservice.GitStatus} returns this
+ */
+proto.contentservice.GitStatus.prototype.clearMissingLfsObjectsList = function() {
+  return this.setMissingLfsObjectsList([]);
+};
+
+
+/**
+ * optional int64 total_missing_lfs_objects = 12;
+ * @return {number}
+ */
+proto.contentservice.GitStatus.prototype.getTotalMissingLfsObjects = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 12, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.contentservice.GitStatus} returns this
+ */
+proto.contentservice.GitStatus.prototype.setTotalMissingLfsObjects = function(value) {
+  return jspb.Message.setProto3IntField(this, 12, value);
+};
+
+
 /**
  * @enum {number}
  */
@@ -31,6 +31,28 @@ function deserialize_contentservice_DeleteWorkspaceResponse(buffer_arg) {
   return workspace_pb.DeleteWorkspaceResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_contentservice_GarbageCollectChunksRequest(arg) {
+  if (!(arg instanceof workspace_pb.GarbageCollectChunksRequest)) {
+    throw new Error('Expected argument of type contentservice.GarbageCollectChunksRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_contentservice_GarbageCollectChunksRequest(buffer_arg) {
+  return workspace_pb.GarbageCollectChunksRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_contentservice_GarbageCollectChunksResponse(arg) {
+  if (!(arg instanceof workspace_pb.GarbageCollectChunksResponse)) {
+    throw new Error('Expected argument of type contentservice.GarbageCollectChunksResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_contentservice_GarbageCollectChunksResponse(buffer_arg) {
+  return workspace_pb.GarbageCollectChunksResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_contentservice_WorkspaceDownloadURLRequest(arg) {
   if (!(arg instanceof workspace_pb.WorkspaceDownloadURLRequest)) {
     throw new Error('Expected argument of type contentservice.WorkspaceDownloadURLRequest');
@@ -113,6 +135,18 @@ workspaceSnapshotExists: {
     responseSerialize: serialize_contentservice_WorkspaceSnapshotExistsResponse,
     responseDeserialize: deserialize_contentservice_WorkspaceSnapshotExistsResponse,
   },
+  // GarbageCollectChunks deletes the incremental backup chunks of a workspace which are no longer referenced by any of its manifests
+garbageCollectChunks: {
+    path: '/contentservice.WorkspaceService/GarbageCollectChunks',
+    requestStream: false,
+    responseStream: false,
+    requestType: workspace_pb.GarbageCollectChunksRequest,
+    responseType: workspace_pb.GarbageCollectChunksResponse,
+    requestSerialize: serialize_contentservice_GarbageCollectChunksRequest,
+    requestDeserialize: deserialize_contentservice_GarbageCollectChunksRequest,
+    responseSerialize: serialize_contentservice_GarbageCollectChunksResponse,
+    responseDeserialize: deserialize_contentservice_GarbageCollectChunksResponse,
+  },
 };
 
 exports.WorkspaceServiceClient = grpc.makeGenericClientConstructor(WorkspaceServiceService);
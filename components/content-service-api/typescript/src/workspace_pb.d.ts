@@ -143,3 +143,49 @@ export namespace WorkspaceSnapshotExistsResponse {
         exists: boolean,
     }
 }
+
+export class GarbageCollectChunksRequest extends jspb.Message {
+    getOwnerId(): string;
+    setOwnerId(value: string): GarbageCollectChunksRequest;
+    getWorkspaceId(): string;
+    setWorkspaceId(value: string): GarbageCollectChunksRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GarbageCollectChunksRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: GarbageCollectChunksRequest): GarbageCollectChunksRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GarbageCollectChunksRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GarbageCollectChunksRequest;
+    static deserializeBinaryFromReader(message: GarbageCollectChunksRequest, reader: jspb.BinaryReader): GarbageCollectChunksRequest;
+}
+
+export namespace GarbageCollectChunksRequest {
+    export type AsObject = {
+        ownerId: string,
+        workspaceId: string,
+    }
+}
+
+export class GarbageCollectChunksResponse extends jspb.Message {
+    getDeletedChunks(): number;
+    setDeletedChunks(value: number): GarbageCollectChunksResponse;
+    getFreedBytes(): number;
+    setFreedBytes(value: number): GarbageCollectChunksResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GarbageCollectChunksResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: GarbageCollectChunksResponse): GarbageCollectChunksResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GarbageCollectChunksResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GarbageCollectChunksResponse;
+    static deserializeBinaryFromReader(message: GarbageCollectChunksResponse, reader: jspb.BinaryReader): GarbageCollectChunksResponse;
+}
+
+export namespace GarbageCollectChunksResponse {
+    export type AsObject = {
+        deletedChunks: number,
+        freedBytes: number,
+    }
+}
@@ -5,10 +5,16 @@
 package cmd
 
 import (
+	"context"
+
 	"github.com/gitpod-io/gitpod/common-go/baseserver"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/retention"
 	"github.com/gitpod-io/gitpod/content-service/pkg/service"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cobra"
 )
 
@@ -20,14 +26,28 @@ var runCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		cfg := getConfig()
 
-		srv, err := baseserver.New("content-service",
+		opts := []baseserver.Option{
 			baseserver.WithGRPC(&cfg.Service),
 			baseserver.WithVersion(Version),
-		)
+		}
+		if cfg.Storage.Kind == config.FileSystemStorage {
+			// the filesystem storage serves its presigned URLs itself
+			opts = append(opts, baseserver.WithHTTP(&baseserver.ServerConfiguration{Address: cfg.Storage.FileSystemConfig.Address}))
+		}
+
+		srv, err := baseserver.New("content-service", opts...)
 		if err != nil {
 			log.WithError(err).Fatal("Failed to create server.")
 		}
 
+		if cfg.Storage.Kind == config.FileSystemStorage {
+			handler, err := storage.NewFileSystemURLHandler(cfg.Storage.FileSystemConfig)
+			if err != nil {
+				log.WithError(err).Fatal("Cannot create filesystem storage handler")
+			}
+			srv.HTTPMux().Handle("/", handler)
+		}
+
 		contentService, err := service.NewContentService(cfg.Storage)
 		if err != nil {
 			log.WithError(err).Fatalf("Cannot create content service")
@@ -58,6 +78,29 @@ var runCmd = &cobra.Command{
 		}
 		api.RegisterIDEPluginServiceServer(srv.GRPC(), idePluginService)
 
+		if cfg.Retention != nil {
+			s, err := storage.NewPresignedAccess(&cfg.Storage)
+			if err != nil {
+				log.WithError(err).Fatal("Cannot create storage access for retention")
+			}
+			var keys storage.KeyProvider
+			if cfg.Storage.Encryption != nil {
+				keys, err = storage.NewKeyProvider(cfg.Storage.Encryption)
+				if err != nil {
+					log.WithError(err).Fatal("Cannot create key provider for retention")
+				}
+			}
+			job, err := retention.NewJob(*cfg.Retention, cfg.Storage.GetStage(), s, keys, prometheus.WrapRegistererWithPrefix("gitpod_content_service_", srv.MetricsRegistry()))
+			if err != nil {
+				log.WithError(err).Fatal("Cannot create retention job")
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go job.Start(ctx)
+			log.WithField("dryRun", cfg.Retention.DryRun).Info("started retention job")
+		}
+
 		err = srv.ListenAndServe()
 		if err != nil {
 			log.WithError(err).Fatal("Cannot start server")
@@ -0,0 +1,112 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package archive
+
+import (
+	"bufio"
+	"io"
+	"math/bits"
+
+	"golang.org/x/xerrors"
+)
+
+const (
+	// DefaultChunkMinSize is the smallest chunk a Chunker produces, unless the stream ends
+	DefaultChunkMinSize = 512 * 1024
+
+	// DefaultChunkAvgSize is the chunk size a Chunker aims for
+	DefaultChunkAvgSize = 2 * 1024 * 1024
+
+	// DefaultChunkMaxSize is the largest chunk a Chunker produces
+	DefaultChunkMaxSize = 8 * 1024 * 1024
+)
+
+// gearTable holds the random values of the gear rolling hash. The values must never change,
+// as they determine the chunk boundaries and hence which chunks can be shared between backups.
+var gearTable = func() (res [256]uint64) {
+	// splitmix64 with a fixed seed
+	seed := uint64(0x6769747061642d63)
+	for i := range res {
+		seed += 0x9e3779b97f4a7c15
+		z := seed
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		res[i] = z ^ (z >> 31)
+	}
+	return
+}()
+
+// Chunker splits a stream into content-defined chunks: chunk boundaries depend on the content
+// surrounding them rather than their offset, so that an insertion or deletion only changes
+// the chunks around it.
+type Chunker struct {
+	r    *bufio.Reader
+	min  int
+	max  int
+	mask uint64
+	buf  []byte
+}
+
+// NewChunker produces a chunker with the default chunk sizes
+func NewChunker(r io.Reader) *Chunker {
+	c, _ := NewChunkerWithSize(r, DefaultChunkMinSize, DefaultChunkAvgSize, DefaultChunkMaxSize)
+	return c
+}
+
+// NewChunkerWithSize produces a chunker with custom chunk sizes. avg must be a power of two.
+func NewChunkerWithSize(r io.Reader, min, avg, max int) (*Chunker, error) {
+	if min <= 0 || min > avg || avg > max {
+		return nil, xerrors.Errorf("invalid chunk sizes: expected 0 < min <= avg <= max")
+	}
+	if avg&(avg-1) != 0 {
+		return nil, xerrors.Errorf("average chunk size must be a power of two")
+	}
+
+	// We use the high bits of the hash as they depend on more input bytes than the low ones.
+	n := bits.TrailingZeros(uint(avg))
+	return &Chunker{
+		r:    bufio.NewReaderSize(r, max),
+		min:  min,
+		max:  max,
+		mask: ((uint64(1) << n) - 1) << (64 - n),
+		buf:  make([]byte, max),
+	}, nil
+}
+
+// Next returns the next chunk of the stream. The returned slice is only valid until the next call to Next.
+// Returns io.EOF once the stream is exhausted.
+func (c *Chunker) Next() ([]byte, error) {
+	data, err := c.r.Peek(c.max)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, io.EOF
+	}
+
+	n := c.boundary(data)
+	copy(c.buf, data[:n])
+	_, err = c.r.Discard(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.buf[:n], nil
+}
+
+func (c *Chunker) boundary(data []byte) int {
+	if len(data) <= c.min {
+		return len(data)
+	}
+
+	var h uint64
+	for i, b := range data {
+		h = (h << 1) + gearTable[b]
+		if i >= c.min && h&c.mask == 0 {
+			return i + 1
+		}
+	}
+	return len(data)
+}
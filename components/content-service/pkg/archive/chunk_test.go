@@ -0,0 +1,68 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package archive
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+func chunkAll(t *testing.T, data []byte) (res [][]byte) {
+	c, err := NewChunkerWithSize(bytes.NewReader(data), 64, 256, 1024)
+	if err != nil {
+		t.Fatalf("cannot create chunker: %v", err)
+	}
+	for {
+		chunk, err := c.Next()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			t.Fatalf("cannot chunk: %v", err)
+		}
+		res = append(res, append([]byte(nil), chunk...))
+	}
+}
+
+func TestChunker(t *testing.T) {
+	data := make([]byte, 64*1024)
+	rand.New(rand.NewSource(42)).Read(data)
+
+	chunks := chunkAll(t, data)
+	if len(chunks) < 2 {
+		t.Fatalf("expected several chunks, got %d", len(chunks))
+	}
+	if joined := bytes.Join(chunks, nil); !bytes.Equal(joined, data) {
+		t.Fatalf("chunks do not add up to the original content")
+	}
+	for i, c := range chunks {
+		if len(c) > 1024 || (len(c) < 64 && i != len(chunks)-1) {
+			t.Errorf("chunk %d has invalid size %d", i, len(c))
+		}
+	}
+
+	// inserting a few bytes must only affect the chunks around the insertion
+	modified := append(append(append([]byte(nil), data[:32*1024]...), []byte("hello world")...), data[32*1024:]...)
+	known := make(map[[sha256.Size]byte]struct{})
+	for _, c := range chunks {
+		known[sha256.Sum256(c)] = struct{}{}
+	}
+	var changed int
+	for _, c := range chunkAll(t, modified) {
+		if _, ok := known[sha256.Sum256(c)]; !ok {
+			changed++
+		}
+	}
+	if changed > 3 {
+		t.Errorf("insertion changed %d chunks, expected at most 3", changed)
+	}
+
+	if chunks := chunkAll(t, nil); len(chunks) != 0 {
+		t.Errorf("expected no chunks for empty input, got %d", len(chunks))
+	}
+}
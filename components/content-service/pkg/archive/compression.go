@@ -0,0 +1,149 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/xerrors"
+)
+
+// Compression is the compression algorithm applied to a tar stream
+type Compression string
+
+const (
+	// Uncompressed leaves the tar stream as is
+	Uncompressed Compression = "none"
+
+	// Gzip compresses the tar stream using gzip
+	Gzip Compression = "gzip"
+
+	// Zstd compresses the tar stream using zstandard
+	Zstd Compression = "zstd"
+)
+
+// mediaTypeImageLayerZstd is the OCI media type of zstd compressed layers. The image-spec version we use predates it.
+const mediaTypeImageLayerZstd = "application/vnd.oci.image.layer.v1.tar+zstd"
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// ParseCompression parses a compression name as found in configuration. The empty string means Uncompressed.
+func ParseCompression(name string) (Compression, error) {
+	switch Compression(name) {
+	case "", Uncompressed:
+		return Uncompressed, nil
+	case Gzip:
+		return Gzip, nil
+	case Zstd:
+		return Zstd, nil
+	default:
+		return "", xerrors.Errorf("unsupported compression: %s", name)
+	}
+}
+
+// MediaType returns the OCI layer media type of a tar stream compressed with c
+func (c Compression) MediaType() string {
+	switch c {
+	case Gzip:
+		return ociv1.MediaTypeImageLayerGzip
+	case Zstd:
+		return mediaTypeImageLayerZstd
+	default:
+		return ociv1.MediaTypeImageLayer
+	}
+}
+
+// ContentType returns the MIME type of a tar stream compressed with c
+func (c Compression) ContentType() string {
+	switch c {
+	case Gzip:
+		return "application/gzip"
+	case Zstd:
+		return "application/zstd"
+	default:
+		return "application/x-tar"
+	}
+}
+
+// Compress wraps w so that everything written to the returned writer is compressed using c.
+// The level is algorithm specific, zero selects the default level.
+// Closing the returned writer flushes the compressor, but does not close w.
+func Compress(w io.Writer, c Compression, level int) (io.WriteCloser, error) {
+	switch c {
+	case "", Uncompressed:
+		return nopWriteCloser{w}, nil
+	case Gzip:
+		if level == 0 {
+			level = gzip.DefaultCompression
+		}
+		return gzip.NewWriterLevel(w, level)
+	case Zstd:
+		var opts []zstd.EOption
+		if level != 0 {
+			opts = append(opts, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+		}
+		return zstd.NewWriter(w, opts...)
+	default:
+		return nil, xerrors.Errorf("unsupported compression: %s", c)
+	}
+}
+
+// DetectCompression peeks at the beginning of r to determine how the stream is compressed
+func DetectCompression(r *bufio.Reader) (Compression, error) {
+	hdr, err := r.Peek(len(zstdMagic))
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+
+	switch {
+	case bytes.HasPrefix(hdr, zstdMagic):
+		return Zstd, nil
+	case bytes.HasPrefix(hdr, gzipMagic):
+		return Gzip, nil
+	default:
+		return Uncompressed, nil
+	}
+}
+
+// Decompress detects the compression of src and returns a reader producing the uncompressed stream.
+// Uncompressed streams are passed through, so that existing uncompressed backups keep working.
+func Decompress(src io.Reader) (io.ReadCloser, Compression, error) {
+	br := bufio.NewReader(src)
+	c, err := DetectCompression(br)
+	if err != nil {
+		return nil, "", xerrors.Errorf("cannot detect compression: %w", err)
+	}
+
+	switch c {
+	case Gzip:
+		gr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, c, err
+		}
+		return gr, c, nil
+	case Zstd:
+		zr, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, c, err
+		}
+		return zr.IOReadCloser(), c, nil
+	default:
+		return io.NopCloser(br), c, nil
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
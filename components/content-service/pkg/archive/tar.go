@@ -28,6 +28,9 @@ import (
 type TarConfig struct {
 	UIDMaps []IDMapping
 	GIDMaps []IDMapping
+
+	Compression      Compression
+	CompressionLevel int
 }
 
 // BuildTarbalOption configures the tarbal creation
@@ -54,7 +57,16 @@ func WithGIDMapping(mappings []IDMapping) TarOption {
 	}
 }
 
-// ExtractTarbal extracts an OCI compatible tar file src to the folder dst, expecting the overlay whiteout format
+// WithCompression compresses the archive during creation using the given algorithm and level
+func WithCompression(c Compression, level int) TarOption {
+	return func(o *TarConfig) {
+		o.Compression = c
+		o.CompressionLevel = level
+	}
+}
+
+// ExtractTarbal extracts an OCI compatible tar file src to the folder dst, expecting the overlay whiteout format.
+// Compressed tar streams are detected and decompressed transparently.
 func ExtractTarbal(ctx context.Context, src io.Reader, dst string, opts ...TarOption) (err error) {
 	type Info struct {
 		UID, GID  int
@@ -73,8 +85,15 @@ func ExtractTarbal(ctx context.Context, src io.Reader, dst string, opts ...TarOp
 		opt(&cfg)
 	}
 
+	uncompressed, compression, err := Decompress(src)
+	if err != nil {
+		return err
+	}
+	defer uncompressed.Close()
+	span.LogKV("compression", compression)
+
 	pipeReader, pipeWriter := io.Pipe()
-	teeReader := io.TeeReader(src, pipeWriter)
+	teeReader := io.TeeReader(uncompressed, pipeWriter)
 
 	tarReader := tar.NewReader(pipeReader)
 
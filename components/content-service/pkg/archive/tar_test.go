@@ -23,8 +23,9 @@ func TestExtractTarbal(t *testing.T) {
 		Mode        int
 	}
 	tests := []struct {
-		Name  string
-		Files []file
+		Name        string
+		Files       []file
+		Compression Compression
 	}{
 		{
 			Name: "simple-test",
@@ -37,17 +38,42 @@ func TestExtractTarbal(t *testing.T) {
 			Name:  "empty-tar",
 			Files: []file{},
 		},
+		{
+			Name: "gzip",
+			Files: []file{
+				{"file.txt", 1024, 33333, 0644},
+			},
+			Compression: Gzip,
+		},
+		{
+			Name: "zstd",
+			Files: []file{
+				{"file.txt", 1024, 33333, 0644},
+			},
+			Compression: Zstd,
+		},
+		{
+			Name:        "empty-zstd",
+			Files:       []file{},
+			Compression: Zstd,
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			var (
-				buf = bytes.NewBuffer(nil)
-				tw  = tar.NewWriter(buf)
-			)
+			compression := test.Compression
+			if compression == "" {
+				compression = Uncompressed
+			}
+			buf := bytes.NewBuffer(nil)
+			cw, err := Compress(buf, compression, 0)
+			if err != nil {
+				t.Fatalf("cannot prepare compression: %q", err)
+			}
+			tw := tar.NewWriter(cw)
 
 			for _, file := range test.Files {
-				err := tw.WriteHeader(&tar.Header{
+				err = tw.WriteHeader(&tar.Header{
 					Name:     file.Name,
 					Size:     file.ContentSize,
 					Uid:      file.UID,
@@ -65,6 +91,7 @@ func TestExtractTarbal(t *testing.T) {
 			}
 			tw.Flush()
 			tw.Close()
+			cw.Close()
 
 			wd, err := os.MkdirTemp("", "")
 			defer os.RemoveAll(wd)
@@ -65,6 +65,7 @@ func Execute(ctx context.Context, destination string, cfgin io.Reader, forceGitU
 		rs = &storage.NamedURLDownloader{URLs: cfg.URLs}
 		ilr, err = initializer.NewFromRequest(ctx, destination, rs, &req, initializer.NewFromRequestOpts{
 			ForceGitpodUserForGit: forceGitUser,
+			Progress:              initializer.WorkspaceProgressReporter(destination),
 		})
 		if err != nil {
 			return "", err
@@ -7,11 +7,16 @@ package git
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/opentracing/opentracing-go"
@@ -22,6 +27,12 @@ import (
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 )
 
+const (
+	// CloneDepthConfig is the local Git config key that records an explicit clone depth,
+	// so that a deliberately shallow repository is not unshallowed later on
+	CloneDepthConfig = "gitpod.clonedepth"
+)
+
 var (
 	// errNoCommitsYet is a substring of a Git error if we have no commits yet in a working copy
 	errNoCommitsYet = "does not have any commits yet"
@@ -87,6 +98,9 @@ type Client struct {
 	// Config values to be set on clone provided through `.gitpod.yml`
 	Config map[string]string
 
+	// CommandConfig values are passed to every Git command using -c, and take precedence over the repository's config
+	CommandConfig map[string]string
+
 	// RemoteURI is the Git WS remote origin
 	RemoteURI string
 
@@ -95,13 +109,40 @@ type Client struct {
 
 	// if true will run git command as gitpod user (should be executed as root that has access to sudo in this case)
 	RunAsGitpodUser bool
+
+	// CloneDepth is the number of commits fetched on clone. If zero, only the latest commit is cloned,
+	// if negative the full history.
+	CloneDepth int
+
+	// CloneFilter is the partial clone filter, e.g. blob:none or tree:0. If empty, all objects are cloned.
+	CloneFilter string
+
+	// SparseCheckout lists the directories checked out in cone mode. If empty, the whole repository is checked out.
+	SparseCheckout []string
+
+	// LFSInclude lists the paths for which Git LFS objects are fetched. If empty, the objects of all paths are fetched.
+	LFSInclude []string
+
+	// LFSExclude lists the paths for which Git LFS objects are not fetched
+	LFSExclude []string
+
+	// Progress is called with the fetch progress during clone
+	Progress ProgressFunc
 }
 
+// ProgressFunc receives the progress of a Git operation, e.g. ("Receiving objects", 42)
+type ProgressFunc func(phase string, percent int)
+
 // Status describes the status of a Git repo/working copy akin to "git status"
 type Status struct {
 	porcelainStatus
 	UnpushedCommits []string
 	LatestCommit    string
+
+	// UnpushedLFSObjects are the paths of Git LFS files changed in unpushed commits
+	UnpushedLFSObjects []string
+	// MissingLFSObjects are the paths of Git LFS files of which only the pointer is checked out
+	MissingLFSObjects []string
 }
 
 const (
@@ -132,6 +173,11 @@ func (s *Status) ToAPI() *csapi.GitStatus {
 		TotalUntrackedFiles:  int64(len(s.UntrackedFiles)),
 		UnpushedCommits:      limit(s.UnpushedCommits),
 		TotalUnpushedCommits: int64(len(s.UnpushedCommits)),
+
+		UnpushedLfsObjects:      limit(s.UnpushedLFSObjects),
+		TotalUnpushedLfsObjects: int64(len(s.UnpushedLFSObjects)),
+		MissingLfsObjects:       limit(s.MissingLFSObjects),
+		TotalMissingLfsObjects:  int64(len(s.MissingLFSObjects)),
 	}
 }
 
@@ -151,6 +197,11 @@ func (e OpFailedError) Error() string {
 // GitWithOutput starts git and returns the stdout of the process. This function returns once git is started,
 // not after it finishd. Once the returned reader returned io.EOF, the command is finished.
 func (c *Client) GitWithOutput(ctx context.Context, ignoreErr *string, subcommand string, args ...string) (out []byte, err error) {
+	return c.gitWithProgress(ctx, ignoreErr, nil, subcommand, args...)
+}
+
+// gitWithProgress runs git like GitWithOutput and passes the progress git prints on stderr to the progress func, if any
+func (c *Client) gitWithProgress(ctx context.Context, ignoreErr *string, progress ProgressFunc, subcommand string, args ...string) (out []byte, err error) {
 	//nolint:staticcheck,ineffassign
 	span, ctx := opentracing.StartSpanFromContext(ctx, fmt.Sprintf("git.%s", subcommand))
 	defer func() {
@@ -161,6 +212,78 @@ func (c *Client) GitWithOutput(ctx context.Context, ignoreErr *string, subcomman
 		}
 	}()
 
+	cmd, err := c.command(span, subcommand, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var res bytes.Buffer
+	cmd.Stdout = &res
+	cmd.Stderr = &res
+	if progress != nil {
+		cmd.Stderr = io.MultiWriter(&res, &progressWriter{progress: progress})
+	}
+	err = cmd.Run()
+	if err != nil {
+		if strings.Contains(err.Error(), "no child process") {
+			return res.Bytes(), nil
+		}
+
+		return nil, OpFailedError{
+			Args:       args,
+			ExecErr:    err,
+			Output:     res.String(),
+			Subcommand: subcommand,
+		}
+	}
+
+	return res.Bytes(), nil
+}
+
+// progressLine matches the progress git prints with --progress, e.g. "Receiving objects:  42% (420/1000), 1.20 MiB | 2.00 MiB/s"
+var progressLine = regexp.MustCompile(`^(?:remote: )?([A-Za-z][A-Za-z ]*):\s+(\d{1,3})%`)
+
+// progressWriter parses git's progress output and reports every change in phase or percentage
+type progressWriter struct {
+	progress ProgressFunc
+
+	buf     []byte
+	phase   string
+	percent int
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+
+	// git terminates progress updates with \r and finished phases with \n
+	rest := w.buf
+	for {
+		i := bytes.IndexAny(rest, "\r\n")
+		if i < 0 {
+			break
+		}
+		line := rest[:i]
+		rest = rest[i+1:]
+
+		m := progressLine.FindSubmatch(line)
+		if m == nil {
+			continue
+		}
+		phase := string(m[1])
+		percent, _ := strconv.Atoi(string(m[2]))
+		if phase == w.phase && percent == w.percent {
+			continue
+		}
+		w.phase, w.percent = phase, percent
+		w.progress(phase, percent)
+	}
+	w.buf = append(w.buf[:0], rest...)
+
+	return len(p), nil
+}
+
+// command prepares the git command with the client's environment and authentication
+func (c *Client) command(span opentracing.Span, subcommand string, args ...string) (*exec.Cmd, error) {
 	fullArgs := make([]string, 0)
 	env := make([]string, 0)
 	if c.AuthMethod == BasicAuth {
@@ -180,6 +303,15 @@ func (c *Client) GitWithOutput(ctx context.Context, ignoreErr *string, subcomman
 
 	env = append(env, "HOME=/home/gitpod")
 
+	keys := make([]string, 0, len(c.CommandConfig))
+	for k := range c.CommandConfig {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fullArgs = append(fullArgs, "-c", k+"="+c.CommandConfig[k])
+	}
+
 	fullArgs = append(fullArgs, subcommand)
 	fullArgs = append(fullArgs, args...)
 
@@ -208,22 +340,7 @@ func (c *Client) GitWithOutput(ctx context.Context, ignoreErr *string, subcomman
 	cmd := exec.Command(cmdName, fullArgs...)
 	cmd.Dir = c.Location
 	cmd.Env = env
-
-	res, err := cmd.CombinedOutput()
-	if err != nil {
-		if strings.Contains(err.Error(), "no child process") {
-			return res, nil
-		}
-
-		return nil, OpFailedError{
-			Args:       args,
-			ExecErr:    err,
-			Output:     string(res),
-			Subcommand: subcommand,
-		}
-	}
-
-	return res, nil
+	return cmd, nil
 }
 
 // Git executes git using the client configuration
@@ -276,11 +393,26 @@ func GitStatusFromFiles(ctx context.Context, loc string) (res *Status, err error
 		latestCommit = strings.TrimSpace(string(gitout))
 	}
 
-	return &Status{
+	res = &Status{
 		porcelainStatus: *porcelain,
 		UnpushedCommits: unpushedCommits,
 		LatestCommit:    latestCommit,
-	}, nil
+	}
+
+	// older prestop hooks do not record the Git LFS status
+	gitout, err = os.ReadFile(filepath.Join(loc, "git_lfs_1.txt"))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	res.MissingLFSObjects = parseLFSFiles(gitout, true)
+
+	gitout, err = os.ReadFile(filepath.Join(loc, "git_lfs_2.txt"))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	res.UnpushedLFSObjects = parseLFSFiles(gitout, false)
+
+	return res, nil
 }
 
 // Status runs git status
@@ -324,11 +456,127 @@ func (c *Client) Status(ctx context.Context) (res *Status, err error) {
 		latestCommit = strings.TrimSpace(string(gitout))
 	}
 
-	return &Status{
+	res = &Status{
 		porcelainStatus: *porcelain,
 		UnpushedCommits: unpushedCommits,
 		LatestCommit:    latestCommit,
-	}, nil
+	}
+
+	// The Git LFS status is informative only, hence we don't want to fail if git-lfs isn't available
+	err = c.lfsStatus(ctx, res)
+	if err != nil {
+		log.WithError(err).WithField("location", c.Location).Warn("cannot determine Git LFS status")
+	}
+
+	return res, nil
+}
+
+// lfsStatus adds the unpushed and missing Git LFS objects to the status if the repository uses Git LFS
+func (c *Client) lfsStatus(ctx context.Context, res *Status) error {
+	usesLFS, err := c.UsesLFS(ctx)
+	if err != nil || !usesLFS {
+		return err
+	}
+
+	gitout, err := c.GitWithOutput(ctx, nil, "lfs", "ls-files", "--long")
+	if err != nil {
+		return err
+	}
+	res.MissingLFSObjects = parseLFSFiles(gitout, true)
+
+	// Unpushed objects are those changed since the upstream of the current branch, or the remote's HEAD if there's none.
+	// Without a remote all objects are unpushed.
+	args := []string{"ls-files", "--long", "HEAD"}
+	for _, base := range []string{"@{upstream}", "origin/HEAD"} {
+		gitout, err = c.GitWithOutput(ctx, nil, "rev-parse", "-q", "--verify", base)
+		if err == nil {
+			args = []string{"ls-files", "--long", strings.TrimSpace(string(gitout)), "HEAD"}
+			break
+		}
+	}
+	gitout, err = c.GitWithOutput(ctx, nil, "lfs", args...)
+	if err != nil {
+		return err
+	}
+	res.UnpushedLFSObjects = parseLFSFiles(gitout, false)
+
+	return nil
+}
+
+// parseLFSFiles parses the output of "git lfs ls-files --long", i.e. lines of "<oid> <*|-> <path>",
+// where * marks files whose object is checked out and - files of which only the pointer is.
+// If missingOnly is true, only the pointer files are returned.
+func parseLFSFiles(out []byte, missingOnly bool) []string {
+	var res []string
+	for _, l := range strings.Split(string(out), "\n") {
+		segs := strings.SplitN(strings.TrimSpace(l), " ", 3)
+		if len(segs) != 3 {
+			continue
+		}
+		if missingOnly && segs[1] != "-" {
+			continue
+		}
+		res = append(res, segs[2])
+	}
+	return res
+}
+
+// UsesLFS determines whether the repository tracks files with Git LFS, i.e. any of its .gitattributes files
+// configures the lfs filter
+func (c *Client) UsesLFS(ctx context.Context) (bool, error) {
+	// we search the index rather than the working copy so that attributes outside a sparse checkout are found, too
+	_, err := c.GitWithOutput(ctx, nil, "grep", "-q", "--cached", "-F", "filter=lfs", "--", ".gitattributes", "*/.gitattributes")
+	var opErr OpFailedError
+	if errors.As(err, &opErr) {
+		var exitErr *exec.ExitError
+		if errors.As(opErr.ExecErr, &exitErr) && exitErr.ExitCode() == 1 {
+			// git grep exits with 1 if nothing matched
+			return false, nil
+		}
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// FetchLFS downloads the Git LFS objects of the checked out commit honouring LFSInclude and LFSExclude,
+// and sets up Git LFS in the working copy. It does nothing if the repository does not use Git LFS.
+func (c *Client) FetchLFS(ctx context.Context) (err error) {
+	//nolint:staticcheck,ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "fetchLFS")
+	defer tracing.FinishSpan(span, &err)
+
+	usesLFS, err := c.UsesLFS(ctx)
+	if err != nil {
+		return err
+	}
+	span.SetTag("usesLFS", usesLFS)
+	if !usesLFS {
+		return nil
+	}
+
+	// installs the LFS filters and hooks in the working copy so that checkouts and pushes in the workspace handle LFS objects
+	err = c.Git(ctx, "lfs", "install", "--local")
+	if err != nil {
+		return err
+	}
+	// Unlike passing them to "git lfs pull" directly, this way the patterns apply to later fetches in the workspace, too.
+	if len(c.LFSInclude) > 0 {
+		err = c.Git(ctx, "config", "--local", "lfs.fetchinclude", strings.Join(c.LFSInclude, ","))
+		if err != nil {
+			return err
+		}
+	}
+	if len(c.LFSExclude) > 0 {
+		err = c.Git(ctx, "config", "--local", "lfs.fetchexclude", strings.Join(c.LFSExclude, ","))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = c.gitWithProgress(ctx, nil, c.Progress, "lfs", "pull")
+	return err
 }
 
 // Clone runs git clone
@@ -338,7 +586,25 @@ func (c *Client) Clone(ctx context.Context) (err error) {
 		log.WithError(err).Error("cannot create clone location")
 	}
 
-	args := []string{"--depth=1", "--shallow-submodules", c.RemoteURI}
+	args := make([]string, 0)
+	switch {
+	case c.CloneDepth == 0:
+		args = append(args, "--depth=1", "--shallow-submodules")
+	case c.CloneDepth > 0:
+		args = append(args, fmt.Sprintf("--depth=%d", c.CloneDepth), "--shallow-submodules")
+		args = append(args, "--config", fmt.Sprintf("%s=%d", CloneDepthConfig, c.CloneDepth))
+	}
+	if c.CloneFilter != "" {
+		args = append(args, "--filter="+c.CloneFilter)
+	}
+	if len(c.SparseCheckout) > 0 {
+		// only checks out the files in the root directory until the sparse checkout is set below
+		args = append(args, "--sparse")
+	}
+	if c.Progress != nil {
+		args = append(args, "--progress")
+	}
+	args = append(args, c.RemoteURI)
 
 	for key, value := range c.Config {
 		args = append(args, "--config")
@@ -353,7 +619,19 @@ func (c *Client) Clone(ctx context.Context) (err error) {
 
 	args = append(args, ".")
 
-	return c.Git(ctx, "clone", args...)
+	_, err = c.gitWithProgress(ctx, nil, c.Progress, "clone", args...)
+	if err != nil {
+		return err
+	}
+
+	if len(c.SparseCheckout) > 0 {
+		err = c.Git(ctx, "sparse-checkout", append([]string{"set", "--cone"}, c.SparseCheckout...)...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // UpdateRemote performs a git fetch on the upstream remote URI
@@ -7,7 +7,9 @@ package git
 import (
 	"context"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -531,3 +533,307 @@ func initFromRemote(ctx context.Context, c *Client) error {
 
 	return nil
 }
+
+func TestClone(t *testing.T) {
+	tests := []struct {
+		Name           string
+		CloneDepth     int
+		CloneFilter    string
+		SparseCheckout []string
+		Commits        string
+		Files          []string
+		Filter         string
+	}{
+		{
+			Name:    "default",
+			Commits: "1",
+			Files:   []string{"README.md", "backend/main.go", "frontend/index.ts"},
+		},
+		{
+			Name:       "depth",
+			CloneDepth: 2,
+			Commits:    "2",
+			Files:      []string{"README.md", "backend/main.go", "frontend/index.ts"},
+		},
+		{
+			Name:       "full history",
+			CloneDepth: -1,
+			Commits:    "3",
+			Files:      []string{"README.md", "backend/main.go", "frontend/index.ts"},
+		},
+		{
+			Name:        "partial clone",
+			CloneDepth:  -1,
+			CloneFilter: "blob:none",
+			Commits:     "3",
+			Files:       []string{"README.md", "backend/main.go", "frontend/index.ts"},
+			Filter:      "blob:none",
+		},
+		{
+			Name:           "sparse checkout",
+			CloneFilter:    "tree:0",
+			SparseCheckout: []string{"backend"},
+			Commits:        "1",
+			Files:          []string{"README.md", "backend/main.go"},
+			Filter:         "tree:0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			remote, err := newGitClient(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := remote.Git(ctx, "init"); err != nil {
+				t.Fatal(err)
+			}
+			if err := remote.Git(ctx, "config", "--local", "uploadpack.allowFilter", "true"); err != nil {
+				t.Fatal(err)
+			}
+			for _, fn := range []string{"README.md", "backend/main.go", "frontend/index.ts"} {
+				if err := os.MkdirAll(filepath.Join(remote.Location, filepath.Dir(fn)), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filepath.Join(remote.Location, fn), []byte(fn), 0644); err != nil {
+					t.Fatal(err)
+				}
+				if err := remote.Git(ctx, "add", fn); err != nil {
+					t.Fatal(err)
+				}
+				if err := remote.Git(ctx, "-c", "user.email=foo@bar.com", "-c", "user.name=foo bar", "commit", "-m", "add "+fn); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			client, err := newGitClient(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var phases []string
+			// depth and filter are ignored for clones from a local path
+			client.RemoteURI = "file://" + remote.Location
+			client.CloneDepth = test.CloneDepth
+			client.CloneFilter = test.CloneFilter
+			client.SparseCheckout = test.SparseCheckout
+			client.Progress = func(phase string, percent int) {
+				if percent == 100 {
+					phases = append(phases, phase)
+				}
+			}
+			if err := client.Clone(ctx); err != nil {
+				t.Fatalf("cannot clone: %v", err)
+			}
+
+			commits, err := client.GitWithOutput(ctx, nil, "rev-list", "--count", "HEAD")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if act := strings.TrimSpace(string(commits)); act != test.Commits {
+				t.Errorf("unexpected number of commits: expected %s, got %s", test.Commits, act)
+			}
+
+			var files []string
+			for _, fn := range []string{"README.md", "backend/main.go", "frontend/index.ts"} {
+				if _, err := os.Stat(filepath.Join(client.Location, fn)); err == nil {
+					files = append(files, fn)
+				}
+			}
+			if diff := cmp.Diff(test.Files, files); diff != "" {
+				t.Errorf("unexpected checked out files (-want +got):\n%s", diff)
+			}
+
+			depth, _ := client.GitWithOutput(ctx, nil, "config", "--get", CloneDepthConfig)
+			if test.CloneDepth > 0 && strings.TrimSpace(string(depth)) != strconv.Itoa(test.CloneDepth) {
+				t.Errorf("explicit clone depth was not recorded: %q", depth)
+			}
+
+			filter, _ := client.GitWithOutput(ctx, nil, "config", "--get", "remote.origin.partialclonefilter")
+			if act := strings.TrimSpace(string(filter)); act != test.Filter {
+				t.Errorf("unexpected partial clone filter: expected %q, got %q", test.Filter, act)
+			}
+
+			if len(phases) == 0 {
+				t.Errorf("no clone progress was reported")
+			}
+
+			status, err := client.Status(ctx)
+			if err != nil {
+				t.Fatalf("cannot get status: %v", err)
+			}
+			if len(status.UncommitedFiles) > 0 || len(status.UntrackedFiles) > 0 || len(status.UnpushedCommits) > 0 {
+				t.Errorf("fresh clone has pending changes: %+v", status)
+			}
+		})
+	}
+}
+
+func TestProgressWriter(t *testing.T) {
+	type progress struct {
+		Phase   string
+		Percent int
+	}
+	tests := []struct {
+		Name        string
+		Chunks      []string
+		Expectation []progress
+	}{
+		{
+			Name:   "phases",
+			Chunks: []string{"Cloning into '.'...\n", "remote: Enumerating objects: 10, done.\n", "Receiving objects:  50% (5/10)\r", "Receiving objects: 100% (10/10), done.\n", "Resolving deltas: 100% (2/2), done.\n"},
+			Expectation: []progress{
+				{"Receiving objects", 50},
+				{"Receiving objects", 100},
+				{"Resolving deltas", 100},
+			},
+		},
+		{
+			Name:   "remote progress",
+			Chunks: []string{"remote: Counting objects:  33% (1/3)\r", "remote: Counting objects: 100% (3/3), done.\n"},
+			Expectation: []progress{
+				{"Counting objects", 33},
+				{"Counting objects", 100},
+			},
+		},
+		{
+			Name:   "split lines",
+			Chunks: []string{"Receiving obj", "ects:  4", "2% (42/100), 1.20 MiB | 2.00 MiB/s\r", "Receiving objects:  42% (42/100), 1.30 MiB | 2.00 MiB/s\r"},
+			Expectation: []progress{
+				{"Receiving objects", 42},
+			},
+		},
+		{
+			Name:   "no progress",
+			Chunks: []string{"warning: redirecting to https://example.com/repo.git/\n", "fatal: repository not found\n"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var act []progress
+			w := &progressWriter{progress: func(phase string, percent int) {
+				act = append(act, progress{phase, percent})
+			}}
+			for _, c := range test.Chunks {
+				if _, err := w.Write([]byte(c)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected progress (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseLFSFiles(t *testing.T) {
+	out := []byte(`5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03 * a.bin
+6fb6d3835a3563bf834b561a53b234839f9c9449ab9b3cf01c531ef30198dcbe - assets/some image.png
+
+`)
+	tests := []struct {
+		Name        string
+		Out         []byte
+		MissingOnly bool
+		Expectation []string
+	}{
+		{Name: "all files", Out: out, Expectation: []string{"a.bin", "assets/some image.png"}},
+		{Name: "missing only", Out: out, MissingOnly: true, Expectation: []string{"assets/some image.png"}},
+		{Name: "no output", Out: nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := parseLFSFiles(test.Out, test.MissingOnly)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected files (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLFS(t *testing.T) {
+	if _, err := exec.LookPath("git-lfs"); err != nil {
+		t.Skip("git-lfs is not installed")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	remote, err := newGitClient(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prep := [][]string{
+		{"init"},
+		{"lfs", "install", "--local"},
+		{"lfs", "track", "*.bin", "assets/**"},
+	}
+	for _, args := range prep {
+		if err := remote.Git(ctx, args[0], args[1:]...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	files := map[string]string{"model.bin": "weights", "assets/logo.png": "logo", "README.md": "readme"}
+	for fn, content := range files {
+		if err := os.MkdirAll(filepath.Join(remote.Location, filepath.Dir(fn)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(remote.Location, fn), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := remote.Git(ctx, "add", "."); err != nil {
+		t.Fatal(err)
+	}
+	if err := remote.Git(ctx, "-c", "user.email=foo@bar.com", "-c", "user.name=foo bar", "commit", "-m", "init"); err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := newGitClient(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.RemoteURI = "file://" + remote.Location
+	client.LFSExclude = []string{"assets"}
+	if err := client.Clone(ctx); err != nil {
+		t.Fatalf("cannot clone: %v", err)
+	}
+
+	if usesLFS, err := client.UsesLFS(ctx); err != nil || !usesLFS {
+		t.Fatalf("expected repository to use Git LFS: %v", err)
+	}
+	if err := client.FetchLFS(ctx); err != nil {
+		t.Fatalf("cannot fetch LFS objects: %v", err)
+	}
+	if content, _ := os.ReadFile(filepath.Join(client.Location, "model.bin")); string(content) != files["model.bin"] {
+		t.Errorf("LFS object was not checked out: %q", content)
+	}
+	if content, _ := os.ReadFile(filepath.Join(client.Location, "assets/logo.png")); string(content) == files["assets/logo.png"] {
+		t.Errorf("excluded LFS object was checked out")
+	}
+
+	if err := os.WriteFile(filepath.Join(client.Location, "new.bin"), []byte("new"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Git(ctx, "add", "new.bin"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Git(ctx, "commit", "-m", "add new.bin"); err != nil {
+		t.Fatal(err)
+	}
+
+	status, err := client.Status(ctx)
+	if err != nil {
+		t.Fatalf("cannot get status: %v", err)
+	}
+	if diff := cmp.Diff([]string{"assets/logo.png"}, status.MissingLFSObjects); diff != "" {
+		t.Errorf("unexpected missing LFS objects (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"new.bin"}, status.UnpushedLFSObjects); diff != "" {
+		t.Errorf("unexpected unpushed LFS objects (-want +got):\n%s", diff)
+	}
+}
@@ -169,6 +169,9 @@ func (ws *GitInitializer) Run(ctx context.Context, mappings []archive.IDMapping)
 	if err := ws.UpdateSubmodules(ctx); err != nil {
 		log.WithError(err).Warn("error while updating submodules - continuing")
 	}
+	if err := ws.FetchLFS(ctx); err != nil {
+		log.WithError(err).Warn("error while fetching Git LFS objects - continuing")
+	}
 
 	log.WithField("stage", "init").WithField("location", ws.Location).Debug("Git operations complete")
 	return
@@ -210,7 +213,8 @@ func (ws *GitInitializer) realizeCloneTarget(ctx context.Context) (err error) {
 		//
 		// We don't recurse submodules because callers realizeCloneTarget() are expected to update submodules explicitly,
 		// and deal with any error appropriately (i.e. emit a warning rather than fail).
-		if err := ws.Git(ctx, "fetch", "--depth=1", "origin", "--recurse-submodules=no", ws.CloneTarget); err != nil {
+		args := append(ws.fetchDepth(1), "origin", "--recurse-submodules=no", ws.CloneTarget)
+		if err := ws.Git(ctx, "fetch", args...); err != nil {
 			log.WithError(err).WithField("remoteURI", ws.RemoteURI).WithField("branch", ws.CloneTarget).Error("Cannot fetch remote branch")
 			return err
 		}
@@ -228,7 +232,8 @@ func (ws *GitInitializer) realizeCloneTarget(ctx context.Context) (err error) {
 		// We did a shallow clone before, hence need to fetch the commit we are about to check out.
 		// Because we don't want to make the "git fetch" mechanism in supervisor more complicated,
 		// we'll just fetch the 20 commits right away.
-		if err := ws.Git(ctx, "fetch", "origin", ws.CloneTarget, "--depth=20"); err != nil {
+		args := append([]string{"origin", ws.CloneTarget}, ws.fetchDepth(20)...)
+		if err := ws.Git(ctx, "fetch", args...); err != nil {
 			return err
 		}
 
@@ -251,6 +256,18 @@ func (ws *GitInitializer) realizeCloneTarget(ctx context.Context) (err error) {
 	return nil
 }
 
+// fetchDepth returns the depth argument for fetching at least the given number of commits without
+// making a deeper clone shallow again
+func (ws *GitInitializer) fetchDepth(depth int) []string {
+	if ws.CloneDepth < 0 {
+		return nil
+	}
+	if ws.CloneDepth > depth {
+		depth = ws.CloneDepth
+	}
+	return []string{fmt.Sprintf("--depth=%d", depth)}
+}
+
 func checkGitStatus(err error) error {
 	if err != nil {
 		if strings.Contains(err.Error(), "The requested URL returned error: 524") {
@@ -0,0 +1,121 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package initializer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/pkg/git"
+)
+
+func TestNewGitInitializer(t *testing.T) {
+	progress := git.ProgressFunc(func(phase string, percent int) {})
+
+	tests := []struct {
+		Name             string
+		Req              *csapi.GitInitializer
+		ExpectErr        bool
+		ExpectedProgress bool
+	}{
+		{
+			Name:             "shallow sparse partial clone",
+			Req:              &csapi.GitInitializer{CheckoutLocation: "repo", CloneDepth: 10, CloneFilter: "blob:none", SparseCheckout: []string{"services/api", "libs"}},
+			ExpectedProgress: true,
+		},
+		{Name: "size limit filter", Req: &csapi.GitInitializer{CheckoutLocation: "repo", CloneFilter: "blob:limit=1m"}, ExpectedProgress: true},
+		{Name: "checkout in workspace root", Req: &csapi.GitInitializer{CloneFilter: "tree:0"}},
+		{Name: "unsupported filter", Req: &csapi.GitInitializer{CloneFilter: "sparse:oid=HEAD"}, ExpectErr: true},
+		{Name: "absolute sparse checkout", Req: &csapi.GitInitializer{SparseCheckout: []string{"/etc"}}, ExpectErr: true},
+		{Name: "sparse checkout outside repo", Req: &csapi.GitInitializer{SparseCheckout: []string{"../other"}}, ExpectErr: true},
+		{Name: "sparse checkout flag", Req: &csapi.GitInitializer{SparseCheckout: []string{"--no-cone"}}, ExpectErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.Req.Config = &csapi.GitConfig{Authentication: csapi.GitAuthMethod_NO_AUTH}
+			gi, err := newGitInitializer(context.Background(), "/workspace", test.Req, NewFromRequestOpts{Progress: progress})
+			if test.ExpectErr {
+				if err == nil {
+					t.Fatal("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if gi.CloneDepth != int(test.Req.CloneDepth) || gi.CloneFilter != test.Req.CloneFilter {
+				t.Errorf("unexpected clone options: depth %d, filter %q", gi.CloneDepth, gi.CloneFilter)
+			}
+			if diff := cmp.Diff(test.Req.SparseCheckout, gi.SparseCheckout); diff != "" {
+				t.Errorf("unexpected sparse checkout (-want +got):\n%s", diff)
+			}
+			if hasProgress := gi.Progress != nil; hasProgress != test.ExpectedProgress {
+				t.Errorf("unexpected progress reporting: expected %v, got %v", test.ExpectedProgress, hasProgress)
+			}
+		})
+	}
+}
+
+func TestFetchDepth(t *testing.T) {
+	tests := []struct {
+		Name        string
+		CloneDepth  int
+		Depth       int
+		Expectation []string
+	}{
+		{Name: "default", CloneDepth: 0, Depth: 20, Expectation: []string{"--depth=20"}},
+		{Name: "shallower clone", CloneDepth: 5, Depth: 20, Expectation: []string{"--depth=20"}},
+		{Name: "deeper clone", CloneDepth: 50, Depth: 1, Expectation: []string{"--depth=50"}},
+		{Name: "full history", CloneDepth: -1, Depth: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ws := &GitInitializer{Client: git.Client{CloneDepth: test.CloneDepth}}
+			if diff := cmp.Diff(test.Expectation, ws.fetchDepth(test.Depth)); diff != "" {
+				t.Errorf("unexpected fetch depth (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkspaceProgressReporter(t *testing.T) {
+	wspath := t.TempDir()
+	report := WorkspaceProgressReporter(wspath)
+
+	report("Receiving objects", 10)
+	report("Receiving objects", 42)
+
+	fc, err := os.ReadFile(filepath.Join(wspath, WorkspaceProgressFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var act csapi.WorkspaceInitProgress
+	err = json.Unmarshal(fc, &act)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(csapi.WorkspaceInitProgress{Phase: "Receiving objects", Percent: 42}, act); diff != "" {
+		t.Errorf("unexpected progress (-want +got):\n%s", diff)
+	}
+
+	err = PlaceWorkspaceReadyFile(context.Background(), wspath, csapi.WorkspaceInitFromOther, os.Getuid(), os.Getgid())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(wspath, WorkspaceProgressFile)); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("progress file was not removed once the workspace was ready: %v", err)
+	}
+}
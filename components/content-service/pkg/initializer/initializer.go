@@ -35,6 +35,10 @@ const (
 	// WorkspaceReadyFile is the name of the ready file we're placing in a workspace
 	WorkspaceReadyFile = ".gitpod/ready"
 
+	// WorkspaceProgressFile is the name of the file in which we report the content initialization progress
+	// until the workspace ready file is placed
+	WorkspaceProgressFile = ".gitpod/progress"
+
 	// GitpodUID is the user ID of the gitpod user
 	GitpodUID = 33333
 
@@ -81,6 +85,9 @@ type NewFromRequestOpts struct {
 	// Git content is forced to the Gitpod user. All other content (backup, prebuild, snapshot) will already
 	// have the correct user.
 	ForceGitpodUserForGit bool
+
+	// Progress receives the progress of Git clones, if not nil
+	Progress git.ProgressFunc
 }
 
 // NewFromRequest picks the initializer from the request but does not execute it.
@@ -109,7 +116,7 @@ func NewFromRequest(ctx context.Context, loc string, rs storage.DirectDownloader
 			return nil, status.Error(codes.InvalidArgument, "missing Git initializer spec")
 		}
 
-		initializer, err = newGitInitializer(ctx, loc, ir.Git, opts.ForceGitpodUserForGit)
+		initializer, err = newGitInitializer(ctx, loc, ir.Git, opts)
 	} else if ir, ok := spec.(*csapi.WorkspaceInitializer_Prebuild); ok {
 		if ir.Prebuild == nil {
 			return nil, status.Error(codes.InvalidArgument, "missing prebuild initializer spec")
@@ -123,7 +130,7 @@ func NewFromRequest(ctx context.Context, loc string, rs storage.DirectDownloader
 		}
 		var gits []*GitInitializer
 		for _, gi := range ir.Prebuild.Git {
-			gitinit, err := newGitInitializer(ctx, loc, gi, opts.ForceGitpodUserForGit)
+			gitinit, err := newGitInitializer(ctx, loc, gi, opts)
 			if err != nil {
 				return nil, err
 			}
@@ -139,6 +146,8 @@ func NewFromRequest(ctx context.Context, loc string, rs storage.DirectDownloader
 		initializer, err = newFileDownloadInitializer(loc, ir.Download)
 	} else if ir, ok := spec.(*csapi.WorkspaceInitializer_Backup); ok {
 		initializer, err = newFromBackupInitializer(loc, rs, ir.Backup)
+	} else if ir, ok := spec.(*csapi.WorkspaceInitializer_OciArtifact); ok {
+		initializer, err = newOCIArtifactInitializer(loc, ir.OciArtifact)
 	} else {
 		initializer = &EmptyInitializer{}
 	}
@@ -191,7 +200,7 @@ func (bi *fromBackupInitializer) Run(ctx context.Context, mappings []archive.IDM
 		return csapi.WorkspaceInitFromBackup, nil
 	}
 
-	hasBackup, err := bi.RemoteStorage.Download(ctx, bi.Location, storage.DefaultBackup, mappings)
+	hasBackup, err := restoreBackup(ctx, bi.Location, bi.RemoteStorage, mappings)
 	if !hasBackup {
 		if err != nil {
 			return src, xerrors.Errorf("no backup found, error: %w", err)
@@ -205,13 +214,68 @@ func (bi *fromBackupInitializer) Run(ctx context.Context, mappings []archive.IDM
 	return csapi.WorkspaceInitFromBackup, nil
 }
 
+// restoreBackup restores the regular backup of a workspace, which is either an incremental backup or a single tar file
+func restoreBackup(ctx context.Context, location string, rs storage.DirectDownloader, mappings []archive.IDMapping) (found bool, err error) {
+	found, err = restoreIncremental(ctx, location, rs, storage.IncrementalBackupManifest, mappings)
+	if found || err != nil {
+		return found, err
+	}
+
+	return rs.Download(ctx, location, storage.DefaultBackup, mappings)
+}
+
+// restoreIncremental rebuilds the workspace content from the chunks of an incremental backup. Returns false
+// if the remote storage cannot open individual objects, or if name does not refer to an incremental backup manifest.
+func restoreIncremental(ctx context.Context, location string, rs storage.DirectDownloader, name string, mappings []archive.IDMapping) (found bool, err error) {
+	opener, ok := rs.(storage.ObjectOpener)
+	if !ok {
+		return false, nil
+	}
+
+	mf, err := storage.ReadIncrementalManifest(ctx, opener, name)
+	if err == storage.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, xerrors.Errorf("cannot read backup manifest: %w", err)
+	}
+	if mf == nil {
+		return false, nil
+	}
+
+	err = storage.DownloadIncremental(ctx, opener, mf, location, mappings)
+	if err != nil {
+		return true, xerrors.Errorf("cannot restore incremental backup: %w", err)
+	}
+	return true, nil
+}
+
 // newGitInitializer creates a Git initializer based on the request.
 // Returns gRPC errors.
-func newGitInitializer(ctx context.Context, loc string, req *csapi.GitInitializer, forceGitpodUser bool) (*GitInitializer, error) {
+func newGitInitializer(ctx context.Context, loc string, req *csapi.GitInitializer, opts NewFromRequestOpts) (*GitInitializer, error) {
 	if req.Config == nil {
 		return nil, status.Error(codes.InvalidArgument, "Git initializer misses config")
 	}
 
+	switch {
+	case req.CloneFilter == "", req.CloneFilter == "blob:none", req.CloneFilter == "tree:0":
+	case strings.HasPrefix(req.CloneFilter, "blob:limit="):
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unsupported clone filter: %s", req.CloneFilter))
+	}
+	for _, dir := range req.SparseCheckout {
+		if dir == "" || strings.HasPrefix(dir, "-") || filepath.IsAbs(dir) || strings.HasPrefix(filepath.Clean(dir), "..") {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid sparse checkout directory: %s", dir))
+		}
+	}
+
+	location := filepath.Join(loc, req.CheckoutLocation)
+	progress := opts.Progress
+	if filepath.Clean(location) == filepath.Clean(loc) {
+		// git clone needs an empty directory, hence we cannot report progress from within the checkout location
+		progress = nil
+	}
+
 	var targetMode CloneTargetMode
 	switch req.TargetMode {
 	case csapi.CloneTargetMode_LOCAL_BRANCH:
@@ -255,13 +319,19 @@ func newGitInitializer(ctx context.Context, loc string, req *csapi.GitInitialize
 	log.WithField("location", loc).Debug("using Git initializer")
 	return &GitInitializer{
 		Client: git.Client{
-			Location:          filepath.Join(loc, req.CheckoutLocation),
+			Location:          location,
 			RemoteURI:         req.RemoteUri,
 			UpstreamRemoteURI: req.Upstream_RemoteUri,
 			Config:            req.Config.CustomConfig,
 			AuthMethod:        authMethod,
 			AuthProvider:      authProvider,
-			RunAsGitpodUser:   forceGitpodUser,
+			RunAsGitpodUser:   opts.ForceGitpodUserForGit,
+			CloneDepth:        int(req.CloneDepth),
+			CloneFilter:       req.CloneFilter,
+			SparseCheckout:    req.SparseCheckout,
+			LFSInclude:        req.LfsInclude,
+			LFSExclude:        req.LfsExclude,
+			Progress:          progress,
 		},
 		TargetMode:  targetMode,
 		CloneTarget: req.CloneTaget,
@@ -426,7 +496,7 @@ func InitializeWorkspace(ctx context.Context, location string, remoteStorage sto
 	}
 
 	// Run the initializer
-	hasBackup, err := remoteStorage.Download(ctx, location, storage.DefaultBackup, cfg.mappings)
+	hasBackup, err := restoreBackup(ctx, location, remoteStorage, cfg.mappings)
 	if err != nil {
 		return src, xerrors.Errorf("cannot restore backup: %w", err)
 	}
@@ -485,6 +555,37 @@ func EnsureCleanDotGitpodDirectory(ctx context.Context, wspath string) error {
 	return nil
 }
 
+// WorkspaceProgressReporter reports the content initialization progress in the workspace progress file,
+// from where supervisor picks it up until the content is ready
+func WorkspaceProgressReporter(wspath string) git.ProgressFunc {
+	fn := filepath.Join(wspath, WorkspaceProgressFile)
+	return func(phase string, percent int) {
+		fc, err := json.Marshal(csapi.WorkspaceInitProgress{
+			Phase:   phase,
+			Percent: percent,
+		})
+		if err != nil {
+			return
+		}
+
+		err = os.MkdirAll(filepath.Dir(fn), 0755)
+		if err != nil {
+			log.WithError(err).Debug("cannot create directory for workspace progress file")
+			return
+		}
+		err = os.WriteFile(fn+".tmp", fc, 0644)
+		if err != nil {
+			log.WithError(err).Debug("cannot write workspace progress file")
+			return
+		}
+		// supervisor must never read a partially written file
+		err = os.Rename(fn+".tmp", fn)
+		if err != nil {
+			log.WithError(err).Debug("cannot rename workspace progress file")
+		}
+	}
+}
+
 // PlaceWorkspaceReadyFile writes a file in the workspace which indicates that the workspace has been initialized
 func PlaceWorkspaceReadyFile(ctx context.Context, wspath string, initsrc csapi.WorkspaceInitSource, uid, gid int) (err error) {
 	//nolint:ineffassign,staticcheck
@@ -510,6 +611,11 @@ func PlaceWorkspaceReadyFile(ctx context.Context, wspath string, initsrc csapi.W
 		return xerrors.Errorf("cannot chown directory for workspace ready file: %w", err)
 	}
 
+	err = os.Remove(filepath.Join(wspath, WorkspaceProgressFile))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return xerrors.Errorf("cannot remove workspace progress file: %w", err)
+	}
+
 	tempWorkspaceReadyFile := WorkspaceReadyFile + ".tmp"
 	fn := filepath.Join(wspath, tempWorkspaceReadyFile)
 	err = os.WriteFile(fn, []byte(fc), 0644)
@@ -0,0 +1,227 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package initializer
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/platforms"
+	"github.com/containerd/containerd/reference/docker"
+	"github.com/containerd/containerd/remotes"
+	dockerremote "github.com/containerd/containerd/remotes/docker"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+// maxOCIManifestSize is the largest manifest or index we're willing to read
+const maxOCIManifestSize = 4 * 1024 * 1024
+
+// OCIArtifactInitializer unpacks the layers of an OCI artifact pulled from a registry.
+// Tar layers are extracted at the target location, all other layers are placed there as file named after their
+// org.opencontainers.image.title annotation.
+type OCIArtifactInitializer struct {
+	// Ref is the normalized reference of the artifact, either by tag or by digest
+	Ref            string
+	TargetLocation string
+	Resolver       remotes.Resolver
+}
+
+// newOCIArtifactInitializer creates an OCI artifact initializer for a request
+func newOCIArtifactInitializer(loc string, req *csapi.OCIArtifactInitializer) (*OCIArtifactInitializer, error) {
+	ref, err := docker.ParseDockerRef(req.Ref)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid artifact reference %s: %w", req.Ref, err)
+	}
+
+	var ropts []dockerremote.RegistryOpt
+	if req.AuthUser != "" {
+		user, pwd := req.AuthUser, req.AuthPassword
+		ropts = append(ropts, dockerremote.WithAuthorizer(dockerremote.NewDockerAuthorizer(
+			dockerremote.WithAuthCreds(func(host string) (string, string, error) {
+				return user, pwd, nil
+			}),
+		)))
+	}
+
+	return &OCIArtifactInitializer{
+		Ref:            ref.String(),
+		TargetLocation: filepath.Join(loc, req.TargetLocation),
+		Resolver: dockerremote.NewResolver(dockerremote.ResolverOptions{
+			Hosts: dockerremote.ConfigureDefaultRegistries(ropts...),
+		}),
+	}, nil
+}
+
+// Run initializes the workspace
+func (oi *OCIArtifactInitializer) Run(ctx context.Context, mappings []archive.IDMapping) (src csapi.WorkspaceInitSource, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "OCIArtifactInitializer.Run")
+	span.SetTag("ref", oi.Ref)
+	defer tracing.FinishSpan(span, &err)
+
+	name, desc, err := oi.Resolver.Resolve(ctx, oi.Ref)
+	if err != nil {
+		return src, xerrors.Errorf("cannot resolve %s: %w", oi.Ref, err)
+	}
+	fetcher, err := oi.Resolver.Fetcher(ctx, name)
+	if err != nil {
+		return src, xerrors.Errorf("cannot fetch %s: %w", oi.Ref, err)
+	}
+
+	manifest, err := fetchOCIManifest(ctx, fetcher, desc)
+	if err != nil {
+		return src, xerrors.Errorf("cannot fetch manifest of %s: %w", oi.Ref, err)
+	}
+	span.LogKV("manifest", manifest.Config.Digest.String(), "layers", len(manifest.Layers))
+
+	err = os.MkdirAll(oi.TargetLocation, 0755)
+	if err != nil {
+		return src, xerrors.Errorf("cannot create target location: %w", err)
+	}
+	for _, layer := range manifest.Layers {
+		err = oi.unpackLayer(ctx, fetcher, layer, mappings)
+		if err != nil {
+			return src, xerrors.Errorf("cannot unpack layer %s of %s: %w", layer.Digest, oi.Ref, err)
+		}
+	}
+
+	return csapi.WorkspaceInitFromOther, nil
+}
+
+func (oi *OCIArtifactInitializer) unpackLayer(ctx context.Context, fetcher remotes.Fetcher, layer ocispec.Descriptor, mappings []archive.IDMapping) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "unpackLayer")
+	span.LogKV("digest", layer.Digest.String(), "mediaType", layer.MediaType, "size", layer.Size)
+	defer tracing.FinishSpan(span, &err)
+
+	rc, err := fetchVerifiedBlob(ctx, fetcher, layer)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	if images.IsLayerType(layer.MediaType) {
+		err = archive.ExtractTarbal(ctx, rc, oi.TargetLocation, archive.WithUIDMapping(mappings), archive.WithGIDMapping(mappings))
+		if err != nil {
+			return err
+		}
+		// the extraction need not read the padding at the end of an archive, but we must reach the end to verify the digest
+		_, err = io.Copy(io.Discard, rc)
+		return err
+	}
+
+	title := layer.Annotations[ocispec.AnnotationTitle]
+	if title == "" {
+		return xerrors.Errorf("layer of media type %s is no tar archive and has no %s annotation", layer.MediaType, ocispec.AnnotationTitle)
+	}
+	// the title must not escape the target location
+	fn := filepath.Join(oi.TargetLocation, filepath.Clean("/"+title))
+	err = os.MkdirAll(filepath.Dir(fn), 0755)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(fn, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, rc)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// fetchOCIManifest fetches the manifest of an artifact. If desc refers to an index, the manifest for the current
+// platform, or the first one without platform, is used.
+func fetchOCIManifest(ctx context.Context, fetcher remotes.Fetcher, desc ocispec.Descriptor) (*ocispec.Manifest, error) {
+	if desc.Size > maxOCIManifestSize {
+		return nil, xerrors.Errorf("manifest is too large: %d bytes", desc.Size)
+	}
+	rc, err := fetchVerifiedBlob(ctx, fetcher, desc)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	buf, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case images.IsManifestType(desc.MediaType):
+		var manifest ocispec.Manifest
+		err = json.Unmarshal(buf, &manifest)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot unmarshal manifest: %w", err)
+		}
+		return &manifest, nil
+	case images.IsIndexType(desc.MediaType):
+		var index ocispec.Index
+		err = json.Unmarshal(buf, &index)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot unmarshal index: %w", err)
+		}
+		matcher := platforms.Default()
+		for _, m := range index.Manifests {
+			if m.Platform != nil && !matcher.Match(*m.Platform) {
+				continue
+			}
+			return fetchOCIManifest(ctx, fetcher, m)
+		}
+		return nil, xerrors.Errorf("index has no manifest for %s", platforms.DefaultString())
+	default:
+		return nil, xerrors.Errorf("unsupported media type %s", desc.MediaType)
+	}
+}
+
+// fetchVerifiedBlob fetches a blob and fails the final read if the content does not match the descriptor
+func fetchVerifiedBlob(ctx context.Context, fetcher remotes.Fetcher, desc ocispec.Descriptor) (io.ReadCloser, error) {
+	err := desc.Digest.Validate()
+	if err != nil {
+		return nil, xerrors.Errorf("invalid digest %s: %w", desc.Digest, err)
+	}
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return nil, err
+	}
+	return &verifyingReader{
+		ReadCloser: rc,
+		desc:       desc,
+		verifier:   desc.Digest.Verifier(),
+	}, nil
+}
+
+type verifyingReader struct {
+	io.ReadCloser
+
+	desc     ocispec.Descriptor
+	verifier digest.Verifier
+	read     int64
+}
+
+func (r *verifyingReader) Read(p []byte) (n int, err error) {
+	n, err = r.ReadCloser.Read(p)
+	r.read += int64(n)
+	_, _ = r.verifier.Write(p[:n])
+
+	if r.read > r.desc.Size {
+		return n, xerrors.Errorf("content of %s exceeds its size of %d bytes", r.desc.Digest, r.desc.Size)
+	}
+	if err == io.EOF && (r.read != r.desc.Size || !r.verifier.Verified()) {
+		return n, xerrors.Errorf("content does not match its digest %s", r.desc.Digest)
+	}
+	return n, err
+}
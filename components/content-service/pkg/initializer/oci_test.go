@@ -0,0 +1,257 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package initializer
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	dockerremote "github.com/containerd/containerd/remotes/docker"
+	"github.com/google/go-cmp/cmp"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+)
+
+// fakeRegistry serves the manifests and blobs of a single repository and requires basic auth
+type fakeRegistry struct {
+	Manifests map[string]ocispec.Descriptor
+	Blobs     map[digest.Digest][]byte
+}
+
+func (reg *fakeRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if user, pwd, ok := r.BasicAuth(); !ok || user != "user" || pwd != "secret" {
+		w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var (
+		desc ocispec.Descriptor
+		ok   bool
+	)
+	if ref := strings.TrimPrefix(r.URL.Path, "/v2/artifacts/manifests/"); ref != r.URL.Path {
+		desc, ok = reg.Manifests[ref]
+		if !ok {
+			desc, ok = reg.Manifests[reg.tagOf(digest.Digest(ref))]
+		}
+	} else if dgst := strings.TrimPrefix(r.URL.Path, "/v2/artifacts/blobs/"); dgst != r.URL.Path {
+		_, ok = reg.Blobs[digest.Digest(dgst)]
+		desc = ocispec.Descriptor{MediaType: "application/octet-stream", Digest: digest.Digest(dgst)}
+	}
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	content := reg.Blobs[desc.Digest]
+	w.Header().Set("Content-Type", desc.MediaType)
+	w.Header().Set("Docker-Content-Digest", desc.Digest.String())
+	w.Header().Set("Content-Length", fmt.Sprint(len(content)))
+	if r.Method == http.MethodHead {
+		return
+	}
+	_, _ = w.Write(content)
+}
+
+func (reg *fakeRegistry) tagOf(dgst digest.Digest) string {
+	for tag, desc := range reg.Manifests {
+		if desc.Digest == dgst {
+			return tag
+		}
+	}
+	return ""
+}
+
+func (reg *fakeRegistry) add(mediaType string, content []byte, annotations map[string]string) ocispec.Descriptor {
+	dgst := digest.FromBytes(content)
+	reg.Blobs[dgst] = content
+	return ocispec.Descriptor{MediaType: mediaType, Digest: dgst, Size: int64(len(content)), Annotations: annotations}
+}
+
+func (reg *fakeRegistry) addJSON(mediaType string, obj interface{}) ocispec.Descriptor {
+	content, _ := json.Marshal(obj)
+	return reg.add(mediaType, content, nil)
+}
+
+func TestOCIArtifactInitializer(t *testing.T) {
+	var tarbuf bytes.Buffer
+	gz := gzip.NewWriter(&tarbuf)
+	tw := tar.NewWriter(gz)
+	_ = tw.WriteHeader(&tar.Header{Name: "cache/", Typeflag: tar.TypeDir, Mode: 0755})
+	_ = tw.WriteHeader(&tar.Header{Name: "cache/deps.txt", Mode: 0644, Size: 4})
+	_, _ = tw.Write([]byte("deps"))
+	_ = tw.Close()
+	_ = gz.Close()
+
+	reg := &fakeRegistry{
+		Manifests: make(map[string]ocispec.Descriptor),
+		Blobs:     make(map[digest.Digest][]byte),
+	}
+	layers := []ocispec.Descriptor{
+		reg.add(ocispec.MediaTypeImageLayerGzip, tarbuf.Bytes(), nil),
+		reg.add("application/vnd.example.dataset", []byte("a,b,c"), map[string]string{ocispec.AnnotationTitle: "../data/set.csv"}),
+	}
+	config := reg.addJSON("application/vnd.example.config.v1+json", map[string]string{})
+	manifest := reg.addJSON(ocispec.MediaTypeImageManifest, ocispec.Manifest{Config: config, Layers: layers})
+	reg.Manifests["v1"] = manifest
+	reg.Manifests["index"] = reg.addJSON(ocispec.MediaTypeImageIndex, ocispec.Index{
+		Manifests: []ocispec.Descriptor{
+			{MediaType: manifest.MediaType, Digest: digest.FromString("other platform"), Size: 10, Platform: &ocispec.Platform{OS: "plan9", Architecture: "mips"}},
+			manifest,
+		},
+	})
+
+	corrupt := reg.add(ocispec.MediaTypeImageLayer, []byte("corrupt"), nil)
+	reg.Blobs[corrupt.Digest] = []byte("tampered")
+	reg.Manifests["corrupt"] = reg.addJSON(ocispec.MediaTypeImageManifest, ocispec.Manifest{Config: config, Layers: []ocispec.Descriptor{corrupt}})
+
+	untitled := reg.add("application/vnd.example.dataset", []byte("a,b,c"), nil)
+	reg.Manifests["untitled"] = reg.addJSON(ocispec.MediaTypeImageManifest, ocispec.Manifest{Config: config, Layers: []ocispec.Descriptor{untitled}})
+
+	srv := httptest.NewServer(reg)
+	defer srv.Close()
+	host := strings.TrimPrefix(srv.URL, "http://")
+
+	tests := []struct {
+		Name        string
+		Ref         string
+		User        string
+		ExpectErr   bool
+		Expectation map[string]string
+	}{
+		{
+			Name: "by tag",
+			Ref:  "v1",
+			User: "user",
+			Expectation: map[string]string{
+				"cache/deps.txt": "deps",
+				"data/set.csv":   "a,b,c",
+			},
+		},
+		{
+			Name: "by digest",
+			Ref:  manifest.Digest.String(),
+			User: "user",
+			Expectation: map[string]string{
+				"cache/deps.txt": "deps",
+				"data/set.csv":   "a,b,c",
+			},
+		},
+		{
+			Name: "index",
+			Ref:  "index",
+			User: "user",
+			Expectation: map[string]string{
+				"cache/deps.txt": "deps",
+				"data/set.csv":   "a,b,c",
+			},
+		},
+		{Name: "digest mismatch", Ref: "corrupt", User: "user", ExpectErr: true},
+		{Name: "untitled file", Ref: "untitled", User: "user", ExpectErr: true},
+		{Name: "unauthorized", Ref: "v1", User: "someone", ExpectErr: true},
+		{Name: "not found", Ref: "v2", User: "user", ExpectErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ref := host + "/artifacts:" + test.Ref
+			if strings.HasPrefix(test.Ref, "sha256:") {
+				ref = host + "/artifacts@" + test.Ref
+			}
+			user := test.User
+			loc := t.TempDir()
+			init := &OCIArtifactInitializer{
+				Ref:            ref,
+				TargetLocation: filepath.Join(loc, "artifact"),
+				Resolver: dockerremote.NewResolver(dockerremote.ResolverOptions{
+					Hosts: dockerremote.ConfigureDefaultRegistries(
+						dockerremote.WithPlainHTTP(dockerremote.MatchAllHosts),
+						dockerremote.WithAuthorizer(dockerremote.NewDockerAuthorizer(dockerremote.WithAuthCreds(func(string) (string, string, error) {
+							return user, "secret", nil
+						}))),
+					),
+				}),
+			}
+
+			src, err := init.Run(context.Background(), nil)
+			if test.ExpectErr {
+				if err == nil {
+					t.Fatal("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if src != csapi.WorkspaceInitFromOther {
+				t.Errorf("unexpected init source: %s", src)
+			}
+
+			act := make(map[string]string)
+			_ = filepath.Walk(init.TargetLocation, func(path string, info os.FileInfo, err error) error {
+				if err != nil || info.IsDir() {
+					return err
+				}
+				content, err := os.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				rel, _ := filepath.Rel(init.TargetLocation, path)
+				act[rel] = string(content)
+				return nil
+			})
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected content (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewOCIArtifactInitializer(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Ref         string
+		Expectation string
+		ExpectErr   bool
+	}{
+		{Name: "fully qualified", Ref: "registry.example.com/datasets/foo:v1", Expectation: "registry.example.com/datasets/foo:v1"},
+		{Name: "docker hub", Ref: "foo", Expectation: "docker.io/library/foo:latest"},
+		{Name: "by digest", Ref: "registry.example.com/foo@" + digest.FromString("foo").String(), Expectation: "registry.example.com/foo@" + digest.FromString("foo").String()},
+		{Name: "invalid", Ref: "Not A Reference", ExpectErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			init, err := newOCIArtifactInitializer("/workspace", &csapi.OCIArtifactInitializer{Ref: test.Ref, TargetLocation: "data"})
+			if test.ExpectErr {
+				if err == nil {
+					t.Fatal("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if init.Ref != test.Expectation {
+				t.Errorf("unexpected ref: expected %s, got %s", test.Expectation, init.Ref)
+			}
+			if init.TargetLocation != "/workspace/data" {
+				t.Errorf("unexpected target location: %s", init.TargetLocation)
+			}
+		})
+	}
+}
@@ -157,6 +157,11 @@ func runGitInit(ctx context.Context, gInit *GitInitializer) (commitChanged bool,
 			log.WithError(err).Warn("error while updating submodules from prebuild initializer - continuing")
 		}
 
+		err = gInit.FetchLFS(ctx)
+		if err != nil {
+			log.WithError(err).Warn("error while fetching Git LFS objects from prebuild initializer - continuing")
+		}
+
 		// If any of these cleanup operations fail that's no reason to fail ws initialization.
 		// It just results in a slightly degraded state.
 		if didStash {
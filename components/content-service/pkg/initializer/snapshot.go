@@ -39,7 +39,10 @@ func (s *SnapshotInitializer) Run(ctx context.Context, mappings []archive.IDMapp
 		return src, nil
 	}
 
-	ok, err := s.Storage.DownloadSnapshot(ctx, s.Location, s.Snapshot, mappings)
+	ok, err := restoreIncremental(ctx, s.Location, s.Storage, s.Snapshot, mappings)
+	if !ok && err == nil {
+		ok, err = s.Storage.DownloadSnapshot(ctx, s.Location, s.Snapshot, mappings)
+	}
 	if err != nil {
 		return src, xerrors.Errorf("snapshot initializer: %w", err)
 	}
@@ -522,7 +522,10 @@ git config --global --add safe.directory ${GITPOD_REPO_ROOT}
 git status --porcelain=v2 --branch -uall > /.workspace/prestophookdata/git_status.txt
 git log --pretty='%h: %s' --branches --not --remotes > /.workspace/prestophookdata/git_log_1.txt
 git log --pretty=%H -n 1 > /.workspace/prestophookdata/git_log_2.txt
+git lfs ls-files --long > /.workspace/prestophookdata/git_lfs_1.txt 2>/dev/null
+git lfs ls-files --long $(git rev-parse -q --verify @{upstream} || git rev-parse -q --verify origin/HEAD) HEAD > /.workspace/prestophookdata/git_lfs_2.txt 2>/dev/null
 cp /workspace/.gitpod/prebuild-log* /.workspace/prestophookdata/ | true
+cp /workspace/.gitpod/terminal-recording-* /.workspace/prestophookdata/ | true
 `
 
 var pvcEnabledFile = `PVC`
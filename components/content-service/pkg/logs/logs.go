@@ -23,11 +23,17 @@ const (
 
 	prebuildLogFilePrefix = "prebuild-log-"
 
+	terminalRecordingFilePrefix = "terminal-recording-"
+	terminalRecordingFileSuffix = ".cast"
+
 	legacyTerminalStoreLocation = "/workspace"
 	legacyPrebuildLogFilePrefix = ".prebuild-log-"
 
 	// UploadedHeadlessLogPathPrefix is the prefix under which headless logs are stored inside an instance
 	UploadedHeadlessLogPathPrefix = "logs"
+
+	// UploadedTerminalRecordingPathPrefix is the prefix under which terminal recordings are stored inside an instance
+	UploadedTerminalRecordingPathPrefix = "recordings"
 )
 
 // UploadedHeadlessLogPath returns the path relative to the workspace instance
@@ -35,6 +41,17 @@ func UploadedHeadlessLogPath(taskID string) string {
 	return fmt.Sprintf("%s/%s", UploadedHeadlessLogPathPrefix, taskID)
 }
 
+// UploadedTerminalRecordingPath returns the path of a terminal recording file relative to the workspace instance
+func UploadedTerminalRecordingPath(fileName string) string {
+	return fmt.Sprintf("%s/%s", UploadedTerminalRecordingPathPrefix, fileName)
+}
+
+// TerminalRecordingFileName is the absolute path to the file containing the asciicast recording of a terminal.
+// Once a recording exceeds its size limit, older parts are rotated to <file name>.1, <file name>.2 and so on.
+func TerminalRecordingFileName(storeLocation string, name string) string {
+	return storeLocation + "/" + terminalRecordingFilePrefix + name + terminalRecordingFileSuffix
+}
+
 // PrebuildLogFileName is the absolute path to the file containing the output of the prebuild log for the given task in recent workspaces
 func PrebuildLogFileName(storeLocation string, taskId string) string {
 	return storeLocation + "/" + prebuildLogFilePrefix + taskId
@@ -84,6 +101,27 @@ func ListPrebuildLogFiles(ctx context.Context, location string) (filePaths []str
 	return filePaths, nil
 }
 
+// ListTerminalRecordingFiles lists all terminal recording files, including rotated ones, in the workspace.
+// Location is assumed to be the base dir of the workspace session.
+func ListTerminalRecordingFiles(ctx context.Context, location string) (filePaths []string, err error) {
+	for _, dir := range []string{location, filepath.Join(location, strings.TrimPrefix(TerminalStoreLocation, "/workspace"))} {
+		files, err := os.ReadDir(dir)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		for _, file := range files {
+			if file.IsDir() || !strings.HasPrefix(file.Name(), terminalRecordingFilePrefix) {
+				continue
+			}
+			filePaths = append(filePaths, filepath.Join(dir, file.Name()))
+		}
+		if len(filePaths) > 0 {
+			break
+		}
+	}
+	return filePaths, nil
+}
+
 // ParseTaskIDFromPrebuildLogFilePath tries to parse the streamID from the given file name path
 func ParseTaskIDFromPrebuildLogFilePath(filePath string) (string, error) {
 	fileName := filepath.Base(filePath)
@@ -0,0 +1,47 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package retention
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type metrics struct {
+	DeletedObjects *prometheus.CounterVec
+	DeletedBytes   *prometheus.CounterVec
+	Runs           *prometheus.CounterVec
+}
+
+func newMetrics(reg prometheus.Registerer) (*metrics, error) {
+	m := &metrics{
+		DeletedObjects: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "retention_deleted_objects_total",
+			Help: "total count of objects deleted by the retention job, by kind. Dry runs count what they would have deleted.",
+		}, []string{"kind", "dry_run"}),
+		DeletedBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "retention_deleted_bytes_total",
+			Help: "total size of objects deleted by the retention job, by kind. Dry runs count what they would have deleted.",
+		}, []string{"kind", "dry_run"}),
+		Runs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "retention_runs_total",
+			Help: "total count of retention job runs, by outcome",
+		}, []string{"outcome"}),
+	}
+	for _, c := range []prometheus.Collector{m.DeletedObjects, m.DeletedBytes, m.Runs} {
+		err := reg.Register(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func (m *metrics) deleted(kind string, dryRun bool, count int, size int64) {
+	lbl := []string{kind, strconv.FormatBool(dryRun)}
+	m.DeletedObjects.WithLabelValues(lbl...).Add(float64(count))
+	m.DeletedBytes.WithLabelValues(lbl...).Add(float64(size))
+}
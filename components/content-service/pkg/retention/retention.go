@@ -0,0 +1,557 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+// Package retention prunes workspace content from remote storage according to per-stage and per-owner policies
+package retention
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/logs"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+const (
+	// KindBackup are full workspace backups
+	KindBackup = "backup"
+	// KindSnapshot are workspace snapshots, both tar files and manifests
+	KindSnapshot = "snapshot"
+	// KindHeadlessLog are the logs of headless workspaces
+	KindHeadlessLog = "headless-log"
+	// KindChunk are incremental backup chunks which are no longer referenced after pruning
+	KindChunk = "chunk"
+
+	// chunkGCGracePeriod protects the chunks of backups whose manifest hasn't been uploaded yet
+	chunkGCGracePeriod = 24 * time.Hour
+
+	// maxManifestSize is the maximum size of a content manifest we're willing to read
+	maxManifestSize = 64 * 1024 * 1024
+)
+
+var (
+	fullWorkspaceBackupName = regexp.MustCompile(`^wsfull-(\d+)\.tar$`)
+	snapshotName            = regexp.MustCompile(`^(snapshot-\d+)\.(tar|mf\.json)$`)
+)
+
+// Deletion is an object the retention policy of its owner deletes
+type Deletion struct {
+	Kind      string
+	Workspace string
+	Object    string
+	Size      int64
+}
+
+// Job applies the retention policies to the content of all owners in the remote storage
+type Job struct {
+	Config  config.RetentionConfig
+	Stage   config.Stage
+	Storage storage.PresignedAccess
+	Keys    storage.KeyProvider
+
+	metrics *metrics
+	now     func() time.Time
+}
+
+// NewJob creates a new retention job. The key provider is needed to read encrypted manifests
+// and may be nil if encryption is disabled.
+func NewJob(cfg config.RetentionConfig, stage config.Stage, s storage.PresignedAccess, keys storage.KeyProvider, reg prometheus.Registerer) (*Job, error) {
+	err := ValidateConfig(&cfg)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid retention config: %w", err)
+	}
+	m, err := newMetrics(reg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Job{
+		Config:  cfg,
+		Stage:   stage,
+		Storage: s,
+		Keys:    keys,
+		metrics: m,
+		now:     time.Now,
+	}, nil
+}
+
+// ValidateConfig checks if the retention config is valid
+func ValidateConfig(cfg *config.RetentionConfig) error {
+	if cfg.Interval <= 0 {
+		return xerrors.Errorf("interval must be positive")
+	}
+	for stage, p := range cfg.Policies {
+		err := validatePolicy(&p)
+		if err != nil {
+			return xerrors.Errorf("policy of stage %s: %w", stage, err)
+		}
+	}
+	for owner, p := range cfg.Owners {
+		err := validatePolicy(&p)
+		if err != nil {
+			return xerrors.Errorf("policy of owner %s: %w", owner, err)
+		}
+	}
+	return nil
+}
+
+func validatePolicy(p *config.RetentionPolicy) error {
+	if p.KeepBackups < 0 || p.SnapshotMaxAge < 0 || p.HeadlessLogMaxAge < 0 || p.MaxBytes < 0 {
+		return xerrors.Errorf("negative values are not allowed")
+	}
+	return nil
+}
+
+// Start applies the retention policies once per interval until the context is canceled
+func (j *Job) Start(ctx context.Context) {
+	t := time.NewTicker(time.Duration(j.Config.Interval))
+	defer t.Stop()
+
+	for {
+		err := j.Run(ctx)
+		if err != nil {
+			log.WithError(err).Error("cannot apply retention policies")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Policy returns the retention policy of an owner. Owners without policy are left alone.
+func (j *Job) Policy(owner string) (policy config.RetentionPolicy, ok bool) {
+	policy, ok = j.Config.Owners[owner]
+	if ok {
+		return
+	}
+	policy, ok = j.Config.Policies[j.Stage]
+	return
+}
+
+// Run applies the retention policies to all owners once
+func (j *Job) Run(ctx context.Context) (err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "retention.Run")
+	span.SetTag("dryRun", j.Config.DryRun)
+	defer tracing.FinishSpan(span, &err)
+	defer func() {
+		outcome := "success"
+		if err != nil {
+			outcome = "failure"
+		}
+		j.metrics.Runs.WithLabelValues(outcome).Inc()
+	}()
+
+	owners, err := j.Storage.ListOwners(ctx)
+	if err != nil {
+		return xerrors.Errorf("cannot list owners: %w", err)
+	}
+
+	var failed int
+	for _, owner := range owners {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		policy, ok := j.Policy(owner)
+		if !ok {
+			continue
+		}
+		err := j.prune(ctx, owner, policy)
+		if err != nil {
+			// one owner's broken content must not keep us from pruning the others
+			log.WithFields(log.OWI(owner, "", "")).WithError(err).Error("cannot apply retention policy")
+			failed++
+		}
+	}
+	if failed > 0 {
+		return xerrors.Errorf("cannot apply retention policy to %d of %d owners", failed, len(owners))
+	}
+	return nil
+}
+
+func (j *Job) prune(ctx context.Context, owner string, policy config.RetentionPolicy) (err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "retention.prune")
+	span.SetTag("owner", owner)
+	defer tracing.FinishSpan(span, &err)
+
+	dels, err := j.Plan(ctx, owner, policy)
+	if err != nil {
+		return err
+	}
+
+	var (
+		dryRun     = j.Config.DryRun
+		bkt        = j.Storage.Bucket(owner)
+		workspaces = make(map[string]struct{})
+		freed      int64
+	)
+	for _, d := range dels {
+		log.WithFields(log.OWI(owner, d.Workspace, "")).WithField("object", d.Object).WithField("kind", d.Kind).WithField("dryRun", dryRun).Debug("retention policy deletes object")
+		if !dryRun {
+			err = j.Storage.DeleteObject(ctx, bkt, &storage.DeleteObjectQuery{Name: d.Object})
+			if err != nil && !errors.Is(err, storage.ErrNotFound) {
+				return xerrors.Errorf("cannot delete %s: %w", d.Object, err)
+			}
+		}
+		j.metrics.deleted(d.Kind, dryRun, 1, d.Size)
+		workspaces[d.Workspace] = struct{}{}
+		freed += d.Size
+	}
+	span.SetTag("deletedObjects", len(dels))
+
+	if !dryRun {
+		// the snapshots we deleted might have been the last to reference some incremental backup chunks
+		for ws := range workspaces {
+			res, err := storage.CollectChunkGarbage(ctx, j.Storage, j.Keys, owner, ws, chunkGCGracePeriod)
+			if err != nil {
+				return xerrors.Errorf("cannot collect chunk garbage of %s: %w", ws, err)
+			}
+			j.metrics.deleted(KindChunk, dryRun, res.DeletedChunks, res.FreedBytes)
+			freed += res.FreedBytes
+		}
+	}
+
+	if len(dels) > 0 {
+		log.WithFields(log.OWI(owner, "", "")).WithField("objects", len(dels)).WithField("bytes", freed).WithField("dryRun", dryRun).Info("applied retention policy")
+	}
+	return nil
+}
+
+// unit is content which is deleted as a whole, e.g. a snapshot manifest and its layer
+type unit struct {
+	Kind      string
+	Workspace string
+	Objects   []storage.ObjectInfo
+
+	prebuild *bool
+}
+
+func (u *unit) LastModified() (res time.Time) {
+	for _, o := range u.Objects {
+		if o.LastModified.After(res) {
+			res = o.LastModified
+		}
+	}
+	return
+}
+
+func (u *unit) Size() (res int64) {
+	for _, o := range u.Objects {
+		res += o.Size
+	}
+	return
+}
+
+// planner keeps track of the objects a policy deletes, and of the manifests referencing objects
+type planner struct {
+	s      storage.PresignedAccess
+	bucket string
+
+	// refs maps objects to the manifests which reference them
+	refs    map[string][]string
+	deleted map[string]bool
+	plan    []Deletion
+}
+
+// Plan determines the objects of an owner which the policy deletes, without deleting anything.
+//
+// The latest full workspace backup of a workspace, the default backups and the incremental backup manifest are never
+// deleted. Neither are snapshots of prebuilds, nor content referenced by the manifest of content we keep. Because full
+// workspace backups reference all backups they build on, KeepBackups mostly prunes backups which were never referenced.
+// References across owners, e.g. of workspaces started from another owner's snapshot, are not taken into account.
+func (j *Job) Plan(ctx context.Context, owner string, policy config.RetentionPolicy) (res []Deletion, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "retention.Plan")
+	span.SetTag("owner", owner)
+	defer tracing.FinishSpan(span, &err)
+
+	var (
+		bkt    = j.Storage.Bucket(owner)
+		prefix = strings.TrimRight(j.Storage.BackupObject(owner, "", ""), "/") + "/"
+		now    = j.now()
+	)
+	objs, err := j.Storage.ListObjects(ctx, bkt, prefix)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list objects: %w", err)
+	}
+
+	p := &planner{
+		s:       j.Storage,
+		bucket:  bkt,
+		refs:    make(map[string][]string),
+		deleted: make(map[string]bool),
+	}
+	var (
+		snapshots = make(map[string]*unit)
+		backups   = make(map[string][]backup)
+		headless  []*unit
+	)
+	for _, obj := range objs {
+		ws, name, ok := strings.Cut(strings.TrimPrefix(obj.Name, prefix), "/")
+		if !ok || ws == "" {
+			continue
+		}
+
+		if strings.HasSuffix(name, ".json") {
+			// We must not delete content we don't know the references of, hence any error here is fatal.
+			mf, err := readManifest(ctx, j.Storage, j.Keys, bkt, obj.Name)
+			if err != nil {
+				return nil, xerrors.Errorf("cannot read manifest %s: %w", obj.Name, err)
+			}
+			if mf != nil {
+				for _, l := range mf.Layers {
+					if l.Bucket == bkt {
+						p.refs[l.Object] = append(p.refs[l.Object], obj.Name)
+					}
+				}
+			}
+		}
+
+		if m := fullWorkspaceBackupName.FindStringSubmatch(name); m != nil {
+			gen, _ := strconv.ParseInt(m[1], 10, 64)
+			backups[ws] = append(backups[ws], backup{Generation: gen, Object: obj})
+		} else if m := snapshotName.FindStringSubmatch(name); m != nil {
+			key := ws + "/" + m[1]
+			u, ok := snapshots[key]
+			if !ok {
+				u = &unit{Kind: KindSnapshot, Workspace: ws}
+				snapshots[key] = u
+			}
+			if m[2] == "tar" {
+				u.Objects = append(u.Objects, obj)
+			} else {
+				// the manifest goes first so that we never leave a manifest behind whose layer is gone
+				u.Objects = append([]storage.ObjectInfo{obj}, u.Objects...)
+			}
+		} else if isHeadlessLog(name) {
+			headless = append(headless, &unit{Kind: KindHeadlessLog, Workspace: ws, Objects: []storage.ObjectInfo{obj}})
+		}
+	}
+
+	// Snapshots go first as their manifests might be the last ones referencing older backups.
+	var candidates []*unit
+	for _, u := range sortedUnits(snapshots) {
+		if policy.SnapshotMaxAge > 0 && now.Sub(u.LastModified()) > time.Duration(policy.SnapshotMaxAge) {
+			ok, err := p.Delete(ctx, u)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				continue
+			}
+		}
+		candidates = append(candidates, u)
+	}
+	for _, u := range headless {
+		if policy.HeadlessLogMaxAge > 0 && now.Sub(u.LastModified()) > time.Duration(policy.HeadlessLogMaxAge) {
+			ok, err := p.Delete(ctx, u)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				continue
+			}
+		}
+		candidates = append(candidates, u)
+	}
+	for _, ws := range sortedKeys(backups) {
+		bs := backups[ws]
+		sort.Slice(bs, func(i, j int) bool { return bs[i].Generation > bs[j].Generation })
+		// the latest backup is never deleted
+		for i, b := range bs[1:] {
+			u := &unit{Kind: KindBackup, Workspace: ws, Objects: []storage.ObjectInfo{b.Object}}
+			if policy.KeepBackups > 0 && i+1 >= policy.KeepBackups {
+				ok, err := p.Delete(ctx, u)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					continue
+				}
+			}
+			candidates = append(candidates, u)
+		}
+	}
+
+	if policy.MaxBytes > 0 {
+		usage, err := j.Storage.DiskUsage(ctx, bkt, prefix)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot determine disk usage: %w", err)
+		}
+		for _, d := range p.plan {
+			usage -= d.Size
+		}
+		span.SetTag("usage", usage)
+
+		// Deleting content can release the references to other content, hence we keep going as long as we make progress.
+		sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].LastModified().Before(candidates[j].LastModified()) })
+		for progress := true; progress && usage > policy.MaxBytes; {
+			progress = false
+			for _, u := range candidates {
+				if usage <= policy.MaxBytes {
+					break
+				}
+				if p.deleted[u.Objects[0].Name] {
+					continue
+				}
+				ok, err := p.Delete(ctx, u)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					usage -= u.Size()
+					progress = true
+				}
+			}
+		}
+	}
+
+	return p.plan, nil
+}
+
+type backup struct {
+	Generation int64
+	Object     storage.ObjectInfo
+}
+
+// Delete adds the unit to the plan, unless it's a prebuild snapshot or content we keep references it
+func (p *planner) Delete(ctx context.Context, u *unit) (ok bool, err error) {
+	if u.Kind == KindSnapshot {
+		prebuild, err := p.isPrebuild(ctx, u)
+		if err != nil {
+			return false, err
+		}
+		if prebuild {
+			return false, nil
+		}
+	}
+
+	for _, o := range u.Objects {
+		p.deleted[o.Name] = true
+	}
+	for _, o := range u.Objects {
+		if p.referenced(o.Name) {
+			for _, o := range u.Objects {
+				delete(p.deleted, o.Name)
+			}
+			return false, nil
+		}
+	}
+
+	for _, o := range u.Objects {
+		p.plan = append(p.plan, Deletion{
+			Kind:      u.Kind,
+			Workspace: u.Workspace,
+			Object:    o.Name,
+			Size:      o.Size,
+		})
+	}
+	return true, nil
+}
+
+// referenced returns true if a manifest we keep references the object
+func (p *planner) referenced(obj string) bool {
+	for _, mf := range p.refs[obj] {
+		if !p.deleted[mf] {
+			return true
+		}
+	}
+	return false
+}
+
+// isPrebuild returns true if the unit is a prebuild snapshot. Snapshots which are not annotated might be prebuild
+// snapshots, too, which we cannot tell without knowing the prebuilds - hence we treat them as such.
+func (p *planner) isPrebuild(ctx context.Context, u *unit) (bool, error) {
+	if u.prebuild != nil {
+		return *u.prebuild, nil
+	}
+
+	var prebuild bool
+	for _, o := range u.Objects {
+		info, err := p.s.SignDownload(ctx, p.bucket, o.Name, &storage.SignedURLOptions{})
+		if errors.Is(err, storage.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return false, xerrors.Errorf("cannot read metadata of %s: %w", o.Name, err)
+		}
+		if info.Meta.Prebuild == nil || *info.Meta.Prebuild {
+			prebuild = true
+			break
+		}
+	}
+	u.prebuild = &prebuild
+	return prebuild, nil
+}
+
+// isHeadlessLog returns true if the name (relative to the workspace) is that of a headless log, i.e. instances/<instanceID>/logs/<taskID>,
+// or of a terminal recording uploaded along with them, i.e. instances/<instanceID>/recordings/<file name>
+func isHeadlessLog(name string) bool {
+	segs := strings.SplitN(name, "/", 4)
+	return len(segs) == 4 && segs[0] == "instances" && (segs[2] == logs.UploadedHeadlessLogPathPrefix || segs[2] == logs.UploadedTerminalRecordingPathPrefix)
+}
+
+// readManifest reads a workspace content manifest. Returns nil if the object isn't a manifest.
+func readManifest(ctx context.Context, s storage.PresignedAccess, keys storage.KeyProvider, bkt, obj string) (*csapi.WorkspaceContentManifest, error) {
+	info, err := s.SignDownload(ctx, bkt, obj, &storage.SignedURLOptions{})
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if info.Meta.ContentType != csapi.ContentTypeManifest {
+		return nil, nil
+	}
+
+	rc, err := storage.OpenSignedObject(ctx, keys, info)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var mf csapi.WorkspaceContentManifest
+	err = json.NewDecoder(io.LimitReader(rc, maxManifestSize)).Decode(&mf)
+	if err != nil {
+		return nil, err
+	}
+	return &mf, nil
+}
+
+func sortedUnits(units map[string]*unit) []*unit {
+	res := make([]*unit, 0, len(units))
+	for _, k := range sortedKeys(units) {
+		res = append(res, units[k])
+	}
+	return res
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	res := make([]string, 0, len(m))
+	for k := range m {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res
+}
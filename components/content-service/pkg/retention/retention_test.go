@@ -0,0 +1,401 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package retention
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+const day = 24 * time.Hour
+
+type testStorage struct {
+	T       *testing.T
+	Config  config.StorageConfig
+	Storage storage.PresignedAccess
+	now     time.Time
+}
+
+func newTestStorage(t *testing.T) *testStorage {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "handler not set up", http.StatusInternalServerError)
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := config.StorageConfig{
+		Stage: config.StageDevStaging,
+		Kind:  config.FileSystemStorage,
+		FileSystemConfig: config.FileSystemConfig{
+			Root:       t.TempDir(),
+			BaseURL:    srv.URL + "/storage",
+			SigningKey: "not-so-secret",
+		},
+	}
+	handler, err := storage.NewFileSystemURLHandler(cfg.FileSystemConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.Config.Handler = handler
+
+	s, err := storage.NewPresignedAccess(&cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testStorage{T: t, Config: cfg, Storage: s, now: time.Now()}
+}
+
+// Put uploads an object to the workspace and makes it look age old
+func (ts *testStorage) Put(owner, workspace, name string, content []byte, age time.Duration, opts ...storage.UploadOption) {
+	t := ts.T
+	ctx := context.Background()
+	rs, err := storage.NewDirectAccess(&ts.Config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rs.Init(ctx, owner, workspace, "instance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rs.EnsureExists(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fn := filepath.Join(t.TempDir(), "content")
+	err = os.WriteFile(fn, content, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bkt, obj, err := rs.Upload(ctx, fn, name, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mtime := ts.now.Add(-age)
+	err = os.Chtimes(filepath.Join(ts.Config.FileSystemConfig.Root, bkt, filepath.FromSlash(obj)), mtime, mtime)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (ts *testStorage) PutManifest(owner, workspace, name string, age time.Duration, layers []string, opts ...storage.UploadOption) {
+	mf := csapi.WorkspaceContentManifest{Type: csapi.TypeFullWorkspaceContentV1}
+	for _, l := range layers {
+		mf.Layers = append(mf.Layers, csapi.WorkspaceContentLayer{
+			Bucket: ts.Storage.Bucket(owner),
+			Object: ts.Storage.BackupObject(owner, workspace, l),
+		})
+	}
+	fc, err := json.Marshal(mf)
+	if err != nil {
+		ts.T.Fatal(err)
+	}
+	ts.Put(owner, workspace, name, fc, age, append(opts, storage.WithContentType(csapi.ContentTypeManifest))...)
+}
+
+func (ts *testStorage) Objects(owner string) []string {
+	objs, err := ts.Storage.ListObjects(context.Background(), ts.Storage.Bucket(owner), "")
+	if err != nil {
+		ts.T.Fatal(err)
+	}
+	res := make([]string, 0, len(objs))
+	for _, o := range objs {
+		res = append(res, o.Name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+var content = []byte(strings.Repeat("a", 100))
+
+// populate produces the content of an owner with a regular and a full workspace backup workspace
+func populate(ts *testStorage, owner string) {
+	prebuild := storage.WithAnnotations(map[string]string{storage.ObjectAnnotationPrebuild: "true"})
+	regular := storage.WithAnnotations(map[string]string{storage.ObjectAnnotationPrebuild: "false"})
+
+	ts.Put(owner, "regular", storage.DefaultBackup, content, 60*day)
+	ts.Put(owner, "regular", "wsfull-1.tar", content, 55*day)
+	ts.Put(owner, "regular", "wsfull-2.tar", content, 50*day)
+	ts.Put(owner, "regular", "wsfull-3.tar", content, 5*day)
+	ts.Put(owner, "regular", "snapshot-1.tar", content, 41*day, regular)
+	ts.Put(owner, "regular", "snapshot-2.tar", content, 45*day, prebuild)
+	ts.Put(owner, "regular", "snapshot-3.tar", content, 1*day, regular)
+	ts.Put(owner, "regular", "instances/old/logs/task", content, 40*day)
+	ts.Put(owner, "regular", "instances/new/logs/task", content, 1*day)
+	ts.Put(owner, "regular", "instances/old/something-else", content, 40*day)
+
+	ts.Put(owner, "fwb", "wsfull-10.tar", content, 20*day)
+	ts.Put(owner, "fwb", "wsfull-11.tar", content, 10*day)
+	ts.Put(owner, "fwb", "wsfull-12.tar", content, 8*day)
+	ts.PutManifest(owner, "fwb", storage.DefaultBackupManifest, 8*day, []string{"wsfull-10.tar", "wsfull-11.tar", "wsfull-12.tar"})
+	ts.Put(owner, "fwb", "wsfull-9.tar", content, 30*day)
+	ts.Put(owner, "fwb", "snapshot-5.tar", content, 35*day, regular)
+	ts.PutManifest(owner, "fwb", "snapshot-5.mf.json", 35*day, []string{"wsfull-9.tar", "snapshot-5.tar"}, regular)
+}
+
+func newTestJob(t *testing.T, ts *testStorage, cfg config.RetentionConfig) *Job {
+	if cfg.Interval == 0 {
+		cfg.Interval = util.Duration(time.Hour)
+	}
+	job, err := NewJob(cfg, config.StageDevStaging, ts.Storage, nil, prometheus.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	job.now = func() time.Time { return ts.now }
+	return job
+}
+
+func TestPlan(t *testing.T) {
+	ts := newTestStorage(t)
+	populate(ts, "owner")
+	usage, err := ts.Storage.DiskUsage(context.Background(), ts.Storage.Bucket("owner"), "workspaces/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Name        string
+		Policy      config.RetentionPolicy
+		Expectation []string
+	}{
+		{
+			Name: "empty policy",
+		},
+		{
+			Name:   "keep backups",
+			Policy: config.RetentionPolicy{KeepBackups: 2},
+			// wsfull-9 is kept because a snapshot references it, and fwb's other backups because its manifest does
+			Expectation: []string{"backup workspaces/regular/wsfull-1.tar"},
+		},
+		{
+			Name:   "keep latest backup only",
+			Policy: config.RetentionPolicy{KeepBackups: 1},
+			Expectation: []string{
+				"backup workspaces/regular/wsfull-2.tar",
+				"backup workspaces/regular/wsfull-1.tar",
+			},
+		},
+		{
+			Name:   "snapshot age",
+			Policy: config.RetentionPolicy{SnapshotMaxAge: util.Duration(30 * day)},
+			Expectation: []string{
+				"snapshot workspaces/fwb/snapshot-5.mf.json",
+				"snapshot workspaces/fwb/snapshot-5.tar",
+				"snapshot workspaces/regular/snapshot-1.tar",
+			},
+		},
+		{
+			Name:   "snapshot age releases backups",
+			Policy: config.RetentionPolicy{SnapshotMaxAge: util.Duration(30 * day), KeepBackups: 1},
+			Expectation: []string{
+				"snapshot workspaces/fwb/snapshot-5.mf.json",
+				"snapshot workspaces/fwb/snapshot-5.tar",
+				"snapshot workspaces/regular/snapshot-1.tar",
+				"backup workspaces/fwb/wsfull-9.tar",
+				"backup workspaces/regular/wsfull-2.tar",
+				"backup workspaces/regular/wsfull-1.tar",
+			},
+		},
+		{
+			Name:        "headless log age",
+			Policy:      config.RetentionPolicy{HeadlessLogMaxAge: util.Duration(30 * day)},
+			Expectation: []string{"headless-log workspaces/regular/instances/old/logs/task"},
+		},
+		{
+			Name:   "max bytes deletes oldest first",
+			Policy: config.RetentionPolicy{MaxBytes: usage - 150},
+			Expectation: []string{
+				"backup workspaces/regular/wsfull-1.tar",
+				"backup workspaces/regular/wsfull-2.tar",
+			},
+		},
+		{
+			Name:   "max bytes skips prebuilds and referenced content",
+			Policy: config.RetentionPolicy{MaxBytes: usage - 350},
+			Expectation: []string{
+				"backup workspaces/regular/wsfull-1.tar",
+				"backup workspaces/regular/wsfull-2.tar",
+				"snapshot workspaces/regular/snapshot-1.tar",
+				"headless-log workspaces/regular/instances/old/logs/task",
+			},
+		},
+		{
+			Name:   "max bytes below the protected content",
+			Policy: config.RetentionPolicy{MaxBytes: 1},
+			Expectation: []string{
+				"backup workspaces/regular/wsfull-1.tar",
+				"backup workspaces/regular/wsfull-2.tar",
+				"snapshot workspaces/regular/snapshot-1.tar",
+				"headless-log workspaces/regular/instances/old/logs/task",
+				"snapshot workspaces/fwb/snapshot-5.mf.json",
+				"snapshot workspaces/fwb/snapshot-5.tar",
+				"backup workspaces/fwb/wsfull-9.tar",
+				"snapshot workspaces/regular/snapshot-3.tar",
+				"headless-log workspaces/regular/instances/new/logs/task",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			job := newTestJob(t, ts, config.RetentionConfig{})
+			dels, err := job.Plan(context.Background(), "owner", test.Policy)
+			if err != nil {
+				t.Fatalf("cannot plan: %v", err)
+			}
+
+			var act []string
+			for _, d := range dels {
+				act = append(act, d.Kind+" "+d.Object)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected deletions (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanUnannotatedSnapshot(t *testing.T) {
+	ts := newTestStorage(t)
+	// snapshots uploaded before they were annotated might be prebuild snapshots
+	ts.Put("owner", "legacy", "snapshot-1.tar", content, 90*day)
+
+	job := newTestJob(t, ts, config.RetentionConfig{})
+	dels, err := job.Plan(context.Background(), "owner", config.RetentionPolicy{SnapshotMaxAge: util.Duration(30 * day), MaxBytes: 1})
+	if err != nil {
+		t.Fatalf("cannot plan: %v", err)
+	}
+	if len(dels) != 0 {
+		t.Errorf("expected an unannotated snapshot to be kept, but got %v", dels)
+	}
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestStorage(t)
+	for _, owner := range []string{"owner", "other-owner", "vip"} {
+		populate(ts, owner)
+	}
+	before := ts.Objects("owner")
+
+	cfg := config.RetentionConfig{
+		DryRun: true,
+		Policies: map[config.Stage]config.RetentionPolicy{
+			config.StageDevStaging: {HeadlessLogMaxAge: util.Duration(30 * day)},
+			config.StageProduction: {MaxBytes: 1},
+		},
+		Owners: map[string]config.RetentionPolicy{
+			"vip": {},
+		},
+	}
+	job := newTestJob(t, ts, cfg)
+	err := job.Run(ctx)
+	if err != nil {
+		t.Fatalf("cannot run retention: %v", err)
+	}
+	if diff := cmp.Diff(before, ts.Objects("owner")); diff != "" {
+		t.Errorf("dry run deleted objects (-want +got):\n%s", diff)
+	}
+	if act := testutil.ToFloat64(job.metrics.DeletedObjects.WithLabelValues(KindHeadlessLog, "true")); act != 2 {
+		t.Errorf("expected dry run to count 2 headless logs, got %v", act)
+	}
+
+	job.Config.DryRun = false
+	err = job.Run(ctx)
+	if err != nil {
+		t.Fatalf("cannot run retention: %v", err)
+	}
+	for _, owner := range []string{"owner", "other-owner"} {
+		var expected []string
+		for _, obj := range before {
+			if obj != "workspaces/regular/instances/old/logs/task" {
+				expected = append(expected, obj)
+			}
+		}
+		if diff := cmp.Diff(expected, ts.Objects(owner)); diff != "" {
+			t.Errorf("unexpected objects of %s (-want +got):\n%s", owner, diff)
+		}
+	}
+	if diff := cmp.Diff(before, ts.Objects("vip")); diff != "" {
+		t.Errorf("owner policy did not override stage policy (-want +got):\n%s", diff)
+	}
+	if act := testutil.ToFloat64(job.metrics.DeletedBytes.WithLabelValues(KindHeadlessLog, "false")); act != 2*float64(len(content)) {
+		t.Errorf("unexpected deleted bytes: %v", act)
+	}
+	if act := testutil.ToFloat64(job.metrics.Runs.WithLabelValues("success")); act != 2 {
+		t.Errorf("unexpected successful runs: %v", act)
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Config      config.RetentionConfig
+		ExpectError bool
+	}{
+		{Name: "valid", Config: config.RetentionConfig{Interval: util.Duration(time.Hour)}},
+		{Name: "no interval", Config: config.RetentionConfig{}, ExpectError: true},
+		{
+			Name: "negative stage policy",
+			Config: config.RetentionConfig{
+				Interval: util.Duration(time.Hour),
+				Policies: map[config.Stage]config.RetentionPolicy{config.StageProduction: {KeepBackups: -1}},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "negative owner policy",
+			Config: config.RetentionConfig{
+				Interval: util.Duration(time.Hour),
+				Owners:   map[string]config.RetentionPolicy{"owner": {MaxBytes: -1}},
+			},
+			ExpectError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := ValidateConfig(&test.Config)
+			if (err != nil) != test.ExpectError {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestIsHeadlessLog(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Expectation bool
+	}{
+		{Name: "instances/foo/logs/0", Expectation: true},
+		{Name: "instances/foo/recordings/terminal-recording-task-0.cast", Expectation: true},
+		{Name: "instances/foo/something-else", Expectation: false},
+		{Name: "wsfull-1.tar", Expectation: false},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if act := isHeadlessLog(test.Name); act != test.Expectation {
+				t.Errorf("unexpected isHeadlessLog(%q): expected %v, got %v", test.Name, test.Expectation, act)
+			}
+		})
+	}
+}
@@ -20,8 +20,9 @@ import (
 
 // ContentService implements ContentServiceServer
 type ContentService struct {
-	cfg config.StorageConfig
-	s   storage.PresignedAccess
+	cfg  config.StorageConfig
+	s    storage.PresignedAccess
+	keys storage.KeyProvider
 
 	api.UnimplementedContentServiceServer
 }
@@ -32,7 +33,15 @@ func NewContentService(cfg config.StorageConfig) (res *ContentService, err error
 	if err != nil {
 		return nil, err
 	}
-	return &ContentService{cfg: cfg, s: s}, nil
+
+	var keys storage.KeyProvider
+	if cfg.Encryption != nil {
+		keys, err = storage.NewKeyProvider(cfg.Encryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &ContentService{cfg: cfg, s: s, keys: keys}, nil
 }
 
 // DeleteUserContent deletes all content associated with a user.
@@ -41,6 +50,16 @@ func (cs *ContentService) DeleteUserContent(ctx context.Context, req *api.Delete
 	span.SetTag("user", req.OwnerId)
 	defer tracing.FinishSpan(span, &err)
 
+	if cs.keys != nil {
+		// Destroying the user's key first renders their encrypted content unreadable, even if deleting
+		// the bucket fails or copies of the content remain elsewhere.
+		err = cs.keys.DestroyKey(ctx, req.OwnerId)
+		if err != nil {
+			log.WithFields(log.OWI(req.OwnerId, "", "")).WithError(err).Error("DeleteUserContent: cannot destroy key")
+			return nil, status.Error(codes.Unknown, err.Error())
+		}
+	}
+
 	bucket := cs.s.Bucket(req.OwnerId)
 	err = cs.s.DeleteBucket(ctx, bucket)
 	// TODO
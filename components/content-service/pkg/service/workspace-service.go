@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/opentracing/opentracing-go"
 	"google.golang.org/grpc/codes"
@@ -20,10 +21,15 @@ import (
 	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
 )
 
+// chunkGCGracePeriod is the minimum age of unreferenced chunks before they're garbage collected.
+// Chunks are uploaded before the manifest which references them, hence we must not delete them right away.
+const chunkGCGracePeriod = 24 * time.Hour
+
 // WorkspaceService implements WorkspaceServiceServer
 type WorkspaceService struct {
-	cfg config.StorageConfig
-	s   storage.PresignedAccess
+	cfg  config.StorageConfig
+	s    storage.PresignedAccess
+	keys storage.KeyProvider
 
 	api.UnimplementedWorkspaceServiceServer
 }
@@ -34,7 +40,15 @@ func NewWorkspaceService(cfg config.StorageConfig) (res *WorkspaceService, err e
 	if err != nil {
 		return nil, err
 	}
-	return &WorkspaceService{cfg: cfg, s: s}, nil
+
+	var keys storage.KeyProvider
+	if cfg.Encryption != nil {
+		keys, err = storage.NewKeyProvider(cfg.Encryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &WorkspaceService{cfg: cfg, s: s, keys: keys}, nil
 }
 
 // WorkspaceDownloadURL provides a URL from where the content of a workspace can be downloaded from
@@ -100,6 +114,21 @@ func (cs *WorkspaceService) DeleteWorkspace(ctx context.Context, req *api.Delete
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
 
+	mfName := cs.s.BackupObject(req.OwnerId, req.WorkspaceId, storage.IncrementalBackupManifest)
+	err = cs.s.DeleteObject(ctx, cs.s.Bucket(req.OwnerId), &storage.DeleteObjectQuery{Name: mfName})
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		log.WithError(err).Error("error deleting workspace backup: ", mfName)
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	if err == nil {
+		// the workspace is gone, hence there's no backup in flight whose chunks we'd have to protect
+		_, err = storage.CollectChunkGarbage(ctx, cs.s, cs.keys, req.OwnerId, req.WorkspaceId, 0)
+		if err != nil {
+			log.WithError(err).Error("error deleting workspace backup chunks")
+			return nil, status.Error(codes.Unknown, err.Error())
+		}
+	}
+
 	trailPrefix := cs.s.BackupObject(req.OwnerId, req.WorkspaceId, "trail-")
 	err = cs.s.DeleteObject(ctx, cs.s.Bucket(req.OwnerId), &storage.DeleteObjectQuery{Prefix: trailPrefix})
 	if err != nil {
@@ -129,3 +158,21 @@ func (cs *WorkspaceService) WorkspaceSnapshotExists(ctx context.Context, req *ap
 		Exists: exists,
 	}, nil
 }
+
+// GarbageCollectChunks deletes the incremental backup chunks of a workspace which are no longer referenced by any of its manifests
+func (cs *WorkspaceService) GarbageCollectChunks(ctx context.Context, req *api.GarbageCollectChunksRequest) (resp *api.GarbageCollectChunksResponse, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "GarbageCollectChunks")
+	span.SetTag("user", req.OwnerId)
+	span.SetTag("workspaceId", req.WorkspaceId)
+	defer tracing.FinishSpan(span, &err)
+
+	res, err := storage.CollectChunkGarbage(ctx, cs.s, cs.keys, req.OwnerId, req.WorkspaceId, chunkGCGracePeriod)
+	if err != nil {
+		log.WithFields(log.OWI(req.OwnerId, req.WorkspaceId, "")).WithError(err).Error("error collecting chunk garbage")
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	return &api.GarbageCollectChunksResponse{
+		DeletedChunks: int64(res.DeletedChunks),
+		FreedBytes:    res.FreedBytes,
+	}, nil
+}
@@ -0,0 +1,487 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+const (
+	// EncryptionAlgorithm is the value of ObjectAnnotationEncryption for objects encrypted by EncryptedDirectAccess
+	EncryptionAlgorithm = "aes-256-gcm-stream-v1"
+
+	// encryptionSegmentSize is the amount of plaintext sealed at once
+	encryptionSegmentSize = 64 * 1024
+	// encryptionSaltSize is the size of the random salt from which we derive the per-object key
+	encryptionSaltSize = 32
+)
+
+var (
+	// ErrDecryptionFailed is returned when encrypted content has been tampered with, truncated or was encrypted using a different key
+	ErrDecryptionFailed = fmt.Errorf("decryption failed")
+
+	encryptionMagic   = []byte("GPENC\x00\x00\x01")
+	encryptionKDFInfo = []byte("gitpod content encryption")
+)
+
+// Encrypted content starts with a header consisting of encryptionMagic and a random salt. The salt and the data key
+// derive a key unique to the object, so that we can use a counter as nonce. The plaintext is then sealed in segments
+// of encryptionSegmentSize using AES-256-GCM. The nonce of each segment contains the segment number and whether it's
+// the last segment, which protects against reordering and truncation.
+
+func deriveObjectKey(dataKey, salt []byte) (cipher.AEAD, error) {
+	key := make([]byte, dataKeySize)
+	_, err := io.ReadFull(hkdf.New(sha256.New, dataKey, salt, encryptionKDFInfo), key)
+	if err != nil {
+		return nil, err
+	}
+	return newGCM(key)
+}
+
+func segmentNonce(aead cipher.AEAD, seq uint32, last bool) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	binary.BigEndian.PutUint32(nonce[len(nonce)-5:], seq)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+// NewEncryptingWriter encrypts everything written to the returned writer using the data key and writes it to w.
+// Callers must close the returned writer to write the final segment - this does not close w.
+func NewEncryptingWriter(w io.Writer, dataKey []byte) (io.WriteCloser, error) {
+	salt := make([]byte, encryptionSaltSize)
+	_, err := io.ReadFull(rand.Reader, salt)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := deriveObjectKey(dataKey, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.Write(append(append([]byte{}, encryptionMagic...), salt...))
+	if err != nil {
+		return nil, err
+	}
+
+	return &encryptingWriter{
+		w:    w,
+		aead: aead,
+		buf:  make([]byte, 0, encryptionSegmentSize),
+	}, nil
+}
+
+type encryptingWriter struct {
+	w      io.Writer
+	aead   cipher.AEAD
+	buf    []byte
+	ct     []byte
+	seq    uint32
+	closed bool
+}
+
+func (e *encryptingWriter) Write(p []byte) (n int, err error) {
+	if e.closed {
+		return 0, xerrors.Errorf("writer is closed")
+	}
+
+	for len(p) > 0 {
+		// we only seal a full segment once more data arrives, because the last segment is sealed differently
+		if len(e.buf) == encryptionSegmentSize {
+			err = e.seal(false)
+			if err != nil {
+				return n, err
+			}
+		}
+
+		c := copy(e.buf[len(e.buf):cap(e.buf)], p)
+		e.buf = e.buf[:len(e.buf)+c]
+		p = p[c:]
+		n += c
+	}
+	return n, nil
+}
+
+func (e *encryptingWriter) seal(last bool) error {
+	e.ct = e.aead.Seal(e.ct[:0], segmentNonce(e.aead, e.seq, last), e.buf, nil)
+	_, err := e.w.Write(e.ct)
+	if err != nil {
+		return err
+	}
+
+	e.buf = e.buf[:0]
+	e.seq++
+	if e.seq == 0 && !last {
+		return xerrors.Errorf("content is too large to be encrypted")
+	}
+	return nil
+}
+
+// Close seals the last segment
+func (e *encryptingWriter) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	return e.seal(true)
+}
+
+// NewDecryptingReader decrypts content produced by NewEncryptingWriter. Read returns ErrDecryptionFailed
+// if the content cannot be authenticated using the data key.
+func NewDecryptingReader(r io.Reader, dataKey []byte) (io.Reader, error) {
+	hdr := make([]byte, len(encryptionMagic)+encryptionSaltSize)
+	_, err := io.ReadFull(r, hdr)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return nil, ErrDecryptionFailed
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(hdr[:len(encryptionMagic)], encryptionMagic) {
+		return nil, xerrors.Errorf("content is not encrypted")
+	}
+
+	aead, err := deriveObjectKey(dataKey, hdr[len(encryptionMagic):])
+	if err != nil {
+		return nil, err
+	}
+	return &decryptingReader{
+		r:    bufio.NewReader(r),
+		aead: aead,
+		ct:   make([]byte, encryptionSegmentSize+aead.Overhead()),
+	}, nil
+}
+
+type decryptingReader struct {
+	r     *bufio.Reader
+	aead  cipher.AEAD
+	ct    []byte
+	plain []byte
+	seq   uint32
+	done  bool
+	err   error
+}
+
+func (d *decryptingReader) Read(p []byte) (n int, err error) {
+	for len(d.plain) == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+		if d.done {
+			return 0, io.EOF
+		}
+		d.err = d.open()
+	}
+
+	n = copy(p, d.plain)
+	d.plain = d.plain[n:]
+	return n, nil
+}
+
+func (d *decryptingReader) open() error {
+	n, err := io.ReadFull(d.r, d.ct)
+	var last bool
+	switch err {
+	case nil:
+		// a full segment is the last one if nothing follows it
+		_, err = d.r.Peek(1)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		last = err == io.EOF
+	case io.ErrUnexpectedEOF:
+		last = true
+	case io.EOF:
+		// the last segment is missing
+		return ErrDecryptionFailed
+	default:
+		return err
+	}
+
+	d.plain, err = d.aead.Open(d.ct[:0], segmentNonce(d.aead, d.seq, last), d.ct[:n], nil)
+	if err != nil {
+		return ErrDecryptionFailed
+	}
+	d.seq++
+	d.done = last
+	return nil
+}
+
+// UnwrapObjectKey unwraps the data key of an encrypted object using the key provider
+func UnwrapObjectKey(ctx context.Context, keys KeyProvider, meta *ObjectMeta) ([]byte, error) {
+	if meta.Encryption != EncryptionAlgorithm {
+		return nil, xerrors.Errorf("unsupported encryption algorithm: %q", meta.Encryption)
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(meta.WrappedKey)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot decode wrapped key: %w", err)
+	}
+	return keys.UnwrapKey(ctx, meta.KeyOwner, wrapped)
+}
+
+// objectMetaReader is implemented by direct storage backends which can read back the metadata of an object
+type objectMetaReader interface {
+	ObjectOpener
+
+	// objectMeta reads the metadata of a backup object. The name is either a backup name or one produced by Qualify.
+	objectMeta(ctx context.Context, name string) (*ObjectMeta, error)
+}
+
+var (
+	_ DirectAccess = &EncryptedDirectAccess{}
+	_ ObjectOpener = &EncryptedDirectAccess{}
+
+	_ objectMetaReader = &DirectGCPStorage{}
+	_ objectMetaReader = &DirectMinIOStorage{}
+	_ objectMetaReader = &DirectFileSystemStorage{}
+	_ objectMetaReader = &DirectS3Storage{}
+)
+
+// EncryptedDirectAccess encrypts content using the owner's data key before uploading it to the underlying
+// storage, and decrypts encrypted content it downloads. The wrapped data key is stored in the object annotations.
+// Content that was uploaded without encryption is downloaded as is.
+type EncryptedDirectAccess struct {
+	DirectAccess
+
+	Keys KeyProvider
+
+	owner string
+}
+
+// NewEncryptedDirectAccess adds envelope encryption to a storage backend
+func NewEncryptedDirectAccess(da DirectAccess, keys KeyProvider) *EncryptedDirectAccess {
+	return &EncryptedDirectAccess{
+		DirectAccess: da,
+		Keys:         keys,
+	}
+}
+
+// Init initializes the remote storage - call this before calling anything else on the interface
+func (rs *EncryptedDirectAccess) Init(ctx context.Context, owner, workspace, instance string) error {
+	rs.owner = owner
+	return rs.DirectAccess.Init(ctx, owner, workspace, instance)
+}
+
+// Upload encrypts a local file and uploads it to the remote storage
+func (rs *EncryptedDirectAccess) Upload(ctx context.Context, source string, name string, opts ...UploadOption) (bucket, obj string, err error) {
+	return rs.upload(ctx, source, name, opts, rs.DirectAccess.Upload)
+}
+
+// UploadInstance encrypts a local file and uploads it to the per-instance remote storage
+func (rs *EncryptedDirectAccess) UploadInstance(ctx context.Context, source string, name string, opts ...UploadOption) (bucket, obj string, err error) {
+	return rs.upload(ctx, source, name, opts, rs.DirectAccess.UploadInstance)
+}
+
+type uploadFunc func(ctx context.Context, source string, name string, opts ...UploadOption) (bucket, obj string, err error)
+
+func (rs *EncryptedDirectAccess) upload(ctx context.Context, source string, name string, opts []UploadOption, upload uploadFunc) (bucket, obj string, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "EncryptedDirectAccess.upload")
+	span.SetTag("name", name)
+	defer tracing.FinishSpan(span, &err)
+
+	if rs.owner == "" {
+		err = xerrors.Errorf("no owner available - did you call Init()?")
+		return
+	}
+	key, wrapped, err := rs.Keys.DataKey(ctx, rs.owner)
+	if err != nil {
+		err = xerrors.Errorf("cannot get data key: %w", err)
+		return
+	}
+
+	src, err := os.Open(source)
+	if err != nil {
+		err = xerrors.Errorf("cannot open file for uploading: %w", err)
+		return
+	}
+	defer src.Close()
+
+	// the encrypted copy lives next to the source, where we know there's room for it
+	dst, err := os.CreateTemp(filepath.Dir(source), ".encrypted-*")
+	if err != nil {
+		err = xerrors.Errorf("cannot create encrypted file: %w", err)
+		return
+	}
+	defer os.Remove(dst.Name())
+	defer dst.Close()
+
+	bw := bufio.NewWriter(dst)
+	enc, err := NewEncryptingWriter(bw, key)
+	if err != nil {
+		return
+	}
+	_, err = io.Copy(enc, src)
+	if err != nil {
+		err = xerrors.Errorf("cannot encrypt %s: %w", source, err)
+		return
+	}
+	err = enc.Close()
+	if err != nil {
+		return
+	}
+	err = bw.Flush()
+	if err != nil {
+		return
+	}
+	err = dst.Close()
+	if err != nil {
+		return
+	}
+
+	opts = append(opts, withAdditionalAnnotations(map[string]string{
+		ObjectAnnotationEncryption: EncryptionAlgorithm,
+		ObjectAnnotationKeyOwner:   rs.owner,
+		ObjectAnnotationWrappedKey: base64.StdEncoding.EncodeToString(wrapped),
+	}))
+	return upload(ctx, dst.Name(), name, opts...)
+}
+
+// withAdditionalAnnotations adds metadata to a storage object without replacing annotations set by other options
+func withAdditionalAnnotations(md map[string]string) UploadOption {
+	return func(opts *UploadOptions) error {
+		res := make(map[string]string, len(opts.Annotations)+len(md))
+		for k, v := range opts.Annotations {
+			res[k] = v
+		}
+		for k, v := range md {
+			res[k] = v
+		}
+		opts.Annotations = res
+		return nil
+	}
+}
+
+// Download takes the latest state from the remote storage, decrypts it if needed and extracts it to a local path
+func (rs *EncryptedDirectAccess) Download(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (bool, error) {
+	return rs.download(ctx, destination, name, mappings, rs.DirectAccess.Download)
+}
+
+// DownloadSnapshot downloads a snapshot and decrypts it if needed. The snapshot name is expected to be one produced by Qualify
+func (rs *EncryptedDirectAccess) DownloadSnapshot(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (bool, error) {
+	return rs.download(ctx, destination, name, mappings, rs.DirectAccess.DownloadSnapshot)
+}
+
+type downloadFunc func(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (bool, error)
+
+func (rs *EncryptedDirectAccess) download(ctx context.Context, destination string, name string, mappings []archive.IDMapping, download downloadFunc) (found bool, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "EncryptedDirectAccess.download")
+	span.SetTag("name", name)
+	defer tracing.FinishSpan(span, &err)
+
+	mr, ok := rs.DirectAccess.(objectMetaReader)
+	if !ok {
+		return download(ctx, destination, name, mappings)
+	}
+	meta, err := mr.objectMeta(ctx, name)
+	if err == ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if meta.Encryption == "" {
+		// content uploaded before encryption was enabled
+		return download(ctx, destination, name, mappings)
+	}
+
+	rc, err := rs.openEncrypted(ctx, mr, name, meta)
+	if err != nil {
+		return true, err
+	}
+	defer rc.Close()
+
+	// the digest is the one of the plaintext, which the caller annotated the object with
+	err = extractVerifiedTarbal(ctx, destination, rc, meta.Digest, mappings)
+	if err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// OpenObject opens an object for reading and decrypts it if needed
+func (rs *EncryptedDirectAccess) OpenObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	mr, ok := rs.DirectAccess.(objectMetaReader)
+	if !ok {
+		return nil, xerrors.Errorf("storage does not support opening objects")
+	}
+	meta, err := mr.objectMeta(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if meta.Encryption == "" {
+		return mr.OpenObject(ctx, name)
+	}
+	return rs.openEncrypted(ctx, mr, name, meta)
+}
+
+func (rs *EncryptedDirectAccess) openEncrypted(ctx context.Context, mr objectMetaReader, name string, meta *ObjectMeta) (io.ReadCloser, error) {
+	key, err := UnwrapObjectKey(ctx, rs.Keys, meta)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot unwrap data key of %s: %w", name, err)
+	}
+
+	rc, err := mr.OpenObject(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	r, err := NewDecryptingReader(rc, key)
+	if err != nil {
+		rc.Close()
+		return nil, err
+	}
+	return &decryptingReadCloser{Reader: r, Closer: rc}, nil
+}
+
+// OpenSignedObject opens an object described by SignDownload and decrypts it if needed.
+// The key provider may be nil if encryption is disabled, in which case encrypted objects cannot be opened.
+func OpenSignedObject(ctx context.Context, keys KeyProvider, info *DownloadInfo) (io.ReadCloser, error) {
+	if info.Meta.Encryption == "" {
+		return OpenURL(ctx, info.URL)
+	}
+	if keys == nil {
+		return nil, xerrors.Errorf("object is encrypted, but encryption is not configured")
+	}
+
+	key, err := UnwrapObjectKey(ctx, keys, &info.Meta)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot unwrap data key: %w", err)
+	}
+	rc, err := OpenURL(ctx, info.URL)
+	if err != nil {
+		return nil, err
+	}
+	r, err := NewDecryptingReader(rc, key)
+	if err != nil {
+		rc.Close()
+		return nil, err
+	}
+	return &decryptingReadCloser{Reader: r, Closer: rc}, nil
+}
+
+type decryptingReadCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,308 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+func encryptForTest(t *testing.T, key, plaintext []byte) []byte {
+	var buf bytes.Buffer
+	w, err := NewEncryptingWriter(&buf, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.Write(plaintext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func decryptForTest(key, ciphertext []byte) ([]byte, error) {
+	r, err := NewDecryptingReader(bytes.NewReader(ciphertext), key)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+func randomForTest(t *testing.T, n int) []byte {
+	res := make([]byte, n)
+	_, err := io.ReadFull(rand.Reader, res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func TestEncryptionRoundtrip(t *testing.T) {
+	key := randomForTest(t, dataKeySize)
+
+	tests := []struct {
+		Name string
+		Size int
+	}{
+		{Name: "empty", Size: 0},
+		{Name: "small", Size: 1},
+		{Name: "just below segment", Size: encryptionSegmentSize - 1},
+		{Name: "exactly one segment", Size: encryptionSegmentSize},
+		{Name: "just above segment", Size: encryptionSegmentSize + 1},
+		{Name: "several segments", Size: 3*encryptionSegmentSize + 17},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			plaintext := randomForTest(t, test.Size)
+			ciphertext := encryptForTest(t, key, plaintext)
+			// very short plaintext may well occur in the ciphertext by chance
+			if test.Size >= 16 && bytes.Contains(ciphertext, plaintext) {
+				t.Errorf("ciphertext contains plaintext")
+			}
+
+			act, err := decryptForTest(key, ciphertext)
+			if err != nil {
+				t.Fatalf("cannot decrypt: %v", err)
+			}
+			if !bytes.Equal(plaintext, act) {
+				t.Errorf("decrypted content differs from plaintext")
+			}
+		})
+	}
+}
+
+func TestDecryptionFailure(t *testing.T) {
+	key := randomForTest(t, dataKeySize)
+	plaintext := randomForTest(t, 2*encryptionSegmentSize+42)
+	ciphertext := encryptForTest(t, key, plaintext)
+	hdrSize := len(encryptionMagic) + encryptionSaltSize
+	fullSegment := encryptionSegmentSize + 16
+
+	tests := []struct {
+		Name       string
+		Key        []byte
+		Ciphertext func() []byte
+	}{
+		{
+			Name:       "wrong key",
+			Key:        randomForTest(t, dataKeySize),
+			Ciphertext: func() []byte { return ciphertext },
+		},
+		{
+			Name: "tampered",
+			Ciphertext: func() []byte {
+				res := append([]byte{}, ciphertext...)
+				res[hdrSize+10] ^= 1
+				return res
+			},
+		},
+		{
+			Name:       "missing last segment",
+			Ciphertext: func() []byte { return ciphertext[:hdrSize+2*fullSegment] },
+		},
+		{
+			Name:       "truncated segment",
+			Ciphertext: func() []byte { return ciphertext[:len(ciphertext)-1] },
+		},
+		{
+			Name: "reordered segments",
+			Ciphertext: func() []byte {
+				res := append([]byte{}, ciphertext[:hdrSize]...)
+				res = append(res, ciphertext[hdrSize+fullSegment:hdrSize+2*fullSegment]...)
+				res = append(res, ciphertext[hdrSize:hdrSize+fullSegment]...)
+				return append(res, ciphertext[hdrSize+2*fullSegment:]...)
+			},
+		},
+		{
+			Name:       "header only",
+			Ciphertext: func() []byte { return ciphertext[:hdrSize] },
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			k := test.Key
+			if k == nil {
+				k = key
+			}
+			_, err := decryptForTest(k, test.Ciphertext())
+			if !errors.Is(err, ErrDecryptionFailed) {
+				t.Errorf("expected ErrDecryptionFailed, got %v", err)
+			}
+		})
+	}
+}
+
+func TestEncryptedDirectAccess(t *testing.T) {
+	ctx := context.Background()
+	s, cfg := newTestFileSystemStorage(t)
+	keys, err := NewKeyProvider(newTestKeyFileConfig(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fs, err := newDirectFileSystemAccess(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rs := NewEncryptedDirectAccess(fs, keys)
+	err = rs.Init(ctx, "owner", "workspace", "instance")
+	if err != nil {
+		t.Fatalf("cannot init storage: %v", err)
+	}
+	err = rs.EnsureExists(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content := []byte("secret workspace content")
+	var tarbuf bytes.Buffer
+	tw := tar.NewWriter(&tarbuf)
+	_ = tw.WriteHeader(&tar.Header{Name: "secret.txt", Mode: 0644, Size: int64(len(content))})
+	_, _ = tw.Write(content)
+	_ = tw.Close()
+	src := filepath.Join(t.TempDir(), "backup.tar")
+	err = os.WriteFile(src, tarbuf.Bytes(), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dgst := digest.FromBytes(tarbuf.Bytes()).String()
+	_, _, err = rs.Upload(ctx, src, DefaultBackup, WithAnnotations(map[string]string{ObjectAnnotationDigest: dgst}))
+	if err != nil {
+		t.Fatalf("cannot upload: %v", err)
+	}
+	// the plaintext backup is stored as well, so that we can check unencrypted content is still readable
+	_, _, err = fs.Upload(ctx, src, "plain.tar")
+	if err != nil {
+		t.Fatalf("cannot upload: %v", err)
+	}
+
+	info, err := s.SignDownload(ctx, s.Bucket("owner"), rs.BackupObject(DefaultBackup), nil)
+	if err != nil {
+		t.Fatalf("cannot sign download: %v", err)
+	}
+	if info.Meta.Encryption != EncryptionAlgorithm || info.Meta.KeyOwner != "owner" || info.Meta.WrappedKey == "" {
+		t.Errorf("encryption annotations are missing: %+v", info.Meta)
+	}
+	if info.Meta.Digest != dgst {
+		t.Errorf("caller's annotations were not preserved: %+v", info.Meta)
+	}
+	raw, err := fs.OpenObject(ctx, DefaultBackup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rawContent, _ := io.ReadAll(raw)
+	raw.Close()
+	if bytes.Contains(rawContent, content) {
+		t.Errorf("stored object contains plaintext")
+	}
+
+	for _, name := range []string{DefaultBackup, "plain.tar"} {
+		dst := t.TempDir()
+		found, err := rs.Download(ctx, dst, name, nil)
+		if err != nil {
+			t.Fatalf("cannot download %s: %v", name, err)
+		}
+		if !found {
+			t.Fatalf("%s not found", name)
+		}
+		act, err := os.ReadFile(filepath.Join(dst, "secret.txt"))
+		if err != nil {
+			t.Fatalf("cannot read downloaded content of %s: %v", name, err)
+		}
+		if !bytes.Equal(content, act) {
+			t.Errorf("unexpected downloaded content of %s: %q", name, act)
+		}
+	}
+
+	rc, err := rs.OpenObject(ctx, rs.Qualify(DefaultBackup))
+	if err != nil {
+		t.Fatalf("cannot open object: %v", err)
+	}
+	act, err := io.ReadAll(rc)
+	rc.Close()
+	if err != nil {
+		t.Fatalf("cannot read object: %v", err)
+	}
+	if !bytes.Equal(tarbuf.Bytes(), act) {
+		t.Errorf("opened object differs from uploaded content")
+	}
+
+	found, err := rs.Download(ctx, t.TempDir(), "does-not-exist.tar", nil)
+	if err != nil || found {
+		t.Errorf("expected missing object to be not found, got found=%v err=%v", found, err)
+	}
+
+	err = keys.DestroyKey(ctx, "owner")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = rs.Download(ctx, t.TempDir(), DefaultBackup, nil)
+	if !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("expected ErrKeyNotFound after crypto-shredding, got %v", err)
+	}
+}
+
+func TestCollectChunkGarbageEncrypted(t *testing.T) {
+	ctx := context.Background()
+	s, cfg := newTestFileSystemStorage(t)
+	keys, err := NewKeyProvider(newTestKeyFileConfig(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs, err := newDirectFileSystemAccess(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rs := NewEncryptedDirectAccess(fs, keys)
+	err = rs.Init(ctx, "owner", "workspace", "instance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rs.EnsureExists(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tarbal := buildTestTarbal(t, map[string][]byte{"file.bin": randomForTest(t, 1024*1024)})
+	gen, err := UploadIncremental(ctx, rs, t.TempDir(), bytes.NewReader(tarbal), nil, archive.Zstd, 0)
+	if err != nil {
+		t.Fatalf("cannot upload backup: %v", err)
+	}
+	uploadTestManifest(t, rs, IncrementalBackupManifest, &csapi.WorkspaceContentManifest{
+		Type:        csapi.TypeIncrementalWorkspaceContentV1,
+		Generations: []csapi.WorkspaceContentGeneration{*gen},
+	})
+
+	// without keys the manifest cannot be read, which must not be mistaken for there being no manifest
+	_, err = CollectChunkGarbage(ctx, s, nil, "owner", "workspace", 0)
+	if err == nil {
+		t.Errorf("expected garbage collection to fail without keys")
+	}
+
+	res, err := CollectChunkGarbage(ctx, s, keys, "owner", "workspace", 0)
+	if err != nil {
+		t.Fatalf("cannot collect garbage: %v", err)
+	}
+	if res.DeletedChunks != 0 {
+		t.Errorf("garbage collection deleted %d referenced chunks", res.DeletedChunks)
+	}
+}
@@ -0,0 +1,1024 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	config "github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+var _ DirectAccess = &DirectFileSystemStorage{}
+var _ PresignedAccess = &presignedFileSystemStorage{}
+
+const (
+	// fileSystemMetaDir is the directory below the storage root where object metadata lives
+	fileSystemMetaDir = ".meta"
+	// fileSystemTempDir is the directory below the storage root where uploads are staged
+	fileSystemTempDir = ".tmp"
+
+	fileSystemSignedURLExpiry = 30 * time.Minute
+)
+
+var validateURL = validation.By(func(o interface{}) error {
+	s, ok := o.(string)
+	if !ok {
+		return xerrors.Errorf("field should be string")
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return xerrors.Errorf("must be an absolute URL")
+	}
+	return nil
+})
+
+// ValidateFileSystemConfig checks if the filesystem storage config is valid for direct access
+func ValidateFileSystemConfig(c *config.FileSystemConfig) error {
+	return validation.ValidateStruct(c,
+		validation.Field(&c.Root, validation.Required),
+	)
+}
+
+// ValidatePresignedFileSystemConfig checks if the filesystem storage config is valid for presigned access
+func ValidatePresignedFileSystemConfig(c *config.FileSystemConfig) error {
+	return validation.ValidateStruct(c,
+		validation.Field(&c.Root, validation.Required),
+		validation.Field(&c.BaseURL, validation.Required, validateURL),
+		validation.Field(&c.SigningKey, validation.Required),
+	)
+}
+
+// addFileSystemParamsFromMounts allows for the signing key to be read from a file
+func addFileSystemParamsFromMounts(c *config.FileSystemConfig) error {
+	if c.SigningKeyFile != "" {
+		value, err := os.ReadFile(c.SigningKeyFile)
+		if err != nil {
+			return err
+		}
+		c.SigningKey = strings.TrimSpace(string(value))
+	}
+	return nil
+}
+
+// newDirectFileSystemAccess provides direct access to the remote storage system
+func newDirectFileSystemAccess(cfg config.FileSystemConfig) (*DirectFileSystemStorage, error) {
+	if err := ValidateFileSystemConfig(&cfg); err != nil {
+		return nil, err
+	}
+	return &DirectFileSystemStorage{FileSystemConfig: cfg}, nil
+}
+
+// DirectFileSystemStorage implements a directory on a mounted filesystem as remote storage backend
+type DirectFileSystemStorage struct {
+	Username         string
+	WorkspaceName    string
+	InstanceID       string
+	FileSystemConfig config.FileSystemConfig
+
+	store *fileSystemStore
+}
+
+// Validate checks if the filesystem storage is configured properly
+func (rs *DirectFileSystemStorage) Validate() error {
+	err := ValidateFileSystemConfig(&rs.FileSystemConfig)
+	if err != nil {
+		return err
+	}
+
+	return validation.ValidateStruct(rs,
+		validation.Field(&rs.Username, validation.Required),
+		validation.Field(&rs.WorkspaceName, validation.Required),
+	)
+}
+
+// Init initializes the remote storage - call this before calling anything else on the interface
+func (rs *DirectFileSystemStorage) Init(ctx context.Context, owner, workspace, instance string) (err error) {
+	rs.Username = owner
+	rs.WorkspaceName = workspace
+	rs.InstanceID = instance
+
+	err = rs.Validate()
+	if err != nil {
+		return err
+	}
+
+	rs.store = &fileSystemStore{Root: rs.FileSystemConfig.Root}
+	return nil
+}
+
+// EnsureExists makes sure that the remote storage location exists and can be up- or downloaded from
+func (rs *DirectFileSystemStorage) EnsureExists(ctx context.Context) (err error) {
+	if rs.store == nil {
+		return xerrors.Errorf("no filesystem store available - did you call Init()?")
+	}
+	return rs.store.EnsureBucket(rs.bucketName())
+}
+
+func (rs *DirectFileSystemStorage) download(ctx context.Context, destination string, bkt string, obj string, mappings []archive.IDMapping) (found bool, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "download")
+	span.SetTag("bucket", bkt)
+	span.SetTag("object", obj)
+	defer tracing.FinishSpan(span, &err)
+
+	if rs.store == nil {
+		return false, xerrors.Errorf("no filesystem store available - did you call Init()?")
+	}
+
+	f, meta, err := rs.store.Open(bkt, obj)
+	if err == ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	err = extractVerifiedTarbal(ctx, destination, f, meta.Annotations[ObjectAnnotationDigest], mappings)
+	if err != nil {
+		return true, err
+	}
+
+	return true, nil
+}
+
+// Download takes the latest state from the remote storage and downloads it to a local path
+func (rs *DirectFileSystemStorage) Download(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (bool, error) {
+	return rs.download(ctx, destination, rs.bucketName(), rs.objectName(name), mappings)
+}
+
+// DownloadSnapshot downloads a snapshot. The snapshot name is expected to be one produced by Qualify
+func (rs *DirectFileSystemStorage) DownloadSnapshot(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (bool, error) {
+	bkt, obj, err := ParseSnapshotName(name)
+	if err != nil {
+		return false, err
+	}
+
+	return rs.download(ctx, destination, bkt, obj, mappings)
+}
+
+// OpenObject opens a backup object for reading. The name is either a backup name or one produced by Qualify.
+func (rs *DirectFileSystemStorage) OpenObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	if rs.store == nil {
+		return nil, xerrors.Errorf("no filesystem store available - did you call Init()?")
+	}
+
+	bkt, obj, err := splitObjectName(name, rs.bucketName(), rs.objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	f, _, err := rs.store.Open(bkt, obj)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// objectMeta reads the metadata of a backup object. The name is either a backup name or one produced by Qualify.
+func (rs *DirectFileSystemStorage) objectMeta(ctx context.Context, name string) (*ObjectMeta, error) {
+	if rs.store == nil {
+		return nil, xerrors.Errorf("no filesystem store available - did you call Init()?")
+	}
+
+	bkt, obj, err := splitObjectName(name, rs.bucketName(), rs.objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	_, meta, err := rs.store.Stat(bkt, obj)
+	if err != nil {
+		return nil, err
+	}
+	return &ObjectMeta{
+		ContentType:        meta.ContentType,
+		OCIMediaType:       meta.Annotations[ObjectAnnotationOCIContentType],
+		Digest:             meta.Annotations[ObjectAnnotationDigest],
+		UncompressedDigest: meta.Annotations[ObjectAnnotationUncompressedDigest],
+		Encryption:         meta.Annotations[ObjectAnnotationEncryption],
+		KeyOwner:           meta.Annotations[ObjectAnnotationKeyOwner],
+		WrappedKey:         meta.Annotations[ObjectAnnotationWrappedKey],
+	}, nil
+}
+
+// ListObjects returns all objects found with the given prefix. Returns an empty list if the bucket does not exuist (yet).
+func (rs *DirectFileSystemStorage) ListObjects(ctx context.Context, prefix string) (objects []string, err error) {
+	if rs.store == nil {
+		return nil, xerrors.Errorf("no filesystem store available - did you call Init()?")
+	}
+
+	err = rs.store.Walk(rs.bucketName(), prefix, func(obj string, info fs.FileInfo) error {
+		objects = append(objects, obj)
+		return nil
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list objects: %w", err)
+	}
+	return objects, nil
+}
+
+// Qualify fully qualifies a snapshot name so that it can be downloaded using DownloadSnapshot
+func (rs *DirectFileSystemStorage) Qualify(name string) string {
+	return fmt.Sprintf("%s@%s", rs.objectName(name), rs.bucketName())
+}
+
+// UploadInstance takes all files from a local location and uploads it to the per-instance remote storage
+func (rs *DirectFileSystemStorage) UploadInstance(ctx context.Context, source string, name string, opts ...UploadOption) (bucket, object string, err error) {
+	if rs.InstanceID == "" {
+		return "", "", xerrors.Errorf("instanceID is required to comput object name")
+	}
+	return rs.Upload(ctx, source, InstanceObjectName(rs.InstanceID, name), opts...)
+}
+
+// Upload takes all files from a local location and uploads it to the remote storage
+func (rs *DirectFileSystemStorage) Upload(ctx context.Context, source string, name string, opts ...UploadOption) (bucket, obj string, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DirectUpload")
+	defer tracing.FinishSpan(span, &err)
+
+	options, err := GetUploadOptions(opts)
+	if err != nil {
+		err = xerrors.Errorf("cannot get options: %w", err)
+		return
+	}
+
+	if rs.store == nil {
+		err = xerrors.Errorf("no filesystem store available - did you call Init()?")
+		return
+	}
+
+	src, err := os.Open(source)
+	if err != nil {
+		err = xerrors.Errorf("cannot open upload source: %w", err)
+		return
+	}
+	defer src.Close()
+
+	bucket = rs.bucketName()
+	obj = rs.objectName(name)
+	span.LogKV("bucket", bucket)
+	span.LogKV("obj", obj)
+	span.LogKV("root", rs.FileSystemConfig.Root)
+
+	err = rs.store.Put(bucket, obj, src, fileSystemObjectMeta{
+		ContentType: options.ContentType,
+		Annotations: options.Annotations,
+	})
+	return
+}
+
+// Bucket provides the bucket name for a particular user
+func (rs *DirectFileSystemStorage) Bucket(ownerID string) string {
+	return fileSystemBucketName(ownerID)
+}
+
+// BackupObject returns a backup's object name that a direct downloader would download
+func (rs *DirectFileSystemStorage) BackupObject(name string) string {
+	return rs.objectName(name)
+}
+
+func (rs *DirectFileSystemStorage) bucketName() string {
+	return fileSystemBucketName(rs.Username)
+}
+
+func (rs *DirectFileSystemStorage) objectName(name string) string {
+	return fileSystemWorkspaceBackupObjectName(rs.WorkspaceName, name)
+}
+
+func fileSystemBucketName(ownerID string) string {
+	return fmt.Sprintf("gitpod-user-%s", ownerID)
+}
+
+func fileSystemWorkspaceBackupObjectName(workspaceID, name string) string {
+	return path.Join("workspaces", workspaceID, name)
+}
+
+func newPresignedFileSystemAccess(cfg config.FileSystemConfig) (*presignedFileSystemStorage, error) {
+	err := addFileSystemParamsFromMounts(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err = ValidatePresignedFileSystemConfig(&cfg); err != nil {
+		return nil, err
+	}
+
+	return &presignedFileSystemStorage{
+		store:  &fileSystemStore{Root: cfg.Root},
+		signer: &fileSystemURLSigner{BaseURL: strings.TrimSuffix(cfg.BaseURL, "/"), Key: []byte(cfg.SigningKey)},
+	}, nil
+}
+
+type presignedFileSystemStorage struct {
+	store  *fileSystemStore
+	signer *fileSystemURLSigner
+}
+
+// EnsureExists makes sure that the remote storage location exists and can be up- or downloaded from
+func (s *presignedFileSystemStorage) EnsureExists(ctx context.Context, bucket string) (err error) {
+	return s.store.EnsureBucket(bucket)
+}
+
+// DiskUsage gives the total objects size of objects that have the given prefix
+func (s *presignedFileSystemStorage) DiskUsage(ctx context.Context, bucket string, prefix string) (size int64, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "filesystem.DiskUsage")
+	defer tracing.FinishSpan(span, &err)
+
+	err = s.store.Walk(bucket, prefix, func(obj string, info fs.FileInfo) error {
+		size += info.Size()
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// ListObjects describes all objects in the given bucket whose name has the given prefix
+func (s *presignedFileSystemStorage) ListObjects(ctx context.Context, bucket string, prefix string) (objects []ObjectInfo, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "filesystem.ListObjects")
+	defer tracing.FinishSpan(span, &err)
+
+	err = s.store.Walk(bucket, prefix, func(obj string, info fs.FileInfo) error {
+		objects = append(objects, ObjectInfo{
+			Name:         obj,
+			Size:         info.Size(),
+			LastModified: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// SignDownload describes an object for download - if the object is not found, ErrNotFound is returned
+func (s *presignedFileSystemStorage) SignDownload(ctx context.Context, bucket, obj string, options *SignedURLOptions) (info *DownloadInfo, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "filesystem.SignDownload")
+	defer func() {
+		if err == ErrNotFound {
+			span.LogKV("found", false)
+			tracing.FinishSpan(span, nil)
+			return
+		}
+
+		tracing.FinishSpan(span, &err)
+	}()
+
+	stat, meta, err := s.store.Stat(bucket, obj)
+	if err != nil {
+		return nil, err
+	}
+	url, err := s.signer.Sign(http.MethodGet, bucket, obj, "", time.Now().Add(fileSystemSignedURLExpiry))
+	if err != nil {
+		return nil, err
+	}
+
+	return &DownloadInfo{
+		Meta: ObjectMeta{
+			ContentType:        meta.ContentType,
+			OCIMediaType:       meta.Annotations[ObjectAnnotationOCIContentType],
+			Digest:             meta.Annotations[ObjectAnnotationDigest],
+			UncompressedDigest: meta.Annotations[ObjectAnnotationUncompressedDigest],
+			Encryption:         meta.Annotations[ObjectAnnotationEncryption],
+			KeyOwner:           meta.Annotations[ObjectAnnotationKeyOwner],
+			WrappedKey:         meta.Annotations[ObjectAnnotationWrappedKey],
+			Prebuild:           parsePrebuildAnnotation(meta.Annotations[ObjectAnnotationPrebuild]),
+		},
+		Size: stat.Size(),
+		URL:  url,
+	}, nil
+}
+
+// SignUpload describes an object for upload
+func (s *presignedFileSystemStorage) SignUpload(ctx context.Context, bucket, obj string, options *SignedURLOptions) (info *UploadInfo, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "filesystem.SignUpload")
+	defer tracing.FinishSpan(span, &err)
+
+	var contentType string
+	if options != nil {
+		contentType = options.ContentType
+	}
+	url, err := s.signer.Sign(http.MethodPut, bucket, obj, contentType, time.Now().Add(fileSystemSignedURLExpiry))
+	if err != nil {
+		return nil, err
+	}
+	return &UploadInfo{URL: url}, nil
+}
+
+// DeleteObject deletes objects in the given bucket specified by the given query
+func (s *presignedFileSystemStorage) DeleteObject(ctx context.Context, bucket string, query *DeleteObjectQuery) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "filesystem.DeleteObject")
+	defer tracing.FinishSpan(span, &err)
+
+	if query.Name != "" {
+		err = s.store.Delete(bucket, query.Name)
+		if err != nil {
+			log.WithField("bucket", bucket).WithField("object", query.Name).Error(err)
+			return err
+		}
+		return nil
+	}
+	if query.Prefix != "" {
+		var objs []string
+		err = s.store.Walk(bucket, query.Prefix, func(obj string, info fs.FileInfo) error {
+			objs = append(objs, obj)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, obj := range objs {
+			removeErr := s.store.Delete(bucket, obj)
+			if removeErr != nil {
+				err = removeErr
+				log.WithField("bucket", bucket).WithField("object", obj).Error(err)
+			}
+		}
+	}
+	return err
+}
+
+// DeleteBucket deletes a bucket
+func (s *presignedFileSystemStorage) DeleteBucket(ctx context.Context, bucket string) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "filesystem.DeleteBucket")
+	defer tracing.FinishSpan(span, &err)
+
+	return s.store.DeleteBucket(bucket)
+}
+
+// ObjectHash gets a hash value of an object
+func (s *presignedFileSystemStorage) ObjectHash(ctx context.Context, bucket string, obj string) (hash string, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "filesystem.ObjectHash")
+	defer tracing.FinishSpan(span, &err)
+
+	f, _, err := s.store.Open(bucket, obj)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := md5.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// ListOwners lists the owners which have a bucket in the storage root
+func (s *presignedFileSystemStorage) ListOwners(ctx context.Context) (owners []string, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "filesystem.ListOwners")
+	defer tracing.FinishSpan(span, &err)
+
+	bkts, err := s.store.Buckets()
+	if err != nil {
+		return nil, err
+	}
+	prefix := fileSystemBucketName("")
+	for _, bkt := range bkts {
+		if !strings.HasPrefix(bkt, prefix) {
+			continue
+		}
+		owners = append(owners, strings.TrimPrefix(bkt, prefix))
+	}
+	return owners, nil
+}
+
+// ObjectExists tells whether the given object exists or not
+func (s *presignedFileSystemStorage) ObjectExists(ctx context.Context, bucket, obj string) (exists bool, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "filesystem.ObjectExists")
+	defer tracing.FinishSpan(span, &err)
+
+	_, _, err = s.store.Stat(bucket, obj)
+	if err == ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Bucket provides the bucket name for a particular user
+func (s *presignedFileSystemStorage) Bucket(ownerID string) string {
+	return fileSystemBucketName(ownerID)
+}
+
+// BlobObject returns a blob's object name
+func (s *presignedFileSystemStorage) BlobObject(name string) (string, error) {
+	return blobObjectName(name)
+}
+
+// BackupObject returns a backup's object name that a direct downloader would download
+func (s *presignedFileSystemStorage) BackupObject(ownerID string, workspaceID, name string) string {
+	return fileSystemWorkspaceBackupObjectName(workspaceID, name)
+}
+
+// InstanceObject returns a instance's object name that a direct downloader would download
+func (s *presignedFileSystemStorage) InstanceObject(ownerID string, workspaceID string, instanceID string, name string) string {
+	return s.BackupObject(ownerID, workspaceID, InstanceObjectName(instanceID, name))
+}
+
+// fileSystemObjectMeta is the metadata we store alongside each object
+type fileSystemObjectMeta struct {
+	ContentType string            `json:"contentType,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// fileSystemStore maps buckets to directories and objects to files below a root directory.
+// Object metadata is kept in a parallel tree so that it never shows up in listings.
+type fileSystemStore struct {
+	Root string
+}
+
+func validateFileSystemBucketName(bkt string) error {
+	// names starting with a dot are reserved for our own metadata and staging directories
+	if bkt == "" || strings.HasPrefix(bkt, ".") || strings.ContainsAny(bkt, `/\`) {
+		return xerrors.Errorf("invalid bucket name: %q", bkt)
+	}
+	return nil
+}
+
+func (s *fileSystemStore) bucketPath(bkt string) (string, error) {
+	err := validateFileSystemBucketName(bkt)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(s.Root, bkt), nil
+}
+
+func cleanObjectName(obj string) (string, error) {
+	clean := strings.TrimPrefix(path.Clean("/"+obj), "/")
+	if clean == "" {
+		return "", xerrors.Errorf("invalid object name: %q", obj)
+	}
+	return clean, nil
+}
+
+func (s *fileSystemStore) objectPath(bkt, obj string) (string, error) {
+	bp, err := s.bucketPath(bkt)
+	if err != nil {
+		return "", err
+	}
+	obj, err = cleanObjectName(obj)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(bp, filepath.FromSlash(obj)), nil
+}
+
+func (s *fileSystemStore) metaPath(bkt, obj string) (string, error) {
+	_, err := s.bucketPath(bkt)
+	if err != nil {
+		return "", err
+	}
+	obj, err = cleanObjectName(obj)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(s.Root, fileSystemMetaDir, bkt, filepath.FromSlash(obj)+".json"), nil
+}
+
+// EnsureBucket creates the bucket directory if it does not exist yet
+func (s *fileSystemStore) EnsureBucket(bkt string) error {
+	bp, err := s.bucketPath(bkt)
+	if err != nil {
+		return err
+	}
+	return os.MkdirAll(bp, 0755)
+}
+
+// Put atomically stores the content of r as object and records its metadata
+func (s *fileSystemStore) Put(bkt, obj string, r io.Reader, meta fileSystemObjectMeta) (err error) {
+	op, err := s.objectPath(bkt, obj)
+	if err != nil {
+		return err
+	}
+	mp, err := s.metaPath(bkt, obj)
+	if err != nil {
+		return err
+	}
+
+	tmpdir := filepath.Join(s.Root, fileSystemTempDir)
+	err = os.MkdirAll(tmpdir, 0755)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(tmpdir, "upload-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	_, err = io.Copy(tmp, r)
+	if err != nil {
+		return xerrors.Errorf("cannot write object: %w", err)
+	}
+	err = tmp.Sync()
+	if err != nil {
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	for _, p := range []string{op, mp} {
+		err = os.MkdirAll(filepath.Dir(p), 0755)
+		if err != nil {
+			return err
+		}
+	}
+	md, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(mp, md, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), op)
+}
+
+// Stat returns the file info and metadata of an object
+func (s *fileSystemStore) Stat(bkt, obj string) (fs.FileInfo, *fileSystemObjectMeta, error) {
+	op, err := s.objectPath(bkt, obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	stat, err := os.Stat(op)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if stat.IsDir() {
+		return nil, nil, ErrNotFound
+	}
+
+	meta, err := s.readMeta(bkt, obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	return stat, meta, nil
+}
+
+func (s *fileSystemStore) readMeta(bkt, obj string) (*fileSystemObjectMeta, error) {
+	mp, err := s.metaPath(bkt, obj)
+	if err != nil {
+		return nil, err
+	}
+	var meta fileSystemObjectMeta
+	md, err := os.ReadFile(mp)
+	if errors.Is(err, fs.ErrNotExist) {
+		// objects placed in the directory by hand have no metadata
+		return &meta, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(md, &meta)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot unmarshal metadata of %s/%s: %w", bkt, obj, err)
+	}
+	return &meta, nil
+}
+
+// Open opens an object for reading
+func (s *fileSystemStore) Open(bkt, obj string) (*os.File, *fileSystemObjectMeta, error) {
+	_, meta, err := s.Stat(bkt, obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	op, err := s.objectPath(bkt, obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	f, err := os.Open(op)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, meta, nil
+}
+
+// Walk calls fn for every object in the bucket whose name starts with prefix.
+// Walking a bucket that does not exist is not an error.
+func (s *fileSystemStore) Walk(bkt, prefix string, fn func(obj string, info fs.FileInfo) error) error {
+	bp, err := s.bucketPath(bkt)
+	if err != nil {
+		return err
+	}
+
+	err = filepath.WalkDir(bp, func(p string, d fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(bp, p)
+		if err != nil {
+			return err
+		}
+		obj := filepath.ToSlash(rel)
+		if !strings.HasPrefix(obj, strings.TrimPrefix(prefix, "/")) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			// the object was deleted while we were walking
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return fn(obj, info)
+	})
+	return err
+}
+
+// Buckets lists all buckets in the store
+func (s *fileSystemStore) Buckets() ([]string, error) {
+	entries, err := os.ReadDir(s.Root)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var res []string
+	for _, e := range entries {
+		if !e.IsDir() || validateFileSystemBucketName(e.Name()) != nil {
+			continue
+		}
+		res = append(res, e.Name())
+	}
+	return res, nil
+}
+
+// Delete removes an object and its metadata. Deleting a non-existent object is not an error.
+func (s *fileSystemStore) Delete(bkt, obj string) error {
+	op, err := s.objectPath(bkt, obj)
+	if err != nil {
+		return err
+	}
+	mp, err := s.metaPath(bkt, obj)
+	if err != nil {
+		return err
+	}
+	for _, p := range []string{op, mp} {
+		err = os.Remove(p)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteBucket removes a bucket with all its objects
+func (s *fileSystemStore) DeleteBucket(bkt string) error {
+	bp, err := s.bucketPath(bkt)
+	if err != nil {
+		return err
+	}
+	err = os.RemoveAll(bp)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(s.Root, fileSystemMetaDir, bkt))
+}
+
+// fileSystemURLSigner produces and verifies HMAC-signed URLs for filesystem storage objects
+type fileSystemURLSigner struct {
+	BaseURL string
+	Key     []byte
+}
+
+func (s *fileSystemURLSigner) signature(method, bkt, obj, contentType string, expires int64) string {
+	mac := hmac.New(sha256.New, s.Key)
+	fmt.Fprintf(mac, "%s\n%s\n%s\n%s\n%d", method, bkt, obj, contentType, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Sign produces a URL that grants method access to the object until expiry
+func (s *fileSystemURLSigner) Sign(method, bkt, obj, contentType string, expiry time.Time) (string, error) {
+	err := validateFileSystemBucketName(bkt)
+	if err != nil {
+		return "", err
+	}
+	obj, err = cleanObjectName(obj)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(s.BaseURL)
+	if err != nil {
+		return "", err
+	}
+	u.Path = path.Join(u.Path, bkt, obj)
+
+	expires := expiry.Unix()
+	q := url.Values{}
+	q.Set("expires", strconv.FormatInt(expires, 10))
+	if contentType != "" {
+		q.Set("contentType", contentType)
+	}
+	q.Set("signature", s.signature(method, bkt, obj, contentType, expires))
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// Verify checks that the query parameters carry a valid, unexpired signature for the request
+func (s *fileSystemURLSigner) Verify(method, bkt, obj string, query url.Values, now time.Time) error {
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil {
+		return xerrors.Errorf("invalid expiry: %w", err)
+	}
+	if now.Unix() > expires {
+		return xerrors.Errorf("URL has expired")
+	}
+
+	sig, err := hex.DecodeString(query.Get("signature"))
+	if err != nil {
+		return xerrors.Errorf("invalid signature: %w", err)
+	}
+	expected, _ := hex.DecodeString(s.signature(method, bkt, obj, query.Get("contentType"), expires))
+	if !hmac.Equal(sig, expected) {
+		return xerrors.Errorf("invalid signature")
+	}
+	return nil
+}
+
+// NewFileSystemURLHandler produces an HTTP handler which serves the presigned URLs
+// handed out by the filesystem storage.
+func NewFileSystemURLHandler(cfg config.FileSystemConfig) (http.Handler, error) {
+	err := addFileSystemParamsFromMounts(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err = ValidatePresignedFileSystemConfig(&cfg); err != nil {
+		return nil, err
+	}
+
+	base, err := url.Parse(cfg.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+	prefix := strings.TrimSuffix(base.Path, "/")
+
+	h := &fileSystemURLHandler{
+		store:  &fileSystemStore{Root: cfg.Root},
+		signer: &fileSystemURLSigner{BaseURL: strings.TrimSuffix(cfg.BaseURL, "/"), Key: []byte(cfg.SigningKey)},
+	}
+	if prefix == "" {
+		return h, nil
+	}
+	return http.StripPrefix(prefix, h), nil
+}
+
+type fileSystemURLHandler struct {
+	store  *fileSystemStore
+	signer *fileSystemURLSigner
+}
+
+// ServeHTTP serves downloads (GET/HEAD) and uploads (PUT) of filesystem storage objects
+func (h *fileSystemURLHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	segments := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	if len(segments) != 2 {
+		http.Error(w, "invalid object path", http.StatusBadRequest)
+		return
+	}
+	bkt := segments[0]
+	obj, err := cleanObjectName(segments[1])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	method := r.Method
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+	if method != http.MethodGet && method != http.MethodPut {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	err = h.signer.Verify(method, bkt, obj, query, time.Now())
+	if err != nil {
+		log.WithError(err).WithField("bucket", bkt).WithField("object", obj).Debug("rejected filesystem storage request")
+		http.Error(w, "access denied", http.StatusForbidden)
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		h.serveDownload(w, r, bkt, obj)
+	case http.MethodPut:
+		if ct := query.Get("contentType"); ct != "" && r.Header.Get("Content-Type") != ct {
+			http.Error(w, "content type does not match signature", http.StatusForbidden)
+			return
+		}
+		h.serveUpload(w, r, bkt, obj)
+	}
+}
+
+func (h *fileSystemURLHandler) serveDownload(w http.ResponseWriter, r *http.Request, bkt, obj string) {
+	f, meta, err := h.store.Open(bkt, obj)
+	if err == ErrNotFound {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.WithError(err).WithField("bucket", bkt).WithField("object", obj).Error("cannot open filesystem storage object")
+		http.Error(w, "cannot open object", http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		http.Error(w, "cannot stat object", http.StatusInternalServerError)
+		return
+	}
+	if meta.ContentType != "" {
+		w.Header().Set("Content-Type", meta.ContentType)
+	}
+	http.ServeContent(w, r, path.Base(obj), stat.ModTime(), f)
+}
+
+func (h *fileSystemURLHandler) serveUpload(w http.ResponseWriter, r *http.Request, bkt, obj string) {
+	defer r.Body.Close()
+
+	err := h.store.EnsureBucket(bkt)
+	if err != nil {
+		log.WithError(err).WithField("bucket", bkt).Error("cannot create filesystem storage bucket")
+		http.Error(w, "cannot create bucket", http.StatusInternalServerError)
+		return
+	}
+
+	err = h.store.Put(bkt, obj, r.Body, fileSystemObjectMeta{ContentType: r.Header.Get("Content-Type")})
+	if err != nil {
+		log.WithError(err).WithField("bucket", bkt).WithField("object", obj).Error("cannot store filesystem storage object")
+		http.Error(w, "cannot store object", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
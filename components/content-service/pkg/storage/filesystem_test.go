@@ -0,0 +1,297 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	config "github.com/gitpod-io/gitpod/content-service/api/config"
+)
+
+func newTestFileSystemStorage(t *testing.T) (*presignedFileSystemStorage, config.FileSystemConfig) {
+	cfg := config.FileSystemConfig{
+		Root:       t.TempDir(),
+		SigningKey: "not-so-secret",
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "handler not set up", http.StatusInternalServerError)
+	}))
+	t.Cleanup(srv.Close)
+	cfg.BaseURL = srv.URL + "/storage"
+
+	handler, err := NewFileSystemURLHandler(cfg)
+	if err != nil {
+		t.Fatalf("cannot create handler: %v", err)
+	}
+	srv.Config.Handler = handler
+
+	s, err := newPresignedFileSystemAccess(cfg)
+	if err != nil {
+		t.Fatalf("cannot create presigned filesystem access: %v", err)
+	}
+	return s, cfg
+}
+
+func TestFileSystemPresignedRoundtrip(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestFileSystemStorage(t)
+	bkt := s.Bucket("owner")
+
+	err := s.EnsureExists(ctx, bkt)
+	if err != nil {
+		t.Fatalf("cannot ensure bucket exists: %v", err)
+	}
+
+	content := []byte("hello world")
+	upload, err := s.SignUpload(ctx, bkt, "blobs/foo/bar", &SignedURLOptions{ContentType: "text/plain"})
+	if err != nil {
+		t.Fatalf("cannot sign upload: %v", err)
+	}
+
+	req, _ := http.NewRequest(http.MethodPut, upload.URL, bytes.NewReader(content))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("cannot upload: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("upload with wrong content type: expected status %d, got %d", http.StatusForbidden, resp.StatusCode)
+	}
+
+	req, _ = http.NewRequest(http.MethodPut, upload.URL, bytes.NewReader(content))
+	req.Header.Set("Content-Type", "text/plain")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("cannot upload: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("upload: unexpected status %d", resp.StatusCode)
+	}
+
+	exists, err := s.ObjectExists(ctx, bkt, "blobs/foo/bar")
+	if err != nil || !exists {
+		t.Fatalf("uploaded object does not exist: %v", err)
+	}
+
+	download, err := s.SignDownload(ctx, bkt, "blobs/foo/bar", &SignedURLOptions{})
+	if err != nil {
+		t.Fatalf("cannot sign download: %v", err)
+	}
+	if download.Size != int64(len(content)) {
+		t.Errorf("unexpected size: %d", download.Size)
+	}
+	if download.Meta.ContentType != "text/plain" {
+		t.Errorf("unexpected content type: %s", download.Meta.ContentType)
+	}
+
+	resp, err = http.Get(download.URL)
+	if err != nil {
+		t.Fatalf("cannot download: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("download: unexpected status %d", resp.StatusCode)
+	}
+	if diff := cmp.Diff(content, body); diff != "" {
+		t.Errorf("unexpected content (-want +got):\n%s", diff)
+	}
+
+	_, err = s.SignDownload(ctx, bkt, "does-not-exist", nil)
+	if err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestFileSystemURLVerification(t *testing.T) {
+	s, _ := newTestFileSystemStorage(t)
+	bkt := s.Bucket("owner")
+
+	tests := []struct {
+		Name   string
+		URL    func() string
+		Method string
+		Status int
+	}{
+		{
+			Name: "expired",
+			URL: func() string {
+				u, _ := s.signer.Sign(http.MethodGet, bkt, "obj", "", time.Now().Add(-time.Minute))
+				return u
+			},
+			Method: http.MethodGet,
+			Status: http.StatusForbidden,
+		},
+		{
+			Name: "tampered object",
+			URL: func() string {
+				u, _ := s.signer.Sign(http.MethodGet, bkt, "obj", "", time.Now().Add(time.Minute))
+				return strings.Replace(u, "/obj?", "/other?", 1)
+			},
+			Method: http.MethodGet,
+			Status: http.StatusForbidden,
+		},
+		{
+			Name: "download URL used for upload",
+			URL: func() string {
+				u, _ := s.signer.Sign(http.MethodGet, bkt, "obj", "", time.Now().Add(time.Minute))
+				return u
+			},
+			Method: http.MethodPut,
+			Status: http.StatusForbidden,
+		},
+		{
+			Name: "valid but missing",
+			URL: func() string {
+				u, _ := s.signer.Sign(http.MethodGet, bkt, "obj", "", time.Now().Add(time.Minute))
+				return u
+			},
+			Method: http.MethodGet,
+			Status: http.StatusNotFound,
+		},
+	}
+
+	_, err := s.signer.Sign(http.MethodGet, "..", "obj", "", time.Now().Add(time.Minute))
+	if err == nil {
+		t.Errorf("expected signing an invalid bucket name to fail")
+	}
+	p, err := s.store.objectPath(bkt, "../../obj")
+	if err != nil || p != filepath.Join(s.store.Root, bkt, "obj") {
+		t.Errorf("object path escapes its bucket: %s (%v)", p, err)
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			req, _ := http.NewRequest(test.Method, test.URL(), bytes.NewReader(nil))
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != test.Status {
+				t.Errorf("unexpected status: is %d but expected %d", resp.StatusCode, test.Status)
+			}
+		})
+	}
+}
+
+func TestFileSystemDirectAccess(t *testing.T) {
+	ctx := context.Background()
+	s, cfg := newTestFileSystemStorage(t)
+
+	rs, err := newDirectFileSystemAccess(cfg)
+	if err != nil {
+		t.Fatalf("cannot create direct filesystem access: %v", err)
+	}
+	err = rs.Init(ctx, "owner", "workspace", "instance")
+	if err != nil {
+		t.Fatalf("cannot init direct filesystem access: %v", err)
+	}
+	err = rs.EnsureExists(ctx)
+	if err != nil {
+		t.Fatalf("cannot ensure bucket exists: %v", err)
+	}
+
+	src := filepath.Join(t.TempDir(), "backup.tar")
+	err = os.WriteFile(src, []byte("0123456789"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{DefaultBackup, "wsfull-1.tar"} {
+		bkt, obj, err := rs.Upload(ctx, src, name, WithAnnotations(map[string]string{ObjectAnnotationDigest: "sha256:abc"}))
+		if err != nil {
+			t.Fatalf("cannot upload %s: %v", name, err)
+		}
+		if bkt != s.Bucket("owner") || obj != s.BackupObject("owner", "workspace", name) {
+			t.Errorf("direct and presigned access disagree on naming: %s/%s", bkt, obj)
+		}
+	}
+	_, _, err = rs.UploadInstance(ctx, src, "log.txt")
+	if err != nil {
+		t.Fatalf("cannot upload instance object: %v", err)
+	}
+
+	objs, err := rs.ListObjects(ctx, "workspaces/workspace/")
+	if err != nil {
+		t.Fatalf("cannot list objects: %v", err)
+	}
+	sort.Strings(objs)
+	expectation := []string{
+		"workspaces/workspace/full.tar",
+		"workspaces/workspace/instances/instance/log.txt",
+		"workspaces/workspace/wsfull-1.tar",
+	}
+	if diff := cmp.Diff(expectation, objs); diff != "" {
+		t.Errorf("unexpected objects (-want +got):\n%s", diff)
+	}
+
+	bkt := rs.Bucket("owner")
+	usage, err := s.DiskUsage(ctx, bkt, "workspaces/")
+	if err != nil {
+		t.Fatalf("cannot compute disk usage: %v", err)
+	}
+	if usage != 30 {
+		t.Errorf("unexpected disk usage: %d", usage)
+	}
+
+	info, err := s.SignDownload(ctx, bkt, rs.BackupObject(DefaultBackup), nil)
+	if err != nil {
+		t.Fatalf("cannot sign download: %v", err)
+	}
+	if info.Meta.Digest != "sha256:abc" {
+		t.Errorf("annotations were not preserved: %+v", info.Meta)
+	}
+
+	hash, err := s.ObjectHash(ctx, bkt, rs.BackupObject(DefaultBackup))
+	if err != nil {
+		t.Fatalf("cannot compute object hash: %v", err)
+	}
+	if hash != "781e5e245d69b566979b86e28d23f2c7" {
+		t.Errorf("unexpected object hash: %s", hash)
+	}
+
+	err = s.DeleteObject(ctx, bkt, &DeleteObjectQuery{Prefix: "workspaces/workspace/instances/"})
+	if err != nil {
+		t.Fatalf("cannot delete objects: %v", err)
+	}
+	err = s.DeleteObject(ctx, bkt, &DeleteObjectQuery{Name: rs.BackupObject("wsfull-1.tar")})
+	if err != nil {
+		t.Fatalf("cannot delete object: %v", err)
+	}
+	objs, err = rs.ListObjects(ctx, "")
+	if err != nil {
+		t.Fatalf("cannot list objects: %v", err)
+	}
+	if diff := cmp.Diff([]string{"workspaces/workspace/full.tar"}, objs); diff != "" {
+		t.Errorf("unexpected objects after delete (-want +got):\n%s", diff)
+	}
+
+	err = s.DeleteBucket(ctx, bkt)
+	if err != nil {
+		t.Fatalf("cannot delete bucket: %v", err)
+	}
+	objs, err = rs.ListObjects(ctx, "")
+	if err != nil {
+		t.Fatalf("cannot list objects of deleted bucket: %v", err)
+	}
+	if len(objs) != 0 {
+		t.Errorf("expected no objects after bucket deletion, got %v", objs)
+	}
+}
@@ -84,6 +84,8 @@ type DirectGCPStorage struct {
 
 	// ObjectAccess just exists so that we can swap out the stream access during testing
 	ObjectAccess func(ctx context.Context, btk, obj string) (io.ReadCloser, bool, error)
+
+	rangeDownload
 }
 
 // Validate checks if the GCloud storage is GCPconfigured properly
@@ -168,17 +170,35 @@ func gcpEnsureExists(ctx context.Context, client *gcpstorage.Client, bucketName
 }
 
 func (rs *DirectGCPStorage) defaultObjectAccess(ctx context.Context, bkt, obj string) (io.ReadCloser, bool, error) {
+	_, rc, err := rs.openObject(ctx, bkt, obj)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return rc, false, nil
+}
+
+// openObject opens an object for reading in parallel byte ranges
+func (rs *DirectGCPStorage) openObject(ctx context.Context, bkt, obj string) (*ObjectMeta, io.ReadCloser, error) {
 	if rs.client == nil {
-		return nil, false, xerrors.Errorf("no gcloud client available - did you call Init()?")
+		return nil, nil, xerrors.Errorf("no gcloud client available - did you call Init()?")
 	}
 
 	objHandle := rs.client.Bucket(bkt).Object(obj)
-	rc, err := objHandle.NewReader(ctx)
+	attrs, err := objHandle.Attrs(ctx)
+	if errors.Is(err, gcpstorage.ErrObjectNotExist) || errors.Is(err, gcpstorage.ErrBucketNotExist) {
+		return nil, nil, ErrNotFound
+	}
 	if err != nil {
-		return nil, false, err
+		return nil, nil, err
 	}
 
-	return rc, false, nil
+	// all ranges must stem from the same generation, lest an object which is overwritten during the download is mixed from two generations
+	objHandle = objHandle.Generation(attrs.Generation)
+	rc := rs.openRanges(ctx, attrs.Size, func(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+		return objHandle.NewRangeReader(ctx, offset, length)
+	})
+	return gcpObjectMeta(attrs), rc, nil
 }
 
 func (rs *DirectGCPStorage) download(ctx context.Context, destination string, bkt string, obj string, mappings []archive.IDMapping) (found bool, err error) {
@@ -188,52 +208,16 @@ func (rs *DirectGCPStorage) download(ctx context.Context, destination string, bk
 	span.SetTag("gcsObj", obj)
 	defer tracing.FinishSpan(span, &err)
 
-	backupDir, err := os.MkdirTemp("", "backup-")
-	if err != nil {
-		return true, err
+	meta, rc, err := rs.openObject(ctx, bkt, obj)
+	if err == ErrNotFound {
+		return false, nil
 	}
-	defer os.RemoveAll(backupDir)
-
-	var wg sync.WaitGroup
-
-	wg.Add(1)
-	backupSpan := opentracing.StartSpan("downloadBackup", opentracing.ChildOf(span.Context()))
-
-	go func() {
-		defer wg.Done()
-
-		sa := ""
-		if rs.GCPConfig.CredentialsFile != "" {
-			sa = fmt.Sprintf(`-o "Credentials:gs_service_key_file=%v"`, rs.GCPConfig.CredentialsFile)
-		}
-
-		args := fmt.Sprintf(`gsutil -q -m %v\
-		  -o "GSUtil:sliced_object_download_max_components=8" \
-		  -o "GSUtil:parallel_thread_count=1" \
-		  cp gs://%s %s`, sa, filepath.Join(bkt, obj), backupDir)
-
-		log.WithField("flags", args).Debug("gsutil flags")
-
-		cmd := exec.Command("/bin/bash", []string{"-c", args}...)
-		var out []byte
-		out, err = cmd.CombinedOutput()
-		if err != nil {
-			log.WithError(err).WithField("out", string(out)).Error("unexpected error downloading file to GCS using gsutil")
-			err = xerrors.Errorf("unexpected error downloading backup")
-			return
-		}
-	}()
-
-	wg.Wait()
-	tracing.FinishSpan(backupSpan, &err)
-
-	rc, err := os.Open(filepath.Join(backupDir, obj))
 	if err != nil {
-		return true, err
+		return false, err
 	}
 	defer rc.Close()
 
-	err = extractTarbal(ctx, destination, rc, mappings)
+	err = extractVerifiedTarbal(ctx, destination, rc, meta.Digest, mappings)
 	if err != nil {
 		return true, err
 	}
@@ -301,6 +285,58 @@ func (rs *DirectGCPStorage) DownloadSnapshot(ctx context.Context, destination st
 	return rs.download(ctx, destination, bkt, obj, mappings)
 }
 
+// OpenObject opens a backup object for reading. The name is either a backup name or one produced by Qualify.
+func (rs *DirectGCPStorage) OpenObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	bkt, obj, err := splitObjectName(name, rs.bucketName(), rs.objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	rc, _, err := rs.ObjectAccess(ctx, bkt, obj)
+	if errors.Is(err, gcpstorage.ErrObjectNotExist) || errors.Is(err, gcpstorage.ErrBucketNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rc, nil
+}
+
+// objectMeta reads the metadata of a backup object. The name is either a backup name or one produced by Qualify.
+func (rs *DirectGCPStorage) objectMeta(ctx context.Context, name string) (*ObjectMeta, error) {
+	if rs.client == nil {
+		return nil, xerrors.Errorf("no gcloud client available - did you call Init()?")
+	}
+
+	bkt, obj, err := splitObjectName(name, rs.bucketName(), rs.objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs, err := rs.client.Bucket(bkt).Object(obj).Attrs(ctx)
+	if errors.Is(err, gcpstorage.ErrObjectNotExist) || errors.Is(err, gcpstorage.ErrBucketNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gcpObjectMeta(attrs), nil
+}
+
+// gcpObjectMeta reads our annotations from the metadata of a GCS object
+func gcpObjectMeta(attrs *gcpstorage.ObjectAttrs) *ObjectMeta {
+	return &ObjectMeta{
+		ContentType:        attrs.ContentType,
+		OCIMediaType:       attrs.Metadata[ObjectAnnotationOCIContentType],
+		Digest:             attrs.Metadata[ObjectAnnotationDigest],
+		UncompressedDigest: attrs.Metadata[ObjectAnnotationUncompressedDigest],
+		Encryption:         attrs.Metadata[ObjectAnnotationEncryption],
+		KeyOwner:           attrs.Metadata[ObjectAnnotationKeyOwner],
+		WrappedKey:         attrs.Metadata[ObjectAnnotationWrappedKey],
+		Prebuild:           parsePrebuildAnnotation(attrs.Metadata[ObjectAnnotationPrebuild]),
+	}
+}
+
 // ParseSnapshotName parses the name of a snapshot into bucket and object
 func ParseSnapshotName(name string) (bkt, obj string, err error) {
 	segments := strings.Split(name, "@")
@@ -578,6 +614,39 @@ func (p *PresignedGCPStorage) DiskUsage(ctx context.Context, bucket string, pref
 	return total, nil
 }
 
+// ListObjects describes all objects in the given bucket whose name has the given prefix
+func (p *PresignedGCPStorage) ListObjects(ctx context.Context, bucket string, prefix string) (objects []ObjectInfo, err error) {
+	client, err := newGCPClient(ctx, p.config)
+	if err != nil {
+		return
+	}
+	//nolint:staticcheck
+	defer client.Close()
+
+	it := client.Bucket(bucket).Objects(ctx, &gcpstorage.Query{
+		Prefix: prefix,
+	})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if errors.Is(err, gcpstorage.ErrBucketNotExist) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, ObjectInfo{
+			Name:         attrs.Name,
+			Size:         attrs.Size,
+			LastModified: attrs.Updated,
+		})
+	}
+
+	return objects, nil
+}
+
 // SignDownload provides presigned URLs to access remote storage objects
 func (p *PresignedGCPStorage) SignDownload(ctx context.Context, bucket, object string, options *SignedURLOptions) (*DownloadInfo, error) {
 	client, err := newGCPClient(ctx, p.config)
@@ -613,12 +682,7 @@ func (p *PresignedGCPStorage) SignDownload(ctx context.Context, bucket, object s
 }
 
 func (p *PresignedGCPStorage) downloadInfo(ctx context.Context, client *gcpstorage.Client, obj *gcpstorage.ObjectAttrs, options *SignedURLOptions) (*DownloadInfo, error) {
-	meta := &ObjectMeta{
-		ContentType:        obj.ContentType,
-		OCIMediaType:       obj.Metadata[ObjectAnnotationOCIContentType],
-		Digest:             obj.Metadata[ObjectAnnotationDigest],
-		UncompressedDigest: obj.Metadata[ObjectAnnotationUncompressedDigest],
-	}
+	meta := gcpObjectMeta(obj)
 	url, err := gcpstorage.SignedURL(obj.Bucket, obj.Name, &gcpstorage.SignedURLOptions{
 		Method:         "GET",
 		GoogleAccessID: p.accessID,
@@ -805,3 +869,28 @@ func (p *PresignedGCPStorage) BackupObject(ownerID string, workspaceID string, n
 func (p *PresignedGCPStorage) InstanceObject(ownerID string, workspaceID string, instanceID string, name string) string {
 	return p.BackupObject(ownerID, workspaceID, InstanceObjectName(instanceID, name))
 }
+
+// ListOwners lists the owners which have a bucket in the project
+func (p *PresignedGCPStorage) ListOwners(ctx context.Context) (owners []string, err error) {
+	client, err := newGCPClient(ctx, p.config)
+	if err != nil {
+		return nil, err
+	}
+	//nolint:staticcheck
+	defer client.Close()
+
+	prefix := gcpBucketName(p.stage, "")
+	it := client.Buckets(ctx, p.config.Project)
+	it.Prefix = prefix
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		owners = append(owners, strings.TrimPrefix(attrs.Name, prefix))
+	}
+	return owners, nil
+}
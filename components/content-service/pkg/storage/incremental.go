@@ -0,0 +1,405 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	digest "github.com/opencontainers/go-digest"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+const (
+	// IncrementalBackupManifest is the name of the manifest of incremental workspace backups
+	IncrementalBackupManifest = "wsincremental.json"
+
+	// ChunkPrefix is the prefix of the backup objects incremental backups are made of
+	ChunkPrefix = "chunks/"
+
+	// maxManifestSize limits how much we read when looking for a manifest
+	maxManifestSize = 64 * 1024 * 1024
+)
+
+// ObjectOpener provides read access to individual remote storage objects
+type ObjectOpener interface {
+	// OpenObject opens an object for reading. The name is either a backup name as passed to Download,
+	// or a fully qualified name as produced by Qualify. Returns ErrNotFound if the object does not exist.
+	OpenObject(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
+// ChunkObject returns the backup name of the chunk with the given digest
+func ChunkObject(dgst digest.Digest) string {
+	return ChunkPrefix + dgst.Algorithm().String() + "/" + dgst.Encoded()
+}
+
+// splitObjectName resolves a name as passed to ObjectOpener.OpenObject into bucket and object
+func splitObjectName(name string, bkt string, objectName func(string) string) (string, string, error) {
+	if strings.Contains(name, "@") {
+		return ParseSnapshotName(name)
+	}
+	return bkt, objectName(name), nil
+}
+
+// UploadIncremental splits a tar stream into content-defined chunks and uploads the chunks which are not already part of
+// the previous manifest. We deliberately don't reuse chunks which merely exist in the bucket: unreferenced chunks are
+// subject to garbage collection and might be gone by the time our manifest references them.
+// Returns the new generation, which the caller needs to add to a manifest and upload.
+func UploadIncremental(ctx context.Context, rs DirectAccess, tmpdir string, src io.Reader, previous *csapi.WorkspaceContentManifest, compression archive.Compression, level int) (gen *csapi.WorkspaceContentGeneration, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UploadIncremental")
+	defer tracing.FinishSpan(span, &err)
+
+	gen = &csapi.WorkspaceContentGeneration{Generation: 1}
+	known := make(map[digest.Digest]string)
+	if previous != nil {
+		for _, g := range previous.Generations {
+			for _, c := range g.Chunks {
+				known[c.Digest] = c.Object
+			}
+		}
+		if latest := previous.LatestGeneration(); latest != nil {
+			gen.Generation = latest.Generation + 1
+		}
+	}
+
+	var (
+		diffID   = digest.Canonical.Digester()
+		chunker  = archive.NewChunker(io.TeeReader(src, diffID.Hash()))
+		uploaded int
+	)
+	for {
+		chunk, err := chunker.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, xerrors.Errorf("cannot read backup: %w", err)
+		}
+
+		dgst := digest.FromBytes(chunk)
+		obj, exists := known[dgst]
+		if !exists {
+			obj, err = uploadChunk(ctx, rs, tmpdir, dgst, chunk, compression, level)
+			if err != nil {
+				return nil, xerrors.Errorf("cannot upload chunk %s: %w", dgst, err)
+			}
+			known[dgst] = obj
+			uploaded++
+		}
+
+		gen.Chunks = append(gen.Chunks, csapi.WorkspaceContentChunk{
+			Digest: dgst,
+			Size:   int64(len(chunk)),
+			Object: obj,
+		})
+		gen.Size += int64(len(chunk))
+	}
+	gen.DiffID = diffID.Digest()
+
+	span.SetTag("chunks", len(gen.Chunks))
+	span.SetTag("uploadedChunks", uploaded)
+	log.WithField("generation", gen.Generation).WithField("chunks", len(gen.Chunks)).WithField("uploadedChunks", uploaded).Debug("uploaded incremental backup")
+
+	return gen, nil
+}
+
+func uploadChunk(ctx context.Context, rs DirectAccess, tmpdir string, dgst digest.Digest, chunk []byte, compression archive.Compression, level int) (obj string, err error) {
+	tmpf, err := os.CreateTemp(tmpdir, "chunk-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmpf.Name())
+
+	compressedDigest := digest.Canonical.Digester()
+	w, err := archive.Compress(io.MultiWriter(tmpf, compressedDigest.Hash()), compression, level)
+	if err != nil {
+		tmpf.Close()
+		return "", err
+	}
+	_, err = w.Write(chunk)
+	if err == nil {
+		err = w.Close()
+	}
+	if cerr := tmpf.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return "", err
+	}
+
+	name := ChunkObject(dgst)
+	_, _, err = rs.Upload(ctx, tmpf.Name(), name,
+		WithAnnotations(map[string]string{
+			ObjectAnnotationDigest:             compressedDigest.Digest().String(),
+			ObjectAnnotationUncompressedDigest: dgst.String(),
+			ObjectAnnotationCompression:        string(compression),
+		}),
+		WithContentType(compression.ContentType()),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return rs.Qualify(name), nil
+}
+
+// ReadIncrementalManifest reads an incremental backup manifest. Returns ErrNotFound if the object does not exist,
+// and a nil manifest if the object exists but is not an incremental backup manifest, e.g. because it's a tar file.
+func ReadIncrementalManifest(ctx context.Context, opener ObjectOpener, name string) (mf *csapi.WorkspaceContentManifest, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ReadIncrementalManifest")
+	span.SetTag("name", name)
+	defer func() {
+		lerr := err
+		if lerr == ErrNotFound {
+			span.LogKV("found", false)
+			lerr = nil
+		}
+		tracing.FinishSpan(span, &lerr)
+	}()
+
+	rc, err := opener.OpenObject(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	br := bufio.NewReader(io.LimitReader(rc, maxManifestSize))
+	hdr, err := br.Peek(1)
+	if err == io.EOF || (err == nil && hdr[0] != '{') {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var res csapi.WorkspaceContentManifest
+	err = json.NewDecoder(br).Decode(&res)
+	if err != nil {
+		// tar files can start with a curly brace, too
+		log.WithError(err).WithField("name", name).Debug("object is not a manifest")
+		return nil, nil
+	}
+	if res.Type != csapi.TypeIncrementalWorkspaceContentV1 {
+		return nil, nil
+	}
+	return &res, nil
+}
+
+// DownloadIncremental restores the latest generation of an incremental backup to destination.
+// Each chunk is verified against its digest before it's extracted.
+func DownloadIncremental(ctx context.Context, opener ObjectOpener, mf *csapi.WorkspaceContentManifest, destination string, mappings []archive.IDMapping) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DownloadIncremental")
+	defer tracing.FinishSpan(span, &err)
+
+	gen := mf.LatestGeneration()
+	if gen == nil {
+		return xerrors.Errorf("incremental backup has no generations")
+	}
+	span.SetTag("generation", gen.Generation)
+	span.SetTag("chunks", len(gen.Chunks))
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeChunks(ctx, opener, gen, pw))
+	}()
+	defer pr.Close()
+
+	err = extractTarbal(ctx, destination, pr, mappings)
+	if err != nil {
+		return err
+	}
+
+	// tar might stop reading before the end of the stream, but we still want to know the stream was intact
+	_, err = io.Copy(io.Discard, pr)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func writeChunks(ctx context.Context, opener ObjectOpener, gen *csapi.WorkspaceContentGeneration, w io.Writer) error {
+	diffID := digest.Canonical.Digester()
+	w = io.MultiWriter(w, diffID.Hash())
+
+	for _, chunk := range gen.Chunks {
+		err := writeChunk(ctx, opener, chunk, w)
+		if err != nil {
+			return xerrors.Errorf("chunk %s: %w", chunk.Digest, err)
+		}
+	}
+
+	if gen.DiffID != "" && diffID.Digest() != gen.DiffID {
+		return xerrors.Errorf("backup digest mismatch: expected %s, got %s", gen.DiffID, diffID.Digest())
+	}
+	return nil
+}
+
+func writeChunk(ctx context.Context, opener ObjectOpener, chunk csapi.WorkspaceContentChunk, w io.Writer) error {
+	err := chunk.Digest.Validate()
+	if err != nil {
+		return err
+	}
+
+	rc, err := opener.OpenObject(ctx, chunk.Object)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	r, _, err := archive.Decompress(rc)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	// we must not pass on unverified content, hence we buffer the chunk before writing it
+	verifier := chunk.Digest.Verifier()
+	content, err := io.ReadAll(io.TeeReader(io.LimitReader(r, chunk.Size+1), verifier))
+	if err != nil {
+		return err
+	}
+	if int64(len(content)) != chunk.Size || !verifier.Verified() {
+		return xerrors.Errorf("content does not match digest")
+	}
+
+	_, err = w.Write(content)
+	return err
+}
+
+// ChunkGCResult summarises a chunk garbage collection run
+type ChunkGCResult struct {
+	DeletedChunks int
+	FreedBytes    int64
+}
+
+// CollectChunkGarbage deletes the chunks of a workspace which are no longer referenced by any of its
+// incremental backup manifests. Chunks younger than gracePeriod are kept, as they might belong to a backup
+// whose manifest hasn't been uploaded yet. The key provider decrypts encrypted manifests and may be nil if
+// encryption is disabled.
+func CollectChunkGarbage(ctx context.Context, s PresignedAccess, keys KeyProvider, ownerID, workspaceID string, gracePeriod time.Duration) (res *ChunkGCResult, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CollectChunkGarbage")
+	span.SetTag("owner", ownerID)
+	span.SetTag("workspace", workspaceID)
+	defer tracing.FinishSpan(span, &err)
+
+	var (
+		bkt         = s.Bucket(ownerID)
+		prefix      = s.BackupObject(ownerID, workspaceID, "")
+		chunkPrefix = s.BackupObject(ownerID, workspaceID, ChunkPrefix)
+	)
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	objs, err := s.ListObjects(ctx, bkt, prefix)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list objects: %w", err)
+	}
+
+	var (
+		referenced = make(map[string]struct{})
+		chunks     []ObjectInfo
+	)
+	for _, obj := range objs {
+		if strings.HasPrefix(obj.Name, chunkPrefix) {
+			chunks = append(chunks, obj)
+			continue
+		}
+		if !strings.HasSuffix(obj.Name, ".json") {
+			continue
+		}
+
+		// We must not delete chunks if we don't know all manifests, hence any error here is fatal.
+		mf, err := downloadIncrementalManifest(ctx, s, keys, bkt, obj.Name)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot read manifest %s: %w", obj.Name, err)
+		}
+		if mf == nil {
+			continue
+		}
+		for _, gen := range mf.Generations {
+			for _, chunk := range gen.Chunks {
+				cbkt, cobj, err := ParseSnapshotName(chunk.Object)
+				if err != nil {
+					return nil, xerrors.Errorf("manifest %s: %w", obj.Name, err)
+				}
+				if cbkt == bkt {
+					referenced[cobj] = struct{}{}
+				}
+			}
+		}
+	}
+
+	res = &ChunkGCResult{}
+	for _, chunk := range chunks {
+		if _, ok := referenced[chunk.Name]; ok {
+			continue
+		}
+		if time.Since(chunk.LastModified) < gracePeriod {
+			continue
+		}
+
+		err = s.DeleteObject(ctx, bkt, &DeleteObjectQuery{Name: chunk.Name})
+		if err != nil && err != ErrNotFound {
+			return res, xerrors.Errorf("cannot delete chunk %s: %w", chunk.Name, err)
+		}
+		res.DeletedChunks++
+		res.FreedBytes += chunk.Size
+	}
+	span.SetTag("deletedChunks", res.DeletedChunks)
+	span.SetTag("freedBytes", res.FreedBytes)
+
+	return res, nil
+}
+
+// downloadIncrementalManifest reads an incremental backup manifest using a presigned URL.
+// Returns nil if the object isn't an incremental backup manifest.
+func downloadIncrementalManifest(ctx context.Context, s PresignedAccess, keys KeyProvider, bkt, obj string) (*csapi.WorkspaceContentManifest, error) {
+	info, err := s.SignDownload(ctx, bkt, obj, &SignedURLOptions{})
+	if err == ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if info.Meta.ContentType != csapi.ContentTypeManifest {
+		return nil, nil
+	}
+
+	mf, err := ReadIncrementalManifest(ctx, &signedObjectOpener{Keys: keys, Name: obj, Info: info}, obj)
+	if err == ErrNotFound {
+		return nil, nil
+	}
+	return mf, err
+}
+
+// signedObjectOpener opens a single object described by SignDownload
+type signedObjectOpener struct {
+	Keys KeyProvider
+	Name string
+	Info *DownloadInfo
+}
+
+// OpenObject opens the object if it has the opener's name
+func (o *signedObjectOpener) OpenObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	if name != o.Name {
+		return nil, ErrNotFound
+	}
+	return OpenSignedObject(ctx, o.Keys, o.Info)
+}
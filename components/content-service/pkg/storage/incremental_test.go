@@ -0,0 +1,191 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+func buildTestTarbal(t *testing.T, files map[string][]byte) []byte {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for name, content := range files {
+		err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = tw.Write(content)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := tw.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func uploadTestManifest(t *testing.T, rs DirectAccess, name string, mf *csapi.WorkspaceContentManifest) {
+	fc, err := json.Marshal(mf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fn := filepath.Join(t.TempDir(), "manifest.json")
+	err = os.WriteFile(fn, fc, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = rs.Upload(context.Background(), fn, name, WithContentType(csapi.ContentTypeManifest))
+	if err != nil {
+		t.Fatalf("cannot upload manifest: %v", err)
+	}
+}
+
+func TestIncrementalBackup(t *testing.T) {
+	ctx := context.Background()
+	s, cfg := newTestFileSystemStorage(t)
+	rs, err := newDirectFileSystemAccess(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rs.Init(ctx, "owner", "workspace", "instance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rs.EnsureExists(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	large := make([]byte, 16*1024*1024)
+	rand.New(rand.NewSource(42)).Read(large)
+	listChunks := func() []string {
+		objs, err := rs.ListObjects(ctx, rs.BackupObject(ChunkPrefix))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return objs
+	}
+
+	// first generation
+	gen1, err := UploadIncremental(ctx, rs, t.TempDir(), bytes.NewReader(buildTestTarbal(t, map[string][]byte{"large.bin": large})), nil, archive.Zstd, 0)
+	if err != nil {
+		t.Fatalf("cannot upload first generation: %v", err)
+	}
+	if gen1.Generation != 1 || len(gen1.Chunks) < 2 {
+		t.Fatalf("unexpected first generation: generation %d with %d chunks", gen1.Generation, len(gen1.Chunks))
+	}
+	mf := &csapi.WorkspaceContentManifest{Type: csapi.TypeIncrementalWorkspaceContentV1, Generations: []csapi.WorkspaceContentGeneration{*gen1}}
+	uploadTestManifest(t, rs, IncrementalBackupManifest, mf)
+	chunksAfterGen1 := len(listChunks())
+
+	// second generation only changes a small file, hence must reuse most chunks
+	gen2, err := UploadIncremental(ctx, rs, t.TempDir(), bytes.NewReader(buildTestTarbal(t, map[string][]byte{"large.bin": large, "small.txt": []byte("hello world")})), mf, archive.Zstd, 0)
+	if err != nil {
+		t.Fatalf("cannot upload second generation: %v", err)
+	}
+	if gen2.Generation != 2 {
+		t.Errorf("unexpected generation: %d", gen2.Generation)
+	}
+	if added := len(listChunks()) - chunksAfterGen1; added >= len(gen2.Chunks) || added > 3 {
+		t.Errorf("second generation uploaded %d of %d chunks", added, len(gen2.Chunks))
+	}
+	mf.Generations = append(mf.Generations, *gen2)
+	uploadTestManifest(t, rs, IncrementalBackupManifest, mf)
+
+	// restore
+	restored, err := ReadIncrementalManifest(ctx, rs, IncrementalBackupManifest)
+	if err != nil || restored == nil {
+		t.Fatalf("cannot read manifest: %v", err)
+	}
+	dst := t.TempDir()
+	err = DownloadIncremental(ctx, rs, restored, dst, nil)
+	if err != nil {
+		t.Fatalf("cannot restore backup: %v", err)
+	}
+	content, err := os.ReadFile(filepath.Join(dst, "small.txt"))
+	if err != nil || string(content) != "hello world" {
+		t.Errorf("unexpected content of small.txt: %q (%v)", content, err)
+	}
+	content, err = os.ReadFile(filepath.Join(dst, "large.bin"))
+	if err != nil || !bytes.Equal(content, large) {
+		t.Errorf("large.bin was not restored correctly: %v", err)
+	}
+
+	// a tar file is not a manifest
+	src := filepath.Join(t.TempDir(), "backup.tar")
+	err = os.WriteFile(src, buildTestTarbal(t, map[string][]byte{"foo": []byte("bar")}), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = rs.Upload(ctx, src, DefaultBackup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mf, err := ReadIncrementalManifest(ctx, rs, DefaultBackup); mf != nil || err != nil {
+		t.Errorf("expected tar file not to be a manifest: %v, %v", mf, err)
+	}
+	if _, err := ReadIncrementalManifest(ctx, rs, "does-not-exist.json"); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	// corrupted chunks must be detected
+	corrupted := *restored
+	corrupted.Generations = []csapi.WorkspaceContentGeneration{*gen2}
+	corrupted.Generations[0].Chunks = append([]csapi.WorkspaceContentChunk(nil), gen2.Chunks...)
+	corrupted.Generations[0].Chunks[0].Object = gen2.Chunks[1].Object
+	err = DownloadIncremental(ctx, rs, &corrupted, t.TempDir(), nil)
+	if err == nil {
+		t.Errorf("expected restoring a corrupted backup to fail")
+	}
+
+	// garbage collection keeps the chunks referenced by the manifest only
+	mf.Generations = mf.Generations[1:]
+	uploadTestManifest(t, rs, IncrementalBackupManifest, mf)
+	res, err := CollectChunkGarbage(ctx, s, nil, "owner", "workspace", 0)
+	if err != nil {
+		t.Fatalf("cannot collect garbage: %v", err)
+	}
+	referenced := make(map[string]struct{})
+	for _, c := range gen2.Chunks {
+		referenced[c.Object] = struct{}{}
+	}
+	remaining := listChunks()
+	if len(remaining) != len(referenced) {
+		t.Errorf("expected %d chunks to remain, found %d (deleted %d)", len(referenced), len(remaining), res.DeletedChunks)
+	}
+	for _, obj := range remaining {
+		if _, ok := referenced[rs.Qualify(strings.TrimPrefix(obj, rs.BackupObject("")))]; !ok {
+			t.Errorf("unreferenced chunk %s survived garbage collection", obj)
+		}
+	}
+	if res.DeletedChunks == 0 {
+		t.Errorf("expected garbage collection to delete chunks")
+	}
+
+	// chunks within the grace period are kept
+	mf.Generations = nil
+	uploadTestManifest(t, rs, IncrementalBackupManifest, mf)
+	res, err = CollectChunkGarbage(ctx, s, nil, "owner", "workspace", time.Hour)
+	if err != nil {
+		t.Fatalf("cannot collect garbage: %v", err)
+	}
+	if res.DeletedChunks != 0 {
+		t.Errorf("garbage collection deleted %d chunks within the grace period", res.DeletedChunks)
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"golang.org/x/xerrors"
+
+	config "github.com/gitpod-io/gitpod/content-service/api/config"
+)
+
+const (
+	// dataKeySize is the size of data and key-encryption keys in bytes (AES-256)
+	dataKeySize = 32
+)
+
+var (
+	// ErrKeyNotFound is returned when an owner has no key-encryption key, e.g. because it was destroyed
+	ErrKeyNotFound = fmt.Errorf("key not found")
+
+	validOwnerName = regexp.MustCompile(`^[a-zA-Z0-9_\-]+$`)
+)
+
+// KeyProvider manages the key-encryption keys of workspace owners, and the data keys they wrap
+type KeyProvider interface {
+	// DataKey returns the owner's data key, both in plaintext and wrapped by the owner's key-encryption key.
+	// If the owner has no keys yet, they are created.
+	DataKey(ctx context.Context, owner string) (key, wrapped []byte, err error)
+
+	// UnwrapKey decrypts a data key wrapped by the owner's key-encryption key.
+	// Returns ErrKeyNotFound if the owner has no key-encryption key.
+	UnwrapKey(ctx context.Context, owner string, wrapped []byte) (key []byte, err error)
+
+	// DestroyKey irrevocably destroys the owner's key-encryption key, which renders all content
+	// encrypted for that owner unreadable. Destroying a key that does not exist is not an error.
+	DestroyKey(ctx context.Context, owner string) error
+}
+
+// NewKeyProvider produces a key provider for envelope encryption
+func NewKeyProvider(c *config.EncryptionConfig) (KeyProvider, error) {
+	switch c.KeyProvider {
+	case config.KeyFileProvider:
+		return newKeyFileProvider(c.KeyFileConfig)
+	default:
+		return nil, xerrors.Errorf("unknown key provider: %q", c.KeyProvider)
+	}
+}
+
+// ValidateKeyFileConfig checks if the keyfile key provider config is valid
+func ValidateKeyFileConfig(c *config.KeyFileConfig) error {
+	return validation.ValidateStruct(c,
+		validation.Field(&c.MasterKeyFile, validation.Required),
+		validation.Field(&c.KeyStore, validation.Required),
+	)
+}
+
+func newKeyFileProvider(cfg config.KeyFileConfig) (*keyFileProvider, error) {
+	err := ValidateKeyFileConfig(&cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	fc, err := os.ReadFile(cfg.MasterKeyFile)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read master key: %w", err)
+	}
+	master, err := hex.DecodeString(strings.TrimSpace(string(fc)))
+	if err != nil {
+		return nil, xerrors.Errorf("cannot decode master key: %w", err)
+	}
+	if len(master) != dataKeySize {
+		return nil, xerrors.Errorf("master key must be %d bytes, not %d", dataKeySize, len(master))
+	}
+
+	err = os.MkdirAll(cfg.KeyStore, 0700)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create key store: %w", err)
+	}
+
+	return &keyFileProvider{
+		MasterKey: master,
+		KeyStore:  cfg.KeyStore,
+	}, nil
+}
+
+// keyFileProvider keeps a record per owner in a key store directory. Each record holds the owner's
+// key-encryption key sealed with the master key, and the owner's data key sealed with the key-encryption key.
+// Destroying an owner's key removes the record and with it the only copy of the key-encryption key.
+type keyFileProvider struct {
+	MasterKey []byte
+	KeyStore  string
+}
+
+type keyFileRecord struct {
+	KeyEncryptionKey []byte `json:"kek"`
+	DataKey          []byte `json:"dataKey"`
+}
+
+func (p *keyFileProvider) recordPath(owner string) (string, error) {
+	if !validOwnerName.MatchString(owner) {
+		return "", xerrors.Errorf("invalid owner: %q", owner)
+	}
+	return filepath.Join(p.KeyStore, owner+".json"), nil
+}
+
+func (p *keyFileProvider) readRecord(owner string) (*keyFileRecord, error) {
+	fn, err := p.recordPath(owner)
+	if err != nil {
+		return nil, err
+	}
+	fc, err := os.ReadFile(fn)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var rec keyFileRecord
+	err = json.Unmarshal(fc, &rec)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot unmarshal key record of %s: %w", owner, err)
+	}
+	return &rec, nil
+}
+
+// createRecord creates a new record for the owner unless one exists already
+func (p *keyFileProvider) createRecord(owner string) error {
+	fn, err := p.recordPath(owner)
+	if err != nil {
+		return err
+	}
+
+	kek := make([]byte, dataKeySize)
+	_, err = io.ReadFull(rand.Reader, kek)
+	if err != nil {
+		return err
+	}
+	dek := make([]byte, dataKeySize)
+	_, err = io.ReadFull(rand.Reader, dek)
+	if err != nil {
+		return err
+	}
+
+	var rec keyFileRecord
+	rec.KeyEncryptionKey, err = sealKey(p.MasterKey, kek, owner)
+	if err != nil {
+		return err
+	}
+	rec.DataKey, err = sealKey(kek, dek, owner)
+	if err != nil {
+		return err
+	}
+	fc, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(p.KeyStore, ".record-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(fc)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	// Linking fails if the record exists already, e.g. because another component created it concurrently.
+	// In that case the existing record wins.
+	err = os.Link(tmp.Name(), fn)
+	if errors.Is(err, fs.ErrExist) {
+		return nil
+	}
+	return err
+}
+
+func (p *keyFileProvider) keyEncryptionKey(owner string, rec *keyFileRecord) ([]byte, error) {
+	kek, err := openKey(p.MasterKey, rec.KeyEncryptionKey, owner)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot unseal key-encryption key of %s: %w", owner, err)
+	}
+	return kek, nil
+}
+
+// DataKey returns the owner's data key, both in plaintext and wrapped by the owner's key-encryption key
+func (p *keyFileProvider) DataKey(ctx context.Context, owner string) (key, wrapped []byte, err error) {
+	rec, err := p.readRecord(owner)
+	if err == ErrKeyNotFound {
+		err = p.createRecord(owner)
+		if err != nil {
+			return nil, nil, xerrors.Errorf("cannot create keys for %s: %w", owner, err)
+		}
+		rec, err = p.readRecord(owner)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, err = p.UnwrapKey(ctx, owner, rec.DataKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, rec.DataKey, nil
+}
+
+// UnwrapKey decrypts a data key wrapped by the owner's key-encryption key
+func (p *keyFileProvider) UnwrapKey(ctx context.Context, owner string, wrapped []byte) (key []byte, err error) {
+	rec, err := p.readRecord(owner)
+	if err != nil {
+		return nil, err
+	}
+	kek, err := p.keyEncryptionKey(owner, rec)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err = openKey(kek, wrapped, owner)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot unwrap data key of %s: %w", owner, err)
+	}
+	return key, nil
+}
+
+// DestroyKey removes the owner's record from the key store
+func (p *keyFileProvider) DestroyKey(ctx context.Context, owner string) error {
+	fn, err := p.recordPath(owner)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(fn)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// sealKey encrypts a key using AES-GCM, binding it to the owner
+func sealKey(kek, key []byte, owner string) ([]byte, error) {
+	aead, err := newGCM(kek)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(key)+aead.Overhead())
+	_, err = io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, key, []byte(owner)), nil
+}
+
+// openKey decrypts a key produced by sealKey
+func openKey(kek, sealed []byte, owner string) ([]byte, error) {
+	aead, err := newGCM(kek)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, xerrors.Errorf("sealed key is too short")
+	}
+	nonce, ct := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ct, []byte(owner))
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	config "github.com/gitpod-io/gitpod/content-service/api/config"
+)
+
+func newTestKeyFileConfig(t *testing.T) *config.EncryptionConfig {
+	dir := t.TempDir()
+	fn := filepath.Join(dir, "master.key")
+	err := os.WriteFile(fn, []byte(strings.Repeat("ab", dataKeySize)+"\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &config.EncryptionConfig{
+		KeyProvider: config.KeyFileProvider,
+		KeyFileConfig: config.KeyFileConfig{
+			MasterKeyFile: fn,
+			KeyStore:      filepath.Join(dir, "keys"),
+		},
+	}
+}
+
+func TestNewKeyProvider(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Config      func(c *config.EncryptionConfig)
+		ExpectError bool
+	}{
+		{Name: "valid", Config: func(c *config.EncryptionConfig) {}},
+		{Name: "unknown provider", Config: func(c *config.EncryptionConfig) { c.KeyProvider = "vault" }, ExpectError: true},
+		{Name: "no key store", Config: func(c *config.EncryptionConfig) { c.KeyFileConfig.KeyStore = "" }, ExpectError: true},
+		{Name: "missing master key", Config: func(c *config.EncryptionConfig) { c.KeyFileConfig.MasterKeyFile += ".missing" }, ExpectError: true},
+		{
+			Name: "short master key",
+			Config: func(c *config.EncryptionConfig) {
+				_ = os.WriteFile(c.KeyFileConfig.MasterKeyFile, []byte("abcd"), 0600)
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			cfg := newTestKeyFileConfig(t)
+			test.Config(cfg)
+
+			_, err := NewKeyProvider(cfg)
+			if (err != nil) != test.ExpectError {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestKeyFileProvider(t *testing.T) {
+	ctx := context.Background()
+	cfg := newTestKeyFileConfig(t)
+	keys, err := NewKeyProvider(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, wrapped, err := keys.DataKey(ctx, "owner")
+	if err != nil {
+		t.Fatalf("cannot create data key: %v", err)
+	}
+	if len(key) != dataKeySize {
+		t.Errorf("unexpected data key size: %d", len(key))
+	}
+	if bytes.Contains(wrapped, key) {
+		t.Errorf("wrapped key contains the plaintext key")
+	}
+
+	// other components sharing the key store must see the same key
+	other, err := NewKeyProvider(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, _, err := other.DataKey(ctx, "owner")
+	if err != nil {
+		t.Fatalf("cannot get data key: %v", err)
+	}
+	if !bytes.Equal(key, again) {
+		t.Errorf("data key changed between calls")
+	}
+	unwrapped, err := other.UnwrapKey(ctx, "owner", wrapped)
+	if err != nil {
+		t.Fatalf("cannot unwrap data key: %v", err)
+	}
+	if !bytes.Equal(key, unwrapped) {
+		t.Errorf("unwrapped key differs from data key")
+	}
+
+	otherOwner, _, err := keys.DataKey(ctx, "other-owner")
+	if err != nil {
+		t.Fatalf("cannot create data key: %v", err)
+	}
+	if bytes.Equal(key, otherOwner) {
+		t.Errorf("owners share a data key")
+	}
+	_, err = keys.UnwrapKey(ctx, "other-owner", wrapped)
+	if err == nil {
+		t.Errorf("unwrapped another owner's data key")
+	}
+
+	_, _, err = keys.DataKey(ctx, "../owner")
+	if err == nil {
+		t.Errorf("accepted invalid owner")
+	}
+
+	err = keys.DestroyKey(ctx, "owner")
+	if err != nil {
+		t.Fatalf("cannot destroy key: %v", err)
+	}
+	_, err = keys.UnwrapKey(ctx, "owner", wrapped)
+	if !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("expected ErrKeyNotFound after destroying key, got %v", err)
+	}
+	err = keys.DestroyKey(ctx, "owner")
+	if err != nil {
+		t.Errorf("destroying a non-existent key failed: %v", err)
+	}
+
+	fresh, _, err := keys.DataKey(ctx, "owner")
+	if err != nil {
+		t.Fatalf("cannot create data key after destroying the old one: %v", err)
+	}
+	if bytes.Equal(key, fresh) {
+		t.Errorf("destroyed data key was reused")
+	}
+}
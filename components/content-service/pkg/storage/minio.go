@@ -110,6 +110,8 @@ type DirectMinIOStorage struct {
 
 	// ObjectAccess just exists so that we can swap out the stream access during testing
 	ObjectAccess func(ctx context.Context, btk, obj string) (io.ReadCloser, error)
+
+	rangeDownload
 }
 
 // Validate checks if the GCloud storage is MinIOconfigured properly
@@ -150,20 +152,44 @@ func (rs *DirectMinIOStorage) Init(ctx context.Context, owner, workspace, instan
 }
 
 func (rs *DirectMinIOStorage) defaultObjectAccess(ctx context.Context, bkt, obj string) (io.ReadCloser, error) {
+	_, rc, err := rs.openObject(ctx, bkt, obj)
+	return rc, err
+}
+
+// openObject opens an object for reading in parallel byte ranges
+func (rs *DirectMinIOStorage) openObject(ctx context.Context, bkt, obj string) (*ObjectMeta, io.ReadCloser, error) {
 	if rs.client == nil {
-		return nil, xerrors.Errorf("no MinIO client available - did you call Init()?")
+		return nil, nil, xerrors.Errorf("no MinIO client available - did you call Init()?")
 	}
 
-	object, err := rs.client.GetObject(ctx, bkt, obj, minio.GetObjectOptions{})
+	stat, err := rs.client.StatObject(ctx, bkt, obj, minio.StatObjectOptions{})
 	if err != nil {
-		return nil, translateMinioError(err)
-	}
-	_, err = object.Stat()
-	if err != nil {
-		return nil, translateMinioError(err)
+		return nil, nil, translateMinioError(err)
 	}
+	meta := minioObjectMeta(stat)
+	return &meta, rs.openRanges(ctx, stat.Size, minioRangeOpener(rs.client, bkt, obj, stat.ETag)), nil
+}
 
-	return object, nil
+// minioRangeOpener opens ranges of an object. The ranges must stem from the object version with the given ETag,
+// so that an object which is overwritten during a download is not mixed from two versions.
+func minioRangeOpener(client *minio.Client, bkt, obj, etag string) rangeOpener {
+	return func(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+		var opts minio.GetObjectOptions
+		err := opts.SetMatchETag(etag)
+		if err != nil {
+			return nil, err
+		}
+		err = opts.SetRange(offset, offset+length-1)
+		if err != nil {
+			return nil, err
+		}
+
+		object, err := client.GetObject(ctx, bkt, obj, opts)
+		if err != nil {
+			return nil, translateMinioError(err)
+		}
+		return object, nil
+	}
 }
 
 // EnsureExists makes sure that the remote storage location exists and can be up- or downloaded from
@@ -205,13 +231,13 @@ func (rs *DirectMinIOStorage) download(ctx context.Context, destination string,
 	span.SetTag("object", obj)
 	defer tracing.FinishSpan(span, &err)
 
-	rc, err := rs.ObjectAccess(ctx, bkt, obj)
-	if rc == nil {
+	meta, rc, err := rs.openObject(ctx, bkt, obj)
+	if err != nil {
 		return false, err
 	}
 	defer rc.Close()
 
-	err = extractTarbal(ctx, destination, rc, mappings)
+	err = extractVerifiedTarbal(ctx, destination, rc, meta.Digest, mappings)
 	if err != nil {
 		return true, err
 	}
@@ -234,6 +260,35 @@ func (rs *DirectMinIOStorage) DownloadSnapshot(ctx context.Context, destination
 	return rs.download(ctx, destination, bkt, obj, mappings)
 }
 
+// OpenObject opens a backup object for reading. The name is either a backup name or one produced by Qualify.
+func (rs *DirectMinIOStorage) OpenObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	bkt, obj, err := splitObjectName(name, rs.bucketName(), rs.objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.ObjectAccess(ctx, bkt, obj)
+}
+
+// objectMeta reads the metadata of a backup object. The name is either a backup name or one produced by Qualify.
+func (rs *DirectMinIOStorage) objectMeta(ctx context.Context, name string) (*ObjectMeta, error) {
+	if rs.client == nil {
+		return nil, xerrors.Errorf("no MinIO client available - did you call Init()?")
+	}
+
+	bkt, obj, err := splitObjectName(name, rs.bucketName(), rs.objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := rs.client.StatObject(ctx, bkt, obj, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, translateMinioError(err)
+	}
+	meta := minioObjectMeta(stat)
+	return &meta, nil
+}
+
 // ListObjects returns all objects found with the given prefix. Returns an empty list if the bucket does not exuist (yet).
 func (rs *DirectMinIOStorage) ListObjects(ctx context.Context, prefix string) (objects []string, err error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
@@ -386,6 +441,32 @@ func (s *presignedMinIOStorage) DiskUsage(ctx context.Context, bucket string, pr
 	return total, nil
 }
 
+// ListObjects describes all objects in the given bucket whose name has the given prefix
+func (s *presignedMinIOStorage) ListObjects(ctx context.Context, bucket string, prefix string) (objects []ObjectInfo, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "minio.ListObjects")
+	defer tracing.FinishSpan(span, &err)
+
+	objectCh := s.client.ListObjects(ctx, bucket, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: true,
+	})
+	for object := range objectCh {
+		if object.Err != nil {
+			if translateMinioError(object.Err) == ErrNotFound {
+				return nil, nil
+			}
+			return nil, object.Err
+		}
+		objects = append(objects, ObjectInfo{
+			Name:         object.Key,
+			Size:         object.Size,
+			LastModified: object.LastModified,
+		})
+	}
+	return objects, nil
+}
+
 func (s *presignedMinIOStorage) SignDownload(ctx context.Context, bucket, object string, options *SignedURLOptions) (info *DownloadInfo, err error) {
 	//nolint:ineffassign
 	span, ctx := opentracing.StartSpanFromContext(ctx, "minio.SignDownload")
@@ -413,12 +494,7 @@ func (s *presignedMinIOStorage) SignDownload(ctx context.Context, bucket, object
 	}
 
 	return &DownloadInfo{
-		Meta: ObjectMeta{
-			ContentType:        stat.ContentType,
-			OCIMediaType:       stat.Metadata.Get(annotationToAmzMetaHeader(ObjectAnnotationOCIContentType)),
-			Digest:             stat.Metadata.Get(annotationToAmzMetaHeader(ObjectAnnotationDigest)),
-			UncompressedDigest: stat.Metadata.Get(annotationToAmzMetaHeader(ObjectAnnotationUncompressedDigest)),
-		},
+		Meta: minioObjectMeta(stat),
 		Size: stat.Size,
 		URL:  url.String(),
 	}, nil
@@ -525,6 +601,20 @@ func annotationToAmzMetaHeader(annotation string) string {
 	return http.CanonicalHeaderKey(fmt.Sprintf("X-Amz-Meta-%s", annotation))
 }
 
+// minioObjectMeta reads our annotations from the user metadata of an S3 object
+func minioObjectMeta(stat minio.ObjectInfo) ObjectMeta {
+	return ObjectMeta{
+		ContentType:        stat.ContentType,
+		OCIMediaType:       stat.Metadata.Get(annotationToAmzMetaHeader(ObjectAnnotationOCIContentType)),
+		Digest:             stat.Metadata.Get(annotationToAmzMetaHeader(ObjectAnnotationDigest)),
+		UncompressedDigest: stat.Metadata.Get(annotationToAmzMetaHeader(ObjectAnnotationUncompressedDigest)),
+		Encryption:         stat.Metadata.Get(annotationToAmzMetaHeader(ObjectAnnotationEncryption)),
+		KeyOwner:           stat.Metadata.Get(annotationToAmzMetaHeader(ObjectAnnotationKeyOwner)),
+		WrappedKey:         stat.Metadata.Get(annotationToAmzMetaHeader(ObjectAnnotationWrappedKey)),
+		Prebuild:           parsePrebuildAnnotation(stat.Metadata.Get(annotationToAmzMetaHeader(ObjectAnnotationPrebuild))),
+	}
+}
+
 // Bucket provides the bucket name for a particular user
 func (s *presignedMinIOStorage) Bucket(ownerID string) string {
 	return minioBucketName(ownerID, s.MinIOConfig.BucketName)
@@ -549,6 +639,43 @@ func (s *presignedMinIOStorage) InstanceObject(ownerID string, workspaceID strin
 	return s.BackupObject(ownerID, workspaceID, InstanceObjectName(instanceID, name))
 }
 
+// ListOwners lists the owners which have content in the remote storage
+func (s *presignedMinIOStorage) ListOwners(ctx context.Context) (owners []string, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "minio.ListOwners")
+	defer tracing.FinishSpan(span, &err)
+
+	if s.MinIOConfig.BucketName != "" {
+		// all owners share a bucket, in which each owner has a top-level directory next to the blobs
+		for object := range s.client.ListObjects(ctx, s.MinIOConfig.BucketName, minio.ListObjectsOptions{}) {
+			if object.Err != nil {
+				if translateMinioError(object.Err) == ErrNotFound {
+					return nil, nil
+				}
+				return nil, object.Err
+			}
+			if !strings.HasSuffix(object.Key, "/") || object.Key == "blobs/" {
+				continue
+			}
+			owners = append(owners, strings.TrimSuffix(object.Key, "/"))
+		}
+		return owners, nil
+	}
+
+	buckets, err := s.client.ListBuckets(ctx)
+	if err != nil {
+		return nil, translateMinioError(err)
+	}
+	prefix := minioBucketName("", "")
+	for _, bkt := range buckets {
+		if !strings.HasPrefix(bkt.Name, prefix) {
+			continue
+		}
+		owners = append(owners, strings.TrimPrefix(bkt.Name, prefix))
+	}
+	return owners, nil
+}
+
 func translateMinioError(err error) error {
 	if err == nil {
 		return nil
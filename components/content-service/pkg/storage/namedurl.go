@@ -6,6 +6,7 @@ package storage
 
 import (
 	"context"
+	"io"
 	"net/http"
 
 	"golang.org/x/xerrors"
@@ -54,3 +55,35 @@ func (d *NamedURLDownloader) Download(ctx context.Context, destination string, n
 func (d *NamedURLDownloader) DownloadSnapshot(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (found bool, err error) {
 	return d.Download(ctx, destination, name, mappings)
 }
+
+// OpenObject opens the object with the given name for reading
+func (d *NamedURLDownloader) OpenObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	url, found := d.URLs[name]
+	if !found {
+		return nil, ErrNotFound
+	}
+	return OpenURL(ctx, url)
+}
+
+// OpenURL opens a (presigned) object URL for reading. Returns ErrNotFound if the object does not exist.
+func OpenURL(ctx context.Context, url string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, xerrors.Errorf("non-OK status code: %v", resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
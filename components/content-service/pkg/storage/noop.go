@@ -6,6 +6,7 @@ package storage
 
 import (
 	"context"
+	"io"
 
 	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
 )
@@ -35,6 +36,11 @@ func (rs *DirectNoopStorage) DownloadSnapshot(ctx context.Context, destination s
 	return false, nil
 }
 
+// OpenObject always returns ErrNotFound
+func (rs *DirectNoopStorage) OpenObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	return nil, ErrNotFound
+}
+
 // ListObjects returns all objects found with the given prefix. Returns an empty list if the bucket does not exuist (yet).
 func (rs *DirectNoopStorage) ListObjects(ctx context.Context, prefix string) (objects []string, err error) {
 	return nil, nil
@@ -81,6 +87,11 @@ func (*PresignedNoopStorage) DiskUsage(ctx context.Context, bucket string, prefi
 	return 0, nil
 }
 
+// ListObjects returns no objects
+func (*PresignedNoopStorage) ListObjects(ctx context.Context, bucket string, prefix string) ([]ObjectInfo, error) {
+	return nil, nil
+}
+
 // SignDownload returns ErrNotFound
 func (*PresignedNoopStorage) SignDownload(ctx context.Context, bucket, obj string, options *SignedURLOptions) (info *DownloadInfo, err error) {
 	return nil, ErrNotFound
@@ -129,3 +140,8 @@ func (*PresignedNoopStorage) BackupObject(ownerID string, workspaceID string, na
 func (*PresignedNoopStorage) InstanceObject(ownerID string, workspaceID string, instanceID string, name string) string {
 	return ""
 }
+
+// ListOwners returns no owners
+func (*PresignedNoopStorage) ListOwners(ctx context.Context) ([]string, error) {
+	return nil, nil
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	config "github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+const (
+	defaultDownloadRangeSize   = 16 * 1024 * 1024
+	defaultDownloadParallelism = 4
+	defaultDownloadAttempts    = 3
+)
+
+// rangeRetryBackoff is the time we wait before the second attempt of a range download. Later attempts wait longer.
+var rangeRetryBackoff = 500 * time.Millisecond
+
+// rangeOpener opens length bytes of an object, starting at offset
+type rangeOpener func(ctx context.Context, offset, length int64) (io.ReadCloser, error)
+
+// rangeDownloader is storage which downloads objects in byte ranges
+type rangeDownloader interface {
+	setDownloadConfig(cfg config.DownloadConfig)
+}
+
+var (
+	_ rangeDownloader = &DirectGCPStorage{}
+	_ rangeDownloader = &DirectMinIOStorage{}
+	_ rangeDownloader = &DirectS3Storage{}
+)
+
+// rangeDownload downloads objects in byte ranges, several of them in parallel. Each range is retried on its own and
+// resumes where the previous attempt broke off, so that a network blip does not restart the whole download.
+type rangeDownload struct {
+	downloadConfig config.DownloadConfig
+}
+
+func (d *rangeDownload) setDownloadConfig(cfg config.DownloadConfig) {
+	d.downloadConfig = cfg
+}
+
+// openRanges provides a reader of an object of the given size. Ranges are delivered in order as soon as they are
+// complete, hence the front of the object can be processed while the rest is still downloading. At most
+// Parallelism ranges are downloaded or waiting to be read at any time, which bounds the memory we use.
+func (d *rangeDownload) openRanges(ctx context.Context, size int64, open rangeOpener) io.ReadCloser {
+	var (
+		rangeSize   = d.downloadConfig.RangeSize
+		parallelism = d.downloadConfig.Parallelism
+		attempts    = d.downloadConfig.Attempts
+	)
+	if rangeSize <= 0 {
+		rangeSize = defaultDownloadRangeSize
+	}
+	if parallelism <= 0 {
+		parallelism = defaultDownloadParallelism
+	}
+	if attempts <= 0 {
+		attempts = defaultDownloadAttempts
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	r := &rangeReader{
+		ctx:    ctx,
+		cancel: cancel,
+		ranges: make([]chan rangeResult, (size+rangeSize-1)/rangeSize),
+		slots:  make(chan struct{}, parallelism),
+	}
+	for i := range r.ranges {
+		r.ranges[i] = make(chan rangeResult, 1)
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		for i := range r.ranges {
+			select {
+			case r.slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			offset := int64(i) * rangeSize
+			length := rangeSize
+			if offset+length > size {
+				length = size - offset
+			}
+			r.wg.Add(1)
+			go func(res chan<- rangeResult) {
+				defer r.wg.Done()
+				data, err := downloadRange(ctx, open, offset, length, attempts)
+				res <- rangeResult{Data: data, Err: err}
+			}(r.ranges[i])
+		}
+	}()
+
+	return r
+}
+
+// downloadRange downloads a single range, resuming partial downloads of failed attempts
+func downloadRange(ctx context.Context, open rangeOpener, offset, length int64, attempts int) ([]byte, error) {
+	var (
+		buf  = make([]byte, length)
+		read int64
+	)
+	for attempt := 1; ; attempt++ {
+		rc, err := open(ctx, offset+read, length-read)
+		if err == nil {
+			var n int
+			n, err = io.ReadFull(rc, buf[read:])
+			rc.Close()
+			read += int64(n)
+			if err == nil {
+				return buf, nil
+			}
+		}
+		if err == ErrNotFound || attempt >= attempts || ctx.Err() != nil {
+			return nil, xerrors.Errorf("cannot download bytes %d-%d: %w", offset, offset+length-1, err)
+		}
+
+		log.WithError(err).WithField("offset", offset+read).WithField("attempt", attempt).Debug("retrying range download")
+		select {
+		case <-time.After(time.Duration(attempt) * rangeRetryBackoff):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+type rangeResult struct {
+	Data []byte
+	Err  error
+}
+
+// rangeReader reads the ranges of an object in order
+type rangeReader struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	ranges []chan rangeResult
+	slots  chan struct{}
+
+	idx int
+	cur []byte
+	err error
+}
+
+func (r *rangeReader) Read(p []byte) (n int, err error) {
+	for len(r.cur) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		if r.idx >= len(r.ranges) {
+			return 0, io.EOF
+		}
+
+		select {
+		case res := <-r.ranges[r.idx]:
+			r.idx++
+			<-r.slots
+			if res.Err != nil {
+				r.err = res.Err
+				return 0, r.err
+			}
+			r.cur = res.Data
+		case <-r.ctx.Done():
+			r.err = r.ctx.Err()
+			return 0, r.err
+		}
+	}
+
+	n = copy(p, r.cur)
+	r.cur = r.cur[n:]
+	return n, nil
+}
+
+// Close stops all downloads which are still in progress
+func (r *rangeReader) Close() error {
+	r.cancel()
+	r.wg.Wait()
+	return nil
+}
+
+// extractVerifiedTarbal extracts a tar stream and verifies it against the digest recorded in the object's annotations.
+// Objects without digest, e.g. ones uploaded before we started recording it, are extracted without verification.
+func extractVerifiedTarbal(ctx context.Context, dest string, src io.Reader, dgst string, mappings []archive.IDMapping) error {
+	if dgst == "" {
+		return extractTarbal(ctx, dest, src, mappings)
+	}
+	expected, err := digest.Parse(dgst)
+	if err != nil {
+		return xerrors.Errorf("invalid content digest %s: %w", dgst, err)
+	}
+
+	verifier := expected.Verifier()
+	tee := io.TeeReader(src, verifier)
+	err = extractTarbal(ctx, dest, tee, mappings)
+	if err != nil {
+		return err
+	}
+	// the extraction need not read the padding at the end of an archive
+	_, err = io.Copy(io.Discard, tee)
+	if err != nil {
+		return xerrors.Errorf("cannot verify content: %w", err)
+	}
+	if !verifier.Verified() {
+		return xerrors.Errorf("downloaded content does not match its digest %s", expected)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	"golang.org/x/xerrors"
+
+	config "github.com/gitpod-io/gitpod/content-service/api/config"
+)
+
+// flakyObject serves ranges of its content and breaks off the first failures reads halfway through
+type flakyObject struct {
+	content  []byte
+	failures int
+
+	mu    sync.Mutex
+	opens int
+}
+
+func (o *flakyObject) open(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.opens++
+	data := o.content[offset : offset+length]
+	if o.failures > 0 {
+		o.failures--
+		return io.NopCloser(io.MultiReader(bytes.NewReader(data[:len(data)/2]), errReader{xerrors.Errorf("connection reset")})), nil
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read(p []byte) (int, error) { return 0, r.err }
+
+func TestRangeDownload(t *testing.T) {
+	defer func(b time.Duration) { rangeRetryBackoff = b }(rangeRetryBackoff)
+	rangeRetryBackoff = time.Millisecond
+
+	content := make([]byte, 1000)
+	for i := range content {
+		content[i] = byte(i % 251)
+	}
+
+	tests := []struct {
+		Name      string
+		Content   []byte
+		Failures  int
+		Attempts  int
+		ExpectErr bool
+		Opens     int
+	}{
+		{Name: "no failures", Content: content, Opens: 10},
+		{Name: "empty object", Content: []byte{}, Opens: 0},
+		{Name: "resumes failed ranges", Content: content, Failures: 3, Opens: 13},
+		{Name: "attempts exhausted", Content: content, Failures: 100, Attempts: 2, ExpectErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			obj := &flakyObject{content: test.Content, failures: test.Failures}
+			d := &rangeDownload{downloadConfig: config.DownloadConfig{RangeSize: 100, Parallelism: 3, Attempts: test.Attempts}}
+
+			rc := d.openRanges(context.Background(), int64(len(test.Content)), obj.open)
+			act, err := io.ReadAll(rc)
+			rc.Close()
+
+			if test.ExpectErr {
+				if err == nil {
+					t.Fatal("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !bytes.Equal(act, test.Content) {
+				t.Errorf("downloaded content differs from the object")
+			}
+			if obj.opens != test.Opens {
+				t.Errorf("unexpected number of range requests: expected %d, got %d", test.Opens, obj.opens)
+			}
+		})
+	}
+}
+
+func TestExtractVerifiedTarbal(t *testing.T) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	_ = tw.WriteHeader(&tar.Header{Name: "hello.txt", Mode: 0644, Size: 5})
+	_, _ = tw.Write([]byte("hello"))
+	_ = tw.Close()
+	content := buf.Bytes()
+
+	tests := []struct {
+		Name      string
+		Digest    string
+		ExpectErr bool
+	}{
+		{Name: "matching digest", Digest: digest.FromBytes(content).String()},
+		{Name: "no digest", Digest: ""},
+		{Name: "mismatching digest", Digest: digest.FromString("something else").String(), ExpectErr: true},
+		{Name: "invalid digest", Digest: "sha256:abc", ExpectErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			dst := t.TempDir()
+			err := extractVerifiedTarbal(context.Background(), dst, bytes.NewReader(content), test.Digest, nil)
+			if test.ExpectErr {
+				if err == nil {
+					t.Fatal("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			act, err := os.ReadFile(filepath.Join(dst, "hello.txt"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(act) != "hello" {
+				t.Errorf("unexpected content: %q", act)
+			}
+		})
+	}
+}
@@ -0,0 +1,713 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	minio "github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	config "github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+var (
+	_ DirectAccess    = &DirectS3Storage{}
+	_ PresignedAccess = &presignedS3Storage{}
+)
+
+const (
+	defaultS3Endpoint       = "s3.amazonaws.com"
+	defaultS3PartSize       = 64 * 1024 * 1024
+	defaultS3ParallelUpload = 4
+
+	// minS3PartSize is the smallest part size S3 accepts for multipart uploads
+	minS3PartSize = 5 * 1024 * 1024
+)
+
+// defaultS3CredentialSources are the credential sources we try if none are configured
+var defaultS3CredentialSources = []config.S3CredentialSource{
+	config.S3CredentialsEnv,
+	config.S3CredentialsWebIdentity,
+	config.S3CredentialsInstanceProfile,
+}
+
+// ValidateS3Config checks if the S3 storage config is valid
+func ValidateS3Config(c *config.S3Config) error {
+	err := validation.ValidateStruct(c,
+		validation.Field(&c.Bucket, validation.Required),
+		validation.Field(&c.Region, validation.Required),
+		validation.Field(&c.ServerSideEncryption, validation.In(config.S3SSES3, config.S3SSEKMS)),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, src := range c.Credentials {
+		switch src {
+		case config.S3CredentialsStatic:
+			if c.AccessKeyIdFile == "" || c.SecretAccessKeyFile == "" {
+				return xerrors.Errorf("static credentials require accessKeyFile and secretKeyFile")
+			}
+		case config.S3CredentialsEnv, config.S3CredentialsWebIdentity, config.S3CredentialsInstanceProfile:
+		default:
+			return xerrors.Errorf("unknown credential source: %s", src)
+		}
+	}
+	if c.ServerSideEncryption == config.S3SSEKMS && c.KMSKeyID == "" {
+		return xerrors.Errorf("aws:kms server-side encryption requires kmsKeyId")
+	}
+	if c.PartSize != 0 && c.PartSize < minS3PartSize {
+		return xerrors.Errorf("partSize must be at least %d bytes", minS3PartSize)
+	}
+	return nil
+}
+
+// newS3Bucket validates the configuration and produces a client for the bucket it configures
+func newS3Bucket(cfg config.S3Config) (*s3Bucket, error) {
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = defaultS3Endpoint
+	}
+	if cfg.PartSize == 0 {
+		cfg.PartSize = defaultS3PartSize
+	}
+	if cfg.ParallelUpload == 0 {
+		cfg.ParallelUpload = defaultS3ParallelUpload
+	}
+	err := ValidateS3Config(&cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := s3Credentials(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  creds,
+		Secure: !cfg.Insecure,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var sse encrypt.ServerSide
+	switch cfg.ServerSideEncryption {
+	case config.S3SSES3:
+		sse = encrypt.NewSSE()
+	case config.S3SSEKMS:
+		sse, err = encrypt.NewSSEKMS(cfg.KMSKeyID, nil)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot configure server-side encryption: %w", err)
+		}
+	}
+
+	return &s3Bucket{client: client, cfg: cfg, sse: sse}, nil
+}
+
+// s3Credentials chains the configured credential sources, so that the first one which provides credentials is used
+func s3Credentials(c *config.S3Config) (*credentials.Credentials, error) {
+	sources := c.Credentials
+	if len(sources) == 0 {
+		sources = defaultS3CredentialSources
+	}
+
+	providers := make([]credentials.Provider, 0, len(sources))
+	for _, src := range sources {
+		switch src {
+		case config.S3CredentialsStatic:
+			id, err := os.ReadFile(c.AccessKeyIdFile)
+			if err != nil {
+				return nil, xerrors.Errorf("cannot read access key: %w", err)
+			}
+			secret, err := os.ReadFile(c.SecretAccessKeyFile)
+			if err != nil {
+				return nil, xerrors.Errorf("cannot read secret key: %w", err)
+			}
+			providers = append(providers, &credentials.Static{Value: credentials.Value{
+				AccessKeyID:     strings.TrimSpace(string(id)),
+				SecretAccessKey: strings.TrimSpace(string(secret)),
+				SignerType:      credentials.SignatureV4,
+			}})
+		case config.S3CredentialsEnv:
+			providers = append(providers, &credentials.EnvAWS{})
+		case config.S3CredentialsWebIdentity:
+			providers = append(providers, s3WebIdentity(c))
+		case config.S3CredentialsInstanceProfile:
+			providers = append(providers, &credentials.IAM{Client: &http.Client{Transport: http.DefaultTransport}})
+		default:
+			return nil, xerrors.Errorf("unknown credential source: %s", src)
+		}
+	}
+	return credentials.NewChainCredentials(providers), nil
+}
+
+// s3WebIdentity assumes a role using a web identity token, e.g. the projected token of an EKS service account
+func s3WebIdentity(c *config.S3Config) credentials.Provider {
+	tokenFile := c.WebIdentityTokenFile
+	if tokenFile == "" {
+		tokenFile = os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE")
+	}
+	roleARN := c.RoleARN
+	if roleARN == "" {
+		roleARN = os.Getenv("AWS_ROLE_ARN")
+	}
+
+	stsEndpoint := fmt.Sprintf("https://sts.%s.amazonaws.com", c.Region)
+	if strings.HasPrefix(c.Region, "cn-") {
+		stsEndpoint += ".cn"
+	}
+
+	return &credentials.STSWebIdentity{
+		Client:      &http.Client{Transport: http.DefaultTransport},
+		STSEndpoint: stsEndpoint,
+		RoleARN:     roleARN,
+		GetWebIDTokenExpiry: func() (*credentials.WebIdentityToken, error) {
+			if tokenFile == "" {
+				return nil, xerrors.Errorf("no web identity token file configured")
+			}
+			token, err := os.ReadFile(tokenFile)
+			if err != nil {
+				return nil, err
+			}
+			return &credentials.WebIdentityToken{Token: strings.TrimSpace(string(token))}, nil
+		},
+	}
+}
+
+// s3Bucket is the single S3 bucket in which we store all content. The buckets the rest of this package deals
+// with become prefixes in this bucket, so that each owner's content lives under a prefix of its own.
+type s3Bucket struct {
+	client *minio.Client
+	cfg    config.S3Config
+	sse    encrypt.ServerSide
+}
+
+// key maps an object of one of our buckets to its key in the S3 bucket
+func (b *s3Bucket) key(bkt, obj string) string {
+	return bkt + "/" + obj
+}
+
+func (b *s3Bucket) ensureExists(ctx context.Context) error {
+	exists, err := b.client.BucketExists(ctx, b.cfg.Bucket)
+	if err != nil {
+		return translateMinioError(err)
+	}
+	if !exists {
+		return xerrors.Errorf("bucket %s does not exist", b.cfg.Bucket)
+	}
+	return nil
+}
+
+// list describes all objects of bkt whose name has the given prefix. The object names are relative to bkt.
+func (b *s3Bucket) list(ctx context.Context, bkt, prefix string) ([]minio.ObjectInfo, error) {
+	var (
+		bktPrefix = b.key(bkt, "")
+		res       []minio.ObjectInfo
+	)
+	for object := range b.client.ListObjects(ctx, b.cfg.Bucket, minio.ListObjectsOptions{
+		Prefix:    b.key(bkt, prefix),
+		Recursive: true,
+	}) {
+		if object.Err != nil {
+			return nil, translateMinioError(object.Err)
+		}
+		object.Key = strings.TrimPrefix(object.Key, bktPrefix)
+		res = append(res, object)
+	}
+	return res, nil
+}
+
+func (b *s3Bucket) stat(ctx context.Context, bkt, obj string) (minio.ObjectInfo, error) {
+	stat, err := b.client.StatObject(ctx, b.cfg.Bucket, b.key(bkt, obj), minio.StatObjectOptions{})
+	if err != nil {
+		return minio.ObjectInfo{}, translateMinioError(err)
+	}
+	return stat, nil
+}
+
+// upload uploads a local file. Files larger than the part size are uploaded in parts, several of them in parallel.
+func (b *s3Bucket) upload(ctx context.Context, bkt, obj, source string, options *UploadOptions) error {
+	_, err := b.client.FPutObject(ctx, b.cfg.Bucket, b.key(bkt, obj), source, minio.PutObjectOptions{
+		UserMetadata:         options.Annotations,
+		ContentType:          options.ContentType,
+		ServerSideEncryption: b.sse,
+		PartSize:             b.cfg.PartSize,
+		NumThreads:           b.cfg.ParallelUpload,
+	})
+	return translateMinioError(err)
+}
+
+func (b *s3Bucket) removePrefix(ctx context.Context, bkt, prefix string) (err error) {
+	objectsCh := make(chan minio.ObjectInfo)
+	go func() {
+		defer close(objectsCh)
+		for object := range b.client.ListObjects(ctx, b.cfg.Bucket, minio.ListObjectsOptions{
+			Prefix:    b.key(bkt, prefix),
+			Recursive: true,
+		}) {
+			objectsCh <- object
+		}
+	}()
+	for removeErr := range b.client.RemoveObjects(ctx, b.cfg.Bucket, objectsCh, minio.RemoveObjectsOptions{}) {
+		err = removeErr.Err
+		log.WithField("bucket", b.cfg.Bucket).WithField("object", removeErr.ObjectName).Error(err)
+	}
+	return translateMinioError(err)
+}
+
+func s3BucketName(ownerID string) string {
+	return fmt.Sprintf("gitpod-user-%s", ownerID)
+}
+
+func s3WorkspaceBackupObjectName(workspaceID, name string) string {
+	return fmt.Sprintf("workspaces/%s/%s", workspaceID, name)
+}
+
+// newDirectS3Access provides direct access to the remote storage system
+func newDirectS3Access(cfg config.S3Config) (*DirectS3Storage, error) {
+	if err := ValidateS3Config(&cfg); err != nil {
+		return nil, err
+	}
+	return &DirectS3Storage{S3Config: cfg}, nil
+}
+
+// DirectS3Storage implements a single S3 bucket as remote storage backend
+type DirectS3Storage struct {
+	Username      string
+	WorkspaceName string
+	InstanceID    string
+	S3Config      config.S3Config
+
+	bucket *s3Bucket
+
+	rangeDownload
+}
+
+// Validate checks if the S3 storage is configured properly
+func (rs *DirectS3Storage) Validate() error {
+	err := ValidateS3Config(&rs.S3Config)
+	if err != nil {
+		return err
+	}
+
+	return validation.ValidateStruct(rs,
+		validation.Field(&rs.Username, validation.Required),
+		validation.Field(&rs.WorkspaceName, validation.Required),
+	)
+}
+
+// Init initializes the remote storage - call this before calling anything else on the interface
+func (rs *DirectS3Storage) Init(ctx context.Context, owner, workspace, instance string) (err error) {
+	rs.Username = owner
+	rs.WorkspaceName = workspace
+	rs.InstanceID = instance
+
+	err = rs.Validate()
+	if err != nil {
+		return err
+	}
+
+	rs.bucket, err = newS3Bucket(rs.S3Config)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// EnsureExists makes sure that the remote storage location exists and can be up- or downloaded from
+func (rs *DirectS3Storage) EnsureExists(ctx context.Context) (err error) {
+	//nolint:staticcheck,ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DirectEnsureExists")
+	defer tracing.FinishSpan(span, &err)
+
+	if rs.bucket == nil {
+		return xerrors.Errorf("no S3 client available - did you call Init()?")
+	}
+	return rs.bucket.ensureExists(ctx)
+}
+
+func (rs *DirectS3Storage) download(ctx context.Context, destination string, bkt string, obj string, mappings []archive.IDMapping) (found bool, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "download")
+	span.SetTag("bucket", bkt)
+	span.SetTag("object", obj)
+	defer tracing.FinishSpan(span, &err)
+
+	if rs.bucket == nil {
+		return false, xerrors.Errorf("no S3 client available - did you call Init()?")
+	}
+
+	meta, rc, err := rs.openObject(ctx, bkt, obj)
+	if err == ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer rc.Close()
+
+	err = extractVerifiedTarbal(ctx, destination, rc, meta.Digest, mappings)
+	if err != nil {
+		return true, err
+	}
+
+	return true, nil
+}
+
+// Download takes the latest state from the remote storage and downloads it to a local path
+func (rs *DirectS3Storage) Download(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (bool, error) {
+	return rs.download(ctx, destination, rs.bucketName(), rs.objectName(name), mappings)
+}
+
+// DownloadSnapshot downloads a snapshot. The snapshot name is expected to be one produced by Qualify
+func (rs *DirectS3Storage) DownloadSnapshot(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (bool, error) {
+	bkt, obj, err := ParseSnapshotName(name)
+	if err != nil {
+		return false, err
+	}
+
+	return rs.download(ctx, destination, bkt, obj, mappings)
+}
+
+// OpenObject opens a backup object for reading. The name is either a backup name or one produced by Qualify.
+func (rs *DirectS3Storage) OpenObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	if rs.bucket == nil {
+		return nil, xerrors.Errorf("no S3 client available - did you call Init()?")
+	}
+
+	bkt, obj, err := splitObjectName(name, rs.bucketName(), rs.objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	_, rc, err := rs.openObject(ctx, bkt, obj)
+	return rc, err
+}
+
+// openObject opens an object for reading in parallel byte ranges
+func (rs *DirectS3Storage) openObject(ctx context.Context, bkt, obj string) (*ObjectMeta, io.ReadCloser, error) {
+	stat, err := rs.bucket.stat(ctx, bkt, obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	meta := minioObjectMeta(stat)
+	return &meta, rs.openRanges(ctx, stat.Size, minioRangeOpener(rs.bucket.client, rs.bucket.cfg.Bucket, rs.bucket.key(bkt, obj), stat.ETag)), nil
+}
+
+// objectMeta reads the metadata of a backup object. The name is either a backup name or one produced by Qualify.
+func (rs *DirectS3Storage) objectMeta(ctx context.Context, name string) (*ObjectMeta, error) {
+	if rs.bucket == nil {
+		return nil, xerrors.Errorf("no S3 client available - did you call Init()?")
+	}
+
+	bkt, obj, err := splitObjectName(name, rs.bucketName(), rs.objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := rs.bucket.stat(ctx, bkt, obj)
+	if err != nil {
+		return nil, err
+	}
+	meta := minioObjectMeta(stat)
+	return &meta, nil
+}
+
+// ListObjects returns all objects found with the given prefix. Returns an empty list if the bucket does not exuist (yet).
+func (rs *DirectS3Storage) ListObjects(ctx context.Context, prefix string) (objects []string, err error) {
+	if rs.bucket == nil {
+		return nil, xerrors.Errorf("no S3 client available - did you call Init()?")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	infos, err := rs.bucket.list(ctx, rs.bucketName(), prefix)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list objects: %w", err)
+	}
+	for _, info := range infos {
+		objects = append(objects, info.Key)
+	}
+	return objects, nil
+}
+
+// Qualify fully qualifies a snapshot name so that it can be downloaded using DownloadSnapshot
+func (rs *DirectS3Storage) Qualify(name string) string {
+	return fmt.Sprintf("%s@%s", rs.objectName(name), rs.bucketName())
+}
+
+// UploadInstance takes all files from a local location and uploads it to the per-instance remote storage
+func (rs *DirectS3Storage) UploadInstance(ctx context.Context, source string, name string, opts ...UploadOption) (bucket, object string, err error) {
+	if rs.InstanceID == "" {
+		return "", "", xerrors.Errorf("instanceID is required to comput object name")
+	}
+	return rs.Upload(ctx, source, InstanceObjectName(rs.InstanceID, name), opts...)
+}
+
+// Upload takes all files from a local location and uploads it to the remote storage
+func (rs *DirectS3Storage) Upload(ctx context.Context, source string, name string, opts ...UploadOption) (bucket, obj string, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "DirectUpload")
+	defer tracing.FinishSpan(span, &err)
+
+	options, err := GetUploadOptions(opts)
+	if err != nil {
+		err = xerrors.Errorf("cannot get options: %w", err)
+		return
+	}
+
+	if rs.bucket == nil {
+		err = xerrors.Errorf("no S3 client available - did you call Init()?")
+		return
+	}
+
+	bucket = rs.bucketName()
+	obj = rs.objectName(name)
+	span.LogKV("bucket", bucket)
+	span.LogKV("obj", obj)
+	span.LogKV("s3Bucket", rs.S3Config.Bucket)
+	span.LogKV("region", rs.S3Config.Region)
+
+	err = rs.bucket.upload(ctx, bucket, obj, source, options)
+	return
+}
+
+// Bucket provides the bucket name for a particular user
+func (rs *DirectS3Storage) Bucket(ownerID string) string {
+	return s3BucketName(ownerID)
+}
+
+// BackupObject returns a backup's object name that a direct downloader would download
+func (rs *DirectS3Storage) BackupObject(name string) string {
+	return rs.objectName(name)
+}
+
+func (rs *DirectS3Storage) bucketName() string {
+	return s3BucketName(rs.Username)
+}
+
+func (rs *DirectS3Storage) objectName(name string) string {
+	return s3WorkspaceBackupObjectName(rs.WorkspaceName, name)
+}
+
+func newPresignedS3Access(cfg config.S3Config) (*presignedS3Storage, error) {
+	bkt, err := newS3Bucket(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &presignedS3Storage{bucket: bkt}, nil
+}
+
+type presignedS3Storage struct {
+	bucket *s3Bucket
+}
+
+// EnsureExists makes sure that the remote storage location exists and can be up- or downloaded from
+func (s *presignedS3Storage) EnsureExists(ctx context.Context, bucket string) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "s3.EnsureExists")
+	defer tracing.FinishSpan(span, &err)
+
+	// prefixes need not be created, hence all that matters is the S3 bucket
+	return s.bucket.ensureExists(ctx)
+}
+
+func (s *presignedS3Storage) DiskUsage(ctx context.Context, bucket string, prefix string) (size int64, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "s3.DiskUsage")
+	defer tracing.FinishSpan(span, &err)
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	objects, err := s.bucket.list(ctx, bucket, prefix)
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	for _, object := range objects {
+		total += object.Size
+	}
+	return total, nil
+}
+
+// ListObjects describes all objects in the given bucket whose name has the given prefix
+func (s *presignedS3Storage) ListObjects(ctx context.Context, bucket string, prefix string) (objects []ObjectInfo, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "s3.ListObjects")
+	defer tracing.FinishSpan(span, &err)
+
+	infos, err := s.bucket.list(ctx, bucket, prefix)
+	if err == ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range infos {
+		objects = append(objects, ObjectInfo{
+			Name:         info.Key,
+			Size:         info.Size,
+			LastModified: info.LastModified,
+		})
+	}
+	return objects, nil
+}
+
+// SignDownload describes an object for download. Objects with server-side encryption need no special
+// treatment, as S3 decrypts them for whoever is authorized to download them.
+func (s *presignedS3Storage) SignDownload(ctx context.Context, bucket, object string, options *SignedURLOptions) (info *DownloadInfo, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "s3.SignDownload")
+	defer func() {
+		if err == ErrNotFound {
+			span.LogKV("found", false)
+			tracing.FinishSpan(span, nil)
+			return
+		}
+
+		tracing.FinishSpan(span, &err)
+	}()
+
+	stat, err := s.bucket.stat(ctx, bucket, object)
+	if err != nil {
+		return nil, err
+	}
+	url, err := s.bucket.client.PresignedGetObject(ctx, s.bucket.cfg.Bucket, s.bucket.key(bucket, object), 30*time.Minute, nil)
+	if err != nil {
+		return nil, translateMinioError(err)
+	}
+
+	return &DownloadInfo{
+		Meta: minioObjectMeta(stat),
+		Size: stat.Size,
+		URL:  url.String(),
+	}, nil
+}
+
+// SignUpload describes an object for upload. Clients only send the URL's content, hence objects uploaded
+// this way are encrypted according to the bucket's default encryption rather than our SSE settings.
+func (s *presignedS3Storage) SignUpload(ctx context.Context, bucket, obj string, options *SignedURLOptions) (info *UploadInfo, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "s3.SignUpload")
+	defer tracing.FinishSpan(span, &err)
+
+	url, err := s.bucket.client.PresignedPutObject(ctx, s.bucket.cfg.Bucket, s.bucket.key(bucket, obj), 30*time.Minute)
+	if err != nil {
+		return nil, translateMinioError(err)
+	}
+	return &UploadInfo{URL: url.String()}, nil
+}
+
+func (s *presignedS3Storage) DeleteObject(ctx context.Context, bucket string, query *DeleteObjectQuery) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "s3.DeleteObject")
+	defer tracing.FinishSpan(span, &err)
+
+	if query.Name != "" {
+		err = s.bucket.client.RemoveObject(ctx, s.bucket.cfg.Bucket, s.bucket.key(bucket, query.Name), minio.RemoveObjectOptions{})
+		if err != nil {
+			log.WithField("bucket", bucket).WithField("object", query.Name).Error(err)
+			return translateMinioError(err)
+		}
+		return nil
+	}
+	if query.Prefix != "" {
+		return s.bucket.removePrefix(ctx, bucket, query.Prefix)
+	}
+	return nil
+}
+
+// DeleteBucket deletes all objects of a bucket. The S3 bucket itself is shared by all owners and stays.
+func (s *presignedS3Storage) DeleteBucket(ctx context.Context, bucket string) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "s3.DeleteBucket")
+	defer tracing.FinishSpan(span, &err)
+
+	return s.bucket.removePrefix(ctx, bucket, "")
+}
+
+// ObjectHash gets a hash value of an object
+func (s *presignedS3Storage) ObjectHash(ctx context.Context, bucket string, obj string) (hash string, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "s3.ObjectHash")
+	defer tracing.FinishSpan(span, &err)
+
+	stat, err := s.bucket.stat(ctx, bucket, obj)
+	if err != nil {
+		return "", err
+	}
+	return stat.ETag, nil
+}
+
+func (s *presignedS3Storage) ObjectExists(ctx context.Context, bucket, obj string) (exists bool, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "s3.ObjectExists")
+	defer tracing.FinishSpan(span, &err)
+
+	_, err = s.bucket.stat(ctx, bucket, obj)
+	if err == ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ListOwners lists the owners which have content in the remote storage
+func (s *presignedS3Storage) ListOwners(ctx context.Context) (owners []string, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "s3.ListOwners")
+	defer tracing.FinishSpan(span, &err)
+
+	prefix := s3BucketName("")
+	for object := range s.bucket.client.ListObjects(ctx, s.bucket.cfg.Bucket, minio.ListObjectsOptions{Prefix: prefix}) {
+		if object.Err != nil {
+			return nil, translateMinioError(object.Err)
+		}
+		if !strings.HasSuffix(object.Key, "/") {
+			continue
+		}
+		owners = append(owners, strings.TrimSuffix(strings.TrimPrefix(object.Key, prefix), "/"))
+	}
+	return owners, nil
+}
+
+// Bucket provides the bucket name for a particular user
+func (s *presignedS3Storage) Bucket(ownerID string) string {
+	return s3BucketName(ownerID)
+}
+
+// BlobObject returns a blob's object name
+func (s *presignedS3Storage) BlobObject(name string) (string, error) {
+	return blobObjectName(name)
+}
+
+// BackupObject returns a backup's object name that a direct downloader would download
+func (s *presignedS3Storage) BackupObject(ownerID string, workspaceID, name string) string {
+	return s3WorkspaceBackupObjectName(workspaceID, name)
+}
+
+// InstanceObject returns a instance's object name that a direct downloader would download
+func (s *presignedS3Storage) InstanceObject(ownerID string, workspaceID string, instanceID string, name string) string {
+	return s.BackupObject(ownerID, workspaceID, InstanceObjectName(instanceID, name))
+}
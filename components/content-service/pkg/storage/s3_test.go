@@ -0,0 +1,527 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	config "github.com/gitpod-io/gitpod/content-service/api/config"
+)
+
+const testS3Bucket = "test-bucket"
+
+type fakeS3Object struct {
+	Content []byte
+	Header  http.Header
+}
+
+// fakeS3 implements the parts of the S3 API our storage uses, with a single bucket
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string]fakeS3Object
+	uploads map[string]map[int][]byte
+	parts   int
+}
+
+func newFakeS3(t *testing.T) (*fakeS3, config.S3Config) {
+	f := &fakeS3{
+		objects: make(map[string]fakeS3Object),
+		uploads: make(map[string]map[int][]byte),
+	}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "access-key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret-key")
+	return f, config.S3Config{
+		Bucket:      testS3Bucket,
+		Region:      "eu-central-1",
+		Endpoint:    strings.TrimPrefix(srv.URL, "http://"),
+		Insecure:    true,
+		Credentials: []config.S3CredentialSource{config.S3CredentialsEnv},
+	}
+}
+
+func (f *fakeS3) Keys() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	res := make([]string, 0, len(f.objects))
+	for k := range f.objects {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	segs := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	if segs[0] != testS3Bucket {
+		writeFakeS3Error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+	var (
+		key   string
+		query = r.URL.Query()
+	)
+	if len(segs) == 2 {
+		key = segs[1]
+	}
+
+	switch {
+	case key == "" && r.Method == http.MethodHead:
+		w.WriteHeader(http.StatusOK)
+	case key == "" && r.Method == http.MethodGet && query.Get("list-type") == "2":
+		f.list(w, query.Get("prefix"), query.Get("delimiter"))
+	case key == "" && r.Method == http.MethodPost && query.Has("delete"):
+		var req struct {
+			Objects []struct {
+				Key string `xml:"Key"`
+			} `xml:"Object"`
+		}
+		_ = xml.NewDecoder(r.Body).Decode(&req)
+		for _, obj := range req.Objects {
+			delete(f.objects, obj.Key)
+		}
+		_, _ = w.Write([]byte(`<DeleteResult></DeleteResult>`))
+	case r.Method == http.MethodHead || r.Method == http.MethodGet:
+		obj, ok := f.objects[key]
+		if !ok {
+			writeFakeS3Error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		for k, v := range obj.Header {
+			w.Header()[k] = v
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(obj.Content)))
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		w.Header().Set("ETag", fmt.Sprintf(`"%x"`, len(obj.Content)))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(obj.Content)
+		}
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		id := strconv.Itoa(len(f.uploads) + 1)
+		f.uploads[id] = make(map[int][]byte)
+		f.objects[key] = fakeS3Object{Header: fakeS3ObjectHeader(r.Header)}
+		fmt.Fprintf(w, `<InitiateMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key><UploadId>%s</UploadId></InitiateMultipartUploadResult>`, testS3Bucket, key, id)
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		part, _ := strconv.Atoi(query.Get("partNumber"))
+		content, _ := io.ReadAll(r.Body)
+		f.uploads[query.Get("uploadId")][part] = content
+		f.parts++
+		w.Header().Set("ETag", fmt.Sprintf(`"part-%d"`, part))
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		parts := f.uploads[query.Get("uploadId")]
+		obj := f.objects[key]
+		for i := 1; i <= len(parts); i++ {
+			obj.Content = append(obj.Content, parts[i]...)
+		}
+		f.objects[key] = obj
+		fmt.Fprintf(w, `<CompleteMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key><ETag>"done"</ETag></CompleteMultipartUploadResult>`, testS3Bucket, key)
+	case r.Method == http.MethodPut:
+		content, err := readFakeS3Body(r)
+		if err != nil {
+			writeFakeS3Error(w, http.StatusBadRequest, "InvalidRequest")
+			return
+		}
+		f.objects[key] = fakeS3Object{Content: content, Header: fakeS3ObjectHeader(r.Header)}
+		w.Header().Set("ETag", `"single"`)
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeFakeS3Error(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (f *fakeS3) list(w http.ResponseWriter, prefix, delimiter string) {
+	type content struct {
+		Key          string
+		LastModified string
+		ETag         string
+		Size         int
+	}
+	type commonPrefix struct {
+		Prefix string
+	}
+	res := struct {
+		XMLName        xml.Name `xml:"ListBucketResult"`
+		Name           string
+		Prefix         string
+		KeyCount       int
+		MaxKeys        int
+		IsTruncated    bool
+		Contents       []content
+		CommonPrefixes []commonPrefix
+	}{Name: testS3Bucket, Prefix: prefix, MaxKeys: 1000}
+
+	seen := make(map[string]struct{})
+	keys := make([]string, 0, len(f.objects))
+	for k := range f.objects {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		if delimiter != "" {
+			if idx := strings.Index(k[len(prefix):], delimiter); idx >= 0 {
+				p := k[:len(prefix)+idx+len(delimiter)]
+				if _, ok := seen[p]; !ok {
+					seen[p] = struct{}{}
+					res.CommonPrefixes = append(res.CommonPrefixes, commonPrefix{Prefix: p})
+				}
+				continue
+			}
+		}
+		res.Contents = append(res.Contents, content{
+			Key:          k,
+			LastModified: time.Now().UTC().Format(time.RFC3339),
+			ETag:         `"etag"`,
+			Size:         len(f.objects[k].Content),
+		})
+	}
+	res.KeyCount = len(res.Contents) + len(res.CommonPrefixes)
+	_ = xml.NewEncoder(w).Encode(res)
+}
+
+func fakeS3ObjectHeader(h http.Header) http.Header {
+	res := make(http.Header)
+	for k, v := range h {
+		if strings.HasPrefix(k, "X-Amz-Meta-") || strings.HasPrefix(k, "X-Amz-Server-Side-Encryption") || k == "Content-Type" {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// readFakeS3Body reads a request body, which minio-go sends aws-chunked encoded over plain HTTP
+func readFakeS3Body(r *http.Request) ([]byte, error) {
+	if r.Header.Get("X-Amz-Content-Sha256") != "STREAMING-AWS4-HMAC-SHA256-PAYLOAD" {
+		return io.ReadAll(r.Body)
+	}
+
+	var (
+		br  = bufio.NewReader(r.Body)
+		res []byte
+	)
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.ParseInt(strings.SplitN(strings.TrimSpace(line), ";", 2)[0], 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		chunk := make([]byte, size+2)
+		_, err = io.ReadFull(br, chunk)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return res, nil
+		}
+		res = append(res, chunk[:size]...)
+	}
+}
+
+func writeFakeS3Error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+}
+
+func TestS3Storage(t *testing.T) {
+	ctx := context.Background()
+	fake, cfg := newFakeS3(t)
+	cfg.ServerSideEncryption = config.S3SSES3
+	cfg.PartSize = minS3PartSize
+
+	rs, err := newDirectS3Access(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rs.Init(ctx, "owner", "workspace", "instance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rs.EnsureExists(ctx)
+	if err != nil {
+		t.Fatalf("cannot ensure bucket exists: %v", err)
+	}
+
+	src := filepath.Join(t.TempDir(), "backup.tar")
+	err = os.WriteFile(src, buildTestTarbal(t, map[string][]byte{"hello.txt": []byte("hello world")}), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bkt, obj, err := rs.Upload(ctx, src, DefaultBackup, WithAnnotations(map[string]string{ObjectAnnotationPrebuild: "true"}))
+	if err != nil {
+		t.Fatalf("cannot upload: %v", err)
+	}
+	if bkt != "gitpod-user-owner" || obj != "workspaces/workspace/full.tar" {
+		t.Errorf("unexpected upload location: %s %s", bkt, obj)
+	}
+
+	large := make([]byte, 2*minS3PartSize+42)
+	rand.New(rand.NewSource(42)).Read(large)
+	src = filepath.Join(t.TempDir(), "large.bin")
+	err = os.WriteFile(src, large, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = rs.UploadInstance(ctx, src, "large.bin")
+	if err != nil {
+		t.Fatalf("cannot upload large object: %v", err)
+	}
+	if fake.parts != 3 {
+		t.Errorf("expected large object to be uploaded in 3 parts, got %d", fake.parts)
+	}
+
+	other, err := newDirectS3Access(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = other.Init(ctx, "other-owner", "other-workspace", "other-instance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = other.Upload(ctx, src, DefaultBackup)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]string{
+		"gitpod-user-other-owner/workspaces/other-workspace/full.tar",
+		"gitpod-user-owner/workspaces/workspace/full.tar",
+		"gitpod-user-owner/workspaces/workspace/instances/instance/large.bin",
+	}, fake.Keys()); diff != "" {
+		t.Errorf("unexpected keys in bucket (-want +got):\n%s", diff)
+	}
+	stored := fake.objects["gitpod-user-owner/workspaces/workspace/instances/instance/large.bin"]
+	if !bytes.Equal(stored.Content, large) {
+		t.Errorf("multipart upload was not assembled correctly")
+	}
+	if sse := stored.Header.Get("X-Amz-Server-Side-Encryption"); sse != string(config.S3SSES3) {
+		t.Errorf("unexpected server-side encryption: %q", sse)
+	}
+
+	dst := t.TempDir()
+	found, err := rs.DownloadSnapshot(ctx, dst, rs.Qualify(DefaultBackup), nil)
+	if err != nil || !found {
+		t.Fatalf("cannot download snapshot: found=%v err=%v", found, err)
+	}
+	content, err := os.ReadFile(filepath.Join(dst, "hello.txt"))
+	if err != nil || string(content) != "hello world" {
+		t.Errorf("unexpected downloaded content: %q (%v)", content, err)
+	}
+	found, err = rs.Download(ctx, t.TempDir(), "does-not-exist.tar", nil)
+	if err != nil || found {
+		t.Errorf("expected missing object to be not found, got found=%v err=%v", found, err)
+	}
+	objs, err := rs.ListObjects(ctx, rs.BackupObject(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"workspaces/workspace/full.tar", "workspaces/workspace/instances/instance/large.bin"}, objs); diff != "" {
+		t.Errorf("unexpected direct listing (-want +got):\n%s", diff)
+	}
+
+	s, err := newPresignedS3Access(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	owners, err := s.ListOwners(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"other-owner", "owner"}, owners); diff != "" {
+		t.Errorf("unexpected owners (-want +got):\n%s", diff)
+	}
+
+	info, err := s.SignDownload(ctx, s.Bucket("owner"), s.BackupObject("owner", "workspace", DefaultBackup), nil)
+	if err != nil {
+		t.Fatalf("cannot sign download: %v", err)
+	}
+	u, err := url.Parse(info.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Path != "/test-bucket/gitpod-user-owner/workspaces/workspace/full.tar" || u.Query().Get("X-Amz-Signature") == "" {
+		t.Errorf("unexpected download URL: %s", info.URL)
+	}
+	if info.Meta.Prebuild == nil || !*info.Meta.Prebuild {
+		t.Errorf("annotations were not preserved: %+v", info.Meta)
+	}
+	_, err = s.SignDownload(ctx, s.Bucket("owner"), "does-not-exist", nil)
+	if err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	upload, err := s.SignUpload(ctx, s.Bucket("owner"), "blobs/foo", nil)
+	if err != nil {
+		t.Fatalf("cannot sign upload: %v", err)
+	}
+	if !strings.Contains(upload.URL, "/test-bucket/gitpod-user-owner/blobs/foo?") {
+		t.Errorf("unexpected upload URL: %s", upload.URL)
+	}
+
+	usage, err := s.DiskUsage(ctx, s.Bucket("owner"), "workspaces")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usage != int64(len(large)+len(fake.objects["gitpod-user-owner/workspaces/workspace/full.tar"].Content)) {
+		t.Errorf("unexpected disk usage: %d", usage)
+	}
+
+	err = s.DeleteBucket(ctx, s.Bucket("owner"))
+	if err != nil {
+		t.Fatalf("cannot delete bucket: %v", err)
+	}
+	if diff := cmp.Diff([]string{"gitpod-user-other-owner/workspaces/other-workspace/full.tar"}, fake.Keys()); diff != "" {
+		t.Errorf("deleting an owner's bucket must leave other owners alone (-want +got):\n%s", diff)
+	}
+	exists, err := s.ObjectExists(ctx, s.Bucket("other-owner"), s.BackupObject("other-owner", "other-workspace", DefaultBackup))
+	if err != nil || !exists {
+		t.Errorf("expected object of other owner to exist: exists=%v err=%v", exists, err)
+	}
+}
+
+func TestS3Credentials(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "accessKeyId")
+	secretFile := filepath.Join(t.TempDir(), "secretAccessKey")
+	_ = os.WriteFile(keyFile, []byte("static-key\n"), 0600)
+	_ = os.WriteFile(secretFile, []byte("static-secret\n"), 0600)
+
+	tests := []struct {
+		Name        string
+		Env         bool
+		Credentials []config.S3CredentialSource
+		Expectation string
+	}{
+		{
+			Name:        "static",
+			Credentials: []config.S3CredentialSource{config.S3CredentialsStatic},
+			Expectation: "static-key",
+		},
+		{
+			Name:        "env",
+			Env:         true,
+			Credentials: []config.S3CredentialSource{config.S3CredentialsEnv},
+			Expectation: "env-key",
+		},
+		{
+			Name:        "first source providing credentials wins",
+			Env:         true,
+			Credentials: []config.S3CredentialSource{config.S3CredentialsEnv, config.S3CredentialsStatic},
+			Expectation: "env-key",
+		},
+		{
+			Name:        "sources without credentials are skipped",
+			Credentials: []config.S3CredentialSource{config.S3CredentialsWebIdentity, config.S3CredentialsEnv, config.S3CredentialsStatic},
+			Expectation: "static-key",
+		},
+		{
+			Name:        "no credentials",
+			Credentials: []config.S3CredentialSource{config.S3CredentialsWebIdentity, config.S3CredentialsEnv},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", "")
+			if test.Env {
+				t.Setenv("AWS_ACCESS_KEY_ID", "env-key")
+				t.Setenv("AWS_SECRET_ACCESS_KEY", "env-secret")
+			} else {
+				t.Setenv("AWS_ACCESS_KEY_ID", "")
+				t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+			}
+
+			creds, err := s3Credentials(&config.S3Config{
+				Region:              "eu-central-1",
+				Credentials:         test.Credentials,
+				AccessKeyIdFile:     keyFile,
+				SecretAccessKeyFile: secretFile,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			val, err := creds.Get()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if val.AccessKeyID != test.Expectation {
+				t.Errorf("unexpected access key: %q", val.AccessKeyID)
+			}
+		})
+	}
+}
+
+func TestValidateS3Config(t *testing.T) {
+	valid := func() config.S3Config {
+		return config.S3Config{Bucket: "bucket", Region: "eu-central-1"}
+	}
+	tests := []struct {
+		Name   string
+		Modify func(*config.S3Config)
+		Valid  bool
+	}{
+		{Name: "valid", Modify: func(c *config.S3Config) {}, Valid: true},
+		{Name: "missing bucket", Modify: func(c *config.S3Config) { c.Bucket = "" }},
+		{Name: "missing region", Modify: func(c *config.S3Config) { c.Region = "" }},
+		{Name: "unknown credential source", Modify: func(c *config.S3Config) { c.Credentials = []config.S3CredentialSource{"foo"} }},
+		{Name: "static without files", Modify: func(c *config.S3Config) { c.Credentials = []config.S3CredentialSource{config.S3CredentialsStatic} }},
+		{Name: "unknown sse", Modify: func(c *config.S3Config) { c.ServerSideEncryption = "foo" }},
+		{Name: "kms without key", Modify: func(c *config.S3Config) { c.ServerSideEncryption = config.S3SSEKMS }},
+		{
+			Name: "kms",
+			Modify: func(c *config.S3Config) {
+				c.ServerSideEncryption = config.S3SSEKMS
+				c.KMSKeyID = "key"
+			},
+			Valid: true,
+		},
+		{Name: "part size too small", Modify: func(c *config.S3Config) { c.PartSize = 1024 }},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			cfg := valid()
+			test.Modify(&cfg)
+			err := ValidateS3Config(&cfg)
+			if test.Valid && err != nil {
+				t.Errorf("expected config to be valid: %v", err)
+			}
+			if !test.Valid && err == nil {
+				t.Errorf("expected config to be invalid")
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"time"
 
 	"golang.org/x/xerrors"
 
@@ -76,6 +77,9 @@ type PresignedAccess interface {
 	// SignUpload describes an object for upload
 	SignUpload(ctx context.Context, bucket, obj string, options *SignedURLOptions) (info *UploadInfo, err error)
 
+	// ListObjects describes all objects in the given bucket whose name has the given prefix
+	ListObjects(ctx context.Context, bucket string, prefix string) ([]ObjectInfo, error)
+
 	// DeleteObject deletes objects in the given bucket specified by the given query
 	DeleteObject(ctx context.Context, bucket string, query *DeleteObjectQuery) error
 
@@ -93,6 +97,9 @@ type PresignedAccess interface {
 
 	// InstanceObject returns a instance's object name that a direct downloader would download
 	InstanceObject(ownerID string, workspaceID string, instanceID string, name string) string
+
+	// ListOwners lists the owners which have content in the remote storage
+	ListOwners(ctx context.Context) ([]string, error)
 }
 
 // ObjectMeta describtes the metadata of a remote object
@@ -101,6 +108,24 @@ type ObjectMeta struct {
 	OCIMediaType       string
 	Digest             string
 	UncompressedDigest string
+
+	// Encryption is the algorithm the object was encrypted with, or empty if the object is not encrypted
+	Encryption string
+	// KeyOwner is the owner whose key-encryption key wrapped the object's data key
+	KeyOwner string
+	// WrappedKey is the base64 encoded data key of the object, wrapped by the owner's key-encryption key
+	WrappedKey string
+
+	// Prebuild tells whether the object is a snapshot produced by a prebuild. It is nil if the object is not annotated,
+	// e.g. because it was uploaded before snapshots were annotated.
+	Prebuild *bool
+}
+
+// ObjectInfo describes an object as found when listing a bucket
+type ObjectInfo struct {
+	Name         string
+	Size         int64
+	LastModified time.Time
 }
 
 // DownloadInfo describes an object for download
@@ -211,8 +236,34 @@ const (
 
 	// ObjectAnnotationOCIContentType is the OCI media type of the object
 	ObjectAnnotationOCIContentType = "gitpod-oci-contentType"
+
+	// ObjectAnnotationCompression is the compression algorithm applied to the object, e.g. zstd or gzip
+	ObjectAnnotationCompression = "gitpod-compression"
+
+	// ObjectAnnotationEncryption is the encryption algorithm applied to the object
+	ObjectAnnotationEncryption = "gitpod-encryption"
+
+	// ObjectAnnotationKeyOwner is the owner whose key-encryption key wrapped the object's data key
+	ObjectAnnotationKeyOwner = "gitpod-encryption-owner"
+
+	// ObjectAnnotationWrappedKey is the object's wrapped data key
+	ObjectAnnotationWrappedKey = "gitpod-encryption-key"
+
+	// ObjectAnnotationPrebuild is "true" on snapshots produced by a prebuild and "false" on all other snapshots.
+	// Retention never deletes prebuild snapshots by age, nor snapshots which lack the annotation.
+	ObjectAnnotationPrebuild = "gitpod-prebuild"
 )
 
+// parsePrebuildAnnotation parses the value of the ObjectAnnotationPrebuild annotation, which is empty if the
+// object is not annotated
+func parsePrebuildAnnotation(v string) *bool {
+	if v == "" {
+		return nil
+	}
+	prebuild := v == "true"
+	return &prebuild
+}
+
 // NewDirectAccess provides direct access to a storage system
 func NewDirectAccess(c *config.StorageConfig) (DirectAccess, error) {
 	stage := c.GetStage()
@@ -220,14 +271,37 @@ func NewDirectAccess(c *config.StorageConfig) (DirectAccess, error) {
 		return nil, xerrors.Errorf("missing storage stage")
 	}
 
+	var (
+		da  DirectAccess
+		err error
+	)
 	switch c.Kind {
 	case config.GCloudStorage:
-		return newDirectGCPAccess(c.GCloudConfig, stage)
+		da, err = newDirectGCPAccess(c.GCloudConfig, stage)
 	case config.MinIOStorage:
-		return newDirectMinIOAccess(c.MinIOConfig)
+		da, err = newDirectMinIOAccess(c.MinIOConfig)
+	case config.FileSystemStorage:
+		da, err = newDirectFileSystemAccess(c.FileSystemConfig)
+	case config.S3Storage:
+		da, err = newDirectS3Access(c.S3Config)
 	default:
 		return &DirectNoopStorage{}, nil
 	}
+	if err != nil {
+		return nil, err
+	}
+	if rd, ok := da.(rangeDownloader); ok {
+		rd.setDownloadConfig(c.Download)
+	}
+
+	if c.Encryption != nil {
+		keys, err := NewKeyProvider(c.Encryption)
+		if err != nil {
+			return nil, err
+		}
+		da = NewEncryptedDirectAccess(da, keys)
+	}
+	return da, nil
 }
 
 // NewPresignedAccess provides presigned URLs to access a storage system
@@ -242,6 +316,10 @@ func NewPresignedAccess(c *config.StorageConfig) (PresignedAccess, error) {
 		return newPresignedGCPAccess(c.GCloudConfig, stage)
 	case config.MinIOStorage:
 		return newPresignedMinIOAccess(c.MinIOConfig)
+	case config.FileSystemStorage:
+		return newPresignedFileSystemAccess(c.FileSystemConfig)
+	case config.S3Storage:
+		return newPresignedS3Access(c.S3Config)
 	default:
 		log.Warnf("falling back to noop presigned storage access. Is this intentional? (storage kind: %s)", c.Kind)
 		return &PresignedNoopStorage{}, nil
@@ -36,6 +36,20 @@ export default function PendingChangesDropdown(props: { workspaceInstance?: Work
                 menuEntries.push({ title: item, customFontStyle: itemStyle }),
             );
         }
+        if ((repo.totalUnpushedLfsObjects || 0) > 0) {
+            totalChanges += repo.totalUnpushedLfsObjects || 0;
+            menuEntries.push({ title: "Unpushed LFS Objects", customFontStyle: headingStyle });
+            (repo.unpushedLfsObjects || []).forEach((item) =>
+                menuEntries.push({ title: item, customFontStyle: itemStyle }),
+            );
+        }
+        if ((repo.totalMissingLfsObjects || 0) > 0) {
+            // missing objects are no changes, they have not been fetched from the remote
+            menuEntries.push({ title: "Missing LFS Objects", customFontStyle: headingStyle });
+            (repo.missingLfsObjects || []).forEach((item) =>
+                menuEntries.push({ title: item, customFontStyle: itemStyle }),
+            );
+        }
     }
     if (totalChanges <= 0) {
         return <div className="text-sm text-gray-400 dark:text-gray-500">No Changes</div>;
@@ -36,10 +36,16 @@ export default function Preferences() {
     };
 
     const [dotfileRepo, setDotfileRepo] = useState<string>(user?.additionalData?.dotfileRepo || "");
+    const [dotfileRef, setDotfileRef] = useState<string>(user?.additionalData?.dotfileRef || "");
+    const [dotfileSigningKeys, setDotfileSigningKeys] = useState<string>(
+        user?.additionalData?.dotfileSigningKeys || "",
+    );
     const actuallySetDotfileRepo = async (value: string) => {
         const additionalData = user?.additionalData || {};
         const prevDotfileRepo = additionalData.dotfileRepo || "";
         additionalData.dotfileRepo = value;
+        additionalData.dotfileRef = dotfileRef.trim() || undefined;
+        additionalData.dotfileSigningKeys = dotfileSigningKeys.trim() || undefined;
         await getGitpodService().server.updateLoggedInUser({ additionalData });
         if (value !== prevDotfileRepo) {
             trackEvent("dotfile_repo_changed", {
@@ -134,6 +140,31 @@ export default function Preferences() {
                             clone and install your dotfiles for every new workspace.
                         </p>
                     </div>
+                    <h4 className="mt-4">Branch, Tag or Commit</h4>
+                    <input
+                        type="text"
+                        value={dotfileRef}
+                        className="w-96 h-9"
+                        placeholder="default branch"
+                        onChange={(e) => setDotfileRef(e.target.value)}
+                    />
+                    <div className="mt-1">
+                        <p className="text-gray-500 dark:text-gray-400">
+                            Pin your dotfiles to a commit to install the same version in every workspace.
+                        </p>
+                    </div>
+                    <h4 className="mt-4">Signing Keys</h4>
+                    <textarea
+                        value={dotfileSigningKeys}
+                        className="w-96 h-24 font-mono text-xs"
+                        placeholder="-----BEGIN PGP PUBLIC KEY BLOCK-----"
+                        onChange={(e) => setDotfileSigningKeys(e.target.value)}
+                    />
+                    <div className="mt-1">
+                        <p className="text-gray-500 dark:text-gray-400">
+                            If set, dotfiles are only installed if their commit is signed by one of these GPG keys.
+                        </p>
+                    </div>
                 </div>
             </PageWithSettingsSubMenu>
         </div>
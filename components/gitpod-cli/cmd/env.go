@@ -36,7 +36,8 @@ var envCmd = &cobra.Command{
 To set the persistent environment variable 'foo' to the value 'bar' use:
 	gp env foo=bar
 
-Beware that this does not modify your current terminal session, but rather persists this variable for the next workspace on this repository.
+Beware that this does not modify your current terminal session. Running workspaces on this repository pick up the change
+within a few seconds for new terminals and offer to restart running tasks, and the next workspace starts with it.
 This command can only interact with environment variables for this repository. If you want to set that environment variable in your terminal,
 you can do so using -e:
 	eval $(gp env -e foo=bar)
@@ -44,6 +45,9 @@ you can do so using -e:
 To update the current terminal session with the latest set of persistent environment variables, use:
     eval $(gp env -e)
 
+Alternatively, the variables which changed since the workspace was started are kept in ~/.gitpod-env:
+    source ~/.gitpod-env
+
 To delete a persistent environment variable use:
 	gp env -u foo
 
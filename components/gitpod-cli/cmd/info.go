@@ -51,6 +51,13 @@ var infoCmd = &cobra.Command{
 			log.Fatal(err)
 		}
 
+		dotfiles, err := supervisor.NewStatusServiceClient(conn).DotfilesStatus(ctx, &supervisor.DotfilesStatusRequest{})
+		if err != nil {
+			log.WithError(err).Debug("cannot fetch the dotfiles status")
+		} else if dotfiles.Phase != supervisor.DotfilesPhase_not_configured {
+			data.Dotfiles = dotfiles
+		}
+
 		if infoCmdOpts.Json {
 			content, _ := json.Marshal(data)
 			fmt.Println(string(content))
@@ -67,6 +74,7 @@ type infoData struct {
 	WorkspaceClass *supervisor.WorkspaceInfoResponse_WorkspaceClass `json:"workspace_class"`
 	WorkspaceUrl   string                                           `json:"workspace_url"`
 	ClusterHost    string                                           `json:"cluster_host"`
+	Dotfiles       *supervisor.DotfilesStatusResponse               `json:"dotfiles,omitempty"`
 }
 
 func outputInfo(info *infoData) {
@@ -79,9 +87,34 @@ func outputInfo(info *infoData) {
 	table.Append([]string{"Workspace class", fmt.Sprintf("%s: %s", info.WorkspaceClass.DisplayName, info.WorkspaceClass.Description)})
 	table.Append([]string{"Workspace URL", info.WorkspaceUrl})
 	table.Append([]string{"Cluster host", info.ClusterHost})
+	if info.Dotfiles != nil {
+		table.Append([]string{"Dotfiles", formatDotfilesStatus(info.Dotfiles)})
+	}
 	table.Render()
 }
 
+func formatDotfilesStatus(status *supervisor.DotfilesStatusResponse) string {
+	commit := status.Commit
+	if len(commit) > 7 {
+		commit = commit[:7]
+	}
+	switch status.Phase {
+	case supervisor.DotfilesPhase_install_failed:
+		return fmt.Sprintf("failed: %s, see %s", status.Failure, status.LogPath)
+	case supervisor.DotfilesPhase_installed:
+		res := "installed"
+		if commit != "" {
+			res += " " + commit
+		}
+		if status.Verified {
+			res += " (verified)"
+		}
+		return res
+	default:
+		return status.Phase.String()
+	}
+}
+
 func init() {
 	infoCmd.Flags().BoolVarP(&infoCmdOpts.Json, "json", "j", false, "Output in JSON format")
 	rootCmd.AddCommand(infoCmd)
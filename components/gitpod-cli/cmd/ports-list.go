@@ -54,6 +54,9 @@ var listPortsCmd = &cobra.Command{
 
 			if !port.Served {
 				status = "not served"
+			} else if port.Protocol == supervisor.PortsStatus_udp && !accessible {
+				// UDP ports cannot be exposed
+				status = "served"
 			} else if !accessible {
 				if port.AutoExposure == supervisor.PortAutoExposure_failed {
 					status = "failed to expose"
@@ -71,6 +74,14 @@ var listPortsCmd = &cobra.Command{
 					status = "open (private)"
 					statusColor = tablewriter.FgHiCyanColor
 				}
+				if port.Exposed.Visibility == supervisor.PortVisibility_team {
+					status = "open (team)"
+					statusColor = tablewriter.FgHiCyanColor
+				}
+				if port.Exposed.Visibility == supervisor.PortVisibility_token {
+					status = "open (token)"
+					statusColor = tablewriter.FgHiCyanColor
+				}
 			} else if port.Tunneled != nil {
 				if port.Tunneled.Visibility == supervisor.TunnelVisiblity(supervisor.TunnelVisiblity_value["network"]) {
 					status = "open on all interfaces"
@@ -96,8 +107,13 @@ var listPortsCmd = &cobra.Command{
 				colors = []tablewriter.Colors{{}, {statusColor}, {}, {}}
 			}
 
+			localPort := fmt.Sprint(port.LocalPort)
+			if port.Protocol == supervisor.PortsStatus_udp {
+				localPort += "/udp"
+			}
+
 			table.Rich(
-				[]string{fmt.Sprint(port.LocalPort), status, exposedUrl, nameAndDescription},
+				[]string{localPort, status, exposedUrl, nameAndDescription},
 				colors,
 			)
 		}
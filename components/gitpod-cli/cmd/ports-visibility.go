@@ -19,8 +19,8 @@ import (
 
 // portsVisibilityCmd change visibility of port
 var portsVisibilityCmd = &cobra.Command{
-	Use:   "visibility <port:{private|public}>",
-	Short: "Make a port public or private",
+	Use:   "visibility <port:{private|public|team|token}>",
+	Short: "Make a port private, public, accessible to the team or accessible with a share link",
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		portVisibility := args[0]
@@ -33,8 +33,10 @@ var portsVisibilityCmd = &cobra.Command{
 			log.Fatal("port should be integer")
 		}
 		visibility := s[1]
-		if visibility != serverapi.PortVisibilityPublic && visibility != serverapi.PortVisibilityPrivate {
-			log.Fatalf("visibility should be `%s` or `%s`", serverapi.PortVisibilityPublic, serverapi.PortVisibilityPrivate)
+		switch visibility {
+		case serverapi.PortVisibilityPublic, serverapi.PortVisibilityPrivate, serverapi.PortVisibilityTeam, serverapi.PortVisibilityToken:
+		default:
+			log.Fatalf("visibility should be `%s`, `%s`, `%s` or `%s`", serverapi.PortVisibilityPrivate, serverapi.PortVisibilityPublic, serverapi.PortVisibilityTeam, serverapi.PortVisibilityToken)
 		}
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
@@ -57,6 +59,9 @@ var portsVisibilityCmd = &cobra.Command{
 			log.Fatalf("failed to change port visibility: %s", err.Error())
 		}
 		fmt.Printf("port %v is now %s\n", port, visibility)
+		if visibility == serverapi.PortVisibilityToken {
+			fmt.Println("the URL shown by `gp ports list` is a share link which expires")
+		}
 	},
 }
 
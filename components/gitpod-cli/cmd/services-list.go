@@ -0,0 +1,73 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	supervisor_helper "github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor-helper"
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/utils"
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+// listServicesCmd represents the services list command
+var listServicesCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the workspace services and their state",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		services, err := supervisor_helper.GetServicesList(ctx)
+		if err != nil {
+			log.Fatalf("cannot get service list: %s", err)
+		}
+
+		if len(services) == 0 {
+			fmt.Println("No services configured")
+			return
+		}
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Name", "Image", "State", "Ready", "Restarts", "Last Exit Code"})
+		table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
+		table.SetCenterSeparator("|")
+
+		mapReadyToColor := map[bool]int{
+			false: tablewriter.FgHiYellowColor,
+			true:  tablewriter.FgHiGreenColor,
+		}
+
+		for _, service := range services {
+			colors := []tablewriter.Colors{}
+			if !noColor && utils.ColorsEnabled() {
+				colors = []tablewriter.Colors{{}, {}, {}, {mapReadyToColor[service.Ready]}, {}, {}}
+			}
+
+			// the exit code is only known once the service exited at least once
+			var lastExitCode string
+			if service.RestartCount > 0 || service.State == supervisor.ServiceState_exited {
+				lastExitCode = strconv.Itoa(int(service.LastExitCode))
+			}
+
+			table.Rich([]string{service.Name, service.Image, service.State.String(), strconv.FormatBool(service.Ready), strconv.Itoa(int(service.RestartCount)), lastExitCode}, colors)
+		}
+
+		table.Render()
+	},
+}
+
+func init() {
+	listServicesCmd.Flags().BoolVarP(&noColor, "no-color", "", false, "Disable output colorization")
+	servicesCmd.AddCommand(listServicesCmd)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	supervisor_helper "github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor-helper"
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+var logsServiceCmdOpts struct {
+	Follow bool
+}
+
+// logsServiceCmd represents the services logs command
+var logsServiceCmd = &cobra.Command{
+	Use:   "logs <name>",
+	Short: "Prints the output of a workspace service",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		services, err := supervisor_helper.GetServicesList(ctx)
+		if err != nil {
+			log.Fatalf("cannot get service list: %s", err)
+		}
+
+		var service *supervisor.ServiceStatus
+		for _, s := range services {
+			if s.Name == args[0] {
+				service = s
+				break
+			}
+		}
+		if service == nil {
+			fmt.Printf("The service was not found: %s.\nUse 'gp services list' to obtain the service names\n", args[0])
+			return
+		}
+
+		followCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		err = printServiceLog(followCtx, os.Stdout, service.LogPath, logsServiceCmdOpts.Follow)
+		if err != nil {
+			log.Fatalf("cannot print the log of %s: %s", service.Name, err)
+		}
+	},
+}
+
+// printServiceLog prints a service log. If follow is true, it keeps printing the output the service produces
+// until the context is canceled, following the log across rotations.
+func printServiceLog(ctx context.Context, out io.Writer, fn string, follow bool) error {
+	f, err := os.Open(fn)
+	if os.IsNotExist(err) && follow {
+		// the service has not produced any output yet
+		f, err = waitForFile(ctx, fn)
+	}
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil || f == nil {
+		return err
+	}
+	defer func() { f.Close() }()
+
+	for {
+		_, err = io.Copy(out, f)
+		if err != nil {
+			return err
+		}
+		if !follow {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(500 * time.Millisecond):
+		}
+
+		// the service wrapper moves the log aside once it grows too large
+		cur, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		latest, err := os.Stat(fn)
+		if err != nil || os.SameFile(cur, latest) {
+			continue
+		}
+		_, err = io.Copy(out, f)
+		if err != nil {
+			return err
+		}
+		f.Close()
+		f, err = os.Open(fn)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func waitForFile(ctx context.Context, fn string) (*os.File, error) {
+	for {
+		f, err := os.Open(fn)
+		if !os.IsNotExist(err) {
+			return f, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+func init() {
+	logsServiceCmd.Flags().BoolVarP(&logsServiceCmdOpts.Follow, "follow", "f", false, "Keep printing the output of the service")
+	servicesCmd.AddCommand(logsServiceCmd)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	supervisor_helper "github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor-helper"
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+// restartServiceCmd represents the services restart command
+var restartServiceCmd = &cobra.Command{
+	Use:   "restart <name>",
+	Short: "Restarts a workspace service",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+		defer cancel()
+
+		conn, err := supervisor_helper.Dial(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		_, err = supervisor.NewControlServiceClient(conn).RestartService(ctx, &supervisor.RestartServiceRequest{Name: args[0]})
+		if err != nil {
+			log.Fatalf("cannot restart %s: %v", args[0], err)
+		}
+	},
+}
+
+func init() {
+	servicesCmd.AddCommand(restartServiceCmd)
+}
@@ -5,46 +5,68 @@
 package cmd
 
 import (
-	"crypto/sha1"
-	"encoding/hex"
+	"context"
 	"fmt"
-	"os"
+	"log"
 	"time"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	supervisor_helper "github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor-helper"
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
 )
 
+var awaitSyncCmdOpts struct {
+	Timeout time.Duration
+	Payload bool
+}
+
 // awaitSyncCmd represents the awaitSync command
 var awaitSyncCmd = &cobra.Command{
 	Use:   "sync-await <name>",
 	Short: "Awaits an event triggered using gp sync-done",
-	Args:  cobra.ExactArgs(1),
+	Long: `Awaits an event triggered using gp sync-done.
+
+Events which are done are persisted in the workspace, i.e. they are still done after
+the workspace was restarted or when it was started from a prebuild.`,
+	Example: `  gp sync-await database
+  DATABASE_URL=$(gp sync-await database --payload --timeout 5m)`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		h := sha1.New()
-		h.Write([]byte(args[0]))
-		id := hex.EncodeToString(h.Sum(nil))
-		lockFile := fmt.Sprintf("/tmp/gp-%s.done", id)
-
-		ticker := time.NewTicker(1 * time.Second)
-		done := make(chan bool)
-		go func() {
-			for {
-				if _, err := os.Stat(lockFile); !os.IsNotExist(err) {
-					break
-				}
-
-				<-ticker.C
-			}
-
-			ticker.Stop()
-			done <- true
-		}()
-
-		<-done
+		ctx := context.Background()
+		if awaitSyncCmdOpts.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, awaitSyncCmdOpts.Timeout)
+			defer cancel()
+		}
+
+		conn, err := supervisor_helper.Dial(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		resp, err := supervisor.NewSyncServiceClient(conn).Await(ctx, &supervisor.SyncAwaitRequest{Name: args[0]})
+		if status.Code(err) == codes.DeadlineExceeded {
+			log.Fatalf("timed out after %s awaiting %s", awaitSyncCmdOpts.Timeout, args[0])
+		}
+		if err != nil {
+			log.Fatalf("cannot await %s: %v", args[0], err)
+		}
+
+		if awaitSyncCmdOpts.Payload {
+			fmt.Println(resp.Barrier.Payload)
+			return
+		}
 		fmt.Printf("%s done\n", args[0])
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(awaitSyncCmd)
+
+	awaitSyncCmd.Flags().DurationVarP(&awaitSyncCmdOpts.Timeout, "timeout", "t", 0, "fail if the event is not done within this duration, e.g. 30s or 5m (no timeout by default)")
+	awaitSyncCmd.Flags().BoolVarP(&awaitSyncCmdOpts.Payload, "payload", "p", false, "print the payload of the event instead of a confirmation")
 }
@@ -5,38 +5,53 @@
 package cmd
 
 import (
-	"crypto/sha1"
-	"encoding/hex"
-	"fmt"
+	"context"
 	"log"
-	"os"
+	"time"
 
 	"github.com/spf13/cobra"
+
+	supervisor_helper "github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor-helper"
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
 )
 
+var syncDoneCmdOpts struct {
+	Payload string
+}
+
 // doneCmd represents the done command
 var syncDoneCmd = &cobra.Command{
 	Use:   "sync-done <name>",
 	Short: "Notifies the corresponding gp sync-await calls that this event has happened",
-	Args:  cobra.ExactArgs(1),
+	Long: `Notifies the corresponding gp sync-await calls that this event has happened.
+
+The event can carry a payload, e.g. a generated port or URL, which gp sync-await prints
+with --payload. An event which is done already keeps its payload until it is reset
+using gp sync-reset.`,
+	Example: `  gp sync-done database --payload "postgres://localhost:5432"`,
+	Args:    cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		h := sha1.New()
-		h.Write([]byte(args[0]))
-		id := hex.EncodeToString(h.Sum(nil))
-		lockFile := fmt.Sprintf("/tmp/gp-%s.done", id)
-
-		if _, err := os.Stat(lockFile); !os.IsNotExist(err) {
-			// file already exists - we're done
-			return
+		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+		defer cancel()
+
+		conn, err := supervisor_helper.Dial(ctx)
+		if err != nil {
+			log.Fatal(err)
 		}
+		defer conn.Close()
 
-		err := os.WriteFile(lockFile, []byte("done"), 0600)
+		_, err = supervisor.NewSyncServiceClient(conn).Done(ctx, &supervisor.SyncDoneRequest{
+			Name:    args[0],
+			Payload: syncDoneCmdOpts.Payload,
+		})
 		if err != nil {
-			log.Fatalf("cannot write lock file: %v", err)
+			log.Fatalf("cannot mark %s as done: %v", args[0], err)
 		}
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(syncDoneCmd)
+
+	syncDoneCmd.Flags().StringVarP(&syncDoneCmdOpts.Payload, "payload", "p", "", "payload of the event, e.g. a generated port or URL")
 }
@@ -0,0 +1,70 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	supervisor_helper "github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor-helper"
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+var listSyncCmdOpts struct {
+	Pending bool
+}
+
+// listSyncCmd represents the sync-list command
+var listSyncCmd = &cobra.Command{
+	Use:   "sync-list",
+	Short: "Lists the events which are done or awaited using gp sync-done and gp sync-await",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		conn, err := supervisor_helper.Dial(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		resp, err := supervisor.NewSyncServiceClient(conn).List(ctx, &supervisor.ListSyncBarriersRequest{PendingOnly: listSyncCmdOpts.Pending})
+		if err != nil {
+			log.Fatalf("cannot list events: %v", err)
+		}
+		if len(resp.Barriers) == 0 {
+			fmt.Println("No events detected")
+			return
+		}
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Name", "State", "Done At", "Waiters", "Payload"})
+		table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
+		table.SetCenterSeparator("|")
+		for _, b := range resp.Barriers {
+			state, doneAt := "pending", ""
+			if b.Done {
+				state = "done"
+				doneAt = b.DoneTime.AsTime().Local().Format(time.RFC3339)
+			}
+			table.Append([]string{b.Name, state, doneAt, strconv.Itoa(int(b.Waiters)), b.Payload})
+		}
+		table.Render()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(listSyncCmd)
+
+	listSyncCmd.Flags().BoolVar(&listSyncCmdOpts.Pending, "pending", false, "list the events which are awaited but not done only")
+}
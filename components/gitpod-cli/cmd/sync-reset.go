@@ -0,0 +1,42 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	supervisor_helper "github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor-helper"
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+// resetSyncCmd represents the sync-reset command
+var resetSyncCmd = &cobra.Command{
+	Use:   "sync-reset <name>",
+	Short: "Resets an event which is done, such that gp sync-await waits for it again",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+		defer cancel()
+
+		conn, err := supervisor_helper.Dial(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		_, err = supervisor.NewSyncServiceClient(conn).Reset(ctx, &supervisor.ResetSyncBarrierRequest{Name: args[0]})
+		if err != nil {
+			log.Fatalf("cannot reset %s: %v", args[0], err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(resetSyncCmd)
+}
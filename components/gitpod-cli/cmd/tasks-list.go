@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	supervisor_helper "github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor-helper"
@@ -38,8 +40,20 @@ var listTasksCmd = &cobra.Command{
 			return
 		}
 
+		// viewers of read-only terminal shares, see 'gp tasks share'
+		watchers := make(map[string][]string)
+		terminalClient, err := supervisor_helper.GetTerminalServiceClient(ctx)
+		if err != nil {
+			log.Fatalf("cannot get terminal service: %s", err)
+		}
+		if shares, err := terminalClient.ListShares(ctx, &supervisor.ListTerminalSharesRequest{}); err == nil {
+			for _, share := range shares.Shares {
+				watchers[share.Alias] = append(watchers[share.Alias], share.Viewers...)
+			}
+		}
+
 		table := tablewriter.NewWriter(os.Stdout)
-		table.SetHeader([]string{"Terminal ID", "Name", "State"})
+		table.SetHeader([]string{"Terminal ID", "Name", "State", "Restarts", "Last Exit Code", "Watchers"})
 		table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
 		table.SetCenterSeparator("|")
 
@@ -47,6 +61,7 @@ var listTasksCmd = &cobra.Command{
 			0: tablewriter.FgHiGreenColor,
 			1: tablewriter.FgHiGreenColor,
 			2: tablewriter.FgHiBlackColor,
+			3: tablewriter.FgHiYellowColor,
 		}
 
 		mapCurrentToColor := map[bool]int{
@@ -62,11 +77,6 @@ var listTasksCmd = &cobra.Command{
 			isCurrent := false
 
 			if task.State == api.TaskState_running {
-				terminalClient, err := supervisor_helper.GetTerminalServiceClient(context.Background())
-				if err != nil {
-					log.Fatalf("cannot get terminal service: %s", err)
-				}
-
 				terminal, err := terminalClient.Get(context.Background(), &supervisor.GetTerminalRequest{Alias: task.Terminal})
 				if err != nil {
 					panic(err)
@@ -78,10 +88,20 @@ var listTasksCmd = &cobra.Command{
 			}
 
 			if !noColor && utils.ColorsEnabled() {
-				colors = []tablewriter.Colors{{mapCurrentToColor[isCurrent]}, {}, {mapStatusToColor[task.State]}}
+				colors = []tablewriter.Colors{{mapCurrentToColor[isCurrent]}, {}, {mapStatusToColor[task.State]}, {}, {}, {}}
+			}
+
+			restarts := strconv.Itoa(int(task.RestartCount))
+			if task.LastRestartTime != nil {
+				restarts += fmt.Sprintf(" (%s ago)", time.Since(task.LastRestartTime.AsTime()).Round(time.Second))
+			}
+			// the exit code is only known once the command exited at least once
+			var lastExitCode string
+			if task.RestartCount > 0 || task.State == api.TaskState_closed {
+				lastExitCode = strconv.Itoa(int(task.LastExitCode))
 			}
 
-			table.Rich([]string{task.Terminal, task.Presentation.Name, task.State.String()}, colors)
+			table.Rich([]string{task.Terminal, task.Presentation.Name, task.State.String(), restarts, lastExitCode, strings.Join(watchers[task.Terminal], ", ")}, colors)
 		}
 
 		table.Render()
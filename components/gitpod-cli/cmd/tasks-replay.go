@@ -0,0 +1,138 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/asciicast"
+	supervisor_helper "github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor-helper"
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+var replayTaskCmdOpts struct {
+	Text          bool
+	Speed         float64
+	IdleTimeLimit time.Duration
+}
+
+// replayTaskCmd represents the replay task command
+var replayTaskCmd = &cobra.Command{
+	Use:   "replay <id>",
+	Short: "Replay the terminal recording of a workspace task",
+	Long: `Replay the terminal recording of a workspace task.
+
+Tasks are recorded if they set 'record: true' in .gitpod.yml.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		tasks, err := supervisor_helper.GetTasksList(ctx)
+		if err != nil {
+			log.Fatalf("cannot get task list: %s", err)
+		}
+
+		var recorded []*supervisor.TaskStatus
+		for _, task := range tasks {
+			if task.Recording != "" {
+				recorded = append(recorded, task)
+			}
+		}
+
+		var task *supervisor.TaskStatus
+		if len(args) > 0 {
+			for _, t := range tasks {
+				if t.Terminal == args[0] || t.Id == args[0] {
+					task = t
+					break
+				}
+			}
+			if task == nil {
+				fmt.Printf("The selected task was not found: %s.\nUse 'gp tasks list' to obtain the task id or run 'gp tasks replay' to select the desired task\n", args[0])
+				return
+			}
+			if task.Recording == "" {
+				fmt.Printf("The task %s is not recorded. Set 'record: true' for the task in .gitpod.yml to record it.\n", task.Presentation.Name)
+				return
+			}
+		} else {
+			if len(recorded) == 0 {
+				fmt.Println("There are no recorded tasks")
+				return
+			}
+
+			taskIndex := 0
+			if len(recorded) > 1 {
+				var taskNames []string
+				for _, t := range recorded {
+					taskNames = append(taskNames, t.Presentation.Name)
+				}
+
+				prompt := promptui.Select{
+					Label:        "What task do you want to replay?",
+					Items:        taskNames,
+					HideSelected: true,
+				}
+
+				selectedIndex, selectedValue, err := prompt.Run()
+				if selectedValue == "" {
+					return
+				}
+				if err != nil {
+					log.Fatalf("error occurred with the input prompt: %s", err)
+				}
+				taskIndex = selectedIndex
+			}
+			task = recorded[taskIndex]
+		}
+
+		files, err := asciicast.Files(task.Recording)
+		if err != nil {
+			log.Fatalf("cannot replay task: %s", err)
+		}
+
+		playCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		for _, fn := range files {
+			f, err := os.Open(fn)
+			if err != nil {
+				log.Fatalf("cannot open recording: %s", err)
+			}
+			if replayTaskCmdOpts.Text {
+				err = asciicast.ExportText(os.Stdout, f)
+			} else {
+				err = asciicast.Play(playCtx, os.Stdout, f, asciicast.PlayOptions{
+					Speed:         replayTaskCmdOpts.Speed,
+					IdleTimeLimit: replayTaskCmdOpts.IdleTimeLimit,
+				})
+			}
+			f.Close()
+			if err == context.Canceled {
+				return
+			}
+			if err != nil {
+				log.Fatalf("cannot replay %s: %s", fn, err)
+			}
+		}
+	},
+}
+
+func init() {
+	tasksCmd.AddCommand(replayTaskCmd)
+
+	replayTaskCmd.Flags().BoolVarP(&replayTaskCmdOpts.Text, "text", "t", false, "export the recording as plain text instead of playing it")
+	replayTaskCmd.Flags().Float64VarP(&replayTaskCmdOpts.Speed, "speed", "s", 1, "playback speed, e.g. 2 plays twice as fast")
+	replayTaskCmd.Flags().DurationVarP(&replayTaskCmdOpts.IdleTimeLimit, "idle-time-limit", "i", 2*time.Second, "limit the time between two outputs, 0 for no limit")
+}
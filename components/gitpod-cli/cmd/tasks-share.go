@@ -0,0 +1,134 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	supervisor_helper "github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor-helper"
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+var shareTaskCmdOpts struct {
+	TTL time.Duration
+}
+
+// shareTaskCmd represents the share task command
+var shareTaskCmd = &cobra.Command{
+	Use:   "share <id>",
+	Short: "Share the terminal of a workspace task read-only",
+	Long: `Share the terminal of a workspace task read-only.
+
+Anyone with the printed link can watch the terminal until the share expires or is revoked
+using 'gp tasks unshare', but nobody can type into or resize it. 'gp tasks list' shows who is watching.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		tasks, err := supervisor_helper.GetTasksListByState(ctx, supervisor.TaskState_running)
+		if err != nil {
+			log.Fatalf("cannot get task list: %s", err)
+		}
+
+		var task *supervisor.TaskStatus
+		if len(args) > 0 {
+			for _, t := range tasks {
+				if t.Terminal == args[0] || t.Id == args[0] {
+					task = t
+					break
+				}
+			}
+			if task == nil {
+				fmt.Printf("The selected task was not found or is not running: %s.\nUse 'gp tasks list' to obtain the task id or run 'gp tasks share' to select the desired task\n", args[0])
+				return
+			}
+		} else {
+			if len(tasks) == 0 {
+				fmt.Println("There are no running tasks")
+				return
+			}
+
+			taskIndex := 0
+			if len(tasks) > 1 {
+				var taskNames []string
+				for _, t := range tasks {
+					taskNames = append(taskNames, t.Presentation.Name)
+				}
+				prompt := promptui.Select{
+					Label:        "What task do you want to share?",
+					Items:        taskNames,
+					HideSelected: true,
+				}
+				selectedIndex, selectedValue, err := prompt.Run()
+				if selectedValue == "" {
+					return
+				}
+				if err != nil {
+					log.Fatalf("error occurred with the input prompt: %s", err)
+				}
+				taskIndex = selectedIndex
+			}
+			task = tasks[taskIndex]
+		}
+
+		conn, err := supervisor_helper.Dial(ctx)
+		if err != nil {
+			log.Fatalf("cannot connect to supervisor: %s", err)
+		}
+		defer conn.Close()
+
+		wsInfo, err := supervisor_helper.FetchInfo(ctx, conn)
+		if err != nil {
+			log.Fatalf("cannot get workspace info: %s", err)
+		}
+
+		resp, err := supervisor.NewTerminalServiceClient(conn).Share(ctx, &supervisor.ShareTerminalRequest{
+			Alias:      task.Terminal,
+			TtlSeconds: uint32(shareTaskCmdOpts.TTL.Seconds()),
+		})
+		if err != nil {
+			log.Fatalf("cannot share task: %s", err)
+		}
+
+		fmt.Printf("Sharing %s read-only until %s (share ID %s):\n", task.Presentation.Name, resp.Share.ExpiresAt.AsTime().Local().Format(time.Kitchen), resp.Share.Id)
+		fmt.Printf("%s/_supervisor/terminal-share/%s\n", strings.TrimSuffix(wsInfo.WorkspaceUrl, "/"), resp.Token)
+		fmt.Printf("Run 'gp tasks unshare %s' to revoke the share.\n", resp.Share.Id)
+	},
+}
+
+// unshareTaskCmd represents the unshare task command
+var unshareTaskCmd = &cobra.Command{
+	Use:   "unshare <share-id>",
+	Short: "Revoke a terminal share and disconnect its viewers",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		terminalClient, err := supervisor_helper.GetTerminalServiceClient(ctx)
+		if err != nil {
+			log.Fatalf("cannot get terminal service: %s", err)
+		}
+
+		_, err = terminalClient.Unshare(ctx, &supervisor.UnshareTerminalRequest{Id: args[0]})
+		if err != nil {
+			log.Fatalf("cannot revoke share %s: %s", args[0], err)
+		}
+	},
+}
+
+func init() {
+	tasksCmd.AddCommand(shareTaskCmd)
+	tasksCmd.AddCommand(unshareTaskCmd)
+
+	shareTaskCmd.Flags().DurationVar(&shareTaskCmdOpts.TTL, "ttl", time.Hour, "time until the share expires (at most 24h)")
+}
@@ -10,7 +10,10 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
+	"strconv"
 	"sync"
+	"syscall"
 	"time"
 
 	supervisor_helper "github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor-helper"
@@ -20,12 +23,18 @@ import (
 	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/utils"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
 
 	"github.com/olekukonko/tablewriter"
 )
 
+// maxTopCommandLength is the length process commands are truncated to
+const maxTopCommandLength = 60
+
 var topCmdOpts struct {
-	Json bool
+	Json     bool
+	Watch    bool
+	Interval time.Duration
 }
 
 type topData struct {
@@ -35,9 +44,13 @@ type topData struct {
 
 var topCmd = &cobra.Command{
 	Use:   "top",
-	Short: "Display usage of workspace resources (CPU and memory)",
+	Short: "Display usage of workspace resources (CPU, memory, disk, IO and network) and the top processes",
 	Run: func(cmd *cobra.Command, args []string) {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if topCmdOpts.Watch && topCmdOpts.Interval <= 0 {
+			log.Fatal("the refresh interval must be positive")
+		}
+
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 		defer cancel()
 
 		conn, err := supervisor_helper.Dial(ctx)
@@ -47,36 +60,67 @@ var topCmd = &cobra.Command{
 
 		defer conn.Close()
 
-		data := &topData{}
-
-		var wg sync.WaitGroup
-		wg.Add(2)
-
-		go func() {
-			workspaceResources, err := supervisor_helper.GetWorkspaceResources(ctx, conn)
+		var workspaceClass *supervisor.WorkspaceInfoResponse_WorkspaceClass
+		for {
+			data, err := fetchTopData(ctx, conn, workspaceClass)
+			if ctx.Err() != nil {
+				return
+			}
 			if err != nil {
 				log.Fatalf("cannot get workspace resources: %s", err)
 			}
-			data.Resources = workspaceResources
-			wg.Done()
-		}()
+			workspaceClass = data.WorkspaceClass
+
+			if topCmdOpts.Json {
+				// in watch mode this prints one JSON object per line and refresh
+				content, _ := json.Marshal(data)
+				fmt.Println(string(content))
+			} else {
+				if topCmdOpts.Watch {
+					// clear the screen and move the cursor to the top left
+					fmt.Print("\033[H\033[2J")
+					fmt.Printf("Refreshing every %s, press Ctrl+C to quit.\n\n", topCmdOpts.Interval)
+				}
+				outputTable(data.Resources, data.WorkspaceClass)
+			}
+
+			if !topCmdOpts.Watch {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(topCmdOpts.Interval):
+			}
+		}
+	},
+}
+
+// fetchTopData fetches the workspace resources, and the workspace class if it is not known yet.
+func fetchTopData(ctx context.Context, conn *grpc.ClientConn, workspaceClass *supervisor.WorkspaceInfoResponse_WorkspaceClass) (*topData, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
 
+	data := &topData{WorkspaceClass: workspaceClass}
+
+	var wg sync.WaitGroup
+	if workspaceClass == nil {
+		wg.Add(1)
 		go func() {
+			defer wg.Done()
 			if wsInfo, err := supervisor.NewInfoServiceClient(conn).WorkspaceInfo(ctx, &supervisor.WorkspaceInfoRequest{}); err == nil {
 				data.WorkspaceClass = wsInfo.WorkspaceClass
 			}
-			wg.Done()
 		}()
+	}
 
-		wg.Wait()
-
-		if topCmdOpts.Json {
-			content, _ := json.Marshal(data)
-			fmt.Println(string(content))
-			return
-		}
-		outputTable(data.Resources, data.WorkspaceClass)
-	},
+	workspaceResources, err := supervisor_helper.GetWorkspaceResources(ctx, conn)
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	data.Resources = workspaceResources
+	return data, nil
 }
 
 func formatWorkspaceClass(workspaceClass *supervisor.WorkspaceInfoResponse_WorkspaceClass) string {
@@ -103,11 +147,49 @@ func outputTable(workspaceResources *supervisor.ResourcesStatusResponse, workspa
 		memoryColors = []tablewriter.Colors{nil, {getColor(workspaceResources.Memory.Severity)}}
 	}
 
+	var diskColors []tablewriter.Colors
+	disk := "n/a"
+	if d := workspaceResources.Disk; d != nil && d.Limit > 0 {
+		disk = fmt.Sprintf("%.1fGi/%.1fGi (%d%%)", float64(d.Used)/(1024*1024*1024), float64(d.Limit)/(1024*1024*1024), int64(float64(d.Used)/float64(d.Limit)*100))
+		if !noColor && utils.ColorsEnabled() {
+			diskColors = []tablewriter.Colors{nil, {getColor(d.Severity)}}
+		}
+	}
+	ioPressure := "n/a"
+	if p := workspaceResources.IoPressure; p != nil {
+		ioPressure = fmt.Sprintf("%.2f%% some, %.2f%% full stalled (10s avg)", p.SomeAvg10, p.FullAvg10)
+	}
+	network := "n/a"
+	if n := workspaceResources.Network; n != nil {
+		network = fmt.Sprintf("%dMi received, %dMi sent", n.ReceivedBytes/(1024*1024), n.SentBytes/(1024*1024))
+	}
+
 	table.Append([]string{"Workspace class", formatWorkspaceClass(workspaceClass)})
 	table.Rich([]string{"CPU (millicores)", cpu}, cpuColors)
 	table.Rich([]string{"Memory (bytes)", memory}, memoryColors)
+	table.Rich([]string{"Disk (bytes)", disk}, diskColors)
+	table.Append([]string{"IO pressure", ioPressure})
+	table.Append([]string{"Network", network})
 
 	table.Render()
+
+	if len(workspaceResources.Processes) == 0 {
+		return
+	}
+	fmt.Println()
+	processes := tablewriter.NewWriter(os.Stdout)
+	processes.SetHeader([]string{"PID", "CPU (millicores)", "Memory (Mi)", "Task", "Command"})
+	processes.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
+	processes.SetCenterSeparator("|")
+	processes.SetAutoWrapText(false)
+	for _, p := range workspaceResources.Processes {
+		command := p.Command
+		if r := []rune(command); len(r) > maxTopCommandLength {
+			command = string(r[:maxTopCommandLength-1]) + "…"
+		}
+		processes.Append([]string{strconv.FormatInt(p.Pid, 10), strconv.FormatInt(p.Cpu, 10), strconv.FormatInt(p.Memory/(1024*1024), 10), p.TaskName, command})
+	}
+	processes.Render()
 }
 
 func getColor(severity api.ResourceStatusSeverity) int {
@@ -124,5 +206,7 @@ func getColor(severity api.ResourceStatusSeverity) int {
 func init() {
 	topCmd.Flags().BoolVarP(&noColor, "no-color", "", false, "Disable output colorization")
 	topCmd.Flags().BoolVarP(&topCmdOpts.Json, "json", "j", false, "Output in JSON format")
+	topCmd.Flags().BoolVarP(&topCmdOpts.Watch, "watch", "w", false, "Refresh the output until interrupted")
+	topCmd.Flags().DurationVar(&topCmdOpts.Interval, "interval", 2*time.Second, "Refresh interval in watch mode")
 	rootCmd.AddCommand(topCmd)
 }
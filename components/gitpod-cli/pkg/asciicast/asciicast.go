@@ -0,0 +1,186 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package asciicast
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+// Header is the first line of an asciicast v2 file,
+// see https://github.com/asciinema/asciinema/blob/develop/doc/asciicast-v2.md
+type Header struct {
+	Version   int    `json:"version"`
+	Width     int    `json:"width"`
+	Height    int    `json:"height"`
+	Timestamp int64  `json:"timestamp"`
+	Title     string `json:"title,omitempty"`
+}
+
+// Event is an output ("o") or resize ("r") event of a recording
+type Event struct {
+	// Time is the time since the start of the recording
+	Time time.Duration
+	Code string
+	Data string
+}
+
+// Files returns the files of a recording, oldest first. Older parts of a recording are rotated to <recording>.1, <recording>.2 and so on.
+func Files(recording string) ([]string, error) {
+	var files []string
+	for i := 1; ; i++ {
+		fn := fmt.Sprintf("%s.%d", recording, i)
+		if _, err := os.Stat(fn); err != nil {
+			break
+		}
+		files = append([]string{fn}, files...)
+	}
+	if _, err := os.Stat(recording); err == nil {
+		files = append(files, recording)
+	}
+	if len(files) == 0 {
+		return nil, xerrors.Errorf("recording %s does not exist", recording)
+	}
+	return files, nil
+}
+
+// Read reads an asciicast v2 file and calls onEvent for every event
+func Read(r io.Reader, onEvent func(Event) error) (*Header, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, xerrors.Errorf("recording is empty")
+	}
+	var header Header
+	err := json.Unmarshal(scanner.Bytes(), &header)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read recording header: %w", err)
+	}
+	if header.Version != 2 {
+		return nil, xerrors.Errorf("unsupported asciicast version %d", header.Version)
+	}
+
+	for scanner.Scan() {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		var (
+			t    float64
+			code string
+			data string
+		)
+		err := json.Unmarshal(scanner.Bytes(), &[3]interface{}{&t, &code, &data})
+		if err != nil {
+			return nil, xerrors.Errorf("cannot read recording event: %w", err)
+		}
+		err = onEvent(Event{
+			Time: time.Duration(t * float64(time.Second)),
+			Code: code,
+			Data: data,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return &header, nil
+}
+
+// PlayOptions configures the playback of a recording
+type PlayOptions struct {
+	// Speed scales the playback speed, e.g. 2 plays twice as fast. Use 0 for 1.
+	Speed float64
+	// IdleTimeLimit caps the time between two events. Use 0 for no limit.
+	IdleTimeLimit time.Duration
+}
+
+// Play writes the output of a recording to w in the timing of the recording
+func Play(ctx context.Context, w io.Writer, r io.Reader, opts PlayOptions) error {
+	speed := opts.Speed
+	if speed <= 0 {
+		speed = 1
+	}
+	var last time.Duration
+	_, err := Read(r, func(e Event) error {
+		delay := e.Time - last
+		last = e.Time
+		if opts.IdleTimeLimit > 0 && delay > opts.IdleTimeLimit {
+			delay = opts.IdleTimeLimit
+		}
+		delay = time.Duration(float64(delay) / speed)
+		if delay > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+
+		if e.Code != "o" {
+			return nil
+		}
+		_, err := io.WriteString(w, e.Data)
+		return err
+	})
+	return err
+}
+
+// escapeSequence matches CSI sequences, OSC sequences and other escape sequences terminals interpret
+var escapeSequence = regexp.MustCompile(`\x1b\[[0-?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(\x07|\x1b\\)|\x1b[@-Z\\-_]`)
+
+// ExportText writes the output of a recording as plain text to w, i.e. without escape sequences
+// and without text which was overwritten after a carriage return
+func ExportText(w io.Writer, r io.Reader) error {
+	var line strings.Builder
+	_, err := Read(r, func(e Event) error {
+		if e.Code != "o" {
+			return nil
+		}
+		for {
+			i := strings.IndexByte(e.Data, '\n')
+			if i < 0 {
+				line.WriteString(e.Data)
+				return nil
+			}
+			line.WriteString(e.Data[:i])
+			_, err := io.WriteString(w, plainLine(line.String())+"\n")
+			if err != nil {
+				return err
+			}
+			line.Reset()
+			e.Data = e.Data[i+1:]
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if line.Len() > 0 {
+		_, err = io.WriteString(w, plainLine(line.String())+"\n")
+	}
+	return err
+}
+
+func plainLine(line string) string {
+	line = escapeSequence.ReplaceAllString(line, "")
+	line = strings.TrimRight(line, "\r")
+	if i := strings.LastIndex(line, "\r"); i >= 0 {
+		line = line[i+1:]
+	}
+	return line
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package asciicast
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const header = `{"version": 2, "width": 80, "height": 24, "timestamp": 1504467315}` + "\n"
+
+func TestExportText(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Events      string
+		Expectation string
+	}{
+		{
+			Name:        "plain",
+			Events:      `[0.1, "o", "hello "]` + "\n" + `[0.2, "o", "world\r\n"]`,
+			Expectation: "hello world\n",
+		},
+		{
+			Name:        "escape sequences",
+			Events:      `[0.1, "o", "\u001b[32mgreen\u001b[0m\r\n\u001b]0;title\u0007done"]`,
+			Expectation: "green\ndone\n",
+		},
+		{
+			Name:        "overwritten progress",
+			Events:      `[0.1, "o", "10%\r"]` + "\n" + `[0.2, "o", "100%\r\n"]`,
+			Expectation: "100%\n",
+		},
+		{
+			Name:        "resize events are ignored",
+			Events:      `[0.1, "r", "100x50"]` + "\n" + `[0.2, "o", "foo\n"]`,
+			Expectation: "foo\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var out bytes.Buffer
+			err := ExportText(&out, strings.NewReader(header+test.Events+"\n"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.Expectation, out.String()); diff != "" {
+				t.Errorf("unexpected text (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPlay(t *testing.T) {
+	recording := header + `[0.01, "o", "foo"]` + "\n" + `[60, "r", "100x50"]` + "\n" + `[60.01, "o", "bar"]` + "\n"
+
+	var out bytes.Buffer
+	start := time.Now()
+	err := Play(context.Background(), &out, strings.NewReader(recording), PlayOptions{Speed: 2, IdleTimeLimit: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "foobar" {
+		t.Errorf("unexpected output %q", out.String())
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("idle time limit was not applied, playing took %v", elapsed)
+	}
+}
+
+func TestReadInvalid(t *testing.T) {
+	tests := []struct {
+		Name      string
+		Recording string
+	}{
+		{Name: "empty", Recording: ""},
+		{Name: "version 1", Recording: `{"version": 1}` + "\n"},
+		{Name: "invalid event", Recording: header + `{"foo": "bar"}` + "\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			_, err := Read(strings.NewReader(test.Recording), func(Event) error { return nil })
+			if err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
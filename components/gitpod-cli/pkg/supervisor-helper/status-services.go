@@ -0,0 +1,29 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor_helper
+
+import (
+	"context"
+
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
+	"golang.org/x/xerrors"
+)
+
+func GetServicesList(ctx context.Context) ([]*supervisor.ServiceStatus, error) {
+	conn, err := Dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := supervisor.NewStatusServiceClient(conn)
+	respClient, err := client.ServicesStatus(ctx, &supervisor.ServicesStatusRequest{Observe: false})
+	if err != nil {
+		return nil, xerrors.Errorf("failed get services status client: %w", err)
+	}
+	resp, err := respClient.Recv()
+	if err != nil {
+		return nil, xerrors.Errorf("failed receive data: %w", err)
+	}
+	return resp.GetServices(), nil
+}
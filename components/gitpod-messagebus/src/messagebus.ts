@@ -166,11 +166,19 @@ export class MessageBusHelperImpl implements MessageBusHelper {
             durable: true,
         });
     }
+
+    static async assertScheduledWorkspaceStartQueue(ch: Channel): Promise<void> {
+        await ch.assertQueue(MessageBusHelperImpl.SCHEDULED_START_QUEUE, {
+            autoDelete: false,
+            durable: true,
+        });
+    }
 }
 
 export namespace MessageBusHelperImpl {
     export const WORKSPACE_EXCHANGE_LOCAL = "gitpod.ws.local";
     export const PREBUILD_UPDATABLE_QUEUE = "pwsupdatable";
+    export const SCHEDULED_START_QUEUE = "wsscheduledstart";
 }
 
 export interface PublishMessageOptions {
@@ -38,6 +38,22 @@ type CoreDump struct {
 type Env struct {
 }
 
+// GitClone Configures how the repository is cloned. Useful to speed up workspace starts of large repositories.
+type GitClone struct {
+
+	// Number of commits to clone. 0 clones the full history. Defaults to the latest commit only.
+	Depth int `yaml:"depth,omitempty"`
+
+	// Partial clone filter. `blob:none` fetches file contents on demand, `tree:0` fetches trees and file contents on demand.
+	Filter string `yaml:"filter,omitempty"`
+
+	// Configures which Git LFS objects are fetched. By default the objects of all files tracked with Git LFS are fetched.
+	Lfs *Lfs `yaml:"lfs,omitempty"`
+
+	// Directories to check out, relative to the repository root. All files in the repository root are checked out as well. Defaults to the whole repository.
+	SparseCheckout []string `yaml:"sparseCheckout,omitempty"`
+}
+
 // Github Configures Gitpod's GitHub app
 type Github struct {
 
@@ -60,6 +76,9 @@ type GitpodConfig struct {
 	// Experimental network configuration in workspaces (deprecated). Enabled by default
 	ExperimentalNetwork bool `yaml:"experimentalNetwork,omitempty"`
 
+	// Configures how the repository is cloned. Useful to speed up workspace starts of large repositories.
+	GitClone *GitClone `yaml:"gitClone,omitempty"`
+
 	// Git config values should be provided in pairs. E.g. `core.autocrlf: input`. See https://git-scm.com/docs/git-config#_values.
 	GitConfig map[string]string `yaml:"gitConfig,omitempty"`
 
@@ -78,6 +97,9 @@ type GitpodConfig struct {
 	// List of exposed ports.
 	Ports []*PortsItems `yaml:"ports,omitempty"`
 
+	// List of services, e.g. databases, which run in containers next to the workspace. They share the network of the workspace and are restarted whenever they exit.
+	Services []*ServicesItems `yaml:"services,omitempty"`
+
 	// List of tasks to run on start. Each task will open a terminal in the IDE.
 	Tasks []*TasksItems `yaml:"tasks,omitempty"`
 
@@ -136,6 +158,16 @@ type JetbrainsProduct struct {
 	Vmoptions string `yaml:"vmoptions,omitempty"`
 }
 
+// Lfs Configures which Git LFS objects are fetched. By default the objects of all files tracked with Git LFS are fetched.
+type Lfs struct {
+
+	// Paths for which Git LFS objects are not fetched.
+	Exclude []string `yaml:"exclude,omitempty"`
+
+	// Paths for which Git LFS objects are fetched, e.g. `assets/**`.
+	Include []string `yaml:"include,omitempty"`
+}
+
 // PortsItems
 type PortsItems struct {
 
@@ -154,7 +186,7 @@ type PortsItems struct {
 	// The protocol to be used. (deprecated)
 	Protocol string `yaml:"protocol,omitempty"`
 
-	// Whether the port visibility should be private or public. 'private' (default) will only allow users with workspace access to access the port. 'public' will allow everyone with the port URL to access the port.
+	// Whether the port visibility should be private, team, token or public. 'private' (default) will only allow users with workspace access to access the port. 'team' will allow members of the workspace's team to access the port. 'token' will allow everyone with a signed, expiring share link to access the port. 'public' will allow everyone with the port URL to access the port.
 	Visibility string `yaml:"visibility,omitempty"`
 }
 
@@ -190,6 +222,70 @@ type Prebuilds_object struct {
 	PullRequestsFromForks bool `yaml:"pullRequestsFromForks,omitempty"`
 }
 
+// Readiness Condition which marks this task as ready for the tasks depending on it. If several conditions are given, all of them must be met. Without a condition a task is ready once it is started, or in prebuilds once it succeeded.
+type Readiness struct {
+
+	// The task is ready once this shell command exits with 0. The command is retried until it does.
+	Command string `yaml:"command,omitempty"`
+
+	// The task is ready once this file exists. Relative paths are resolved against the directory the task runs in.
+	File string `yaml:"file,omitempty"`
+
+	// The task is ready once a line of its output matches this regular expression.
+	Log string `yaml:"log,omitempty"`
+
+	// The task is ready once this port is open.
+	Port int `yaml:"port,omitempty"`
+}
+
+// ReadinessProbe Probe which marks the service as ready. Without a probe a service is ready once it is running.
+type ReadinessProbe struct {
+
+	// If set, the service is ready once an HTTP GET request of this path on the port succeeds.
+	Path string `yaml:"path,omitempty"`
+
+	// How often the probe is performed.
+	PeriodSeconds int `yaml:"periodSeconds,omitempty"`
+
+	// The service is ready once this port is open.
+	Port int `yaml:"port"`
+}
+
+// Resources Resource limits of the service. The service is guaranteed the resources it is limited to.
+type Resources struct {
+
+	// CPU limit of the service, e.g. `500m` or `1`.
+	Cpu string `yaml:"cpu,omitempty"`
+
+	// Memory limit of the service, e.g. `512Mi`.
+	Memory string `yaml:"memory,omitempty"`
+}
+
+// ServicesItems
+type ServicesItems struct {
+
+	// Command which starts the service, including the entrypoint of the image, e.g. `[docker-entrypoint.sh, postgres]`.
+	Command []string `yaml:"command"`
+
+	// Environment variables to set for the service.
+	Env map[string]string `yaml:"env,omitempty"`
+
+	// Docker image the service runs in, e.g. `postgres:14`.
+	Image string `yaml:"image"`
+
+	// Name of the service, e.g. `postgres`. Must consist of lower case alphanumeric characters or '-'.
+	Name string `yaml:"name"`
+
+	// Ports the service listens on.
+	Ports []int `yaml:"ports,omitempty"`
+
+	// Probe which marks the service as ready. Without a probe a service is ready once it is running.
+	ReadinessProbe *ReadinessProbe `yaml:"readinessProbe,omitempty"`
+
+	// Resource limits of the service. The service is guaranteed the resources it is limited to.
+	Resources *Resources `yaml:"resources,omitempty"`
+}
+
 // TasksItems
 type TasksItems struct {
 
@@ -199,12 +295,18 @@ type TasksItems struct {
 	// The main shell command to run after `before` and `init`. This command is executed last on every start and doesn't have to terminate.
 	Command string `yaml:"command,omitempty"`
 
+	// Names of the tasks which must be ready before this task is started.
+	DependsOn []string `yaml:"dependsOn,omitempty"`
+
 	// Environment variables to set.
 	Env *Env `yaml:"env,omitempty"`
 
 	// A shell command to run between `before` and the main `command`. This command is executed only on after initializing a workspace with a fresh clone, but not on restarts and snapshots. This command is expected to terminate. If it fails, the `command` property will not be executed.
 	Init string `yaml:"init,omitempty"`
 
+	// The number of times the command is restarted according to the restart policy before it is given up.
+	MaxRestarts int `yaml:"maxRestarts,omitempty"`
+
 	// Name of the task. Shown on the tab of the opened terminal.
 	Name string `yaml:"name,omitempty"`
 
@@ -216,6 +318,15 @@ type TasksItems struct {
 
 	// A shell command to run after `before`. This command is executed only on during workspace prebuilds. This command is expected to terminate. If it fails, the workspace build fails.
 	Prebuild string `yaml:"prebuild,omitempty"`
+
+	// Condition which marks this task as ready for the tasks depending on it. If several conditions are given, all of them must be met. Without a condition a task is ready once it is started, or in prebuilds once it succeeded.
+	Readiness *Readiness `yaml:"readiness,omitempty"`
+
+	// Records the terminal of this task in the asciicast format. Recordings are uploaded with the workspace logs and can be replayed with `gp tasks replay`.
+	Record bool `yaml:"record,omitempty"`
+
+	// Whether the command is restarted once it exits. `on-failure` restarts it if it exits with a non-zero code, `always` restarts it regardless of its exit code. Restarts are delayed by an exponential backoff. Does not apply to prebuilds.
+	RestartPolicy string `yaml:"restartPolicy,omitempty"`
 }
 
 // Vscode Configure VS Code integration
@@ -358,6 +469,91 @@ func (strct *CoreDump) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+func (strct *GitClone) MarshalJSON() ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0))
+	buf.WriteString("{")
+	comma := false
+	// Marshal the "depth" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"depth\": ")
+	if tmp, err := json.Marshal(strct.Depth); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "filter" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"filter\": ")
+	if tmp, err := json.Marshal(strct.Filter); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "lfs" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"lfs\": ")
+	if tmp, err := json.Marshal(strct.Lfs); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "sparseCheckout" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"sparseCheckout\": ")
+	if tmp, err := json.Marshal(strct.SparseCheckout); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+
+	buf.WriteString("}")
+	rv := buf.Bytes()
+	return rv, nil
+}
+
+func (strct *GitClone) UnmarshalJSON(b []byte) error {
+	var jsonMap map[string]json.RawMessage
+	if err := json.Unmarshal(b, &jsonMap); err != nil {
+		return err
+	}
+	// parse all the defined properties
+	for k, v := range jsonMap {
+		switch k {
+		case "depth":
+			if err := json.Unmarshal([]byte(v), &strct.Depth); err != nil {
+				return err
+			}
+		case "filter":
+			if err := json.Unmarshal([]byte(v), &strct.Filter); err != nil {
+				return err
+			}
+		case "lfs":
+			if err := json.Unmarshal([]byte(v), &strct.Lfs); err != nil {
+				return err
+			}
+		case "sparseCheckout":
+			if err := json.Unmarshal([]byte(v), &strct.SparseCheckout); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("additional property not allowed: \"" + k + "\"")
+		}
+	}
+	return nil
+}
+
 func (strct *Github) MarshalJSON() ([]byte, error) {
 	buf := bytes.NewBuffer(make([]byte, 0))
 	buf.WriteString("{")
@@ -446,6 +642,17 @@ func (strct *GitpodConfig) MarshalJSON() ([]byte, error) {
 		buf.Write(tmp)
 	}
 	comma = true
+	// Marshal the "gitClone" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"gitClone\": ")
+	if tmp, err := json.Marshal(strct.GitClone); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
 	// Marshal the "gitConfig" field
 	if comma {
 		buf.WriteString(",")
@@ -512,6 +719,17 @@ func (strct *GitpodConfig) MarshalJSON() ([]byte, error) {
 		buf.Write(tmp)
 	}
 	comma = true
+	// Marshal the "services" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"services\": ")
+	if tmp, err := json.Marshal(strct.Services); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
 	// Marshal the "tasks" field
 	if comma {
 		buf.WriteString(",")
@@ -575,6 +793,10 @@ func (strct *GitpodConfig) UnmarshalJSON(b []byte) error {
 			if err := json.Unmarshal([]byte(v), &strct.ExperimentalNetwork); err != nil {
 				return err
 			}
+		case "gitClone":
+			if err := json.Unmarshal([]byte(v), &strct.GitClone); err != nil {
+				return err
+			}
 		case "gitConfig":
 			if err := json.Unmarshal([]byte(v), &strct.GitConfig); err != nil {
 				return err
@@ -599,6 +821,10 @@ func (strct *GitpodConfig) UnmarshalJSON(b []byte) error {
 			if err := json.Unmarshal([]byte(v), &strct.Ports); err != nil {
 				return err
 			}
+		case "services":
+			if err := json.Unmarshal([]byte(v), &strct.Services); err != nil {
+				return err
+			}
 		case "tasks":
 			if err := json.Unmarshal([]byte(v), &strct.Tasks); err != nil {
 				return err
@@ -881,6 +1107,61 @@ func (strct *JetbrainsProduct) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+func (strct *Lfs) MarshalJSON() ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0))
+	buf.WriteString("{")
+	comma := false
+	// Marshal the "exclude" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"exclude\": ")
+	if tmp, err := json.Marshal(strct.Exclude); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "include" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"include\": ")
+	if tmp, err := json.Marshal(strct.Include); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+
+	buf.WriteString("}")
+	rv := buf.Bytes()
+	return rv, nil
+}
+
+func (strct *Lfs) UnmarshalJSON(b []byte) error {
+	var jsonMap map[string]json.RawMessage
+	if err := json.Unmarshal(b, &jsonMap); err != nil {
+		return err
+	}
+	// parse all the defined properties
+	for k, v := range jsonMap {
+		switch k {
+		case "exclude":
+			if err := json.Unmarshal([]byte(v), &strct.Exclude); err != nil {
+				return err
+			}
+		case "include":
+			if err := json.Unmarshal([]byte(v), &strct.Include); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("additional property not allowed: \"" + k + "\"")
+		}
+	}
+	return nil
+}
+
 func (strct *PortsItems) MarshalJSON() ([]byte, error) {
 	buf := bytes.NewBuffer(make([]byte, 0))
 	buf.WriteString("{")
@@ -1044,21 +1325,10 @@ func (strct *Prebuilds) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-func (strct *TasksItems) MarshalJSON() ([]byte, error) {
+func (strct *Readiness) MarshalJSON() ([]byte, error) {
 	buf := bytes.NewBuffer(make([]byte, 0))
 	buf.WriteString("{")
 	comma := false
-	// Marshal the "before" field
-	if comma {
-		buf.WriteString(",")
-	}
-	buf.WriteString("\"before\": ")
-	if tmp, err := json.Marshal(strct.Before); err != nil {
-		return nil, err
-	} else {
-		buf.Write(tmp)
-	}
-	comma = true
 	// Marshal the "command" field
 	if comma {
 		buf.WriteString(",")
@@ -1070,67 +1340,110 @@ func (strct *TasksItems) MarshalJSON() ([]byte, error) {
 		buf.Write(tmp)
 	}
 	comma = true
-	// Marshal the "env" field
+	// Marshal the "file" field
 	if comma {
 		buf.WriteString(",")
 	}
-	buf.WriteString("\"env\": ")
-	if tmp, err := json.Marshal(strct.Env); err != nil {
+	buf.WriteString("\"file\": ")
+	if tmp, err := json.Marshal(strct.File); err != nil {
 		return nil, err
 	} else {
 		buf.Write(tmp)
 	}
 	comma = true
-	// Marshal the "init" field
+	// Marshal the "log" field
 	if comma {
 		buf.WriteString(",")
 	}
-	buf.WriteString("\"init\": ")
-	if tmp, err := json.Marshal(strct.Init); err != nil {
+	buf.WriteString("\"log\": ")
+	if tmp, err := json.Marshal(strct.Log); err != nil {
 		return nil, err
 	} else {
 		buf.Write(tmp)
 	}
 	comma = true
-	// Marshal the "name" field
+	// Marshal the "port" field
 	if comma {
 		buf.WriteString(",")
 	}
-	buf.WriteString("\"name\": ")
-	if tmp, err := json.Marshal(strct.Name); err != nil {
+	buf.WriteString("\"port\": ")
+	if tmp, err := json.Marshal(strct.Port); err != nil {
 		return nil, err
 	} else {
 		buf.Write(tmp)
 	}
 	comma = true
-	// Marshal the "openIn" field
+
+	buf.WriteString("}")
+	rv := buf.Bytes()
+	return rv, nil
+}
+
+func (strct *Readiness) UnmarshalJSON(b []byte) error {
+	var jsonMap map[string]json.RawMessage
+	if err := json.Unmarshal(b, &jsonMap); err != nil {
+		return err
+	}
+	// parse all the defined properties
+	for k, v := range jsonMap {
+		switch k {
+		case "command":
+			if err := json.Unmarshal([]byte(v), &strct.Command); err != nil {
+				return err
+			}
+		case "file":
+			if err := json.Unmarshal([]byte(v), &strct.File); err != nil {
+				return err
+			}
+		case "log":
+			if err := json.Unmarshal([]byte(v), &strct.Log); err != nil {
+				return err
+			}
+		case "port":
+			if err := json.Unmarshal([]byte(v), &strct.Port); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("additional property not allowed: \"" + k + "\"")
+		}
+	}
+	return nil
+}
+
+func (strct *ReadinessProbe) MarshalJSON() ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0))
+	buf.WriteString("{")
+	comma := false
+	// Marshal the "path" field
 	if comma {
 		buf.WriteString(",")
 	}
-	buf.WriteString("\"openIn\": ")
-	if tmp, err := json.Marshal(strct.OpenIn); err != nil {
+	buf.WriteString("\"path\": ")
+	if tmp, err := json.Marshal(strct.Path); err != nil {
 		return nil, err
 	} else {
 		buf.Write(tmp)
 	}
 	comma = true
-	// Marshal the "openMode" field
+	// Marshal the "periodSeconds" field
 	if comma {
 		buf.WriteString(",")
 	}
-	buf.WriteString("\"openMode\": ")
-	if tmp, err := json.Marshal(strct.OpenMode); err != nil {
+	buf.WriteString("\"periodSeconds\": ")
+	if tmp, err := json.Marshal(strct.PeriodSeconds); err != nil {
 		return nil, err
 	} else {
 		buf.Write(tmp)
 	}
 	comma = true
-	// Marshal the "prebuild" field
+	// "Port" field is required
+	// only required object types supported for marshal checking (for now)
+	// Marshal the "port" field
 	if comma {
 		buf.WriteString(",")
 	}
-	buf.WriteString("\"prebuild\": ")
-	if tmp, err := json.Marshal(strct.Prebuild); err != nil {
+	buf.WriteString("\"port\": ")
+	if tmp, err := json.Marshal(strct.Port); err != nil {
 		return nil, err
 	} else {
 		buf.Write(tmp)
@@ -1142,7 +1455,8 @@ func (strct *TasksItems) MarshalJSON() ([]byte, error) {
 	return rv, nil
 }
 
-func (strct *TasksItems) UnmarshalJSON(b []byte) error {
+func (strct *ReadinessProbe) UnmarshalJSON(b []byte) error {
+	portReceived := false
 	var jsonMap map[string]json.RawMessage
 	if err := json.Unmarshal(b, &jsonMap); err != nil {
 		return err
@@ -1150,22 +1464,424 @@ func (strct *TasksItems) UnmarshalJSON(b []byte) error {
 	// parse all the defined properties
 	for k, v := range jsonMap {
 		switch k {
-		case "before":
-			if err := json.Unmarshal([]byte(v), &strct.Before); err != nil {
+		case "path":
+			if err := json.Unmarshal([]byte(v), &strct.Path); err != nil {
 				return err
 			}
-		case "command":
-			if err := json.Unmarshal([]byte(v), &strct.Command); err != nil {
+		case "periodSeconds":
+			if err := json.Unmarshal([]byte(v), &strct.PeriodSeconds); err != nil {
 				return err
 			}
-		case "env":
-			if err := json.Unmarshal([]byte(v), &strct.Env); err != nil {
+		case "port":
+			if err := json.Unmarshal([]byte(v), &strct.Port); err != nil {
+				return err
+			}
+			portReceived = true
+		default:
+			return fmt.Errorf("additional property not allowed: \"" + k + "\"")
+		}
+	}
+	// check if port (a required property) was received
+	if !portReceived {
+		return errors.New("\"port\" is required but was not present")
+	}
+	return nil
+}
+
+func (strct *Resources) MarshalJSON() ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0))
+	buf.WriteString("{")
+	comma := false
+	// Marshal the "cpu" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"cpu\": ")
+	if tmp, err := json.Marshal(strct.Cpu); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "memory" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"memory\": ")
+	if tmp, err := json.Marshal(strct.Memory); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+
+	buf.WriteString("}")
+	rv := buf.Bytes()
+	return rv, nil
+}
+
+func (strct *Resources) UnmarshalJSON(b []byte) error {
+	var jsonMap map[string]json.RawMessage
+	if err := json.Unmarshal(b, &jsonMap); err != nil {
+		return err
+	}
+	// parse all the defined properties
+	for k, v := range jsonMap {
+		switch k {
+		case "cpu":
+			if err := json.Unmarshal([]byte(v), &strct.Cpu); err != nil {
+				return err
+			}
+		case "memory":
+			if err := json.Unmarshal([]byte(v), &strct.Memory); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("additional property not allowed: \"" + k + "\"")
+		}
+	}
+	return nil
+}
+
+func (strct *ServicesItems) MarshalJSON() ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0))
+	buf.WriteString("{")
+	comma := false
+	// "Command" field is required
+	// only required object types supported for marshal checking (for now)
+	// Marshal the "command" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"command\": ")
+	if tmp, err := json.Marshal(strct.Command); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "env" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"env\": ")
+	if tmp, err := json.Marshal(strct.Env); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// "Image" field is required
+	// only required object types supported for marshal checking (for now)
+	// Marshal the "image" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"image\": ")
+	if tmp, err := json.Marshal(strct.Image); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// "Name" field is required
+	// only required object types supported for marshal checking (for now)
+	// Marshal the "name" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"name\": ")
+	if tmp, err := json.Marshal(strct.Name); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "ports" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"ports\": ")
+	if tmp, err := json.Marshal(strct.Ports); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "readinessProbe" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"readinessProbe\": ")
+	if tmp, err := json.Marshal(strct.ReadinessProbe); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "resources" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"resources\": ")
+	if tmp, err := json.Marshal(strct.Resources); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+
+	buf.WriteString("}")
+	rv := buf.Bytes()
+	return rv, nil
+}
+
+func (strct *ServicesItems) UnmarshalJSON(b []byte) error {
+	commandReceived := false
+	imageReceived := false
+	nameReceived := false
+	var jsonMap map[string]json.RawMessage
+	if err := json.Unmarshal(b, &jsonMap); err != nil {
+		return err
+	}
+	// parse all the defined properties
+	for k, v := range jsonMap {
+		switch k {
+		case "command":
+			if err := json.Unmarshal([]byte(v), &strct.Command); err != nil {
+				return err
+			}
+			commandReceived = true
+		case "env":
+			if err := json.Unmarshal([]byte(v), &strct.Env); err != nil {
+				return err
+			}
+		case "image":
+			if err := json.Unmarshal([]byte(v), &strct.Image); err != nil {
+				return err
+			}
+			imageReceived = true
+		case "name":
+			if err := json.Unmarshal([]byte(v), &strct.Name); err != nil {
+				return err
+			}
+			nameReceived = true
+		case "ports":
+			if err := json.Unmarshal([]byte(v), &strct.Ports); err != nil {
+				return err
+			}
+		case "readinessProbe":
+			if err := json.Unmarshal([]byte(v), &strct.ReadinessProbe); err != nil {
+				return err
+			}
+		case "resources":
+			if err := json.Unmarshal([]byte(v), &strct.Resources); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("additional property not allowed: \"" + k + "\"")
+		}
+	}
+	// check if command (a required property) was received
+	if !commandReceived {
+		return errors.New("\"command\" is required but was not present")
+	}
+	// check if image (a required property) was received
+	if !imageReceived {
+		return errors.New("\"image\" is required but was not present")
+	}
+	// check if name (a required property) was received
+	if !nameReceived {
+		return errors.New("\"name\" is required but was not present")
+	}
+	return nil
+}
+
+func (strct *TasksItems) MarshalJSON() ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0))
+	buf.WriteString("{")
+	comma := false
+	// Marshal the "before" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"before\": ")
+	if tmp, err := json.Marshal(strct.Before); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "command" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"command\": ")
+	if tmp, err := json.Marshal(strct.Command); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "dependsOn" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"dependsOn\": ")
+	if tmp, err := json.Marshal(strct.DependsOn); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "env" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"env\": ")
+	if tmp, err := json.Marshal(strct.Env); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "init" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"init\": ")
+	if tmp, err := json.Marshal(strct.Init); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "maxRestarts" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"maxRestarts\": ")
+	if tmp, err := json.Marshal(strct.MaxRestarts); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "name" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"name\": ")
+	if tmp, err := json.Marshal(strct.Name); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "openIn" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"openIn\": ")
+	if tmp, err := json.Marshal(strct.OpenIn); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "openMode" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"openMode\": ")
+	if tmp, err := json.Marshal(strct.OpenMode); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "prebuild" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"prebuild\": ")
+	if tmp, err := json.Marshal(strct.Prebuild); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "readiness" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"readiness\": ")
+	if tmp, err := json.Marshal(strct.Readiness); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "record" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"record\": ")
+	if tmp, err := json.Marshal(strct.Record); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+	// Marshal the "restartPolicy" field
+	if comma {
+		buf.WriteString(",")
+	}
+	buf.WriteString("\"restartPolicy\": ")
+	if tmp, err := json.Marshal(strct.RestartPolicy); err != nil {
+		return nil, err
+	} else {
+		buf.Write(tmp)
+	}
+	comma = true
+
+	buf.WriteString("}")
+	rv := buf.Bytes()
+	return rv, nil
+}
+
+func (strct *TasksItems) UnmarshalJSON(b []byte) error {
+	var jsonMap map[string]json.RawMessage
+	if err := json.Unmarshal(b, &jsonMap); err != nil {
+		return err
+	}
+	// parse all the defined properties
+	for k, v := range jsonMap {
+		switch k {
+		case "before":
+			if err := json.Unmarshal([]byte(v), &strct.Before); err != nil {
+				return err
+			}
+		case "command":
+			if err := json.Unmarshal([]byte(v), &strct.Command); err != nil {
+				return err
+			}
+		case "dependsOn":
+			if err := json.Unmarshal([]byte(v), &strct.DependsOn); err != nil {
+				return err
+			}
+		case "env":
+			if err := json.Unmarshal([]byte(v), &strct.Env); err != nil {
 				return err
 			}
 		case "init":
 			if err := json.Unmarshal([]byte(v), &strct.Init); err != nil {
 				return err
 			}
+		case "maxRestarts":
+			if err := json.Unmarshal([]byte(v), &strct.MaxRestarts); err != nil {
+				return err
+			}
 		case "name":
 			if err := json.Unmarshal([]byte(v), &strct.Name); err != nil {
 				return err
@@ -1182,6 +1898,18 @@ func (strct *TasksItems) UnmarshalJSON(b []byte) error {
 			if err := json.Unmarshal([]byte(v), &strct.Prebuild); err != nil {
 				return err
 			}
+		case "readiness":
+			if err := json.Unmarshal([]byte(v), &strct.Readiness); err != nil {
+				return err
+			}
+		case "record":
+			if err := json.Unmarshal([]byte(v), &strct.Record); err != nil {
+				return err
+			}
+		case "restartPolicy":
+			if err := json.Unmarshal([]byte(v), &strct.RestartPolicy); err != nil {
+				return err
+			}
 		default:
 			return fmt.Errorf("additional property not allowed: \"" + k + "\"")
 		}
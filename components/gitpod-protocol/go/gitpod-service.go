@@ -1807,14 +1807,18 @@ type WorkspaceInstanceConfiguration struct {
 
 // WorkspaceInstanceRepoStatus is the WorkspaceInstanceRepoStatus message type
 type WorkspaceInstanceRepoStatus struct {
-	Branch               string   `json:"branch,omitempty"`
-	LatestCommit         string   `json:"latestCommit,omitempty"`
-	TotalUncommitedFiles float64  `json:"totalUncommitedFiles,omitempty"`
-	TotalUnpushedCommits float64  `json:"totalUnpushedCommits,omitempty"`
-	TotalUntrackedFiles  float64  `json:"totalUntrackedFiles,omitempty"`
-	UncommitedFiles      []string `json:"uncommitedFiles,omitempty"`
-	UnpushedCommits      []string `json:"unpushedCommits,omitempty"`
-	UntrackedFiles       []string `json:"untrackedFiles,omitempty"`
+	Branch                  string   `json:"branch,omitempty"`
+	LatestCommit            string   `json:"latestCommit,omitempty"`
+	MissingLfsObjects       []string `json:"missingLfsObjects,omitempty"`
+	TotalMissingLfsObjects  float64  `json:"totalMissingLfsObjects,omitempty"`
+	TotalUncommitedFiles    float64  `json:"totalUncommitedFiles,omitempty"`
+	TotalUnpushedCommits    float64  `json:"totalUnpushedCommits,omitempty"`
+	TotalUnpushedLfsObjects float64  `json:"totalUnpushedLfsObjects,omitempty"`
+	TotalUntrackedFiles     float64  `json:"totalUntrackedFiles,omitempty"`
+	UncommitedFiles         []string `json:"uncommitedFiles,omitempty"`
+	UnpushedCommits         []string `json:"unpushedCommits,omitempty"`
+	UnpushedLfsObjects      []string `json:"unpushedLfsObjects,omitempty"`
+	UntrackedFiles          []string `json:"untrackedFiles,omitempty"`
 }
 
 // WorkspaceInstanceStatus is the WorkspaceInstanceStatus message type
@@ -1844,14 +1848,18 @@ type GetWorkspaceTimeoutResult struct {
 
 // WorkspaceInstancePort is the WorkspaceInstancePort message type
 type WorkspaceInstancePort struct {
-	Port       float64 `json:"port,omitempty"`
-	URL        string  `json:"url,omitempty"`
-	Visibility string  `json:"visibility,omitempty"`
+	Port             float64 `json:"port,omitempty"`
+	URL              string  `json:"url,omitempty"`
+	Visibility       string  `json:"visibility,omitempty"`
+	ShareLinkExpiry  string  `json:"shareLinkExpiry,omitempty"`
+	RevokeShareLinks bool    `json:"revokeShareLinks,omitempty"`
 }
 
 const (
 	PortVisibilityPublic  = "public"
 	PortVisibilityPrivate = "private"
+	PortVisibilityTeam    = "team"
+	PortVisibilityToken   = "token"
 )
 
 // GithubAppConfig is the GithubAppConfig message type
@@ -133,6 +133,65 @@ gitConfig:
         });
     }
 
+    @test public testGitClone() {
+        const content = `
+gitClone:
+    depth: 50
+    filter: blob:none
+    sparseCheckout:
+        - services/api
+    lfs:
+        exclude:
+            - assets/videos
+`;
+
+        const result = this.parser.parse(content, {}, DEFAULT_CONFIG);
+        expect(result.config).to.deep.equal({
+            gitClone: {
+                depth: 50,
+                filter: "blob:none",
+                sparseCheckout: ["services/api"],
+                lfs: {
+                    exclude: ["assets/videos"],
+                },
+            },
+            image: DEFAULT_IMAGE,
+        });
+    }
+
+    @test public testTaskDependencies() {
+        const content = `
+tasks:
+    - name: db
+      command: docker-compose up
+      readiness:
+          port: 5432
+    - name: server
+      dependsOn:
+          - db
+      command: npm start
+`;
+
+        const result = this.parser.parse(content, {}, DEFAULT_CONFIG);
+        expect(result.config).to.deep.equal({
+            tasks: [
+                {
+                    name: "db",
+                    command: "docker-compose up",
+                    readiness: {
+                        port: 5432,
+                    },
+                },
+                {
+                    name: "server",
+                    dependsOn: ["db"],
+                    command: "npm start",
+                },
+            ],
+            image: DEFAULT_IMAGE,
+        });
+    }
+
     @test public testBrokenConfig() {
         const content = `image: 42\n`;
 
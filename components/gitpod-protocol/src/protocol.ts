@@ -209,6 +209,10 @@ export interface AdditionalUserData {
     knownGitHubOrgs?: string[];
     // Git clone URL pointing to the user's dotfile repo
     dotfileRepo?: string;
+    // branch, tag or commit of the dotfile repo to install, the default branch if empty
+    dotfileRef?: string;
+    // ASCII armored public GPG keys, if set the installed dotfiles commit must be signed by one of them
+    dotfileSigningKeys?: string;
     // preferred workspace classes
     workspaceClasses?: WorkspaceClasses;
     // additional user profile data
@@ -822,15 +826,28 @@ export interface CoreDumpConfig {
     hardLimit?: number;
 }
 
+export interface GitCloneConfig {
+    /** number of commits to clone, 0 clones the full history. Defaults to the latest commit only. */
+    depth?: number;
+    filter?: "blob:none" | "tree:0";
+    sparseCheckout?: string[];
+    lfs?: {
+        include?: string[];
+        exclude?: string[];
+    };
+}
+
 export interface WorkspaceConfig {
     mainConfiguration?: string;
     additionalRepositories?: RepositoryCloneInformation[];
     image?: ImageConfig;
     ports?: PortConfig[];
     tasks?: TaskConfig[];
+    services?: ServiceConfig[];
     checkoutLocation?: string;
     workspaceLocation?: string;
     gitConfig?: { [config: string]: string };
+    gitClone?: GitCloneConfig;
     github?: GithubAppConfig;
     vscode?: VSCodeConfig;
     jetbrains?: JetBrainsConfig;
@@ -988,6 +1005,27 @@ export namespace PortRangeConfig {
     }
 }
 
+export interface ServiceConfig {
+    name: string;
+    image: string;
+    command: string[];
+    env?: { [env: string]: string };
+    ports?: number[];
+    resources?: ServiceResources;
+    readinessProbe?: ServiceReadinessProbe;
+}
+
+export interface ServiceResources {
+    cpu?: string;
+    memory?: string;
+}
+
+export interface ServiceReadinessProbe {
+    port: number;
+    path?: string;
+    periodSeconds?: number;
+}
+
 export interface TaskConfig {
     name?: string;
     before?: string;
@@ -997,6 +1035,18 @@ export interface TaskConfig {
     env?: { [env: string]: any };
     openIn?: "bottom" | "main" | "left" | "right";
     openMode?: "split-top" | "split-left" | "split-right" | "split-bottom" | "tab-before" | "tab-after";
+    dependsOn?: string[];
+    readiness?: TaskReadiness;
+    restartPolicy?: "never" | "on-failure" | "always";
+    maxRestarts?: number;
+    record?: boolean;
+}
+
+export interface TaskReadiness {
+    port?: number;
+    file?: string;
+    command?: string;
+    log?: string;
 }
 
 export namespace TaskConfig {
@@ -1353,6 +1403,16 @@ export namespace WorkspaceInstancePortsChangedEvent {
     }
 }
 
+/**
+ * A workspace start which is due according to the workspace's schedule in ws-manager.
+ */
+export interface ScheduledWorkspaceStart {
+    ownerId: string;
+    workspaceId: string;
+    /** the time (ISO) the start was scheduled for */
+    time: string;
+}
+
 export interface WorkspaceInfo {
     workspace: Workspace;
     latestInstance?: WorkspaceInstance;
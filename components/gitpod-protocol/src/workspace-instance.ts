@@ -106,6 +106,9 @@ export interface WorkspaceInstanceStatus {
 
     // ownerToken is the token one needs to access the workspace. Its presence is checked by ws-proxy.
     ownerToken?: string;
+
+    // teamToken grants members of the workspace's team access to ports with team visibility. It is checked by ws-proxy.
+    teamToken?: string;
 }
 
 // WorkspaceInstancePhase describes a high-level state of a workspace instance
@@ -178,13 +181,16 @@ export interface WorkspaceInstanceConditions {
 
     // stopped_by_request is true if the workspace was stopped using a StopWorkspace call
     stoppedByRequest?: boolean;
+
+    // servicesFailed contains the reasons service containers failed to start. Failed services do not fail the workspace.
+    servicesFailed?: string;
 }
 
 // AdmissionLevel describes who can access a workspace instance and its ports.
 export type AdmissionLevel = "owner_only" | "everyone";
 
 // PortVisibility describes how a port can be accessed
-export type PortVisibility = "public" | "private";
+export type PortVisibility = "public" | "private" | "team" | "token";
 
 // WorkspaceInstancePort describes a port exposed on a workspace instance
 export interface WorkspaceInstancePort {
@@ -196,6 +202,13 @@ export interface WorkspaceInstancePort {
 
     // Public, outward-facing URL where the port can be accessed on.
     url?: string;
+
+    // How long a share link of a port with token visibility is valid for, e.g. "2h".
+    // Only used when opening a port. Defaults to the longest expiry the installation allows.
+    shareLinkExpiry?: string;
+
+    // Invalidates all share links of the port issued before. Only used when opening a port.
+    revokeShareLinks?: boolean;
 }
 
 // WorkspaceInstanceRepoStatus describes the status of th Git working copy of a workspace
@@ -223,6 +236,18 @@ export interface WorkspaceInstanceRepoStatus {
 
     // the total number of unpushed changes
     totalUnpushedCommits?: number;
+
+    // unpushedLfsObjects is the list of Git LFS files changed in unpushed commits, possibly truncated
+    unpushedLfsObjects?: string[];
+
+    // the total number of unpushed Git LFS files
+    totalUnpushedLfsObjects?: number;
+
+    // missingLfsObjects is the list of Git LFS files of which only the pointer is checked out, possibly truncated
+    missingLfsObjects?: string[];
+
+    // the total number of missing Git LFS files
+    totalMissingLfsObjects?: number;
 }
 
 // ConfigurationIdeConfig ide config of WorkspaceInstanceConfiguration
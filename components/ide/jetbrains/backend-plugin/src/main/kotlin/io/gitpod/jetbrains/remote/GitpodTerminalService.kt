@@ -111,7 +111,7 @@ class GitpodTerminalService(project: Project) {
 
                 override fun onNext(response: Status.TasksStatusResponse) {
                     for (task in response.tasksList) {
-                        if (task.state === Status.TaskState.opening) return
+                        if (task.state === Status.TaskState.opening || task.state === Status.TaskState.waiting) return
                     }
 
                     completableFuture.complete(response.tasksList)
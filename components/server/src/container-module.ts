@@ -59,6 +59,7 @@ import {
 } from "@gitpod/image-builder/lib";
 import { ImageSourceProvider } from "./workspace/image-source-provider";
 import { WorkspaceGarbageCollector } from "./workspace/garbage-collector";
+import { ScheduledWorkspaceStarter } from "./workspace/scheduled-workspace-starter";
 import { TokenGarbageCollector } from "./user/token-garbage-collector";
 import { WorkspaceDownloadService } from "./workspace/workspace-download-service";
 import { WebsocketConnectionManager } from "./websocket/websocket-connection-manager";
@@ -219,6 +220,7 @@ export const productionContainerModule = new ContainerModule((bind, unbind, isBo
     bind(ConsensusLeaderQorum).toSelf().inSingletonScope();
 
     bind(WorkspaceGarbageCollector).toSelf().inSingletonScope();
+    bind(ScheduledWorkspaceStarter).toSelf().inSingletonScope();
     bind(WorkspaceDownloadService).toSelf().inSingletonScope();
     bind(LivenessController).toSelf().inSingletonScope();
 
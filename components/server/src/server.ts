@@ -26,6 +26,7 @@ import { AddressInfo } from "net";
 import { ConsensusLeaderQorum } from "./consensus/consensus-leader-quorum";
 import { RabbitMQConsensusLeaderMessenger } from "./consensus/rabbitmq-consensus-leader-messenger";
 import { WorkspaceGarbageCollector } from "./workspace/garbage-collector";
+import { ScheduledWorkspaceStarter } from "./workspace/scheduled-workspace-starter";
 import { WorkspaceDownloadService } from "./workspace/workspace-download-service";
 import { MonitoringEndpointsApp } from "./monitoring-endpoints";
 import { WebsocketConnectionManager } from "./websocket/websocket-connection-manager";
@@ -75,6 +76,7 @@ export class Server<C extends GitpodClient, S extends GitpodServer> {
     @inject(RabbitMQConsensusLeaderMessenger) protected readonly consensusMessenger: RabbitMQConsensusLeaderMessenger;
     @inject(ConsensusLeaderQorum) protected readonly qorum: ConsensusLeaderQorum;
     @inject(WorkspaceGarbageCollector) protected readonly workspaceGC: WorkspaceGarbageCollector;
+    @inject(ScheduledWorkspaceStarter) protected readonly scheduledWorkspaceStarter: ScheduledWorkspaceStarter;
     @inject(OneTimeSecretServer) protected readonly oneTimeSecretServer: OneTimeSecretServer;
 
     @inject(PeriodicDbDeleter) protected readonly periodicDbDeleter: PeriodicDbDeleter;
@@ -264,6 +266,9 @@ export class Server<C extends GitpodClient, S extends GitpodServer> {
         await this.consensusMessenger.connect();
         await this.qorum.start();
 
+        // Start workspaces whose pre-start is due
+        this.disposables.push(this.scheduledWorkspaceStarter.start());
+
         // Start workspace garbage collector
         this.workspaceGC.start().catch((err) => log.error("wsgc: error during startup", err));
 
@@ -6,7 +6,7 @@
 
 import * as crypto from "crypto";
 import { inject, injectable } from "inversify";
-import { UserDB, DBUser, WorkspaceDB, OneTimeSecretDB } from "@gitpod/gitpod-db/lib";
+import { UserDB, DBUser, WorkspaceDB, OneTimeSecretDB, ProjectDB, TeamDB } from "@gitpod/gitpod-db/lib";
 import * as express from "express";
 import { Authenticator } from "../auth/authenticator";
 import { Config } from "../config";
@@ -41,6 +41,8 @@ import { daysBefore, isDateSmaller } from "@gitpod/gitpod-protocol/lib/util/time
 export class UserController {
     @inject(WorkspaceDB) protected readonly workspaceDB: WorkspaceDB;
     @inject(UserDB) protected readonly userDb: UserDB;
+    @inject(ProjectDB) protected readonly projectDb: ProjectDB;
+    @inject(TeamDB) protected readonly teamDb: TeamDB;
     @inject(Authenticator) protected readonly authenticator: Authenticator;
     @inject(Config) protected readonly config: Config;
     @inject(TosCookie) protected readonly tosCookie: TosCookie;
@@ -251,6 +253,24 @@ export class UserController {
                     // [cw] The user is not the workspace owner, which means they don't get the owner cookie.
                     // [cw] In the future, when we introduce per-user tokens we can set the user-specific token here.
 
+                    const teamToken = instance.status.teamToken;
+                    if (teamToken && (await this.isWorkspaceTeamMember(user, workspace.projectId))) {
+                        // members of the workspace's team get the team cookie, which grants access to ports with team visibility.
+                        if (res.headersSent) {
+                            return;
+                        }
+                        res.cookie(`_${cookiePrefix}_ws_${instanceID}_team_`, teamToken, {
+                            path: "/",
+                            httpOnly: true,
+                            secure: true,
+                            maxAge: 1000 * 60 * 60 * 24 * 1, // 1 day
+                            sameSite: "lax",
+                            domain: `.${this.config.hostUrl.url.host}`,
+                        });
+                        res.sendStatus(200);
+                        return;
+                    }
+
                     if (workspace.shareable) {
                         // workspace is shared and hence can be accessed without the cookie.
                         res.sendStatus(200);
@@ -679,6 +699,18 @@ export class UserController {
         }
     }
 
+    protected async isWorkspaceTeamMember(user: User, projectId: string | undefined): Promise<boolean> {
+        if (!projectId) {
+            return false;
+        }
+        const project = await this.projectDb.findProjectById(projectId);
+        if (!project?.teamId) {
+            return false;
+        }
+        const members = await this.teamDb.findMembersByTeam(project.teamId);
+        return members.some((m) => m.userId === user.id);
+    }
+
     protected getSorryUrl(message: string) {
         return this.config.hostUrl.asSorry(message).toString();
     }
@@ -348,6 +348,8 @@ export class GitpodServerImpl implements GitpodServerWithTracing, Disposable {
 
         // owner token will set as cookie in the future
         delete res.status.ownerToken;
+        // the team token is only handed out as cookie to team members
+        delete res.status.teamToken;
         // is an operational internal detail
         delete res.status.nodeName;
         // internal operation detail
@@ -1540,6 +1542,10 @@ export class GitpodServerImpl implements GitpodServerWithTracing, Disposable {
         spec.setVisibility(this.portVisibilityToProto(port.visibility));
         req.setSpec(spec);
         req.setExpose(true);
+        if (port.shareLinkExpiry) {
+            req.setShareLinkExpiry(port.shareLinkExpiry);
+        }
+        req.setRevokeShareLinks(!!port.revokeShareLinks);
 
         try {
             const client = await this.workspaceManagerClientProvider.get(
@@ -1559,6 +1565,10 @@ export class GitpodServerImpl implements GitpodServerWithTracing, Disposable {
                 return "private";
             case ProtoPortVisibility.PORT_VISIBILITY_PUBLIC:
                 return "public";
+            case ProtoPortVisibility.PORT_VISIBILITY_TEAM:
+                return "team";
+            case ProtoPortVisibility.PORT_VISIBILITY_TOKEN:
+                return "token";
         }
     }
 
@@ -1569,6 +1579,10 @@ export class GitpodServerImpl implements GitpodServerWithTracing, Disposable {
                 return ProtoPortVisibility.PORT_VISIBILITY_PRIVATE;
             case "public":
                 return ProtoPortVisibility.PORT_VISIBILITY_PUBLIC;
+            case "team":
+                return ProtoPortVisibility.PORT_VISIBILITY_TEAM;
+            case "token":
+                return ProtoPortVisibility.PORT_VISIBILITY_TOKEN;
         }
     }
 
@@ -180,6 +180,10 @@ export class HeadlessLogService {
                 // to be sure to get hold of all terminals created.
                 throw new Error(`instance's ${instanceId} task ${task.getId()} has no terminal yet`);
             }
+            if (task.getState() === TaskState.WAITING) {
+                // a task waiting for its dependencies might not get a terminal for a long time, so we don't wait for it.
+                continue;
+            }
             if (task.getState() === TaskState.CLOSED) {
                 // if a task has already been closed we can no longer access it's terminal, and have to skip it.
                 continue;
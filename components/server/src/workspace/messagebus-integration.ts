@@ -13,7 +13,12 @@ import {
     MessageBusHelperImpl,
     MessagebusListener,
 } from "@gitpod/gitpod-messagebus/lib";
-import { Disposable, PrebuildWithStatus, WorkspaceInstance } from "@gitpod/gitpod-protocol";
+import {
+    Disposable,
+    PrebuildWithStatus,
+    ScheduledWorkspaceStart,
+    WorkspaceInstance,
+} from "@gitpod/gitpod-protocol";
 import { log } from "@gitpod/gitpod-protocol/lib/util/logging";
 import { HeadlessWorkspaceEvent, HeadlessWorkspaceEventType } from "@gitpod/gitpod-protocol/lib/headless-workspace-log";
 import { Channel, Message } from "amqplib";
@@ -157,6 +162,83 @@ export class PrebuildUpdatableQueueListener implements MessagebusListener {
     }
 }
 
+/**
+ * Consumes the scheduled workspace starts which ws-manager-bridge forwards. All servers share the queue, hence every
+ * start is handled by a single server. A message is only acknowledged once it was handled.
+ */
+export class ScheduledWorkspaceStartQueueListener implements MessagebusListener {
+    protected channel: Channel | undefined;
+    protected consumerTag: string | undefined;
+    constructor(protected readonly callback: (ctx: TraceContext, start: ScheduledWorkspaceStart) => Promise<void>) {}
+
+    async establish(channel: Channel): Promise<void> {
+        this.channel = channel;
+
+        await MessageBusHelperImpl.assertScheduledWorkspaceStartQueue(this.channel);
+        const consumer = await channel.consume(
+            MessageBusHelperImpl.SCHEDULED_START_QUEUE,
+            (message) => {
+                this.handleMessage(message).catch((err) =>
+                    log.error("Error while handling scheduled workspace start", err),
+                );
+            },
+            { noAck: false },
+        );
+        this.consumerTag = consumer.consumerTag;
+    }
+
+    protected async handleMessage(message: Message | null) {
+        if (message === null) return;
+        const channel = this.channel;
+
+        const spanCtx = opentracing.globalTracer().extract(opentracing.FORMAT_HTTP_HEADERS, message.properties.headers);
+        let span;
+        if (!!spanCtx && !!spanCtx.toTraceId()) {
+            span = opentracing.globalTracer().startSpan(`/messagebus/${MessageBusHelperImpl.SCHEDULED_START_QUEUE}`, {
+                references: [opentracing.childOf(spanCtx!)],
+            });
+        }
+
+        let msg: ScheduledWorkspaceStart | undefined;
+        try {
+            msg = JSON.parse(message.content.toString()) as ScheduledWorkspaceStart;
+        } catch (e) {
+            log.warn("Caught message without or with invalid JSON content", e, { message });
+        }
+
+        try {
+            if (msg) {
+                await this.callback({ span }, msg);
+            }
+        } catch (e) {
+            log.error("Error while executing message handler", e, { message });
+        } finally {
+            if (channel !== undefined) {
+                channel.ack(message);
+            }
+            if (span) {
+                span.finish();
+            }
+        }
+    }
+
+    async dispose(): Promise<void> {
+        if (!this.channel || !this.consumerTag) return;
+
+        try {
+            // cancel our subscription on the queue
+            await this.channel.cancel(this.consumerTag);
+            this.channel = this.consumerTag = undefined;
+        } catch (e) {
+            if (e instanceof Error && e.toString().includes("Channel closed")) {
+                // This is expected behavior when the message bus server goes down.
+            } else {
+                throw e;
+            }
+        }
+    }
+}
+
 @injectable()
 export class MessageBusIntegration extends AbstractMessageBusIntegration {
     async connect(): Promise<void> {
@@ -165,6 +247,7 @@ export class MessageBusIntegration extends AbstractMessageBusIntegration {
         if (this.channel !== undefined) {
             await this.messageBusHelper.assertWorkspaceExchange(this.channel);
             await MessageBusHelperImpl.assertPrebuildWorkspaceUpdatableQueue(this.channel);
+            await MessageBusHelperImpl.assertScheduledWorkspaceStartQueue(this.channel);
         }
     }
 
@@ -177,6 +260,17 @@ export class MessageBusIntegration extends AbstractMessageBusIntegration {
         return Disposable.create(() => cancellationTokenSource.cancel());
     }
 
+    listenForScheduledWorkspaceStarts(
+        callback: (ctx: TraceContext, start: ScheduledWorkspaceStart) => Promise<void>,
+    ): Disposable {
+        const listener = new ScheduledWorkspaceStartQueueListener(callback);
+        const cancellationTokenSource = new CancellationTokenSource();
+        this.listen(listener, cancellationTokenSource.token).catch((err) => {
+            /** ignore */
+        });
+        return Disposable.create(() => cancellationTokenSource.cancel());
+    }
+
     listenForWorkspaceInstanceUpdates(
         userId: string | undefined,
         callback: WorkspaceInstanceUpdateCallback,
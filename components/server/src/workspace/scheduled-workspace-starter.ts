@@ -0,0 +1,89 @@
+/**
+ * Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+ * Licensed under the GNU Affero General Public License (AGPL).
+ * See License-AGPL.txt in the project root for license information.
+ */
+
+import { injectable, inject } from "inversify";
+import { Disposable, ScheduledWorkspaceStart } from "@gitpod/gitpod-protocol";
+import { log } from "@gitpod/gitpod-protocol/lib/util/logging";
+import { TraceContext } from "@gitpod/gitpod-protocol/lib/util/tracing";
+import { DBWithTracing, TracedWorkspaceDB, UserDB, WorkspaceDB } from "@gitpod/gitpod-db/lib";
+import { MessageBusIntegration } from "./messagebus-integration";
+import { WorkspaceStarter } from "./workspace-starter";
+import { ProjectsService } from "../projects/projects-service";
+
+/**
+ * ScheduledWorkspaceStarter starts the workspaces whose pre-start is due according to their schedule in ws-manager.
+ * ws-manager only announces these starts, so that the workspace instance is created here like for any other start.
+ */
+@injectable()
+export class ScheduledWorkspaceStarter {
+    @inject(MessageBusIntegration) protected readonly messagebus: MessageBusIntegration;
+    @inject(TracedWorkspaceDB) protected readonly workspaceDB: DBWithTracing<WorkspaceDB>;
+    @inject(UserDB) protected readonly userDB: UserDB;
+    @inject(WorkspaceStarter) protected readonly workspaceStarter: WorkspaceStarter;
+    @inject(ProjectsService) protected readonly projectsService: ProjectsService;
+
+    public start(): Disposable {
+        return this.messagebus.listenForScheduledWorkspaceStarts((ctx, start) =>
+            this.startScheduledWorkspace(ctx, start),
+        );
+    }
+
+    protected async startScheduledWorkspace(ctx: TraceContext, start: ScheduledWorkspaceStart): Promise<void> {
+        const span = TraceContext.startSpan("startScheduledWorkspace", ctx);
+        span.setTag("workspaceId", start.workspaceId);
+        const logCtx = { userId: start.ownerId, workspaceId: start.workspaceId };
+
+        try {
+            const workspace = await this.workspaceDB.trace({ span }).findById(start.workspaceId);
+            if (!workspace || workspace.ownerId !== start.ownerId) {
+                log.warn(logCtx, "Ignoring scheduled start of unknown workspace");
+                return;
+            }
+            if (workspace.type !== "regular" || workspace.deleted || !!workspace.softDeleted) {
+                log.info(logCtx, "Ignoring scheduled start of workspace which cannot be started", {
+                    type: workspace.type,
+                });
+                return;
+            }
+
+            const user = await this.userDB.findUserById(workspace.ownerId);
+            if (!user || user.blocked || user.markedDeleted) {
+                log.info(logCtx, "Ignoring scheduled start of workspace whose owner cannot start workspaces");
+                return;
+            }
+
+            // the announcement repeats if ws-manager could not complete its run
+            const runningInstance = await this.workspaceDB.trace({ span }).findRunningInstance(workspace.id);
+            if (runningInstance) {
+                log.info(logCtx, "Scheduled workspace is running already", { instanceId: runningInstance.id });
+                return;
+            }
+
+            const userEnvVars = await this.userDB.getEnvVars(user.id);
+            const projectEnvVars = workspace.projectId
+                ? await this.projectsService.getProjectEnvironmentVariables(workspace.projectId)
+                : [];
+
+            log.info(logCtx, "Starting scheduled workspace", { time: start.time });
+            const result = await this.workspaceStarter.startWorkspace(
+                { span },
+                workspace,
+                user,
+                userEnvVars,
+                projectEnvVars,
+                {
+                    forceDefaultImage: false,
+                },
+            );
+            span.setTag("instanceId", result.instanceID);
+        } catch (err) {
+            TraceContext.setError({ span }, err);
+            log.error(logCtx, "Cannot start scheduled workspace", err);
+        } finally {
+            span.finish();
+        }
+    }
+}
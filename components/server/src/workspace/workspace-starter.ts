@@ -86,6 +86,7 @@ import {
     GitSpec,
     PortSpec,
     PortVisibility,
+    ServiceSpec,
     StartWorkspaceRequest,
     WorkspaceMetadata,
     WorkspaceType,
@@ -1367,12 +1368,14 @@ export class WorkspaceStarter {
         const context = workspace.context;
 
         let allEnvVars: EnvVarWithValue[] = [];
+        // supervisor keeps the user env vars of the repository up to date and needs to know which ones it was started with
+        const repoEnvVarNames: string[] | undefined = CommitContext.is(context) ? [] : undefined;
         if (userEnvVars.length > 0) {
             if (CommitContext.is(context)) {
                 // this is a commit context, thus we can filter the env vars
-                allEnvVars = allEnvVars.concat(
-                    UserEnvVar.filter(userEnvVars, context.repository.owner, context.repository.name),
-                );
+                const repoEnvVars = UserEnvVar.filter(userEnvVars, context.repository.owner, context.repository.name);
+                allEnvVars = allEnvVars.concat(repoEnvVars);
+                repoEnvVarNames?.push(...repoEnvVars.map((e) => e.name));
             } else {
                 allEnvVars = allEnvVars.concat(userEnvVars);
             }
@@ -1404,6 +1407,13 @@ export class WorkspaceStarter {
             envvars.push(ev);
         });
 
+        if (repoEnvVarNames) {
+            const userEnvVarNamesEnv = new EnvironmentVariable();
+            userEnvVarNamesEnv.setName("SUPERVISOR_USER_ENVVARS");
+            userEnvVarNamesEnv.setValue(repoEnvVarNames.join(","));
+            envvars.push(userEnvVarNamesEnv);
+        }
+
         const ideAlias = user.additionalData?.ideSettings?.defaultIde;
         if (ideAlias && ideConfig.ideOptions.options[ideAlias]) {
             const ideAliasEnv = new EnvironmentVariable();
@@ -1443,6 +1453,33 @@ export class WorkspaceStarter {
             envvars.push(ev);
         }
 
+        const serviceConfigs = workspace.config.services || [];
+        if (serviceConfigs.length) {
+            // ws-manager runs the services, supervisor probes their readiness and reports their status.
+            const ev = new EnvironmentVariable();
+            ev.setName("GITPOD_SERVICES");
+            ev.setValue(JSON.stringify(serviceConfigs));
+            envvars.push(ev);
+        }
+        const services = serviceConfigs.map((s) => {
+            const spec = new ServiceSpec();
+            spec.setName(s.name);
+            spec.setImage(s.image);
+            spec.setCommandList(s.command);
+            spec.setEnvList(
+                Object.entries(s.env || {}).map(([name, value]) => {
+                    const ev = new EnvironmentVariable();
+                    ev.setName(name);
+                    ev.setValue(value);
+                    return ev;
+                }),
+            );
+            spec.setPortsList(s.ports || []);
+            spec.setCpuLimit(s.resources?.cpu || "");
+            spec.setMemoryLimit(s.resources?.memory || "");
+            return spec;
+        });
+
         const vsxRegistryUrl = new EnvironmentVariable();
         vsxRegistryUrl.setName("VSX_REGISTRY_URL");
         vsxRegistryUrl.setValue(this.config.vsxRegistryUrl);
@@ -1454,6 +1491,16 @@ export class WorkspaceStarter {
         dotfileEnv.setValue(user.additionalData?.dotfileRepo || "");
         envvars.push(dotfileEnv);
 
+        const dotfileRefEnv = new EnvironmentVariable();
+        dotfileRefEnv.setName("SUPERVISOR_DOTFILE_REF");
+        dotfileRefEnv.setValue(user.additionalData?.dotfileRef || "");
+        envvars.push(dotfileRefEnv);
+
+        const dotfileSigningKeysEnv = new EnvironmentVariable();
+        dotfileSigningKeysEnv.setName("SUPERVISOR_DOTFILE_SIGNING_KEYS");
+        dotfileSigningKeysEnv.setValue(user.additionalData?.dotfileSigningKeys || "");
+        envvars.push(dotfileSigningKeysEnv);
+
         if (workspace.config.coreDump?.enabled) {
             // default core dump size is 262144 blocks (if blocksize is 4096)
             const defaultLimit: number = 1073741824;
@@ -1517,11 +1564,19 @@ export class WorkspaceStarter {
 
                 const spec = new PortSpec();
                 spec.setPort(p.port);
-                spec.setVisibility(
-                    p.visibility == "public"
-                        ? PortVisibility.PORT_VISIBILITY_PUBLIC
-                        : PortVisibility.PORT_VISIBILITY_PRIVATE,
-                );
+                switch (p.visibility) {
+                    case "public":
+                        spec.setVisibility(PortVisibility.PORT_VISIBILITY_PUBLIC);
+                        break;
+                    case "team":
+                        spec.setVisibility(PortVisibility.PORT_VISIBILITY_TEAM);
+                        break;
+                    case "token":
+                        spec.setVisibility(PortVisibility.PORT_VISIBILITY_TOKEN);
+                        break;
+                    default:
+                        spec.setVisibility(PortVisibility.PORT_VISIBILITY_PRIVATE);
+                }
                 return spec;
             })
             .filter((spec) => !!spec) as PortSpec[];
@@ -1598,6 +1653,7 @@ export class WorkspaceStarter {
         spec.setEnvvarsList(envvars);
         spec.setGit(this.createGitSpec(workspace, user));
         spec.setPortsList(ports);
+        spec.setServicesList(services);
         spec.setInitializer((await initializerPromise).initializer);
         const startWorkspaceSpecIDEImage = new IDEImage();
         startWorkspaceSpecIDEImage.setWebRef(ideImage);
@@ -1931,6 +1987,26 @@ export class WorkspaceStarter {
             result.setUpstreamRemoteUri(context.upstreamRemoteURI);
         }
 
+        const gitClone = workspace.config.gitClone;
+        if (!!gitClone) {
+            if (typeof gitClone.depth === "number") {
+                // the initializer clones the full history for negative depths, while zero means the latest commit only
+                result.setCloneDepth(gitClone.depth === 0 ? -1 : gitClone.depth);
+            }
+            if (!!gitClone.filter) {
+                result.setCloneFilter(gitClone.filter);
+            }
+            if (!!gitClone.sparseCheckout) {
+                result.setSparseCheckoutList(gitClone.sparseCheckout);
+            }
+            if (!!gitClone.lfs?.include) {
+                result.setLfsIncludeList(gitClone.lfs.include);
+            }
+            if (!!gitClone.lfs?.exclude) {
+                result.setLfsExcludeList(gitClone.lfs.exclude);
+            }
+        }
+
         return {
             initializer: result,
         };
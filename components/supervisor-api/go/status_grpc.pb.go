@@ -43,6 +43,12 @@ type StatusServiceClient interface {
 	TasksStatus(ctx context.Context, in *TasksStatusRequest, opts ...grpc.CallOption) (StatusService_TasksStatusClient, error)
 	// ResourcesStatus provides workspace resources status information.
 	ResourcesStatus(ctx context.Context, in *ResourcesStatuRequest, opts ...grpc.CallOption) (*ResourcesStatusResponse, error)
+	// DotfilesStatus provides feedback about the installation of the user's dotfiles. When used with `wait`,
+	// the call returns once the installation has finished.
+	DotfilesStatus(ctx context.Context, in *DotfilesStatusRequest, opts ...grpc.CallOption) (*DotfilesStatusResponse, error)
+	// ServicesStatus provides the status of the workspace's services, i.e. the containers ws-manager runs
+	// next to the workspace as configured in the services section of the .gitpod.yml.
+	ServicesStatus(ctx context.Context, in *ServicesStatusRequest, opts ...grpc.CallOption) (StatusService_ServicesStatusClient, error)
 }
 
 type statusServiceClient struct {
@@ -162,6 +168,47 @@ func (c *statusServiceClient) ResourcesStatus(ctx context.Context, in *Resources
 	return out, nil
 }
 
+func (c *statusServiceClient) DotfilesStatus(ctx context.Context, in *DotfilesStatusRequest, opts ...grpc.CallOption) (*DotfilesStatusResponse, error) {
+	out := new(DotfilesStatusResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.StatusService/DotfilesStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statusServiceClient) ServicesStatus(ctx context.Context, in *ServicesStatusRequest, opts ...grpc.CallOption) (StatusService_ServicesStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StatusService_ServiceDesc.Streams[2], "/supervisor.StatusService/ServicesStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &statusServiceServicesStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StatusService_ServicesStatusClient interface {
+	Recv() (*ServicesStatusResponse, error)
+	grpc.ClientStream
+}
+
+type statusServiceServicesStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *statusServiceServicesStatusClient) Recv() (*ServicesStatusResponse, error) {
+	m := new(ServicesStatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // StatusServiceServer is the server API for StatusService service.
 // All implementations must embed UnimplementedStatusServiceServer
 // for forward compatibility
@@ -183,6 +230,12 @@ type StatusServiceServer interface {
 	TasksStatus(*TasksStatusRequest, StatusService_TasksStatusServer) error
 	// ResourcesStatus provides workspace resources status information.
 	ResourcesStatus(context.Context, *ResourcesStatuRequest) (*ResourcesStatusResponse, error)
+	// DotfilesStatus provides feedback about the installation of the user's dotfiles. When used with `wait`,
+	// the call returns once the installation has finished.
+	DotfilesStatus(context.Context, *DotfilesStatusRequest) (*DotfilesStatusResponse, error)
+	// ServicesStatus provides the status of the workspace's services, i.e. the containers ws-manager runs
+	// next to the workspace as configured in the services section of the .gitpod.yml.
+	ServicesStatus(*ServicesStatusRequest, StatusService_ServicesStatusServer) error
 	mustEmbedUnimplementedStatusServiceServer()
 }
 
@@ -211,6 +264,12 @@ func (UnimplementedStatusServiceServer) TasksStatus(*TasksStatusRequest, StatusS
 func (UnimplementedStatusServiceServer) ResourcesStatus(context.Context, *ResourcesStatuRequest) (*ResourcesStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResourcesStatus not implemented")
 }
+func (UnimplementedStatusServiceServer) DotfilesStatus(context.Context, *DotfilesStatusRequest) (*DotfilesStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DotfilesStatus not implemented")
+}
+func (UnimplementedStatusServiceServer) ServicesStatus(*ServicesStatusRequest, StatusService_ServicesStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method ServicesStatus not implemented")
+}
 func (UnimplementedStatusServiceServer) mustEmbedUnimplementedStatusServiceServer() {}
 
 // UnsafeStatusServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -356,6 +415,45 @@ func _StatusService_ResourcesStatus_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StatusService_DotfilesStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DotfilesStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusServiceServer).DotfilesStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.StatusService/DotfilesStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusServiceServer).DotfilesStatus(ctx, req.(*DotfilesStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatusService_ServicesStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ServicesStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StatusServiceServer).ServicesStatus(m, &statusServiceServicesStatusServer{stream})
+}
+
+type StatusService_ServicesStatusServer interface {
+	Send(*ServicesStatusResponse) error
+	grpc.ServerStream
+}
+
+type statusServiceServicesStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *statusServiceServicesStatusServer) Send(m *ServicesStatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // StatusService_ServiceDesc is the grpc.ServiceDesc for StatusService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -383,6 +481,10 @@ var StatusService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResourcesStatus",
 			Handler:    _StatusService_ResourcesStatus_Handler,
 		},
+		{
+			MethodName: "DotfilesStatus",
+			Handler:    _StatusService_DotfilesStatus_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -395,6 +497,11 @@ var StatusService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _StatusService_TasksStatus_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ServicesStatus",
+			Handler:       _StatusService_ServicesStatus_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "status.proto",
 }
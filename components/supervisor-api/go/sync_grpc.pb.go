@@ -0,0 +1,227 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.20.1
+// source: sync.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SyncServiceClient is the client API for SyncService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SyncServiceClient interface {
+	// Done completes a barrier and releases all its waiters. Completing a barrier which is done
+	// already keeps its payload.
+	Done(ctx context.Context, in *SyncDoneRequest, opts ...grpc.CallOption) (*SyncDoneResponse, error)
+	// Await returns once a barrier is done. The deadline of the call is the timeout of the wait.
+	Await(ctx context.Context, in *SyncAwaitRequest, opts ...grpc.CallOption) (*SyncAwaitResponse, error)
+	// List lists the barriers which are done or awaited.
+	List(ctx context.Context, in *ListSyncBarriersRequest, opts ...grpc.CallOption) (*ListSyncBarriersResponse, error)
+	// Reset resets a barrier which is done, such that it can be awaited and completed again.
+	Reset(ctx context.Context, in *ResetSyncBarrierRequest, opts ...grpc.CallOption) (*ResetSyncBarrierResponse, error)
+}
+
+type syncServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSyncServiceClient(cc grpc.ClientConnInterface) SyncServiceClient {
+	return &syncServiceClient{cc}
+}
+
+func (c *syncServiceClient) Done(ctx context.Context, in *SyncDoneRequest, opts ...grpc.CallOption) (*SyncDoneResponse, error) {
+	out := new(SyncDoneResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.SyncService/Done", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncServiceClient) Await(ctx context.Context, in *SyncAwaitRequest, opts ...grpc.CallOption) (*SyncAwaitResponse, error) {
+	out := new(SyncAwaitResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.SyncService/Await", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncServiceClient) List(ctx context.Context, in *ListSyncBarriersRequest, opts ...grpc.CallOption) (*ListSyncBarriersResponse, error) {
+	out := new(ListSyncBarriersResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.SyncService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncServiceClient) Reset(ctx context.Context, in *ResetSyncBarrierRequest, opts ...grpc.CallOption) (*ResetSyncBarrierResponse, error) {
+	out := new(ResetSyncBarrierResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.SyncService/Reset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SyncServiceServer is the server API for SyncService service.
+// All implementations must embed UnimplementedSyncServiceServer
+// for forward compatibility
+type SyncServiceServer interface {
+	// Done completes a barrier and releases all its waiters. Completing a barrier which is done
+	// already keeps its payload.
+	Done(context.Context, *SyncDoneRequest) (*SyncDoneResponse, error)
+	// Await returns once a barrier is done. The deadline of the call is the timeout of the wait.
+	Await(context.Context, *SyncAwaitRequest) (*SyncAwaitResponse, error)
+	// List lists the barriers which are done or awaited.
+	List(context.Context, *ListSyncBarriersRequest) (*ListSyncBarriersResponse, error)
+	// Reset resets a barrier which is done, such that it can be awaited and completed again.
+	Reset(context.Context, *ResetSyncBarrierRequest) (*ResetSyncBarrierResponse, error)
+	mustEmbedUnimplementedSyncServiceServer()
+}
+
+// UnimplementedSyncServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSyncServiceServer struct {
+}
+
+func (UnimplementedSyncServiceServer) Done(context.Context, *SyncDoneRequest) (*SyncDoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Done not implemented")
+}
+func (UnimplementedSyncServiceServer) Await(context.Context, *SyncAwaitRequest) (*SyncAwaitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Await not implemented")
+}
+func (UnimplementedSyncServiceServer) List(context.Context, *ListSyncBarriersRequest) (*ListSyncBarriersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedSyncServiceServer) Reset(context.Context, *ResetSyncBarrierRequest) (*ResetSyncBarrierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reset not implemented")
+}
+func (UnimplementedSyncServiceServer) mustEmbedUnimplementedSyncServiceServer() {}
+
+// UnsafeSyncServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SyncServiceServer will
+// result in compilation errors.
+type UnsafeSyncServiceServer interface {
+	mustEmbedUnimplementedSyncServiceServer()
+}
+
+func RegisterSyncServiceServer(s grpc.ServiceRegistrar, srv SyncServiceServer) {
+	s.RegisterService(&SyncService_ServiceDesc, srv)
+}
+
+func _SyncService_Done_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncDoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServiceServer).Done(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.SyncService/Done",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServiceServer).Done(ctx, req.(*SyncDoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SyncService_Await_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncAwaitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServiceServer).Await(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.SyncService/Await",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServiceServer).Await(ctx, req.(*SyncAwaitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SyncService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSyncBarriersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.SyncService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServiceServer).List(ctx, req.(*ListSyncBarriersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SyncService_Reset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetSyncBarrierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServiceServer).Reset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.SyncService/Reset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServiceServer).Reset(ctx, req.(*ResetSyncBarrierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SyncService_ServiceDesc is the grpc.ServiceDesc for SyncService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SyncService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "supervisor.SyncService",
+	HandlerType: (*SyncServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Done",
+			Handler:    _SyncService_Done_Handler,
+		},
+		{
+			MethodName: "Await",
+			Handler:    _SyncService_Await_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _SyncService_List_Handler,
+		},
+		{
+			MethodName: "Reset",
+			Handler:    _SyncService_Reset_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sync.proto",
+}
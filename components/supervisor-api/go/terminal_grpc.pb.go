@@ -45,6 +45,14 @@ type TerminalServiceClient interface {
 	SetTitle(ctx context.Context, in *SetTerminalTitleRequest, opts ...grpc.CallOption) (*SetTerminalTitleResponse, error)
 	// UpdateAnnotations updates the terminal's annotations
 	UpdateAnnotations(ctx context.Context, in *UpdateTerminalAnnotationsRequest, opts ...grpc.CallOption) (*UpdateTerminalAnnotationsResponse, error)
+	// Share creates a short-lived, read-only share of a terminal
+	Share(ctx context.Context, in *ShareTerminalRequest, opts ...grpc.CallOption) (*ShareTerminalResponse, error)
+	// Unshare revokes a terminal share and disconnects all of its viewers
+	Unshare(ctx context.Context, in *UnshareTerminalRequest, opts ...grpc.CallOption) (*UnshareTerminalResponse, error)
+	// ListShares lists the active terminal shares
+	ListShares(ctx context.Context, in *ListTerminalSharesRequest, opts ...grpc.CallOption) (*ListTerminalSharesResponse, error)
+	// ListenShared listens read-only to a shared terminal
+	ListenShared(ctx context.Context, in *ListenSharedTerminalRequest, opts ...grpc.CallOption) (TerminalService_ListenSharedClient, error)
 }
 
 type terminalServiceClient struct {
@@ -159,6 +167,65 @@ func (c *terminalServiceClient) UpdateAnnotations(ctx context.Context, in *Updat
 	return out, nil
 }
 
+func (c *terminalServiceClient) Share(ctx context.Context, in *ShareTerminalRequest, opts ...grpc.CallOption) (*ShareTerminalResponse, error) {
+	out := new(ShareTerminalResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.TerminalService/Share", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalServiceClient) Unshare(ctx context.Context, in *UnshareTerminalRequest, opts ...grpc.CallOption) (*UnshareTerminalResponse, error) {
+	out := new(UnshareTerminalResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.TerminalService/Unshare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalServiceClient) ListShares(ctx context.Context, in *ListTerminalSharesRequest, opts ...grpc.CallOption) (*ListTerminalSharesResponse, error) {
+	out := new(ListTerminalSharesResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.TerminalService/ListShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalServiceClient) ListenShared(ctx context.Context, in *ListenSharedTerminalRequest, opts ...grpc.CallOption) (TerminalService_ListenSharedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TerminalService_ServiceDesc.Streams[1], "/supervisor.TerminalService/ListenShared", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &terminalServiceListenSharedClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TerminalService_ListenSharedClient interface {
+	Recv() (*ListenTerminalResponse, error)
+	grpc.ClientStream
+}
+
+type terminalServiceListenSharedClient struct {
+	grpc.ClientStream
+}
+
+func (x *terminalServiceListenSharedClient) Recv() (*ListenTerminalResponse, error) {
+	m := new(ListenTerminalResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // TerminalServiceServer is the server API for TerminalService service.
 // All implementations must embed UnimplementedTerminalServiceServer
 // for forward compatibility
@@ -182,6 +249,14 @@ type TerminalServiceServer interface {
 	SetTitle(context.Context, *SetTerminalTitleRequest) (*SetTerminalTitleResponse, error)
 	// UpdateAnnotations updates the terminal's annotations
 	UpdateAnnotations(context.Context, *UpdateTerminalAnnotationsRequest) (*UpdateTerminalAnnotationsResponse, error)
+	// Share creates a short-lived, read-only share of a terminal
+	Share(context.Context, *ShareTerminalRequest) (*ShareTerminalResponse, error)
+	// Unshare revokes a terminal share and disconnects all of its viewers
+	Unshare(context.Context, *UnshareTerminalRequest) (*UnshareTerminalResponse, error)
+	// ListShares lists the active terminal shares
+	ListShares(context.Context, *ListTerminalSharesRequest) (*ListTerminalSharesResponse, error)
+	// ListenShared listens read-only to a shared terminal
+	ListenShared(*ListenSharedTerminalRequest, TerminalService_ListenSharedServer) error
 	mustEmbedUnimplementedTerminalServiceServer()
 }
 
@@ -216,6 +291,18 @@ func (UnimplementedTerminalServiceServer) SetTitle(context.Context, *SetTerminal
 func (UnimplementedTerminalServiceServer) UpdateAnnotations(context.Context, *UpdateTerminalAnnotationsRequest) (*UpdateTerminalAnnotationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAnnotations not implemented")
 }
+func (UnimplementedTerminalServiceServer) Share(context.Context, *ShareTerminalRequest) (*ShareTerminalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Share not implemented")
+}
+func (UnimplementedTerminalServiceServer) Unshare(context.Context, *UnshareTerminalRequest) (*UnshareTerminalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unshare not implemented")
+}
+func (UnimplementedTerminalServiceServer) ListShares(context.Context, *ListTerminalSharesRequest) (*ListTerminalSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShares not implemented")
+}
+func (UnimplementedTerminalServiceServer) ListenShared(*ListenSharedTerminalRequest, TerminalService_ListenSharedServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListenShared not implemented")
+}
 func (UnimplementedTerminalServiceServer) mustEmbedUnimplementedTerminalServiceServer() {}
 
 // UnsafeTerminalServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -394,6 +481,81 @@ func _TerminalService_UpdateAnnotations_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TerminalService_Share_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareTerminalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).Share(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.TerminalService/Share",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).Share(ctx, req.(*ShareTerminalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalService_Unshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareTerminalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).Unshare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.TerminalService/Unshare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).Unshare(ctx, req.(*UnshareTerminalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalService_ListShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTerminalSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).ListShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.TerminalService/ListShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).ListShares(ctx, req.(*ListTerminalSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalService_ListenShared_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListenSharedTerminalRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TerminalServiceServer).ListenShared(m, &terminalServiceListenSharedServer{stream})
+}
+
+type TerminalService_ListenSharedServer interface {
+	Send(*ListenTerminalResponse) error
+	grpc.ServerStream
+}
+
+type terminalServiceListenSharedServer struct {
+	grpc.ServerStream
+}
+
+func (x *terminalServiceListenSharedServer) Send(m *ListenTerminalResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // TerminalService_ServiceDesc is the grpc.ServiceDesc for TerminalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -433,6 +595,18 @@ var TerminalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateAnnotations",
 			Handler:    _TerminalService_UpdateAnnotations_Handler,
 		},
+		{
+			MethodName: "Share",
+			Handler:    _TerminalService_Share_Handler,
+		},
+		{
+			MethodName: "Unshare",
+			Handler:    _TerminalService_Unshare_Handler,
+		},
+		{
+			MethodName: "ListShares",
+			Handler:    _TerminalService_ListShares_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -440,6 +614,11 @@ var TerminalService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _TerminalService_Listen_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListenShared",
+			Handler:       _TerminalService_ListenShared_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "terminal.proto",
 }
@@ -84,6 +84,37 @@ public final class ControlServiceGrpc {
     return getCreateSSHKeyPairMethod;
   }
 
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Control.RestartServiceRequest,
+      io.gitpod.supervisor.api.Control.RestartServiceResponse> getRestartServiceMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "RestartService",
+      requestType = io.gitpod.supervisor.api.Control.RestartServiceRequest.class,
+      responseType = io.gitpod.supervisor.api.Control.RestartServiceResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Control.RestartServiceRequest,
+      io.gitpod.supervisor.api.Control.RestartServiceResponse> getRestartServiceMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Control.RestartServiceRequest, io.gitpod.supervisor.api.Control.RestartServiceResponse> getRestartServiceMethod;
+    if ((getRestartServiceMethod = ControlServiceGrpc.getRestartServiceMethod) == null) {
+      synchronized (ControlServiceGrpc.class) {
+        if ((getRestartServiceMethod = ControlServiceGrpc.getRestartServiceMethod) == null) {
+          ControlServiceGrpc.getRestartServiceMethod = getRestartServiceMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.Control.RestartServiceRequest, io.gitpod.supervisor.api.Control.RestartServiceResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "RestartService"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Control.RestartServiceRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Control.RestartServiceResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new ControlServiceMethodDescriptorSupplier("RestartService"))
+              .build();
+        }
+      }
+    }
+    return getRestartServiceMethod;
+  }
+
   /**
    * Creates a new async stub that supports all call types for the service
    */
@@ -155,6 +186,16 @@ public final class ControlServiceGrpc {
       io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getCreateSSHKeyPairMethod(), responseObserver);
     }
 
+    /**
+     * <pre>
+     * RestartService restarts a service of the workspace
+     * </pre>
+     */
+    public void restartService(io.gitpod.supervisor.api.Control.RestartServiceRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Control.RestartServiceResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getRestartServiceMethod(), responseObserver);
+    }
+
     @java.lang.Override public final io.grpc.ServerServiceDefinition bindService() {
       return io.grpc.ServerServiceDefinition.builder(getServiceDescriptor())
           .addMethod(
@@ -171,6 +212,13 @@ public final class ControlServiceGrpc {
                 io.gitpod.supervisor.api.Control.CreateSSHKeyPairRequest,
                 io.gitpod.supervisor.api.Control.CreateSSHKeyPairResponse>(
                   this, METHODID_CREATE_SSHKEY_PAIR)))
+          .addMethod(
+            getRestartServiceMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.Control.RestartServiceRequest,
+                io.gitpod.supervisor.api.Control.RestartServiceResponse>(
+                  this, METHODID_RESTART_SERVICE)))
           .build();
     }
   }
@@ -213,6 +261,17 @@ public final class ControlServiceGrpc {
       io.grpc.stub.ClientCalls.asyncUnaryCall(
           getChannel().newCall(getCreateSSHKeyPairMethod(), getCallOptions()), request, responseObserver);
     }
+
+    /**
+     * <pre>
+     * RestartService restarts a service of the workspace
+     * </pre>
+     */
+    public void restartService(io.gitpod.supervisor.api.Control.RestartServiceRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Control.RestartServiceResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getRestartServiceMethod(), getCallOptions()), request, responseObserver);
+    }
   }
 
   /**
@@ -251,6 +310,16 @@ public final class ControlServiceGrpc {
       return io.grpc.stub.ClientCalls.blockingUnaryCall(
           getChannel(), getCreateSSHKeyPairMethod(), getCallOptions(), request);
     }
+
+    /**
+     * <pre>
+     * RestartService restarts a service of the workspace
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.Control.RestartServiceResponse restartService(io.gitpod.supervisor.api.Control.RestartServiceRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getRestartServiceMethod(), getCallOptions(), request);
+    }
   }
 
   /**
@@ -291,10 +360,22 @@ public final class ControlServiceGrpc {
       return io.grpc.stub.ClientCalls.futureUnaryCall(
           getChannel().newCall(getCreateSSHKeyPairMethod(), getCallOptions()), request);
     }
+
+    /**
+     * <pre>
+     * RestartService restarts a service of the workspace
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.Control.RestartServiceResponse> restartService(
+        io.gitpod.supervisor.api.Control.RestartServiceRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getRestartServiceMethod(), getCallOptions()), request);
+    }
   }
 
   private static final int METHODID_EXPOSE_PORT = 0;
   private static final int METHODID_CREATE_SSHKEY_PAIR = 1;
+  private static final int METHODID_RESTART_SERVICE = 2;
 
   private static final class MethodHandlers<Req, Resp> implements
       io.grpc.stub.ServerCalls.UnaryMethod<Req, Resp>,
@@ -321,6 +402,10 @@ public final class ControlServiceGrpc {
           serviceImpl.createSSHKeyPair((io.gitpod.supervisor.api.Control.CreateSSHKeyPairRequest) request,
               (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Control.CreateSSHKeyPairResponse>) responseObserver);
           break;
+        case METHODID_RESTART_SERVICE:
+          serviceImpl.restartService((io.gitpod.supervisor.api.Control.RestartServiceRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Control.RestartServiceResponse>) responseObserver);
+          break;
         default:
           throw new AssertionError();
       }
@@ -384,6 +469,7 @@ public final class ControlServiceGrpc {
               .setSchemaDescriptor(new ControlServiceFileDescriptorSupplier())
               .addMethod(getExposePortMethod())
               .addMethod(getCreateSSHKeyPairMethod())
+              .addMethod(getRestartServiceMethod())
               .build();
         }
       }
@@ -148,6 +148,22 @@ public final class Status {
      * <code>public_visibility = 1;</code>
      */
     public_visibility(1),
+    /**
+     * <pre>
+     * team ports can be accessed by members of the workspace's team
+     * </pre>
+     *
+     * <code>team = 2;</code>
+     */
+    team(2),
+    /**
+     * <pre>
+     * token ports can be accessed by everyone with a signed share link which expires
+     * </pre>
+     *
+     * <code>token = 3;</code>
+     */
+    token(3),
     UNRECOGNIZED(-1),
     ;
 
@@ -159,6 +175,22 @@ public final class Status {
      * <code>public_visibility = 1;</code>
      */
     public static final int public_visibility_VALUE = 1;
+    /**
+     * <pre>
+     * team ports can be accessed by members of the workspace's team
+     * </pre>
+     *
+     * <code>team = 2;</code>
+     */
+    public static final int team_VALUE = 2;
+    /**
+     * <pre>
+     * token ports can be accessed by everyone with a signed share link which expires
+     * </pre>
+     *
+     * <code>token = 3;</code>
+     */
+    public static final int token_VALUE = 3;
 
 
     public final int getNumber() {
@@ -187,6 +219,8 @@ public final class Status {
       switch (value) {
         case 0: return private_visibility;
         case 1: return public_visibility;
+        case 2: return team;
+        case 3: return token;
         default: return null;
       }
     }
@@ -516,6 +550,14 @@ public final class Status {
      * <code>closed = 2;</code>
      */
     closed(2),
+    /**
+     * <pre>
+     * waiting tasks have not been started yet because they wait for the tasks they depend on to become ready
+     * </pre>
+     *
+     * <code>waiting = 3;</code>
+     */
+    waiting(3),
     UNRECOGNIZED(-1),
     ;
 
@@ -531,6 +573,14 @@ public final class Status {
      * <code>closed = 2;</code>
      */
     public static final int closed_VALUE = 2;
+    /**
+     * <pre>
+     * waiting tasks have not been started yet because they wait for the tasks they depend on to become ready
+     * </pre>
+     *
+     * <code>waiting = 3;</code>
+     */
+    public static final int waiting_VALUE = 3;
 
 
     public final int getNumber() {
@@ -560,6 +610,7 @@ public final class Status {
         case 0: return opening;
         case 1: return running;
         case 2: return closed;
+        case 3: return waiting;
         default: return null;
       }
     }
@@ -733,6 +784,291 @@ public final class Status {
     // @@protoc_insertion_point(enum_scope:supervisor.ResourceStatusSeverity)
   }
 
+  /**
+   * Protobuf enum {@code supervisor.DotfilesPhase}
+   */
+  public enum DotfilesPhase
+      implements com.google.protobuf.ProtocolMessageEnum {
+    /**
+     * <pre>
+     * no dotfiles repository is configured
+     * </pre>
+     *
+     * <code>not_configured = 0;</code>
+     */
+    not_configured(0),
+    /**
+     * <code>cloning = 1;</code>
+     */
+    cloning(1),
+    /**
+     * <code>verifying = 2;</code>
+     */
+    verifying(2),
+    /**
+     * <code>installing = 3;</code>
+     */
+    installing(3),
+    /**
+     * <code>installed = 4;</code>
+     */
+    installed(4),
+    /**
+     * <code>install_failed = 5;</code>
+     */
+    install_failed(5),
+    UNRECOGNIZED(-1),
+    ;
+
+    /**
+     * <pre>
+     * no dotfiles repository is configured
+     * </pre>
+     *
+     * <code>not_configured = 0;</code>
+     */
+    public static final int not_configured_VALUE = 0;
+    /**
+     * <code>cloning = 1;</code>
+     */
+    public static final int cloning_VALUE = 1;
+    /**
+     * <code>verifying = 2;</code>
+     */
+    public static final int verifying_VALUE = 2;
+    /**
+     * <code>installing = 3;</code>
+     */
+    public static final int installing_VALUE = 3;
+    /**
+     * <code>installed = 4;</code>
+     */
+    public static final int installed_VALUE = 4;
+    /**
+     * <code>install_failed = 5;</code>
+     */
+    public static final int install_failed_VALUE = 5;
+
+
+    public final int getNumber() {
+      if (this == UNRECOGNIZED) {
+        throw new java.lang.IllegalArgumentException(
+            "Can't get the number of an unknown enum value.");
+      }
+      return value;
+    }
+
+    /**
+     * @param value The numeric wire value of the corresponding enum entry.
+     * @return The enum associated with the given numeric wire value.
+     * @deprecated Use {@link #forNumber(int)} instead.
+     */
+    @java.lang.Deprecated
+    public static DotfilesPhase valueOf(int value) {
+      return forNumber(value);
+    }
+
+    /**
+     * @param value The numeric wire value of the corresponding enum entry.
+     * @return The enum associated with the given numeric wire value.
+     */
+    public static DotfilesPhase forNumber(int value) {
+      switch (value) {
+        case 0: return not_configured;
+        case 1: return cloning;
+        case 2: return verifying;
+        case 3: return installing;
+        case 4: return installed;
+        case 5: return install_failed;
+        default: return null;
+      }
+    }
+
+    public static com.google.protobuf.Internal.EnumLiteMap<DotfilesPhase>
+        internalGetValueMap() {
+      return internalValueMap;
+    }
+    private static final com.google.protobuf.Internal.EnumLiteMap<
+        DotfilesPhase> internalValueMap =
+          new com.google.protobuf.Internal.EnumLiteMap<DotfilesPhase>() {
+            public DotfilesPhase findValueByNumber(int number) {
+              return DotfilesPhase.forNumber(number);
+            }
+          };
+
+    public final com.google.protobuf.Descriptors.EnumValueDescriptor
+        getValueDescriptor() {
+      if (this == UNRECOGNIZED) {
+        throw new java.lang.IllegalStateException(
+            "Can't get the descriptor of an unrecognized enum value.");
+      }
+      return getDescriptor().getValues().get(ordinal());
+    }
+    public final com.google.protobuf.Descriptors.EnumDescriptor
+        getDescriptorForType() {
+      return getDescriptor();
+    }
+    public static final com.google.protobuf.Descriptors.EnumDescriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(6);
+    }
+
+    private static final DotfilesPhase[] VALUES = values();
+
+    public static DotfilesPhase valueOf(
+        com.google.protobuf.Descriptors.EnumValueDescriptor desc) {
+      if (desc.getType() != getDescriptor()) {
+        throw new java.lang.IllegalArgumentException(
+          "EnumValueDescriptor is not for this type.");
+      }
+      if (desc.getIndex() == -1) {
+        return UNRECOGNIZED;
+      }
+      return VALUES[desc.getIndex()];
+    }
+
+    private final int value;
+
+    private DotfilesPhase(int value) {
+      this.value = value;
+    }
+
+    // @@protoc_insertion_point(enum_scope:supervisor.DotfilesPhase)
+  }
+
+  /**
+   * Protobuf enum {@code supervisor.ServiceState}
+   */
+  public enum ServiceState
+      implements com.google.protobuf.ProtocolMessageEnum {
+    /**
+     * <pre>
+     * the service container has not started the service yet
+     * </pre>
+     *
+     * <code>pending = 0;</code>
+     */
+    pending(0),
+    /**
+     * <code>started = 1;</code>
+     */
+    started(1),
+    /**
+     * <pre>
+     * the service exited and is about to be restarted
+     * </pre>
+     *
+     * <code>exited = 2;</code>
+     */
+    exited(2),
+    UNRECOGNIZED(-1),
+    ;
+
+    /**
+     * <pre>
+     * the service container has not started the service yet
+     * </pre>
+     *
+     * <code>pending = 0;</code>
+     */
+    public static final int pending_VALUE = 0;
+    /**
+     * <code>started = 1;</code>
+     */
+    public static final int started_VALUE = 1;
+    /**
+     * <pre>
+     * the service exited and is about to be restarted
+     * </pre>
+     *
+     * <code>exited = 2;</code>
+     */
+    public static final int exited_VALUE = 2;
+
+
+    public final int getNumber() {
+      if (this == UNRECOGNIZED) {
+        throw new java.lang.IllegalArgumentException(
+            "Can't get the number of an unknown enum value.");
+      }
+      return value;
+    }
+
+    /**
+     * @param value The numeric wire value of the corresponding enum entry.
+     * @return The enum associated with the given numeric wire value.
+     * @deprecated Use {@link #forNumber(int)} instead.
+     */
+    @java.lang.Deprecated
+    public static ServiceState valueOf(int value) {
+      return forNumber(value);
+    }
+
+    /**
+     * @param value The numeric wire value of the corresponding enum entry.
+     * @return The enum associated with the given numeric wire value.
+     */
+    public static ServiceState forNumber(int value) {
+      switch (value) {
+        case 0: return pending;
+        case 1: return started;
+        case 2: return exited;
+        default: return null;
+      }
+    }
+
+    public static com.google.protobuf.Internal.EnumLiteMap<ServiceState>
+        internalGetValueMap() {
+      return internalValueMap;
+    }
+    private static final com.google.protobuf.Internal.EnumLiteMap<
+        ServiceState> internalValueMap =
+          new com.google.protobuf.Internal.EnumLiteMap<ServiceState>() {
+            public ServiceState findValueByNumber(int number) {
+              return ServiceState.forNumber(number);
+            }
+          };
+
+    public final com.google.protobuf.Descriptors.EnumValueDescriptor
+        getValueDescriptor() {
+      if (this == UNRECOGNIZED) {
+        throw new java.lang.IllegalStateException(
+            "Can't get the descriptor of an unrecognized enum value.");
+      }
+      return getDescriptor().getValues().get(ordinal());
+    }
+    public final com.google.protobuf.Descriptors.EnumDescriptor
+        getDescriptorForType() {
+      return getDescriptor();
+    }
+    public static final com.google.protobuf.Descriptors.EnumDescriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(7);
+    }
+
+    private static final ServiceState[] VALUES = values();
+
+    public static ServiceState valueOf(
+        com.google.protobuf.Descriptors.EnumValueDescriptor desc) {
+      if (desc.getType() != getDescriptor()) {
+        throw new java.lang.IllegalArgumentException(
+          "EnumValueDescriptor is not for this type.");
+      }
+      if (desc.getIndex() == -1) {
+        return UNRECOGNIZED;
+      }
+      return VALUES[desc.getIndex()];
+    }
+
+    private final int value;
+
+    private ServiceState(int value) {
+      this.value = value;
+    }
+
+    // @@protoc_insertion_point(enum_scope:supervisor.ServiceState)
+  }
+
   public interface SupervisorStatusRequestOrBuilder extends
       // @@protoc_insertion_point(interface_extends:supervisor.SupervisorStatusRequest)
       com.google.protobuf.MessageOrBuilder {
@@ -4422,6 +4758,38 @@ public final class Status {
      * @return The source.
      */
     io.gitpod.supervisor.api.Status.ContentSource getSource();
+
+    /**
+     * <pre>
+     * progress_phase is the phase of the content initialization while the content is not available yet,
+     * e.g. "Receiving objects" during a Git clone. Empty if there is no progress to report.
+     * </pre>
+     *
+     * <code>string progress_phase = 3;</code>
+     * @return The progressPhase.
+     */
+    java.lang.String getProgressPhase();
+    /**
+     * <pre>
+     * progress_phase is the phase of the content initialization while the content is not available yet,
+     * e.g. "Receiving objects" during a Git clone. Empty if there is no progress to report.
+     * </pre>
+     *
+     * <code>string progress_phase = 3;</code>
+     * @return The bytes for progressPhase.
+     */
+    com.google.protobuf.ByteString
+        getProgressPhaseBytes();
+
+    /**
+     * <pre>
+     * progress_percent is the progress of the current phase in percent
+     * </pre>
+     *
+     * <code>int32 progress_percent = 4;</code>
+     * @return The progressPercent.
+     */
+    int getProgressPercent();
   }
   /**
    * Protobuf type {@code supervisor.ContentStatusResponse}
@@ -4437,6 +4805,7 @@ public final class Status {
     }
     private ContentStatusResponse() {
       source_ = 0;
+      progressPhase_ = "";
     }
 
     @java.lang.Override
@@ -4480,8 +4849,19 @@ public final class Status {
               source_ = rawValue;
               break;
             }
-            default: {
-              if (!parseUnknownField(
+            case 26: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              progressPhase_ = s;
+              break;
+            }
+            case 32: {
+
+              progressPercent_ = input.readInt32();
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
                   input, unknownFields, extensionRegistry, tag)) {
                 done = true;
               }
@@ -4556,6 +4936,69 @@ public final class Status {
       return result == null ? io.gitpod.supervisor.api.Status.ContentSource.UNRECOGNIZED : result;
     }
 
+    public static final int PROGRESS_PHASE_FIELD_NUMBER = 3;
+    private volatile java.lang.Object progressPhase_;
+    /**
+     * <pre>
+     * progress_phase is the phase of the content initialization while the content is not available yet,
+     * e.g. "Receiving objects" during a Git clone. Empty if there is no progress to report.
+     * </pre>
+     *
+     * <code>string progress_phase = 3;</code>
+     * @return The progressPhase.
+     */
+    @java.lang.Override
+    public java.lang.String getProgressPhase() {
+      java.lang.Object ref = progressPhase_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        progressPhase_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * progress_phase is the phase of the content initialization while the content is not available yet,
+     * e.g. "Receiving objects" during a Git clone. Empty if there is no progress to report.
+     * </pre>
+     *
+     * <code>string progress_phase = 3;</code>
+     * @return The bytes for progressPhase.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getProgressPhaseBytes() {
+      java.lang.Object ref = progressPhase_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        progressPhase_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int PROGRESS_PERCENT_FIELD_NUMBER = 4;
+    private int progressPercent_;
+    /**
+     * <pre>
+     * progress_percent is the progress of the current phase in percent
+     * </pre>
+     *
+     * <code>int32 progress_percent = 4;</code>
+     * @return The progressPercent.
+     */
+    @java.lang.Override
+    public int getProgressPercent() {
+      return progressPercent_;
+    }
+
     private byte memoizedIsInitialized = -1;
     @java.lang.Override
     public final boolean isInitialized() {
@@ -4576,6 +5019,12 @@ public final class Status {
       if (source_ != io.gitpod.supervisor.api.Status.ContentSource.from_other.getNumber()) {
         output.writeEnum(2, source_);
       }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(progressPhase_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 3, progressPhase_);
+      }
+      if (progressPercent_ != 0) {
+        output.writeInt32(4, progressPercent_);
+      }
       unknownFields.writeTo(output);
     }
 
@@ -4593,6 +5042,13 @@ public final class Status {
         size += com.google.protobuf.CodedOutputStream
           .computeEnumSize(2, source_);
       }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(progressPhase_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(3, progressPhase_);
+      }
+      if (progressPercent_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(4, progressPercent_);
+      }
       size += unknownFields.getSerializedSize();
       memoizedSize = size;
       return size;
@@ -4611,6 +5067,10 @@ public final class Status {
       if (getAvailable()
           != other.getAvailable()) return false;
       if (source_ != other.source_) return false;
+      if (!getProgressPhase()
+          .equals(other.getProgressPhase())) return false;
+      if (getProgressPercent()
+          != other.getProgressPercent()) return false;
       if (!unknownFields.equals(other.unknownFields)) return false;
       return true;
     }
@@ -4627,6 +5087,10 @@ public final class Status {
           getAvailable());
       hash = (37 * hash) + SOURCE_FIELD_NUMBER;
       hash = (53 * hash) + source_;
+      hash = (37 * hash) + PROGRESS_PHASE_FIELD_NUMBER;
+      hash = (53 * hash) + getProgressPhase().hashCode();
+      hash = (37 * hash) + PROGRESS_PERCENT_FIELD_NUMBER;
+      hash = (53 * hash) + getProgressPercent();
       hash = (29 * hash) + unknownFields.hashCode();
       memoizedHashCode = hash;
       return hash;
@@ -4764,6 +5228,10 @@ public final class Status {
 
         source_ = 0;
 
+        progressPhase_ = "";
+
+        progressPercent_ = 0;
+
         return this;
       }
 
@@ -4792,6 +5260,8 @@ public final class Status {
         io.gitpod.supervisor.api.Status.ContentStatusResponse result = new io.gitpod.supervisor.api.Status.ContentStatusResponse(this);
         result.available_ = available_;
         result.source_ = source_;
+        result.progressPhase_ = progressPhase_;
+        result.progressPercent_ = progressPercent_;
         onBuilt();
         return result;
       }
@@ -4846,6 +5316,13 @@ public final class Status {
         if (other.source_ != 0) {
           setSourceValue(other.getSourceValue());
         }
+        if (!other.getProgressPhase().isEmpty()) {
+          progressPhase_ = other.progressPhase_;
+          onChanged();
+        }
+        if (other.getProgressPercent() != 0) {
+          setProgressPercent(other.getProgressPercent());
+        }
         this.mergeUnknownFields(other.unknownFields);
         onChanged();
         return this;
@@ -4991,6 +5468,150 @@ public final class Status {
         onChanged();
         return this;
       }
+
+      private java.lang.Object progressPhase_ = "";
+      /**
+       * <pre>
+       * progress_phase is the phase of the content initialization while the content is not available yet,
+       * e.g. "Receiving objects" during a Git clone. Empty if there is no progress to report.
+       * </pre>
+       *
+       * <code>string progress_phase = 3;</code>
+       * @return The progressPhase.
+       */
+      public java.lang.String getProgressPhase() {
+        java.lang.Object ref = progressPhase_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          progressPhase_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * progress_phase is the phase of the content initialization while the content is not available yet,
+       * e.g. "Receiving objects" during a Git clone. Empty if there is no progress to report.
+       * </pre>
+       *
+       * <code>string progress_phase = 3;</code>
+       * @return The bytes for progressPhase.
+       */
+      public com.google.protobuf.ByteString
+          getProgressPhaseBytes() {
+        java.lang.Object ref = progressPhase_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          progressPhase_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * progress_phase is the phase of the content initialization while the content is not available yet,
+       * e.g. "Receiving objects" during a Git clone. Empty if there is no progress to report.
+       * </pre>
+       *
+       * <code>string progress_phase = 3;</code>
+       * @param value The progressPhase to set.
+       * @return This builder for chaining.
+       */
+      public Builder setProgressPhase(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        progressPhase_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * progress_phase is the phase of the content initialization while the content is not available yet,
+       * e.g. "Receiving objects" during a Git clone. Empty if there is no progress to report.
+       * </pre>
+       *
+       * <code>string progress_phase = 3;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearProgressPhase() {
+
+        progressPhase_ = getDefaultInstance().getProgressPhase();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * progress_phase is the phase of the content initialization while the content is not available yet,
+       * e.g. "Receiving objects" during a Git clone. Empty if there is no progress to report.
+       * </pre>
+       *
+       * <code>string progress_phase = 3;</code>
+       * @param value The bytes for progressPhase to set.
+       * @return This builder for chaining.
+       */
+      public Builder setProgressPhaseBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        progressPhase_ = value;
+        onChanged();
+        return this;
+      }
+
+      private int progressPercent_ ;
+      /**
+       * <pre>
+       * progress_percent is the progress of the current phase in percent
+       * </pre>
+       *
+       * <code>int32 progress_percent = 4;</code>
+       * @return The progressPercent.
+       */
+      @java.lang.Override
+      public int getProgressPercent() {
+        return progressPercent_;
+      }
+      /**
+       * <pre>
+       * progress_percent is the progress of the current phase in percent
+       * </pre>
+       *
+       * <code>int32 progress_percent = 4;</code>
+       * @param value The progressPercent to set.
+       * @return This builder for chaining.
+       */
+      public Builder setProgressPercent(int value) {
+
+        progressPercent_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * progress_percent is the progress of the current phase in percent
+       * </pre>
+       *
+       * <code>int32 progress_percent = 4;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearProgressPercent() {
+
+        progressPercent_ = 0;
+        onChanged();
+        return this;
+      }
       @java.lang.Override
       public final Builder setUnknownFields(
           final com.google.protobuf.UnknownFieldSet unknownFields) {
@@ -9387,6 +10008,27 @@ public final class Status {
      * @return The onOpen.
      */
     io.gitpod.supervisor.api.Status.PortsStatus.OnOpenAction getOnOpen();
+
+    /**
+     * <pre>
+     * protocol is the transport protocol of the served port. Only TCP ports are
+     * proxied and auto-exposed.
+     * </pre>
+     *
+     * <code>.supervisor.PortsStatus.Protocol protocol = 11;</code>
+     * @return The enum numeric value on the wire for protocol.
+     */
+    int getProtocolValue();
+    /**
+     * <pre>
+     * protocol is the transport protocol of the served port. Only TCP ports are
+     * proxied and auto-exposed.
+     * </pre>
+     *
+     * <code>.supervisor.PortsStatus.Protocol protocol = 11;</code>
+     * @return The protocol.
+     */
+    io.gitpod.supervisor.api.Status.PortsStatus.Protocol getProtocol();
   }
   /**
    * Protobuf type {@code supervisor.PortsStatus}
@@ -9401,6 +10043,7 @@ public final class Status {
       super(builder);
     }
     private PortsStatus() {
+      protocol_ = 0;
       autoExposure_ = 0;
       description_ = "";
       name_ = "";
@@ -9497,6 +10140,12 @@ public final class Status {
               onOpen_ = rawValue;
               break;
             }
+            case 88: {
+              int rawValue = input.readEnum();
+
+              protocol_ = rawValue;
+              break;
+            }
             default: {
               if (!parseUnknownField(
                   input, unknownFields, extensionRegistry, tag)) {
@@ -9666,83 +10315,191 @@ public final class Status {
       // @@protoc_insertion_point(enum_scope:supervisor.PortsStatus.OnOpenAction)
     }
 
-    public static final int LOCAL_PORT_FIELD_NUMBER = 1;
-    private int localPort_;
     /**
-     * <pre>
-     * local_port is the port a service actually bound to. Some services bind
-     * to localhost:&lt;port&gt;, in which case they cannot be made accessible from
-     * outside the container. To help with this, supervisor then starts a proxy
-     * that forwards traffic to this local port. In those cases, global_port
-     * contains the port where the proxy is listening on.
-     * </pre>
-     *
-     * <code>uint32 local_port = 1;</code>
-     * @return The localPort.
+     * Protobuf enum {@code supervisor.PortsStatus.Protocol}
      */
-    @java.lang.Override
-    public int getLocalPort() {
-      return localPort_;
-    }
+    public enum Protocol
+        implements com.google.protobuf.ProtocolMessageEnum {
+      /**
+       * <code>tcp = 0;</code>
+       */
+      tcp(0),
+      /**
+       * <code>udp = 1;</code>
+       */
+      udp(1),
+      UNRECOGNIZED(-1),
+      ;
 
-    public static final int SERVED_FIELD_NUMBER = 4;
-    private boolean served_;
-    /**
-     * <pre>
-     * served is true if there is a process in the workspace that serves this port.
-     * </pre>
-     *
-     * <code>bool served = 4;</code>
-     * @return The served.
-     */
-    @java.lang.Override
-    public boolean getServed() {
-      return served_;
-    }
+      /**
+       * <code>tcp = 0;</code>
+       */
+      public static final int tcp_VALUE = 0;
+      /**
+       * <code>udp = 1;</code>
+       */
+      public static final int udp_VALUE = 1;
 
-    public static final int EXPOSED_FIELD_NUMBER = 5;
-    private io.gitpod.supervisor.api.Status.ExposedPortInfo exposed_;
-    /**
-     * <pre>
-     * Exposed provides information when a port is exposed. If this field isn't set,
-     * the port is not available from outside the workspace (i.e. the internet).
-     * </pre>
-     *
-     * <code>.supervisor.ExposedPortInfo exposed = 5;</code>
-     * @return Whether the exposed field is set.
-     */
-    @java.lang.Override
-    public boolean hasExposed() {
-      return exposed_ != null;
-    }
-    /**
-     * <pre>
-     * Exposed provides information when a port is exposed. If this field isn't set,
-     * the port is not available from outside the workspace (i.e. the internet).
-     * </pre>
-     *
-     * <code>.supervisor.ExposedPortInfo exposed = 5;</code>
-     * @return The exposed.
-     */
-    @java.lang.Override
-    public io.gitpod.supervisor.api.Status.ExposedPortInfo getExposed() {
-      return exposed_ == null ? io.gitpod.supervisor.api.Status.ExposedPortInfo.getDefaultInstance() : exposed_;
-    }
-    /**
-     * <pre>
-     * Exposed provides information when a port is exposed. If this field isn't set,
-     * the port is not available from outside the workspace (i.e. the internet).
-     * </pre>
-     *
-     * <code>.supervisor.ExposedPortInfo exposed = 5;</code>
-     */
-    @java.lang.Override
-    public io.gitpod.supervisor.api.Status.ExposedPortInfoOrBuilder getExposedOrBuilder() {
-      return getExposed();
-    }
 
-    public static final int AUTO_EXPOSURE_FIELD_NUMBER = 7;
-    private int autoExposure_;
+      public final int getNumber() {
+        if (this == UNRECOGNIZED) {
+          throw new java.lang.IllegalArgumentException(
+              "Can't get the number of an unknown enum value.");
+        }
+        return value;
+      }
+
+      /**
+       * @param value The numeric wire value of the corresponding enum entry.
+       * @return The enum associated with the given numeric wire value.
+       * @deprecated Use {@link #forNumber(int)} instead.
+       */
+      @java.lang.Deprecated
+      public static Protocol valueOf(int value) {
+        return forNumber(value);
+      }
+
+      /**
+       * @param value The numeric wire value of the corresponding enum entry.
+       * @return The enum associated with the given numeric wire value.
+       */
+      public static Protocol forNumber(int value) {
+        switch (value) {
+          case 0: return tcp;
+          case 1: return udp;
+          default: return null;
+        }
+      }
+
+      public static com.google.protobuf.Internal.EnumLiteMap<Protocol>
+          internalGetValueMap() {
+        return internalValueMap;
+      }
+      private static final com.google.protobuf.Internal.EnumLiteMap<
+          Protocol> internalValueMap =
+            new com.google.protobuf.Internal.EnumLiteMap<Protocol>() {
+              public Protocol findValueByNumber(int number) {
+                return Protocol.forNumber(number);
+              }
+            };
+
+      public final com.google.protobuf.Descriptors.EnumValueDescriptor
+          getValueDescriptor() {
+        if (this == UNRECOGNIZED) {
+          throw new java.lang.IllegalStateException(
+              "Can't get the descriptor of an unrecognized enum value.");
+        }
+        return getDescriptor().getValues().get(ordinal());
+      }
+      public final com.google.protobuf.Descriptors.EnumDescriptor
+          getDescriptorForType() {
+        return getDescriptor();
+      }
+      public static final com.google.protobuf.Descriptors.EnumDescriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Status.PortsStatus.getDescriptor().getEnumTypes().get(1);
+      }
+
+      private static final Protocol[] VALUES = values();
+
+      public static Protocol valueOf(
+          com.google.protobuf.Descriptors.EnumValueDescriptor desc) {
+        if (desc.getType() != getDescriptor()) {
+          throw new java.lang.IllegalArgumentException(
+            "EnumValueDescriptor is not for this type.");
+        }
+        if (desc.getIndex() == -1) {
+          return UNRECOGNIZED;
+        }
+        return VALUES[desc.getIndex()];
+      }
+
+      private final int value;
+
+      private Protocol(int value) {
+        this.value = value;
+      }
+
+      // @@protoc_insertion_point(enum_scope:supervisor.PortsStatus.Protocol)
+    }
+
+    public static final int LOCAL_PORT_FIELD_NUMBER = 1;
+    private int localPort_;
+    /**
+     * <pre>
+     * local_port is the port a service actually bound to. Some services bind
+     * to localhost:&lt;port&gt;, in which case they cannot be made accessible from
+     * outside the container. To help with this, supervisor then starts a proxy
+     * that forwards traffic to this local port. In those cases, global_port
+     * contains the port where the proxy is listening on.
+     * </pre>
+     *
+     * <code>uint32 local_port = 1;</code>
+     * @return The localPort.
+     */
+    @java.lang.Override
+    public int getLocalPort() {
+      return localPort_;
+    }
+
+    public static final int SERVED_FIELD_NUMBER = 4;
+    private boolean served_;
+    /**
+     * <pre>
+     * served is true if there is a process in the workspace that serves this port.
+     * </pre>
+     *
+     * <code>bool served = 4;</code>
+     * @return The served.
+     */
+    @java.lang.Override
+    public boolean getServed() {
+      return served_;
+    }
+
+    public static final int EXPOSED_FIELD_NUMBER = 5;
+    private io.gitpod.supervisor.api.Status.ExposedPortInfo exposed_;
+    /**
+     * <pre>
+     * Exposed provides information when a port is exposed. If this field isn't set,
+     * the port is not available from outside the workspace (i.e. the internet).
+     * </pre>
+     *
+     * <code>.supervisor.ExposedPortInfo exposed = 5;</code>
+     * @return Whether the exposed field is set.
+     */
+    @java.lang.Override
+    public boolean hasExposed() {
+      return exposed_ != null;
+    }
+    /**
+     * <pre>
+     * Exposed provides information when a port is exposed. If this field isn't set,
+     * the port is not available from outside the workspace (i.e. the internet).
+     * </pre>
+     *
+     * <code>.supervisor.ExposedPortInfo exposed = 5;</code>
+     * @return The exposed.
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.ExposedPortInfo getExposed() {
+      return exposed_ == null ? io.gitpod.supervisor.api.Status.ExposedPortInfo.getDefaultInstance() : exposed_;
+    }
+    /**
+     * <pre>
+     * Exposed provides information when a port is exposed. If this field isn't set,
+     * the port is not available from outside the workspace (i.e. the internet).
+     * </pre>
+     *
+     * <code>.supervisor.ExposedPortInfo exposed = 5;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.ExposedPortInfoOrBuilder getExposedOrBuilder() {
+      return getExposed();
+    }
+
+    public static final int AUTO_EXPOSURE_FIELD_NUMBER = 7;
+    private int autoExposure_;
     /**
      * <pre>
      * AutoExposure indicates the state of auto exposure
@@ -9928,6 +10685,35 @@ public final class Status {
       return result == null ? io.gitpod.supervisor.api.Status.PortsStatus.OnOpenAction.UNRECOGNIZED : result;
     }
 
+    public static final int PROTOCOL_FIELD_NUMBER = 11;
+    private int protocol_;
+    /**
+     * <pre>
+     * protocol is the transport protocol of the served port. Only TCP ports are
+     * proxied and auto-exposed.
+     * </pre>
+     *
+     * <code>.supervisor.PortsStatus.Protocol protocol = 11;</code>
+     * @return The enum numeric value on the wire for protocol.
+     */
+    @java.lang.Override public int getProtocolValue() {
+      return protocol_;
+    }
+    /**
+     * <pre>
+     * protocol is the transport protocol of the served port. Only TCP ports are
+     * proxied and auto-exposed.
+     * </pre>
+     *
+     * <code>.supervisor.PortsStatus.Protocol protocol = 11;</code>
+     * @return The protocol.
+     */
+    @java.lang.Override public io.gitpod.supervisor.api.Status.PortsStatus.Protocol getProtocol() {
+      @SuppressWarnings("deprecation")
+      io.gitpod.supervisor.api.Status.PortsStatus.Protocol result = io.gitpod.supervisor.api.Status.PortsStatus.Protocol.valueOf(protocol_);
+      return result == null ? io.gitpod.supervisor.api.Status.PortsStatus.Protocol.UNRECOGNIZED : result;
+    }
+
     private byte memoizedIsInitialized = -1;
     @java.lang.Override
     public final boolean isInitialized() {
@@ -9966,6 +10752,9 @@ public final class Status {
       if (onOpen_ != io.gitpod.supervisor.api.Status.PortsStatus.OnOpenAction.ignore.getNumber()) {
         output.writeEnum(10, onOpen_);
       }
+      if (protocol_ != io.gitpod.supervisor.api.Status.PortsStatus.Protocol.tcp.getNumber()) {
+        output.writeEnum(11, protocol_);
+      }
       unknownFields.writeTo(output);
     }
 
@@ -10005,6 +10794,10 @@ public final class Status {
         size += com.google.protobuf.CodedOutputStream
           .computeEnumSize(10, onOpen_);
       }
+      if (protocol_ != io.gitpod.supervisor.api.Status.PortsStatus.Protocol.tcp.getNumber()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeEnumSize(11, protocol_);
+      }
       size += unknownFields.getSerializedSize();
       memoizedSize = size;
       return size;
@@ -10040,6 +10833,7 @@ public final class Status {
       if (!getName()
           .equals(other.getName())) return false;
       if (onOpen_ != other.onOpen_) return false;
+      if (protocol_ != other.protocol_) return false;
       if (!unknownFields.equals(other.unknownFields)) return false;
       return true;
     }
@@ -10072,6 +10866,8 @@ public final class Status {
       hash = (53 * hash) + getName().hashCode();
       hash = (37 * hash) + ON_OPEN_FIELD_NUMBER;
       hash = (53 * hash) + onOpen_;
+      hash = (37 * hash) + PROTOCOL_FIELD_NUMBER;
+      hash = (53 * hash) + protocol_;
       hash = (29 * hash) + unknownFields.hashCode();
       memoizedHashCode = hash;
       return hash;
@@ -10229,6 +11025,8 @@ public final class Status {
 
         onOpen_ = 0;
 
+        protocol_ = 0;
+
         return this;
       }
 
@@ -10271,6 +11069,7 @@ public final class Status {
         result.description_ = description_;
         result.name_ = name_;
         result.onOpen_ = onOpen_;
+        result.protocol_ = protocol_;
         onBuilt();
         return result;
       }
@@ -10345,6 +11144,9 @@ public final class Status {
         if (other.onOpen_ != 0) {
           setOnOpenValue(other.getOnOpenValue());
         }
+        if (other.protocol_ != 0) {
+          setProtocolValue(other.getProtocolValue());
+        }
         this.mergeUnknownFields(other.unknownFields);
         onChanged();
         return this;
@@ -11139,6 +11941,85 @@ public final class Status {
         onChanged();
         return this;
       }
+
+      private int protocol_ = 0;
+      /**
+       * <pre>
+       * protocol is the transport protocol of the served port. Only TCP ports are
+       * proxied and auto-exposed.
+       * </pre>
+       *
+       * <code>.supervisor.PortsStatus.Protocol protocol = 11;</code>
+       * @return The enum numeric value on the wire for protocol.
+       */
+      @java.lang.Override public int getProtocolValue() {
+        return protocol_;
+      }
+      /**
+       * <pre>
+       * protocol is the transport protocol of the served port. Only TCP ports are
+       * proxied and auto-exposed.
+       * </pre>
+       *
+       * <code>.supervisor.PortsStatus.Protocol protocol = 11;</code>
+       * @param value The enum numeric value on the wire for protocol to set.
+       * @return This builder for chaining.
+       */
+      public Builder setProtocolValue(int value) {
+
+        protocol_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * protocol is the transport protocol of the served port. Only TCP ports are
+       * proxied and auto-exposed.
+       * </pre>
+       *
+       * <code>.supervisor.PortsStatus.Protocol protocol = 11;</code>
+       * @return The protocol.
+       */
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.PortsStatus.Protocol getProtocol() {
+        @SuppressWarnings("deprecation")
+        io.gitpod.supervisor.api.Status.PortsStatus.Protocol result = io.gitpod.supervisor.api.Status.PortsStatus.Protocol.valueOf(protocol_);
+        return result == null ? io.gitpod.supervisor.api.Status.PortsStatus.Protocol.UNRECOGNIZED : result;
+      }
+      /**
+       * <pre>
+       * protocol is the transport protocol of the served port. Only TCP ports are
+       * proxied and auto-exposed.
+       * </pre>
+       *
+       * <code>.supervisor.PortsStatus.Protocol protocol = 11;</code>
+       * @param value The protocol to set.
+       * @return This builder for chaining.
+       */
+      public Builder setProtocol(io.gitpod.supervisor.api.Status.PortsStatus.Protocol value) {
+        if (value == null) {
+          throw new NullPointerException();
+        }
+
+        protocol_ = value.getNumber();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * protocol is the transport protocol of the served port. Only TCP ports are
+       * proxied and auto-exposed.
+       * </pre>
+       *
+       * <code>.supervisor.PortsStatus.Protocol protocol = 11;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearProtocol() {
+
+        protocol_ = 0;
+        onChanged();
+        return this;
+      }
       @java.lang.Override
       public final Builder setUnknownFields(
           final com.google.protobuf.UnknownFieldSet unknownFields) {
@@ -12556,47 +13437,128 @@ public final class Status {
      * <code>.supervisor.TaskPresentation presentation = 4;</code>
      */
     io.gitpod.supervisor.api.Status.TaskPresentationOrBuilder getPresentationOrBuilder();
-  }
-  /**
-   * Protobuf type {@code supervisor.TaskStatus}
-   */
-  public static final class TaskStatus extends
-      com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:supervisor.TaskStatus)
-      TaskStatusOrBuilder {
-  private static final long serialVersionUID = 0L;
-    // Use TaskStatus.newBuilder() to construct.
-    private TaskStatus(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
-      super(builder);
-    }
-    private TaskStatus() {
-      id_ = "";
-      state_ = 0;
-      terminal_ = "";
-    }
 
-    @java.lang.Override
-    @SuppressWarnings({"unused"})
-    protected java.lang.Object newInstance(
-        UnusedPrivateParameter unused) {
-      return new TaskStatus();
-    }
+    /**
+     * <pre>
+     * ready is true once the task's readiness condition is met. Tasks which depend on this task
+     * are started only once it is ready.
+     * </pre>
+     *
+     * <code>bool ready = 5;</code>
+     * @return The ready.
+     */
+    boolean getReady();
 
-    @java.lang.Override
-    public final com.google.protobuf.UnknownFieldSet
-    getUnknownFields() {
-      return this.unknownFields;
-    }
-    private TaskStatus(
-        com.google.protobuf.CodedInputStream input,
-        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-        throws com.google.protobuf.InvalidProtocolBufferException {
-      this();
-      if (extensionRegistry == null) {
-        throw new java.lang.NullPointerException();
-      }
-      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
-          com.google.protobuf.UnknownFieldSet.newBuilder();
+    /**
+     * <pre>
+     * restart_count is the number of times the task was restarted according to its restart policy
+     * </pre>
+     *
+     * <code>int32 restart_count = 6;</code>
+     * @return The restartCount.
+     */
+    int getRestartCount();
+
+    /**
+     * <pre>
+     * last_exit_code is the exit code of the last run of the task, -1 if it was terminated by a signal
+     * </pre>
+     *
+     * <code>int32 last_exit_code = 7;</code>
+     * @return The lastExitCode.
+     */
+    int getLastExitCode();
+
+    /**
+     * <pre>
+     * last_restart_time is the time the task was last restarted
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp last_restart_time = 8;</code>
+     * @return Whether the lastRestartTime field is set.
+     */
+    boolean hasLastRestartTime();
+    /**
+     * <pre>
+     * last_restart_time is the time the task was last restarted
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp last_restart_time = 8;</code>
+     * @return The lastRestartTime.
+     */
+    com.google.protobuf.Timestamp getLastRestartTime();
+    /**
+     * <pre>
+     * last_restart_time is the time the task was last restarted
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp last_restart_time = 8;</code>
+     */
+    com.google.protobuf.TimestampOrBuilder getLastRestartTimeOrBuilder();
+
+    /**
+     * <pre>
+     * recording is the path of the asciicast recording of the task's terminal, empty if the task is not recorded.
+     * Older parts of the recording are rotated to &lt;recording&gt;.1, &lt;recording&gt;.2 and so on.
+     * </pre>
+     *
+     * <code>string recording = 9;</code>
+     * @return The recording.
+     */
+    java.lang.String getRecording();
+    /**
+     * <pre>
+     * recording is the path of the asciicast recording of the task's terminal, empty if the task is not recorded.
+     * Older parts of the recording are rotated to &lt;recording&gt;.1, &lt;recording&gt;.2 and so on.
+     * </pre>
+     *
+     * <code>string recording = 9;</code>
+     * @return The bytes for recording.
+     */
+    com.google.protobuf.ByteString
+        getRecordingBytes();
+  }
+  /**
+   * Protobuf type {@code supervisor.TaskStatus}
+   */
+  public static final class TaskStatus extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.TaskStatus)
+      TaskStatusOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use TaskStatus.newBuilder() to construct.
+    private TaskStatus(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private TaskStatus() {
+      recording_ = "";
+      id_ = "";
+      state_ = 0;
+      terminal_ = "";
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new TaskStatus();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private TaskStatus(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
       try {
         boolean done = false;
         while (!done) {
@@ -12636,6 +13598,40 @@ public final class Status {
 
               break;
             }
+            case 40: {
+
+              ready_ = input.readBool();
+              break;
+            }
+            case 48: {
+
+              restartCount_ = input.readInt32();
+              break;
+            }
+            case 56: {
+
+              lastExitCode_ = input.readInt32();
+              break;
+            }
+            case 66: {
+              com.google.protobuf.Timestamp.Builder subBuilder = null;
+              if (lastRestartTime_ != null) {
+                subBuilder = lastRestartTime_.toBuilder();
+              }
+              lastRestartTime_ = input.readMessage(com.google.protobuf.Timestamp.parser(), extensionRegistry);
+              if (subBuilder != null) {
+                subBuilder.mergeFrom(lastRestartTime_);
+                lastRestartTime_ = subBuilder.buildPartial();
+              }
+
+              break;
+            }
+            case 74: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              recording_ = s;
+              break;
+            }
             default: {
               if (!parseUnknownField(
                   input, unknownFields, extensionRegistry, tag)) {
@@ -12791,6 +13787,138 @@ public final class Status {
       return getPresentation();
     }
 
+    public static final int READY_FIELD_NUMBER = 5;
+    private boolean ready_;
+    /**
+     * <pre>
+     * ready is true once the task's readiness condition is met. Tasks which depend on this task
+     * are started only once it is ready.
+     * </pre>
+     *
+     * <code>bool ready = 5;</code>
+     * @return The ready.
+     */
+    @java.lang.Override
+    public boolean getReady() {
+      return ready_;
+    }
+
+    public static final int RESTART_COUNT_FIELD_NUMBER = 6;
+    private int restartCount_;
+    /**
+     * <pre>
+     * restart_count is the number of times the task was restarted according to its restart policy
+     * </pre>
+     *
+     * <code>int32 restart_count = 6;</code>
+     * @return The restartCount.
+     */
+    @java.lang.Override
+    public int getRestartCount() {
+      return restartCount_;
+    }
+
+    public static final int LAST_EXIT_CODE_FIELD_NUMBER = 7;
+    private int lastExitCode_;
+    /**
+     * <pre>
+     * last_exit_code is the exit code of the last run of the task, -1 if it was terminated by a signal
+     * </pre>
+     *
+     * <code>int32 last_exit_code = 7;</code>
+     * @return The lastExitCode.
+     */
+    @java.lang.Override
+    public int getLastExitCode() {
+      return lastExitCode_;
+    }
+
+    public static final int LAST_RESTART_TIME_FIELD_NUMBER = 8;
+    private com.google.protobuf.Timestamp lastRestartTime_;
+    /**
+     * <pre>
+     * last_restart_time is the time the task was last restarted
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp last_restart_time = 8;</code>
+     * @return Whether the lastRestartTime field is set.
+     */
+    @java.lang.Override
+    public boolean hasLastRestartTime() {
+      return lastRestartTime_ != null;
+    }
+    /**
+     * <pre>
+     * last_restart_time is the time the task was last restarted
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp last_restart_time = 8;</code>
+     * @return The lastRestartTime.
+     */
+    @java.lang.Override
+    public com.google.protobuf.Timestamp getLastRestartTime() {
+      return lastRestartTime_ == null ? com.google.protobuf.Timestamp.getDefaultInstance() : lastRestartTime_;
+    }
+    /**
+     * <pre>
+     * last_restart_time is the time the task was last restarted
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp last_restart_time = 8;</code>
+     */
+    @java.lang.Override
+    public com.google.protobuf.TimestampOrBuilder getLastRestartTimeOrBuilder() {
+      return getLastRestartTime();
+    }
+
+    public static final int RECORDING_FIELD_NUMBER = 9;
+    private volatile java.lang.Object recording_;
+    /**
+     * <pre>
+     * recording is the path of the asciicast recording of the task's terminal, empty if the task is not recorded.
+     * Older parts of the recording are rotated to &lt;recording&gt;.1, &lt;recording&gt;.2 and so on.
+     * </pre>
+     *
+     * <code>string recording = 9;</code>
+     * @return The recording.
+     */
+    @java.lang.Override
+    public java.lang.String getRecording() {
+      java.lang.Object ref = recording_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        recording_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * recording is the path of the asciicast recording of the task's terminal, empty if the task is not recorded.
+     * Older parts of the recording are rotated to &lt;recording&gt;.1, &lt;recording&gt;.2 and so on.
+     * </pre>
+     *
+     * <code>string recording = 9;</code>
+     * @return The bytes for recording.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getRecordingBytes() {
+      java.lang.Object ref = recording_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        recording_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
     private byte memoizedIsInitialized = -1;
     @java.lang.Override
     public final boolean isInitialized() {
@@ -12817,6 +13945,21 @@ public final class Status {
       if (presentation_ != null) {
         output.writeMessage(4, getPresentation());
       }
+      if (ready_ != false) {
+        output.writeBool(5, ready_);
+      }
+      if (restartCount_ != 0) {
+        output.writeInt32(6, restartCount_);
+      }
+      if (lastExitCode_ != 0) {
+        output.writeInt32(7, lastExitCode_);
+      }
+      if (lastRestartTime_ != null) {
+        output.writeMessage(8, getLastRestartTime());
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(recording_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 9, recording_);
+      }
       unknownFields.writeTo(output);
     }
 
@@ -12840,6 +13983,25 @@ public final class Status {
         size += com.google.protobuf.CodedOutputStream
           .computeMessageSize(4, getPresentation());
       }
+      if (ready_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(5, ready_);
+      }
+      if (restartCount_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(6, restartCount_);
+      }
+      if (lastExitCode_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(7, lastExitCode_);
+      }
+      if (lastRestartTime_ != null) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(8, getLastRestartTime());
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(recording_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(9, recording_);
+      }
       size += unknownFields.getSerializedSize();
       memoizedSize = size;
       return size;
@@ -12865,6 +14027,19 @@ public final class Status {
         if (!getPresentation()
             .equals(other.getPresentation())) return false;
       }
+      if (getReady()
+          != other.getReady()) return false;
+      if (getRestartCount()
+          != other.getRestartCount()) return false;
+      if (getLastExitCode()
+          != other.getLastExitCode()) return false;
+      if (hasLastRestartTime() != other.hasLastRestartTime()) return false;
+      if (hasLastRestartTime()) {
+        if (!getLastRestartTime()
+            .equals(other.getLastRestartTime())) return false;
+      }
+      if (!getRecording()
+          .equals(other.getRecording())) return false;
       if (!unknownFields.equals(other.unknownFields)) return false;
       return true;
     }
@@ -12886,6 +14061,19 @@ public final class Status {
         hash = (37 * hash) + PRESENTATION_FIELD_NUMBER;
         hash = (53 * hash) + getPresentation().hashCode();
       }
+      hash = (37 * hash) + READY_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getReady());
+      hash = (37 * hash) + RESTART_COUNT_FIELD_NUMBER;
+      hash = (53 * hash) + getRestartCount();
+      hash = (37 * hash) + LAST_EXIT_CODE_FIELD_NUMBER;
+      hash = (53 * hash) + getLastExitCode();
+      if (hasLastRestartTime()) {
+        hash = (37 * hash) + LAST_RESTART_TIME_FIELD_NUMBER;
+        hash = (53 * hash) + getLastRestartTime().hashCode();
+      }
+      hash = (37 * hash) + RECORDING_FIELD_NUMBER;
+      hash = (53 * hash) + getRecording().hashCode();
       hash = (29 * hash) + unknownFields.hashCode();
       memoizedHashCode = hash;
       return hash;
@@ -13031,6 +14219,20 @@ public final class Status {
           presentation_ = null;
           presentationBuilder_ = null;
         }
+        ready_ = false;
+
+        restartCount_ = 0;
+
+        lastExitCode_ = 0;
+
+        if (lastRestartTimeBuilder_ == null) {
+          lastRestartTime_ = null;
+        } else {
+          lastRestartTime_ = null;
+          lastRestartTimeBuilder_ = null;
+        }
+        recording_ = "";
+
         return this;
       }
 
@@ -13065,6 +14267,15 @@ public final class Status {
         } else {
           result.presentation_ = presentationBuilder_.build();
         }
+        result.ready_ = ready_;
+        result.restartCount_ = restartCount_;
+        result.lastExitCode_ = lastExitCode_;
+        if (lastRestartTimeBuilder_ == null) {
+          result.lastRestartTime_ = lastRestartTime_;
+        } else {
+          result.lastRestartTime_ = lastRestartTimeBuilder_.build();
+        }
+        result.recording_ = recording_;
         onBuilt();
         return result;
       }
@@ -13127,6 +14338,22 @@ public final class Status {
         if (other.hasPresentation()) {
           mergePresentation(other.getPresentation());
         }
+        if (other.getReady() != false) {
+          setReady(other.getReady());
+        }
+        if (other.getRestartCount() != 0) {
+          setRestartCount(other.getRestartCount());
+        }
+        if (other.getLastExitCode() != 0) {
+          setLastExitCode(other.getLastExitCode());
+        }
+        if (other.hasLastRestartTime()) {
+          mergeLastRestartTime(other.getLastRestartTime());
+        }
+        if (!other.getRecording().isEmpty()) {
+          recording_ = other.recording_;
+          onChanged();
+        }
         this.mergeUnknownFields(other.unknownFields);
         onChanged();
         return this;
@@ -13480,24 +14707,412 @@ public final class Status {
         }
         return presentationBuilder_;
       }
+
+      private boolean ready_ ;
+      /**
+       * <pre>
+       * ready is true once the task's readiness condition is met. Tasks which depend on this task
+       * are started only once it is ready.
+       * </pre>
+       *
+       * <code>bool ready = 5;</code>
+       * @return The ready.
+       */
       @java.lang.Override
-      public final Builder setUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.setUnknownFields(unknownFields);
+      public boolean getReady() {
+        return ready_;
       }
+      /**
+       * <pre>
+       * ready is true once the task's readiness condition is met. Tasks which depend on this task
+       * are started only once it is ready.
+       * </pre>
+       *
+       * <code>bool ready = 5;</code>
+       * @param value The ready to set.
+       * @return This builder for chaining.
+       */
+      public Builder setReady(boolean value) {
 
-      @java.lang.Override
-      public final Builder mergeUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.mergeUnknownFields(unknownFields);
+        ready_ = value;
+        onChanged();
+        return this;
       }
+      /**
+       * <pre>
+       * ready is true once the task's readiness condition is met. Tasks which depend on this task
+       * are started only once it is ready.
+       * </pre>
+       *
+       * <code>bool ready = 5;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearReady() {
 
+        ready_ = false;
+        onChanged();
+        return this;
+      }
 
-      // @@protoc_insertion_point(builder_scope:supervisor.TaskStatus)
-    }
+      private int restartCount_ ;
+      /**
+       * <pre>
+       * restart_count is the number of times the task was restarted according to its restart policy
+       * </pre>
+       *
+       * <code>int32 restart_count = 6;</code>
+       * @return The restartCount.
+       */
+      @java.lang.Override
+      public int getRestartCount() {
+        return restartCount_;
+      }
+      /**
+       * <pre>
+       * restart_count is the number of times the task was restarted according to its restart policy
+       * </pre>
+       *
+       * <code>int32 restart_count = 6;</code>
+       * @param value The restartCount to set.
+       * @return This builder for chaining.
+       */
+      public Builder setRestartCount(int value) {
 
-    // @@protoc_insertion_point(class_scope:supervisor.TaskStatus)
-    private static final io.gitpod.supervisor.api.Status.TaskStatus DEFAULT_INSTANCE;
+        restartCount_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * restart_count is the number of times the task was restarted according to its restart policy
+       * </pre>
+       *
+       * <code>int32 restart_count = 6;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearRestartCount() {
+
+        restartCount_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private int lastExitCode_ ;
+      /**
+       * <pre>
+       * last_exit_code is the exit code of the last run of the task, -1 if it was terminated by a signal
+       * </pre>
+       *
+       * <code>int32 last_exit_code = 7;</code>
+       * @return The lastExitCode.
+       */
+      @java.lang.Override
+      public int getLastExitCode() {
+        return lastExitCode_;
+      }
+      /**
+       * <pre>
+       * last_exit_code is the exit code of the last run of the task, -1 if it was terminated by a signal
+       * </pre>
+       *
+       * <code>int32 last_exit_code = 7;</code>
+       * @param value The lastExitCode to set.
+       * @return This builder for chaining.
+       */
+      public Builder setLastExitCode(int value) {
+
+        lastExitCode_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * last_exit_code is the exit code of the last run of the task, -1 if it was terminated by a signal
+       * </pre>
+       *
+       * <code>int32 last_exit_code = 7;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearLastExitCode() {
+
+        lastExitCode_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.Timestamp lastRestartTime_;
+      private com.google.protobuf.SingleFieldBuilderV3<
+          com.google.protobuf.Timestamp, com.google.protobuf.Timestamp.Builder, com.google.protobuf.TimestampOrBuilder> lastRestartTimeBuilder_;
+      /**
+       * <pre>
+       * last_restart_time is the time the task was last restarted
+       * </pre>
+       *
+       * <code>.google.protobuf.Timestamp last_restart_time = 8;</code>
+       * @return Whether the lastRestartTime field is set.
+       */
+      public boolean hasLastRestartTime() {
+        return lastRestartTimeBuilder_ != null || lastRestartTime_ != null;
+      }
+      /**
+       * <pre>
+       * last_restart_time is the time the task was last restarted
+       * </pre>
+       *
+       * <code>.google.protobuf.Timestamp last_restart_time = 8;</code>
+       * @return The lastRestartTime.
+       */
+      public com.google.protobuf.Timestamp getLastRestartTime() {
+        if (lastRestartTimeBuilder_ == null) {
+          return lastRestartTime_ == null ? com.google.protobuf.Timestamp.getDefaultInstance() : lastRestartTime_;
+        } else {
+          return lastRestartTimeBuilder_.getMessage();
+        }
+      }
+      /**
+       * <pre>
+       * last_restart_time is the time the task was last restarted
+       * </pre>
+       *
+       * <code>.google.protobuf.Timestamp last_restart_time = 8;</code>
+       */
+      public Builder setLastRestartTime(com.google.protobuf.Timestamp value) {
+        if (lastRestartTimeBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          lastRestartTime_ = value;
+          onChanged();
+        } else {
+          lastRestartTimeBuilder_.setMessage(value);
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * last_restart_time is the time the task was last restarted
+       * </pre>
+       *
+       * <code>.google.protobuf.Timestamp last_restart_time = 8;</code>
+       */
+      public Builder setLastRestartTime(
+          com.google.protobuf.Timestamp.Builder builderForValue) {
+        if (lastRestartTimeBuilder_ == null) {
+          lastRestartTime_ = builderForValue.build();
+          onChanged();
+        } else {
+          lastRestartTimeBuilder_.setMessage(builderForValue.build());
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * last_restart_time is the time the task was last restarted
+       * </pre>
+       *
+       * <code>.google.protobuf.Timestamp last_restart_time = 8;</code>
+       */
+      public Builder mergeLastRestartTime(com.google.protobuf.Timestamp value) {
+        if (lastRestartTimeBuilder_ == null) {
+          if (lastRestartTime_ != null) {
+            lastRestartTime_ =
+              com.google.protobuf.Timestamp.newBuilder(lastRestartTime_).mergeFrom(value).buildPartial();
+          } else {
+            lastRestartTime_ = value;
+          }
+          onChanged();
+        } else {
+          lastRestartTimeBuilder_.mergeFrom(value);
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * last_restart_time is the time the task was last restarted
+       * </pre>
+       *
+       * <code>.google.protobuf.Timestamp last_restart_time = 8;</code>
+       */
+      public Builder clearLastRestartTime() {
+        if (lastRestartTimeBuilder_ == null) {
+          lastRestartTime_ = null;
+          onChanged();
+        } else {
+          lastRestartTime_ = null;
+          lastRestartTimeBuilder_ = null;
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * last_restart_time is the time the task was last restarted
+       * </pre>
+       *
+       * <code>.google.protobuf.Timestamp last_restart_time = 8;</code>
+       */
+      public com.google.protobuf.Timestamp.Builder getLastRestartTimeBuilder() {
+
+        onChanged();
+        return getLastRestartTimeFieldBuilder().getBuilder();
+      }
+      /**
+       * <pre>
+       * last_restart_time is the time the task was last restarted
+       * </pre>
+       *
+       * <code>.google.protobuf.Timestamp last_restart_time = 8;</code>
+       */
+      public com.google.protobuf.TimestampOrBuilder getLastRestartTimeOrBuilder() {
+        if (lastRestartTimeBuilder_ != null) {
+          return lastRestartTimeBuilder_.getMessageOrBuilder();
+        } else {
+          return lastRestartTime_ == null ?
+              com.google.protobuf.Timestamp.getDefaultInstance() : lastRestartTime_;
+        }
+      }
+      /**
+       * <pre>
+       * last_restart_time is the time the task was last restarted
+       * </pre>
+       *
+       * <code>.google.protobuf.Timestamp last_restart_time = 8;</code>
+       */
+      private com.google.protobuf.SingleFieldBuilderV3<
+          com.google.protobuf.Timestamp, com.google.protobuf.Timestamp.Builder, com.google.protobuf.TimestampOrBuilder>
+          getLastRestartTimeFieldBuilder() {
+        if (lastRestartTimeBuilder_ == null) {
+          lastRestartTimeBuilder_ = new com.google.protobuf.SingleFieldBuilderV3<
+              com.google.protobuf.Timestamp, com.google.protobuf.Timestamp.Builder, com.google.protobuf.TimestampOrBuilder>(
+                  getLastRestartTime(),
+                  getParentForChildren(),
+                  isClean());
+          lastRestartTime_ = null;
+        }
+        return lastRestartTimeBuilder_;
+      }
+
+      private java.lang.Object recording_ = "";
+      /**
+       * <pre>
+       * recording is the path of the asciicast recording of the task's terminal, empty if the task is not recorded.
+       * Older parts of the recording are rotated to &lt;recording&gt;.1, &lt;recording&gt;.2 and so on.
+       * </pre>
+       *
+       * <code>string recording = 9;</code>
+       * @return The recording.
+       */
+      public java.lang.String getRecording() {
+        java.lang.Object ref = recording_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          recording_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * recording is the path of the asciicast recording of the task's terminal, empty if the task is not recorded.
+       * Older parts of the recording are rotated to &lt;recording&gt;.1, &lt;recording&gt;.2 and so on.
+       * </pre>
+       *
+       * <code>string recording = 9;</code>
+       * @return The bytes for recording.
+       */
+      public com.google.protobuf.ByteString
+          getRecordingBytes() {
+        java.lang.Object ref = recording_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          recording_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * recording is the path of the asciicast recording of the task's terminal, empty if the task is not recorded.
+       * Older parts of the recording are rotated to &lt;recording&gt;.1, &lt;recording&gt;.2 and so on.
+       * </pre>
+       *
+       * <code>string recording = 9;</code>
+       * @param value The recording to set.
+       * @return This builder for chaining.
+       */
+      public Builder setRecording(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        recording_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * recording is the path of the asciicast recording of the task's terminal, empty if the task is not recorded.
+       * Older parts of the recording are rotated to &lt;recording&gt;.1, &lt;recording&gt;.2 and so on.
+       * </pre>
+       *
+       * <code>string recording = 9;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearRecording() {
+
+        recording_ = getDefaultInstance().getRecording();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * recording is the path of the asciicast recording of the task's terminal, empty if the task is not recorded.
+       * Older parts of the recording are rotated to &lt;recording&gt;.1, &lt;recording&gt;.2 and so on.
+       * </pre>
+       *
+       * <code>string recording = 9;</code>
+       * @param value The bytes for recording to set.
+       * @return This builder for chaining.
+       */
+      public Builder setRecordingBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        recording_ = value;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.TaskStatus)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.TaskStatus)
+    private static final io.gitpod.supervisor.api.Status.TaskStatus DEFAULT_INSTANCE;
     static {
       DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Status.TaskStatus();
     }
@@ -14880,30 +16495,156 @@ public final class Status {
      * <code>.supervisor.ResourceStatus cpu = 2;</code>
      */
     io.gitpod.supervisor.api.Status.ResourceStatusOrBuilder getCpuOrBuilder();
-  }
-  /**
-   * Protobuf type {@code supervisor.ResourcesStatusResponse}
-   */
-  public static final class ResourcesStatusResponse extends
-      com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:supervisor.ResourcesStatusResponse)
-      ResourcesStatusResponseOrBuilder {
-  private static final long serialVersionUID = 0L;
-    // Use ResourcesStatusResponse.newBuilder() to construct.
-    private ResourcesStatusResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
-      super(builder);
-    }
-    private ResourcesStatusResponse() {
-    }
 
-    @java.lang.Override
-    @SuppressWarnings({"unused"})
-    protected java.lang.Object newInstance(
-        UnusedPrivateParameter unused) {
-      return new ResourcesStatusResponse();
-    }
+    /**
+     * <pre>
+     * Used disk space of the workspace and its quota limit in bytes.
+     * </pre>
+     *
+     * <code>.supervisor.ResourceStatus disk = 3;</code>
+     * @return Whether the disk field is set.
+     */
+    boolean hasDisk();
+    /**
+     * <pre>
+     * Used disk space of the workspace and its quota limit in bytes.
+     * </pre>
+     *
+     * <code>.supervisor.ResourceStatus disk = 3;</code>
+     * @return The disk.
+     */
+    io.gitpod.supervisor.api.Status.ResourceStatus getDisk();
+    /**
+     * <pre>
+     * Used disk space of the workspace and its quota limit in bytes.
+     * </pre>
+     *
+     * <code>.supervisor.ResourceStatus disk = 3;</code>
+     */
+    io.gitpod.supervisor.api.Status.ResourceStatusOrBuilder getDiskOrBuilder();
 
-    @java.lang.Override
+    /**
+     * <pre>
+     * IO pressure stall information of the workspace.
+     * </pre>
+     *
+     * <code>.supervisor.PressureStatus io_pressure = 4;</code>
+     * @return Whether the ioPressure field is set.
+     */
+    boolean hasIoPressure();
+    /**
+     * <pre>
+     * IO pressure stall information of the workspace.
+     * </pre>
+     *
+     * <code>.supervisor.PressureStatus io_pressure = 4;</code>
+     * @return The ioPressure.
+     */
+    io.gitpod.supervisor.api.Status.PressureStatus getIoPressure();
+    /**
+     * <pre>
+     * IO pressure stall information of the workspace.
+     * </pre>
+     *
+     * <code>.supervisor.PressureStatus io_pressure = 4;</code>
+     */
+    io.gitpod.supervisor.api.Status.PressureStatusOrBuilder getIoPressureOrBuilder();
+
+    /**
+     * <pre>
+     * Network traffic of the workspace.
+     * </pre>
+     *
+     * <code>.supervisor.NetworkStatus network = 5;</code>
+     * @return Whether the network field is set.
+     */
+    boolean hasNetwork();
+    /**
+     * <pre>
+     * Network traffic of the workspace.
+     * </pre>
+     *
+     * <code>.supervisor.NetworkStatus network = 5;</code>
+     * @return The network.
+     */
+    io.gitpod.supervisor.api.Status.NetworkStatus getNetwork();
+    /**
+     * <pre>
+     * Network traffic of the workspace.
+     * </pre>
+     *
+     * <code>.supervisor.NetworkStatus network = 5;</code>
+     */
+    io.gitpod.supervisor.api.Status.NetworkStatusOrBuilder getNetworkOrBuilder();
+
+    /**
+     * <pre>
+     * Processes using the most CPU or memory, ordered by CPU usage.
+     * </pre>
+     *
+     * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+     */
+    java.util.List<io.gitpod.supervisor.api.Status.ProcessStatus>
+        getProcessesList();
+    /**
+     * <pre>
+     * Processes using the most CPU or memory, ordered by CPU usage.
+     * </pre>
+     *
+     * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+     */
+    io.gitpod.supervisor.api.Status.ProcessStatus getProcesses(int index);
+    /**
+     * <pre>
+     * Processes using the most CPU or memory, ordered by CPU usage.
+     * </pre>
+     *
+     * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+     */
+    int getProcessesCount();
+    /**
+     * <pre>
+     * Processes using the most CPU or memory, ordered by CPU usage.
+     * </pre>
+     *
+     * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+     */
+    java.util.List<? extends io.gitpod.supervisor.api.Status.ProcessStatusOrBuilder>
+        getProcessesOrBuilderList();
+    /**
+     * <pre>
+     * Processes using the most CPU or memory, ordered by CPU usage.
+     * </pre>
+     *
+     * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+     */
+    io.gitpod.supervisor.api.Status.ProcessStatusOrBuilder getProcessesOrBuilder(
+        int index);
+  }
+  /**
+   * Protobuf type {@code supervisor.ResourcesStatusResponse}
+   */
+  public static final class ResourcesStatusResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ResourcesStatusResponse)
+      ResourcesStatusResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ResourcesStatusResponse.newBuilder() to construct.
+    private ResourcesStatusResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ResourcesStatusResponse() {
+      processes_ = java.util.Collections.emptyList();
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ResourcesStatusResponse();
+    }
+
+    @java.lang.Override
     public final com.google.protobuf.UnknownFieldSet
     getUnknownFields() {
       return this.unknownFields;
@@ -14916,6 +16657,7 @@ public final class Status {
       if (extensionRegistry == null) {
         throw new java.lang.NullPointerException();
       }
+      int mutable_bitField0_ = 0;
       com.google.protobuf.UnknownFieldSet.Builder unknownFields =
           com.google.protobuf.UnknownFieldSet.newBuilder();
       try {
@@ -14952,6 +16694,54 @@ public final class Status {
 
               break;
             }
+            case 26: {
+              io.gitpod.supervisor.api.Status.ResourceStatus.Builder subBuilder = null;
+              if (disk_ != null) {
+                subBuilder = disk_.toBuilder();
+              }
+              disk_ = input.readMessage(io.gitpod.supervisor.api.Status.ResourceStatus.parser(), extensionRegistry);
+              if (subBuilder != null) {
+                subBuilder.mergeFrom(disk_);
+                disk_ = subBuilder.buildPartial();
+              }
+
+              break;
+            }
+            case 34: {
+              io.gitpod.supervisor.api.Status.PressureStatus.Builder subBuilder = null;
+              if (ioPressure_ != null) {
+                subBuilder = ioPressure_.toBuilder();
+              }
+              ioPressure_ = input.readMessage(io.gitpod.supervisor.api.Status.PressureStatus.parser(), extensionRegistry);
+              if (subBuilder != null) {
+                subBuilder.mergeFrom(ioPressure_);
+                ioPressure_ = subBuilder.buildPartial();
+              }
+
+              break;
+            }
+            case 42: {
+              io.gitpod.supervisor.api.Status.NetworkStatus.Builder subBuilder = null;
+              if (network_ != null) {
+                subBuilder = network_.toBuilder();
+              }
+              network_ = input.readMessage(io.gitpod.supervisor.api.Status.NetworkStatus.parser(), extensionRegistry);
+              if (subBuilder != null) {
+                subBuilder.mergeFrom(network_);
+                network_ = subBuilder.buildPartial();
+              }
+
+              break;
+            }
+            case 50: {
+              if (!((mutable_bitField0_ & 0x00000001) != 0)) {
+                processes_ = new java.util.ArrayList<io.gitpod.supervisor.api.Status.ProcessStatus>();
+                mutable_bitField0_ |= 0x00000001;
+              }
+              processes_.add(
+                  input.readMessage(io.gitpod.supervisor.api.Status.ProcessStatus.parser(), extensionRegistry));
+              break;
+            }
             default: {
               if (!parseUnknownField(
                   input, unknownFields, extensionRegistry, tag)) {
@@ -14969,6 +16759,9 @@ public final class Status {
         throw new com.google.protobuf.InvalidProtocolBufferException(
             e).setUnfinishedMessage(this);
       } finally {
+        if (((mutable_bitField0_ & 0x00000001) != 0)) {
+          processes_ = java.util.Collections.unmodifiableList(processes_);
+        }
         this.unknownFields = unknownFields.build();
         makeExtensionsImmutable();
       }
@@ -15062,6 +16855,180 @@ public final class Status {
       return getCpu();
     }
 
+    public static final int DISK_FIELD_NUMBER = 3;
+    private io.gitpod.supervisor.api.Status.ResourceStatus disk_;
+    /**
+     * <pre>
+     * Used disk space of the workspace and its quota limit in bytes.
+     * </pre>
+     *
+     * <code>.supervisor.ResourceStatus disk = 3;</code>
+     * @return Whether the disk field is set.
+     */
+    @java.lang.Override
+    public boolean hasDisk() {
+      return disk_ != null;
+    }
+    /**
+     * <pre>
+     * Used disk space of the workspace and its quota limit in bytes.
+     * </pre>
+     *
+     * <code>.supervisor.ResourceStatus disk = 3;</code>
+     * @return The disk.
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.ResourceStatus getDisk() {
+      return disk_ == null ? io.gitpod.supervisor.api.Status.ResourceStatus.getDefaultInstance() : disk_;
+    }
+    /**
+     * <pre>
+     * Used disk space of the workspace and its quota limit in bytes.
+     * </pre>
+     *
+     * <code>.supervisor.ResourceStatus disk = 3;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.ResourceStatusOrBuilder getDiskOrBuilder() {
+      return getDisk();
+    }
+
+    public static final int IO_PRESSURE_FIELD_NUMBER = 4;
+    private io.gitpod.supervisor.api.Status.PressureStatus ioPressure_;
+    /**
+     * <pre>
+     * IO pressure stall information of the workspace.
+     * </pre>
+     *
+     * <code>.supervisor.PressureStatus io_pressure = 4;</code>
+     * @return Whether the ioPressure field is set.
+     */
+    @java.lang.Override
+    public boolean hasIoPressure() {
+      return ioPressure_ != null;
+    }
+    /**
+     * <pre>
+     * IO pressure stall information of the workspace.
+     * </pre>
+     *
+     * <code>.supervisor.PressureStatus io_pressure = 4;</code>
+     * @return The ioPressure.
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.PressureStatus getIoPressure() {
+      return ioPressure_ == null ? io.gitpod.supervisor.api.Status.PressureStatus.getDefaultInstance() : ioPressure_;
+    }
+    /**
+     * <pre>
+     * IO pressure stall information of the workspace.
+     * </pre>
+     *
+     * <code>.supervisor.PressureStatus io_pressure = 4;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.PressureStatusOrBuilder getIoPressureOrBuilder() {
+      return getIoPressure();
+    }
+
+    public static final int NETWORK_FIELD_NUMBER = 5;
+    private io.gitpod.supervisor.api.Status.NetworkStatus network_;
+    /**
+     * <pre>
+     * Network traffic of the workspace.
+     * </pre>
+     *
+     * <code>.supervisor.NetworkStatus network = 5;</code>
+     * @return Whether the network field is set.
+     */
+    @java.lang.Override
+    public boolean hasNetwork() {
+      return network_ != null;
+    }
+    /**
+     * <pre>
+     * Network traffic of the workspace.
+     * </pre>
+     *
+     * <code>.supervisor.NetworkStatus network = 5;</code>
+     * @return The network.
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.NetworkStatus getNetwork() {
+      return network_ == null ? io.gitpod.supervisor.api.Status.NetworkStatus.getDefaultInstance() : network_;
+    }
+    /**
+     * <pre>
+     * Network traffic of the workspace.
+     * </pre>
+     *
+     * <code>.supervisor.NetworkStatus network = 5;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.NetworkStatusOrBuilder getNetworkOrBuilder() {
+      return getNetwork();
+    }
+
+    public static final int PROCESSES_FIELD_NUMBER = 6;
+    private java.util.List<io.gitpod.supervisor.api.Status.ProcessStatus> processes_;
+    /**
+     * <pre>
+     * Processes using the most CPU or memory, ordered by CPU usage.
+     * </pre>
+     *
+     * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+     */
+    @java.lang.Override
+    public java.util.List<io.gitpod.supervisor.api.Status.ProcessStatus> getProcessesList() {
+      return processes_;
+    }
+    /**
+     * <pre>
+     * Processes using the most CPU or memory, ordered by CPU usage.
+     * </pre>
+     *
+     * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+     */
+    @java.lang.Override
+    public java.util.List<? extends io.gitpod.supervisor.api.Status.ProcessStatusOrBuilder>
+        getProcessesOrBuilderList() {
+      return processes_;
+    }
+    /**
+     * <pre>
+     * Processes using the most CPU or memory, ordered by CPU usage.
+     * </pre>
+     *
+     * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+     */
+    @java.lang.Override
+    public int getProcessesCount() {
+      return processes_.size();
+    }
+    /**
+     * <pre>
+     * Processes using the most CPU or memory, ordered by CPU usage.
+     * </pre>
+     *
+     * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.ProcessStatus getProcesses(int index) {
+      return processes_.get(index);
+    }
+    /**
+     * <pre>
+     * Processes using the most CPU or memory, ordered by CPU usage.
+     * </pre>
+     *
+     * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.ProcessStatusOrBuilder getProcessesOrBuilder(
+        int index) {
+      return processes_.get(index);
+    }
+
     private byte memoizedIsInitialized = -1;
     @java.lang.Override
     public final boolean isInitialized() {
@@ -15082,6 +17049,18 @@ public final class Status {
       if (cpu_ != null) {
         output.writeMessage(2, getCpu());
       }
+      if (disk_ != null) {
+        output.writeMessage(3, getDisk());
+      }
+      if (ioPressure_ != null) {
+        output.writeMessage(4, getIoPressure());
+      }
+      if (network_ != null) {
+        output.writeMessage(5, getNetwork());
+      }
+      for (int i = 0; i < processes_.size(); i++) {
+        output.writeMessage(6, processes_.get(i));
+      }
       unknownFields.writeTo(output);
     }
 
@@ -15099,6 +17078,22 @@ public final class Status {
         size += com.google.protobuf.CodedOutputStream
           .computeMessageSize(2, getCpu());
       }
+      if (disk_ != null) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(3, getDisk());
+      }
+      if (ioPressure_ != null) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(4, getIoPressure());
+      }
+      if (network_ != null) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(5, getNetwork());
+      }
+      for (int i = 0; i < processes_.size(); i++) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(6, processes_.get(i));
+      }
       size += unknownFields.getSerializedSize();
       memoizedSize = size;
       return size;
@@ -15124,6 +17119,23 @@ public final class Status {
         if (!getCpu()
             .equals(other.getCpu())) return false;
       }
+      if (hasDisk() != other.hasDisk()) return false;
+      if (hasDisk()) {
+        if (!getDisk()
+            .equals(other.getDisk())) return false;
+      }
+      if (hasIoPressure() != other.hasIoPressure()) return false;
+      if (hasIoPressure()) {
+        if (!getIoPressure()
+            .equals(other.getIoPressure())) return false;
+      }
+      if (hasNetwork() != other.hasNetwork()) return false;
+      if (hasNetwork()) {
+        if (!getNetwork()
+            .equals(other.getNetwork())) return false;
+      }
+      if (!getProcessesList()
+          .equals(other.getProcessesList())) return false;
       if (!unknownFields.equals(other.unknownFields)) return false;
       return true;
     }
@@ -15143,6 +17155,22 @@ public final class Status {
         hash = (37 * hash) + CPU_FIELD_NUMBER;
         hash = (53 * hash) + getCpu().hashCode();
       }
+      if (hasDisk()) {
+        hash = (37 * hash) + DISK_FIELD_NUMBER;
+        hash = (53 * hash) + getDisk().hashCode();
+      }
+      if (hasIoPressure()) {
+        hash = (37 * hash) + IO_PRESSURE_FIELD_NUMBER;
+        hash = (53 * hash) + getIoPressure().hashCode();
+      }
+      if (hasNetwork()) {
+        hash = (37 * hash) + NETWORK_FIELD_NUMBER;
+        hash = (53 * hash) + getNetwork().hashCode();
+      }
+      if (getProcessesCount() > 0) {
+        hash = (37 * hash) + PROCESSES_FIELD_NUMBER;
+        hash = (53 * hash) + getProcessesList().hashCode();
+      }
       hash = (29 * hash) + unknownFields.hashCode();
       memoizedHashCode = hash;
       return hash;
@@ -15271,6 +17299,7 @@ public final class Status {
       private void maybeForceBuilderInitialization() {
         if (com.google.protobuf.GeneratedMessageV3
                 .alwaysUseFieldBuilders) {
+          getProcessesFieldBuilder();
         }
       }
       @java.lang.Override
@@ -15288,6 +17317,30 @@ public final class Status {
           cpu_ = null;
           cpuBuilder_ = null;
         }
+        if (diskBuilder_ == null) {
+          disk_ = null;
+        } else {
+          disk_ = null;
+          diskBuilder_ = null;
+        }
+        if (ioPressureBuilder_ == null) {
+          ioPressure_ = null;
+        } else {
+          ioPressure_ = null;
+          ioPressureBuilder_ = null;
+        }
+        if (networkBuilder_ == null) {
+          network_ = null;
+        } else {
+          network_ = null;
+          networkBuilder_ = null;
+        }
+        if (processesBuilder_ == null) {
+          processes_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+        } else {
+          processesBuilder_.clear();
+        }
         return this;
       }
 
@@ -15314,6 +17367,7 @@ public final class Status {
       @java.lang.Override
       public io.gitpod.supervisor.api.Status.ResourcesStatusResponse buildPartial() {
         io.gitpod.supervisor.api.Status.ResourcesStatusResponse result = new io.gitpod.supervisor.api.Status.ResourcesStatusResponse(this);
+        int from_bitField0_ = bitField0_;
         if (memoryBuilder_ == null) {
           result.memory_ = memory_;
         } else {
@@ -15324,6 +17378,30 @@ public final class Status {
         } else {
           result.cpu_ = cpuBuilder_.build();
         }
+        if (diskBuilder_ == null) {
+          result.disk_ = disk_;
+        } else {
+          result.disk_ = diskBuilder_.build();
+        }
+        if (ioPressureBuilder_ == null) {
+          result.ioPressure_ = ioPressure_;
+        } else {
+          result.ioPressure_ = ioPressureBuilder_.build();
+        }
+        if (networkBuilder_ == null) {
+          result.network_ = network_;
+        } else {
+          result.network_ = networkBuilder_.build();
+        }
+        if (processesBuilder_ == null) {
+          if (((bitField0_ & 0x00000001) != 0)) {
+            processes_ = java.util.Collections.unmodifiableList(processes_);
+            bitField0_ = (bitField0_ & ~0x00000001);
+          }
+          result.processes_ = processes_;
+        } else {
+          result.processes_ = processesBuilder_.build();
+        }
         onBuilt();
         return result;
       }
@@ -15378,6 +17456,41 @@ public final class Status {
         if (other.hasCpu()) {
           mergeCpu(other.getCpu());
         }
+        if (other.hasDisk()) {
+          mergeDisk(other.getDisk());
+        }
+        if (other.hasIoPressure()) {
+          mergeIoPressure(other.getIoPressure());
+        }
+        if (other.hasNetwork()) {
+          mergeNetwork(other.getNetwork());
+        }
+        if (processesBuilder_ == null) {
+          if (!other.processes_.isEmpty()) {
+            if (processes_.isEmpty()) {
+              processes_ = other.processes_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+            } else {
+              ensureProcessesIsMutable();
+              processes_.addAll(other.processes_);
+            }
+            onChanged();
+          }
+        } else {
+          if (!other.processes_.isEmpty()) {
+            if (processesBuilder_.isEmpty()) {
+              processesBuilder_.dispose();
+              processesBuilder_ = null;
+              processes_ = other.processes_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+              processesBuilder_ =
+                com.google.protobuf.GeneratedMessageV3.alwaysUseFieldBuilders ?
+                   getProcessesFieldBuilder() : null;
+            } else {
+              processesBuilder_.addAllMessages(other.processes_);
+            }
+          }
+        }
         this.mergeUnknownFields(other.unknownFields);
         onChanged();
         return this;
@@ -15406,6 +17519,7 @@ public final class Status {
         }
         return this;
       }
+      private int bitField0_;
 
       private io.gitpod.supervisor.api.Status.ResourceStatus memory_;
       private com.google.protobuf.SingleFieldBuilderV3<
@@ -15716,107 +17830,8049 @@ public final class Status {
         }
         return cpuBuilder_;
       }
-      @java.lang.Override
-      public final Builder setUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.setUnknownFields(unknownFields);
-      }
 
-      @java.lang.Override
-      public final Builder mergeUnknownFields(
-          final com.google.protobuf.UnknownFieldSet unknownFields) {
-        return super.mergeUnknownFields(unknownFields);
+      private io.gitpod.supervisor.api.Status.ResourceStatus disk_;
+      private com.google.protobuf.SingleFieldBuilderV3<
+          io.gitpod.supervisor.api.Status.ResourceStatus, io.gitpod.supervisor.api.Status.ResourceStatus.Builder, io.gitpod.supervisor.api.Status.ResourceStatusOrBuilder> diskBuilder_;
+      /**
+       * <pre>
+       * Used disk space of the workspace and its quota limit in bytes.
+       * </pre>
+       *
+       * <code>.supervisor.ResourceStatus disk = 3;</code>
+       * @return Whether the disk field is set.
+       */
+      public boolean hasDisk() {
+        return diskBuilder_ != null || disk_ != null;
       }
+      /**
+       * <pre>
+       * Used disk space of the workspace and its quota limit in bytes.
+       * </pre>
+       *
+       * <code>.supervisor.ResourceStatus disk = 3;</code>
+       * @return The disk.
+       */
+      public io.gitpod.supervisor.api.Status.ResourceStatus getDisk() {
+        if (diskBuilder_ == null) {
+          return disk_ == null ? io.gitpod.supervisor.api.Status.ResourceStatus.getDefaultInstance() : disk_;
+        } else {
+          return diskBuilder_.getMessage();
+        }
+      }
+      /**
+       * <pre>
+       * Used disk space of the workspace and its quota limit in bytes.
+       * </pre>
+       *
+       * <code>.supervisor.ResourceStatus disk = 3;</code>
+       */
+      public Builder setDisk(io.gitpod.supervisor.api.Status.ResourceStatus value) {
+        if (diskBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          disk_ = value;
+          onChanged();
+        } else {
+          diskBuilder_.setMessage(value);
+        }
 
+        return this;
+      }
+      /**
+       * <pre>
+       * Used disk space of the workspace and its quota limit in bytes.
+       * </pre>
+       *
+       * <code>.supervisor.ResourceStatus disk = 3;</code>
+       */
+      public Builder setDisk(
+          io.gitpod.supervisor.api.Status.ResourceStatus.Builder builderForValue) {
+        if (diskBuilder_ == null) {
+          disk_ = builderForValue.build();
+          onChanged();
+        } else {
+          diskBuilder_.setMessage(builderForValue.build());
+        }
 
-      // @@protoc_insertion_point(builder_scope:supervisor.ResourcesStatusResponse)
-    }
-
-    // @@protoc_insertion_point(class_scope:supervisor.ResourcesStatusResponse)
-    private static final io.gitpod.supervisor.api.Status.ResourcesStatusResponse DEFAULT_INSTANCE;
-    static {
-      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Status.ResourcesStatusResponse();
-    }
+        return this;
+      }
+      /**
+       * <pre>
+       * Used disk space of the workspace and its quota limit in bytes.
+       * </pre>
+       *
+       * <code>.supervisor.ResourceStatus disk = 3;</code>
+       */
+      public Builder mergeDisk(io.gitpod.supervisor.api.Status.ResourceStatus value) {
+        if (diskBuilder_ == null) {
+          if (disk_ != null) {
+            disk_ =
+              io.gitpod.supervisor.api.Status.ResourceStatus.newBuilder(disk_).mergeFrom(value).buildPartial();
+          } else {
+            disk_ = value;
+          }
+          onChanged();
+        } else {
+          diskBuilder_.mergeFrom(value);
+        }
 
-    public static io.gitpod.supervisor.api.Status.ResourcesStatusResponse getDefaultInstance() {
-      return DEFAULT_INSTANCE;
-    }
+        return this;
+      }
+      /**
+       * <pre>
+       * Used disk space of the workspace and its quota limit in bytes.
+       * </pre>
+       *
+       * <code>.supervisor.ResourceStatus disk = 3;</code>
+       */
+      public Builder clearDisk() {
+        if (diskBuilder_ == null) {
+          disk_ = null;
+          onChanged();
+        } else {
+          disk_ = null;
+          diskBuilder_ = null;
+        }
 
-    private static final com.google.protobuf.Parser<ResourcesStatusResponse>
-        PARSER = new com.google.protobuf.AbstractParser<ResourcesStatusResponse>() {
-      @java.lang.Override
-      public ResourcesStatusResponse parsePartialFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws com.google.protobuf.InvalidProtocolBufferException {
-        return new ResourcesStatusResponse(input, extensionRegistry);
+        return this;
       }
-    };
+      /**
+       * <pre>
+       * Used disk space of the workspace and its quota limit in bytes.
+       * </pre>
+       *
+       * <code>.supervisor.ResourceStatus disk = 3;</code>
+       */
+      public io.gitpod.supervisor.api.Status.ResourceStatus.Builder getDiskBuilder() {
 
-    public static com.google.protobuf.Parser<ResourcesStatusResponse> parser() {
-      return PARSER;
-    }
+        onChanged();
+        return getDiskFieldBuilder().getBuilder();
+      }
+      /**
+       * <pre>
+       * Used disk space of the workspace and its quota limit in bytes.
+       * </pre>
+       *
+       * <code>.supervisor.ResourceStatus disk = 3;</code>
+       */
+      public io.gitpod.supervisor.api.Status.ResourceStatusOrBuilder getDiskOrBuilder() {
+        if (diskBuilder_ != null) {
+          return diskBuilder_.getMessageOrBuilder();
+        } else {
+          return disk_ == null ?
+              io.gitpod.supervisor.api.Status.ResourceStatus.getDefaultInstance() : disk_;
+        }
+      }
+      /**
+       * <pre>
+       * Used disk space of the workspace and its quota limit in bytes.
+       * </pre>
+       *
+       * <code>.supervisor.ResourceStatus disk = 3;</code>
+       */
+      private com.google.protobuf.SingleFieldBuilderV3<
+          io.gitpod.supervisor.api.Status.ResourceStatus, io.gitpod.supervisor.api.Status.ResourceStatus.Builder, io.gitpod.supervisor.api.Status.ResourceStatusOrBuilder>
+          getDiskFieldBuilder() {
+        if (diskBuilder_ == null) {
+          diskBuilder_ = new com.google.protobuf.SingleFieldBuilderV3<
+              io.gitpod.supervisor.api.Status.ResourceStatus, io.gitpod.supervisor.api.Status.ResourceStatus.Builder, io.gitpod.supervisor.api.Status.ResourceStatusOrBuilder>(
+                  getDisk(),
+                  getParentForChildren(),
+                  isClean());
+          disk_ = null;
+        }
+        return diskBuilder_;
+      }
 
-    @java.lang.Override
-    public com.google.protobuf.Parser<ResourcesStatusResponse> getParserForType() {
-      return PARSER;
-    }
+      private io.gitpod.supervisor.api.Status.PressureStatus ioPressure_;
+      private com.google.protobuf.SingleFieldBuilderV3<
+          io.gitpod.supervisor.api.Status.PressureStatus, io.gitpod.supervisor.api.Status.PressureStatus.Builder, io.gitpod.supervisor.api.Status.PressureStatusOrBuilder> ioPressureBuilder_;
+      /**
+       * <pre>
+       * IO pressure stall information of the workspace.
+       * </pre>
+       *
+       * <code>.supervisor.PressureStatus io_pressure = 4;</code>
+       * @return Whether the ioPressure field is set.
+       */
+      public boolean hasIoPressure() {
+        return ioPressureBuilder_ != null || ioPressure_ != null;
+      }
+      /**
+       * <pre>
+       * IO pressure stall information of the workspace.
+       * </pre>
+       *
+       * <code>.supervisor.PressureStatus io_pressure = 4;</code>
+       * @return The ioPressure.
+       */
+      public io.gitpod.supervisor.api.Status.PressureStatus getIoPressure() {
+        if (ioPressureBuilder_ == null) {
+          return ioPressure_ == null ? io.gitpod.supervisor.api.Status.PressureStatus.getDefaultInstance() : ioPressure_;
+        } else {
+          return ioPressureBuilder_.getMessage();
+        }
+      }
+      /**
+       * <pre>
+       * IO pressure stall information of the workspace.
+       * </pre>
+       *
+       * <code>.supervisor.PressureStatus io_pressure = 4;</code>
+       */
+      public Builder setIoPressure(io.gitpod.supervisor.api.Status.PressureStatus value) {
+        if (ioPressureBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ioPressure_ = value;
+          onChanged();
+        } else {
+          ioPressureBuilder_.setMessage(value);
+        }
 
-    @java.lang.Override
-    public io.gitpod.supervisor.api.Status.ResourcesStatusResponse getDefaultInstanceForType() {
-      return DEFAULT_INSTANCE;
-    }
+        return this;
+      }
+      /**
+       * <pre>
+       * IO pressure stall information of the workspace.
+       * </pre>
+       *
+       * <code>.supervisor.PressureStatus io_pressure = 4;</code>
+       */
+      public Builder setIoPressure(
+          io.gitpod.supervisor.api.Status.PressureStatus.Builder builderForValue) {
+        if (ioPressureBuilder_ == null) {
+          ioPressure_ = builderForValue.build();
+          onChanged();
+        } else {
+          ioPressureBuilder_.setMessage(builderForValue.build());
+        }
 
-  }
+        return this;
+      }
+      /**
+       * <pre>
+       * IO pressure stall information of the workspace.
+       * </pre>
+       *
+       * <code>.supervisor.PressureStatus io_pressure = 4;</code>
+       */
+      public Builder mergeIoPressure(io.gitpod.supervisor.api.Status.PressureStatus value) {
+        if (ioPressureBuilder_ == null) {
+          if (ioPressure_ != null) {
+            ioPressure_ =
+              io.gitpod.supervisor.api.Status.PressureStatus.newBuilder(ioPressure_).mergeFrom(value).buildPartial();
+          } else {
+            ioPressure_ = value;
+          }
+          onChanged();
+        } else {
+          ioPressureBuilder_.mergeFrom(value);
+        }
 
-  public interface ResourceStatusOrBuilder extends
-      // @@protoc_insertion_point(interface_extends:supervisor.ResourceStatus)
-      com.google.protobuf.MessageOrBuilder {
+        return this;
+      }
+      /**
+       * <pre>
+       * IO pressure stall information of the workspace.
+       * </pre>
+       *
+       * <code>.supervisor.PressureStatus io_pressure = 4;</code>
+       */
+      public Builder clearIoPressure() {
+        if (ioPressureBuilder_ == null) {
+          ioPressure_ = null;
+          onChanged();
+        } else {
+          ioPressure_ = null;
+          ioPressureBuilder_ = null;
+        }
 
-    /**
-     * <code>int64 used = 1;</code>
-     * @return The used.
-     */
-    long getUsed();
+        return this;
+      }
+      /**
+       * <pre>
+       * IO pressure stall information of the workspace.
+       * </pre>
+       *
+       * <code>.supervisor.PressureStatus io_pressure = 4;</code>
+       */
+      public io.gitpod.supervisor.api.Status.PressureStatus.Builder getIoPressureBuilder() {
 
-    /**
-     * <code>int64 limit = 2;</code>
-     * @return The limit.
-     */
-    long getLimit();
+        onChanged();
+        return getIoPressureFieldBuilder().getBuilder();
+      }
+      /**
+       * <pre>
+       * IO pressure stall information of the workspace.
+       * </pre>
+       *
+       * <code>.supervisor.PressureStatus io_pressure = 4;</code>
+       */
+      public io.gitpod.supervisor.api.Status.PressureStatusOrBuilder getIoPressureOrBuilder() {
+        if (ioPressureBuilder_ != null) {
+          return ioPressureBuilder_.getMessageOrBuilder();
+        } else {
+          return ioPressure_ == null ?
+              io.gitpod.supervisor.api.Status.PressureStatus.getDefaultInstance() : ioPressure_;
+        }
+      }
+      /**
+       * <pre>
+       * IO pressure stall information of the workspace.
+       * </pre>
+       *
+       * <code>.supervisor.PressureStatus io_pressure = 4;</code>
+       */
+      private com.google.protobuf.SingleFieldBuilderV3<
+          io.gitpod.supervisor.api.Status.PressureStatus, io.gitpod.supervisor.api.Status.PressureStatus.Builder, io.gitpod.supervisor.api.Status.PressureStatusOrBuilder>
+          getIoPressureFieldBuilder() {
+        if (ioPressureBuilder_ == null) {
+          ioPressureBuilder_ = new com.google.protobuf.SingleFieldBuilderV3<
+              io.gitpod.supervisor.api.Status.PressureStatus, io.gitpod.supervisor.api.Status.PressureStatus.Builder, io.gitpod.supervisor.api.Status.PressureStatusOrBuilder>(
+                  getIoPressure(),
+                  getParentForChildren(),
+                  isClean());
+          ioPressure_ = null;
+        }
+        return ioPressureBuilder_;
+      }
 
-    /**
-     * <code>.supervisor.ResourceStatusSeverity severity = 3;</code>
-     * @return The enum numeric value on the wire for severity.
-     */
-    int getSeverityValue();
-    /**
-     * <code>.supervisor.ResourceStatusSeverity severity = 3;</code>
-     * @return The severity.
-     */
-    io.gitpod.supervisor.api.Status.ResourceStatusSeverity getSeverity();
-  }
-  /**
-   * Protobuf type {@code supervisor.ResourceStatus}
-   */
-  public static final class ResourceStatus extends
+      private io.gitpod.supervisor.api.Status.NetworkStatus network_;
+      private com.google.protobuf.SingleFieldBuilderV3<
+          io.gitpod.supervisor.api.Status.NetworkStatus, io.gitpod.supervisor.api.Status.NetworkStatus.Builder, io.gitpod.supervisor.api.Status.NetworkStatusOrBuilder> networkBuilder_;
+      /**
+       * <pre>
+       * Network traffic of the workspace.
+       * </pre>
+       *
+       * <code>.supervisor.NetworkStatus network = 5;</code>
+       * @return Whether the network field is set.
+       */
+      public boolean hasNetwork() {
+        return networkBuilder_ != null || network_ != null;
+      }
+      /**
+       * <pre>
+       * Network traffic of the workspace.
+       * </pre>
+       *
+       * <code>.supervisor.NetworkStatus network = 5;</code>
+       * @return The network.
+       */
+      public io.gitpod.supervisor.api.Status.NetworkStatus getNetwork() {
+        if (networkBuilder_ == null) {
+          return network_ == null ? io.gitpod.supervisor.api.Status.NetworkStatus.getDefaultInstance() : network_;
+        } else {
+          return networkBuilder_.getMessage();
+        }
+      }
+      /**
+       * <pre>
+       * Network traffic of the workspace.
+       * </pre>
+       *
+       * <code>.supervisor.NetworkStatus network = 5;</code>
+       */
+      public Builder setNetwork(io.gitpod.supervisor.api.Status.NetworkStatus value) {
+        if (networkBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          network_ = value;
+          onChanged();
+        } else {
+          networkBuilder_.setMessage(value);
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * Network traffic of the workspace.
+       * </pre>
+       *
+       * <code>.supervisor.NetworkStatus network = 5;</code>
+       */
+      public Builder setNetwork(
+          io.gitpod.supervisor.api.Status.NetworkStatus.Builder builderForValue) {
+        if (networkBuilder_ == null) {
+          network_ = builderForValue.build();
+          onChanged();
+        } else {
+          networkBuilder_.setMessage(builderForValue.build());
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * Network traffic of the workspace.
+       * </pre>
+       *
+       * <code>.supervisor.NetworkStatus network = 5;</code>
+       */
+      public Builder mergeNetwork(io.gitpod.supervisor.api.Status.NetworkStatus value) {
+        if (networkBuilder_ == null) {
+          if (network_ != null) {
+            network_ =
+              io.gitpod.supervisor.api.Status.NetworkStatus.newBuilder(network_).mergeFrom(value).buildPartial();
+          } else {
+            network_ = value;
+          }
+          onChanged();
+        } else {
+          networkBuilder_.mergeFrom(value);
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * Network traffic of the workspace.
+       * </pre>
+       *
+       * <code>.supervisor.NetworkStatus network = 5;</code>
+       */
+      public Builder clearNetwork() {
+        if (networkBuilder_ == null) {
+          network_ = null;
+          onChanged();
+        } else {
+          network_ = null;
+          networkBuilder_ = null;
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * Network traffic of the workspace.
+       * </pre>
+       *
+       * <code>.supervisor.NetworkStatus network = 5;</code>
+       */
+      public io.gitpod.supervisor.api.Status.NetworkStatus.Builder getNetworkBuilder() {
+
+        onChanged();
+        return getNetworkFieldBuilder().getBuilder();
+      }
+      /**
+       * <pre>
+       * Network traffic of the workspace.
+       * </pre>
+       *
+       * <code>.supervisor.NetworkStatus network = 5;</code>
+       */
+      public io.gitpod.supervisor.api.Status.NetworkStatusOrBuilder getNetworkOrBuilder() {
+        if (networkBuilder_ != null) {
+          return networkBuilder_.getMessageOrBuilder();
+        } else {
+          return network_ == null ?
+              io.gitpod.supervisor.api.Status.NetworkStatus.getDefaultInstance() : network_;
+        }
+      }
+      /**
+       * <pre>
+       * Network traffic of the workspace.
+       * </pre>
+       *
+       * <code>.supervisor.NetworkStatus network = 5;</code>
+       */
+      private com.google.protobuf.SingleFieldBuilderV3<
+          io.gitpod.supervisor.api.Status.NetworkStatus, io.gitpod.supervisor.api.Status.NetworkStatus.Builder, io.gitpod.supervisor.api.Status.NetworkStatusOrBuilder>
+          getNetworkFieldBuilder() {
+        if (networkBuilder_ == null) {
+          networkBuilder_ = new com.google.protobuf.SingleFieldBuilderV3<
+              io.gitpod.supervisor.api.Status.NetworkStatus, io.gitpod.supervisor.api.Status.NetworkStatus.Builder, io.gitpod.supervisor.api.Status.NetworkStatusOrBuilder>(
+                  getNetwork(),
+                  getParentForChildren(),
+                  isClean());
+          network_ = null;
+        }
+        return networkBuilder_;
+      }
+
+      private java.util.List<io.gitpod.supervisor.api.Status.ProcessStatus> processes_ =
+        java.util.Collections.emptyList();
+      private void ensureProcessesIsMutable() {
+        if (!((bitField0_ & 0x00000001) != 0)) {
+          processes_ = new java.util.ArrayList<io.gitpod.supervisor.api.Status.ProcessStatus>(processes_);
+          bitField0_ |= 0x00000001;
+         }
+      }
+
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          io.gitpod.supervisor.api.Status.ProcessStatus, io.gitpod.supervisor.api.Status.ProcessStatus.Builder, io.gitpod.supervisor.api.Status.ProcessStatusOrBuilder> processesBuilder_;
+
+      /**
+       * <pre>
+       * Processes using the most CPU or memory, ordered by CPU usage.
+       * </pre>
+       *
+       * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+       */
+      public java.util.List<io.gitpod.supervisor.api.Status.ProcessStatus> getProcessesList() {
+        if (processesBuilder_ == null) {
+          return java.util.Collections.unmodifiableList(processes_);
+        } else {
+          return processesBuilder_.getMessageList();
+        }
+      }
+      /**
+       * <pre>
+       * Processes using the most CPU or memory, ordered by CPU usage.
+       * </pre>
+       *
+       * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+       */
+      public int getProcessesCount() {
+        if (processesBuilder_ == null) {
+          return processes_.size();
+        } else {
+          return processesBuilder_.getCount();
+        }
+      }
+      /**
+       * <pre>
+       * Processes using the most CPU or memory, ordered by CPU usage.
+       * </pre>
+       *
+       * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+       */
+      public io.gitpod.supervisor.api.Status.ProcessStatus getProcesses(int index) {
+        if (processesBuilder_ == null) {
+          return processes_.get(index);
+        } else {
+          return processesBuilder_.getMessage(index);
+        }
+      }
+      /**
+       * <pre>
+       * Processes using the most CPU or memory, ordered by CPU usage.
+       * </pre>
+       *
+       * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+       */
+      public Builder setProcesses(
+          int index, io.gitpod.supervisor.api.Status.ProcessStatus value) {
+        if (processesBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureProcessesIsMutable();
+          processes_.set(index, value);
+          onChanged();
+        } else {
+          processesBuilder_.setMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * Processes using the most CPU or memory, ordered by CPU usage.
+       * </pre>
+       *
+       * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+       */
+      public Builder setProcesses(
+          int index, io.gitpod.supervisor.api.Status.ProcessStatus.Builder builderForValue) {
+        if (processesBuilder_ == null) {
+          ensureProcessesIsMutable();
+          processes_.set(index, builderForValue.build());
+          onChanged();
+        } else {
+          processesBuilder_.setMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * Processes using the most CPU or memory, ordered by CPU usage.
+       * </pre>
+       *
+       * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+       */
+      public Builder addProcesses(io.gitpod.supervisor.api.Status.ProcessStatus value) {
+        if (processesBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureProcessesIsMutable();
+          processes_.add(value);
+          onChanged();
+        } else {
+          processesBuilder_.addMessage(value);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * Processes using the most CPU or memory, ordered by CPU usage.
+       * </pre>
+       *
+       * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+       */
+      public Builder addProcesses(
+          int index, io.gitpod.supervisor.api.Status.ProcessStatus value) {
+        if (processesBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureProcessesIsMutable();
+          processes_.add(index, value);
+          onChanged();
+        } else {
+          processesBuilder_.addMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * Processes using the most CPU or memory, ordered by CPU usage.
+       * </pre>
+       *
+       * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+       */
+      public Builder addProcesses(
+          io.gitpod.supervisor.api.Status.ProcessStatus.Builder builderForValue) {
+        if (processesBuilder_ == null) {
+          ensureProcessesIsMutable();
+          processes_.add(builderForValue.build());
+          onChanged();
+        } else {
+          processesBuilder_.addMessage(builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * Processes using the most CPU or memory, ordered by CPU usage.
+       * </pre>
+       *
+       * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+       */
+      public Builder addProcesses(
+          int index, io.gitpod.supervisor.api.Status.ProcessStatus.Builder builderForValue) {
+        if (processesBuilder_ == null) {
+          ensureProcessesIsMutable();
+          processes_.add(index, builderForValue.build());
+          onChanged();
+        } else {
+          processesBuilder_.addMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * Processes using the most CPU or memory, ordered by CPU usage.
+       * </pre>
+       *
+       * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+       */
+      public Builder addAllProcesses(
+          java.lang.Iterable<? extends io.gitpod.supervisor.api.Status.ProcessStatus> values) {
+        if (processesBuilder_ == null) {
+          ensureProcessesIsMutable();
+          com.google.protobuf.AbstractMessageLite.Builder.addAll(
+              values, processes_);
+          onChanged();
+        } else {
+          processesBuilder_.addAllMessages(values);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * Processes using the most CPU or memory, ordered by CPU usage.
+       * </pre>
+       *
+       * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+       */
+      public Builder clearProcesses() {
+        if (processesBuilder_ == null) {
+          processes_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+          onChanged();
+        } else {
+          processesBuilder_.clear();
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * Processes using the most CPU or memory, ordered by CPU usage.
+       * </pre>
+       *
+       * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+       */
+      public Builder removeProcesses(int index) {
+        if (processesBuilder_ == null) {
+          ensureProcessesIsMutable();
+          processes_.remove(index);
+          onChanged();
+        } else {
+          processesBuilder_.remove(index);
+        }
+        return this;
+      }
+      /**
+       * <pre>
+       * Processes using the most CPU or memory, ordered by CPU usage.
+       * </pre>
+       *
+       * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+       */
+      public io.gitpod.supervisor.api.Status.ProcessStatus.Builder getProcessesBuilder(
+          int index) {
+        return getProcessesFieldBuilder().getBuilder(index);
+      }
+      /**
+       * <pre>
+       * Processes using the most CPU or memory, ordered by CPU usage.
+       * </pre>
+       *
+       * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+       */
+      public io.gitpod.supervisor.api.Status.ProcessStatusOrBuilder getProcessesOrBuilder(
+          int index) {
+        if (processesBuilder_ == null) {
+          return processes_.get(index);  } else {
+          return processesBuilder_.getMessageOrBuilder(index);
+        }
+      }
+      /**
+       * <pre>
+       * Processes using the most CPU or memory, ordered by CPU usage.
+       * </pre>
+       *
+       * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+       */
+      public java.util.List<? extends io.gitpod.supervisor.api.Status.ProcessStatusOrBuilder>
+           getProcessesOrBuilderList() {
+        if (processesBuilder_ != null) {
+          return processesBuilder_.getMessageOrBuilderList();
+        } else {
+          return java.util.Collections.unmodifiableList(processes_);
+        }
+      }
+      /**
+       * <pre>
+       * Processes using the most CPU or memory, ordered by CPU usage.
+       * </pre>
+       *
+       * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+       */
+      public io.gitpod.supervisor.api.Status.ProcessStatus.Builder addProcessesBuilder() {
+        return getProcessesFieldBuilder().addBuilder(
+            io.gitpod.supervisor.api.Status.ProcessStatus.getDefaultInstance());
+      }
+      /**
+       * <pre>
+       * Processes using the most CPU or memory, ordered by CPU usage.
+       * </pre>
+       *
+       * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+       */
+      public io.gitpod.supervisor.api.Status.ProcessStatus.Builder addProcessesBuilder(
+          int index) {
+        return getProcessesFieldBuilder().addBuilder(
+            index, io.gitpod.supervisor.api.Status.ProcessStatus.getDefaultInstance());
+      }
+      /**
+       * <pre>
+       * Processes using the most CPU or memory, ordered by CPU usage.
+       * </pre>
+       *
+       * <code>repeated .supervisor.ProcessStatus processes = 6;</code>
+       */
+      public java.util.List<io.gitpod.supervisor.api.Status.ProcessStatus.Builder>
+           getProcessesBuilderList() {
+        return getProcessesFieldBuilder().getBuilderList();
+      }
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          io.gitpod.supervisor.api.Status.ProcessStatus, io.gitpod.supervisor.api.Status.ProcessStatus.Builder, io.gitpod.supervisor.api.Status.ProcessStatusOrBuilder>
+          getProcessesFieldBuilder() {
+        if (processesBuilder_ == null) {
+          processesBuilder_ = new com.google.protobuf.RepeatedFieldBuilderV3<
+              io.gitpod.supervisor.api.Status.ProcessStatus, io.gitpod.supervisor.api.Status.ProcessStatus.Builder, io.gitpod.supervisor.api.Status.ProcessStatusOrBuilder>(
+                  processes_,
+                  ((bitField0_ & 0x00000001) != 0),
+                  getParentForChildren(),
+                  isClean());
+          processes_ = null;
+        }
+        return processesBuilder_;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ResourcesStatusResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ResourcesStatusResponse)
+    private static final io.gitpod.supervisor.api.Status.ResourcesStatusResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Status.ResourcesStatusResponse();
+    }
+
+    public static io.gitpod.supervisor.api.Status.ResourcesStatusResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ResourcesStatusResponse>
+        PARSER = new com.google.protobuf.AbstractParser<ResourcesStatusResponse>() {
+      @java.lang.Override
+      public ResourcesStatusResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ResourcesStatusResponse(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ResourcesStatusResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ResourcesStatusResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.ResourcesStatusResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ResourceStatusOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ResourceStatus)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>int64 used = 1;</code>
+     * @return The used.
+     */
+    long getUsed();
+
+    /**
+     * <code>int64 limit = 2;</code>
+     * @return The limit.
+     */
+    long getLimit();
+
+    /**
+     * <code>.supervisor.ResourceStatusSeverity severity = 3;</code>
+     * @return The enum numeric value on the wire for severity.
+     */
+    int getSeverityValue();
+    /**
+     * <code>.supervisor.ResourceStatusSeverity severity = 3;</code>
+     * @return The severity.
+     */
+    io.gitpod.supervisor.api.Status.ResourceStatusSeverity getSeverity();
+  }
+  /**
+   * Protobuf type {@code supervisor.ResourceStatus}
+   */
+  public static final class ResourceStatus extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ResourceStatus)
+      ResourceStatusOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ResourceStatus.newBuilder() to construct.
+    private ResourceStatus(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ResourceStatus() {
+      severity_ = 0;
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ResourceStatus();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ResourceStatus(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 8: {
+
+              used_ = input.readInt64();
+              break;
+            }
+            case 16: {
+
+              limit_ = input.readInt64();
+              break;
+            }
+            case 24: {
+              int rawValue = input.readEnum();
+
+              severity_ = rawValue;
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_ResourceStatus_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_ResourceStatus_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Status.ResourceStatus.class, io.gitpod.supervisor.api.Status.ResourceStatus.Builder.class);
+    }
+
+    public static final int USED_FIELD_NUMBER = 1;
+    private long used_;
+    /**
+     * <code>int64 used = 1;</code>
+     * @return The used.
+     */
+    @java.lang.Override
+    public long getUsed() {
+      return used_;
+    }
+
+    public static final int LIMIT_FIELD_NUMBER = 2;
+    private long limit_;
+    /**
+     * <code>int64 limit = 2;</code>
+     * @return The limit.
+     */
+    @java.lang.Override
+    public long getLimit() {
+      return limit_;
+    }
+
+    public static final int SEVERITY_FIELD_NUMBER = 3;
+    private int severity_;
+    /**
+     * <code>.supervisor.ResourceStatusSeverity severity = 3;</code>
+     * @return The enum numeric value on the wire for severity.
+     */
+    @java.lang.Override public int getSeverityValue() {
+      return severity_;
+    }
+    /**
+     * <code>.supervisor.ResourceStatusSeverity severity = 3;</code>
+     * @return The severity.
+     */
+    @java.lang.Override public io.gitpod.supervisor.api.Status.ResourceStatusSeverity getSeverity() {
+      @SuppressWarnings("deprecation")
+      io.gitpod.supervisor.api.Status.ResourceStatusSeverity result = io.gitpod.supervisor.api.Status.ResourceStatusSeverity.valueOf(severity_);
+      return result == null ? io.gitpod.supervisor.api.Status.ResourceStatusSeverity.UNRECOGNIZED : result;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (used_ != 0L) {
+        output.writeInt64(1, used_);
+      }
+      if (limit_ != 0L) {
+        output.writeInt64(2, limit_);
+      }
+      if (severity_ != io.gitpod.supervisor.api.Status.ResourceStatusSeverity.normal.getNumber()) {
+        output.writeEnum(3, severity_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (used_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt64Size(1, used_);
+      }
+      if (limit_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt64Size(2, limit_);
+      }
+      if (severity_ != io.gitpod.supervisor.api.Status.ResourceStatusSeverity.normal.getNumber()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeEnumSize(3, severity_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Status.ResourceStatus)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Status.ResourceStatus other = (io.gitpod.supervisor.api.Status.ResourceStatus) obj;
+
+      if (getUsed()
+          != other.getUsed()) return false;
+      if (getLimit()
+          != other.getLimit()) return false;
+      if (severity_ != other.severity_) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + USED_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getUsed());
+      hash = (37 * hash) + LIMIT_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getLimit());
+      hash = (37 * hash) + SEVERITY_FIELD_NUMBER;
+      hash = (53 * hash) + severity_;
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ResourceStatus parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.ResourceStatus parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Status.ResourceStatus prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ResourceStatus}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ResourceStatus)
+        io.gitpod.supervisor.api.Status.ResourceStatusOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_ResourceStatus_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_ResourceStatus_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Status.ResourceStatus.class, io.gitpod.supervisor.api.Status.ResourceStatus.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Status.ResourceStatus.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        used_ = 0L;
+
+        limit_ = 0L;
+
+        severity_ = 0;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_ResourceStatus_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.ResourceStatus getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Status.ResourceStatus.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.ResourceStatus build() {
+        io.gitpod.supervisor.api.Status.ResourceStatus result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.ResourceStatus buildPartial() {
+        io.gitpod.supervisor.api.Status.ResourceStatus result = new io.gitpod.supervisor.api.Status.ResourceStatus(this);
+        result.used_ = used_;
+        result.limit_ = limit_;
+        result.severity_ = severity_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Status.ResourceStatus) {
+          return mergeFrom((io.gitpod.supervisor.api.Status.ResourceStatus)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Status.ResourceStatus other) {
+        if (other == io.gitpod.supervisor.api.Status.ResourceStatus.getDefaultInstance()) return this;
+        if (other.getUsed() != 0L) {
+          setUsed(other.getUsed());
+        }
+        if (other.getLimit() != 0L) {
+          setLimit(other.getLimit());
+        }
+        if (other.severity_ != 0) {
+          setSeverityValue(other.getSeverityValue());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Status.ResourceStatus parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Status.ResourceStatus) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private long used_ ;
+      /**
+       * <code>int64 used = 1;</code>
+       * @return The used.
+       */
+      @java.lang.Override
+      public long getUsed() {
+        return used_;
+      }
+      /**
+       * <code>int64 used = 1;</code>
+       * @param value The used to set.
+       * @return This builder for chaining.
+       */
+      public Builder setUsed(long value) {
+
+        used_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int64 used = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearUsed() {
+
+        used_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      private long limit_ ;
+      /**
+       * <code>int64 limit = 2;</code>
+       * @return The limit.
+       */
+      @java.lang.Override
+      public long getLimit() {
+        return limit_;
+      }
+      /**
+       * <code>int64 limit = 2;</code>
+       * @param value The limit to set.
+       * @return This builder for chaining.
+       */
+      public Builder setLimit(long value) {
+
+        limit_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int64 limit = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearLimit() {
+
+        limit_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      private int severity_ = 0;
+      /**
+       * <code>.supervisor.ResourceStatusSeverity severity = 3;</code>
+       * @return The enum numeric value on the wire for severity.
+       */
+      @java.lang.Override public int getSeverityValue() {
+        return severity_;
+      }
+      /**
+       * <code>.supervisor.ResourceStatusSeverity severity = 3;</code>
+       * @param value The enum numeric value on the wire for severity to set.
+       * @return This builder for chaining.
+       */
+      public Builder setSeverityValue(int value) {
+
+        severity_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.supervisor.ResourceStatusSeverity severity = 3;</code>
+       * @return The severity.
+       */
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.ResourceStatusSeverity getSeverity() {
+        @SuppressWarnings("deprecation")
+        io.gitpod.supervisor.api.Status.ResourceStatusSeverity result = io.gitpod.supervisor.api.Status.ResourceStatusSeverity.valueOf(severity_);
+        return result == null ? io.gitpod.supervisor.api.Status.ResourceStatusSeverity.UNRECOGNIZED : result;
+      }
+      /**
+       * <code>.supervisor.ResourceStatusSeverity severity = 3;</code>
+       * @param value The severity to set.
+       * @return This builder for chaining.
+       */
+      public Builder setSeverity(io.gitpod.supervisor.api.Status.ResourceStatusSeverity value) {
+        if (value == null) {
+          throw new NullPointerException();
+        }
+
+        severity_ = value.getNumber();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.supervisor.ResourceStatusSeverity severity = 3;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearSeverity() {
+
+        severity_ = 0;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ResourceStatus)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ResourceStatus)
+    private static final io.gitpod.supervisor.api.Status.ResourceStatus DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Status.ResourceStatus();
+    }
+
+    public static io.gitpod.supervisor.api.Status.ResourceStatus getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ResourceStatus>
+        PARSER = new com.google.protobuf.AbstractParser<ResourceStatus>() {
+      @java.lang.Override
+      public ResourceStatus parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ResourceStatus(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ResourceStatus> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ResourceStatus> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.ResourceStatus getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface PressureStatusOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.PressureStatus)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <pre>
+     * Percentage of time in which at least one process was stalled, averaged over 10, 60 and 300 seconds.
+     * </pre>
+     *
+     * <code>double some_avg10 = 1;</code>
+     * @return The someAvg10.
+     */
+    double getSomeAvg10();
+
+    /**
+     * <code>double some_avg60 = 2;</code>
+     * @return The someAvg60.
+     */
+    double getSomeAvg60();
+
+    /**
+     * <code>double some_avg300 = 3;</code>
+     * @return The someAvg300.
+     */
+    double getSomeAvg300();
+
+    /**
+     * <pre>
+     * Percentage of time in which all non-idle processes were stalled at once, averaged over 10, 60 and 300 seconds.
+     * </pre>
+     *
+     * <code>double full_avg10 = 4;</code>
+     * @return The fullAvg10.
+     */
+    double getFullAvg10();
+
+    /**
+     * <code>double full_avg60 = 5;</code>
+     * @return The fullAvg60.
+     */
+    double getFullAvg60();
+
+    /**
+     * <code>double full_avg300 = 6;</code>
+     * @return The fullAvg300.
+     */
+    double getFullAvg300();
+  }
+  /**
+   * Protobuf type {@code supervisor.PressureStatus}
+   */
+  public static final class PressureStatus extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.PressureStatus)
+      PressureStatusOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use PressureStatus.newBuilder() to construct.
+    private PressureStatus(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private PressureStatus() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new PressureStatus();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private PressureStatus(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 9: {
+
+              someAvg10_ = input.readDouble();
+              break;
+            }
+            case 17: {
+
+              someAvg60_ = input.readDouble();
+              break;
+            }
+            case 25: {
+
+              someAvg300_ = input.readDouble();
+              break;
+            }
+            case 33: {
+
+              fullAvg10_ = input.readDouble();
+              break;
+            }
+            case 41: {
+
+              fullAvg60_ = input.readDouble();
+              break;
+            }
+            case 49: {
+
+              fullAvg300_ = input.readDouble();
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_PressureStatus_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_PressureStatus_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Status.PressureStatus.class, io.gitpod.supervisor.api.Status.PressureStatus.Builder.class);
+    }
+
+    public static final int SOME_AVG10_FIELD_NUMBER = 1;
+    private double someAvg10_;
+    /**
+     * <pre>
+     * Percentage of time in which at least one process was stalled, averaged over 10, 60 and 300 seconds.
+     * </pre>
+     *
+     * <code>double some_avg10 = 1;</code>
+     * @return The someAvg10.
+     */
+    @java.lang.Override
+    public double getSomeAvg10() {
+      return someAvg10_;
+    }
+
+    public static final int SOME_AVG60_FIELD_NUMBER = 2;
+    private double someAvg60_;
+    /**
+     * <code>double some_avg60 = 2;</code>
+     * @return The someAvg60.
+     */
+    @java.lang.Override
+    public double getSomeAvg60() {
+      return someAvg60_;
+    }
+
+    public static final int SOME_AVG300_FIELD_NUMBER = 3;
+    private double someAvg300_;
+    /**
+     * <code>double some_avg300 = 3;</code>
+     * @return The someAvg300.
+     */
+    @java.lang.Override
+    public double getSomeAvg300() {
+      return someAvg300_;
+    }
+
+    public static final int FULL_AVG10_FIELD_NUMBER = 4;
+    private double fullAvg10_;
+    /**
+     * <pre>
+     * Percentage of time in which all non-idle processes were stalled at once, averaged over 10, 60 and 300 seconds.
+     * </pre>
+     *
+     * <code>double full_avg10 = 4;</code>
+     * @return The fullAvg10.
+     */
+    @java.lang.Override
+    public double getFullAvg10() {
+      return fullAvg10_;
+    }
+
+    public static final int FULL_AVG60_FIELD_NUMBER = 5;
+    private double fullAvg60_;
+    /**
+     * <code>double full_avg60 = 5;</code>
+     * @return The fullAvg60.
+     */
+    @java.lang.Override
+    public double getFullAvg60() {
+      return fullAvg60_;
+    }
+
+    public static final int FULL_AVG300_FIELD_NUMBER = 6;
+    private double fullAvg300_;
+    /**
+     * <code>double full_avg300 = 6;</code>
+     * @return The fullAvg300.
+     */
+    @java.lang.Override
+    public double getFullAvg300() {
+      return fullAvg300_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (someAvg10_ != 0D) {
+        output.writeDouble(1, someAvg10_);
+      }
+      if (someAvg60_ != 0D) {
+        output.writeDouble(2, someAvg60_);
+      }
+      if (someAvg300_ != 0D) {
+        output.writeDouble(3, someAvg300_);
+      }
+      if (fullAvg10_ != 0D) {
+        output.writeDouble(4, fullAvg10_);
+      }
+      if (fullAvg60_ != 0D) {
+        output.writeDouble(5, fullAvg60_);
+      }
+      if (fullAvg300_ != 0D) {
+        output.writeDouble(6, fullAvg300_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (someAvg10_ != 0D) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeDoubleSize(1, someAvg10_);
+      }
+      if (someAvg60_ != 0D) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeDoubleSize(2, someAvg60_);
+      }
+      if (someAvg300_ != 0D) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeDoubleSize(3, someAvg300_);
+      }
+      if (fullAvg10_ != 0D) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeDoubleSize(4, fullAvg10_);
+      }
+      if (fullAvg60_ != 0D) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeDoubleSize(5, fullAvg60_);
+      }
+      if (fullAvg300_ != 0D) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeDoubleSize(6, fullAvg300_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Status.PressureStatus)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Status.PressureStatus other = (io.gitpod.supervisor.api.Status.PressureStatus) obj;
+
+      if (java.lang.Double.doubleToLongBits(getSomeAvg10())
+          != java.lang.Double.doubleToLongBits(
+              other.getSomeAvg10())) return false;
+      if (java.lang.Double.doubleToLongBits(getSomeAvg60())
+          != java.lang.Double.doubleToLongBits(
+              other.getSomeAvg60())) return false;
+      if (java.lang.Double.doubleToLongBits(getSomeAvg300())
+          != java.lang.Double.doubleToLongBits(
+              other.getSomeAvg300())) return false;
+      if (java.lang.Double.doubleToLongBits(getFullAvg10())
+          != java.lang.Double.doubleToLongBits(
+              other.getFullAvg10())) return false;
+      if (java.lang.Double.doubleToLongBits(getFullAvg60())
+          != java.lang.Double.doubleToLongBits(
+              other.getFullAvg60())) return false;
+      if (java.lang.Double.doubleToLongBits(getFullAvg300())
+          != java.lang.Double.doubleToLongBits(
+              other.getFullAvg300())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + SOME_AVG10_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          java.lang.Double.doubleToLongBits(getSomeAvg10()));
+      hash = (37 * hash) + SOME_AVG60_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          java.lang.Double.doubleToLongBits(getSomeAvg60()));
+      hash = (37 * hash) + SOME_AVG300_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          java.lang.Double.doubleToLongBits(getSomeAvg300()));
+      hash = (37 * hash) + FULL_AVG10_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          java.lang.Double.doubleToLongBits(getFullAvg10()));
+      hash = (37 * hash) + FULL_AVG60_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          java.lang.Double.doubleToLongBits(getFullAvg60()));
+      hash = (37 * hash) + FULL_AVG300_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          java.lang.Double.doubleToLongBits(getFullAvg300()));
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Status.PressureStatus parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.PressureStatus parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.PressureStatus parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.PressureStatus parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.PressureStatus parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.PressureStatus parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.PressureStatus parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.PressureStatus parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.PressureStatus parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.PressureStatus parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.PressureStatus parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.PressureStatus parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Status.PressureStatus prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.PressureStatus}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.PressureStatus)
+        io.gitpod.supervisor.api.Status.PressureStatusOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_PressureStatus_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_PressureStatus_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Status.PressureStatus.class, io.gitpod.supervisor.api.Status.PressureStatus.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Status.PressureStatus.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        someAvg10_ = 0D;
+
+        someAvg60_ = 0D;
+
+        someAvg300_ = 0D;
+
+        fullAvg10_ = 0D;
+
+        fullAvg60_ = 0D;
+
+        fullAvg300_ = 0D;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_PressureStatus_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.PressureStatus getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Status.PressureStatus.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.PressureStatus build() {
+        io.gitpod.supervisor.api.Status.PressureStatus result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.PressureStatus buildPartial() {
+        io.gitpod.supervisor.api.Status.PressureStatus result = new io.gitpod.supervisor.api.Status.PressureStatus(this);
+        result.someAvg10_ = someAvg10_;
+        result.someAvg60_ = someAvg60_;
+        result.someAvg300_ = someAvg300_;
+        result.fullAvg10_ = fullAvg10_;
+        result.fullAvg60_ = fullAvg60_;
+        result.fullAvg300_ = fullAvg300_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Status.PressureStatus) {
+          return mergeFrom((io.gitpod.supervisor.api.Status.PressureStatus)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Status.PressureStatus other) {
+        if (other == io.gitpod.supervisor.api.Status.PressureStatus.getDefaultInstance()) return this;
+        if (other.getSomeAvg10() != 0D) {
+          setSomeAvg10(other.getSomeAvg10());
+        }
+        if (other.getSomeAvg60() != 0D) {
+          setSomeAvg60(other.getSomeAvg60());
+        }
+        if (other.getSomeAvg300() != 0D) {
+          setSomeAvg300(other.getSomeAvg300());
+        }
+        if (other.getFullAvg10() != 0D) {
+          setFullAvg10(other.getFullAvg10());
+        }
+        if (other.getFullAvg60() != 0D) {
+          setFullAvg60(other.getFullAvg60());
+        }
+        if (other.getFullAvg300() != 0D) {
+          setFullAvg300(other.getFullAvg300());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Status.PressureStatus parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Status.PressureStatus) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private double someAvg10_ ;
+      /**
+       * <pre>
+       * Percentage of time in which at least one process was stalled, averaged over 10, 60 and 300 seconds.
+       * </pre>
+       *
+       * <code>double some_avg10 = 1;</code>
+       * @return The someAvg10.
+       */
+      @java.lang.Override
+      public double getSomeAvg10() {
+        return someAvg10_;
+      }
+      /**
+       * <pre>
+       * Percentage of time in which at least one process was stalled, averaged over 10, 60 and 300 seconds.
+       * </pre>
+       *
+       * <code>double some_avg10 = 1;</code>
+       * @param value The someAvg10 to set.
+       * @return This builder for chaining.
+       */
+      public Builder setSomeAvg10(double value) {
+
+        someAvg10_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * Percentage of time in which at least one process was stalled, averaged over 10, 60 and 300 seconds.
+       * </pre>
+       *
+       * <code>double some_avg10 = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearSomeAvg10() {
+
+        someAvg10_ = 0D;
+        onChanged();
+        return this;
+      }
+
+      private double someAvg60_ ;
+      /**
+       * <code>double some_avg60 = 2;</code>
+       * @return The someAvg60.
+       */
+      @java.lang.Override
+      public double getSomeAvg60() {
+        return someAvg60_;
+      }
+      /**
+       * <code>double some_avg60 = 2;</code>
+       * @param value The someAvg60 to set.
+       * @return This builder for chaining.
+       */
+      public Builder setSomeAvg60(double value) {
+
+        someAvg60_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>double some_avg60 = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearSomeAvg60() {
+
+        someAvg60_ = 0D;
+        onChanged();
+        return this;
+      }
+
+      private double someAvg300_ ;
+      /**
+       * <code>double some_avg300 = 3;</code>
+       * @return The someAvg300.
+       */
+      @java.lang.Override
+      public double getSomeAvg300() {
+        return someAvg300_;
+      }
+      /**
+       * <code>double some_avg300 = 3;</code>
+       * @param value The someAvg300 to set.
+       * @return This builder for chaining.
+       */
+      public Builder setSomeAvg300(double value) {
+
+        someAvg300_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>double some_avg300 = 3;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearSomeAvg300() {
+
+        someAvg300_ = 0D;
+        onChanged();
+        return this;
+      }
+
+      private double fullAvg10_ ;
+      /**
+       * <pre>
+       * Percentage of time in which all non-idle processes were stalled at once, averaged over 10, 60 and 300 seconds.
+       * </pre>
+       *
+       * <code>double full_avg10 = 4;</code>
+       * @return The fullAvg10.
+       */
+      @java.lang.Override
+      public double getFullAvg10() {
+        return fullAvg10_;
+      }
+      /**
+       * <pre>
+       * Percentage of time in which all non-idle processes were stalled at once, averaged over 10, 60 and 300 seconds.
+       * </pre>
+       *
+       * <code>double full_avg10 = 4;</code>
+       * @param value The fullAvg10 to set.
+       * @return This builder for chaining.
+       */
+      public Builder setFullAvg10(double value) {
+
+        fullAvg10_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * Percentage of time in which all non-idle processes were stalled at once, averaged over 10, 60 and 300 seconds.
+       * </pre>
+       *
+       * <code>double full_avg10 = 4;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearFullAvg10() {
+
+        fullAvg10_ = 0D;
+        onChanged();
+        return this;
+      }
+
+      private double fullAvg60_ ;
+      /**
+       * <code>double full_avg60 = 5;</code>
+       * @return The fullAvg60.
+       */
+      @java.lang.Override
+      public double getFullAvg60() {
+        return fullAvg60_;
+      }
+      /**
+       * <code>double full_avg60 = 5;</code>
+       * @param value The fullAvg60 to set.
+       * @return This builder for chaining.
+       */
+      public Builder setFullAvg60(double value) {
+
+        fullAvg60_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>double full_avg60 = 5;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearFullAvg60() {
+
+        fullAvg60_ = 0D;
+        onChanged();
+        return this;
+      }
+
+      private double fullAvg300_ ;
+      /**
+       * <code>double full_avg300 = 6;</code>
+       * @return The fullAvg300.
+       */
+      @java.lang.Override
+      public double getFullAvg300() {
+        return fullAvg300_;
+      }
+      /**
+       * <code>double full_avg300 = 6;</code>
+       * @param value The fullAvg300 to set.
+       * @return This builder for chaining.
+       */
+      public Builder setFullAvg300(double value) {
+
+        fullAvg300_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>double full_avg300 = 6;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearFullAvg300() {
+
+        fullAvg300_ = 0D;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.PressureStatus)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.PressureStatus)
+    private static final io.gitpod.supervisor.api.Status.PressureStatus DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Status.PressureStatus();
+    }
+
+    public static io.gitpod.supervisor.api.Status.PressureStatus getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<PressureStatus>
+        PARSER = new com.google.protobuf.AbstractParser<PressureStatus>() {
+      @java.lang.Override
+      public PressureStatus parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new PressureStatus(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<PressureStatus> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<PressureStatus> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.PressureStatus getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface NetworkStatusOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.NetworkStatus)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <pre>
+     * Bytes received since the workspace started.
+     * </pre>
+     *
+     * <code>uint64 received_bytes = 1;</code>
+     * @return The receivedBytes.
+     */
+    long getReceivedBytes();
+
+    /**
+     * <pre>
+     * Bytes sent since the workspace started.
+     * </pre>
+     *
+     * <code>uint64 sent_bytes = 2;</code>
+     * @return The sentBytes.
+     */
+    long getSentBytes();
+  }
+  /**
+   * Protobuf type {@code supervisor.NetworkStatus}
+   */
+  public static final class NetworkStatus extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.NetworkStatus)
+      NetworkStatusOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use NetworkStatus.newBuilder() to construct.
+    private NetworkStatus(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private NetworkStatus() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new NetworkStatus();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private NetworkStatus(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 8: {
+
+              receivedBytes_ = input.readUInt64();
+              break;
+            }
+            case 16: {
+
+              sentBytes_ = input.readUInt64();
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_NetworkStatus_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_NetworkStatus_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Status.NetworkStatus.class, io.gitpod.supervisor.api.Status.NetworkStatus.Builder.class);
+    }
+
+    public static final int RECEIVED_BYTES_FIELD_NUMBER = 1;
+    private long receivedBytes_;
+    /**
+     * <pre>
+     * Bytes received since the workspace started.
+     * </pre>
+     *
+     * <code>uint64 received_bytes = 1;</code>
+     * @return The receivedBytes.
+     */
+    @java.lang.Override
+    public long getReceivedBytes() {
+      return receivedBytes_;
+    }
+
+    public static final int SENT_BYTES_FIELD_NUMBER = 2;
+    private long sentBytes_;
+    /**
+     * <pre>
+     * Bytes sent since the workspace started.
+     * </pre>
+     *
+     * <code>uint64 sent_bytes = 2;</code>
+     * @return The sentBytes.
+     */
+    @java.lang.Override
+    public long getSentBytes() {
+      return sentBytes_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (receivedBytes_ != 0L) {
+        output.writeUInt64(1, receivedBytes_);
+      }
+      if (sentBytes_ != 0L) {
+        output.writeUInt64(2, sentBytes_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (receivedBytes_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt64Size(1, receivedBytes_);
+      }
+      if (sentBytes_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt64Size(2, sentBytes_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Status.NetworkStatus)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Status.NetworkStatus other = (io.gitpod.supervisor.api.Status.NetworkStatus) obj;
+
+      if (getReceivedBytes()
+          != other.getReceivedBytes()) return false;
+      if (getSentBytes()
+          != other.getSentBytes()) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + RECEIVED_BYTES_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getReceivedBytes());
+      hash = (37 * hash) + SENT_BYTES_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getSentBytes());
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Status.NetworkStatus parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.NetworkStatus parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.NetworkStatus parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.NetworkStatus parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.NetworkStatus parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.NetworkStatus parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.NetworkStatus parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.NetworkStatus parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.NetworkStatus parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.NetworkStatus parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.NetworkStatus parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.NetworkStatus parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Status.NetworkStatus prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.NetworkStatus}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.NetworkStatus)
+        io.gitpod.supervisor.api.Status.NetworkStatusOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_NetworkStatus_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_NetworkStatus_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Status.NetworkStatus.class, io.gitpod.supervisor.api.Status.NetworkStatus.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Status.NetworkStatus.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        receivedBytes_ = 0L;
+
+        sentBytes_ = 0L;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_NetworkStatus_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.NetworkStatus getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Status.NetworkStatus.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.NetworkStatus build() {
+        io.gitpod.supervisor.api.Status.NetworkStatus result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.NetworkStatus buildPartial() {
+        io.gitpod.supervisor.api.Status.NetworkStatus result = new io.gitpod.supervisor.api.Status.NetworkStatus(this);
+        result.receivedBytes_ = receivedBytes_;
+        result.sentBytes_ = sentBytes_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Status.NetworkStatus) {
+          return mergeFrom((io.gitpod.supervisor.api.Status.NetworkStatus)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Status.NetworkStatus other) {
+        if (other == io.gitpod.supervisor.api.Status.NetworkStatus.getDefaultInstance()) return this;
+        if (other.getReceivedBytes() != 0L) {
+          setReceivedBytes(other.getReceivedBytes());
+        }
+        if (other.getSentBytes() != 0L) {
+          setSentBytes(other.getSentBytes());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Status.NetworkStatus parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Status.NetworkStatus) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private long receivedBytes_ ;
+      /**
+       * <pre>
+       * Bytes received since the workspace started.
+       * </pre>
+       *
+       * <code>uint64 received_bytes = 1;</code>
+       * @return The receivedBytes.
+       */
+      @java.lang.Override
+      public long getReceivedBytes() {
+        return receivedBytes_;
+      }
+      /**
+       * <pre>
+       * Bytes received since the workspace started.
+       * </pre>
+       *
+       * <code>uint64 received_bytes = 1;</code>
+       * @param value The receivedBytes to set.
+       * @return This builder for chaining.
+       */
+      public Builder setReceivedBytes(long value) {
+
+        receivedBytes_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * Bytes received since the workspace started.
+       * </pre>
+       *
+       * <code>uint64 received_bytes = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearReceivedBytes() {
+
+        receivedBytes_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      private long sentBytes_ ;
+      /**
+       * <pre>
+       * Bytes sent since the workspace started.
+       * </pre>
+       *
+       * <code>uint64 sent_bytes = 2;</code>
+       * @return The sentBytes.
+       */
+      @java.lang.Override
+      public long getSentBytes() {
+        return sentBytes_;
+      }
+      /**
+       * <pre>
+       * Bytes sent since the workspace started.
+       * </pre>
+       *
+       * <code>uint64 sent_bytes = 2;</code>
+       * @param value The sentBytes to set.
+       * @return This builder for chaining.
+       */
+      public Builder setSentBytes(long value) {
+
+        sentBytes_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * Bytes sent since the workspace started.
+       * </pre>
+       *
+       * <code>uint64 sent_bytes = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearSentBytes() {
+
+        sentBytes_ = 0L;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.NetworkStatus)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.NetworkStatus)
+    private static final io.gitpod.supervisor.api.Status.NetworkStatus DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Status.NetworkStatus();
+    }
+
+    public static io.gitpod.supervisor.api.Status.NetworkStatus getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<NetworkStatus>
+        PARSER = new com.google.protobuf.AbstractParser<NetworkStatus>() {
+      @java.lang.Override
+      public NetworkStatus parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new NetworkStatus(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<NetworkStatus> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<NetworkStatus> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.NetworkStatus getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ProcessStatusOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ProcessStatus)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>int64 pid = 1;</code>
+     * @return The pid.
+     */
+    long getPid();
+
+    /**
+     * <code>string command = 2;</code>
+     * @return The command.
+     */
+    java.lang.String getCommand();
+    /**
+     * <code>string command = 2;</code>
+     * @return The bytes for command.
+     */
+    com.google.protobuf.ByteString
+        getCommandBytes();
+
+    /**
+     * <pre>
+     * Used CPU in millicores.
+     * </pre>
+     *
+     * <code>int64 cpu = 3;</code>
+     * @return The cpu.
+     */
+    long getCpu();
+
+    /**
+     * <pre>
+     * Resident set size in bytes.
+     * </pre>
+     *
+     * <code>int64 memory = 4;</code>
+     * @return The memory.
+     */
+    long getMemory();
+
+    /**
+     * <pre>
+     * ID of the task the process belongs to, empty if it does not belong to a task.
+     * </pre>
+     *
+     * <code>string task_id = 5;</code>
+     * @return The taskId.
+     */
+    java.lang.String getTaskId();
+    /**
+     * <pre>
+     * ID of the task the process belongs to, empty if it does not belong to a task.
+     * </pre>
+     *
+     * <code>string task_id = 5;</code>
+     * @return The bytes for taskId.
+     */
+    com.google.protobuf.ByteString
+        getTaskIdBytes();
+
+    /**
+     * <pre>
+     * Name of the task the process belongs to.
+     * </pre>
+     *
+     * <code>string task_name = 6;</code>
+     * @return The taskName.
+     */
+    java.lang.String getTaskName();
+    /**
+     * <pre>
+     * Name of the task the process belongs to.
+     * </pre>
+     *
+     * <code>string task_name = 6;</code>
+     * @return The bytes for taskName.
+     */
+    com.google.protobuf.ByteString
+        getTaskNameBytes();
+  }
+  /**
+   * Protobuf type {@code supervisor.ProcessStatus}
+   */
+  public static final class ProcessStatus extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ProcessStatus)
+      ProcessStatusOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ProcessStatus.newBuilder() to construct.
+    private ProcessStatus(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ProcessStatus() {
+      command_ = "";
+      taskId_ = "";
+      taskName_ = "";
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ProcessStatus();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ProcessStatus(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 8: {
+
+              pid_ = input.readInt64();
+              break;
+            }
+            case 18: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              command_ = s;
+              break;
+            }
+            case 24: {
+
+              cpu_ = input.readInt64();
+              break;
+            }
+            case 32: {
+
+              memory_ = input.readInt64();
+              break;
+            }
+            case 42: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              taskId_ = s;
+              break;
+            }
+            case 50: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              taskName_ = s;
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_ProcessStatus_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_ProcessStatus_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Status.ProcessStatus.class, io.gitpod.supervisor.api.Status.ProcessStatus.Builder.class);
+    }
+
+    public static final int PID_FIELD_NUMBER = 1;
+    private long pid_;
+    /**
+     * <code>int64 pid = 1;</code>
+     * @return The pid.
+     */
+    @java.lang.Override
+    public long getPid() {
+      return pid_;
+    }
+
+    public static final int COMMAND_FIELD_NUMBER = 2;
+    private volatile java.lang.Object command_;
+    /**
+     * <code>string command = 2;</code>
+     * @return The command.
+     */
+    @java.lang.Override
+    public java.lang.String getCommand() {
+      java.lang.Object ref = command_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        command_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string command = 2;</code>
+     * @return The bytes for command.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getCommandBytes() {
+      java.lang.Object ref = command_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        command_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int CPU_FIELD_NUMBER = 3;
+    private long cpu_;
+    /**
+     * <pre>
+     * Used CPU in millicores.
+     * </pre>
+     *
+     * <code>int64 cpu = 3;</code>
+     * @return The cpu.
+     */
+    @java.lang.Override
+    public long getCpu() {
+      return cpu_;
+    }
+
+    public static final int MEMORY_FIELD_NUMBER = 4;
+    private long memory_;
+    /**
+     * <pre>
+     * Resident set size in bytes.
+     * </pre>
+     *
+     * <code>int64 memory = 4;</code>
+     * @return The memory.
+     */
+    @java.lang.Override
+    public long getMemory() {
+      return memory_;
+    }
+
+    public static final int TASK_ID_FIELD_NUMBER = 5;
+    private volatile java.lang.Object taskId_;
+    /**
+     * <pre>
+     * ID of the task the process belongs to, empty if it does not belong to a task.
+     * </pre>
+     *
+     * <code>string task_id = 5;</code>
+     * @return The taskId.
+     */
+    @java.lang.Override
+    public java.lang.String getTaskId() {
+      java.lang.Object ref = taskId_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        taskId_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * ID of the task the process belongs to, empty if it does not belong to a task.
+     * </pre>
+     *
+     * <code>string task_id = 5;</code>
+     * @return The bytes for taskId.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getTaskIdBytes() {
+      java.lang.Object ref = taskId_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        taskId_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int TASK_NAME_FIELD_NUMBER = 6;
+    private volatile java.lang.Object taskName_;
+    /**
+     * <pre>
+     * Name of the task the process belongs to.
+     * </pre>
+     *
+     * <code>string task_name = 6;</code>
+     * @return The taskName.
+     */
+    @java.lang.Override
+    public java.lang.String getTaskName() {
+      java.lang.Object ref = taskName_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        taskName_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * Name of the task the process belongs to.
+     * </pre>
+     *
+     * <code>string task_name = 6;</code>
+     * @return The bytes for taskName.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getTaskNameBytes() {
+      java.lang.Object ref = taskName_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        taskName_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (pid_ != 0L) {
+        output.writeInt64(1, pid_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(command_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 2, command_);
+      }
+      if (cpu_ != 0L) {
+        output.writeInt64(3, cpu_);
+      }
+      if (memory_ != 0L) {
+        output.writeInt64(4, memory_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(taskId_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 5, taskId_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(taskName_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 6, taskName_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (pid_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt64Size(1, pid_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(command_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(2, command_);
+      }
+      if (cpu_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt64Size(3, cpu_);
+      }
+      if (memory_ != 0L) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt64Size(4, memory_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(taskId_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(5, taskId_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(taskName_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(6, taskName_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Status.ProcessStatus)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Status.ProcessStatus other = (io.gitpod.supervisor.api.Status.ProcessStatus) obj;
+
+      if (getPid()
+          != other.getPid()) return false;
+      if (!getCommand()
+          .equals(other.getCommand())) return false;
+      if (getCpu()
+          != other.getCpu()) return false;
+      if (getMemory()
+          != other.getMemory()) return false;
+      if (!getTaskId()
+          .equals(other.getTaskId())) return false;
+      if (!getTaskName()
+          .equals(other.getTaskName())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + PID_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getPid());
+      hash = (37 * hash) + COMMAND_FIELD_NUMBER;
+      hash = (53 * hash) + getCommand().hashCode();
+      hash = (37 * hash) + CPU_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getCpu());
+      hash = (37 * hash) + MEMORY_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
+          getMemory());
+      hash = (37 * hash) + TASK_ID_FIELD_NUMBER;
+      hash = (53 * hash) + getTaskId().hashCode();
+      hash = (37 * hash) + TASK_NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getTaskName().hashCode();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Status.ProcessStatus parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.ProcessStatus parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ProcessStatus parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.ProcessStatus parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ProcessStatus parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.ProcessStatus parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ProcessStatus parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.ProcessStatus parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ProcessStatus parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.ProcessStatus parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ProcessStatus parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.ProcessStatus parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Status.ProcessStatus prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ProcessStatus}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ProcessStatus)
+        io.gitpod.supervisor.api.Status.ProcessStatusOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_ProcessStatus_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_ProcessStatus_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Status.ProcessStatus.class, io.gitpod.supervisor.api.Status.ProcessStatus.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Status.ProcessStatus.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        pid_ = 0L;
+
+        command_ = "";
+
+        cpu_ = 0L;
+
+        memory_ = 0L;
+
+        taskId_ = "";
+
+        taskName_ = "";
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_ProcessStatus_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.ProcessStatus getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Status.ProcessStatus.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.ProcessStatus build() {
+        io.gitpod.supervisor.api.Status.ProcessStatus result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.ProcessStatus buildPartial() {
+        io.gitpod.supervisor.api.Status.ProcessStatus result = new io.gitpod.supervisor.api.Status.ProcessStatus(this);
+        result.pid_ = pid_;
+        result.command_ = command_;
+        result.cpu_ = cpu_;
+        result.memory_ = memory_;
+        result.taskId_ = taskId_;
+        result.taskName_ = taskName_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Status.ProcessStatus) {
+          return mergeFrom((io.gitpod.supervisor.api.Status.ProcessStatus)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Status.ProcessStatus other) {
+        if (other == io.gitpod.supervisor.api.Status.ProcessStatus.getDefaultInstance()) return this;
+        if (other.getPid() != 0L) {
+          setPid(other.getPid());
+        }
+        if (!other.getCommand().isEmpty()) {
+          command_ = other.command_;
+          onChanged();
+        }
+        if (other.getCpu() != 0L) {
+          setCpu(other.getCpu());
+        }
+        if (other.getMemory() != 0L) {
+          setMemory(other.getMemory());
+        }
+        if (!other.getTaskId().isEmpty()) {
+          taskId_ = other.taskId_;
+          onChanged();
+        }
+        if (!other.getTaskName().isEmpty()) {
+          taskName_ = other.taskName_;
+          onChanged();
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Status.ProcessStatus parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Status.ProcessStatus) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private long pid_ ;
+      /**
+       * <code>int64 pid = 1;</code>
+       * @return The pid.
+       */
+      @java.lang.Override
+      public long getPid() {
+        return pid_;
+      }
+      /**
+       * <code>int64 pid = 1;</code>
+       * @param value The pid to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPid(long value) {
+
+        pid_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>int64 pid = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPid() {
+
+        pid_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object command_ = "";
+      /**
+       * <code>string command = 2;</code>
+       * @return The command.
+       */
+      public java.lang.String getCommand() {
+        java.lang.Object ref = command_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          command_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string command = 2;</code>
+       * @return The bytes for command.
+       */
+      public com.google.protobuf.ByteString
+          getCommandBytes() {
+        java.lang.Object ref = command_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          command_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string command = 2;</code>
+       * @param value The command to set.
+       * @return This builder for chaining.
+       */
+      public Builder setCommand(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        command_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string command = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearCommand() {
+
+        command_ = getDefaultInstance().getCommand();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string command = 2;</code>
+       * @param value The bytes for command to set.
+       * @return This builder for chaining.
+       */
+      public Builder setCommandBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        command_ = value;
+        onChanged();
+        return this;
+      }
+
+      private long cpu_ ;
+      /**
+       * <pre>
+       * Used CPU in millicores.
+       * </pre>
+       *
+       * <code>int64 cpu = 3;</code>
+       * @return The cpu.
+       */
+      @java.lang.Override
+      public long getCpu() {
+        return cpu_;
+      }
+      /**
+       * <pre>
+       * Used CPU in millicores.
+       * </pre>
+       *
+       * <code>int64 cpu = 3;</code>
+       * @param value The cpu to set.
+       * @return This builder for chaining.
+       */
+      public Builder setCpu(long value) {
+
+        cpu_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * Used CPU in millicores.
+       * </pre>
+       *
+       * <code>int64 cpu = 3;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearCpu() {
+
+        cpu_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      private long memory_ ;
+      /**
+       * <pre>
+       * Resident set size in bytes.
+       * </pre>
+       *
+       * <code>int64 memory = 4;</code>
+       * @return The memory.
+       */
+      @java.lang.Override
+      public long getMemory() {
+        return memory_;
+      }
+      /**
+       * <pre>
+       * Resident set size in bytes.
+       * </pre>
+       *
+       * <code>int64 memory = 4;</code>
+       * @param value The memory to set.
+       * @return This builder for chaining.
+       */
+      public Builder setMemory(long value) {
+
+        memory_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * Resident set size in bytes.
+       * </pre>
+       *
+       * <code>int64 memory = 4;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearMemory() {
+
+        memory_ = 0L;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object taskId_ = "";
+      /**
+       * <pre>
+       * ID of the task the process belongs to, empty if it does not belong to a task.
+       * </pre>
+       *
+       * <code>string task_id = 5;</code>
+       * @return The taskId.
+       */
+      public java.lang.String getTaskId() {
+        java.lang.Object ref = taskId_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          taskId_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * ID of the task the process belongs to, empty if it does not belong to a task.
+       * </pre>
+       *
+       * <code>string task_id = 5;</code>
+       * @return The bytes for taskId.
+       */
+      public com.google.protobuf.ByteString
+          getTaskIdBytes() {
+        java.lang.Object ref = taskId_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          taskId_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * ID of the task the process belongs to, empty if it does not belong to a task.
+       * </pre>
+       *
+       * <code>string task_id = 5;</code>
+       * @param value The taskId to set.
+       * @return This builder for chaining.
+       */
+      public Builder setTaskId(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        taskId_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * ID of the task the process belongs to, empty if it does not belong to a task.
+       * </pre>
+       *
+       * <code>string task_id = 5;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearTaskId() {
+
+        taskId_ = getDefaultInstance().getTaskId();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * ID of the task the process belongs to, empty if it does not belong to a task.
+       * </pre>
+       *
+       * <code>string task_id = 5;</code>
+       * @param value The bytes for taskId to set.
+       * @return This builder for chaining.
+       */
+      public Builder setTaskIdBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        taskId_ = value;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object taskName_ = "";
+      /**
+       * <pre>
+       * Name of the task the process belongs to.
+       * </pre>
+       *
+       * <code>string task_name = 6;</code>
+       * @return The taskName.
+       */
+      public java.lang.String getTaskName() {
+        java.lang.Object ref = taskName_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          taskName_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * Name of the task the process belongs to.
+       * </pre>
+       *
+       * <code>string task_name = 6;</code>
+       * @return The bytes for taskName.
+       */
+      public com.google.protobuf.ByteString
+          getTaskNameBytes() {
+        java.lang.Object ref = taskName_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          taskName_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * Name of the task the process belongs to.
+       * </pre>
+       *
+       * <code>string task_name = 6;</code>
+       * @param value The taskName to set.
+       * @return This builder for chaining.
+       */
+      public Builder setTaskName(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        taskName_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * Name of the task the process belongs to.
+       * </pre>
+       *
+       * <code>string task_name = 6;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearTaskName() {
+
+        taskName_ = getDefaultInstance().getTaskName();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * Name of the task the process belongs to.
+       * </pre>
+       *
+       * <code>string task_name = 6;</code>
+       * @param value The bytes for taskName to set.
+       * @return This builder for chaining.
+       */
+      public Builder setTaskNameBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        taskName_ = value;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ProcessStatus)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ProcessStatus)
+    private static final io.gitpod.supervisor.api.Status.ProcessStatus DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Status.ProcessStatus();
+    }
+
+    public static io.gitpod.supervisor.api.Status.ProcessStatus getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ProcessStatus>
+        PARSER = new com.google.protobuf.AbstractParser<ProcessStatus>() {
+      @java.lang.Override
+      public ProcessStatus parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ProcessStatus(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ProcessStatus> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ProcessStatus> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.ProcessStatus getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface DotfilesStatusRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.DotfilesStatusRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <pre>
+     * if true this request will return either when it times out or when the dotfiles
+     * installation has finished.
+     * </pre>
+     *
+     * <code>bool wait = 1;</code>
+     * @return The wait.
+     */
+    boolean getWait();
+  }
+  /**
+   * Protobuf type {@code supervisor.DotfilesStatusRequest}
+   */
+  public static final class DotfilesStatusRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.DotfilesStatusRequest)
+      DotfilesStatusRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use DotfilesStatusRequest.newBuilder() to construct.
+    private DotfilesStatusRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private DotfilesStatusRequest() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new DotfilesStatusRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private DotfilesStatusRequest(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 8: {
+
+              wait_ = input.readBool();
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_DotfilesStatusRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_DotfilesStatusRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Status.DotfilesStatusRequest.class, io.gitpod.supervisor.api.Status.DotfilesStatusRequest.Builder.class);
+    }
+
+    public static final int WAIT_FIELD_NUMBER = 1;
+    private boolean wait_;
+    /**
+     * <pre>
+     * if true this request will return either when it times out or when the dotfiles
+     * installation has finished.
+     * </pre>
+     *
+     * <code>bool wait = 1;</code>
+     * @return The wait.
+     */
+    @java.lang.Override
+    public boolean getWait() {
+      return wait_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (wait_ != false) {
+        output.writeBool(1, wait_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (wait_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(1, wait_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Status.DotfilesStatusRequest)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Status.DotfilesStatusRequest other = (io.gitpod.supervisor.api.Status.DotfilesStatusRequest) obj;
+
+      if (getWait()
+          != other.getWait()) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + WAIT_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getWait());
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Status.DotfilesStatusRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.DotfilesStatusRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.DotfilesStatusRequest)
+        io.gitpod.supervisor.api.Status.DotfilesStatusRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_DotfilesStatusRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_DotfilesStatusRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Status.DotfilesStatusRequest.class, io.gitpod.supervisor.api.Status.DotfilesStatusRequest.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Status.DotfilesStatusRequest.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        wait_ = false;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_DotfilesStatusRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.DotfilesStatusRequest getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Status.DotfilesStatusRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.DotfilesStatusRequest build() {
+        io.gitpod.supervisor.api.Status.DotfilesStatusRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.DotfilesStatusRequest buildPartial() {
+        io.gitpod.supervisor.api.Status.DotfilesStatusRequest result = new io.gitpod.supervisor.api.Status.DotfilesStatusRequest(this);
+        result.wait_ = wait_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Status.DotfilesStatusRequest) {
+          return mergeFrom((io.gitpod.supervisor.api.Status.DotfilesStatusRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Status.DotfilesStatusRequest other) {
+        if (other == io.gitpod.supervisor.api.Status.DotfilesStatusRequest.getDefaultInstance()) return this;
+        if (other.getWait() != false) {
+          setWait(other.getWait());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Status.DotfilesStatusRequest parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Status.DotfilesStatusRequest) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private boolean wait_ ;
+      /**
+       * <pre>
+       * if true this request will return either when it times out or when the dotfiles
+       * installation has finished.
+       * </pre>
+       *
+       * <code>bool wait = 1;</code>
+       * @return The wait.
+       */
+      @java.lang.Override
+      public boolean getWait() {
+        return wait_;
+      }
+      /**
+       * <pre>
+       * if true this request will return either when it times out or when the dotfiles
+       * installation has finished.
+       * </pre>
+       *
+       * <code>bool wait = 1;</code>
+       * @param value The wait to set.
+       * @return This builder for chaining.
+       */
+      public Builder setWait(boolean value) {
+
+        wait_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * if true this request will return either when it times out or when the dotfiles
+       * installation has finished.
+       * </pre>
+       *
+       * <code>bool wait = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearWait() {
+
+        wait_ = false;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.DotfilesStatusRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.DotfilesStatusRequest)
+    private static final io.gitpod.supervisor.api.Status.DotfilesStatusRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Status.DotfilesStatusRequest();
+    }
+
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<DotfilesStatusRequest>
+        PARSER = new com.google.protobuf.AbstractParser<DotfilesStatusRequest>() {
+      @java.lang.Override
+      public DotfilesStatusRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new DotfilesStatusRequest(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<DotfilesStatusRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<DotfilesStatusRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.DotfilesStatusRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface DotfilesStatusResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.DotfilesStatusResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>.supervisor.DotfilesPhase phase = 1;</code>
+     * @return The enum numeric value on the wire for phase.
+     */
+    int getPhaseValue();
+    /**
+     * <code>.supervisor.DotfilesPhase phase = 1;</code>
+     * @return The phase.
+     */
+    io.gitpod.supervisor.api.Status.DotfilesPhase getPhase();
+
+    /**
+     * <pre>
+     * repository is the dotfiles repository
+     * </pre>
+     *
+     * <code>string repository = 2;</code>
+     * @return The repository.
+     */
+    java.lang.String getRepository();
+    /**
+     * <pre>
+     * repository is the dotfiles repository
+     * </pre>
+     *
+     * <code>string repository = 2;</code>
+     * @return The bytes for repository.
+     */
+    com.google.protobuf.ByteString
+        getRepositoryBytes();
+
+    /**
+     * <pre>
+     * ref is the branch, tag or commit the dotfiles are pinned to. Empty if the default branch is installed.
+     * </pre>
+     *
+     * <code>string ref = 3;</code>
+     * @return The ref.
+     */
+    java.lang.String getRef();
+    /**
+     * <pre>
+     * ref is the branch, tag or commit the dotfiles are pinned to. Empty if the default branch is installed.
+     * </pre>
+     *
+     * <code>string ref = 3;</code>
+     * @return The bytes for ref.
+     */
+    com.google.protobuf.ByteString
+        getRefBytes();
+
+    /**
+     * <pre>
+     * commit is the commit which is installed
+     * </pre>
+     *
+     * <code>string commit = 4;</code>
+     * @return The commit.
+     */
+    java.lang.String getCommit();
+    /**
+     * <pre>
+     * commit is the commit which is installed
+     * </pre>
+     *
+     * <code>string commit = 4;</code>
+     * @return The bytes for commit.
+     */
+    com.google.protobuf.ByteString
+        getCommitBytes();
+
+    /**
+     * <pre>
+     * verified is true if the signature of the commit was verified
+     * </pre>
+     *
+     * <code>bool verified = 5;</code>
+     * @return The verified.
+     */
+    boolean getVerified();
+
+    /**
+     * <pre>
+     * cached is true if the dotfiles were installed from the clone cached in the workspace
+     * </pre>
+     *
+     * <code>bool cached = 6;</code>
+     * @return The cached.
+     */
+    boolean getCached();
+
+    /**
+     * <pre>
+     * install_script is the installation script which ran. Empty if the dotfiles were symlinked.
+     * </pre>
+     *
+     * <code>string install_script = 7;</code>
+     * @return The installScript.
+     */
+    java.lang.String getInstallScript();
+    /**
+     * <pre>
+     * install_script is the installation script which ran. Empty if the dotfiles were symlinked.
+     * </pre>
+     *
+     * <code>string install_script = 7;</code>
+     * @return The bytes for installScript.
+     */
+    com.google.protobuf.ByteString
+        getInstallScriptBytes();
+
+    /**
+     * <pre>
+     * exit_code is the exit code of the installation script
+     * </pre>
+     *
+     * <code>int32 exit_code = 8;</code>
+     * @return The exitCode.
+     */
+    int getExitCode();
+
+    /**
+     * <pre>
+     * failure describes why the installation failed
+     * </pre>
+     *
+     * <code>string failure = 9;</code>
+     * @return The failure.
+     */
+    java.lang.String getFailure();
+    /**
+     * <pre>
+     * failure describes why the installation failed
+     * </pre>
+     *
+     * <code>string failure = 9;</code>
+     * @return The bytes for failure.
+     */
+    com.google.protobuf.ByteString
+        getFailureBytes();
+
+    /**
+     * <pre>
+     * log_path is the path of the installation log
+     * </pre>
+     *
+     * <code>string log_path = 10;</code>
+     * @return The logPath.
+     */
+    java.lang.String getLogPath();
+    /**
+     * <pre>
+     * log_path is the path of the installation log
+     * </pre>
+     *
+     * <code>string log_path = 10;</code>
+     * @return The bytes for logPath.
+     */
+    com.google.protobuf.ByteString
+        getLogPathBytes();
+  }
+  /**
+   * Protobuf type {@code supervisor.DotfilesStatusResponse}
+   */
+  public static final class DotfilesStatusResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.DotfilesStatusResponse)
+      DotfilesStatusResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use DotfilesStatusResponse.newBuilder() to construct.
+    private DotfilesStatusResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private DotfilesStatusResponse() {
+      phase_ = 0;
+      repository_ = "";
+      ref_ = "";
+      commit_ = "";
+      installScript_ = "";
+      failure_ = "";
+      logPath_ = "";
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new DotfilesStatusResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private DotfilesStatusResponse(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 8: {
+              int rawValue = input.readEnum();
+
+              phase_ = rawValue;
+              break;
+            }
+            case 18: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              repository_ = s;
+              break;
+            }
+            case 26: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              ref_ = s;
+              break;
+            }
+            case 34: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              commit_ = s;
+              break;
+            }
+            case 40: {
+
+              verified_ = input.readBool();
+              break;
+            }
+            case 48: {
+
+              cached_ = input.readBool();
+              break;
+            }
+            case 58: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              installScript_ = s;
+              break;
+            }
+            case 64: {
+
+              exitCode_ = input.readInt32();
+              break;
+            }
+            case 74: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              failure_ = s;
+              break;
+            }
+            case 82: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              logPath_ = s;
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_DotfilesStatusResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_DotfilesStatusResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Status.DotfilesStatusResponse.class, io.gitpod.supervisor.api.Status.DotfilesStatusResponse.Builder.class);
+    }
+
+    public static final int PHASE_FIELD_NUMBER = 1;
+    private int phase_;
+    /**
+     * <code>.supervisor.DotfilesPhase phase = 1;</code>
+     * @return The enum numeric value on the wire for phase.
+     */
+    @java.lang.Override public int getPhaseValue() {
+      return phase_;
+    }
+    /**
+     * <code>.supervisor.DotfilesPhase phase = 1;</code>
+     * @return The phase.
+     */
+    @java.lang.Override public io.gitpod.supervisor.api.Status.DotfilesPhase getPhase() {
+      @SuppressWarnings("deprecation")
+      io.gitpod.supervisor.api.Status.DotfilesPhase result = io.gitpod.supervisor.api.Status.DotfilesPhase.valueOf(phase_);
+      return result == null ? io.gitpod.supervisor.api.Status.DotfilesPhase.UNRECOGNIZED : result;
+    }
+
+    public static final int REPOSITORY_FIELD_NUMBER = 2;
+    private volatile java.lang.Object repository_;
+    /**
+     * <pre>
+     * repository is the dotfiles repository
+     * </pre>
+     *
+     * <code>string repository = 2;</code>
+     * @return The repository.
+     */
+    @java.lang.Override
+    public java.lang.String getRepository() {
+      java.lang.Object ref = repository_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        repository_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * repository is the dotfiles repository
+     * </pre>
+     *
+     * <code>string repository = 2;</code>
+     * @return The bytes for repository.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getRepositoryBytes() {
+      java.lang.Object ref = repository_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        repository_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int REF_FIELD_NUMBER = 3;
+    private volatile java.lang.Object ref_;
+    /**
+     * <pre>
+     * ref is the branch, tag or commit the dotfiles are pinned to. Empty if the default branch is installed.
+     * </pre>
+     *
+     * <code>string ref = 3;</code>
+     * @return The ref.
+     */
+    @java.lang.Override
+    public java.lang.String getRef() {
+      java.lang.Object ref = ref_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        ref_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * ref is the branch, tag or commit the dotfiles are pinned to. Empty if the default branch is installed.
+     * </pre>
+     *
+     * <code>string ref = 3;</code>
+     * @return The bytes for ref.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getRefBytes() {
+      java.lang.Object ref = ref_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        ref_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int COMMIT_FIELD_NUMBER = 4;
+    private volatile java.lang.Object commit_;
+    /**
+     * <pre>
+     * commit is the commit which is installed
+     * </pre>
+     *
+     * <code>string commit = 4;</code>
+     * @return The commit.
+     */
+    @java.lang.Override
+    public java.lang.String getCommit() {
+      java.lang.Object ref = commit_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        commit_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * commit is the commit which is installed
+     * </pre>
+     *
+     * <code>string commit = 4;</code>
+     * @return The bytes for commit.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getCommitBytes() {
+      java.lang.Object ref = commit_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        commit_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int VERIFIED_FIELD_NUMBER = 5;
+    private boolean verified_;
+    /**
+     * <pre>
+     * verified is true if the signature of the commit was verified
+     * </pre>
+     *
+     * <code>bool verified = 5;</code>
+     * @return The verified.
+     */
+    @java.lang.Override
+    public boolean getVerified() {
+      return verified_;
+    }
+
+    public static final int CACHED_FIELD_NUMBER = 6;
+    private boolean cached_;
+    /**
+     * <pre>
+     * cached is true if the dotfiles were installed from the clone cached in the workspace
+     * </pre>
+     *
+     * <code>bool cached = 6;</code>
+     * @return The cached.
+     */
+    @java.lang.Override
+    public boolean getCached() {
+      return cached_;
+    }
+
+    public static final int INSTALL_SCRIPT_FIELD_NUMBER = 7;
+    private volatile java.lang.Object installScript_;
+    /**
+     * <pre>
+     * install_script is the installation script which ran. Empty if the dotfiles were symlinked.
+     * </pre>
+     *
+     * <code>string install_script = 7;</code>
+     * @return The installScript.
+     */
+    @java.lang.Override
+    public java.lang.String getInstallScript() {
+      java.lang.Object ref = installScript_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        installScript_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * install_script is the installation script which ran. Empty if the dotfiles were symlinked.
+     * </pre>
+     *
+     * <code>string install_script = 7;</code>
+     * @return The bytes for installScript.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getInstallScriptBytes() {
+      java.lang.Object ref = installScript_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        installScript_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int EXIT_CODE_FIELD_NUMBER = 8;
+    private int exitCode_;
+    /**
+     * <pre>
+     * exit_code is the exit code of the installation script
+     * </pre>
+     *
+     * <code>int32 exit_code = 8;</code>
+     * @return The exitCode.
+     */
+    @java.lang.Override
+    public int getExitCode() {
+      return exitCode_;
+    }
+
+    public static final int FAILURE_FIELD_NUMBER = 9;
+    private volatile java.lang.Object failure_;
+    /**
+     * <pre>
+     * failure describes why the installation failed
+     * </pre>
+     *
+     * <code>string failure = 9;</code>
+     * @return The failure.
+     */
+    @java.lang.Override
+    public java.lang.String getFailure() {
+      java.lang.Object ref = failure_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        failure_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * failure describes why the installation failed
+     * </pre>
+     *
+     * <code>string failure = 9;</code>
+     * @return The bytes for failure.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getFailureBytes() {
+      java.lang.Object ref = failure_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        failure_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int LOG_PATH_FIELD_NUMBER = 10;
+    private volatile java.lang.Object logPath_;
+    /**
+     * <pre>
+     * log_path is the path of the installation log
+     * </pre>
+     *
+     * <code>string log_path = 10;</code>
+     * @return The logPath.
+     */
+    @java.lang.Override
+    public java.lang.String getLogPath() {
+      java.lang.Object ref = logPath_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        logPath_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * log_path is the path of the installation log
+     * </pre>
+     *
+     * <code>string log_path = 10;</code>
+     * @return The bytes for logPath.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getLogPathBytes() {
+      java.lang.Object ref = logPath_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        logPath_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (phase_ != io.gitpod.supervisor.api.Status.DotfilesPhase.not_configured.getNumber()) {
+        output.writeEnum(1, phase_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(repository_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 2, repository_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(ref_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 3, ref_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(commit_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 4, commit_);
+      }
+      if (verified_ != false) {
+        output.writeBool(5, verified_);
+      }
+      if (cached_ != false) {
+        output.writeBool(6, cached_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(installScript_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 7, installScript_);
+      }
+      if (exitCode_ != 0) {
+        output.writeInt32(8, exitCode_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(failure_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 9, failure_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(logPath_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 10, logPath_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (phase_ != io.gitpod.supervisor.api.Status.DotfilesPhase.not_configured.getNumber()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeEnumSize(1, phase_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(repository_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(2, repository_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(ref_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(3, ref_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(commit_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(4, commit_);
+      }
+      if (verified_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(5, verified_);
+      }
+      if (cached_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(6, cached_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(installScript_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(7, installScript_);
+      }
+      if (exitCode_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(8, exitCode_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(failure_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(9, failure_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(logPath_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(10, logPath_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Status.DotfilesStatusResponse)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Status.DotfilesStatusResponse other = (io.gitpod.supervisor.api.Status.DotfilesStatusResponse) obj;
+
+      if (phase_ != other.phase_) return false;
+      if (!getRepository()
+          .equals(other.getRepository())) return false;
+      if (!getRef()
+          .equals(other.getRef())) return false;
+      if (!getCommit()
+          .equals(other.getCommit())) return false;
+      if (getVerified()
+          != other.getVerified()) return false;
+      if (getCached()
+          != other.getCached()) return false;
+      if (!getInstallScript()
+          .equals(other.getInstallScript())) return false;
+      if (getExitCode()
+          != other.getExitCode()) return false;
+      if (!getFailure()
+          .equals(other.getFailure())) return false;
+      if (!getLogPath()
+          .equals(other.getLogPath())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + PHASE_FIELD_NUMBER;
+      hash = (53 * hash) + phase_;
+      hash = (37 * hash) + REPOSITORY_FIELD_NUMBER;
+      hash = (53 * hash) + getRepository().hashCode();
+      hash = (37 * hash) + REF_FIELD_NUMBER;
+      hash = (53 * hash) + getRef().hashCode();
+      hash = (37 * hash) + COMMIT_FIELD_NUMBER;
+      hash = (53 * hash) + getCommit().hashCode();
+      hash = (37 * hash) + VERIFIED_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getVerified());
+      hash = (37 * hash) + CACHED_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getCached());
+      hash = (37 * hash) + INSTALL_SCRIPT_FIELD_NUMBER;
+      hash = (53 * hash) + getInstallScript().hashCode();
+      hash = (37 * hash) + EXIT_CODE_FIELD_NUMBER;
+      hash = (53 * hash) + getExitCode();
+      hash = (37 * hash) + FAILURE_FIELD_NUMBER;
+      hash = (53 * hash) + getFailure().hashCode();
+      hash = (37 * hash) + LOG_PATH_FIELD_NUMBER;
+      hash = (53 * hash) + getLogPath().hashCode();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Status.DotfilesStatusResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.DotfilesStatusResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.DotfilesStatusResponse)
+        io.gitpod.supervisor.api.Status.DotfilesStatusResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_DotfilesStatusResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_DotfilesStatusResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Status.DotfilesStatusResponse.class, io.gitpod.supervisor.api.Status.DotfilesStatusResponse.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Status.DotfilesStatusResponse.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        phase_ = 0;
+
+        repository_ = "";
+
+        ref_ = "";
+
+        commit_ = "";
+
+        verified_ = false;
+
+        cached_ = false;
+
+        installScript_ = "";
+
+        exitCode_ = 0;
+
+        failure_ = "";
+
+        logPath_ = "";
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_DotfilesStatusResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.DotfilesStatusResponse getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Status.DotfilesStatusResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.DotfilesStatusResponse build() {
+        io.gitpod.supervisor.api.Status.DotfilesStatusResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.DotfilesStatusResponse buildPartial() {
+        io.gitpod.supervisor.api.Status.DotfilesStatusResponse result = new io.gitpod.supervisor.api.Status.DotfilesStatusResponse(this);
+        result.phase_ = phase_;
+        result.repository_ = repository_;
+        result.ref_ = ref_;
+        result.commit_ = commit_;
+        result.verified_ = verified_;
+        result.cached_ = cached_;
+        result.installScript_ = installScript_;
+        result.exitCode_ = exitCode_;
+        result.failure_ = failure_;
+        result.logPath_ = logPath_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Status.DotfilesStatusResponse) {
+          return mergeFrom((io.gitpod.supervisor.api.Status.DotfilesStatusResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Status.DotfilesStatusResponse other) {
+        if (other == io.gitpod.supervisor.api.Status.DotfilesStatusResponse.getDefaultInstance()) return this;
+        if (other.phase_ != 0) {
+          setPhaseValue(other.getPhaseValue());
+        }
+        if (!other.getRepository().isEmpty()) {
+          repository_ = other.repository_;
+          onChanged();
+        }
+        if (!other.getRef().isEmpty()) {
+          ref_ = other.ref_;
+          onChanged();
+        }
+        if (!other.getCommit().isEmpty()) {
+          commit_ = other.commit_;
+          onChanged();
+        }
+        if (other.getVerified() != false) {
+          setVerified(other.getVerified());
+        }
+        if (other.getCached() != false) {
+          setCached(other.getCached());
+        }
+        if (!other.getInstallScript().isEmpty()) {
+          installScript_ = other.installScript_;
+          onChanged();
+        }
+        if (other.getExitCode() != 0) {
+          setExitCode(other.getExitCode());
+        }
+        if (!other.getFailure().isEmpty()) {
+          failure_ = other.failure_;
+          onChanged();
+        }
+        if (!other.getLogPath().isEmpty()) {
+          logPath_ = other.logPath_;
+          onChanged();
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Status.DotfilesStatusResponse parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Status.DotfilesStatusResponse) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private int phase_ = 0;
+      /**
+       * <code>.supervisor.DotfilesPhase phase = 1;</code>
+       * @return The enum numeric value on the wire for phase.
+       */
+      @java.lang.Override public int getPhaseValue() {
+        return phase_;
+      }
+      /**
+       * <code>.supervisor.DotfilesPhase phase = 1;</code>
+       * @param value The enum numeric value on the wire for phase to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPhaseValue(int value) {
+
+        phase_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.supervisor.DotfilesPhase phase = 1;</code>
+       * @return The phase.
+       */
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.DotfilesPhase getPhase() {
+        @SuppressWarnings("deprecation")
+        io.gitpod.supervisor.api.Status.DotfilesPhase result = io.gitpod.supervisor.api.Status.DotfilesPhase.valueOf(phase_);
+        return result == null ? io.gitpod.supervisor.api.Status.DotfilesPhase.UNRECOGNIZED : result;
+      }
+      /**
+       * <code>.supervisor.DotfilesPhase phase = 1;</code>
+       * @param value The phase to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPhase(io.gitpod.supervisor.api.Status.DotfilesPhase value) {
+        if (value == null) {
+          throw new NullPointerException();
+        }
+
+        phase_ = value.getNumber();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.supervisor.DotfilesPhase phase = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPhase() {
+
+        phase_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object repository_ = "";
+      /**
+       * <pre>
+       * repository is the dotfiles repository
+       * </pre>
+       *
+       * <code>string repository = 2;</code>
+       * @return The repository.
+       */
+      public java.lang.String getRepository() {
+        java.lang.Object ref = repository_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          repository_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * repository is the dotfiles repository
+       * </pre>
+       *
+       * <code>string repository = 2;</code>
+       * @return The bytes for repository.
+       */
+      public com.google.protobuf.ByteString
+          getRepositoryBytes() {
+        java.lang.Object ref = repository_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          repository_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * repository is the dotfiles repository
+       * </pre>
+       *
+       * <code>string repository = 2;</code>
+       * @param value The repository to set.
+       * @return This builder for chaining.
+       */
+      public Builder setRepository(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        repository_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * repository is the dotfiles repository
+       * </pre>
+       *
+       * <code>string repository = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearRepository() {
+
+        repository_ = getDefaultInstance().getRepository();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * repository is the dotfiles repository
+       * </pre>
+       *
+       * <code>string repository = 2;</code>
+       * @param value The bytes for repository to set.
+       * @return This builder for chaining.
+       */
+      public Builder setRepositoryBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        repository_ = value;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object ref_ = "";
+      /**
+       * <pre>
+       * ref is the branch, tag or commit the dotfiles are pinned to. Empty if the default branch is installed.
+       * </pre>
+       *
+       * <code>string ref = 3;</code>
+       * @return The ref.
+       */
+      public java.lang.String getRef() {
+        java.lang.Object ref = ref_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          ref_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * ref is the branch, tag or commit the dotfiles are pinned to. Empty if the default branch is installed.
+       * </pre>
+       *
+       * <code>string ref = 3;</code>
+       * @return The bytes for ref.
+       */
+      public com.google.protobuf.ByteString
+          getRefBytes() {
+        java.lang.Object ref = ref_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          ref_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * ref is the branch, tag or commit the dotfiles are pinned to. Empty if the default branch is installed.
+       * </pre>
+       *
+       * <code>string ref = 3;</code>
+       * @param value The ref to set.
+       * @return This builder for chaining.
+       */
+      public Builder setRef(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        ref_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * ref is the branch, tag or commit the dotfiles are pinned to. Empty if the default branch is installed.
+       * </pre>
+       *
+       * <code>string ref = 3;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearRef() {
+
+        ref_ = getDefaultInstance().getRef();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * ref is the branch, tag or commit the dotfiles are pinned to. Empty if the default branch is installed.
+       * </pre>
+       *
+       * <code>string ref = 3;</code>
+       * @param value The bytes for ref to set.
+       * @return This builder for chaining.
+       */
+      public Builder setRefBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        ref_ = value;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object commit_ = "";
+      /**
+       * <pre>
+       * commit is the commit which is installed
+       * </pre>
+       *
+       * <code>string commit = 4;</code>
+       * @return The commit.
+       */
+      public java.lang.String getCommit() {
+        java.lang.Object ref = commit_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          commit_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * commit is the commit which is installed
+       * </pre>
+       *
+       * <code>string commit = 4;</code>
+       * @return The bytes for commit.
+       */
+      public com.google.protobuf.ByteString
+          getCommitBytes() {
+        java.lang.Object ref = commit_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          commit_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * commit is the commit which is installed
+       * </pre>
+       *
+       * <code>string commit = 4;</code>
+       * @param value The commit to set.
+       * @return This builder for chaining.
+       */
+      public Builder setCommit(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        commit_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * commit is the commit which is installed
+       * </pre>
+       *
+       * <code>string commit = 4;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearCommit() {
+
+        commit_ = getDefaultInstance().getCommit();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * commit is the commit which is installed
+       * </pre>
+       *
+       * <code>string commit = 4;</code>
+       * @param value The bytes for commit to set.
+       * @return This builder for chaining.
+       */
+      public Builder setCommitBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        commit_ = value;
+        onChanged();
+        return this;
+      }
+
+      private boolean verified_ ;
+      /**
+       * <pre>
+       * verified is true if the signature of the commit was verified
+       * </pre>
+       *
+       * <code>bool verified = 5;</code>
+       * @return The verified.
+       */
+      @java.lang.Override
+      public boolean getVerified() {
+        return verified_;
+      }
+      /**
+       * <pre>
+       * verified is true if the signature of the commit was verified
+       * </pre>
+       *
+       * <code>bool verified = 5;</code>
+       * @param value The verified to set.
+       * @return This builder for chaining.
+       */
+      public Builder setVerified(boolean value) {
+
+        verified_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * verified is true if the signature of the commit was verified
+       * </pre>
+       *
+       * <code>bool verified = 5;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearVerified() {
+
+        verified_ = false;
+        onChanged();
+        return this;
+      }
+
+      private boolean cached_ ;
+      /**
+       * <pre>
+       * cached is true if the dotfiles were installed from the clone cached in the workspace
+       * </pre>
+       *
+       * <code>bool cached = 6;</code>
+       * @return The cached.
+       */
+      @java.lang.Override
+      public boolean getCached() {
+        return cached_;
+      }
+      /**
+       * <pre>
+       * cached is true if the dotfiles were installed from the clone cached in the workspace
+       * </pre>
+       *
+       * <code>bool cached = 6;</code>
+       * @param value The cached to set.
+       * @return This builder for chaining.
+       */
+      public Builder setCached(boolean value) {
+
+        cached_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * cached is true if the dotfiles were installed from the clone cached in the workspace
+       * </pre>
+       *
+       * <code>bool cached = 6;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearCached() {
+
+        cached_ = false;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object installScript_ = "";
+      /**
+       * <pre>
+       * install_script is the installation script which ran. Empty if the dotfiles were symlinked.
+       * </pre>
+       *
+       * <code>string install_script = 7;</code>
+       * @return The installScript.
+       */
+      public java.lang.String getInstallScript() {
+        java.lang.Object ref = installScript_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          installScript_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * install_script is the installation script which ran. Empty if the dotfiles were symlinked.
+       * </pre>
+       *
+       * <code>string install_script = 7;</code>
+       * @return The bytes for installScript.
+       */
+      public com.google.protobuf.ByteString
+          getInstallScriptBytes() {
+        java.lang.Object ref = installScript_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          installScript_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * install_script is the installation script which ran. Empty if the dotfiles were symlinked.
+       * </pre>
+       *
+       * <code>string install_script = 7;</code>
+       * @param value The installScript to set.
+       * @return This builder for chaining.
+       */
+      public Builder setInstallScript(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        installScript_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * install_script is the installation script which ran. Empty if the dotfiles were symlinked.
+       * </pre>
+       *
+       * <code>string install_script = 7;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearInstallScript() {
+
+        installScript_ = getDefaultInstance().getInstallScript();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * install_script is the installation script which ran. Empty if the dotfiles were symlinked.
+       * </pre>
+       *
+       * <code>string install_script = 7;</code>
+       * @param value The bytes for installScript to set.
+       * @return This builder for chaining.
+       */
+      public Builder setInstallScriptBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        installScript_ = value;
+        onChanged();
+        return this;
+      }
+
+      private int exitCode_ ;
+      /**
+       * <pre>
+       * exit_code is the exit code of the installation script
+       * </pre>
+       *
+       * <code>int32 exit_code = 8;</code>
+       * @return The exitCode.
+       */
+      @java.lang.Override
+      public int getExitCode() {
+        return exitCode_;
+      }
+      /**
+       * <pre>
+       * exit_code is the exit code of the installation script
+       * </pre>
+       *
+       * <code>int32 exit_code = 8;</code>
+       * @param value The exitCode to set.
+       * @return This builder for chaining.
+       */
+      public Builder setExitCode(int value) {
+
+        exitCode_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * exit_code is the exit code of the installation script
+       * </pre>
+       *
+       * <code>int32 exit_code = 8;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearExitCode() {
+
+        exitCode_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object failure_ = "";
+      /**
+       * <pre>
+       * failure describes why the installation failed
+       * </pre>
+       *
+       * <code>string failure = 9;</code>
+       * @return The failure.
+       */
+      public java.lang.String getFailure() {
+        java.lang.Object ref = failure_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          failure_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * failure describes why the installation failed
+       * </pre>
+       *
+       * <code>string failure = 9;</code>
+       * @return The bytes for failure.
+       */
+      public com.google.protobuf.ByteString
+          getFailureBytes() {
+        java.lang.Object ref = failure_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          failure_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * failure describes why the installation failed
+       * </pre>
+       *
+       * <code>string failure = 9;</code>
+       * @param value The failure to set.
+       * @return This builder for chaining.
+       */
+      public Builder setFailure(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        failure_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * failure describes why the installation failed
+       * </pre>
+       *
+       * <code>string failure = 9;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearFailure() {
+
+        failure_ = getDefaultInstance().getFailure();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * failure describes why the installation failed
+       * </pre>
+       *
+       * <code>string failure = 9;</code>
+       * @param value The bytes for failure to set.
+       * @return This builder for chaining.
+       */
+      public Builder setFailureBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        failure_ = value;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object logPath_ = "";
+      /**
+       * <pre>
+       * log_path is the path of the installation log
+       * </pre>
+       *
+       * <code>string log_path = 10;</code>
+       * @return The logPath.
+       */
+      public java.lang.String getLogPath() {
+        java.lang.Object ref = logPath_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          logPath_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * log_path is the path of the installation log
+       * </pre>
+       *
+       * <code>string log_path = 10;</code>
+       * @return The bytes for logPath.
+       */
+      public com.google.protobuf.ByteString
+          getLogPathBytes() {
+        java.lang.Object ref = logPath_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          logPath_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * log_path is the path of the installation log
+       * </pre>
+       *
+       * <code>string log_path = 10;</code>
+       * @param value The logPath to set.
+       * @return This builder for chaining.
+       */
+      public Builder setLogPath(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        logPath_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * log_path is the path of the installation log
+       * </pre>
+       *
+       * <code>string log_path = 10;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearLogPath() {
+
+        logPath_ = getDefaultInstance().getLogPath();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * log_path is the path of the installation log
+       * </pre>
+       *
+       * <code>string log_path = 10;</code>
+       * @param value The bytes for logPath to set.
+       * @return This builder for chaining.
+       */
+      public Builder setLogPathBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        logPath_ = value;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.DotfilesStatusResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.DotfilesStatusResponse)
+    private static final io.gitpod.supervisor.api.Status.DotfilesStatusResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Status.DotfilesStatusResponse();
+    }
+
+    public static io.gitpod.supervisor.api.Status.DotfilesStatusResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<DotfilesStatusResponse>
+        PARSER = new com.google.protobuf.AbstractParser<DotfilesStatusResponse>() {
+      @java.lang.Override
+      public DotfilesStatusResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new DotfilesStatusResponse(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<DotfilesStatusResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<DotfilesStatusResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.DotfilesStatusResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ServicesStatusRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ServicesStatusRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <pre>
+     * if observe is true, we'll return a stream of changes rather than just the
+     * current state of affairs.
+     * </pre>
+     *
+     * <code>bool observe = 1;</code>
+     * @return The observe.
+     */
+    boolean getObserve();
+  }
+  /**
+   * Protobuf type {@code supervisor.ServicesStatusRequest}
+   */
+  public static final class ServicesStatusRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ServicesStatusRequest)
+      ServicesStatusRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ServicesStatusRequest.newBuilder() to construct.
+    private ServicesStatusRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ServicesStatusRequest() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ServicesStatusRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ServicesStatusRequest(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 8: {
+
+              observe_ = input.readBool();
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_ServicesStatusRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_ServicesStatusRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Status.ServicesStatusRequest.class, io.gitpod.supervisor.api.Status.ServicesStatusRequest.Builder.class);
+    }
+
+    public static final int OBSERVE_FIELD_NUMBER = 1;
+    private boolean observe_;
+    /**
+     * <pre>
+     * if observe is true, we'll return a stream of changes rather than just the
+     * current state of affairs.
+     * </pre>
+     *
+     * <code>bool observe = 1;</code>
+     * @return The observe.
+     */
+    @java.lang.Override
+    public boolean getObserve() {
+      return observe_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (observe_ != false) {
+        output.writeBool(1, observe_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (observe_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(1, observe_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Status.ServicesStatusRequest)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Status.ServicesStatusRequest other = (io.gitpod.supervisor.api.Status.ServicesStatusRequest) obj;
+
+      if (getObserve()
+          != other.getObserve()) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + OBSERVE_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getObserve());
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Status.ServicesStatusRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Status.ServicesStatusRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ServicesStatusRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ServicesStatusRequest)
+        io.gitpod.supervisor.api.Status.ServicesStatusRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_ServicesStatusRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_ServicesStatusRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Status.ServicesStatusRequest.class, io.gitpod.supervisor.api.Status.ServicesStatusRequest.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Status.ServicesStatusRequest.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        observe_ = false;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_ServicesStatusRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.ServicesStatusRequest getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Status.ServicesStatusRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.ServicesStatusRequest build() {
+        io.gitpod.supervisor.api.Status.ServicesStatusRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.ServicesStatusRequest buildPartial() {
+        io.gitpod.supervisor.api.Status.ServicesStatusRequest result = new io.gitpod.supervisor.api.Status.ServicesStatusRequest(this);
+        result.observe_ = observe_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Status.ServicesStatusRequest) {
+          return mergeFrom((io.gitpod.supervisor.api.Status.ServicesStatusRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Status.ServicesStatusRequest other) {
+        if (other == io.gitpod.supervisor.api.Status.ServicesStatusRequest.getDefaultInstance()) return this;
+        if (other.getObserve() != false) {
+          setObserve(other.getObserve());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Status.ServicesStatusRequest parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Status.ServicesStatusRequest) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private boolean observe_ ;
+      /**
+       * <pre>
+       * if observe is true, we'll return a stream of changes rather than just the
+       * current state of affairs.
+       * </pre>
+       *
+       * <code>bool observe = 1;</code>
+       * @return The observe.
+       */
+      @java.lang.Override
+      public boolean getObserve() {
+        return observe_;
+      }
+      /**
+       * <pre>
+       * if observe is true, we'll return a stream of changes rather than just the
+       * current state of affairs.
+       * </pre>
+       *
+       * <code>bool observe = 1;</code>
+       * @param value The observe to set.
+       * @return This builder for chaining.
+       */
+      public Builder setObserve(boolean value) {
+
+        observe_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * if observe is true, we'll return a stream of changes rather than just the
+       * current state of affairs.
+       * </pre>
+       *
+       * <code>bool observe = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearObserve() {
+
+        observe_ = false;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ServicesStatusRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ServicesStatusRequest)
+    private static final io.gitpod.supervisor.api.Status.ServicesStatusRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Status.ServicesStatusRequest();
+    }
+
+    public static io.gitpod.supervisor.api.Status.ServicesStatusRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ServicesStatusRequest>
+        PARSER = new com.google.protobuf.AbstractParser<ServicesStatusRequest>() {
+      @java.lang.Override
+      public ServicesStatusRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ServicesStatusRequest(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ServicesStatusRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ServicesStatusRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.ServicesStatusRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ServicesStatusResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ServicesStatusResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+     */
+    java.util.List<io.gitpod.supervisor.api.Status.ServiceStatus>
+        getServicesList();
+    /**
+     * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+     */
+    io.gitpod.supervisor.api.Status.ServiceStatus getServices(int index);
+    /**
+     * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+     */
+    int getServicesCount();
+    /**
+     * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+     */
+    java.util.List<? extends io.gitpod.supervisor.api.Status.ServiceStatusOrBuilder>
+        getServicesOrBuilderList();
+    /**
+     * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+     */
+    io.gitpod.supervisor.api.Status.ServiceStatusOrBuilder getServicesOrBuilder(
+        int index);
+  }
+  /**
+   * Protobuf type {@code supervisor.ServicesStatusResponse}
+   */
+  public static final class ServicesStatusResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ServicesStatusResponse)
+      ServicesStatusResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ServicesStatusResponse.newBuilder() to construct.
+    private ServicesStatusResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ServicesStatusResponse() {
+      services_ = java.util.Collections.emptyList();
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ServicesStatusResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ServicesStatusResponse(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      int mutable_bitField0_ = 0;
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              if (!((mutable_bitField0_ & 0x00000001) != 0)) {
+                services_ = new java.util.ArrayList<io.gitpod.supervisor.api.Status.ServiceStatus>();
+                mutable_bitField0_ |= 0x00000001;
+              }
+              services_.add(
+                  input.readMessage(io.gitpod.supervisor.api.Status.ServiceStatus.parser(), extensionRegistry));
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        if (((mutable_bitField0_ & 0x00000001) != 0)) {
+          services_ = java.util.Collections.unmodifiableList(services_);
+        }
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_ServicesStatusResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_ServicesStatusResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Status.ServicesStatusResponse.class, io.gitpod.supervisor.api.Status.ServicesStatusResponse.Builder.class);
+    }
+
+    public static final int SERVICES_FIELD_NUMBER = 1;
+    private java.util.List<io.gitpod.supervisor.api.Status.ServiceStatus> services_;
+    /**
+     * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+     */
+    @java.lang.Override
+    public java.util.List<io.gitpod.supervisor.api.Status.ServiceStatus> getServicesList() {
+      return services_;
+    }
+    /**
+     * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+     */
+    @java.lang.Override
+    public java.util.List<? extends io.gitpod.supervisor.api.Status.ServiceStatusOrBuilder>
+        getServicesOrBuilderList() {
+      return services_;
+    }
+    /**
+     * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+     */
+    @java.lang.Override
+    public int getServicesCount() {
+      return services_.size();
+    }
+    /**
+     * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.ServiceStatus getServices(int index) {
+      return services_.get(index);
+    }
+    /**
+     * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.ServiceStatusOrBuilder getServicesOrBuilder(
+        int index) {
+      return services_.get(index);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      for (int i = 0; i < services_.size(); i++) {
+        output.writeMessage(1, services_.get(i));
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      for (int i = 0; i < services_.size(); i++) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(1, services_.get(i));
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Status.ServicesStatusResponse)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Status.ServicesStatusResponse other = (io.gitpod.supervisor.api.Status.ServicesStatusResponse) obj;
+
+      if (!getServicesList()
+          .equals(other.getServicesList())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      if (getServicesCount() > 0) {
+        hash = (37 * hash) + SERVICES_FIELD_NUMBER;
+        hash = (53 * hash) + getServicesList().hashCode();
+      }
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Status.ServicesStatusResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.ServicesStatusResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Status.ServicesStatusResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ServicesStatusResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ServicesStatusResponse)
+        io.gitpod.supervisor.api.Status.ServicesStatusResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_ServicesStatusResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_ServicesStatusResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Status.ServicesStatusResponse.class, io.gitpod.supervisor.api.Status.ServicesStatusResponse.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Status.ServicesStatusResponse.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+          getServicesFieldBuilder();
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        if (servicesBuilder_ == null) {
+          services_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+        } else {
+          servicesBuilder_.clear();
+        }
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_ServicesStatusResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.ServicesStatusResponse getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Status.ServicesStatusResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.ServicesStatusResponse build() {
+        io.gitpod.supervisor.api.Status.ServicesStatusResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.ServicesStatusResponse buildPartial() {
+        io.gitpod.supervisor.api.Status.ServicesStatusResponse result = new io.gitpod.supervisor.api.Status.ServicesStatusResponse(this);
+        int from_bitField0_ = bitField0_;
+        if (servicesBuilder_ == null) {
+          if (((bitField0_ & 0x00000001) != 0)) {
+            services_ = java.util.Collections.unmodifiableList(services_);
+            bitField0_ = (bitField0_ & ~0x00000001);
+          }
+          result.services_ = services_;
+        } else {
+          result.services_ = servicesBuilder_.build();
+        }
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Status.ServicesStatusResponse) {
+          return mergeFrom((io.gitpod.supervisor.api.Status.ServicesStatusResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Status.ServicesStatusResponse other) {
+        if (other == io.gitpod.supervisor.api.Status.ServicesStatusResponse.getDefaultInstance()) return this;
+        if (servicesBuilder_ == null) {
+          if (!other.services_.isEmpty()) {
+            if (services_.isEmpty()) {
+              services_ = other.services_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+            } else {
+              ensureServicesIsMutable();
+              services_.addAll(other.services_);
+            }
+            onChanged();
+          }
+        } else {
+          if (!other.services_.isEmpty()) {
+            if (servicesBuilder_.isEmpty()) {
+              servicesBuilder_.dispose();
+              servicesBuilder_ = null;
+              services_ = other.services_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+              servicesBuilder_ =
+                com.google.protobuf.GeneratedMessageV3.alwaysUseFieldBuilders ?
+                   getServicesFieldBuilder() : null;
+            } else {
+              servicesBuilder_.addAllMessages(other.services_);
+            }
+          }
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Status.ServicesStatusResponse parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Status.ServicesStatusResponse) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+      private int bitField0_;
+
+      private java.util.List<io.gitpod.supervisor.api.Status.ServiceStatus> services_ =
+        java.util.Collections.emptyList();
+      private void ensureServicesIsMutable() {
+        if (!((bitField0_ & 0x00000001) != 0)) {
+          services_ = new java.util.ArrayList<io.gitpod.supervisor.api.Status.ServiceStatus>(services_);
+          bitField0_ |= 0x00000001;
+         }
+      }
+
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          io.gitpod.supervisor.api.Status.ServiceStatus, io.gitpod.supervisor.api.Status.ServiceStatus.Builder, io.gitpod.supervisor.api.Status.ServiceStatusOrBuilder> servicesBuilder_;
+
+      /**
+       * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+       */
+      public java.util.List<io.gitpod.supervisor.api.Status.ServiceStatus> getServicesList() {
+        if (servicesBuilder_ == null) {
+          return java.util.Collections.unmodifiableList(services_);
+        } else {
+          return servicesBuilder_.getMessageList();
+        }
+      }
+      /**
+       * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+       */
+      public int getServicesCount() {
+        if (servicesBuilder_ == null) {
+          return services_.size();
+        } else {
+          return servicesBuilder_.getCount();
+        }
+      }
+      /**
+       * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Status.ServiceStatus getServices(int index) {
+        if (servicesBuilder_ == null) {
+          return services_.get(index);
+        } else {
+          return servicesBuilder_.getMessage(index);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+       */
+      public Builder setServices(
+          int index, io.gitpod.supervisor.api.Status.ServiceStatus value) {
+        if (servicesBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureServicesIsMutable();
+          services_.set(index, value);
+          onChanged();
+        } else {
+          servicesBuilder_.setMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+       */
+      public Builder setServices(
+          int index, io.gitpod.supervisor.api.Status.ServiceStatus.Builder builderForValue) {
+        if (servicesBuilder_ == null) {
+          ensureServicesIsMutable();
+          services_.set(index, builderForValue.build());
+          onChanged();
+        } else {
+          servicesBuilder_.setMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+       */
+      public Builder addServices(io.gitpod.supervisor.api.Status.ServiceStatus value) {
+        if (servicesBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureServicesIsMutable();
+          services_.add(value);
+          onChanged();
+        } else {
+          servicesBuilder_.addMessage(value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+       */
+      public Builder addServices(
+          int index, io.gitpod.supervisor.api.Status.ServiceStatus value) {
+        if (servicesBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureServicesIsMutable();
+          services_.add(index, value);
+          onChanged();
+        } else {
+          servicesBuilder_.addMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+       */
+      public Builder addServices(
+          io.gitpod.supervisor.api.Status.ServiceStatus.Builder builderForValue) {
+        if (servicesBuilder_ == null) {
+          ensureServicesIsMutable();
+          services_.add(builderForValue.build());
+          onChanged();
+        } else {
+          servicesBuilder_.addMessage(builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+       */
+      public Builder addServices(
+          int index, io.gitpod.supervisor.api.Status.ServiceStatus.Builder builderForValue) {
+        if (servicesBuilder_ == null) {
+          ensureServicesIsMutable();
+          services_.add(index, builderForValue.build());
+          onChanged();
+        } else {
+          servicesBuilder_.addMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+       */
+      public Builder addAllServices(
+          java.lang.Iterable<? extends io.gitpod.supervisor.api.Status.ServiceStatus> values) {
+        if (servicesBuilder_ == null) {
+          ensureServicesIsMutable();
+          com.google.protobuf.AbstractMessageLite.Builder.addAll(
+              values, services_);
+          onChanged();
+        } else {
+          servicesBuilder_.addAllMessages(values);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+       */
+      public Builder clearServices() {
+        if (servicesBuilder_ == null) {
+          services_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+          onChanged();
+        } else {
+          servicesBuilder_.clear();
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+       */
+      public Builder removeServices(int index) {
+        if (servicesBuilder_ == null) {
+          ensureServicesIsMutable();
+          services_.remove(index);
+          onChanged();
+        } else {
+          servicesBuilder_.remove(index);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Status.ServiceStatus.Builder getServicesBuilder(
+          int index) {
+        return getServicesFieldBuilder().getBuilder(index);
+      }
+      /**
+       * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Status.ServiceStatusOrBuilder getServicesOrBuilder(
+          int index) {
+        if (servicesBuilder_ == null) {
+          return services_.get(index);  } else {
+          return servicesBuilder_.getMessageOrBuilder(index);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+       */
+      public java.util.List<? extends io.gitpod.supervisor.api.Status.ServiceStatusOrBuilder>
+           getServicesOrBuilderList() {
+        if (servicesBuilder_ != null) {
+          return servicesBuilder_.getMessageOrBuilderList();
+        } else {
+          return java.util.Collections.unmodifiableList(services_);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Status.ServiceStatus.Builder addServicesBuilder() {
+        return getServicesFieldBuilder().addBuilder(
+            io.gitpod.supervisor.api.Status.ServiceStatus.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Status.ServiceStatus.Builder addServicesBuilder(
+          int index) {
+        return getServicesFieldBuilder().addBuilder(
+            index, io.gitpod.supervisor.api.Status.ServiceStatus.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .supervisor.ServiceStatus services = 1;</code>
+       */
+      public java.util.List<io.gitpod.supervisor.api.Status.ServiceStatus.Builder>
+           getServicesBuilderList() {
+        return getServicesFieldBuilder().getBuilderList();
+      }
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          io.gitpod.supervisor.api.Status.ServiceStatus, io.gitpod.supervisor.api.Status.ServiceStatus.Builder, io.gitpod.supervisor.api.Status.ServiceStatusOrBuilder>
+          getServicesFieldBuilder() {
+        if (servicesBuilder_ == null) {
+          servicesBuilder_ = new com.google.protobuf.RepeatedFieldBuilderV3<
+              io.gitpod.supervisor.api.Status.ServiceStatus, io.gitpod.supervisor.api.Status.ServiceStatus.Builder, io.gitpod.supervisor.api.Status.ServiceStatusOrBuilder>(
+                  services_,
+                  ((bitField0_ & 0x00000001) != 0),
+                  getParentForChildren(),
+                  isClean());
+          services_ = null;
+        }
+        return servicesBuilder_;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ServicesStatusResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ServicesStatusResponse)
+    private static final io.gitpod.supervisor.api.Status.ServicesStatusResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Status.ServicesStatusResponse();
+    }
+
+    public static io.gitpod.supervisor.api.Status.ServicesStatusResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ServicesStatusResponse>
+        PARSER = new com.google.protobuf.AbstractParser<ServicesStatusResponse>() {
+      @java.lang.Override
+      public ServicesStatusResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ServicesStatusResponse(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ServicesStatusResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ServicesStatusResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.ServicesStatusResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ServiceStatusOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ServiceStatus)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string name = 1;</code>
+     * @return The name.
+     */
+    java.lang.String getName();
+    /**
+     * <code>string name = 1;</code>
+     * @return The bytes for name.
+     */
+    com.google.protobuf.ByteString
+        getNameBytes();
+
+    /**
+     * <code>string image = 2;</code>
+     * @return The image.
+     */
+    java.lang.String getImage();
+    /**
+     * <code>string image = 2;</code>
+     * @return The bytes for image.
+     */
+    com.google.protobuf.ByteString
+        getImageBytes();
+
+    /**
+     * <code>.supervisor.ServiceState state = 3;</code>
+     * @return The enum numeric value on the wire for state.
+     */
+    int getStateValue();
+    /**
+     * <code>.supervisor.ServiceState state = 3;</code>
+     * @return The state.
+     */
+    io.gitpod.supervisor.api.Status.ServiceState getState();
+
+    /**
+     * <pre>
+     * ready is true while the service's readiness probe succeeds. Services without a readiness probe are ready while they run.
+     * </pre>
+     *
+     * <code>bool ready = 4;</code>
+     * @return The ready.
+     */
+    boolean getReady();
+
+    /**
+     * <pre>
+     * restart_count is the number of times the service was restarted
+     * </pre>
+     *
+     * <code>int32 restart_count = 5;</code>
+     * @return The restartCount.
+     */
+    int getRestartCount();
+
+    /**
+     * <pre>
+     * last_exit_code is the exit code of the last run of the service
+     * </pre>
+     *
+     * <code>int32 last_exit_code = 6;</code>
+     * @return The lastExitCode.
+     */
+    int getLastExitCode();
+
+    /**
+     * <pre>
+     * log_path is the path of the service's log. Older output is rotated to <log_path>.1.
+     * </pre>
+     *
+     * <code>string log_path = 7;</code>
+     * @return The logPath.
+     */
+    java.lang.String getLogPath();
+    /**
+     * <pre>
+     * log_path is the path of the service's log. Older output is rotated to <log_path>.1.
+     * </pre>
+     *
+     * <code>string log_path = 7;</code>
+     * @return The bytes for logPath.
+     */
+    com.google.protobuf.ByteString
+        getLogPathBytes();
+  }
+  /**
+   * Protobuf type {@code supervisor.ServiceStatus}
+   */
+  public static final class ServiceStatus extends
       com.google.protobuf.GeneratedMessageV3 implements
-      // @@protoc_insertion_point(message_implements:supervisor.ResourceStatus)
-      ResourceStatusOrBuilder {
+      // @@protoc_insertion_point(message_implements:supervisor.ServiceStatus)
+      ServiceStatusOrBuilder {
   private static final long serialVersionUID = 0L;
-    // Use ResourceStatus.newBuilder() to construct.
-    private ResourceStatus(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+    // Use ServiceStatus.newBuilder() to construct.
+    private ServiceStatus(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
       super(builder);
     }
-    private ResourceStatus() {
-      severity_ = 0;
+    private ServiceStatus() {
+      name_ = "";
+      image_ = "";
+      state_ = 0;
+      logPath_ = "";
     }
 
     @java.lang.Override
     @SuppressWarnings({"unused"})
     protected java.lang.Object newInstance(
         UnusedPrivateParameter unused) {
-      return new ResourceStatus();
+      return new ServiceStatus();
     }
 
     @java.lang.Override
@@ -15824,7 +25880,7 @@ public final class Status {
     getUnknownFields() {
       return this.unknownFields;
     }
-    private ResourceStatus(
+    private ServiceStatus(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
@@ -15842,20 +25898,43 @@ public final class Status {
             case 0:
               done = true;
               break;
-            case 8: {
+            case 10: {
+              java.lang.String s = input.readStringRequireUtf8();
 
-              used_ = input.readInt64();
+              name_ = s;
               break;
             }
-            case 16: {
+            case 18: {
+              java.lang.String s = input.readStringRequireUtf8();
 
-              limit_ = input.readInt64();
+              image_ = s;
               break;
             }
             case 24: {
               int rawValue = input.readEnum();
 
-              severity_ = rawValue;
+              state_ = rawValue;
+              break;
+            }
+            case 32: {
+
+              ready_ = input.readBool();
+              break;
+            }
+            case 40: {
+
+              restartCount_ = input.readInt32();
+              break;
+            }
+            case 48: {
+
+              lastExitCode_ = input.readInt32();
+              break;
+            }
+            case 58: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              logPath_ = s;
               break;
             }
             default: {
@@ -15881,56 +25960,201 @@ public final class Status {
     }
     public static final com.google.protobuf.Descriptors.Descriptor
         getDescriptor() {
-      return io.gitpod.supervisor.api.Status.internal_static_supervisor_ResourceStatus_descriptor;
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_ServiceStatus_descriptor;
     }
 
     @java.lang.Override
     protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
         internalGetFieldAccessorTable() {
-      return io.gitpod.supervisor.api.Status.internal_static_supervisor_ResourceStatus_fieldAccessorTable
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_ServiceStatus_fieldAccessorTable
           .ensureFieldAccessorsInitialized(
-              io.gitpod.supervisor.api.Status.ResourceStatus.class, io.gitpod.supervisor.api.Status.ResourceStatus.Builder.class);
+              io.gitpod.supervisor.api.Status.ServiceStatus.class, io.gitpod.supervisor.api.Status.ServiceStatus.Builder.class);
     }
 
-    public static final int USED_FIELD_NUMBER = 1;
-    private long used_;
+    public static final int NAME_FIELD_NUMBER = 1;
+    private volatile java.lang.Object name_;
     /**
-     * <code>int64 used = 1;</code>
-     * @return The used.
+     * <code>string name = 1;</code>
+     * @return The name.
      */
     @java.lang.Override
-    public long getUsed() {
-      return used_;
+    public java.lang.String getName() {
+      java.lang.Object ref = name_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        name_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string name = 1;</code>
+     * @return The bytes for name.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getNameBytes() {
+      java.lang.Object ref = name_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        name_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
     }
 
-    public static final int LIMIT_FIELD_NUMBER = 2;
-    private long limit_;
+    public static final int IMAGE_FIELD_NUMBER = 2;
+    private volatile java.lang.Object image_;
     /**
-     * <code>int64 limit = 2;</code>
-     * @return The limit.
+     * <code>string image = 2;</code>
+     * @return The image.
      */
     @java.lang.Override
-    public long getLimit() {
-      return limit_;
+    public java.lang.String getImage() {
+      java.lang.Object ref = image_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        image_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string image = 2;</code>
+     * @return The bytes for image.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getImageBytes() {
+      java.lang.Object ref = image_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        image_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
     }
 
-    public static final int SEVERITY_FIELD_NUMBER = 3;
-    private int severity_;
+    public static final int STATE_FIELD_NUMBER = 3;
+    private int state_;
     /**
-     * <code>.supervisor.ResourceStatusSeverity severity = 3;</code>
-     * @return The enum numeric value on the wire for severity.
+     * <code>.supervisor.ServiceState state = 3;</code>
+     * @return The enum numeric value on the wire for state.
      */
-    @java.lang.Override public int getSeverityValue() {
-      return severity_;
+    @java.lang.Override public int getStateValue() {
+      return state_;
     }
     /**
-     * <code>.supervisor.ResourceStatusSeverity severity = 3;</code>
-     * @return The severity.
+     * <code>.supervisor.ServiceState state = 3;</code>
+     * @return The state.
      */
-    @java.lang.Override public io.gitpod.supervisor.api.Status.ResourceStatusSeverity getSeverity() {
+    @java.lang.Override public io.gitpod.supervisor.api.Status.ServiceState getState() {
       @SuppressWarnings("deprecation")
-      io.gitpod.supervisor.api.Status.ResourceStatusSeverity result = io.gitpod.supervisor.api.Status.ResourceStatusSeverity.valueOf(severity_);
-      return result == null ? io.gitpod.supervisor.api.Status.ResourceStatusSeverity.UNRECOGNIZED : result;
+      io.gitpod.supervisor.api.Status.ServiceState result = io.gitpod.supervisor.api.Status.ServiceState.valueOf(state_);
+      return result == null ? io.gitpod.supervisor.api.Status.ServiceState.UNRECOGNIZED : result;
+    }
+
+    public static final int READY_FIELD_NUMBER = 4;
+    private boolean ready_;
+    /**
+     * <pre>
+     * ready is true while the service's readiness probe succeeds. Services without a readiness probe are ready while they run.
+     * </pre>
+     *
+     * <code>bool ready = 4;</code>
+     * @return The ready.
+     */
+    @java.lang.Override
+    public boolean getReady() {
+      return ready_;
+    }
+
+    public static final int RESTART_COUNT_FIELD_NUMBER = 5;
+    private int restartCount_;
+    /**
+     * <pre>
+     * restart_count is the number of times the service was restarted
+     * </pre>
+     *
+     * <code>int32 restart_count = 5;</code>
+     * @return The restartCount.
+     */
+    @java.lang.Override
+    public int getRestartCount() {
+      return restartCount_;
+    }
+
+    public static final int LAST_EXIT_CODE_FIELD_NUMBER = 6;
+    private int lastExitCode_;
+    /**
+     * <pre>
+     * last_exit_code is the exit code of the last run of the service
+     * </pre>
+     *
+     * <code>int32 last_exit_code = 6;</code>
+     * @return The lastExitCode.
+     */
+    @java.lang.Override
+    public int getLastExitCode() {
+      return lastExitCode_;
+    }
+
+    public static final int LOG_PATH_FIELD_NUMBER = 7;
+    private volatile java.lang.Object logPath_;
+    /**
+     * <pre>
+     * log_path is the path of the service's log. Older output is rotated to <log_path>.1.
+     * </pre>
+     *
+     * <code>string log_path = 7;</code>
+     * @return The logPath.
+     */
+    @java.lang.Override
+    public java.lang.String getLogPath() {
+      java.lang.Object ref = logPath_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        logPath_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * log_path is the path of the service's log. Older output is rotated to <log_path>.1.
+     * </pre>
+     *
+     * <code>string log_path = 7;</code>
+     * @return The bytes for logPath.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getLogPathBytes() {
+      java.lang.Object ref = logPath_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        logPath_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
     }
 
     private byte memoizedIsInitialized = -1;
@@ -15947,14 +26171,26 @@ public final class Status {
     @java.lang.Override
     public void writeTo(com.google.protobuf.CodedOutputStream output)
                         throws java.io.IOException {
-      if (used_ != 0L) {
-        output.writeInt64(1, used_);
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(name_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, name_);
       }
-      if (limit_ != 0L) {
-        output.writeInt64(2, limit_);
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(image_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 2, image_);
       }
-      if (severity_ != io.gitpod.supervisor.api.Status.ResourceStatusSeverity.normal.getNumber()) {
-        output.writeEnum(3, severity_);
+      if (state_ != io.gitpod.supervisor.api.Status.ServiceState.pending.getNumber()) {
+        output.writeEnum(3, state_);
+      }
+      if (ready_ != false) {
+        output.writeBool(4, ready_);
+      }
+      if (restartCount_ != 0) {
+        output.writeInt32(5, restartCount_);
+      }
+      if (lastExitCode_ != 0) {
+        output.writeInt32(6, lastExitCode_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(logPath_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 7, logPath_);
       }
       unknownFields.writeTo(output);
     }
@@ -15965,17 +26201,30 @@ public final class Status {
       if (size != -1) return size;
 
       size = 0;
-      if (used_ != 0L) {
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(name_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, name_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(image_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(2, image_);
+      }
+      if (state_ != io.gitpod.supervisor.api.Status.ServiceState.pending.getNumber()) {
         size += com.google.protobuf.CodedOutputStream
-          .computeInt64Size(1, used_);
+          .computeEnumSize(3, state_);
       }
-      if (limit_ != 0L) {
+      if (ready_ != false) {
         size += com.google.protobuf.CodedOutputStream
-          .computeInt64Size(2, limit_);
+          .computeBoolSize(4, ready_);
       }
-      if (severity_ != io.gitpod.supervisor.api.Status.ResourceStatusSeverity.normal.getNumber()) {
+      if (restartCount_ != 0) {
         size += com.google.protobuf.CodedOutputStream
-          .computeEnumSize(3, severity_);
+          .computeInt32Size(5, restartCount_);
+      }
+      if (lastExitCode_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeInt32Size(6, lastExitCode_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(logPath_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(7, logPath_);
       }
       size += unknownFields.getSerializedSize();
       memoizedSize = size;
@@ -15987,16 +26236,24 @@ public final class Status {
       if (obj == this) {
        return true;
       }
-      if (!(obj instanceof io.gitpod.supervisor.api.Status.ResourceStatus)) {
+      if (!(obj instanceof io.gitpod.supervisor.api.Status.ServiceStatus)) {
         return super.equals(obj);
       }
-      io.gitpod.supervisor.api.Status.ResourceStatus other = (io.gitpod.supervisor.api.Status.ResourceStatus) obj;
+      io.gitpod.supervisor.api.Status.ServiceStatus other = (io.gitpod.supervisor.api.Status.ServiceStatus) obj;
 
-      if (getUsed()
-          != other.getUsed()) return false;
-      if (getLimit()
-          != other.getLimit()) return false;
-      if (severity_ != other.severity_) return false;
+      if (!getName()
+          .equals(other.getName())) return false;
+      if (!getImage()
+          .equals(other.getImage())) return false;
+      if (state_ != other.state_) return false;
+      if (getReady()
+          != other.getReady()) return false;
+      if (getRestartCount()
+          != other.getRestartCount()) return false;
+      if (getLastExitCode()
+          != other.getLastExitCode()) return false;
+      if (!getLogPath()
+          .equals(other.getLogPath())) return false;
       if (!unknownFields.equals(other.unknownFields)) return false;
       return true;
     }
@@ -16008,82 +26265,89 @@ public final class Status {
       }
       int hash = 41;
       hash = (19 * hash) + getDescriptor().hashCode();
-      hash = (37 * hash) + USED_FIELD_NUMBER;
-      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
-          getUsed());
-      hash = (37 * hash) + LIMIT_FIELD_NUMBER;
-      hash = (53 * hash) + com.google.protobuf.Internal.hashLong(
-          getLimit());
-      hash = (37 * hash) + SEVERITY_FIELD_NUMBER;
-      hash = (53 * hash) + severity_;
+      hash = (37 * hash) + NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getName().hashCode();
+      hash = (37 * hash) + IMAGE_FIELD_NUMBER;
+      hash = (53 * hash) + getImage().hashCode();
+      hash = (37 * hash) + STATE_FIELD_NUMBER;
+      hash = (53 * hash) + state_;
+      hash = (37 * hash) + READY_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getReady());
+      hash = (37 * hash) + RESTART_COUNT_FIELD_NUMBER;
+      hash = (53 * hash) + getRestartCount();
+      hash = (37 * hash) + LAST_EXIT_CODE_FIELD_NUMBER;
+      hash = (53 * hash) + getLastExitCode();
+      hash = (37 * hash) + LOG_PATH_FIELD_NUMBER;
+      hash = (53 * hash) + getLogPath().hashCode();
       hash = (29 * hash) + unknownFields.hashCode();
       memoizedHashCode = hash;
       return hash;
     }
 
-    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(
+    public static io.gitpod.supervisor.api.Status.ServiceStatus parseFrom(
         java.nio.ByteBuffer data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(
+    public static io.gitpod.supervisor.api.Status.ServiceStatus parseFrom(
         java.nio.ByteBuffer data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(
+    public static io.gitpod.supervisor.api.Status.ServiceStatus parseFrom(
         com.google.protobuf.ByteString data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(
+    public static io.gitpod.supervisor.api.Status.ServiceStatus parseFrom(
         com.google.protobuf.ByteString data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(byte[] data)
+    public static io.gitpod.supervisor.api.Status.ServiceStatus parseFrom(byte[] data)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data);
     }
-    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(
+    public static io.gitpod.supervisor.api.Status.ServiceStatus parseFrom(
         byte[] data,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws com.google.protobuf.InvalidProtocolBufferException {
       return PARSER.parseFrom(data, extensionRegistry);
     }
-    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(java.io.InputStream input)
+    public static io.gitpod.supervisor.api.Status.ServiceStatus parseFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input);
     }
-    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(
+    public static io.gitpod.supervisor.api.Status.ServiceStatus parseFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input, extensionRegistry);
     }
-    public static io.gitpod.supervisor.api.Status.ResourceStatus parseDelimitedFrom(java.io.InputStream input)
+    public static io.gitpod.supervisor.api.Status.ServiceStatus parseDelimitedFrom(java.io.InputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseDelimitedWithIOException(PARSER, input);
     }
-    public static io.gitpod.supervisor.api.Status.ResourceStatus parseDelimitedFrom(
+    public static io.gitpod.supervisor.api.Status.ServiceStatus parseDelimitedFrom(
         java.io.InputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
     }
-    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(
+    public static io.gitpod.supervisor.api.Status.ServiceStatus parseFrom(
         com.google.protobuf.CodedInputStream input)
         throws java.io.IOException {
       return com.google.protobuf.GeneratedMessageV3
           .parseWithIOException(PARSER, input);
     }
-    public static io.gitpod.supervisor.api.Status.ResourceStatus parseFrom(
+    public static io.gitpod.supervisor.api.Status.ServiceStatus parseFrom(
         com.google.protobuf.CodedInputStream input,
         com.google.protobuf.ExtensionRegistryLite extensionRegistry)
         throws java.io.IOException {
@@ -16096,7 +26360,7 @@ public final class Status {
     public static Builder newBuilder() {
       return DEFAULT_INSTANCE.toBuilder();
     }
-    public static Builder newBuilder(io.gitpod.supervisor.api.Status.ResourceStatus prototype) {
+    public static Builder newBuilder(io.gitpod.supervisor.api.Status.ServiceStatus prototype) {
       return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
     }
     @java.lang.Override
@@ -16112,26 +26376,26 @@ public final class Status {
       return builder;
     }
     /**
-     * Protobuf type {@code supervisor.ResourceStatus}
+     * Protobuf type {@code supervisor.ServiceStatus}
      */
     public static final class Builder extends
         com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
-        // @@protoc_insertion_point(builder_implements:supervisor.ResourceStatus)
-        io.gitpod.supervisor.api.Status.ResourceStatusOrBuilder {
+        // @@protoc_insertion_point(builder_implements:supervisor.ServiceStatus)
+        io.gitpod.supervisor.api.Status.ServiceStatusOrBuilder {
       public static final com.google.protobuf.Descriptors.Descriptor
           getDescriptor() {
-        return io.gitpod.supervisor.api.Status.internal_static_supervisor_ResourceStatus_descriptor;
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_ServiceStatus_descriptor;
       }
 
       @java.lang.Override
       protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
           internalGetFieldAccessorTable() {
-        return io.gitpod.supervisor.api.Status.internal_static_supervisor_ResourceStatus_fieldAccessorTable
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_ServiceStatus_fieldAccessorTable
             .ensureFieldAccessorsInitialized(
-                io.gitpod.supervisor.api.Status.ResourceStatus.class, io.gitpod.supervisor.api.Status.ResourceStatus.Builder.class);
+                io.gitpod.supervisor.api.Status.ServiceStatus.class, io.gitpod.supervisor.api.Status.ServiceStatus.Builder.class);
       }
 
-      // Construct using io.gitpod.supervisor.api.Status.ResourceStatus.newBuilder()
+      // Construct using io.gitpod.supervisor.api.Status.ServiceStatus.newBuilder()
       private Builder() {
         maybeForceBuilderInitialization();
       }
@@ -16149,11 +26413,19 @@ public final class Status {
       @java.lang.Override
       public Builder clear() {
         super.clear();
-        used_ = 0L;
+        name_ = "";
 
-        limit_ = 0L;
+        image_ = "";
 
-        severity_ = 0;
+        state_ = 0;
+
+        ready_ = false;
+
+        restartCount_ = 0;
+
+        lastExitCode_ = 0;
+
+        logPath_ = "";
 
         return this;
       }
@@ -16161,17 +26433,17 @@ public final class Status {
       @java.lang.Override
       public com.google.protobuf.Descriptors.Descriptor
           getDescriptorForType() {
-        return io.gitpod.supervisor.api.Status.internal_static_supervisor_ResourceStatus_descriptor;
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_ServiceStatus_descriptor;
       }
 
       @java.lang.Override
-      public io.gitpod.supervisor.api.Status.ResourceStatus getDefaultInstanceForType() {
-        return io.gitpod.supervisor.api.Status.ResourceStatus.getDefaultInstance();
+      public io.gitpod.supervisor.api.Status.ServiceStatus getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Status.ServiceStatus.getDefaultInstance();
       }
 
       @java.lang.Override
-      public io.gitpod.supervisor.api.Status.ResourceStatus build() {
-        io.gitpod.supervisor.api.Status.ResourceStatus result = buildPartial();
+      public io.gitpod.supervisor.api.Status.ServiceStatus build() {
+        io.gitpod.supervisor.api.Status.ServiceStatus result = buildPartial();
         if (!result.isInitialized()) {
           throw newUninitializedMessageException(result);
         }
@@ -16179,11 +26451,15 @@ public final class Status {
       }
 
       @java.lang.Override
-      public io.gitpod.supervisor.api.Status.ResourceStatus buildPartial() {
-        io.gitpod.supervisor.api.Status.ResourceStatus result = new io.gitpod.supervisor.api.Status.ResourceStatus(this);
-        result.used_ = used_;
-        result.limit_ = limit_;
-        result.severity_ = severity_;
+      public io.gitpod.supervisor.api.Status.ServiceStatus buildPartial() {
+        io.gitpod.supervisor.api.Status.ServiceStatus result = new io.gitpod.supervisor.api.Status.ServiceStatus(this);
+        result.name_ = name_;
+        result.image_ = image_;
+        result.state_ = state_;
+        result.ready_ = ready_;
+        result.restartCount_ = restartCount_;
+        result.lastExitCode_ = lastExitCode_;
+        result.logPath_ = logPath_;
         onBuilt();
         return result;
       }
@@ -16222,166 +26498,496 @@ public final class Status {
       }
       @java.lang.Override
       public Builder mergeFrom(com.google.protobuf.Message other) {
-        if (other instanceof io.gitpod.supervisor.api.Status.ResourceStatus) {
-          return mergeFrom((io.gitpod.supervisor.api.Status.ResourceStatus)other);
+        if (other instanceof io.gitpod.supervisor.api.Status.ServiceStatus) {
+          return mergeFrom((io.gitpod.supervisor.api.Status.ServiceStatus)other);
         } else {
           super.mergeFrom(other);
           return this;
         }
       }
 
-      public Builder mergeFrom(io.gitpod.supervisor.api.Status.ResourceStatus other) {
-        if (other == io.gitpod.supervisor.api.Status.ResourceStatus.getDefaultInstance()) return this;
-        if (other.getUsed() != 0L) {
-          setUsed(other.getUsed());
-        }
-        if (other.getLimit() != 0L) {
-          setLimit(other.getLimit());
-        }
-        if (other.severity_ != 0) {
-          setSeverityValue(other.getSeverityValue());
-        }
-        this.mergeUnknownFields(other.unknownFields);
+      public Builder mergeFrom(io.gitpod.supervisor.api.Status.ServiceStatus other) {
+        if (other == io.gitpod.supervisor.api.Status.ServiceStatus.getDefaultInstance()) return this;
+        if (!other.getName().isEmpty()) {
+          name_ = other.name_;
+          onChanged();
+        }
+        if (!other.getImage().isEmpty()) {
+          image_ = other.image_;
+          onChanged();
+        }
+        if (other.state_ != 0) {
+          setStateValue(other.getStateValue());
+        }
+        if (other.getReady() != false) {
+          setReady(other.getReady());
+        }
+        if (other.getRestartCount() != 0) {
+          setRestartCount(other.getRestartCount());
+        }
+        if (other.getLastExitCode() != 0) {
+          setLastExitCode(other.getLastExitCode());
+        }
+        if (!other.getLogPath().isEmpty()) {
+          logPath_ = other.logPath_;
+          onChanged();
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Status.ServiceStatus parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Status.ServiceStatus) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private java.lang.Object name_ = "";
+      /**
+       * <code>string name = 1;</code>
+       * @return The name.
+       */
+      public java.lang.String getName() {
+        java.lang.Object ref = name_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          name_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @return The bytes for name.
+       */
+      public com.google.protobuf.ByteString
+          getNameBytes() {
+        java.lang.Object ref = name_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          name_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @param value The name to set.
+       * @return This builder for chaining.
+       */
+      public Builder setName(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        name_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearName() {
+
+        name_ = getDefaultInstance().getName();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @param value The bytes for name to set.
+       * @return This builder for chaining.
+       */
+      public Builder setNameBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        name_ = value;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object image_ = "";
+      /**
+       * <code>string image = 2;</code>
+       * @return The image.
+       */
+      public java.lang.String getImage() {
+        java.lang.Object ref = image_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          image_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string image = 2;</code>
+       * @return The bytes for image.
+       */
+      public com.google.protobuf.ByteString
+          getImageBytes() {
+        java.lang.Object ref = image_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          image_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string image = 2;</code>
+       * @param value The image to set.
+       * @return This builder for chaining.
+       */
+      public Builder setImage(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        image_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string image = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearImage() {
+
+        image_ = getDefaultInstance().getImage();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string image = 2;</code>
+       * @param value The bytes for image to set.
+       * @return This builder for chaining.
+       */
+      public Builder setImageBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        image_ = value;
         onChanged();
         return this;
       }
 
-      @java.lang.Override
-      public final boolean isInitialized() {
-        return true;
+      private int state_ = 0;
+      /**
+       * <code>.supervisor.ServiceState state = 3;</code>
+       * @return The enum numeric value on the wire for state.
+       */
+      @java.lang.Override public int getStateValue() {
+        return state_;
       }
+      /**
+       * <code>.supervisor.ServiceState state = 3;</code>
+       * @param value The enum numeric value on the wire for state to set.
+       * @return This builder for chaining.
+       */
+      public Builder setStateValue(int value) {
 
+        state_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.supervisor.ServiceState state = 3;</code>
+       * @return The state.
+       */
       @java.lang.Override
-      public Builder mergeFrom(
-          com.google.protobuf.CodedInputStream input,
-          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
-          throws java.io.IOException {
-        io.gitpod.supervisor.api.Status.ResourceStatus parsedMessage = null;
-        try {
-          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
-        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
-          parsedMessage = (io.gitpod.supervisor.api.Status.ResourceStatus) e.getUnfinishedMessage();
-          throw e.unwrapIOException();
-        } finally {
-          if (parsedMessage != null) {
-            mergeFrom(parsedMessage);
-          }
+      public io.gitpod.supervisor.api.Status.ServiceState getState() {
+        @SuppressWarnings("deprecation")
+        io.gitpod.supervisor.api.Status.ServiceState result = io.gitpod.supervisor.api.Status.ServiceState.valueOf(state_);
+        return result == null ? io.gitpod.supervisor.api.Status.ServiceState.UNRECOGNIZED : result;
+      }
+      /**
+       * <code>.supervisor.ServiceState state = 3;</code>
+       * @param value The state to set.
+       * @return This builder for chaining.
+       */
+      public Builder setState(io.gitpod.supervisor.api.Status.ServiceState value) {
+        if (value == null) {
+          throw new NullPointerException();
         }
+
+        state_ = value.getNumber();
+        onChanged();
         return this;
       }
+      /**
+       * <code>.supervisor.ServiceState state = 3;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearState() {
 
-      private long used_ ;
+        state_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private boolean ready_ ;
       /**
-       * <code>int64 used = 1;</code>
-       * @return The used.
+       * <pre>
+       * ready is true while the service's readiness probe succeeds. Services without a readiness probe are ready while they run.
+       * </pre>
+       *
+       * <code>bool ready = 4;</code>
+       * @return The ready.
        */
       @java.lang.Override
-      public long getUsed() {
-        return used_;
+      public boolean getReady() {
+        return ready_;
       }
       /**
-       * <code>int64 used = 1;</code>
-       * @param value The used to set.
+       * <pre>
+       * ready is true while the service's readiness probe succeeds. Services without a readiness probe are ready while they run.
+       * </pre>
+       *
+       * <code>bool ready = 4;</code>
+       * @param value The ready to set.
        * @return This builder for chaining.
        */
-      public Builder setUsed(long value) {
+      public Builder setReady(boolean value) {
 
-        used_ = value;
+        ready_ = value;
         onChanged();
         return this;
       }
       /**
-       * <code>int64 used = 1;</code>
+       * <pre>
+       * ready is true while the service's readiness probe succeeds. Services without a readiness probe are ready while they run.
+       * </pre>
+       *
+       * <code>bool ready = 4;</code>
        * @return This builder for chaining.
        */
-      public Builder clearUsed() {
+      public Builder clearReady() {
 
-        used_ = 0L;
+        ready_ = false;
         onChanged();
         return this;
       }
 
-      private long limit_ ;
+      private int restartCount_ ;
       /**
-       * <code>int64 limit = 2;</code>
-       * @return The limit.
+       * <pre>
+       * restart_count is the number of times the service was restarted
+       * </pre>
+       *
+       * <code>int32 restart_count = 5;</code>
+       * @return The restartCount.
        */
       @java.lang.Override
-      public long getLimit() {
-        return limit_;
+      public int getRestartCount() {
+        return restartCount_;
       }
       /**
-       * <code>int64 limit = 2;</code>
-       * @param value The limit to set.
+       * <pre>
+       * restart_count is the number of times the service was restarted
+       * </pre>
+       *
+       * <code>int32 restart_count = 5;</code>
+       * @param value The restartCount to set.
        * @return This builder for chaining.
        */
-      public Builder setLimit(long value) {
+      public Builder setRestartCount(int value) {
 
-        limit_ = value;
+        restartCount_ = value;
         onChanged();
         return this;
       }
       /**
-       * <code>int64 limit = 2;</code>
+       * <pre>
+       * restart_count is the number of times the service was restarted
+       * </pre>
+       *
+       * <code>int32 restart_count = 5;</code>
        * @return This builder for chaining.
        */
-      public Builder clearLimit() {
+      public Builder clearRestartCount() {
 
-        limit_ = 0L;
+        restartCount_ = 0;
         onChanged();
         return this;
       }
 
-      private int severity_ = 0;
+      private int lastExitCode_ ;
       /**
-       * <code>.supervisor.ResourceStatusSeverity severity = 3;</code>
-       * @return The enum numeric value on the wire for severity.
+       * <pre>
+       * last_exit_code is the exit code of the last run of the service
+       * </pre>
+       *
+       * <code>int32 last_exit_code = 6;</code>
+       * @return The lastExitCode.
        */
-      @java.lang.Override public int getSeverityValue() {
-        return severity_;
+      @java.lang.Override
+      public int getLastExitCode() {
+        return lastExitCode_;
       }
       /**
-       * <code>.supervisor.ResourceStatusSeverity severity = 3;</code>
-       * @param value The enum numeric value on the wire for severity to set.
+       * <pre>
+       * last_exit_code is the exit code of the last run of the service
+       * </pre>
+       *
+       * <code>int32 last_exit_code = 6;</code>
+       * @param value The lastExitCode to set.
        * @return This builder for chaining.
        */
-      public Builder setSeverityValue(int value) {
+      public Builder setLastExitCode(int value) {
 
-        severity_ = value;
+        lastExitCode_ = value;
         onChanged();
         return this;
       }
       /**
-       * <code>.supervisor.ResourceStatusSeverity severity = 3;</code>
-       * @return The severity.
+       * <pre>
+       * last_exit_code is the exit code of the last run of the service
+       * </pre>
+       *
+       * <code>int32 last_exit_code = 6;</code>
+       * @return This builder for chaining.
        */
-      @java.lang.Override
-      public io.gitpod.supervisor.api.Status.ResourceStatusSeverity getSeverity() {
-        @SuppressWarnings("deprecation")
-        io.gitpod.supervisor.api.Status.ResourceStatusSeverity result = io.gitpod.supervisor.api.Status.ResourceStatusSeverity.valueOf(severity_);
-        return result == null ? io.gitpod.supervisor.api.Status.ResourceStatusSeverity.UNRECOGNIZED : result;
+      public Builder clearLastExitCode() {
+
+        lastExitCode_ = 0;
+        onChanged();
+        return this;
       }
+
+      private java.lang.Object logPath_ = "";
       /**
-       * <code>.supervisor.ResourceStatusSeverity severity = 3;</code>
-       * @param value The severity to set.
+       * <pre>
+       * log_path is the path of the service's log. Older output is rotated to <log_path>.1.
+       * </pre>
+       *
+       * <code>string log_path = 7;</code>
+       * @return The logPath.
+       */
+      public java.lang.String getLogPath() {
+        java.lang.Object ref = logPath_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          logPath_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * log_path is the path of the service's log. Older output is rotated to <log_path>.1.
+       * </pre>
+       *
+       * <code>string log_path = 7;</code>
+       * @return The bytes for logPath.
+       */
+      public com.google.protobuf.ByteString
+          getLogPathBytes() {
+        java.lang.Object ref = logPath_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          logPath_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * log_path is the path of the service's log. Older output is rotated to <log_path>.1.
+       * </pre>
+       *
+       * <code>string log_path = 7;</code>
+       * @param value The logPath to set.
        * @return This builder for chaining.
        */
-      public Builder setSeverity(io.gitpod.supervisor.api.Status.ResourceStatusSeverity value) {
+      public Builder setLogPath(
+          java.lang.String value) {
         if (value == null) {
-          throw new NullPointerException();
-        }
+    throw new NullPointerException();
+  }
 
-        severity_ = value.getNumber();
+        logPath_ = value;
         onChanged();
         return this;
       }
       /**
-       * <code>.supervisor.ResourceStatusSeverity severity = 3;</code>
+       * <pre>
+       * log_path is the path of the service's log. Older output is rotated to <log_path>.1.
+       * </pre>
+       *
+       * <code>string log_path = 7;</code>
        * @return This builder for chaining.
        */
-      public Builder clearSeverity() {
+      public Builder clearLogPath() {
 
-        severity_ = 0;
+        logPath_ = getDefaultInstance().getLogPath();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * log_path is the path of the service's log. Older output is rotated to <log_path>.1.
+       * </pre>
+       *
+       * <code>string log_path = 7;</code>
+       * @param value The bytes for logPath to set.
+       * @return This builder for chaining.
+       */
+      public Builder setLogPathBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        logPath_ = value;
         onChanged();
         return this;
       }
@@ -16398,41 +27004,41 @@ public final class Status {
       }
 
 
-      // @@protoc_insertion_point(builder_scope:supervisor.ResourceStatus)
+      // @@protoc_insertion_point(builder_scope:supervisor.ServiceStatus)
     }
 
-    // @@protoc_insertion_point(class_scope:supervisor.ResourceStatus)
-    private static final io.gitpod.supervisor.api.Status.ResourceStatus DEFAULT_INSTANCE;
+    // @@protoc_insertion_point(class_scope:supervisor.ServiceStatus)
+    private static final io.gitpod.supervisor.api.Status.ServiceStatus DEFAULT_INSTANCE;
     static {
-      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Status.ResourceStatus();
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Status.ServiceStatus();
     }
 
-    public static io.gitpod.supervisor.api.Status.ResourceStatus getDefaultInstance() {
+    public static io.gitpod.supervisor.api.Status.ServiceStatus getDefaultInstance() {
       return DEFAULT_INSTANCE;
     }
 
-    private static final com.google.protobuf.Parser<ResourceStatus>
-        PARSER = new com.google.protobuf.AbstractParser<ResourceStatus>() {
+    private static final com.google.protobuf.Parser<ServiceStatus>
+        PARSER = new com.google.protobuf.AbstractParser<ServiceStatus>() {
       @java.lang.Override
-      public ResourceStatus parsePartialFrom(
+      public ServiceStatus parsePartialFrom(
           com.google.protobuf.CodedInputStream input,
           com.google.protobuf.ExtensionRegistryLite extensionRegistry)
           throws com.google.protobuf.InvalidProtocolBufferException {
-        return new ResourceStatus(input, extensionRegistry);
+        return new ServiceStatus(input, extensionRegistry);
       }
     };
 
-    public static com.google.protobuf.Parser<ResourceStatus> parser() {
+    public static com.google.protobuf.Parser<ServiceStatus> parser() {
       return PARSER;
     }
 
     @java.lang.Override
-    public com.google.protobuf.Parser<ResourceStatus> getParserForType() {
+    public com.google.protobuf.Parser<ServiceStatus> getParserForType() {
       return PARSER;
     }
 
     @java.lang.Override
-    public io.gitpod.supervisor.api.Status.ResourceStatus getDefaultInstanceForType() {
+    public io.gitpod.supervisor.api.Status.ServiceStatus getDefaultInstanceForType() {
       return DEFAULT_INSTANCE;
     }
 
@@ -16549,6 +27155,46 @@ public final class Status {
     com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
       internal_static_supervisor_ResourceStatus_fieldAccessorTable;
 
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_PressureStatus_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_PressureStatus_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_NetworkStatus_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_NetworkStatus_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ProcessStatus_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ProcessStatus_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_DotfilesStatusRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_DotfilesStatusRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_DotfilesStatusResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_DotfilesStatusResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ServicesStatusRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ServicesStatusRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ServicesStatusResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ServicesStatusResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ServiceStatus_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ServiceStatus_fieldAccessorTable;
   public static com.google.protobuf.Descriptors.FileDescriptor
       getDescriptor() {
     return descriptor;
@@ -16558,95 +27204,143 @@ public final class Status {
   static {
     java.lang.String[] descriptorData = {
       "\n\014status.proto\022\nsupervisor\032\034google/api/a" +
-      "nnotations.proto\032\nport.proto\"\031\n\027Supervis" +
-      "orStatusRequest\"&\n\030SupervisorStatusRespo" +
-      "nse\022\n\n\002ok\030\001 \001(\010\" \n\020IDEStatusRequest\022\014\n\004w" +
-      "ait\030\001 \001(\010\"\253\001\n\021IDEStatusResponse\022\n\n\002ok\030\001 " +
-      "\001(\010\022<\n\007desktop\030\002 \001(\0132+.supervisor.IDESta" +
-      "tusResponse.DesktopStatus\032L\n\rDesktopStat" +
-      "us\022\014\n\004link\030\001 \001(\t\022\r\n\005label\030\002 \001(\t\022\020\n\010clien" +
-      "tID\030\003 \001(\t\022\014\n\004kind\030\004 \001(\t\"$\n\024ContentStatus" +
-      "Request\022\014\n\004wait\030\001 \001(\010\"U\n\025ContentStatusRe" +
-      "sponse\022\021\n\tavailable\030\001 \001(\010\022)\n\006source\030\002 \001(" +
-      "\0162\031.supervisor.ContentSource\"\025\n\023BackupSt" +
-      "atusRequest\"0\n\024BackupStatusResponse\022\030\n\020c" +
-      "anary_available\030\001 \001(\010\"%\n\022PortsStatusRequ" +
-      "est\022\017\n\007observe\030\001 \001(\010\"=\n\023PortsStatusRespo" +
-      "nse\022&\n\005ports\030\001 \003(\0132\027.supervisor.PortsSta" +
-      "tus\"\207\001\n\017ExposedPortInfo\022.\n\nvisibility\030\001 " +
-      "\001(\0162\032.supervisor.PortVisibility\022\013\n\003url\030\002" +
-      " \001(\t\0227\n\non_exposed\030\003 \001(\0162\037.supervisor.On" +
-      "PortExposedActionB\002\030\001\"\304\001\n\020TunneledPortIn" +
-      "fo\022\023\n\013target_port\030\001 \001(\r\022/\n\nvisibility\030\002 " +
-      "\001(\0162\033.supervisor.TunnelVisiblity\022:\n\007clie" +
-      "nts\030\003 \003(\0132).supervisor.TunneledPortInfo." +
-      "ClientsEntry\032.\n\014ClientsEntry\022\013\n\003key\030\001 \001(" +
-      "\t\022\r\n\005value\030\002 \001(\r:\0028\001\"\204\003\n\013PortsStatus\022\022\n\n" +
-      "local_port\030\001 \001(\r\022\016\n\006served\030\004 \001(\010\022,\n\007expo" +
-      "sed\030\005 \001(\0132\033.supervisor.ExposedPortInfo\0223" +
-      "\n\rauto_exposure\030\007 \001(\0162\034.supervisor.PortA" +
-      "utoExposure\022.\n\010tunneled\030\006 \001(\0132\034.supervis" +
-      "or.TunneledPortInfo\022\023\n\013description\030\010 \001(\t" +
-      "\022\014\n\004name\030\t \001(\t\0225\n\007on_open\030\n \001(\0162$.superv" +
-      "isor.PortsStatus.OnOpenAction\"^\n\014OnOpenA" +
-      "ction\022\n\n\006ignore\020\000\022\020\n\014open_browser\020\001\022\020\n\014o" +
-      "pen_preview\020\002\022\n\n\006notify\020\003\022\022\n\016notify_priv" +
-      "ate\020\004J\004\010\002\020\003\"%\n\022TasksStatusRequest\022\017\n\007obs" +
-      "erve\030\001 \001(\010\"<\n\023TasksStatusResponse\022%\n\005tas" +
-      "ks\030\001 \003(\0132\026.supervisor.TaskStatus\"\204\001\n\nTas" +
-      "kStatus\022\n\n\002id\030\001 \001(\t\022$\n\005state\030\002 \001(\0162\025.sup" +
-      "ervisor.TaskState\022\020\n\010terminal\030\003 \001(\t\0222\n\014p" +
-      "resentation\030\004 \001(\0132\034.supervisor.TaskPrese" +
-      "ntation\"D\n\020TaskPresentation\022\014\n\004name\030\001 \001(" +
-      "\t\022\017\n\007open_in\030\002 \001(\t\022\021\n\topen_mode\030\003 \001(\t\"\027\n" +
-      "\025ResourcesStatuRequest\"n\n\027ResourcesStatu" +
-      "sResponse\022*\n\006memory\030\001 \001(\0132\032.supervisor.R" +
-      "esourceStatus\022\'\n\003cpu\030\002 \001(\0132\032.supervisor." +
-      "ResourceStatus\"c\n\016ResourceStatus\022\014\n\004used" +
-      "\030\001 \001(\003\022\r\n\005limit\030\002 \001(\003\0224\n\010severity\030\003 \001(\0162" +
-      "\".supervisor.ResourceStatusSeverity*C\n\rC" +
-      "ontentSource\022\016\n\nfrom_other\020\000\022\017\n\013from_bac" +
-      "kup\020\001\022\021\n\rfrom_prebuild\020\002*?\n\016PortVisibili" +
-      "ty\022\026\n\022private_visibility\020\000\022\025\n\021public_vis" +
-      "ibility\020\001*e\n\023OnPortExposedAction\022\n\n\006igno" +
-      "re\020\000\022\020\n\014open_browser\020\001\022\020\n\014open_preview\020\002" +
-      "\022\n\n\006notify\020\003\022\022\n\016notify_private\020\004*9\n\020Port" +
-      "AutoExposure\022\n\n\006trying\020\000\022\r\n\tsucceeded\020\001\022" +
-      "\n\n\006failed\020\002*1\n\tTaskState\022\013\n\007opening\020\000\022\013\n" +
-      "\007running\020\001\022\n\n\006closed\020\002*=\n\026ResourceStatus" +
-      "Severity\022\n\n\006normal\020\000\022\013\n\007warning\020\001\022\n\n\006dan" +
-      "ger\020\0022\304\007\n\rStatusService\022|\n\020SupervisorSta" +
-      "tus\022#.supervisor.SupervisorStatusRequest" +
-      "\032$.supervisor.SupervisorStatusResponse\"\035" +
-      "\202\323\344\223\002\027\022\025/v1/status/supervisor\022\203\001\n\tIDESta" +
-      "tus\022\034.supervisor.IDEStatusRequest\032\035.supe" +
-      "rvisor.IDEStatusResponse\"9\202\323\344\223\0023\022\016/v1/st" +
-      "atus/ideZ!\022\037/v1/status/ide/wait/{wait=tr" +
-      "ue}\022\227\001\n\rContentStatus\022 .supervisor.Conte" +
-      "ntStatusRequest\032!.supervisor.ContentStat" +
-      "usResponse\"A\202\323\344\223\002;\022\022/v1/status/contentZ%" +
-      "\022#/v1/status/content/wait/{wait=true}\022l\n" +
-      "\014BackupStatus\022\037.supervisor.BackupStatusR" +
-      "equest\032 .supervisor.BackupStatusResponse" +
-      "\"\031\202\323\344\223\002\023\022\021/v1/status/backup\022\225\001\n\013PortsSta" +
-      "tus\022\036.supervisor.PortsStatusRequest\032\037.su" +
-      "pervisor.PortsStatusResponse\"C\202\323\344\223\002=\022\020/v" +
-      "1/status/portsZ)\022\'/v1/status/ports/obser" +
-      "ve/{observe=true}0\001\022\225\001\n\013TasksStatus\022\036.su" +
-      "pervisor.TasksStatusRequest\032\037.supervisor" +
-      ".TasksStatusResponse\"C\202\323\344\223\002=\022\020/v1/status" +
-      "/tasksZ)\022\'/v1/status/tasks/observe/{obse" +
-      "rve=true}0\001\022w\n\017ResourcesStatus\022!.supervi" +
-      "sor.ResourcesStatuRequest\032#.supervisor.R" +
-      "esourcesStatusResponse\"\034\202\323\344\223\002\026\022\024/v1/stat" +
-      "us/resourcesBF\n\030io.gitpod.supervisor.api" +
-      "Z*github.com/gitpod-io/gitpod/supervisor" +
-      "/apib\006proto3"
+      "nnotations.proto\032\037google/protobuf/timest" +
+      "amp.proto\032\nport.proto\"\031\n\027SupervisorStatu" +
+      "sRequest\"&\n\030SupervisorStatusResponse\022\n\n\002" +
+      "ok\030\001 \001(\010\" \n\020IDEStatusRequest\022\014\n\004wait\030\001 \001" +
+      "(\010\"\253\001\n\021IDEStatusResponse\022\n\n\002ok\030\001 \001(\010\022<\n\007" +
+      "desktop\030\002 \001(\0132+.supervisor.IDEStatusResp" +
+      "onse.DesktopStatus\032L\n\rDesktopStatus\022\014\n\004l" +
+      "ink\030\001 \001(\t\022\r\n\005label\030\002 \001(\t\022\020\n\010clientID\030\003 \001" +
+      "(\t\022\014\n\004kind\030\004 \001(\t\"$\n\024ContentStatusRequest" +
+      "\022\014\n\004wait\030\001 \001(\010\"\207\001\n\025ContentStatusResponse" +
+      "\022\021\n\tavailable\030\001 \001(\010\022)\n\006source\030\002 \001(\0162\031.su" +
+      "pervisor.ContentSource\022\026\n\016progress_phase" +
+      "\030\003 \001(\t\022\030\n\020progress_percent\030\004 \001(\005\"\025\n\023Back" +
+      "upStatusRequest\"0\n\024BackupStatusResponse\022" +
+      "\030\n\020canary_available\030\001 \001(\010\"%\n\022PortsStatus" +
+      "Request\022\017\n\007observe\030\001 \001(\010\"=\n\023PortsStatusR" +
+      "esponse\022&\n\005ports\030\001 \003(\0132\027.supervisor.Port" +
+      "sStatus\"\207\001\n\017ExposedPortInfo\022.\n\nvisibilit" +
+      "y\030\001 \001(\0162\032.supervisor.PortVisibility\022\013\n\003u" +
+      "rl\030\002 \001(\t\0227\n\non_exposed\030\003 \001(\0162\037.superviso" +
+      "r.OnPortExposedActionB\002\030\001\"\304\001\n\020TunneledPo" +
+      "rtInfo\022\023\n\013target_port\030\001 \001(\r\022/\n\nvisibilit" +
+      "y\030\002 \001(\0162\033.supervisor.TunnelVisiblity\022:\n\007" +
+      "clients\030\003 \003(\0132).supervisor.TunneledPortI" +
+      "nfo.ClientsEntry\032.\n\014ClientsEntry\022\013\n\003key\030" +
+      "\001 \001(\t\022\r\n\005value\030\002 \001(\r:\0028\001\"\326\003\n\013PortsStatus" +
+      "\022\022\n\nlocal_port\030\001 \001(\r\022\016\n\006served\030\004 \001(\010\022,\n\007" +
+      "exposed\030\005 \001(\0132\033.supervisor.ExposedPortIn" +
+      "fo\0223\n\rauto_exposure\030\007 \001(\0162\034.supervisor.P" +
+      "ortAutoExposure\022.\n\010tunneled\030\006 \001(\0132\034.supe" +
+      "rvisor.TunneledPortInfo\022\023\n\013description\030\010" +
+      " \001(\t\022\014\n\004name\030\t \001(\t\0225\n\007on_open\030\n \001(\0162$.su" +
+      "pervisor.PortsStatus.OnOpenAction\0222\n\010pro" +
+      "tocol\030\013 \001(\0162 .supervisor.PortsStatus.Pro" +
+      "tocol\"^\n\014OnOpenAction\022\n\n\006ignore\020\000\022\020\n\014ope" +
+      "n_browser\020\001\022\020\n\014open_preview\020\002\022\n\n\006notify\020" +
+      "\003\022\022\n\016notify_private\020\004\"\034\n\010Protocol\022\007\n\003tcp" +
+      "\020\000\022\007\n\003udp\020\001J\004\010\002\020\003\"%\n\022TasksStatusRequest\022" +
+      "\017\n\007observe\030\001 \001(\010\"<\n\023TasksStatusResponse\022" +
+      "%\n\005tasks\030\001 \003(\0132\026.supervisor.TaskStatus\"\214" +
+      "\002\n\nTaskStatus\022\n\n\002id\030\001 \001(\t\022$\n\005state\030\002 \001(\016" +
+      "2\025.supervisor.TaskState\022\020\n\010terminal\030\003 \001(" +
+      "\t\0222\n\014presentation\030\004 \001(\0132\034.supervisor.Tas" +
+      "kPresentation\022\r\n\005ready\030\005 \001(\010\022\025\n\rrestart_" +
+      "count\030\006 \001(\005\022\026\n\016last_exit_code\030\007 \001(\005\0225\n\021l" +
+      "ast_restart_time\030\010 \001(\0132\032.google.protobuf" +
+      ".Timestamp\022\021\n\trecording\030\t \001(\t\"D\n\020TaskPre" +
+      "sentation\022\014\n\004name\030\001 \001(\t\022\017\n\007open_in\030\002 \001(\t" +
+      "\022\021\n\topen_mode\030\003 \001(\t\"\027\n\025ResourcesStatuReq" +
+      "uest\"\243\002\n\027ResourcesStatusResponse\022*\n\006memo" +
+      "ry\030\001 \001(\0132\032.supervisor.ResourceStatus\022\'\n\003" +
+      "cpu\030\002 \001(\0132\032.supervisor.ResourceStatus\022(\n" +
+      "\004disk\030\003 \001(\0132\032.supervisor.ResourceStatus\022" +
+      "/\n\013io_pressure\030\004 \001(\0132\032.supervisor.Pressu" +
+      "reStatus\022*\n\007network\030\005 \001(\0132\031.supervisor.N" +
+      "etworkStatus\022,\n\tprocesses\030\006 \003(\0132\031.superv" +
+      "isor.ProcessStatus\"c\n\016ResourceStatus\022\014\n\004" +
+      "used\030\001 \001(\003\022\r\n\005limit\030\002 \001(\003\0224\n\010severity\030\003 " +
+      "\001(\0162\".supervisor.ResourceStatusSeverity\"" +
+      "\212\001\n\016PressureStatus\022\022\n\nsome_avg10\030\001 \001(\001\022\022" +
+      "\n\nsome_avg60\030\002 \001(\001\022\023\n\013some_avg300\030\003 \001(\001\022" +
+      "\022\n\nfull_avg10\030\004 \001(\001\022\022\n\nfull_avg60\030\005 \001(\001\022" +
+      "\023\n\013full_avg300\030\006 \001(\001\";\n\rNetworkStatus\022\026\n" +
+      "\016received_bytes\030\001 \001(\004\022\022\n\nsent_bytes\030\002 \001(" +
+      "\004\"n\n\rProcessStatus\022\013\n\003pid\030\001 \001(\003\022\017\n\007comma" +
+      "nd\030\002 \001(\t\022\013\n\003cpu\030\003 \001(\003\022\016\n\006memory\030\004 \001(\003\022\017\n" +
+      "\007task_id\030\005 \001(\t\022\021\n\ttask_name\030\006 \001(\t\"%\n\025Dot" +
+      "filesStatusRequest\022\014\n\004wait\030\001 \001(\010\"\343\001\n\026Dot" +
+      "filesStatusResponse\022(\n\005phase\030\001 \001(\0162\031.sup" +
+      "ervisor.DotfilesPhase\022\022\n\nrepository\030\002 \001(" +
+      "\t\022\013\n\003ref\030\003 \001(\t\022\016\n\006commit\030\004 \001(\t\022\020\n\010verifi" +
+      "ed\030\005 \001(\010\022\016\n\006cached\030\006 \001(\010\022\026\n\016install_scri" +
+      "pt\030\007 \001(\t\022\021\n\texit_code\030\010 \001(\005\022\017\n\007failure\030\t" +
+      " \001(\t\022\020\n\010log_path\030\n \001(\t\"(\n\025ServicesStatus" +
+      "Request\022\017\n\007observe\030\001 \001(\010\"E\n\026ServicesStat" +
+      "usResponse\022+\n\010services\030\001 \003(\0132\031.superviso" +
+      "r.ServiceStatus\"\245\001\n\rServiceStatus\022\014\n\004nam" +
+      "e\030\001 \001(\t\022\r\n\005image\030\002 \001(\t\022\'\n\005state\030\003 \001(\0162\030." +
+      "supervisor.ServiceState\022\r\n\005ready\030\004 \001(\010\022\025" +
+      "\n\rrestart_count\030\005 \001(\005\022\026\n\016last_exit_code\030" +
+      "\006 \001(\005\022\020\n\010log_path\030\007 \001(\t*C\n\rContentSource" +
+      "\022\016\n\nfrom_other\020\000\022\017\n\013from_backup\020\001\022\021\n\rfro" +
+      "m_prebuild\020\002*T\n\016PortVisibility\022\026\n\022privat" +
+      "e_visibility\020\000\022\025\n\021public_visibility\020\001\022\010\n" +
+      "\004team\020\002\022\t\n\005token\020\003*e\n\023OnPortExposedActio" +
+      "n\022\n\n\006ignore\020\000\022\020\n\014open_browser\020\001\022\020\n\014open_" +
+      "preview\020\002\022\n\n\006notify\020\003\022\022\n\016notify_private\020" +
+      "\004*9\n\020PortAutoExposure\022\n\n\006trying\020\000\022\r\n\tsuc" +
+      "ceeded\020\001\022\n\n\006failed\020\002*>\n\tTaskState\022\013\n\007ope" +
+      "ning\020\000\022\013\n\007running\020\001\022\n\n\006closed\020\002\022\013\n\007waiti" +
+      "ng\020\003*=\n\026ResourceStatusSeverity\022\n\n\006normal" +
+      "\020\000\022\013\n\007warning\020\001\022\n\n\006danger\020\002*r\n\rDotfilesP" +
+      "hase\022\022\n\016not_configured\020\000\022\013\n\007cloning\020\001\022\r\n" +
+      "\tverifying\020\002\022\016\n\ninstalling\020\003\022\r\n\tinstalle" +
+      "d\020\004\022\022\n\016install_failed\020\005*4\n\014ServiceState\022" +
+      "\013\n\007pending\020\000\022\013\n\007started\020\001\022\n\n\006exited\020\0022\212\n" +
+      "\n\rStatusService\022|\n\020SupervisorStatus\022#.su" +
+      "pervisor.SupervisorStatusRequest\032$.super" +
+      "visor.SupervisorStatusResponse\"\035\202\323\344\223\002\027\022\025" +
+      "/v1/status/supervisor\022\203\001\n\tIDEStatus\022\034.su" +
+      "pervisor.IDEStatusRequest\032\035.supervisor.I" +
+      "DEStatusResponse\"9\202\323\344\223\0023\022\016/v1/status/ide" +
+      "Z!\022\037/v1/status/ide/wait/{wait=true}\022\227\001\n\r" +
+      "ContentStatus\022 .supervisor.ContentStatus" +
+      "Request\032!.supervisor.ContentStatusRespon" +
+      "se\"A\202\323\344\223\002;\022\022/v1/status/contentZ%\022#/v1/st" +
+      "atus/content/wait/{wait=true}\022l\n\014BackupS" +
+      "tatus\022\037.supervisor.BackupStatusRequest\032 " +
+      ".supervisor.BackupStatusResponse\"\031\202\323\344\223\002\023" +
+      "\022\021/v1/status/backup\022\225\001\n\013PortsStatus\022\036.su" +
+      "pervisor.PortsStatusRequest\032\037.supervisor" +
+      ".PortsStatusResponse\"C\202\323\344\223\002=\022\020/v1/status" +
+      "/portsZ)\022\'/v1/status/ports/observe/{obse" +
+      "rve=true}0\001\022\225\001\n\013TasksStatus\022\036.supervisor" +
+      ".TasksStatusRequest\032\037.supervisor.TasksSt" +
+      "atusResponse\"C\202\323\344\223\002=\022\020/v1/status/tasksZ)" +
+      "\022\'/v1/status/tasks/observe/{observe=true" +
+      "}0\001\022w\n\017ResourcesStatus\022!.supervisor.Reso" +
+      "urcesStatuRequest\032#.supervisor.Resources" +
+      "StatusResponse\"\034\202\323\344\223\002\026\022\024/v1/status/resou" +
+      "rces\022\234\001\n\016DotfilesStatus\022!.supervisor.Dot" +
+      "filesStatusRequest\032\".supervisor.Dotfiles" +
+      "StatusResponse\"C\202\323\344\223\002=\022\023/v1/status/dotfi" +
+      "lesZ&\022$/v1/status/dotfiles/wait/{wait=tr" +
+      "ue}\022\244\001\n\016ServicesStatus\022!.supervisor.Serv" +
+      "icesStatusRequest\032\".supervisor.ServicesS" +
+      "tatusResponse\"I\202\323\344\223\002C\022\023/v1/status/servic" +
+      "esZ,\022*/v1/status/services/observe/{obser" +
+      "ve=true}0\001BF\n\030io.gitpod.supervisor.apiZ*" +
+      "github.com/gitpod-io/gitpod/supervisor/a" +
+      "pib\006proto3"
     };
     descriptor = com.google.protobuf.Descriptors.FileDescriptor
       .internalBuildGeneratedFileFrom(descriptorData,
         new com.google.protobuf.Descriptors.FileDescriptor[] {
           com.google.api.AnnotationsProto.getDescriptor(),
+          com.google.protobuf.TimestampProto.getDescriptor(),
           io.gitpod.supervisor.api.Port.getDescriptor(),
         });
     internal_static_supervisor_SupervisorStatusRequest_descriptor =
@@ -16690,7 +27384,7 @@ public final class Status {
     internal_static_supervisor_ContentStatusResponse_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_supervisor_ContentStatusResponse_descriptor,
-        new java.lang.String[] { "Available", "Source", });
+        new java.lang.String[] { "Available", "Source", "ProgressPhase", "ProgressPercent", });
     internal_static_supervisor_BackupStatusRequest_descriptor =
       getDescriptor().getMessageTypes().get(6);
     internal_static_supervisor_BackupStatusRequest_fieldAccessorTable = new
@@ -16738,7 +27432,7 @@ public final class Status {
     internal_static_supervisor_PortsStatus_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_supervisor_PortsStatus_descriptor,
-        new java.lang.String[] { "LocalPort", "Served", "Exposed", "AutoExposure", "Tunneled", "Description", "Name", "OnOpen", });
+        new java.lang.String[] { "LocalPort", "Served", "Exposed", "AutoExposure", "Tunneled", "Description", "Name", "OnOpen", "Protocol", });
     internal_static_supervisor_TasksStatusRequest_descriptor =
       getDescriptor().getMessageTypes().get(13);
     internal_static_supervisor_TasksStatusRequest_fieldAccessorTable = new
@@ -16756,7 +27450,7 @@ public final class Status {
     internal_static_supervisor_TaskStatus_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_supervisor_TaskStatus_descriptor,
-        new java.lang.String[] { "Id", "State", "Terminal", "Presentation", });
+        new java.lang.String[] { "Id", "State", "Terminal", "Presentation", "Ready", "RestartCount", "LastExitCode", "LastRestartTime", "Recording", });
     internal_static_supervisor_TaskPresentation_descriptor =
       getDescriptor().getMessageTypes().get(16);
     internal_static_supervisor_TaskPresentation_fieldAccessorTable = new
@@ -16774,19 +27468,68 @@ public final class Status {
     internal_static_supervisor_ResourcesStatusResponse_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_supervisor_ResourcesStatusResponse_descriptor,
-        new java.lang.String[] { "Memory", "Cpu", });
+        new java.lang.String[] { "Memory", "Cpu", "Disk", "IoPressure", "Network", "Processes", });
     internal_static_supervisor_ResourceStatus_descriptor =
       getDescriptor().getMessageTypes().get(19);
     internal_static_supervisor_ResourceStatus_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_supervisor_ResourceStatus_descriptor,
         new java.lang.String[] { "Used", "Limit", "Severity", });
+    internal_static_supervisor_PressureStatus_descriptor =
+      getDescriptor().getMessageTypes().get(20);
+    internal_static_supervisor_PressureStatus_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_PressureStatus_descriptor,
+        new java.lang.String[] { "SomeAvg10", "SomeAvg60", "SomeAvg300", "FullAvg10", "FullAvg60", "FullAvg300", });
+    internal_static_supervisor_NetworkStatus_descriptor =
+      getDescriptor().getMessageTypes().get(21);
+    internal_static_supervisor_NetworkStatus_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_NetworkStatus_descriptor,
+        new java.lang.String[] { "ReceivedBytes", "SentBytes", });
+    internal_static_supervisor_ProcessStatus_descriptor =
+      getDescriptor().getMessageTypes().get(22);
+    internal_static_supervisor_ProcessStatus_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ProcessStatus_descriptor,
+        new java.lang.String[] { "Pid", "Command", "Cpu", "Memory", "TaskId", "TaskName", });
+    internal_static_supervisor_DotfilesStatusRequest_descriptor =
+      getDescriptor().getMessageTypes().get(23);
+    internal_static_supervisor_DotfilesStatusRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_DotfilesStatusRequest_descriptor,
+        new java.lang.String[] { "Wait", });
+    internal_static_supervisor_DotfilesStatusResponse_descriptor =
+      getDescriptor().getMessageTypes().get(24);
+    internal_static_supervisor_DotfilesStatusResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_DotfilesStatusResponse_descriptor,
+        new java.lang.String[] { "Phase", "Repository", "Ref", "Commit", "Verified", "Cached", "InstallScript", "ExitCode", "Failure", "LogPath", });
+    internal_static_supervisor_ServicesStatusRequest_descriptor =
+      getDescriptor().getMessageTypes().get(25);
+    internal_static_supervisor_ServicesStatusRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ServicesStatusRequest_descriptor,
+        new java.lang.String[] { "Observe", });
+    internal_static_supervisor_ServicesStatusResponse_descriptor =
+      getDescriptor().getMessageTypes().get(26);
+    internal_static_supervisor_ServicesStatusResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ServicesStatusResponse_descriptor,
+        new java.lang.String[] { "Services", });
+    internal_static_supervisor_ServiceStatus_descriptor =
+      getDescriptor().getMessageTypes().get(27);
+    internal_static_supervisor_ServiceStatus_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ServiceStatus_descriptor,
+        new java.lang.String[] { "Name", "Image", "State", "Ready", "RestartCount", "LastExitCode", "LogPath", });
     com.google.protobuf.ExtensionRegistry registry =
         com.google.protobuf.ExtensionRegistry.newInstance();
     registry.add(com.google.api.AnnotationsProto.http);
     com.google.protobuf.Descriptors.FileDescriptor
         .internalUpdateFileDescriptor(descriptor, registry);
     com.google.api.AnnotationsProto.getDescriptor();
+    com.google.protobuf.TimestampProto.getDescriptor();
     io.gitpod.supervisor.api.Port.getDescriptor();
   }
 
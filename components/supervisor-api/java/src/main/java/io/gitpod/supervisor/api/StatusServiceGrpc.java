@@ -208,6 +208,37 @@ public final class StatusServiceGrpc {
     return getTasksStatusMethod;
   }
 
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Status.ServicesStatusRequest,
+      io.gitpod.supervisor.api.Status.ServicesStatusResponse> getServicesStatusMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "ServicesStatus",
+      requestType = io.gitpod.supervisor.api.Status.ServicesStatusRequest.class,
+      responseType = io.gitpod.supervisor.api.Status.ServicesStatusResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.SERVER_STREAMING)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Status.ServicesStatusRequest,
+      io.gitpod.supervisor.api.Status.ServicesStatusResponse> getServicesStatusMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Status.ServicesStatusRequest, io.gitpod.supervisor.api.Status.ServicesStatusResponse> getServicesStatusMethod;
+    if ((getServicesStatusMethod = StatusServiceGrpc.getServicesStatusMethod) == null) {
+      synchronized (StatusServiceGrpc.class) {
+        if ((getServicesStatusMethod = StatusServiceGrpc.getServicesStatusMethod) == null) {
+          StatusServiceGrpc.getServicesStatusMethod = getServicesStatusMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.Status.ServicesStatusRequest, io.gitpod.supervisor.api.Status.ServicesStatusResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.SERVER_STREAMING)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "ServicesStatus"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Status.ServicesStatusRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Status.ServicesStatusResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new StatusServiceMethodDescriptorSupplier("ServicesStatus"))
+              .build();
+        }
+      }
+    }
+    return getServicesStatusMethod;
+  }
+
   private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Status.ResourcesStatuRequest,
       io.gitpod.supervisor.api.Status.ResourcesStatusResponse> getResourcesStatusMethod;
 
@@ -239,6 +270,37 @@ public final class StatusServiceGrpc {
     return getResourcesStatusMethod;
   }
 
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Status.DotfilesStatusRequest,
+      io.gitpod.supervisor.api.Status.DotfilesStatusResponse> getDotfilesStatusMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "DotfilesStatus",
+      requestType = io.gitpod.supervisor.api.Status.DotfilesStatusRequest.class,
+      responseType = io.gitpod.supervisor.api.Status.DotfilesStatusResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Status.DotfilesStatusRequest,
+      io.gitpod.supervisor.api.Status.DotfilesStatusResponse> getDotfilesStatusMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Status.DotfilesStatusRequest, io.gitpod.supervisor.api.Status.DotfilesStatusResponse> getDotfilesStatusMethod;
+    if ((getDotfilesStatusMethod = StatusServiceGrpc.getDotfilesStatusMethod) == null) {
+      synchronized (StatusServiceGrpc.class) {
+        if ((getDotfilesStatusMethod = StatusServiceGrpc.getDotfilesStatusMethod) == null) {
+          StatusServiceGrpc.getDotfilesStatusMethod = getDotfilesStatusMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.Status.DotfilesStatusRequest, io.gitpod.supervisor.api.Status.DotfilesStatusResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "DotfilesStatus"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Status.DotfilesStatusRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Status.DotfilesStatusResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new StatusServiceMethodDescriptorSupplier("DotfilesStatus"))
+              .build();
+        }
+      }
+    }
+    return getDotfilesStatusMethod;
+  }
+
   /**
    * Creates a new async stub that supports all call types for the service
    */
@@ -353,6 +415,17 @@ public final class StatusServiceGrpc {
       io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getTasksStatusMethod(), responseObserver);
     }
 
+    /**
+     * <pre>
+     * ServicesStatus provides the status of the workspace's services, i.e. the containers ws-manager runs
+     * next to the workspace as configured in the services section of the .gitpod.yml.
+     * </pre>
+     */
+    public void servicesStatus(io.gitpod.supervisor.api.Status.ServicesStatusRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Status.ServicesStatusResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getServicesStatusMethod(), responseObserver);
+    }
+
     /**
      * <pre>
      * ResourcesStatus provides workspace resources status information.
@@ -363,6 +436,17 @@ public final class StatusServiceGrpc {
       io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getResourcesStatusMethod(), responseObserver);
     }
 
+    /**
+     * <pre>
+     * DotfilesStatus provides feedback about the installation of the user's dotfiles. When used with `wait`,
+     * the call returns once the installation has finished.
+     * </pre>
+     */
+    public void dotfilesStatus(io.gitpod.supervisor.api.Status.DotfilesStatusRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Status.DotfilesStatusResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getDotfilesStatusMethod(), responseObserver);
+    }
+
     @java.lang.Override public final io.grpc.ServerServiceDefinition bindService() {
       return io.grpc.ServerServiceDefinition.builder(getServiceDescriptor())
           .addMethod(
@@ -407,6 +491,13 @@ public final class StatusServiceGrpc {
                 io.gitpod.supervisor.api.Status.TasksStatusRequest,
                 io.gitpod.supervisor.api.Status.TasksStatusResponse>(
                   this, METHODID_TASKS_STATUS)))
+          .addMethod(
+            getServicesStatusMethod(),
+            io.grpc.stub.ServerCalls.asyncServerStreamingCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.Status.ServicesStatusRequest,
+                io.gitpod.supervisor.api.Status.ServicesStatusResponse>(
+                  this, METHODID_SERVICES_STATUS)))
           .addMethod(
             getResourcesStatusMethod(),
             io.grpc.stub.ServerCalls.asyncUnaryCall(
@@ -414,6 +505,13 @@ public final class StatusServiceGrpc {
                 io.gitpod.supervisor.api.Status.ResourcesStatuRequest,
                 io.gitpod.supervisor.api.Status.ResourcesStatusResponse>(
                   this, METHODID_RESOURCES_STATUS)))
+          .addMethod(
+            getDotfilesStatusMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.Status.DotfilesStatusRequest,
+                io.gitpod.supervisor.api.Status.DotfilesStatusResponse>(
+                  this, METHODID_DOTFILES_STATUS)))
           .build();
     }
   }
@@ -504,6 +602,18 @@ public final class StatusServiceGrpc {
           getChannel().newCall(getTasksStatusMethod(), getCallOptions()), request, responseObserver);
     }
 
+    /**
+     * <pre>
+     * ServicesStatus provides the status of the workspace's services, i.e. the containers ws-manager runs
+     * next to the workspace as configured in the services section of the .gitpod.yml.
+     * </pre>
+     */
+    public void servicesStatus(io.gitpod.supervisor.api.Status.ServicesStatusRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Status.ServicesStatusResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncServerStreamingCall(
+          getChannel().newCall(getServicesStatusMethod(), getCallOptions()), request, responseObserver);
+    }
+
     /**
      * <pre>
      * ResourcesStatus provides workspace resources status information.
@@ -514,6 +624,18 @@ public final class StatusServiceGrpc {
       io.grpc.stub.ClientCalls.asyncUnaryCall(
           getChannel().newCall(getResourcesStatusMethod(), getCallOptions()), request, responseObserver);
     }
+
+    /**
+     * <pre>
+     * DotfilesStatus provides feedback about the installation of the user's dotfiles. When used with `wait`,
+     * the call returns once the installation has finished.
+     * </pre>
+     */
+    public void dotfilesStatus(io.gitpod.supervisor.api.Status.DotfilesStatusRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Status.DotfilesStatusResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getDotfilesStatusMethod(), getCallOptions()), request, responseObserver);
+    }
   }
 
   /**
@@ -598,6 +720,18 @@ public final class StatusServiceGrpc {
           getChannel(), getTasksStatusMethod(), getCallOptions(), request);
     }
 
+    /**
+     * <pre>
+     * ServicesStatus provides the status of the workspace's services, i.e. the containers ws-manager runs
+     * next to the workspace as configured in the services section of the .gitpod.yml.
+     * </pre>
+     */
+    public java.util.Iterator<io.gitpod.supervisor.api.Status.ServicesStatusResponse> servicesStatus(
+        io.gitpod.supervisor.api.Status.ServicesStatusRequest request) {
+      return io.grpc.stub.ClientCalls.blockingServerStreamingCall(
+          getChannel(), getServicesStatusMethod(), getCallOptions(), request);
+    }
+
     /**
      * <pre>
      * ResourcesStatus provides workspace resources status information.
@@ -607,6 +741,17 @@ public final class StatusServiceGrpc {
       return io.grpc.stub.ClientCalls.blockingUnaryCall(
           getChannel(), getResourcesStatusMethod(), getCallOptions(), request);
     }
+
+    /**
+     * <pre>
+     * DotfilesStatus provides feedback about the installation of the user's dotfiles. When used with `wait`,
+     * the call returns once the installation has finished.
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.Status.DotfilesStatusResponse dotfilesStatus(io.gitpod.supervisor.api.Status.DotfilesStatusRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getDotfilesStatusMethod(), getCallOptions(), request);
+    }
   }
 
   /**
@@ -683,6 +828,18 @@ public final class StatusServiceGrpc {
       return io.grpc.stub.ClientCalls.futureUnaryCall(
           getChannel().newCall(getResourcesStatusMethod(), getCallOptions()), request);
     }
+
+    /**
+     * <pre>
+     * DotfilesStatus provides feedback about the installation of the user's dotfiles. When used with `wait`,
+     * the call returns once the installation has finished.
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.Status.DotfilesStatusResponse> dotfilesStatus(
+        io.gitpod.supervisor.api.Status.DotfilesStatusRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getDotfilesStatusMethod(), getCallOptions()), request);
+    }
   }
 
   private static final int METHODID_SUPERVISOR_STATUS = 0;
@@ -691,7 +848,9 @@ public final class StatusServiceGrpc {
   private static final int METHODID_BACKUP_STATUS = 3;
   private static final int METHODID_PORTS_STATUS = 4;
   private static final int METHODID_TASKS_STATUS = 5;
+  private static final int METHODID_SERVICES_STATUS = 8;
   private static final int METHODID_RESOURCES_STATUS = 6;
+  private static final int METHODID_DOTFILES_STATUS = 7;
 
   private static final class MethodHandlers<Req, Resp> implements
       io.grpc.stub.ServerCalls.UnaryMethod<Req, Resp>,
@@ -734,10 +893,18 @@ public final class StatusServiceGrpc {
           serviceImpl.tasksStatus((io.gitpod.supervisor.api.Status.TasksStatusRequest) request,
               (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Status.TasksStatusResponse>) responseObserver);
           break;
+        case METHODID_SERVICES_STATUS:
+          serviceImpl.servicesStatus((io.gitpod.supervisor.api.Status.ServicesStatusRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Status.ServicesStatusResponse>) responseObserver);
+          break;
         case METHODID_RESOURCES_STATUS:
           serviceImpl.resourcesStatus((io.gitpod.supervisor.api.Status.ResourcesStatuRequest) request,
               (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Status.ResourcesStatusResponse>) responseObserver);
           break;
+        case METHODID_DOTFILES_STATUS:
+          serviceImpl.dotfilesStatus((io.gitpod.supervisor.api.Status.DotfilesStatusRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Status.DotfilesStatusResponse>) responseObserver);
+          break;
         default:
           throw new AssertionError();
       }
@@ -805,7 +972,9 @@ public final class StatusServiceGrpc {
               .addMethod(getBackupStatusMethod())
               .addMethod(getPortsStatusMethod())
               .addMethod(getTasksStatusMethod())
+              .addMethod(getServicesStatusMethod())
               .addMethod(getResourcesStatusMethod())
+              .addMethod(getDotfilesStatusMethod())
               .build();
         }
       }
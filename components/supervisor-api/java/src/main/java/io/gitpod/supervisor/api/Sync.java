@@ -0,0 +1,6207 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+// Generated by the protocol buffer compiler.  DO NOT EDIT!
+// source: sync.proto
+
+package io.gitpod.supervisor.api;
+
+public final class Sync {
+  private Sync() {}
+  public static void registerAllExtensions(
+      com.google.protobuf.ExtensionRegistryLite registry) {
+  }
+
+  public static void registerAllExtensions(
+      com.google.protobuf.ExtensionRegistry registry) {
+    registerAllExtensions(
+        (com.google.protobuf.ExtensionRegistryLite) registry);
+  }
+  public interface SyncBarrierOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.SyncBarrier)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string name = 1;</code>
+     * @return The name.
+     */
+    java.lang.String getName();
+    /**
+     * <code>string name = 1;</code>
+     * @return The bytes for name.
+     */
+    com.google.protobuf.ByteString
+        getNameBytes();
+
+    /**
+     * <pre>
+     * done is true if the barrier was completed
+     * </pre>
+     *
+     * <code>bool done = 2;</code>
+     * @return The done.
+     */
+    boolean getDone();
+
+    /**
+     * <pre>
+     * payload is the payload the barrier was completed with, e.g. a generated port or URL
+     * </pre>
+     *
+     * <code>string payload = 3;</code>
+     * @return The payload.
+     */
+    java.lang.String getPayload();
+    /**
+     * <pre>
+     * payload is the payload the barrier was completed with, e.g. a generated port or URL
+     * </pre>
+     *
+     * <code>string payload = 3;</code>
+     * @return The bytes for payload.
+     */
+    com.google.protobuf.ByteString
+        getPayloadBytes();
+
+    /**
+     * <pre>
+     * done_time is the time the barrier was completed
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp done_time = 4;</code>
+     * @return Whether the doneTime field is set.
+     */
+    boolean hasDoneTime();
+    /**
+     * <pre>
+     * done_time is the time the barrier was completed
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp done_time = 4;</code>
+     * @return The doneTime.
+     */
+    com.google.protobuf.Timestamp getDoneTime();
+    /**
+     * <pre>
+     * done_time is the time the barrier was completed
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp done_time = 4;</code>
+     */
+    com.google.protobuf.TimestampOrBuilder getDoneTimeOrBuilder();
+
+    /**
+     * <pre>
+     * waiters is the number of calls awaiting the barrier
+     * </pre>
+     *
+     * <code>uint32 waiters = 5;</code>
+     * @return The waiters.
+     */
+    int getWaiters();
+  }
+  /**
+   * Protobuf type {@code supervisor.SyncBarrier}
+   */
+  public static final class SyncBarrier extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.SyncBarrier)
+      SyncBarrierOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use SyncBarrier.newBuilder() to construct.
+    private SyncBarrier(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private SyncBarrier() {
+      name_ = "";
+      payload_ = "";
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new SyncBarrier();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private SyncBarrier(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              name_ = s;
+              break;
+            }
+            case 16: {
+
+              done_ = input.readBool();
+              break;
+            }
+            case 26: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              payload_ = s;
+              break;
+            }
+            case 34: {
+              com.google.protobuf.Timestamp.Builder subBuilder = null;
+              if (doneTime_ != null) {
+                subBuilder = doneTime_.toBuilder();
+              }
+              doneTime_ = input.readMessage(com.google.protobuf.Timestamp.parser(), extensionRegistry);
+              if (subBuilder != null) {
+                subBuilder.mergeFrom(doneTime_);
+                doneTime_ = subBuilder.buildPartial();
+              }
+
+              break;
+            }
+            case 40: {
+
+              waiters_ = input.readUInt32();
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncBarrier_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncBarrier_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Sync.SyncBarrier.class, io.gitpod.supervisor.api.Sync.SyncBarrier.Builder.class);
+    }
+
+    public static final int NAME_FIELD_NUMBER = 1;
+    private volatile java.lang.Object name_;
+    /**
+     * <code>string name = 1;</code>
+     * @return The name.
+     */
+    @java.lang.Override
+    public java.lang.String getName() {
+      java.lang.Object ref = name_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        name_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string name = 1;</code>
+     * @return The bytes for name.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getNameBytes() {
+      java.lang.Object ref = name_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        name_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int DONE_FIELD_NUMBER = 2;
+    private boolean done_;
+    /**
+     * <pre>
+     * done is true if the barrier was completed
+     * </pre>
+     *
+     * <code>bool done = 2;</code>
+     * @return The done.
+     */
+    @java.lang.Override
+    public boolean getDone() {
+      return done_;
+    }
+
+    public static final int PAYLOAD_FIELD_NUMBER = 3;
+    private volatile java.lang.Object payload_;
+    /**
+     * <pre>
+     * payload is the payload the barrier was completed with, e.g. a generated port or URL
+     * </pre>
+     *
+     * <code>string payload = 3;</code>
+     * @return The payload.
+     */
+    @java.lang.Override
+    public java.lang.String getPayload() {
+      java.lang.Object ref = payload_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        payload_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * payload is the payload the barrier was completed with, e.g. a generated port or URL
+     * </pre>
+     *
+     * <code>string payload = 3;</code>
+     * @return The bytes for payload.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getPayloadBytes() {
+      java.lang.Object ref = payload_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        payload_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int DONE_TIME_FIELD_NUMBER = 4;
+    private com.google.protobuf.Timestamp doneTime_;
+    /**
+     * <pre>
+     * done_time is the time the barrier was completed
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp done_time = 4;</code>
+     * @return Whether the doneTime field is set.
+     */
+    @java.lang.Override
+    public boolean hasDoneTime() {
+      return doneTime_ != null;
+    }
+    /**
+     * <pre>
+     * done_time is the time the barrier was completed
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp done_time = 4;</code>
+     * @return The doneTime.
+     */
+    @java.lang.Override
+    public com.google.protobuf.Timestamp getDoneTime() {
+      return doneTime_ == null ? com.google.protobuf.Timestamp.getDefaultInstance() : doneTime_;
+    }
+    /**
+     * <pre>
+     * done_time is the time the barrier was completed
+     * </pre>
+     *
+     * <code>.google.protobuf.Timestamp done_time = 4;</code>
+     */
+    @java.lang.Override
+    public com.google.protobuf.TimestampOrBuilder getDoneTimeOrBuilder() {
+      return getDoneTime();
+    }
+
+    public static final int WAITERS_FIELD_NUMBER = 5;
+    private int waiters_;
+    /**
+     * <pre>
+     * waiters is the number of calls awaiting the barrier
+     * </pre>
+     *
+     * <code>uint32 waiters = 5;</code>
+     * @return The waiters.
+     */
+    @java.lang.Override
+    public int getWaiters() {
+      return waiters_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(name_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, name_);
+      }
+      if (done_ != false) {
+        output.writeBool(2, done_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(payload_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 3, payload_);
+      }
+      if (doneTime_ != null) {
+        output.writeMessage(4, getDoneTime());
+      }
+      if (waiters_ != 0) {
+        output.writeUInt32(5, waiters_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(name_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, name_);
+      }
+      if (done_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(2, done_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(payload_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(3, payload_);
+      }
+      if (doneTime_ != null) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(4, getDoneTime());
+      }
+      if (waiters_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt32Size(5, waiters_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Sync.SyncBarrier)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Sync.SyncBarrier other = (io.gitpod.supervisor.api.Sync.SyncBarrier) obj;
+
+      if (!getName()
+          .equals(other.getName())) return false;
+      if (getDone()
+          != other.getDone()) return false;
+      if (!getPayload()
+          .equals(other.getPayload())) return false;
+      if (hasDoneTime() != other.hasDoneTime()) return false;
+      if (hasDoneTime()) {
+        if (!getDoneTime()
+            .equals(other.getDoneTime())) return false;
+      }
+      if (getWaiters()
+          != other.getWaiters()) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getName().hashCode();
+      hash = (37 * hash) + DONE_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getDone());
+      hash = (37 * hash) + PAYLOAD_FIELD_NUMBER;
+      hash = (53 * hash) + getPayload().hashCode();
+      if (hasDoneTime()) {
+        hash = (37 * hash) + DONE_TIME_FIELD_NUMBER;
+        hash = (53 * hash) + getDoneTime().hashCode();
+      }
+      hash = (37 * hash) + WAITERS_FIELD_NUMBER;
+      hash = (53 * hash) + getWaiters();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Sync.SyncBarrier parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncBarrier parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncBarrier parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncBarrier parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncBarrier parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncBarrier parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncBarrier parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncBarrier parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncBarrier parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncBarrier parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncBarrier parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncBarrier parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Sync.SyncBarrier prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.SyncBarrier}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.SyncBarrier)
+        io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncBarrier_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncBarrier_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Sync.SyncBarrier.class, io.gitpod.supervisor.api.Sync.SyncBarrier.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Sync.SyncBarrier.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        name_ = "";
+
+        done_ = false;
+
+        payload_ = "";
+
+        if (doneTimeBuilder_ == null) {
+          doneTime_ = null;
+        } else {
+          doneTime_ = null;
+          doneTimeBuilder_ = null;
+        }
+        waiters_ = 0;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncBarrier_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.SyncBarrier getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Sync.SyncBarrier.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.SyncBarrier build() {
+        io.gitpod.supervisor.api.Sync.SyncBarrier result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.SyncBarrier buildPartial() {
+        io.gitpod.supervisor.api.Sync.SyncBarrier result = new io.gitpod.supervisor.api.Sync.SyncBarrier(this);
+        result.name_ = name_;
+        result.done_ = done_;
+        result.payload_ = payload_;
+        if (doneTimeBuilder_ == null) {
+          result.doneTime_ = doneTime_;
+        } else {
+          result.doneTime_ = doneTimeBuilder_.build();
+        }
+        result.waiters_ = waiters_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Sync.SyncBarrier) {
+          return mergeFrom((io.gitpod.supervisor.api.Sync.SyncBarrier)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Sync.SyncBarrier other) {
+        if (other == io.gitpod.supervisor.api.Sync.SyncBarrier.getDefaultInstance()) return this;
+        if (!other.getName().isEmpty()) {
+          name_ = other.name_;
+          onChanged();
+        }
+        if (other.getDone() != false) {
+          setDone(other.getDone());
+        }
+        if (!other.getPayload().isEmpty()) {
+          payload_ = other.payload_;
+          onChanged();
+        }
+        if (other.hasDoneTime()) {
+          mergeDoneTime(other.getDoneTime());
+        }
+        if (other.getWaiters() != 0) {
+          setWaiters(other.getWaiters());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Sync.SyncBarrier parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Sync.SyncBarrier) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private java.lang.Object name_ = "";
+      /**
+       * <code>string name = 1;</code>
+       * @return The name.
+       */
+      public java.lang.String getName() {
+        java.lang.Object ref = name_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          name_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @return The bytes for name.
+       */
+      public com.google.protobuf.ByteString
+          getNameBytes() {
+        java.lang.Object ref = name_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          name_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @param value The name to set.
+       * @return This builder for chaining.
+       */
+      public Builder setName(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        name_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearName() {
+
+        name_ = getDefaultInstance().getName();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @param value The bytes for name to set.
+       * @return This builder for chaining.
+       */
+      public Builder setNameBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        name_ = value;
+        onChanged();
+        return this;
+      }
+
+      private boolean done_ ;
+      /**
+       * <pre>
+       * done is true if the barrier was completed
+       * </pre>
+       *
+       * <code>bool done = 2;</code>
+       * @return The done.
+       */
+      @java.lang.Override
+      public boolean getDone() {
+        return done_;
+      }
+      /**
+       * <pre>
+       * done is true if the barrier was completed
+       * </pre>
+       *
+       * <code>bool done = 2;</code>
+       * @param value The done to set.
+       * @return This builder for chaining.
+       */
+      public Builder setDone(boolean value) {
+
+        done_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * done is true if the barrier was completed
+       * </pre>
+       *
+       * <code>bool done = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearDone() {
+
+        done_ = false;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object payload_ = "";
+      /**
+       * <pre>
+       * payload is the payload the barrier was completed with, e.g. a generated port or URL
+       * </pre>
+       *
+       * <code>string payload = 3;</code>
+       * @return The payload.
+       */
+      public java.lang.String getPayload() {
+        java.lang.Object ref = payload_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          payload_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * payload is the payload the barrier was completed with, e.g. a generated port or URL
+       * </pre>
+       *
+       * <code>string payload = 3;</code>
+       * @return The bytes for payload.
+       */
+      public com.google.protobuf.ByteString
+          getPayloadBytes() {
+        java.lang.Object ref = payload_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          payload_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * payload is the payload the barrier was completed with, e.g. a generated port or URL
+       * </pre>
+       *
+       * <code>string payload = 3;</code>
+       * @param value The payload to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPayload(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        payload_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * payload is the payload the barrier was completed with, e.g. a generated port or URL
+       * </pre>
+       *
+       * <code>string payload = 3;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPayload() {
+
+        payload_ = getDefaultInstance().getPayload();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * payload is the payload the barrier was completed with, e.g. a generated port or URL
+       * </pre>
+       *
+       * <code>string payload = 3;</code>
+       * @param value The bytes for payload to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPayloadBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        payload_ = value;
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.Timestamp doneTime_;
+      private com.google.protobuf.SingleFieldBuilderV3<
+          com.google.protobuf.Timestamp, com.google.protobuf.Timestamp.Builder, com.google.protobuf.TimestampOrBuilder> doneTimeBuilder_;
+      /**
+       * <pre>
+       * done_time is the time the barrier was completed
+       * </pre>
+       *
+       * <code>.google.protobuf.Timestamp done_time = 4;</code>
+       * @return Whether the doneTime field is set.
+       */
+      public boolean hasDoneTime() {
+        return doneTimeBuilder_ != null || doneTime_ != null;
+      }
+      /**
+       * <pre>
+       * done_time is the time the barrier was completed
+       * </pre>
+       *
+       * <code>.google.protobuf.Timestamp done_time = 4;</code>
+       * @return The doneTime.
+       */
+      public com.google.protobuf.Timestamp getDoneTime() {
+        if (doneTimeBuilder_ == null) {
+          return doneTime_ == null ? com.google.protobuf.Timestamp.getDefaultInstance() : doneTime_;
+        } else {
+          return doneTimeBuilder_.getMessage();
+        }
+      }
+      /**
+       * <pre>
+       * done_time is the time the barrier was completed
+       * </pre>
+       *
+       * <code>.google.protobuf.Timestamp done_time = 4;</code>
+       */
+      public Builder setDoneTime(com.google.protobuf.Timestamp value) {
+        if (doneTimeBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          doneTime_ = value;
+          onChanged();
+        } else {
+          doneTimeBuilder_.setMessage(value);
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * done_time is the time the barrier was completed
+       * </pre>
+       *
+       * <code>.google.protobuf.Timestamp done_time = 4;</code>
+       */
+      public Builder setDoneTime(
+          com.google.protobuf.Timestamp.Builder builderForValue) {
+        if (doneTimeBuilder_ == null) {
+          doneTime_ = builderForValue.build();
+          onChanged();
+        } else {
+          doneTimeBuilder_.setMessage(builderForValue.build());
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * done_time is the time the barrier was completed
+       * </pre>
+       *
+       * <code>.google.protobuf.Timestamp done_time = 4;</code>
+       */
+      public Builder mergeDoneTime(com.google.protobuf.Timestamp value) {
+        if (doneTimeBuilder_ == null) {
+          if (doneTime_ != null) {
+            doneTime_ =
+              com.google.protobuf.Timestamp.newBuilder(doneTime_).mergeFrom(value).buildPartial();
+          } else {
+            doneTime_ = value;
+          }
+          onChanged();
+        } else {
+          doneTimeBuilder_.mergeFrom(value);
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * done_time is the time the barrier was completed
+       * </pre>
+       *
+       * <code>.google.protobuf.Timestamp done_time = 4;</code>
+       */
+      public Builder clearDoneTime() {
+        if (doneTimeBuilder_ == null) {
+          doneTime_ = null;
+          onChanged();
+        } else {
+          doneTime_ = null;
+          doneTimeBuilder_ = null;
+        }
+
+        return this;
+      }
+      /**
+       * <pre>
+       * done_time is the time the barrier was completed
+       * </pre>
+       *
+       * <code>.google.protobuf.Timestamp done_time = 4;</code>
+       */
+      public com.google.protobuf.Timestamp.Builder getDoneTimeBuilder() {
+
+        onChanged();
+        return getDoneTimeFieldBuilder().getBuilder();
+      }
+      /**
+       * <pre>
+       * done_time is the time the barrier was completed
+       * </pre>
+       *
+       * <code>.google.protobuf.Timestamp done_time = 4;</code>
+       */
+      public com.google.protobuf.TimestampOrBuilder getDoneTimeOrBuilder() {
+        if (doneTimeBuilder_ != null) {
+          return doneTimeBuilder_.getMessageOrBuilder();
+        } else {
+          return doneTime_ == null ?
+              com.google.protobuf.Timestamp.getDefaultInstance() : doneTime_;
+        }
+      }
+      /**
+       * <pre>
+       * done_time is the time the barrier was completed
+       * </pre>
+       *
+       * <code>.google.protobuf.Timestamp done_time = 4;</code>
+       */
+      private com.google.protobuf.SingleFieldBuilderV3<
+          com.google.protobuf.Timestamp, com.google.protobuf.Timestamp.Builder, com.google.protobuf.TimestampOrBuilder>
+          getDoneTimeFieldBuilder() {
+        if (doneTimeBuilder_ == null) {
+          doneTimeBuilder_ = new com.google.protobuf.SingleFieldBuilderV3<
+              com.google.protobuf.Timestamp, com.google.protobuf.Timestamp.Builder, com.google.protobuf.TimestampOrBuilder>(
+                  getDoneTime(),
+                  getParentForChildren(),
+                  isClean());
+          doneTime_ = null;
+        }
+        return doneTimeBuilder_;
+      }
+
+      private int waiters_ ;
+      /**
+       * <pre>
+       * waiters is the number of calls awaiting the barrier
+       * </pre>
+       *
+       * <code>uint32 waiters = 5;</code>
+       * @return The waiters.
+       */
+      @java.lang.Override
+      public int getWaiters() {
+        return waiters_;
+      }
+      /**
+       * <pre>
+       * waiters is the number of calls awaiting the barrier
+       * </pre>
+       *
+       * <code>uint32 waiters = 5;</code>
+       * @param value The waiters to set.
+       * @return This builder for chaining.
+       */
+      public Builder setWaiters(int value) {
+
+        waiters_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * waiters is the number of calls awaiting the barrier
+       * </pre>
+       *
+       * <code>uint32 waiters = 5;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearWaiters() {
+
+        waiters_ = 0;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.SyncBarrier)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.SyncBarrier)
+    private static final io.gitpod.supervisor.api.Sync.SyncBarrier DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Sync.SyncBarrier();
+    }
+
+    public static io.gitpod.supervisor.api.Sync.SyncBarrier getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<SyncBarrier>
+        PARSER = new com.google.protobuf.AbstractParser<SyncBarrier>() {
+      @java.lang.Override
+      public SyncBarrier parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new SyncBarrier(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<SyncBarrier> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<SyncBarrier> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Sync.SyncBarrier getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface SyncDoneRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.SyncDoneRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string name = 1;</code>
+     * @return The name.
+     */
+    java.lang.String getName();
+    /**
+     * <code>string name = 1;</code>
+     * @return The bytes for name.
+     */
+    com.google.protobuf.ByteString
+        getNameBytes();
+
+    /**
+     * <code>string payload = 2;</code>
+     * @return The payload.
+     */
+    java.lang.String getPayload();
+    /**
+     * <code>string payload = 2;</code>
+     * @return The bytes for payload.
+     */
+    com.google.protobuf.ByteString
+        getPayloadBytes();
+  }
+  /**
+   * Protobuf type {@code supervisor.SyncDoneRequest}
+   */
+  public static final class SyncDoneRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.SyncDoneRequest)
+      SyncDoneRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use SyncDoneRequest.newBuilder() to construct.
+    private SyncDoneRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private SyncDoneRequest() {
+      name_ = "";
+      payload_ = "";
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new SyncDoneRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private SyncDoneRequest(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              name_ = s;
+              break;
+            }
+            case 18: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              payload_ = s;
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncDoneRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncDoneRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Sync.SyncDoneRequest.class, io.gitpod.supervisor.api.Sync.SyncDoneRequest.Builder.class);
+    }
+
+    public static final int NAME_FIELD_NUMBER = 1;
+    private volatile java.lang.Object name_;
+    /**
+     * <code>string name = 1;</code>
+     * @return The name.
+     */
+    @java.lang.Override
+    public java.lang.String getName() {
+      java.lang.Object ref = name_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        name_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string name = 1;</code>
+     * @return The bytes for name.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getNameBytes() {
+      java.lang.Object ref = name_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        name_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int PAYLOAD_FIELD_NUMBER = 2;
+    private volatile java.lang.Object payload_;
+    /**
+     * <code>string payload = 2;</code>
+     * @return The payload.
+     */
+    @java.lang.Override
+    public java.lang.String getPayload() {
+      java.lang.Object ref = payload_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        payload_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string payload = 2;</code>
+     * @return The bytes for payload.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getPayloadBytes() {
+      java.lang.Object ref = payload_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        payload_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(name_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, name_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(payload_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 2, payload_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(name_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, name_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(payload_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(2, payload_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Sync.SyncDoneRequest)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Sync.SyncDoneRequest other = (io.gitpod.supervisor.api.Sync.SyncDoneRequest) obj;
+
+      if (!getName()
+          .equals(other.getName())) return false;
+      if (!getPayload()
+          .equals(other.getPayload())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getName().hashCode();
+      hash = (37 * hash) + PAYLOAD_FIELD_NUMBER;
+      hash = (53 * hash) + getPayload().hashCode();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Sync.SyncDoneRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Sync.SyncDoneRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.SyncDoneRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.SyncDoneRequest)
+        io.gitpod.supervisor.api.Sync.SyncDoneRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncDoneRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncDoneRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Sync.SyncDoneRequest.class, io.gitpod.supervisor.api.Sync.SyncDoneRequest.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Sync.SyncDoneRequest.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        name_ = "";
+
+        payload_ = "";
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncDoneRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.SyncDoneRequest getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Sync.SyncDoneRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.SyncDoneRequest build() {
+        io.gitpod.supervisor.api.Sync.SyncDoneRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.SyncDoneRequest buildPartial() {
+        io.gitpod.supervisor.api.Sync.SyncDoneRequest result = new io.gitpod.supervisor.api.Sync.SyncDoneRequest(this);
+        result.name_ = name_;
+        result.payload_ = payload_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Sync.SyncDoneRequest) {
+          return mergeFrom((io.gitpod.supervisor.api.Sync.SyncDoneRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Sync.SyncDoneRequest other) {
+        if (other == io.gitpod.supervisor.api.Sync.SyncDoneRequest.getDefaultInstance()) return this;
+        if (!other.getName().isEmpty()) {
+          name_ = other.name_;
+          onChanged();
+        }
+        if (!other.getPayload().isEmpty()) {
+          payload_ = other.payload_;
+          onChanged();
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Sync.SyncDoneRequest parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Sync.SyncDoneRequest) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private java.lang.Object name_ = "";
+      /**
+       * <code>string name = 1;</code>
+       * @return The name.
+       */
+      public java.lang.String getName() {
+        java.lang.Object ref = name_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          name_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @return The bytes for name.
+       */
+      public com.google.protobuf.ByteString
+          getNameBytes() {
+        java.lang.Object ref = name_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          name_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @param value The name to set.
+       * @return This builder for chaining.
+       */
+      public Builder setName(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        name_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearName() {
+
+        name_ = getDefaultInstance().getName();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @param value The bytes for name to set.
+       * @return This builder for chaining.
+       */
+      public Builder setNameBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        name_ = value;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object payload_ = "";
+      /**
+       * <code>string payload = 2;</code>
+       * @return The payload.
+       */
+      public java.lang.String getPayload() {
+        java.lang.Object ref = payload_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          payload_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string payload = 2;</code>
+       * @return The bytes for payload.
+       */
+      public com.google.protobuf.ByteString
+          getPayloadBytes() {
+        java.lang.Object ref = payload_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          payload_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string payload = 2;</code>
+       * @param value The payload to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPayload(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        payload_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string payload = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPayload() {
+
+        payload_ = getDefaultInstance().getPayload();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string payload = 2;</code>
+       * @param value The bytes for payload to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPayloadBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        payload_ = value;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.SyncDoneRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.SyncDoneRequest)
+    private static final io.gitpod.supervisor.api.Sync.SyncDoneRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Sync.SyncDoneRequest();
+    }
+
+    public static io.gitpod.supervisor.api.Sync.SyncDoneRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<SyncDoneRequest>
+        PARSER = new com.google.protobuf.AbstractParser<SyncDoneRequest>() {
+      @java.lang.Override
+      public SyncDoneRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new SyncDoneRequest(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<SyncDoneRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<SyncDoneRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Sync.SyncDoneRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface SyncDoneResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.SyncDoneResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>.supervisor.SyncBarrier barrier = 1;</code>
+     * @return Whether the barrier field is set.
+     */
+    boolean hasBarrier();
+    /**
+     * <code>.supervisor.SyncBarrier barrier = 1;</code>
+     * @return The barrier.
+     */
+    io.gitpod.supervisor.api.Sync.SyncBarrier getBarrier();
+    /**
+     * <code>.supervisor.SyncBarrier barrier = 1;</code>
+     */
+    io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder getBarrierOrBuilder();
+  }
+  /**
+   * Protobuf type {@code supervisor.SyncDoneResponse}
+   */
+  public static final class SyncDoneResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.SyncDoneResponse)
+      SyncDoneResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use SyncDoneResponse.newBuilder() to construct.
+    private SyncDoneResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private SyncDoneResponse() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new SyncDoneResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private SyncDoneResponse(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              io.gitpod.supervisor.api.Sync.SyncBarrier.Builder subBuilder = null;
+              if (barrier_ != null) {
+                subBuilder = barrier_.toBuilder();
+              }
+              barrier_ = input.readMessage(io.gitpod.supervisor.api.Sync.SyncBarrier.parser(), extensionRegistry);
+              if (subBuilder != null) {
+                subBuilder.mergeFrom(barrier_);
+                barrier_ = subBuilder.buildPartial();
+              }
+
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncDoneResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncDoneResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Sync.SyncDoneResponse.class, io.gitpod.supervisor.api.Sync.SyncDoneResponse.Builder.class);
+    }
+
+    public static final int BARRIER_FIELD_NUMBER = 1;
+    private io.gitpod.supervisor.api.Sync.SyncBarrier barrier_;
+    /**
+     * <code>.supervisor.SyncBarrier barrier = 1;</code>
+     * @return Whether the barrier field is set.
+     */
+    @java.lang.Override
+    public boolean hasBarrier() {
+      return barrier_ != null;
+    }
+    /**
+     * <code>.supervisor.SyncBarrier barrier = 1;</code>
+     * @return The barrier.
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Sync.SyncBarrier getBarrier() {
+      return barrier_ == null ? io.gitpod.supervisor.api.Sync.SyncBarrier.getDefaultInstance() : barrier_;
+    }
+    /**
+     * <code>.supervisor.SyncBarrier barrier = 1;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder getBarrierOrBuilder() {
+      return getBarrier();
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (barrier_ != null) {
+        output.writeMessage(1, getBarrier());
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (barrier_ != null) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(1, getBarrier());
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Sync.SyncDoneResponse)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Sync.SyncDoneResponse other = (io.gitpod.supervisor.api.Sync.SyncDoneResponse) obj;
+
+      if (hasBarrier() != other.hasBarrier()) return false;
+      if (hasBarrier()) {
+        if (!getBarrier()
+            .equals(other.getBarrier())) return false;
+      }
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      if (hasBarrier()) {
+        hash = (37 * hash) + BARRIER_FIELD_NUMBER;
+        hash = (53 * hash) + getBarrier().hashCode();
+      }
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Sync.SyncDoneResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncDoneResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Sync.SyncDoneResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.SyncDoneResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.SyncDoneResponse)
+        io.gitpod.supervisor.api.Sync.SyncDoneResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncDoneResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncDoneResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Sync.SyncDoneResponse.class, io.gitpod.supervisor.api.Sync.SyncDoneResponse.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Sync.SyncDoneResponse.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        if (barrierBuilder_ == null) {
+          barrier_ = null;
+        } else {
+          barrier_ = null;
+          barrierBuilder_ = null;
+        }
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncDoneResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.SyncDoneResponse getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Sync.SyncDoneResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.SyncDoneResponse build() {
+        io.gitpod.supervisor.api.Sync.SyncDoneResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.SyncDoneResponse buildPartial() {
+        io.gitpod.supervisor.api.Sync.SyncDoneResponse result = new io.gitpod.supervisor.api.Sync.SyncDoneResponse(this);
+        if (barrierBuilder_ == null) {
+          result.barrier_ = barrier_;
+        } else {
+          result.barrier_ = barrierBuilder_.build();
+        }
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Sync.SyncDoneResponse) {
+          return mergeFrom((io.gitpod.supervisor.api.Sync.SyncDoneResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Sync.SyncDoneResponse other) {
+        if (other == io.gitpod.supervisor.api.Sync.SyncDoneResponse.getDefaultInstance()) return this;
+        if (other.hasBarrier()) {
+          mergeBarrier(other.getBarrier());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Sync.SyncDoneResponse parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Sync.SyncDoneResponse) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private io.gitpod.supervisor.api.Sync.SyncBarrier barrier_;
+      private com.google.protobuf.SingleFieldBuilderV3<
+          io.gitpod.supervisor.api.Sync.SyncBarrier, io.gitpod.supervisor.api.Sync.SyncBarrier.Builder, io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder> barrierBuilder_;
+      /**
+       * <code>.supervisor.SyncBarrier barrier = 1;</code>
+       * @return Whether the barrier field is set.
+       */
+      public boolean hasBarrier() {
+        return barrierBuilder_ != null || barrier_ != null;
+      }
+      /**
+       * <code>.supervisor.SyncBarrier barrier = 1;</code>
+       * @return The barrier.
+       */
+      public io.gitpod.supervisor.api.Sync.SyncBarrier getBarrier() {
+        if (barrierBuilder_ == null) {
+          return barrier_ == null ? io.gitpod.supervisor.api.Sync.SyncBarrier.getDefaultInstance() : barrier_;
+        } else {
+          return barrierBuilder_.getMessage();
+        }
+      }
+      /**
+       * <code>.supervisor.SyncBarrier barrier = 1;</code>
+       */
+      public Builder setBarrier(io.gitpod.supervisor.api.Sync.SyncBarrier value) {
+        if (barrierBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          barrier_ = value;
+          onChanged();
+        } else {
+          barrierBuilder_.setMessage(value);
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.SyncBarrier barrier = 1;</code>
+       */
+      public Builder setBarrier(
+          io.gitpod.supervisor.api.Sync.SyncBarrier.Builder builderForValue) {
+        if (barrierBuilder_ == null) {
+          barrier_ = builderForValue.build();
+          onChanged();
+        } else {
+          barrierBuilder_.setMessage(builderForValue.build());
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.SyncBarrier barrier = 1;</code>
+       */
+      public Builder mergeBarrier(io.gitpod.supervisor.api.Sync.SyncBarrier value) {
+        if (barrierBuilder_ == null) {
+          if (barrier_ != null) {
+            barrier_ =
+              io.gitpod.supervisor.api.Sync.SyncBarrier.newBuilder(barrier_).mergeFrom(value).buildPartial();
+          } else {
+            barrier_ = value;
+          }
+          onChanged();
+        } else {
+          barrierBuilder_.mergeFrom(value);
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.SyncBarrier barrier = 1;</code>
+       */
+      public Builder clearBarrier() {
+        if (barrierBuilder_ == null) {
+          barrier_ = null;
+          onChanged();
+        } else {
+          barrier_ = null;
+          barrierBuilder_ = null;
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.SyncBarrier barrier = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Sync.SyncBarrier.Builder getBarrierBuilder() {
+
+        onChanged();
+        return getBarrierFieldBuilder().getBuilder();
+      }
+      /**
+       * <code>.supervisor.SyncBarrier barrier = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder getBarrierOrBuilder() {
+        if (barrierBuilder_ != null) {
+          return barrierBuilder_.getMessageOrBuilder();
+        } else {
+          return barrier_ == null ?
+              io.gitpod.supervisor.api.Sync.SyncBarrier.getDefaultInstance() : barrier_;
+        }
+      }
+      /**
+       * <code>.supervisor.SyncBarrier barrier = 1;</code>
+       */
+      private com.google.protobuf.SingleFieldBuilderV3<
+          io.gitpod.supervisor.api.Sync.SyncBarrier, io.gitpod.supervisor.api.Sync.SyncBarrier.Builder, io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder>
+          getBarrierFieldBuilder() {
+        if (barrierBuilder_ == null) {
+          barrierBuilder_ = new com.google.protobuf.SingleFieldBuilderV3<
+              io.gitpod.supervisor.api.Sync.SyncBarrier, io.gitpod.supervisor.api.Sync.SyncBarrier.Builder, io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder>(
+                  getBarrier(),
+                  getParentForChildren(),
+                  isClean());
+          barrier_ = null;
+        }
+        return barrierBuilder_;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.SyncDoneResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.SyncDoneResponse)
+    private static final io.gitpod.supervisor.api.Sync.SyncDoneResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Sync.SyncDoneResponse();
+    }
+
+    public static io.gitpod.supervisor.api.Sync.SyncDoneResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<SyncDoneResponse>
+        PARSER = new com.google.protobuf.AbstractParser<SyncDoneResponse>() {
+      @java.lang.Override
+      public SyncDoneResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new SyncDoneResponse(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<SyncDoneResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<SyncDoneResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Sync.SyncDoneResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface SyncAwaitRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.SyncAwaitRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string name = 1;</code>
+     * @return The name.
+     */
+    java.lang.String getName();
+    /**
+     * <code>string name = 1;</code>
+     * @return The bytes for name.
+     */
+    com.google.protobuf.ByteString
+        getNameBytes();
+  }
+  /**
+   * Protobuf type {@code supervisor.SyncAwaitRequest}
+   */
+  public static final class SyncAwaitRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.SyncAwaitRequest)
+      SyncAwaitRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use SyncAwaitRequest.newBuilder() to construct.
+    private SyncAwaitRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private SyncAwaitRequest() {
+      name_ = "";
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new SyncAwaitRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private SyncAwaitRequest(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              name_ = s;
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncAwaitRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncAwaitRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Sync.SyncAwaitRequest.class, io.gitpod.supervisor.api.Sync.SyncAwaitRequest.Builder.class);
+    }
+
+    public static final int NAME_FIELD_NUMBER = 1;
+    private volatile java.lang.Object name_;
+    /**
+     * <code>string name = 1;</code>
+     * @return The name.
+     */
+    @java.lang.Override
+    public java.lang.String getName() {
+      java.lang.Object ref = name_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        name_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string name = 1;</code>
+     * @return The bytes for name.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getNameBytes() {
+      java.lang.Object ref = name_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        name_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(name_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, name_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(name_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, name_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Sync.SyncAwaitRequest)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Sync.SyncAwaitRequest other = (io.gitpod.supervisor.api.Sync.SyncAwaitRequest) obj;
+
+      if (!getName()
+          .equals(other.getName())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getName().hashCode();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Sync.SyncAwaitRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.SyncAwaitRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.SyncAwaitRequest)
+        io.gitpod.supervisor.api.Sync.SyncAwaitRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncAwaitRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncAwaitRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Sync.SyncAwaitRequest.class, io.gitpod.supervisor.api.Sync.SyncAwaitRequest.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Sync.SyncAwaitRequest.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        name_ = "";
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncAwaitRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.SyncAwaitRequest getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Sync.SyncAwaitRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.SyncAwaitRequest build() {
+        io.gitpod.supervisor.api.Sync.SyncAwaitRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.SyncAwaitRequest buildPartial() {
+        io.gitpod.supervisor.api.Sync.SyncAwaitRequest result = new io.gitpod.supervisor.api.Sync.SyncAwaitRequest(this);
+        result.name_ = name_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Sync.SyncAwaitRequest) {
+          return mergeFrom((io.gitpod.supervisor.api.Sync.SyncAwaitRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Sync.SyncAwaitRequest other) {
+        if (other == io.gitpod.supervisor.api.Sync.SyncAwaitRequest.getDefaultInstance()) return this;
+        if (!other.getName().isEmpty()) {
+          name_ = other.name_;
+          onChanged();
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Sync.SyncAwaitRequest parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Sync.SyncAwaitRequest) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private java.lang.Object name_ = "";
+      /**
+       * <code>string name = 1;</code>
+       * @return The name.
+       */
+      public java.lang.String getName() {
+        java.lang.Object ref = name_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          name_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @return The bytes for name.
+       */
+      public com.google.protobuf.ByteString
+          getNameBytes() {
+        java.lang.Object ref = name_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          name_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @param value The name to set.
+       * @return This builder for chaining.
+       */
+      public Builder setName(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        name_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearName() {
+
+        name_ = getDefaultInstance().getName();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @param value The bytes for name to set.
+       * @return This builder for chaining.
+       */
+      public Builder setNameBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        name_ = value;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.SyncAwaitRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.SyncAwaitRequest)
+    private static final io.gitpod.supervisor.api.Sync.SyncAwaitRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Sync.SyncAwaitRequest();
+    }
+
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<SyncAwaitRequest>
+        PARSER = new com.google.protobuf.AbstractParser<SyncAwaitRequest>() {
+      @java.lang.Override
+      public SyncAwaitRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new SyncAwaitRequest(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<SyncAwaitRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<SyncAwaitRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Sync.SyncAwaitRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface SyncAwaitResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.SyncAwaitResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>.supervisor.SyncBarrier barrier = 1;</code>
+     * @return Whether the barrier field is set.
+     */
+    boolean hasBarrier();
+    /**
+     * <code>.supervisor.SyncBarrier barrier = 1;</code>
+     * @return The barrier.
+     */
+    io.gitpod.supervisor.api.Sync.SyncBarrier getBarrier();
+    /**
+     * <code>.supervisor.SyncBarrier barrier = 1;</code>
+     */
+    io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder getBarrierOrBuilder();
+  }
+  /**
+   * Protobuf type {@code supervisor.SyncAwaitResponse}
+   */
+  public static final class SyncAwaitResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.SyncAwaitResponse)
+      SyncAwaitResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use SyncAwaitResponse.newBuilder() to construct.
+    private SyncAwaitResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private SyncAwaitResponse() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new SyncAwaitResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private SyncAwaitResponse(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              io.gitpod.supervisor.api.Sync.SyncBarrier.Builder subBuilder = null;
+              if (barrier_ != null) {
+                subBuilder = barrier_.toBuilder();
+              }
+              barrier_ = input.readMessage(io.gitpod.supervisor.api.Sync.SyncBarrier.parser(), extensionRegistry);
+              if (subBuilder != null) {
+                subBuilder.mergeFrom(barrier_);
+                barrier_ = subBuilder.buildPartial();
+              }
+
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncAwaitResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncAwaitResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Sync.SyncAwaitResponse.class, io.gitpod.supervisor.api.Sync.SyncAwaitResponse.Builder.class);
+    }
+
+    public static final int BARRIER_FIELD_NUMBER = 1;
+    private io.gitpod.supervisor.api.Sync.SyncBarrier barrier_;
+    /**
+     * <code>.supervisor.SyncBarrier barrier = 1;</code>
+     * @return Whether the barrier field is set.
+     */
+    @java.lang.Override
+    public boolean hasBarrier() {
+      return barrier_ != null;
+    }
+    /**
+     * <code>.supervisor.SyncBarrier barrier = 1;</code>
+     * @return The barrier.
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Sync.SyncBarrier getBarrier() {
+      return barrier_ == null ? io.gitpod.supervisor.api.Sync.SyncBarrier.getDefaultInstance() : barrier_;
+    }
+    /**
+     * <code>.supervisor.SyncBarrier barrier = 1;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder getBarrierOrBuilder() {
+      return getBarrier();
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (barrier_ != null) {
+        output.writeMessage(1, getBarrier());
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (barrier_ != null) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(1, getBarrier());
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Sync.SyncAwaitResponse)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Sync.SyncAwaitResponse other = (io.gitpod.supervisor.api.Sync.SyncAwaitResponse) obj;
+
+      if (hasBarrier() != other.hasBarrier()) return false;
+      if (hasBarrier()) {
+        if (!getBarrier()
+            .equals(other.getBarrier())) return false;
+      }
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      if (hasBarrier()) {
+        hash = (37 * hash) + BARRIER_FIELD_NUMBER;
+        hash = (53 * hash) + getBarrier().hashCode();
+      }
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Sync.SyncAwaitResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.SyncAwaitResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.SyncAwaitResponse)
+        io.gitpod.supervisor.api.Sync.SyncAwaitResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncAwaitResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncAwaitResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Sync.SyncAwaitResponse.class, io.gitpod.supervisor.api.Sync.SyncAwaitResponse.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Sync.SyncAwaitResponse.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        if (barrierBuilder_ == null) {
+          barrier_ = null;
+        } else {
+          barrier_ = null;
+          barrierBuilder_ = null;
+        }
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_SyncAwaitResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.SyncAwaitResponse getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Sync.SyncAwaitResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.SyncAwaitResponse build() {
+        io.gitpod.supervisor.api.Sync.SyncAwaitResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.SyncAwaitResponse buildPartial() {
+        io.gitpod.supervisor.api.Sync.SyncAwaitResponse result = new io.gitpod.supervisor.api.Sync.SyncAwaitResponse(this);
+        if (barrierBuilder_ == null) {
+          result.barrier_ = barrier_;
+        } else {
+          result.barrier_ = barrierBuilder_.build();
+        }
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Sync.SyncAwaitResponse) {
+          return mergeFrom((io.gitpod.supervisor.api.Sync.SyncAwaitResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Sync.SyncAwaitResponse other) {
+        if (other == io.gitpod.supervisor.api.Sync.SyncAwaitResponse.getDefaultInstance()) return this;
+        if (other.hasBarrier()) {
+          mergeBarrier(other.getBarrier());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Sync.SyncAwaitResponse parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Sync.SyncAwaitResponse) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private io.gitpod.supervisor.api.Sync.SyncBarrier barrier_;
+      private com.google.protobuf.SingleFieldBuilderV3<
+          io.gitpod.supervisor.api.Sync.SyncBarrier, io.gitpod.supervisor.api.Sync.SyncBarrier.Builder, io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder> barrierBuilder_;
+      /**
+       * <code>.supervisor.SyncBarrier barrier = 1;</code>
+       * @return Whether the barrier field is set.
+       */
+      public boolean hasBarrier() {
+        return barrierBuilder_ != null || barrier_ != null;
+      }
+      /**
+       * <code>.supervisor.SyncBarrier barrier = 1;</code>
+       * @return The barrier.
+       */
+      public io.gitpod.supervisor.api.Sync.SyncBarrier getBarrier() {
+        if (barrierBuilder_ == null) {
+          return barrier_ == null ? io.gitpod.supervisor.api.Sync.SyncBarrier.getDefaultInstance() : barrier_;
+        } else {
+          return barrierBuilder_.getMessage();
+        }
+      }
+      /**
+       * <code>.supervisor.SyncBarrier barrier = 1;</code>
+       */
+      public Builder setBarrier(io.gitpod.supervisor.api.Sync.SyncBarrier value) {
+        if (barrierBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          barrier_ = value;
+          onChanged();
+        } else {
+          barrierBuilder_.setMessage(value);
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.SyncBarrier barrier = 1;</code>
+       */
+      public Builder setBarrier(
+          io.gitpod.supervisor.api.Sync.SyncBarrier.Builder builderForValue) {
+        if (barrierBuilder_ == null) {
+          barrier_ = builderForValue.build();
+          onChanged();
+        } else {
+          barrierBuilder_.setMessage(builderForValue.build());
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.SyncBarrier barrier = 1;</code>
+       */
+      public Builder mergeBarrier(io.gitpod.supervisor.api.Sync.SyncBarrier value) {
+        if (barrierBuilder_ == null) {
+          if (barrier_ != null) {
+            barrier_ =
+              io.gitpod.supervisor.api.Sync.SyncBarrier.newBuilder(barrier_).mergeFrom(value).buildPartial();
+          } else {
+            barrier_ = value;
+          }
+          onChanged();
+        } else {
+          barrierBuilder_.mergeFrom(value);
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.SyncBarrier barrier = 1;</code>
+       */
+      public Builder clearBarrier() {
+        if (barrierBuilder_ == null) {
+          barrier_ = null;
+          onChanged();
+        } else {
+          barrier_ = null;
+          barrierBuilder_ = null;
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.SyncBarrier barrier = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Sync.SyncBarrier.Builder getBarrierBuilder() {
+
+        onChanged();
+        return getBarrierFieldBuilder().getBuilder();
+      }
+      /**
+       * <code>.supervisor.SyncBarrier barrier = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder getBarrierOrBuilder() {
+        if (barrierBuilder_ != null) {
+          return barrierBuilder_.getMessageOrBuilder();
+        } else {
+          return barrier_ == null ?
+              io.gitpod.supervisor.api.Sync.SyncBarrier.getDefaultInstance() : barrier_;
+        }
+      }
+      /**
+       * <code>.supervisor.SyncBarrier barrier = 1;</code>
+       */
+      private com.google.protobuf.SingleFieldBuilderV3<
+          io.gitpod.supervisor.api.Sync.SyncBarrier, io.gitpod.supervisor.api.Sync.SyncBarrier.Builder, io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder>
+          getBarrierFieldBuilder() {
+        if (barrierBuilder_ == null) {
+          barrierBuilder_ = new com.google.protobuf.SingleFieldBuilderV3<
+              io.gitpod.supervisor.api.Sync.SyncBarrier, io.gitpod.supervisor.api.Sync.SyncBarrier.Builder, io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder>(
+                  getBarrier(),
+                  getParentForChildren(),
+                  isClean());
+          barrier_ = null;
+        }
+        return barrierBuilder_;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.SyncAwaitResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.SyncAwaitResponse)
+    private static final io.gitpod.supervisor.api.Sync.SyncAwaitResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Sync.SyncAwaitResponse();
+    }
+
+    public static io.gitpod.supervisor.api.Sync.SyncAwaitResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<SyncAwaitResponse>
+        PARSER = new com.google.protobuf.AbstractParser<SyncAwaitResponse>() {
+      @java.lang.Override
+      public SyncAwaitResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new SyncAwaitResponse(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<SyncAwaitResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<SyncAwaitResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Sync.SyncAwaitResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ListSyncBarriersRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ListSyncBarriersRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <pre>
+     * pending_only lists the barriers which are awaited but not done only
+     * </pre>
+     *
+     * <code>bool pending_only = 1;</code>
+     * @return The pendingOnly.
+     */
+    boolean getPendingOnly();
+  }
+  /**
+   * Protobuf type {@code supervisor.ListSyncBarriersRequest}
+   */
+  public static final class ListSyncBarriersRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ListSyncBarriersRequest)
+      ListSyncBarriersRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ListSyncBarriersRequest.newBuilder() to construct.
+    private ListSyncBarriersRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ListSyncBarriersRequest() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ListSyncBarriersRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ListSyncBarriersRequest(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 8: {
+
+              pendingOnly_ = input.readBool();
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ListSyncBarriersRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ListSyncBarriersRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest.class, io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest.Builder.class);
+    }
+
+    public static final int PENDING_ONLY_FIELD_NUMBER = 1;
+    private boolean pendingOnly_;
+    /**
+     * <pre>
+     * pending_only lists the barriers which are awaited but not done only
+     * </pre>
+     *
+     * <code>bool pending_only = 1;</code>
+     * @return The pendingOnly.
+     */
+    @java.lang.Override
+    public boolean getPendingOnly() {
+      return pendingOnly_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (pendingOnly_ != false) {
+        output.writeBool(1, pendingOnly_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (pendingOnly_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(1, pendingOnly_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest other = (io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest) obj;
+
+      if (getPendingOnly()
+          != other.getPendingOnly()) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + PENDING_ONLY_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getPendingOnly());
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ListSyncBarriersRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ListSyncBarriersRequest)
+        io.gitpod.supervisor.api.Sync.ListSyncBarriersRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ListSyncBarriersRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ListSyncBarriersRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest.class, io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        pendingOnly_ = false;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ListSyncBarriersRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest build() {
+        io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest buildPartial() {
+        io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest result = new io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest(this);
+        result.pendingOnly_ = pendingOnly_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest) {
+          return mergeFrom((io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest other) {
+        if (other == io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest.getDefaultInstance()) return this;
+        if (other.getPendingOnly() != false) {
+          setPendingOnly(other.getPendingOnly());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private boolean pendingOnly_ ;
+      /**
+       * <pre>
+       * pending_only lists the barriers which are awaited but not done only
+       * </pre>
+       *
+       * <code>bool pending_only = 1;</code>
+       * @return The pendingOnly.
+       */
+      @java.lang.Override
+      public boolean getPendingOnly() {
+        return pendingOnly_;
+      }
+      /**
+       * <pre>
+       * pending_only lists the barriers which are awaited but not done only
+       * </pre>
+       *
+       * <code>bool pending_only = 1;</code>
+       * @param value The pendingOnly to set.
+       * @return This builder for chaining.
+       */
+      public Builder setPendingOnly(boolean value) {
+
+        pendingOnly_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * pending_only lists the barriers which are awaited but not done only
+       * </pre>
+       *
+       * <code>bool pending_only = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearPendingOnly() {
+
+        pendingOnly_ = false;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ListSyncBarriersRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ListSyncBarriersRequest)
+    private static final io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest();
+    }
+
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ListSyncBarriersRequest>
+        PARSER = new com.google.protobuf.AbstractParser<ListSyncBarriersRequest>() {
+      @java.lang.Override
+      public ListSyncBarriersRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ListSyncBarriersRequest(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ListSyncBarriersRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ListSyncBarriersRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ListSyncBarriersResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ListSyncBarriersResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+     */
+    java.util.List<io.gitpod.supervisor.api.Sync.SyncBarrier>
+        getBarriersList();
+    /**
+     * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+     */
+    io.gitpod.supervisor.api.Sync.SyncBarrier getBarriers(int index);
+    /**
+     * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+     */
+    int getBarriersCount();
+    /**
+     * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+     */
+    java.util.List<? extends io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder>
+        getBarriersOrBuilderList();
+    /**
+     * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+     */
+    io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder getBarriersOrBuilder(
+        int index);
+  }
+  /**
+   * Protobuf type {@code supervisor.ListSyncBarriersResponse}
+   */
+  public static final class ListSyncBarriersResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ListSyncBarriersResponse)
+      ListSyncBarriersResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ListSyncBarriersResponse.newBuilder() to construct.
+    private ListSyncBarriersResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ListSyncBarriersResponse() {
+      barriers_ = java.util.Collections.emptyList();
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ListSyncBarriersResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ListSyncBarriersResponse(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      int mutable_bitField0_ = 0;
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              if (!((mutable_bitField0_ & 0x00000001) != 0)) {
+                barriers_ = new java.util.ArrayList<io.gitpod.supervisor.api.Sync.SyncBarrier>();
+                mutable_bitField0_ |= 0x00000001;
+              }
+              barriers_.add(
+                  input.readMessage(io.gitpod.supervisor.api.Sync.SyncBarrier.parser(), extensionRegistry));
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        if (((mutable_bitField0_ & 0x00000001) != 0)) {
+          barriers_ = java.util.Collections.unmodifiableList(barriers_);
+        }
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ListSyncBarriersResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ListSyncBarriersResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse.class, io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse.Builder.class);
+    }
+
+    public static final int BARRIERS_FIELD_NUMBER = 1;
+    private java.util.List<io.gitpod.supervisor.api.Sync.SyncBarrier> barriers_;
+    /**
+     * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+     */
+    @java.lang.Override
+    public java.util.List<io.gitpod.supervisor.api.Sync.SyncBarrier> getBarriersList() {
+      return barriers_;
+    }
+    /**
+     * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+     */
+    @java.lang.Override
+    public java.util.List<? extends io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder>
+        getBarriersOrBuilderList() {
+      return barriers_;
+    }
+    /**
+     * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+     */
+    @java.lang.Override
+    public int getBarriersCount() {
+      return barriers_.size();
+    }
+    /**
+     * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Sync.SyncBarrier getBarriers(int index) {
+      return barriers_.get(index);
+    }
+    /**
+     * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder getBarriersOrBuilder(
+        int index) {
+      return barriers_.get(index);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      for (int i = 0; i < barriers_.size(); i++) {
+        output.writeMessage(1, barriers_.get(i));
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      for (int i = 0; i < barriers_.size(); i++) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(1, barriers_.get(i));
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse other = (io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse) obj;
+
+      if (!getBarriersList()
+          .equals(other.getBarriersList())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      if (getBarriersCount() > 0) {
+        hash = (37 * hash) + BARRIERS_FIELD_NUMBER;
+        hash = (53 * hash) + getBarriersList().hashCode();
+      }
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ListSyncBarriersResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ListSyncBarriersResponse)
+        io.gitpod.supervisor.api.Sync.ListSyncBarriersResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ListSyncBarriersResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ListSyncBarriersResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse.class, io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+          getBarriersFieldBuilder();
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        if (barriersBuilder_ == null) {
+          barriers_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+        } else {
+          barriersBuilder_.clear();
+        }
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ListSyncBarriersResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse build() {
+        io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse buildPartial() {
+        io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse result = new io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse(this);
+        int from_bitField0_ = bitField0_;
+        if (barriersBuilder_ == null) {
+          if (((bitField0_ & 0x00000001) != 0)) {
+            barriers_ = java.util.Collections.unmodifiableList(barriers_);
+            bitField0_ = (bitField0_ & ~0x00000001);
+          }
+          result.barriers_ = barriers_;
+        } else {
+          result.barriers_ = barriersBuilder_.build();
+        }
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse) {
+          return mergeFrom((io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse other) {
+        if (other == io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse.getDefaultInstance()) return this;
+        if (barriersBuilder_ == null) {
+          if (!other.barriers_.isEmpty()) {
+            if (barriers_.isEmpty()) {
+              barriers_ = other.barriers_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+            } else {
+              ensureBarriersIsMutable();
+              barriers_.addAll(other.barriers_);
+            }
+            onChanged();
+          }
+        } else {
+          if (!other.barriers_.isEmpty()) {
+            if (barriersBuilder_.isEmpty()) {
+              barriersBuilder_.dispose();
+              barriersBuilder_ = null;
+              barriers_ = other.barriers_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+              barriersBuilder_ =
+                com.google.protobuf.GeneratedMessageV3.alwaysUseFieldBuilders ?
+                   getBarriersFieldBuilder() : null;
+            } else {
+              barriersBuilder_.addAllMessages(other.barriers_);
+            }
+          }
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+      private int bitField0_;
+
+      private java.util.List<io.gitpod.supervisor.api.Sync.SyncBarrier> barriers_ =
+        java.util.Collections.emptyList();
+      private void ensureBarriersIsMutable() {
+        if (!((bitField0_ & 0x00000001) != 0)) {
+          barriers_ = new java.util.ArrayList<io.gitpod.supervisor.api.Sync.SyncBarrier>(barriers_);
+          bitField0_ |= 0x00000001;
+         }
+      }
+
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          io.gitpod.supervisor.api.Sync.SyncBarrier, io.gitpod.supervisor.api.Sync.SyncBarrier.Builder, io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder> barriersBuilder_;
+
+      /**
+       * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+       */
+      public java.util.List<io.gitpod.supervisor.api.Sync.SyncBarrier> getBarriersList() {
+        if (barriersBuilder_ == null) {
+          return java.util.Collections.unmodifiableList(barriers_);
+        } else {
+          return barriersBuilder_.getMessageList();
+        }
+      }
+      /**
+       * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+       */
+      public int getBarriersCount() {
+        if (barriersBuilder_ == null) {
+          return barriers_.size();
+        } else {
+          return barriersBuilder_.getCount();
+        }
+      }
+      /**
+       * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Sync.SyncBarrier getBarriers(int index) {
+        if (barriersBuilder_ == null) {
+          return barriers_.get(index);
+        } else {
+          return barriersBuilder_.getMessage(index);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+       */
+      public Builder setBarriers(
+          int index, io.gitpod.supervisor.api.Sync.SyncBarrier value) {
+        if (barriersBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureBarriersIsMutable();
+          barriers_.set(index, value);
+          onChanged();
+        } else {
+          barriersBuilder_.setMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+       */
+      public Builder setBarriers(
+          int index, io.gitpod.supervisor.api.Sync.SyncBarrier.Builder builderForValue) {
+        if (barriersBuilder_ == null) {
+          ensureBarriersIsMutable();
+          barriers_.set(index, builderForValue.build());
+          onChanged();
+        } else {
+          barriersBuilder_.setMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+       */
+      public Builder addBarriers(io.gitpod.supervisor.api.Sync.SyncBarrier value) {
+        if (barriersBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureBarriersIsMutable();
+          barriers_.add(value);
+          onChanged();
+        } else {
+          barriersBuilder_.addMessage(value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+       */
+      public Builder addBarriers(
+          int index, io.gitpod.supervisor.api.Sync.SyncBarrier value) {
+        if (barriersBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureBarriersIsMutable();
+          barriers_.add(index, value);
+          onChanged();
+        } else {
+          barriersBuilder_.addMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+       */
+      public Builder addBarriers(
+          io.gitpod.supervisor.api.Sync.SyncBarrier.Builder builderForValue) {
+        if (barriersBuilder_ == null) {
+          ensureBarriersIsMutable();
+          barriers_.add(builderForValue.build());
+          onChanged();
+        } else {
+          barriersBuilder_.addMessage(builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+       */
+      public Builder addBarriers(
+          int index, io.gitpod.supervisor.api.Sync.SyncBarrier.Builder builderForValue) {
+        if (barriersBuilder_ == null) {
+          ensureBarriersIsMutable();
+          barriers_.add(index, builderForValue.build());
+          onChanged();
+        } else {
+          barriersBuilder_.addMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+       */
+      public Builder addAllBarriers(
+          java.lang.Iterable<? extends io.gitpod.supervisor.api.Sync.SyncBarrier> values) {
+        if (barriersBuilder_ == null) {
+          ensureBarriersIsMutable();
+          com.google.protobuf.AbstractMessageLite.Builder.addAll(
+              values, barriers_);
+          onChanged();
+        } else {
+          barriersBuilder_.addAllMessages(values);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+       */
+      public Builder clearBarriers() {
+        if (barriersBuilder_ == null) {
+          barriers_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+          onChanged();
+        } else {
+          barriersBuilder_.clear();
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+       */
+      public Builder removeBarriers(int index) {
+        if (barriersBuilder_ == null) {
+          ensureBarriersIsMutable();
+          barriers_.remove(index);
+          onChanged();
+        } else {
+          barriersBuilder_.remove(index);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Sync.SyncBarrier.Builder getBarriersBuilder(
+          int index) {
+        return getBarriersFieldBuilder().getBuilder(index);
+      }
+      /**
+       * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder getBarriersOrBuilder(
+          int index) {
+        if (barriersBuilder_ == null) {
+          return barriers_.get(index);  } else {
+          return barriersBuilder_.getMessageOrBuilder(index);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+       */
+      public java.util.List<? extends io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder>
+           getBarriersOrBuilderList() {
+        if (barriersBuilder_ != null) {
+          return barriersBuilder_.getMessageOrBuilderList();
+        } else {
+          return java.util.Collections.unmodifiableList(barriers_);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Sync.SyncBarrier.Builder addBarriersBuilder() {
+        return getBarriersFieldBuilder().addBuilder(
+            io.gitpod.supervisor.api.Sync.SyncBarrier.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Sync.SyncBarrier.Builder addBarriersBuilder(
+          int index) {
+        return getBarriersFieldBuilder().addBuilder(
+            index, io.gitpod.supervisor.api.Sync.SyncBarrier.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .supervisor.SyncBarrier barriers = 1;</code>
+       */
+      public java.util.List<io.gitpod.supervisor.api.Sync.SyncBarrier.Builder>
+           getBarriersBuilderList() {
+        return getBarriersFieldBuilder().getBuilderList();
+      }
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          io.gitpod.supervisor.api.Sync.SyncBarrier, io.gitpod.supervisor.api.Sync.SyncBarrier.Builder, io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder>
+          getBarriersFieldBuilder() {
+        if (barriersBuilder_ == null) {
+          barriersBuilder_ = new com.google.protobuf.RepeatedFieldBuilderV3<
+              io.gitpod.supervisor.api.Sync.SyncBarrier, io.gitpod.supervisor.api.Sync.SyncBarrier.Builder, io.gitpod.supervisor.api.Sync.SyncBarrierOrBuilder>(
+                  barriers_,
+                  ((bitField0_ & 0x00000001) != 0),
+                  getParentForChildren(),
+                  isClean());
+          barriers_ = null;
+        }
+        return barriersBuilder_;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ListSyncBarriersResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ListSyncBarriersResponse)
+    private static final io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse();
+    }
+
+    public static io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ListSyncBarriersResponse>
+        PARSER = new com.google.protobuf.AbstractParser<ListSyncBarriersResponse>() {
+      @java.lang.Override
+      public ListSyncBarriersResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ListSyncBarriersResponse(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ListSyncBarriersResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ListSyncBarriersResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ResetSyncBarrierRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ResetSyncBarrierRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string name = 1;</code>
+     * @return The name.
+     */
+    java.lang.String getName();
+    /**
+     * <code>string name = 1;</code>
+     * @return The bytes for name.
+     */
+    com.google.protobuf.ByteString
+        getNameBytes();
+  }
+  /**
+   * Protobuf type {@code supervisor.ResetSyncBarrierRequest}
+   */
+  public static final class ResetSyncBarrierRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ResetSyncBarrierRequest)
+      ResetSyncBarrierRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ResetSyncBarrierRequest.newBuilder() to construct.
+    private ResetSyncBarrierRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ResetSyncBarrierRequest() {
+      name_ = "";
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ResetSyncBarrierRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ResetSyncBarrierRequest(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              name_ = s;
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ResetSyncBarrierRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ResetSyncBarrierRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest.class, io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest.Builder.class);
+    }
+
+    public static final int NAME_FIELD_NUMBER = 1;
+    private volatile java.lang.Object name_;
+    /**
+     * <code>string name = 1;</code>
+     * @return The name.
+     */
+    @java.lang.Override
+    public java.lang.String getName() {
+      java.lang.Object ref = name_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        name_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string name = 1;</code>
+     * @return The bytes for name.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getNameBytes() {
+      java.lang.Object ref = name_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        name_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(name_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, name_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(name_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, name_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest other = (io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest) obj;
+
+      if (!getName()
+          .equals(other.getName())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getName().hashCode();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ResetSyncBarrierRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ResetSyncBarrierRequest)
+        io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ResetSyncBarrierRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ResetSyncBarrierRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest.class, io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        name_ = "";
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ResetSyncBarrierRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest build() {
+        io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest buildPartial() {
+        io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest result = new io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest(this);
+        result.name_ = name_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest) {
+          return mergeFrom((io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest other) {
+        if (other == io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest.getDefaultInstance()) return this;
+        if (!other.getName().isEmpty()) {
+          name_ = other.name_;
+          onChanged();
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private java.lang.Object name_ = "";
+      /**
+       * <code>string name = 1;</code>
+       * @return The name.
+       */
+      public java.lang.String getName() {
+        java.lang.Object ref = name_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          name_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @return The bytes for name.
+       */
+      public com.google.protobuf.ByteString
+          getNameBytes() {
+        java.lang.Object ref = name_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          name_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @param value The name to set.
+       * @return This builder for chaining.
+       */
+      public Builder setName(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        name_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearName() {
+
+        name_ = getDefaultInstance().getName();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @param value The bytes for name to set.
+       * @return This builder for chaining.
+       */
+      public Builder setNameBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        name_ = value;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ResetSyncBarrierRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ResetSyncBarrierRequest)
+    private static final io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest();
+    }
+
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ResetSyncBarrierRequest>
+        PARSER = new com.google.protobuf.AbstractParser<ResetSyncBarrierRequest>() {
+      @java.lang.Override
+      public ResetSyncBarrierRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ResetSyncBarrierRequest(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ResetSyncBarrierRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ResetSyncBarrierRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ResetSyncBarrierResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ResetSyncBarrierResponse)
+      com.google.protobuf.MessageOrBuilder {
+  }
+  /**
+   * Protobuf type {@code supervisor.ResetSyncBarrierResponse}
+   */
+  public static final class ResetSyncBarrierResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ResetSyncBarrierResponse)
+      ResetSyncBarrierResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ResetSyncBarrierResponse.newBuilder() to construct.
+    private ResetSyncBarrierResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ResetSyncBarrierResponse() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ResetSyncBarrierResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ResetSyncBarrierResponse(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ResetSyncBarrierResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ResetSyncBarrierResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse.class, io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse.Builder.class);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse other = (io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse) obj;
+
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ResetSyncBarrierResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ResetSyncBarrierResponse)
+        io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ResetSyncBarrierResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ResetSyncBarrierResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse.class, io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Sync.internal_static_supervisor_ResetSyncBarrierResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse build() {
+        io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse buildPartial() {
+        io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse result = new io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse(this);
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse) {
+          return mergeFrom((io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse other) {
+        if (other == io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse.getDefaultInstance()) return this;
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ResetSyncBarrierResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ResetSyncBarrierResponse)
+    private static final io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse();
+    }
+
+    public static io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ResetSyncBarrierResponse>
+        PARSER = new com.google.protobuf.AbstractParser<ResetSyncBarrierResponse>() {
+      @java.lang.Override
+      public ResetSyncBarrierResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ResetSyncBarrierResponse(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ResetSyncBarrierResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ResetSyncBarrierResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_SyncBarrier_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_SyncBarrier_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_SyncDoneRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_SyncDoneRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_SyncDoneResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_SyncDoneResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_SyncAwaitRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_SyncAwaitRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_SyncAwaitResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_SyncAwaitResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ListSyncBarriersRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ListSyncBarriersRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ListSyncBarriersResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ListSyncBarriersResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ResetSyncBarrierRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ResetSyncBarrierRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ResetSyncBarrierResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ResetSyncBarrierResponse_fieldAccessorTable;
+
+  public static com.google.protobuf.Descriptors.FileDescriptor
+      getDescriptor() {
+    return descriptor;
+  }
+  private static  com.google.protobuf.Descriptors.FileDescriptor
+      descriptor;
+  static {
+    java.lang.String[] descriptorData = {
+      "\n\nsync.proto\022\nsupervisor\032\034google/api/ann" +
+      "otations.proto\032\037google/protobuf/timestam" +
+      "p.proto\"z\n\013SyncBarrier\022\014\n\004name\030\001 \001(\t\022\014\n\004" +
+      "done\030\002 \001(\010\022\017\n\007payload\030\003 \001(\t\022-\n\tdone_time" +
+      "\030\004 \001(\0132\032.google.protobuf.Timestamp\022\017\n\007wa" +
+      "iters\030\005 \001(\r\"0\n\017SyncDoneRequest\022\014\n\004name\030\001" +
+      " \001(\t\022\017\n\007payload\030\002 \001(\t\"<\n\020SyncDoneRespons" +
+      "e\022(\n\007barrier\030\001 \001(\0132\027.supervisor.SyncBarr" +
+      "ier\" \n\020SyncAwaitRequest\022\014\n\004name\030\001 \001(\t\"=\n" +
+      "\021SyncAwaitResponse\022(\n\007barrier\030\001 \001(\0132\027.su" +
+      "pervisor.SyncBarrier\"/\n\027ListSyncBarriers" +
+      "Request\022\024\n\014pending_only\030\001 \001(\010\"E\n\030ListSyn" +
+      "cBarriersResponse\022)\n\010barriers\030\001 \003(\0132\027.su" +
+      "pervisor.SyncBarrier\"\'\n\027ResetSyncBarrier" +
+      "Request\022\014\n\004name\030\001 \001(\t\"\032\n\030ResetSyncBarrie" +
+      "rResponse2\267\003\n\013SyncService\022b\n\004Done\022\033.supe" +
+      "rvisor.SyncDoneRequest\032\034.supervisor.Sync" +
+      "DoneResponse\"\037\202\323\344\223\002\031\"\024/v1/sync/done/{nam" +
+      "e}:\001*\022c\n\005Await\022\034.supervisor.SyncAwaitReq" +
+      "uest\032\035.supervisor.SyncAwaitResponse\"\035\202\323\344" +
+      "\223\002\027\022\025/v1/sync/await/{name}\022l\n\004List\022#.sup" +
+      "ervisor.ListSyncBarriersRequest\032$.superv" +
+      "isor.ListSyncBarriersResponse\"\031\202\323\344\223\002\023\022\021/" +
+      "v1/sync/barriers\022q\n\005Reset\022#.supervisor.R" +
+      "esetSyncBarrierRequest\032$.supervisor.Rese" +
+      "tSyncBarrierResponse\"\035\202\323\344\223\002\027\"\025/v1/sync/r" +
+      "eset/{name}BF\n\030io.gitpod.supervisor.apiZ" +
+      "*github.com/gitpod-io/gitpod/supervisor/" +
+      "apib\006proto3"
+    };
+    descriptor = com.google.protobuf.Descriptors.FileDescriptor
+      .internalBuildGeneratedFileFrom(descriptorData,
+        new com.google.protobuf.Descriptors.FileDescriptor[] {
+          com.google.api.AnnotationsProto.getDescriptor(),
+          com.google.protobuf.TimestampProto.getDescriptor(),
+        });
+    internal_static_supervisor_SyncBarrier_descriptor =
+      getDescriptor().getMessageTypes().get(0);
+    internal_static_supervisor_SyncBarrier_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_SyncBarrier_descriptor,
+        new java.lang.String[] { "Name", "Done", "Payload", "DoneTime", "Waiters", });
+    internal_static_supervisor_SyncDoneRequest_descriptor =
+      getDescriptor().getMessageTypes().get(1);
+    internal_static_supervisor_SyncDoneRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_SyncDoneRequest_descriptor,
+        new java.lang.String[] { "Name", "Payload", });
+    internal_static_supervisor_SyncDoneResponse_descriptor =
+      getDescriptor().getMessageTypes().get(2);
+    internal_static_supervisor_SyncDoneResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_SyncDoneResponse_descriptor,
+        new java.lang.String[] { "Barrier", });
+    internal_static_supervisor_SyncAwaitRequest_descriptor =
+      getDescriptor().getMessageTypes().get(3);
+    internal_static_supervisor_SyncAwaitRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_SyncAwaitRequest_descriptor,
+        new java.lang.String[] { "Name", });
+    internal_static_supervisor_SyncAwaitResponse_descriptor =
+      getDescriptor().getMessageTypes().get(4);
+    internal_static_supervisor_SyncAwaitResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_SyncAwaitResponse_descriptor,
+        new java.lang.String[] { "Barrier", });
+    internal_static_supervisor_ListSyncBarriersRequest_descriptor =
+      getDescriptor().getMessageTypes().get(5);
+    internal_static_supervisor_ListSyncBarriersRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ListSyncBarriersRequest_descriptor,
+        new java.lang.String[] { "PendingOnly", });
+    internal_static_supervisor_ListSyncBarriersResponse_descriptor =
+      getDescriptor().getMessageTypes().get(6);
+    internal_static_supervisor_ListSyncBarriersResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ListSyncBarriersResponse_descriptor,
+        new java.lang.String[] { "Barriers", });
+    internal_static_supervisor_ResetSyncBarrierRequest_descriptor =
+      getDescriptor().getMessageTypes().get(7);
+    internal_static_supervisor_ResetSyncBarrierRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ResetSyncBarrierRequest_descriptor,
+        new java.lang.String[] { "Name", });
+    internal_static_supervisor_ResetSyncBarrierResponse_descriptor =
+      getDescriptor().getMessageTypes().get(8);
+    internal_static_supervisor_ResetSyncBarrierResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ResetSyncBarrierResponse_descriptor,
+        new java.lang.String[] { });
+    com.google.protobuf.ExtensionRegistry registry =
+        com.google.protobuf.ExtensionRegistry.newInstance();
+    registry.add(com.google.api.AnnotationsProto.http);
+    com.google.protobuf.Descriptors.FileDescriptor
+        .internalUpdateFileDescriptor(descriptor, registry);
+    com.google.api.AnnotationsProto.getDescriptor();
+    com.google.protobuf.TimestampProto.getDescriptor();
+  }
+
+  // @@protoc_insertion_point(outer_class_scope)
+}
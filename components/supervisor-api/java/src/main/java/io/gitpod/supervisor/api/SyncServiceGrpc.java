@@ -0,0 +1,579 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package io.gitpod.supervisor.api;
+
+import static io.grpc.MethodDescriptor.generateFullMethodName;
+
+/**
+ * <pre>
+ * SyncService coordinates processes in the workspace, e.g. tasks, through named barriers.
+ * Barriers which are done are persisted in the workspace, i.e. they survive restarts and are
+ * handed over from prebuilds to the workspaces started from them.
+ * </pre>
+ */
+@javax.annotation.Generated(
+    value = "by gRPC proto compiler (version 1.49.0)",
+    comments = "Source: sync.proto")
+@io.grpc.stub.annotations.GrpcGenerated
+public final class SyncServiceGrpc {
+
+  private SyncServiceGrpc() {}
+
+  public static final String SERVICE_NAME = "supervisor.SyncService";
+
+  // Static method descriptors that strictly reflect the proto.
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Sync.SyncDoneRequest,
+      io.gitpod.supervisor.api.Sync.SyncDoneResponse> getDoneMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "Done",
+      requestType = io.gitpod.supervisor.api.Sync.SyncDoneRequest.class,
+      responseType = io.gitpod.supervisor.api.Sync.SyncDoneResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Sync.SyncDoneRequest,
+      io.gitpod.supervisor.api.Sync.SyncDoneResponse> getDoneMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Sync.SyncDoneRequest, io.gitpod.supervisor.api.Sync.SyncDoneResponse> getDoneMethod;
+    if ((getDoneMethod = SyncServiceGrpc.getDoneMethod) == null) {
+      synchronized (SyncServiceGrpc.class) {
+        if ((getDoneMethod = SyncServiceGrpc.getDoneMethod) == null) {
+          SyncServiceGrpc.getDoneMethod = getDoneMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.Sync.SyncDoneRequest, io.gitpod.supervisor.api.Sync.SyncDoneResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "Done"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Sync.SyncDoneRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Sync.SyncDoneResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new SyncServiceMethodDescriptorSupplier("Done"))
+              .build();
+        }
+      }
+    }
+    return getDoneMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Sync.SyncAwaitRequest,
+      io.gitpod.supervisor.api.Sync.SyncAwaitResponse> getAwaitMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "Await",
+      requestType = io.gitpod.supervisor.api.Sync.SyncAwaitRequest.class,
+      responseType = io.gitpod.supervisor.api.Sync.SyncAwaitResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Sync.SyncAwaitRequest,
+      io.gitpod.supervisor.api.Sync.SyncAwaitResponse> getAwaitMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Sync.SyncAwaitRequest, io.gitpod.supervisor.api.Sync.SyncAwaitResponse> getAwaitMethod;
+    if ((getAwaitMethod = SyncServiceGrpc.getAwaitMethod) == null) {
+      synchronized (SyncServiceGrpc.class) {
+        if ((getAwaitMethod = SyncServiceGrpc.getAwaitMethod) == null) {
+          SyncServiceGrpc.getAwaitMethod = getAwaitMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.Sync.SyncAwaitRequest, io.gitpod.supervisor.api.Sync.SyncAwaitResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "Await"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Sync.SyncAwaitRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Sync.SyncAwaitResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new SyncServiceMethodDescriptorSupplier("Await"))
+              .build();
+        }
+      }
+    }
+    return getAwaitMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest,
+      io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse> getListMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "List",
+      requestType = io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest.class,
+      responseType = io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest,
+      io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse> getListMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest, io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse> getListMethod;
+    if ((getListMethod = SyncServiceGrpc.getListMethod) == null) {
+      synchronized (SyncServiceGrpc.class) {
+        if ((getListMethod = SyncServiceGrpc.getListMethod) == null) {
+          SyncServiceGrpc.getListMethod = getListMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest, io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "List"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new SyncServiceMethodDescriptorSupplier("List"))
+              .build();
+        }
+      }
+    }
+    return getListMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest,
+      io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse> getResetMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "Reset",
+      requestType = io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest.class,
+      responseType = io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest,
+      io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse> getResetMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest, io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse> getResetMethod;
+    if ((getResetMethod = SyncServiceGrpc.getResetMethod) == null) {
+      synchronized (SyncServiceGrpc.class) {
+        if ((getResetMethod = SyncServiceGrpc.getResetMethod) == null) {
+          SyncServiceGrpc.getResetMethod = getResetMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest, io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "Reset"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new SyncServiceMethodDescriptorSupplier("Reset"))
+              .build();
+        }
+      }
+    }
+    return getResetMethod;
+  }
+
+  /**
+   * Creates a new async stub that supports all call types for the service
+   */
+  public static SyncServiceStub newStub(io.grpc.Channel channel) {
+    io.grpc.stub.AbstractStub.StubFactory<SyncServiceStub> factory =
+      new io.grpc.stub.AbstractStub.StubFactory<SyncServiceStub>() {
+        @java.lang.Override
+        public SyncServiceStub newStub(io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+          return new SyncServiceStub(channel, callOptions);
+        }
+      };
+    return SyncServiceStub.newStub(factory, channel);
+  }
+
+  /**
+   * Creates a new blocking-style stub that supports unary and streaming output calls on the service
+   */
+  public static SyncServiceBlockingStub newBlockingStub(
+      io.grpc.Channel channel) {
+    io.grpc.stub.AbstractStub.StubFactory<SyncServiceBlockingStub> factory =
+      new io.grpc.stub.AbstractStub.StubFactory<SyncServiceBlockingStub>() {
+        @java.lang.Override
+        public SyncServiceBlockingStub newStub(io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+          return new SyncServiceBlockingStub(channel, callOptions);
+        }
+      };
+    return SyncServiceBlockingStub.newStub(factory, channel);
+  }
+
+  /**
+   * Creates a new ListenableFuture-style stub that supports unary calls on the service
+   */
+  public static SyncServiceFutureStub newFutureStub(
+      io.grpc.Channel channel) {
+    io.grpc.stub.AbstractStub.StubFactory<SyncServiceFutureStub> factory =
+      new io.grpc.stub.AbstractStub.StubFactory<SyncServiceFutureStub>() {
+        @java.lang.Override
+        public SyncServiceFutureStub newStub(io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+          return new SyncServiceFutureStub(channel, callOptions);
+        }
+      };
+    return SyncServiceFutureStub.newStub(factory, channel);
+  }
+
+  /**
+   * <pre>
+   * SyncService coordinates processes in the workspace, e.g. tasks, through named barriers.
+   * Barriers which are done are persisted in the workspace, i.e. they survive restarts and are
+   * handed over from prebuilds to the workspaces started from them.
+   * </pre>
+   */
+  public static abstract class SyncServiceImplBase implements io.grpc.BindableService {
+
+    /**
+     * <pre>
+     * Done completes a barrier and releases all its waiters. Completing a barrier which is done
+     * already keeps its payload.
+     * </pre>
+     */
+    public void done(io.gitpod.supervisor.api.Sync.SyncDoneRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Sync.SyncDoneResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getDoneMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * Await returns once a barrier is done. The deadline of the call is the timeout of the wait.
+     * </pre>
+     */
+    public void await(io.gitpod.supervisor.api.Sync.SyncAwaitRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Sync.SyncAwaitResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getAwaitMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * List lists the barriers which are done or awaited.
+     * </pre>
+     */
+    public void list(io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getListMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * Reset resets a barrier which is done, such that it can be awaited and completed again.
+     * </pre>
+     */
+    public void reset(io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getResetMethod(), responseObserver);
+    }
+
+    @java.lang.Override public final io.grpc.ServerServiceDefinition bindService() {
+      return io.grpc.ServerServiceDefinition.builder(getServiceDescriptor())
+          .addMethod(
+            getDoneMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.Sync.SyncDoneRequest,
+                io.gitpod.supervisor.api.Sync.SyncDoneResponse>(
+                  this, METHODID_DONE)))
+          .addMethod(
+            getAwaitMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.Sync.SyncAwaitRequest,
+                io.gitpod.supervisor.api.Sync.SyncAwaitResponse>(
+                  this, METHODID_AWAIT)))
+          .addMethod(
+            getListMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest,
+                io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse>(
+                  this, METHODID_LIST)))
+          .addMethod(
+            getResetMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest,
+                io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse>(
+                  this, METHODID_RESET)))
+          .build();
+    }
+  }
+
+  /**
+   * <pre>
+   * SyncService coordinates processes in the workspace, e.g. tasks, through named barriers.
+   * Barriers which are done are persisted in the workspace, i.e. they survive restarts and are
+   * handed over from prebuilds to the workspaces started from them.
+   * </pre>
+   */
+  public static final class SyncServiceStub extends io.grpc.stub.AbstractAsyncStub<SyncServiceStub> {
+    private SyncServiceStub(
+        io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+      super(channel, callOptions);
+    }
+
+    @java.lang.Override
+    protected SyncServiceStub build(
+        io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+      return new SyncServiceStub(channel, callOptions);
+    }
+
+    /**
+     * <pre>
+     * Done completes a barrier and releases all its waiters. Completing a barrier which is done
+     * already keeps its payload.
+     * </pre>
+     */
+    public void done(io.gitpod.supervisor.api.Sync.SyncDoneRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Sync.SyncDoneResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getDoneMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * Await returns once a barrier is done. The deadline of the call is the timeout of the wait.
+     * </pre>
+     */
+    public void await(io.gitpod.supervisor.api.Sync.SyncAwaitRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Sync.SyncAwaitResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getAwaitMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * List lists the barriers which are done or awaited.
+     * </pre>
+     */
+    public void list(io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getListMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * Reset resets a barrier which is done, such that it can be awaited and completed again.
+     * </pre>
+     */
+    public void reset(io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getResetMethod(), getCallOptions()), request, responseObserver);
+    }
+  }
+
+  /**
+   * <pre>
+   * SyncService coordinates processes in the workspace, e.g. tasks, through named barriers.
+   * Barriers which are done are persisted in the workspace, i.e. they survive restarts and are
+   * handed over from prebuilds to the workspaces started from them.
+   * </pre>
+   */
+  public static final class SyncServiceBlockingStub extends io.grpc.stub.AbstractBlockingStub<SyncServiceBlockingStub> {
+    private SyncServiceBlockingStub(
+        io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+      super(channel, callOptions);
+    }
+
+    @java.lang.Override
+    protected SyncServiceBlockingStub build(
+        io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+      return new SyncServiceBlockingStub(channel, callOptions);
+    }
+
+    /**
+     * <pre>
+     * Done completes a barrier and releases all its waiters. Completing a barrier which is done
+     * already keeps its payload.
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.Sync.SyncDoneResponse done(io.gitpod.supervisor.api.Sync.SyncDoneRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getDoneMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * Await returns once a barrier is done. The deadline of the call is the timeout of the wait.
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.Sync.SyncAwaitResponse await(io.gitpod.supervisor.api.Sync.SyncAwaitRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getAwaitMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * List lists the barriers which are done or awaited.
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse list(io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getListMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * Reset resets a barrier which is done, such that it can be awaited and completed again.
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse reset(io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getResetMethod(), getCallOptions(), request);
+    }
+  }
+
+  /**
+   * <pre>
+   * SyncService coordinates processes in the workspace, e.g. tasks, through named barriers.
+   * Barriers which are done are persisted in the workspace, i.e. they survive restarts and are
+   * handed over from prebuilds to the workspaces started from them.
+   * </pre>
+   */
+  public static final class SyncServiceFutureStub extends io.grpc.stub.AbstractFutureStub<SyncServiceFutureStub> {
+    private SyncServiceFutureStub(
+        io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+      super(channel, callOptions);
+    }
+
+    @java.lang.Override
+    protected SyncServiceFutureStub build(
+        io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+      return new SyncServiceFutureStub(channel, callOptions);
+    }
+
+    /**
+     * <pre>
+     * Done completes a barrier and releases all its waiters. Completing a barrier which is done
+     * already keeps its payload.
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.Sync.SyncDoneResponse> done(
+        io.gitpod.supervisor.api.Sync.SyncDoneRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getDoneMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * Await returns once a barrier is done. The deadline of the call is the timeout of the wait.
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.Sync.SyncAwaitResponse> await(
+        io.gitpod.supervisor.api.Sync.SyncAwaitRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getAwaitMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * List lists the barriers which are done or awaited.
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse> list(
+        io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getListMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * Reset resets a barrier which is done, such that it can be awaited and completed again.
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse> reset(
+        io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getResetMethod(), getCallOptions()), request);
+    }
+  }
+
+  private static final int METHODID_DONE = 0;
+  private static final int METHODID_AWAIT = 1;
+  private static final int METHODID_LIST = 2;
+  private static final int METHODID_RESET = 3;
+
+  private static final class MethodHandlers<Req, Resp> implements
+      io.grpc.stub.ServerCalls.UnaryMethod<Req, Resp>,
+      io.grpc.stub.ServerCalls.ServerStreamingMethod<Req, Resp>,
+      io.grpc.stub.ServerCalls.ClientStreamingMethod<Req, Resp>,
+      io.grpc.stub.ServerCalls.BidiStreamingMethod<Req, Resp> {
+    private final SyncServiceImplBase serviceImpl;
+    private final int methodId;
+
+    MethodHandlers(SyncServiceImplBase serviceImpl, int methodId) {
+      this.serviceImpl = serviceImpl;
+      this.methodId = methodId;
+    }
+
+    @java.lang.Override
+    @java.lang.SuppressWarnings("unchecked")
+    public void invoke(Req request, io.grpc.stub.StreamObserver<Resp> responseObserver) {
+      switch (methodId) {
+        case METHODID_DONE:
+          serviceImpl.done((io.gitpod.supervisor.api.Sync.SyncDoneRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Sync.SyncDoneResponse>) responseObserver);
+          break;
+        case METHODID_AWAIT:
+          serviceImpl.await((io.gitpod.supervisor.api.Sync.SyncAwaitRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Sync.SyncAwaitResponse>) responseObserver);
+          break;
+        case METHODID_LIST:
+          serviceImpl.list((io.gitpod.supervisor.api.Sync.ListSyncBarriersRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Sync.ListSyncBarriersResponse>) responseObserver);
+          break;
+        case METHODID_RESET:
+          serviceImpl.reset((io.gitpod.supervisor.api.Sync.ResetSyncBarrierRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Sync.ResetSyncBarrierResponse>) responseObserver);
+          break;
+        default:
+          throw new AssertionError();
+      }
+    }
+
+    @java.lang.Override
+    @java.lang.SuppressWarnings("unchecked")
+    public io.grpc.stub.StreamObserver<Req> invoke(
+        io.grpc.stub.StreamObserver<Resp> responseObserver) {
+      switch (methodId) {
+        default:
+          throw new AssertionError();
+      }
+    }
+  }
+
+  private static abstract class SyncServiceBaseDescriptorSupplier
+      implements io.grpc.protobuf.ProtoFileDescriptorSupplier, io.grpc.protobuf.ProtoServiceDescriptorSupplier {
+    SyncServiceBaseDescriptorSupplier() {}
+
+    @java.lang.Override
+    public com.google.protobuf.Descriptors.FileDescriptor getFileDescriptor() {
+      return io.gitpod.supervisor.api.Sync.getDescriptor();
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Descriptors.ServiceDescriptor getServiceDescriptor() {
+      return getFileDescriptor().findServiceByName("SyncService");
+    }
+  }
+
+  private static final class SyncServiceFileDescriptorSupplier
+      extends SyncServiceBaseDescriptorSupplier {
+    SyncServiceFileDescriptorSupplier() {}
+  }
+
+  private static final class SyncServiceMethodDescriptorSupplier
+      extends SyncServiceBaseDescriptorSupplier
+      implements io.grpc.protobuf.ProtoMethodDescriptorSupplier {
+    private final String methodName;
+
+    SyncServiceMethodDescriptorSupplier(String methodName) {
+      this.methodName = methodName;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Descriptors.MethodDescriptor getMethodDescriptor() {
+      return getServiceDescriptor().findMethodByName(methodName);
+    }
+  }
+
+  private static volatile io.grpc.ServiceDescriptor serviceDescriptor;
+
+  public static io.grpc.ServiceDescriptor getServiceDescriptor() {
+    io.grpc.ServiceDescriptor result = serviceDescriptor;
+    if (result == null) {
+      synchronized (SyncServiceGrpc.class) {
+        result = serviceDescriptor;
+        if (result == null) {
+          serviceDescriptor = result = io.grpc.ServiceDescriptor.newBuilder(SERVICE_NAME)
+              .setSchemaDescriptor(new SyncServiceFileDescriptorSupplier())
+              .addMethod(getDoneMethod())
+              .addMethod(getAwaitMethod())
+              .addMethod(getListMethod())
+              .addMethod(getResetMethod())
+              .build();
+        }
+      }
+    }
+    return result;
+  }
+}
@@ -15086,6 +15086,5647 @@ java.lang.String defaultValue);
 
   }
 
+  public interface TerminalShareOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.TerminalShare)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string id = 1;</code>
+     * @return The id.
+     */
+    java.lang.String getId();
+    /**
+     * <code>string id = 1;</code>
+     * @return The bytes for id.
+     */
+    com.google.protobuf.ByteString
+        getIdBytes();
+
+    /**
+     * <code>string alias = 2;</code>
+     * @return The alias.
+     */
+    java.lang.String getAlias();
+    /**
+     * <code>string alias = 2;</code>
+     * @return The bytes for alias.
+     */
+    com.google.protobuf.ByteString
+        getAliasBytes();
+
+    /**
+     * <code>.google.protobuf.Timestamp expires_at = 3;</code>
+     * @return Whether the expiresAt field is set.
+     */
+    boolean hasExpiresAt();
+    /**
+     * <code>.google.protobuf.Timestamp expires_at = 3;</code>
+     * @return The expiresAt.
+     */
+    com.google.protobuf.Timestamp getExpiresAt();
+    /**
+     * <code>.google.protobuf.Timestamp expires_at = 3;</code>
+     */
+    com.google.protobuf.TimestampOrBuilder getExpiresAtOrBuilder();
+
+    /**
+     * <pre>
+     * viewers who are currently watching the terminal
+     * </pre>
+     *
+     * <code>repeated string viewers = 4;</code>
+     * @return A list containing the viewers.
+     */
+    java.util.List<java.lang.String>
+        getViewersList();
+    /**
+     * <pre>
+     * viewers who are currently watching the terminal
+     * </pre>
+     *
+     * <code>repeated string viewers = 4;</code>
+     * @return The count of viewers.
+     */
+    int getViewersCount();
+    /**
+     * <pre>
+     * viewers who are currently watching the terminal
+     * </pre>
+     *
+     * <code>repeated string viewers = 4;</code>
+     * @param index The index of the element to return.
+     * @return The viewers at the given index.
+     */
+    java.lang.String getViewers(int index);
+    /**
+     * <pre>
+     * viewers who are currently watching the terminal
+     * </pre>
+     *
+     * <code>repeated string viewers = 4;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the viewers at the given index.
+     */
+    com.google.protobuf.ByteString
+        getViewersBytes(int index);
+  }
+  /**
+   * Protobuf type {@code supervisor.TerminalShare}
+   */
+  public static final class TerminalShare extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.TerminalShare)
+      TerminalShareOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use TerminalShare.newBuilder() to construct.
+    private TerminalShare(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private TerminalShare() {
+      id_ = "";
+      alias_ = "";
+      viewers_ = com.google.protobuf.LazyStringArrayList.EMPTY;
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new TerminalShare();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private TerminalShare(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      int mutable_bitField0_ = 0;
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              id_ = s;
+              break;
+            }
+            case 18: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              alias_ = s;
+              break;
+            }
+            case 26: {
+              com.google.protobuf.Timestamp.Builder subBuilder = null;
+              if (expiresAt_ != null) {
+                subBuilder = expiresAt_.toBuilder();
+              }
+              expiresAt_ = input.readMessage(com.google.protobuf.Timestamp.parser(), extensionRegistry);
+              if (subBuilder != null) {
+                subBuilder.mergeFrom(expiresAt_);
+                expiresAt_ = subBuilder.buildPartial();
+              }
+
+              break;
+            }
+            case 34: {
+              java.lang.String s = input.readStringRequireUtf8();
+              if (!((mutable_bitField0_ & 0x00000001) != 0)) {
+                viewers_ = new com.google.protobuf.LazyStringArrayList();
+                mutable_bitField0_ |= 0x00000001;
+              }
+              viewers_.add(s);
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        if (((mutable_bitField0_ & 0x00000001) != 0)) {
+          viewers_ = viewers_.getUnmodifiableView();
+        }
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_TerminalShare_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_TerminalShare_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.class, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.Builder.class);
+    }
+
+    public static final int ID_FIELD_NUMBER = 1;
+    private volatile java.lang.Object id_;
+    /**
+     * <code>string id = 1;</code>
+     * @return The id.
+     */
+    @java.lang.Override
+    public java.lang.String getId() {
+      java.lang.Object ref = id_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        id_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string id = 1;</code>
+     * @return The bytes for id.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getIdBytes() {
+      java.lang.Object ref = id_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        id_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int ALIAS_FIELD_NUMBER = 2;
+    private volatile java.lang.Object alias_;
+    /**
+     * <code>string alias = 2;</code>
+     * @return The alias.
+     */
+    @java.lang.Override
+    public java.lang.String getAlias() {
+      java.lang.Object ref = alias_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        alias_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string alias = 2;</code>
+     * @return The bytes for alias.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getAliasBytes() {
+      java.lang.Object ref = alias_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        alias_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int EXPIRES_AT_FIELD_NUMBER = 3;
+    private com.google.protobuf.Timestamp expiresAt_;
+    /**
+     * <code>.google.protobuf.Timestamp expires_at = 3;</code>
+     * @return Whether the expiresAt field is set.
+     */
+    @java.lang.Override
+    public boolean hasExpiresAt() {
+      return expiresAt_ != null;
+    }
+    /**
+     * <code>.google.protobuf.Timestamp expires_at = 3;</code>
+     * @return The expiresAt.
+     */
+    @java.lang.Override
+    public com.google.protobuf.Timestamp getExpiresAt() {
+      return expiresAt_ == null ? com.google.protobuf.Timestamp.getDefaultInstance() : expiresAt_;
+    }
+    /**
+     * <code>.google.protobuf.Timestamp expires_at = 3;</code>
+     */
+    @java.lang.Override
+    public com.google.protobuf.TimestampOrBuilder getExpiresAtOrBuilder() {
+      return getExpiresAt();
+    }
+
+    public static final int VIEWERS_FIELD_NUMBER = 2;
+    private com.google.protobuf.LazyStringList viewers_;
+    /**
+     * <pre>
+     * viewers who are currently watching the terminal
+     * </pre>
+     *
+     * <code>repeated string viewers = 4;</code>
+     * @return A list containing the viewers.
+     */
+    public com.google.protobuf.ProtocolStringList
+        getViewersList() {
+      return viewers_;
+    }
+    /**
+     * <pre>
+     * viewers who are currently watching the terminal
+     * </pre>
+     *
+     * <code>repeated string viewers = 4;</code>
+     * @return The count of viewers.
+     */
+    public int getViewersCount() {
+      return viewers_.size();
+    }
+    /**
+     * <pre>
+     * viewers who are currently watching the terminal
+     * </pre>
+     *
+     * <code>repeated string viewers = 4;</code>
+     * @param index The index of the element to return.
+     * @return The viewers at the given index.
+     */
+    public java.lang.String getViewers(int index) {
+      return viewers_.get(index);
+    }
+    /**
+     * <pre>
+     * viewers who are currently watching the terminal
+     * </pre>
+     *
+     * <code>repeated string viewers = 4;</code>
+     * @param index The index of the value to return.
+     * @return The bytes of the viewers at the given index.
+     */
+    public com.google.protobuf.ByteString
+        getViewersBytes(int index) {
+      return viewers_.getByteString(index);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(id_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, id_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(alias_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 2, alias_);
+      }
+      if (expiresAt_ != null) {
+        output.writeMessage(3, getExpiresAt());
+      }
+      for (int i = 0; i < viewers_.size(); i++) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 4, viewers_.getRaw(i));
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(id_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, id_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(alias_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(2, alias_);
+      }
+      if (expiresAt_ != null) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(3, getExpiresAt());
+      }
+      {
+        int dataSize = 0;
+        for (int i = 0; i < viewers_.size(); i++) {
+          dataSize += computeStringSizeNoTag(viewers_.getRaw(i));
+        }
+        size += dataSize;
+        size += 1 * getViewersList().size();
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare other = (io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare) obj;
+
+      if (!getId()
+          .equals(other.getId())) return false;
+      if (!getAlias()
+          .equals(other.getAlias())) return false;
+      if (hasExpiresAt() != other.hasExpiresAt()) return false;
+      if (hasExpiresAt()) {
+        if (!getExpiresAt()
+            .equals(other.getExpiresAt())) return false;
+      }
+      if (!getViewersList()
+          .equals(other.getViewersList())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + ID_FIELD_NUMBER;
+      hash = (53 * hash) + getId().hashCode();
+      hash = (37 * hash) + ALIAS_FIELD_NUMBER;
+      hash = (53 * hash) + getAlias().hashCode();
+      if (hasExpiresAt()) {
+        hash = (37 * hash) + EXPIRES_AT_FIELD_NUMBER;
+        hash = (53 * hash) + getExpiresAt().hashCode();
+      }
+      if (getViewersCount() > 0) {
+        hash = (37 * hash) + VIEWERS_FIELD_NUMBER;
+        hash = (53 * hash) + getViewersList().hashCode();
+      }
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.TerminalShare}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.TerminalShare)
+        io.gitpod.supervisor.api.TerminalOuterClass.TerminalShareOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_TerminalShare_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_TerminalShare_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.class, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        id_ = "";
+
+        alias_ = "";
+
+        if (expiresAtBuilder_ == null) {
+          expiresAt_ = null;
+        } else {
+          expiresAt_ = null;
+          expiresAtBuilder_ = null;
+        }
+        viewers_ = com.google.protobuf.LazyStringArrayList.EMPTY;
+        bitField0_ = (bitField0_ & ~0x00000001);
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_TerminalShare_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare build() {
+        io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare buildPartial() {
+        io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare result = new io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare(this);
+        int from_bitField0_ = bitField0_;
+        result.id_ = id_;
+        result.alias_ = alias_;
+        if (expiresAtBuilder_ == null) {
+          result.expiresAt_ = expiresAt_;
+        } else {
+          result.expiresAt_ = expiresAtBuilder_.build();
+        }
+        if (((bitField0_ & 0x00000001) != 0)) {
+          viewers_ = viewers_.getUnmodifiableView();
+          bitField0_ = (bitField0_ & ~0x00000001);
+        }
+        result.viewers_ = viewers_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare) {
+          return mergeFrom((io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare other) {
+        if (other == io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.getDefaultInstance()) return this;
+        if (!other.getId().isEmpty()) {
+          id_ = other.id_;
+          onChanged();
+        }
+        if (!other.getAlias().isEmpty()) {
+          alias_ = other.alias_;
+          onChanged();
+        }
+        if (other.hasExpiresAt()) {
+          mergeExpiresAt(other.getExpiresAt());
+        }
+        if (!other.viewers_.isEmpty()) {
+          if (viewers_.isEmpty()) {
+            viewers_ = other.viewers_;
+            bitField0_ = (bitField0_ & ~0x00000001);
+          } else {
+            ensureViewersIsMutable();
+            viewers_.addAll(other.viewers_);
+          }
+          onChanged();
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+      private int bitField0_;
+
+      private java.lang.Object id_ = "";
+      /**
+       * <code>string id = 1;</code>
+       * @return The id.
+       */
+      public java.lang.String getId() {
+        java.lang.Object ref = id_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          id_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string id = 1;</code>
+       * @return The bytes for id.
+       */
+      public com.google.protobuf.ByteString
+          getIdBytes() {
+        java.lang.Object ref = id_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          id_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string id = 1;</code>
+       * @param value The id to set.
+       * @return This builder for chaining.
+       */
+      public Builder setId(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        id_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string id = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearId() {
+
+        id_ = getDefaultInstance().getId();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string id = 1;</code>
+       * @param value The bytes for id to set.
+       * @return This builder for chaining.
+       */
+      public Builder setIdBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        id_ = value;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object alias_ = "";
+      /**
+       * <code>string alias = 2;</code>
+       * @return The alias.
+       */
+      public java.lang.String getAlias() {
+        java.lang.Object ref = alias_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          alias_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string alias = 2;</code>
+       * @return The bytes for alias.
+       */
+      public com.google.protobuf.ByteString
+          getAliasBytes() {
+        java.lang.Object ref = alias_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          alias_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string alias = 2;</code>
+       * @param value The alias to set.
+       * @return This builder for chaining.
+       */
+      public Builder setAlias(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        alias_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string alias = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearAlias() {
+
+        alias_ = getDefaultInstance().getAlias();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string alias = 2;</code>
+       * @param value The bytes for alias to set.
+       * @return This builder for chaining.
+       */
+      public Builder setAliasBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        alias_ = value;
+        onChanged();
+        return this;
+      }
+
+      private com.google.protobuf.Timestamp expiresAt_;
+      private com.google.protobuf.SingleFieldBuilderV3<
+          com.google.protobuf.Timestamp, com.google.protobuf.Timestamp.Builder, com.google.protobuf.TimestampOrBuilder> expiresAtBuilder_;
+      /**
+       * <code>.google.protobuf.Timestamp expires_at = 3;</code>
+       * @return Whether the expiresAt field is set.
+       */
+      public boolean hasExpiresAt() {
+        return expiresAtBuilder_ != null || expiresAt_ != null;
+      }
+      /**
+       * <code>.google.protobuf.Timestamp expires_at = 3;</code>
+       * @return The expiresAt.
+       */
+      public com.google.protobuf.Timestamp getExpiresAt() {
+        if (expiresAtBuilder_ == null) {
+          return expiresAt_ == null ? com.google.protobuf.Timestamp.getDefaultInstance() : expiresAt_;
+        } else {
+          return expiresAtBuilder_.getMessage();
+        }
+      }
+      /**
+       * <code>.google.protobuf.Timestamp expires_at = 3;</code>
+       */
+      public Builder setExpiresAt(com.google.protobuf.Timestamp value) {
+        if (expiresAtBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          expiresAt_ = value;
+          onChanged();
+        } else {
+          expiresAtBuilder_.setMessage(value);
+        }
+
+        return this;
+      }
+      /**
+       * <code>.google.protobuf.Timestamp expires_at = 3;</code>
+       */
+      public Builder setExpiresAt(
+          com.google.protobuf.Timestamp.Builder builderForValue) {
+        if (expiresAtBuilder_ == null) {
+          expiresAt_ = builderForValue.build();
+          onChanged();
+        } else {
+          expiresAtBuilder_.setMessage(builderForValue.build());
+        }
+
+        return this;
+      }
+      /**
+       * <code>.google.protobuf.Timestamp expires_at = 3;</code>
+       */
+      public Builder mergeExpiresAt(com.google.protobuf.Timestamp value) {
+        if (expiresAtBuilder_ == null) {
+          if (expiresAt_ != null) {
+            expiresAt_ =
+              com.google.protobuf.Timestamp.newBuilder(expiresAt_).mergeFrom(value).buildPartial();
+          } else {
+            expiresAt_ = value;
+          }
+          onChanged();
+        } else {
+          expiresAtBuilder_.mergeFrom(value);
+        }
+
+        return this;
+      }
+      /**
+       * <code>.google.protobuf.Timestamp expires_at = 3;</code>
+       */
+      public Builder clearExpiresAt() {
+        if (expiresAtBuilder_ == null) {
+          expiresAt_ = null;
+          onChanged();
+        } else {
+          expiresAt_ = null;
+          expiresAtBuilder_ = null;
+        }
+
+        return this;
+      }
+      /**
+       * <code>.google.protobuf.Timestamp expires_at = 3;</code>
+       */
+      public com.google.protobuf.Timestamp.Builder getExpiresAtBuilder() {
+
+        onChanged();
+        return getExpiresAtFieldBuilder().getBuilder();
+      }
+      /**
+       * <code>.google.protobuf.Timestamp expires_at = 3;</code>
+       */
+      public com.google.protobuf.TimestampOrBuilder getExpiresAtOrBuilder() {
+        if (expiresAtBuilder_ != null) {
+          return expiresAtBuilder_.getMessageOrBuilder();
+        } else {
+          return expiresAt_ == null ?
+              com.google.protobuf.Timestamp.getDefaultInstance() : expiresAt_;
+        }
+      }
+      /**
+       * <code>.google.protobuf.Timestamp expires_at = 3;</code>
+       */
+      private com.google.protobuf.SingleFieldBuilderV3<
+          com.google.protobuf.Timestamp, com.google.protobuf.Timestamp.Builder, com.google.protobuf.TimestampOrBuilder>
+          getExpiresAtFieldBuilder() {
+        if (expiresAtBuilder_ == null) {
+          expiresAtBuilder_ = new com.google.protobuf.SingleFieldBuilderV3<
+              com.google.protobuf.Timestamp, com.google.protobuf.Timestamp.Builder, com.google.protobuf.TimestampOrBuilder>(
+                  getExpiresAt(),
+                  getParentForChildren(),
+                  isClean());
+          expiresAt_ = null;
+        }
+        return expiresAtBuilder_;
+      }
+      private com.google.protobuf.LazyStringList viewers_ = com.google.protobuf.LazyStringArrayList.EMPTY;
+      private void ensureViewersIsMutable() {
+        if (!((bitField0_ & 0x00000001) != 0)) {
+          viewers_ = new com.google.protobuf.LazyStringArrayList(viewers_);
+          bitField0_ |= 0x00000001;
+         }
+      }
+      /**
+       * <pre>
+       * viewers who are currently watching the terminal
+       * </pre>
+       *
+       * <code>repeated string viewers = 4;</code>
+       * @return A list containing the viewers.
+       */
+      public com.google.protobuf.ProtocolStringList
+          getViewersList() {
+        return viewers_.getUnmodifiableView();
+      }
+      /**
+       * <pre>
+       * viewers who are currently watching the terminal
+       * </pre>
+       *
+       * <code>repeated string viewers = 4;</code>
+       * @return The count of viewers.
+       */
+      public int getViewersCount() {
+        return viewers_.size();
+      }
+      /**
+       * <pre>
+       * viewers who are currently watching the terminal
+       * </pre>
+       *
+       * <code>repeated string viewers = 4;</code>
+       * @param index The index of the element to return.
+       * @return The viewers at the given index.
+       */
+      public java.lang.String getViewers(int index) {
+        return viewers_.get(index);
+      }
+      /**
+       * <pre>
+       * viewers who are currently watching the terminal
+       * </pre>
+       *
+       * <code>repeated string viewers = 4;</code>
+       * @param index The index of the value to return.
+       * @return The bytes of the viewers at the given index.
+       */
+      public com.google.protobuf.ByteString
+          getViewersBytes(int index) {
+        return viewers_.getByteString(index);
+      }
+      /**
+       * <pre>
+       * viewers who are currently watching the terminal
+       * </pre>
+       *
+       * <code>repeated string viewers = 4;</code>
+       * @param index The index to set the value at.
+       * @param value The viewers to set.
+       * @return This builder for chaining.
+       */
+      public Builder setViewers(
+          int index, java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  ensureViewersIsMutable();
+        viewers_.set(index, value);
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * viewers who are currently watching the terminal
+       * </pre>
+       *
+       * <code>repeated string viewers = 4;</code>
+       * @param value The viewers to add.
+       * @return This builder for chaining.
+       */
+      public Builder addViewers(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  ensureViewersIsMutable();
+        viewers_.add(value);
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * viewers who are currently watching the terminal
+       * </pre>
+       *
+       * <code>repeated string viewers = 4;</code>
+       * @param values The viewers to add.
+       * @return This builder for chaining.
+       */
+      public Builder addAllViewers(
+          java.lang.Iterable<java.lang.String> values) {
+        ensureViewersIsMutable();
+        com.google.protobuf.AbstractMessageLite.Builder.addAll(
+            values, viewers_);
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * viewers who are currently watching the terminal
+       * </pre>
+       *
+       * <code>repeated string viewers = 4;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearViewers() {
+        viewers_ = com.google.protobuf.LazyStringArrayList.EMPTY;
+        bitField0_ = (bitField0_ & ~0x00000001);
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * viewers who are currently watching the terminal
+       * </pre>
+       *
+       * <code>repeated string viewers = 4;</code>
+       * @param value The bytes of the viewers to add.
+       * @return This builder for chaining.
+       */
+      public Builder addViewersBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+        ensureViewersIsMutable();
+        viewers_.add(value);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.TerminalShare)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.TerminalShare)
+    private static final io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare();
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<TerminalShare>
+        PARSER = new com.google.protobuf.AbstractParser<TerminalShare>() {
+      @java.lang.Override
+      public TerminalShare parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new TerminalShare(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<TerminalShare> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<TerminalShare> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ShareTerminalRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ShareTerminalRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string alias = 1;</code>
+     * @return The alias.
+     */
+    java.lang.String getAlias();
+    /**
+     * <code>string alias = 1;</code>
+     * @return The bytes for alias.
+     */
+    com.google.protobuf.ByteString
+        getAliasBytes();
+
+    /**
+     * <pre>
+     * ttl_seconds is the time until the share expires, defaults to one hour
+     * </pre>
+     *
+     * <code>uint32 ttl_seconds = 2;</code>
+     * @return The ttlSeconds.
+     */
+    int getTtlSeconds();
+  }
+  /**
+   * Protobuf type {@code supervisor.ShareTerminalRequest}
+   */
+  public static final class ShareTerminalRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ShareTerminalRequest)
+      ShareTerminalRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ShareTerminalRequest.newBuilder() to construct.
+    private ShareTerminalRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ShareTerminalRequest() {
+      alias_ = "";
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ShareTerminalRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ShareTerminalRequest(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              alias_ = s;
+              break;
+            }
+            case 16: {
+
+              ttlSeconds_ = input.readUInt32();
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ShareTerminalRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ShareTerminalRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest.class, io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest.Builder.class);
+    }
+
+    public static final int ALIAS_FIELD_NUMBER = 1;
+    private volatile java.lang.Object alias_;
+    /**
+     * <code>string alias = 1;</code>
+     * @return The alias.
+     */
+    @java.lang.Override
+    public java.lang.String getAlias() {
+      java.lang.Object ref = alias_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        alias_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string alias = 1;</code>
+     * @return The bytes for alias.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getAliasBytes() {
+      java.lang.Object ref = alias_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        alias_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int TTL_SECONDS_FIELD_NUMBER = 2;
+    private int ttlSeconds_;
+    /**
+     * <pre>
+     * ttl_seconds is the time until the share expires, defaults to one hour
+     * </pre>
+     *
+     * <code>uint32 ttl_seconds = 2;</code>
+     * @return The ttlSeconds.
+     */
+    @java.lang.Override
+    public int getTtlSeconds() {
+      return ttlSeconds_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(alias_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, alias_);
+      }
+      if (ttlSeconds_ != 0) {
+        output.writeUInt32(2, ttlSeconds_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(alias_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, alias_);
+      }
+      if (ttlSeconds_ != 0) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeUInt32Size(2, ttlSeconds_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest other = (io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest) obj;
+
+      if (!getAlias()
+          .equals(other.getAlias())) return false;
+      if (getTtlSeconds()
+          != other.getTtlSeconds()) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + ALIAS_FIELD_NUMBER;
+      hash = (53 * hash) + getAlias().hashCode();
+      hash = (37 * hash) + TTL_SECONDS_FIELD_NUMBER;
+      hash = (53 * hash) + getTtlSeconds();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ShareTerminalRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ShareTerminalRequest)
+        io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ShareTerminalRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ShareTerminalRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest.class, io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        alias_ = "";
+
+        ttlSeconds_ = 0;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ShareTerminalRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest build() {
+        io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest buildPartial() {
+        io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest result = new io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest(this);
+        result.alias_ = alias_;
+        result.ttlSeconds_ = ttlSeconds_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest) {
+          return mergeFrom((io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest other) {
+        if (other == io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest.getDefaultInstance()) return this;
+        if (!other.getAlias().isEmpty()) {
+          alias_ = other.alias_;
+          onChanged();
+        }
+        if (other.getTtlSeconds() != 0) {
+          setTtlSeconds(other.getTtlSeconds());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private java.lang.Object alias_ = "";
+      /**
+       * <code>string alias = 1;</code>
+       * @return The alias.
+       */
+      public java.lang.String getAlias() {
+        java.lang.Object ref = alias_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          alias_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string alias = 1;</code>
+       * @return The bytes for alias.
+       */
+      public com.google.protobuf.ByteString
+          getAliasBytes() {
+        java.lang.Object ref = alias_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          alias_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string alias = 1;</code>
+       * @param value The alias to set.
+       * @return This builder for chaining.
+       */
+      public Builder setAlias(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        alias_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string alias = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearAlias() {
+
+        alias_ = getDefaultInstance().getAlias();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string alias = 1;</code>
+       * @param value The bytes for alias to set.
+       * @return This builder for chaining.
+       */
+      public Builder setAliasBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        alias_ = value;
+        onChanged();
+        return this;
+      }
+
+      private int ttlSeconds_ ;
+      /**
+       * <pre>
+       * ttl_seconds is the time until the share expires, defaults to one hour
+       * </pre>
+       *
+       * <code>uint32 ttl_seconds = 2;</code>
+       * @return The ttlSeconds.
+       */
+      @java.lang.Override
+      public int getTtlSeconds() {
+        return ttlSeconds_;
+      }
+      /**
+       * <pre>
+       * ttl_seconds is the time until the share expires, defaults to one hour
+       * </pre>
+       *
+       * <code>uint32 ttl_seconds = 2;</code>
+       * @param value The ttlSeconds to set.
+       * @return This builder for chaining.
+       */
+      public Builder setTtlSeconds(int value) {
+
+        ttlSeconds_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * ttl_seconds is the time until the share expires, defaults to one hour
+       * </pre>
+       *
+       * <code>uint32 ttl_seconds = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearTtlSeconds() {
+
+        ttlSeconds_ = 0;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ShareTerminalRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ShareTerminalRequest)
+    private static final io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest();
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ShareTerminalRequest>
+        PARSER = new com.google.protobuf.AbstractParser<ShareTerminalRequest>() {
+      @java.lang.Override
+      public ShareTerminalRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ShareTerminalRequest(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ShareTerminalRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ShareTerminalRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ShareTerminalResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ShareTerminalResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>.supervisor.TerminalShare share = 1;</code>
+     * @return Whether the share field is set.
+     */
+    boolean hasShare();
+    /**
+     * <code>.supervisor.TerminalShare share = 1;</code>
+     * @return The share.
+     */
+    io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare getShare();
+    /**
+     * <code>.supervisor.TerminalShare share = 1;</code>
+     */
+    io.gitpod.supervisor.api.TerminalOuterClass.TerminalShareOrBuilder getShareOrBuilder();
+
+    /**
+     * <pre>
+     * token grants read-only access to the terminal until the share expires or is revoked
+     * </pre>
+     *
+     * <code>string token = 2;</code>
+     * @return The token.
+     */
+    java.lang.String getToken();
+    /**
+     * <pre>
+     * token grants read-only access to the terminal until the share expires or is revoked
+     * </pre>
+     *
+     * <code>string token = 2;</code>
+     * @return The bytes for token.
+     */
+    com.google.protobuf.ByteString
+        getTokenBytes();
+  }
+  /**
+   * Protobuf type {@code supervisor.ShareTerminalResponse}
+   */
+  public static final class ShareTerminalResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ShareTerminalResponse)
+      ShareTerminalResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ShareTerminalResponse.newBuilder() to construct.
+    private ShareTerminalResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ShareTerminalResponse() {
+      token_ = "";
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ShareTerminalResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ShareTerminalResponse(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.Builder subBuilder = null;
+              if (share_ != null) {
+                subBuilder = share_.toBuilder();
+              }
+              share_ = input.readMessage(io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.parser(), extensionRegistry);
+              if (subBuilder != null) {
+                subBuilder.mergeFrom(share_);
+                share_ = subBuilder.buildPartial();
+              }
+
+              break;
+            }
+            case 18: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              token_ = s;
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ShareTerminalResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ShareTerminalResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse.class, io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse.Builder.class);
+    }
+
+    public static final int SHARE_FIELD_NUMBER = 1;
+    private io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare share_;
+    /**
+     * <code>.supervisor.TerminalShare share = 1;</code>
+     * @return Whether the share field is set.
+     */
+    @java.lang.Override
+    public boolean hasShare() {
+      return share_ != null;
+    }
+    /**
+     * <code>.supervisor.TerminalShare share = 1;</code>
+     * @return The share.
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare getShare() {
+      return share_ == null ? io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.getDefaultInstance() : share_;
+    }
+    /**
+     * <code>.supervisor.TerminalShare share = 1;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.TerminalOuterClass.TerminalShareOrBuilder getShareOrBuilder() {
+      return getShare();
+    }
+
+    public static final int TOKEN_FIELD_NUMBER = 2;
+    private volatile java.lang.Object token_;
+    /**
+     * <pre>
+     * token grants read-only access to the terminal until the share expires or is revoked
+     * </pre>
+     *
+     * <code>string token = 2;</code>
+     * @return The token.
+     */
+    @java.lang.Override
+    public java.lang.String getToken() {
+      java.lang.Object ref = token_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        token_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * token grants read-only access to the terminal until the share expires or is revoked
+     * </pre>
+     *
+     * <code>string token = 2;</code>
+     * @return The bytes for token.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getTokenBytes() {
+      java.lang.Object ref = token_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        token_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (share_ != null) {
+        output.writeMessage(1, getShare());
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(token_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 2, token_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (share_ != null) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(1, getShare());
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(token_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(2, token_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse other = (io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse) obj;
+
+      if (hasShare() != other.hasShare()) return false;
+      if (hasShare()) {
+        if (!getShare()
+            .equals(other.getShare())) return false;
+      }
+      if (!getToken()
+          .equals(other.getToken())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      if (hasShare()) {
+        hash = (37 * hash) + SHARE_FIELD_NUMBER;
+        hash = (53 * hash) + getShare().hashCode();
+      }
+      hash = (37 * hash) + TOKEN_FIELD_NUMBER;
+      hash = (53 * hash) + getToken().hashCode();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ShareTerminalResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ShareTerminalResponse)
+        io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ShareTerminalResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ShareTerminalResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse.class, io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        if (shareBuilder_ == null) {
+          share_ = null;
+        } else {
+          share_ = null;
+          shareBuilder_ = null;
+        }
+        token_ = "";
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ShareTerminalResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse build() {
+        io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse buildPartial() {
+        io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse result = new io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse(this);
+        if (shareBuilder_ == null) {
+          result.share_ = share_;
+        } else {
+          result.share_ = shareBuilder_.build();
+        }
+        result.token_ = token_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse) {
+          return mergeFrom((io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse other) {
+        if (other == io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse.getDefaultInstance()) return this;
+        if (other.hasShare()) {
+          mergeShare(other.getShare());
+        }
+        if (!other.getToken().isEmpty()) {
+          token_ = other.token_;
+          onChanged();
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare share_;
+      private com.google.protobuf.SingleFieldBuilderV3<
+          io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.Builder, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShareOrBuilder> shareBuilder_;
+      /**
+       * <code>.supervisor.TerminalShare share = 1;</code>
+       * @return Whether the share field is set.
+       */
+      public boolean hasShare() {
+        return shareBuilder_ != null || share_ != null;
+      }
+      /**
+       * <code>.supervisor.TerminalShare share = 1;</code>
+       * @return The share.
+       */
+      public io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare getShare() {
+        if (shareBuilder_ == null) {
+          return share_ == null ? io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.getDefaultInstance() : share_;
+        } else {
+          return shareBuilder_.getMessage();
+        }
+      }
+      /**
+       * <code>.supervisor.TerminalShare share = 1;</code>
+       */
+      public Builder setShare(io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare value) {
+        if (shareBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          share_ = value;
+          onChanged();
+        } else {
+          shareBuilder_.setMessage(value);
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.TerminalShare share = 1;</code>
+       */
+      public Builder setShare(
+          io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.Builder builderForValue) {
+        if (shareBuilder_ == null) {
+          share_ = builderForValue.build();
+          onChanged();
+        } else {
+          shareBuilder_.setMessage(builderForValue.build());
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.TerminalShare share = 1;</code>
+       */
+      public Builder mergeShare(io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare value) {
+        if (shareBuilder_ == null) {
+          if (share_ != null) {
+            share_ =
+              io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.newBuilder(share_).mergeFrom(value).buildPartial();
+          } else {
+            share_ = value;
+          }
+          onChanged();
+        } else {
+          shareBuilder_.mergeFrom(value);
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.TerminalShare share = 1;</code>
+       */
+      public Builder clearShare() {
+        if (shareBuilder_ == null) {
+          share_ = null;
+          onChanged();
+        } else {
+          share_ = null;
+          shareBuilder_ = null;
+        }
+
+        return this;
+      }
+      /**
+       * <code>.supervisor.TerminalShare share = 1;</code>
+       */
+      public io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.Builder getShareBuilder() {
+
+        onChanged();
+        return getShareFieldBuilder().getBuilder();
+      }
+      /**
+       * <code>.supervisor.TerminalShare share = 1;</code>
+       */
+      public io.gitpod.supervisor.api.TerminalOuterClass.TerminalShareOrBuilder getShareOrBuilder() {
+        if (shareBuilder_ != null) {
+          return shareBuilder_.getMessageOrBuilder();
+        } else {
+          return share_ == null ?
+              io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.getDefaultInstance() : share_;
+        }
+      }
+      /**
+       * <code>.supervisor.TerminalShare share = 1;</code>
+       */
+      private com.google.protobuf.SingleFieldBuilderV3<
+          io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.Builder, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShareOrBuilder>
+          getShareFieldBuilder() {
+        if (shareBuilder_ == null) {
+          shareBuilder_ = new com.google.protobuf.SingleFieldBuilderV3<
+              io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.Builder, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShareOrBuilder>(
+                  getShare(),
+                  getParentForChildren(),
+                  isClean());
+          share_ = null;
+        }
+        return shareBuilder_;
+      }
+
+      private java.lang.Object token_ = "";
+      /**
+       * <pre>
+       * token grants read-only access to the terminal until the share expires or is revoked
+       * </pre>
+       *
+       * <code>string token = 2;</code>
+       * @return The token.
+       */
+      public java.lang.String getToken() {
+        java.lang.Object ref = token_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          token_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * token grants read-only access to the terminal until the share expires or is revoked
+       * </pre>
+       *
+       * <code>string token = 2;</code>
+       * @return The bytes for token.
+       */
+      public com.google.protobuf.ByteString
+          getTokenBytes() {
+        java.lang.Object ref = token_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          token_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * token grants read-only access to the terminal until the share expires or is revoked
+       * </pre>
+       *
+       * <code>string token = 2;</code>
+       * @param value The token to set.
+       * @return This builder for chaining.
+       */
+      public Builder setToken(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        token_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * token grants read-only access to the terminal until the share expires or is revoked
+       * </pre>
+       *
+       * <code>string token = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearToken() {
+
+        token_ = getDefaultInstance().getToken();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * token grants read-only access to the terminal until the share expires or is revoked
+       * </pre>
+       *
+       * <code>string token = 2;</code>
+       * @param value The bytes for token to set.
+       * @return This builder for chaining.
+       */
+      public Builder setTokenBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        token_ = value;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ShareTerminalResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ShareTerminalResponse)
+    private static final io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse();
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ShareTerminalResponse>
+        PARSER = new com.google.protobuf.AbstractParser<ShareTerminalResponse>() {
+      @java.lang.Override
+      public ShareTerminalResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ShareTerminalResponse(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ShareTerminalResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ShareTerminalResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface UnshareTerminalRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.UnshareTerminalRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string id = 1;</code>
+     * @return The id.
+     */
+    java.lang.String getId();
+    /**
+     * <code>string id = 1;</code>
+     * @return The bytes for id.
+     */
+    com.google.protobuf.ByteString
+        getIdBytes();
+  }
+  /**
+   * Protobuf type {@code supervisor.UnshareTerminalRequest}
+   */
+  public static final class UnshareTerminalRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.UnshareTerminalRequest)
+      UnshareTerminalRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use UnshareTerminalRequest.newBuilder() to construct.
+    private UnshareTerminalRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private UnshareTerminalRequest() {
+      id_ = "";
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new UnshareTerminalRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private UnshareTerminalRequest(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              id_ = s;
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_UnshareTerminalRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_UnshareTerminalRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest.class, io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest.Builder.class);
+    }
+
+    public static final int ID_FIELD_NUMBER = 1;
+    private volatile java.lang.Object id_;
+    /**
+     * <code>string id = 1;</code>
+     * @return The id.
+     */
+    @java.lang.Override
+    public java.lang.String getId() {
+      java.lang.Object ref = id_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        id_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string id = 1;</code>
+     * @return The bytes for id.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getIdBytes() {
+      java.lang.Object ref = id_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        id_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(id_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, id_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(id_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, id_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest other = (io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest) obj;
+
+      if (!getId()
+          .equals(other.getId())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + ID_FIELD_NUMBER;
+      hash = (53 * hash) + getId().hashCode();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.UnshareTerminalRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.UnshareTerminalRequest)
+        io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_UnshareTerminalRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_UnshareTerminalRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest.class, io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        id_ = "";
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_UnshareTerminalRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest build() {
+        io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest buildPartial() {
+        io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest result = new io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest(this);
+        result.id_ = id_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest) {
+          return mergeFrom((io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest other) {
+        if (other == io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest.getDefaultInstance()) return this;
+        if (!other.getId().isEmpty()) {
+          id_ = other.id_;
+          onChanged();
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private java.lang.Object id_ = "";
+      /**
+       * <code>string id = 1;</code>
+       * @return The id.
+       */
+      public java.lang.String getId() {
+        java.lang.Object ref = id_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          id_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string id = 1;</code>
+       * @return The bytes for id.
+       */
+      public com.google.protobuf.ByteString
+          getIdBytes() {
+        java.lang.Object ref = id_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          id_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string id = 1;</code>
+       * @param value The id to set.
+       * @return This builder for chaining.
+       */
+      public Builder setId(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        id_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string id = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearId() {
+
+        id_ = getDefaultInstance().getId();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string id = 1;</code>
+       * @param value The bytes for id to set.
+       * @return This builder for chaining.
+       */
+      public Builder setIdBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        id_ = value;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.UnshareTerminalRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.UnshareTerminalRequest)
+    private static final io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest();
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<UnshareTerminalRequest>
+        PARSER = new com.google.protobuf.AbstractParser<UnshareTerminalRequest>() {
+      @java.lang.Override
+      public UnshareTerminalRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new UnshareTerminalRequest(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<UnshareTerminalRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<UnshareTerminalRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface UnshareTerminalResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.UnshareTerminalResponse)
+      com.google.protobuf.MessageOrBuilder {
+  }
+  /**
+   * Protobuf type {@code supervisor.UnshareTerminalResponse}
+   */
+  public static final class UnshareTerminalResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.UnshareTerminalResponse)
+      UnshareTerminalResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use UnshareTerminalResponse.newBuilder() to construct.
+    private UnshareTerminalResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private UnshareTerminalResponse() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new UnshareTerminalResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private UnshareTerminalResponse(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_UnshareTerminalResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_UnshareTerminalResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse.class, io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse.Builder.class);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse other = (io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse) obj;
+
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.UnshareTerminalResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.UnshareTerminalResponse)
+        io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_UnshareTerminalResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_UnshareTerminalResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse.class, io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_UnshareTerminalResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse build() {
+        io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse buildPartial() {
+        io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse result = new io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse(this);
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse) {
+          return mergeFrom((io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse other) {
+        if (other == io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse.getDefaultInstance()) return this;
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.UnshareTerminalResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.UnshareTerminalResponse)
+    private static final io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse();
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<UnshareTerminalResponse>
+        PARSER = new com.google.protobuf.AbstractParser<UnshareTerminalResponse>() {
+      @java.lang.Override
+      public UnshareTerminalResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new UnshareTerminalResponse(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<UnshareTerminalResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<UnshareTerminalResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ListTerminalSharesRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ListTerminalSharesRequest)
+      com.google.protobuf.MessageOrBuilder {
+  }
+  /**
+   * Protobuf type {@code supervisor.ListTerminalSharesRequest}
+   */
+  public static final class ListTerminalSharesRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ListTerminalSharesRequest)
+      ListTerminalSharesRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ListTerminalSharesRequest.newBuilder() to construct.
+    private ListTerminalSharesRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ListTerminalSharesRequest() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ListTerminalSharesRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ListTerminalSharesRequest(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ListTerminalSharesRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ListTerminalSharesRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest.class, io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest.Builder.class);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest other = (io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest) obj;
+
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ListTerminalSharesRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ListTerminalSharesRequest)
+        io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ListTerminalSharesRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ListTerminalSharesRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest.class, io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ListTerminalSharesRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest build() {
+        io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest buildPartial() {
+        io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest result = new io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest(this);
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest) {
+          return mergeFrom((io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest other) {
+        if (other == io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest.getDefaultInstance()) return this;
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ListTerminalSharesRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ListTerminalSharesRequest)
+    private static final io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest();
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ListTerminalSharesRequest>
+        PARSER = new com.google.protobuf.AbstractParser<ListTerminalSharesRequest>() {
+      @java.lang.Override
+      public ListTerminalSharesRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ListTerminalSharesRequest(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ListTerminalSharesRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ListTerminalSharesRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ListTerminalSharesResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ListTerminalSharesResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+     */
+    java.util.List<io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare>
+        getSharesList();
+    /**
+     * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+     */
+    io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare getShares(int index);
+    /**
+     * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+     */
+    int getSharesCount();
+    /**
+     * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+     */
+    java.util.List<? extends io.gitpod.supervisor.api.TerminalOuterClass.TerminalShareOrBuilder>
+        getSharesOrBuilderList();
+    /**
+     * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+     */
+    io.gitpod.supervisor.api.TerminalOuterClass.TerminalShareOrBuilder getSharesOrBuilder(
+        int index);
+  }
+  /**
+   * Protobuf type {@code supervisor.ListTerminalSharesResponse}
+   */
+  public static final class ListTerminalSharesResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ListTerminalSharesResponse)
+      ListTerminalSharesResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ListTerminalSharesResponse.newBuilder() to construct.
+    private ListTerminalSharesResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ListTerminalSharesResponse() {
+      shares_ = java.util.Collections.emptyList();
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ListTerminalSharesResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ListTerminalSharesResponse(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      int mutable_bitField0_ = 0;
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              if (!((mutable_bitField0_ & 0x00000001) != 0)) {
+                shares_ = new java.util.ArrayList<io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare>();
+                mutable_bitField0_ |= 0x00000001;
+              }
+              shares_.add(
+                  input.readMessage(io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.parser(), extensionRegistry));
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        if (((mutable_bitField0_ & 0x00000001) != 0)) {
+          shares_ = java.util.Collections.unmodifiableList(shares_);
+        }
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ListTerminalSharesResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ListTerminalSharesResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse.class, io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse.Builder.class);
+    }
+
+    public static final int SHARES_FIELD_NUMBER = 1;
+    private java.util.List<io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare> shares_;
+    /**
+     * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+     */
+    @java.lang.Override
+    public java.util.List<io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare> getSharesList() {
+      return shares_;
+    }
+    /**
+     * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+     */
+    @java.lang.Override
+    public java.util.List<? extends io.gitpod.supervisor.api.TerminalOuterClass.TerminalShareOrBuilder>
+        getSharesOrBuilderList() {
+      return shares_;
+    }
+    /**
+     * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+     */
+    @java.lang.Override
+    public int getSharesCount() {
+      return shares_.size();
+    }
+    /**
+     * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare getShares(int index) {
+      return shares_.get(index);
+    }
+    /**
+     * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.TerminalOuterClass.TerminalShareOrBuilder getSharesOrBuilder(
+        int index) {
+      return shares_.get(index);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      for (int i = 0; i < shares_.size(); i++) {
+        output.writeMessage(1, shares_.get(i));
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      for (int i = 0; i < shares_.size(); i++) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(1, shares_.get(i));
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse other = (io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse) obj;
+
+      if (!getSharesList()
+          .equals(other.getSharesList())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      if (getSharesCount() > 0) {
+        hash = (37 * hash) + SHARES_FIELD_NUMBER;
+        hash = (53 * hash) + getSharesList().hashCode();
+      }
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ListTerminalSharesResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ListTerminalSharesResponse)
+        io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ListTerminalSharesResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ListTerminalSharesResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse.class, io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+          getSharesFieldBuilder();
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        if (sharesBuilder_ == null) {
+          shares_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+        } else {
+          sharesBuilder_.clear();
+        }
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ListTerminalSharesResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse build() {
+        io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse buildPartial() {
+        io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse result = new io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse(this);
+        int from_bitField0_ = bitField0_;
+        if (sharesBuilder_ == null) {
+          if (((bitField0_ & 0x00000001) != 0)) {
+            shares_ = java.util.Collections.unmodifiableList(shares_);
+            bitField0_ = (bitField0_ & ~0x00000001);
+          }
+          result.shares_ = shares_;
+        } else {
+          result.shares_ = sharesBuilder_.build();
+        }
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse) {
+          return mergeFrom((io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse other) {
+        if (other == io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse.getDefaultInstance()) return this;
+        if (sharesBuilder_ == null) {
+          if (!other.shares_.isEmpty()) {
+            if (shares_.isEmpty()) {
+              shares_ = other.shares_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+            } else {
+              ensureSharesIsMutable();
+              shares_.addAll(other.shares_);
+            }
+            onChanged();
+          }
+        } else {
+          if (!other.shares_.isEmpty()) {
+            if (sharesBuilder_.isEmpty()) {
+              sharesBuilder_.dispose();
+              sharesBuilder_ = null;
+              shares_ = other.shares_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+              sharesBuilder_ =
+                com.google.protobuf.GeneratedMessageV3.alwaysUseFieldBuilders ?
+                   getSharesFieldBuilder() : null;
+            } else {
+              sharesBuilder_.addAllMessages(other.shares_);
+            }
+          }
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+      private int bitField0_;
+
+      private java.util.List<io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare> shares_ =
+        java.util.Collections.emptyList();
+      private void ensureSharesIsMutable() {
+        if (!((bitField0_ & 0x00000001) != 0)) {
+          shares_ = new java.util.ArrayList<io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare>(shares_);
+          bitField0_ |= 0x00000001;
+         }
+      }
+
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.Builder, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShareOrBuilder> sharesBuilder_;
+
+      /**
+       * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+       */
+      public java.util.List<io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare> getSharesList() {
+        if (sharesBuilder_ == null) {
+          return java.util.Collections.unmodifiableList(shares_);
+        } else {
+          return sharesBuilder_.getMessageList();
+        }
+      }
+      /**
+       * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+       */
+      public int getSharesCount() {
+        if (sharesBuilder_ == null) {
+          return shares_.size();
+        } else {
+          return sharesBuilder_.getCount();
+        }
+      }
+      /**
+       * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+       */
+      public io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare getShares(int index) {
+        if (sharesBuilder_ == null) {
+          return shares_.get(index);
+        } else {
+          return sharesBuilder_.getMessage(index);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+       */
+      public Builder setShares(
+          int index, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare value) {
+        if (sharesBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureSharesIsMutable();
+          shares_.set(index, value);
+          onChanged();
+        } else {
+          sharesBuilder_.setMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+       */
+      public Builder setShares(
+          int index, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.Builder builderForValue) {
+        if (sharesBuilder_ == null) {
+          ensureSharesIsMutable();
+          shares_.set(index, builderForValue.build());
+          onChanged();
+        } else {
+          sharesBuilder_.setMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+       */
+      public Builder addShares(io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare value) {
+        if (sharesBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureSharesIsMutable();
+          shares_.add(value);
+          onChanged();
+        } else {
+          sharesBuilder_.addMessage(value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+       */
+      public Builder addShares(
+          int index, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare value) {
+        if (sharesBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureSharesIsMutable();
+          shares_.add(index, value);
+          onChanged();
+        } else {
+          sharesBuilder_.addMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+       */
+      public Builder addShares(
+          io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.Builder builderForValue) {
+        if (sharesBuilder_ == null) {
+          ensureSharesIsMutable();
+          shares_.add(builderForValue.build());
+          onChanged();
+        } else {
+          sharesBuilder_.addMessage(builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+       */
+      public Builder addShares(
+          int index, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.Builder builderForValue) {
+        if (sharesBuilder_ == null) {
+          ensureSharesIsMutable();
+          shares_.add(index, builderForValue.build());
+          onChanged();
+        } else {
+          sharesBuilder_.addMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+       */
+      public Builder addAllShares(
+          java.lang.Iterable<? extends io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare> values) {
+        if (sharesBuilder_ == null) {
+          ensureSharesIsMutable();
+          com.google.protobuf.AbstractMessageLite.Builder.addAll(
+              values, shares_);
+          onChanged();
+        } else {
+          sharesBuilder_.addAllMessages(values);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+       */
+      public Builder clearShares() {
+        if (sharesBuilder_ == null) {
+          shares_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+          onChanged();
+        } else {
+          sharesBuilder_.clear();
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+       */
+      public Builder removeShares(int index) {
+        if (sharesBuilder_ == null) {
+          ensureSharesIsMutable();
+          shares_.remove(index);
+          onChanged();
+        } else {
+          sharesBuilder_.remove(index);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+       */
+      public io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.Builder getSharesBuilder(
+          int index) {
+        return getSharesFieldBuilder().getBuilder(index);
+      }
+      /**
+       * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+       */
+      public io.gitpod.supervisor.api.TerminalOuterClass.TerminalShareOrBuilder getSharesOrBuilder(
+          int index) {
+        if (sharesBuilder_ == null) {
+          return shares_.get(index);  } else {
+          return sharesBuilder_.getMessageOrBuilder(index);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+       */
+      public java.util.List<? extends io.gitpod.supervisor.api.TerminalOuterClass.TerminalShareOrBuilder>
+           getSharesOrBuilderList() {
+        if (sharesBuilder_ != null) {
+          return sharesBuilder_.getMessageOrBuilderList();
+        } else {
+          return java.util.Collections.unmodifiableList(shares_);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+       */
+      public io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.Builder addSharesBuilder() {
+        return getSharesFieldBuilder().addBuilder(
+            io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+       */
+      public io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.Builder addSharesBuilder(
+          int index) {
+        return getSharesFieldBuilder().addBuilder(
+            index, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .supervisor.TerminalShare shares = 1;</code>
+       */
+      public java.util.List<io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.Builder>
+           getSharesBuilderList() {
+        return getSharesFieldBuilder().getBuilderList();
+      }
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.Builder, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShareOrBuilder>
+          getSharesFieldBuilder() {
+        if (sharesBuilder_ == null) {
+          sharesBuilder_ = new com.google.protobuf.RepeatedFieldBuilderV3<
+              io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShare.Builder, io.gitpod.supervisor.api.TerminalOuterClass.TerminalShareOrBuilder>(
+                  shares_,
+                  ((bitField0_ & 0x00000001) != 0),
+                  getParentForChildren(),
+                  isClean());
+          shares_ = null;
+        }
+        return sharesBuilder_;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ListTerminalSharesResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ListTerminalSharesResponse)
+    private static final io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse();
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ListTerminalSharesResponse>
+        PARSER = new com.google.protobuf.AbstractParser<ListTerminalSharesResponse>() {
+      @java.lang.Override
+      public ListTerminalSharesResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ListTerminalSharesResponse(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ListTerminalSharesResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ListTerminalSharesResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface ListenSharedTerminalRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.ListenSharedTerminalRequest)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string token = 1;</code>
+     * @return The token.
+     */
+    java.lang.String getToken();
+    /**
+     * <code>string token = 1;</code>
+     * @return The bytes for token.
+     */
+    com.google.protobuf.ByteString
+        getTokenBytes();
+
+    /**
+     * <pre>
+     * viewer describes who is watching, e.g. a name and the remote address
+     * </pre>
+     *
+     * <code>string viewer = 2;</code>
+     * @return The viewer.
+     */
+    java.lang.String getViewer();
+    /**
+     * <pre>
+     * viewer describes who is watching, e.g. a name and the remote address
+     * </pre>
+     *
+     * <code>string viewer = 2;</code>
+     * @return The bytes for viewer.
+     */
+    com.google.protobuf.ByteString
+        getViewerBytes();
+  }
+  /**
+   * Protobuf type {@code supervisor.ListenSharedTerminalRequest}
+   */
+  public static final class ListenSharedTerminalRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.ListenSharedTerminalRequest)
+      ListenSharedTerminalRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use ListenSharedTerminalRequest.newBuilder() to construct.
+    private ListenSharedTerminalRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private ListenSharedTerminalRequest() {
+      token_ = "";
+      viewer_ = "";
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new ListenSharedTerminalRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private ListenSharedTerminalRequest(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              token_ = s;
+              break;
+            }
+            case 18: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              viewer_ = s;
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ListenSharedTerminalRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ListenSharedTerminalRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest.class, io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest.Builder.class);
+    }
+
+    public static final int TOKEN_FIELD_NUMBER = 1;
+    private volatile java.lang.Object token_;
+    /**
+     * <code>string token = 1;</code>
+     * @return The token.
+     */
+    @java.lang.Override
+    public java.lang.String getToken() {
+      java.lang.Object ref = token_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        token_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string token = 1;</code>
+     * @return The bytes for token.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getTokenBytes() {
+      java.lang.Object ref = token_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        token_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int VIEWER_FIELD_NUMBER = 2;
+    private volatile java.lang.Object viewer_;
+    /**
+     * <pre>
+     * viewer describes who is watching, e.g. a name and the remote address
+     * </pre>
+     *
+     * <code>string viewer = 2;</code>
+     * @return The viewer.
+     */
+    @java.lang.Override
+    public java.lang.String getViewer() {
+      java.lang.Object ref = viewer_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        viewer_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * viewer describes who is watching, e.g. a name and the remote address
+     * </pre>
+     *
+     * <code>string viewer = 2;</code>
+     * @return The bytes for viewer.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getViewerBytes() {
+      java.lang.Object ref = viewer_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        viewer_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(token_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, token_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(viewer_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 2, viewer_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(token_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, token_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(viewer_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(2, viewer_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest other = (io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest) obj;
+
+      if (!getToken()
+          .equals(other.getToken())) return false;
+      if (!getViewer()
+          .equals(other.getViewer())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + TOKEN_FIELD_NUMBER;
+      hash = (53 * hash) + getToken().hashCode();
+      hash = (37 * hash) + VIEWER_FIELD_NUMBER;
+      hash = (53 * hash) + getViewer().hashCode();
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.ListenSharedTerminalRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.ListenSharedTerminalRequest)
+        io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ListenSharedTerminalRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ListenSharedTerminalRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest.class, io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        token_ = "";
+
+        viewer_ = "";
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.internal_static_supervisor_ListenSharedTerminalRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest build() {
+        io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest buildPartial() {
+        io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest result = new io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest(this);
+        result.token_ = token_;
+        result.viewer_ = viewer_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest) {
+          return mergeFrom((io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest other) {
+        if (other == io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest.getDefaultInstance()) return this;
+        if (!other.getToken().isEmpty()) {
+          token_ = other.token_;
+          onChanged();
+        }
+        if (!other.getViewer().isEmpty()) {
+          viewer_ = other.viewer_;
+          onChanged();
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private java.lang.Object token_ = "";
+      /**
+       * <code>string token = 1;</code>
+       * @return The token.
+       */
+      public java.lang.String getToken() {
+        java.lang.Object ref = token_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          token_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string token = 1;</code>
+       * @return The bytes for token.
+       */
+      public com.google.protobuf.ByteString
+          getTokenBytes() {
+        java.lang.Object ref = token_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          token_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string token = 1;</code>
+       * @param value The token to set.
+       * @return This builder for chaining.
+       */
+      public Builder setToken(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        token_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string token = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearToken() {
+
+        token_ = getDefaultInstance().getToken();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string token = 1;</code>
+       * @param value The bytes for token to set.
+       * @return This builder for chaining.
+       */
+      public Builder setTokenBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        token_ = value;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object viewer_ = "";
+      /**
+       * <pre>
+       * viewer describes who is watching, e.g. a name and the remote address
+       * </pre>
+       *
+       * <code>string viewer = 2;</code>
+       * @return The viewer.
+       */
+      public java.lang.String getViewer() {
+        java.lang.Object ref = viewer_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          viewer_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * viewer describes who is watching, e.g. a name and the remote address
+       * </pre>
+       *
+       * <code>string viewer = 2;</code>
+       * @return The bytes for viewer.
+       */
+      public com.google.protobuf.ByteString
+          getViewerBytes() {
+        java.lang.Object ref = viewer_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          viewer_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * viewer describes who is watching, e.g. a name and the remote address
+       * </pre>
+       *
+       * <code>string viewer = 2;</code>
+       * @param value The viewer to set.
+       * @return This builder for chaining.
+       */
+      public Builder setViewer(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        viewer_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * viewer describes who is watching, e.g. a name and the remote address
+       * </pre>
+       *
+       * <code>string viewer = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearViewer() {
+
+        viewer_ = getDefaultInstance().getViewer();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * viewer describes who is watching, e.g. a name and the remote address
+       * </pre>
+       *
+       * <code>string viewer = 2;</code>
+       * @param value The bytes for viewer to set.
+       * @return This builder for chaining.
+       */
+      public Builder setViewerBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        viewer_ = value;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.ListenSharedTerminalRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.ListenSharedTerminalRequest)
+    private static final io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest();
+    }
+
+    public static io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<ListenSharedTerminalRequest>
+        PARSER = new com.google.protobuf.AbstractParser<ListenSharedTerminalRequest>() {
+      @java.lang.Override
+      public ListenSharedTerminalRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new ListenSharedTerminalRequest(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<ListenSharedTerminalRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<ListenSharedTerminalRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
   private static final com.google.protobuf.Descriptors.Descriptor
     internal_static_supervisor_TerminalSize_descriptor;
   private static final
@@ -15202,6 +20843,46 @@ java.lang.String defaultValue);
     com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
       internal_static_supervisor_UpdateTerminalAnnotationsResponse_fieldAccessorTable;
 
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_TerminalShare_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_TerminalShare_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ShareTerminalRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ShareTerminalRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ShareTerminalResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ShareTerminalResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_UnshareTerminalRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_UnshareTerminalRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_UnshareTerminalResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_UnshareTerminalResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ListTerminalSharesRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ListTerminalSharesRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ListTerminalSharesResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ListTerminalSharesResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ListenSharedTerminalRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ListenSharedTerminalRequest_fieldAccessorTable;
   public static com.google.protobuf.Descriptors.FileDescriptor
       getDescriptor() {
     return descriptor;
@@ -15211,82 +20892,105 @@ java.lang.String defaultValue);
   static {
     java.lang.String[] descriptorData = {
       "\n\016terminal.proto\022\nsupervisor\032\034google/api" +
-      "/annotations.proto\"M\n\014TerminalSize\022\014\n\004ro" +
-      "ws\030\001 \001(\r\022\014\n\004cols\030\002 \001(\r\022\017\n\007widthPx\030\003 \001(\r\022" +
-      "\020\n\010heightPx\030\004 \001(\r\"\317\002\n\023OpenTerminalReques" +
-      "t\022\017\n\007workdir\030\001 \001(\t\0225\n\003env\030\002 \003(\0132(.superv" +
-      "isor.OpenTerminalRequest.EnvEntry\022E\n\013ann" +
-      "otations\030\003 \003(\01320.supervisor.OpenTerminal" +
-      "Request.AnnotationsEntry\022\r\n\005shell\030\004 \001(\t\022" +
-      "\022\n\nshell_args\030\005 \003(\t\022&\n\004size\030\006 \001(\0132\030.supe" +
-      "rvisor.TerminalSize\032*\n\010EnvEntry\022\013\n\003key\030\001" +
-      " \001(\t\022\r\n\005value\030\002 \001(\t:\0028\001\0322\n\020AnnotationsEn" +
-      "try\022\013\n\003key\030\001 \001(\t\022\r\n\005value\030\002 \001(\t:\0028\001\"U\n\024O" +
-      "penTerminalResponse\022&\n\010terminal\030\001 \001(\0132\024." +
-      "supervisor.Terminal\022\025\n\rstarter_token\030\002 \001" +
-      "(\t\"(\n\027ShutdownTerminalRequest\022\r\n\005alias\030\001" +
-      " \001(\t\"\032\n\030ShutdownTerminalResponse\"\237\002\n\010Ter" +
-      "minal\022\r\n\005alias\030\001 \001(\t\022\017\n\007command\030\002 \003(\t\022\r\n" +
-      "\005title\030\003 \001(\t\022\013\n\003pid\030\004 \001(\003\022\027\n\017initial_wor" +
-      "kdir\030\005 \001(\t\022\027\n\017current_workdir\030\006 \001(\t\022:\n\013a" +
-      "nnotations\030\007 \003(\0132%.supervisor.Terminal.A" +
-      "nnotationsEntry\0225\n\014title_source\030\010 \001(\0162\037." +
-      "supervisor.TerminalTitleSource\0322\n\020Annota" +
-      "tionsEntry\022\013\n\003key\030\001 \001(\t\022\r\n\005value\030\002 \001(\t:\002" +
-      "8\001\"#\n\022GetTerminalRequest\022\r\n\005alias\030\001 \001(\t\"" +
-      "\026\n\024ListTerminalsRequest\"@\n\025ListTerminals" +
-      "Response\022\'\n\tterminals\030\001 \003(\0132\024.supervisor" +
-      ".Terminal\"&\n\025ListenTerminalRequest\022\r\n\005al" +
-      "ias\030\001 \001(\t\"\217\001\n\026ListenTerminalResponse\022\016\n\004" +
-      "data\030\001 \001(\014H\000\022\023\n\texit_code\030\002 \001(\005H\000\022\017\n\005tit" +
-      "le\030\003 \001(\tH\000\0225\n\014title_source\030\004 \001(\0162\037.super" +
-      "visor.TerminalTitleSourceB\010\n\006output\"4\n\024W" +
-      "riteTerminalRequest\022\r\n\005alias\030\001 \001(\t\022\r\n\005st" +
-      "din\030\002 \001(\014\".\n\025WriteTerminalResponse\022\025\n\rby" +
-      "tes_written\030\001 \001(\r\"}\n\026SetTerminalSizeRequ" +
-      "est\022\r\n\005alias\030\001 \001(\t\022\017\n\005token\030\002 \001(\tH\000\022\017\n\005f" +
-      "orce\030\003 \001(\010H\000\022&\n\004size\030\004 \001(\0132\030.supervisor." +
-      "TerminalSizeB\n\n\010priority\"\031\n\027SetTerminalS" +
-      "izeResponse\"7\n\027SetTerminalTitleRequest\022\r" +
-      "\n\005alias\030\001 \001(\t\022\r\n\005title\030\002 \001(\t\"\032\n\030SetTermi" +
-      "nalTitleResponse\"\276\001\n UpdateTerminalAnnot" +
-      "ationsRequest\022\r\n\005alias\030\001 \001(\t\022J\n\007changed\030" +
-      "\002 \003(\01329.supervisor.UpdateTerminalAnnotat" +
-      "ionsRequest.ChangedEntry\022\017\n\007deleted\030\003 \003(" +
-      "\t\032.\n\014ChangedEntry\022\013\n\003key\030\001 \001(\t\022\r\n\005value\030" +
-      "\002 \001(\t:\0028\001\"#\n!UpdateTerminalAnnotationsRe" +
-      "sponse*+\n\023TerminalTitleSource\022\013\n\007process" +
-      "\020\000\022\007\n\003api\020\0012\260\007\n\017TerminalService\022K\n\004Open\022" +
-      "\037.supervisor.OpenTerminalRequest\032 .super" +
-      "visor.OpenTerminalResponse\"\000\022|\n\010Shutdown" +
-      "\022#.supervisor.ShutdownTerminalRequest\032$." +
-      "supervisor.ShutdownTerminalResponse\"%\202\323\344" +
-      "\223\002\037\022\035/v1/terminal/shutdown/{alias}\022]\n\003Ge" +
-      "t\022\036.supervisor.GetTerminalRequest\032\024.supe" +
-      "rvisor.Terminal\" \202\323\344\223\002\032\022\030/v1/terminal/ge" +
-      "t/{alias}\022f\n\004List\022 .supervisor.ListTermi" +
-      "nalsRequest\032!.supervisor.ListTerminalsRe" +
-      "sponse\"\031\202\323\344\223\002\023\022\021/v1/terminal/list\022v\n\006Lis" +
-      "ten\022!.supervisor.ListenTerminalRequest\032\"" +
-      ".supervisor.ListenTerminalResponse\"#\202\323\344\223" +
-      "\002\035\022\033/v1/terminal/listen/{alias}0\001\022p\n\005Wri" +
-      "te\022 .supervisor.WriteTerminalRequest\032!.s" +
-      "upervisor.WriteTerminalResponse\"\"\202\323\344\223\002\034\"" +
-      "\032/v1/terminal/write/{alias}\022T\n\007SetSize\022\"" +
-      ".supervisor.SetTerminalSizeRequest\032#.sup" +
-      "ervisor.SetTerminalSizeResponse\"\000\022W\n\010Set" +
-      "Title\022#.supervisor.SetTerminalTitleReque" +
-      "st\032$.supervisor.SetTerminalTitleResponse" +
-      "\"\000\022r\n\021UpdateAnnotations\022,.supervisor.Upd" +
-      "ateTerminalAnnotationsRequest\032-.supervis" +
-      "or.UpdateTerminalAnnotationsResponse\"\000BF" +
-      "\n\030io.gitpod.supervisor.apiZ*github.com/g" +
-      "itpod-io/gitpod/supervisor/apib\006proto3"
+      "/annotations.proto\032\037google/protobuf/time" +
+      "stamp.proto\"M\n\014TerminalSize\022\014\n\004rows\030\001 \001(" +
+      "\r\022\014\n\004cols\030\002 \001(\r\022\017\n\007widthPx\030\003 \001(\r\022\020\n\010heig" +
+      "htPx\030\004 \001(\r\"\317\002\n\023OpenTerminalRequest\022\017\n\007wo" +
+      "rkdir\030\001 \001(\t\0225\n\003env\030\002 \003(\0132(.supervisor.Op" +
+      "enTerminalRequest.EnvEntry\022E\n\013annotation" +
+      "s\030\003 \003(\01320.supervisor.OpenTerminalRequest" +
+      ".AnnotationsEntry\022\r\n\005shell\030\004 \001(\t\022\022\n\nshel" +
+      "l_args\030\005 \003(\t\022&\n\004size\030\006 \001(\0132\030.supervisor." +
+      "TerminalSize\032*\n\010EnvEntry\022\013\n\003key\030\001 \001(\t\022\r\n" +
+      "\005value\030\002 \001(\t:\0028\001\0322\n\020AnnotationsEntry\022\013\n\003" +
+      "key\030\001 \001(\t\022\r\n\005value\030\002 \001(\t:\0028\001\"U\n\024OpenTerm" +
+      "inalResponse\022&\n\010terminal\030\001 \001(\0132\024.supervi" +
+      "sor.Terminal\022\025\n\rstarter_token\030\002 \001(\t\"(\n\027S" +
+      "hutdownTerminalRequest\022\r\n\005alias\030\001 \001(\t\"\032\n" +
+      "\030ShutdownTerminalResponse\"\237\002\n\010Terminal\022\r" +
+      "\n\005alias\030\001 \001(\t\022\017\n\007command\030\002 \003(\t\022\r\n\005title\030" +
+      "\003 \001(\t\022\013\n\003pid\030\004 \001(\003\022\027\n\017initial_workdir\030\005 " +
+      "\001(\t\022\027\n\017current_workdir\030\006 \001(\t\022:\n\013annotati" +
+      "ons\030\007 \003(\0132%.supervisor.Terminal.Annotati" +
+      "onsEntry\0225\n\014title_source\030\010 \001(\0162\037.supervi" +
+      "sor.TerminalTitleSource\0322\n\020AnnotationsEn" +
+      "try\022\013\n\003key\030\001 \001(\t\022\r\n\005value\030\002 \001(\t:\0028\001\"#\n\022G" +
+      "etTerminalRequest\022\r\n\005alias\030\001 \001(\t\"\026\n\024List" +
+      "TerminalsRequest\"@\n\025ListTerminalsRespons" +
+      "e\022\'\n\tterminals\030\001 \003(\0132\024.supervisor.Termin" +
+      "al\"&\n\025ListenTerminalRequest\022\r\n\005alias\030\001 \001" +
+      "(\t\"\217\001\n\026ListenTerminalResponse\022\016\n\004data\030\001 " +
+      "\001(\014H\000\022\023\n\texit_code\030\002 \001(\005H\000\022\017\n\005title\030\003 \001(" +
+      "\tH\000\0225\n\014title_source\030\004 \001(\0162\037.supervisor.T" +
+      "erminalTitleSourceB\010\n\006output\"4\n\024WriteTer" +
+      "minalRequest\022\r\n\005alias\030\001 \001(\t\022\r\n\005stdin\030\002 \001" +
+      "(\014\".\n\025WriteTerminalResponse\022\025\n\rbytes_wri" +
+      "tten\030\001 \001(\r\"}\n\026SetTerminalSizeRequest\022\r\n\005" +
+      "alias\030\001 \001(\t\022\017\n\005token\030\002 \001(\tH\000\022\017\n\005force\030\003 " +
+      "\001(\010H\000\022&\n\004size\030\004 \001(\0132\030.supervisor.Termina" +
+      "lSizeB\n\n\010priority\"\031\n\027SetTerminalSizeResp" +
+      "onse\"7\n\027SetTerminalTitleRequest\022\r\n\005alias" +
+      "\030\001 \001(\t\022\r\n\005title\030\002 \001(\t\"\032\n\030SetTerminalTitl" +
+      "eResponse\"\276\001\n UpdateTerminalAnnotationsR" +
+      "equest\022\r\n\005alias\030\001 \001(\t\022J\n\007changed\030\002 \003(\01329" +
+      ".supervisor.UpdateTerminalAnnotationsReq" +
+      "uest.ChangedEntry\022\017\n\007deleted\030\003 \003(\t\032.\n\014Ch" +
+      "angedEntry\022\013\n\003key\030\001 \001(\t\022\r\n\005value\030\002 \001(\t:\002" +
+      "8\001\"#\n!UpdateTerminalAnnotationsResponse\"" +
+      "k\n\rTerminalShare\022\n\n\002id\030\001 \001(\t\022\r\n\005alias\030\002 " +
+      "\001(\t\022.\n\nexpires_at\030\003 \001(\0132\032.google.protobu" +
+      "f.Timestamp\022\017\n\007viewers\030\004 \003(\t\":\n\024ShareTer" +
+      "minalRequest\022\r\n\005alias\030\001 \001(\t\022\023\n\013ttl_secon" +
+      "ds\030\002 \001(\r\"P\n\025ShareTerminalResponse\022(\n\005sha" +
+      "re\030\001 \001(\0132\031.supervisor.TerminalShare\022\r\n\005t" +
+      "oken\030\002 \001(\t\"$\n\026UnshareTerminalRequest\022\n\n\002" +
+      "id\030\001 \001(\t\"\031\n\027UnshareTerminalResponse\"\033\n\031L" +
+      "istTerminalSharesRequest\"G\n\032ListTerminal" +
+      "SharesResponse\022)\n\006shares\030\001 \003(\0132\031.supervi" +
+      "sor.TerminalShare\"<\n\033ListenSharedTermina" +
+      "lRequest\022\r\n\005token\030\001 \001(\t\022\016\n\006viewer\030\002 \001(\t*" +
+      "+\n\023TerminalTitleSource\022\013\n\007process\020\000\022\007\n\003a" +
+      "pi\020\0012\226\n\n\017TerminalService\022K\n\004Open\022\037.super" +
+      "visor.OpenTerminalRequest\032 .supervisor.O" +
+      "penTerminalResponse\"\000\022|\n\010Shutdown\022#.supe" +
+      "rvisor.ShutdownTerminalRequest\032$.supervi" +
+      "sor.ShutdownTerminalResponse\"%\202\323\344\223\002\037\022\035/v" +
+      "1/terminal/shutdown/{alias}\022]\n\003Get\022\036.sup" +
+      "ervisor.GetTerminalRequest\032\024.supervisor." +
+      "Terminal\" \202\323\344\223\002\032\022\030/v1/terminal/get/{alia" +
+      "s}\022f\n\004List\022 .supervisor.ListTerminalsReq" +
+      "uest\032!.supervisor.ListTerminalsResponse\"" +
+      "\031\202\323\344\223\002\023\022\021/v1/terminal/list\022v\n\006Listen\022!.s" +
+      "upervisor.ListenTerminalRequest\032\".superv" +
+      "isor.ListenTerminalResponse\"#\202\323\344\223\002\035\022\033/v1" +
+      "/terminal/listen/{alias}0\001\022p\n\005Write\022 .su" +
+      "pervisor.WriteTerminalRequest\032!.supervis" +
+      "or.WriteTerminalResponse\"\"\202\323\344\223\002\034\"\032/v1/te" +
+      "rminal/write/{alias}\022T\n\007SetSize\022\".superv" +
+      "isor.SetTerminalSizeRequest\032#.supervisor" +
+      ".SetTerminalSizeResponse\"\000\022W\n\010SetTitle\022#" +
+      ".supervisor.SetTerminalTitleRequest\032$.su" +
+      "pervisor.SetTerminalTitleResponse\"\000\022r\n\021U" +
+      "pdateAnnotations\022,.supervisor.UpdateTerm" +
+      "inalAnnotationsRequest\032-.supervisor.Upda" +
+      "teTerminalAnnotationsResponse\"\000\022N\n\005Share" +
+      "\022 .supervisor.ShareTerminalRequest\032!.sup" +
+      "ervisor.ShareTerminalResponse\"\000\022T\n\007Unsha" +
+      "re\022\".supervisor.UnshareTerminalRequest\032#" +
+      ".supervisor.UnshareTerminalResponse\"\000\022]\n" +
+      "\nListShares\022%.supervisor.ListTerminalSha" +
+      "resRequest\032&.supervisor.ListTerminalShar" +
+      "esResponse\"\000\022_\n\014ListenShared\022\'.superviso" +
+      "r.ListenSharedTerminalRequest\032\".supervis" +
+      "or.ListenTerminalResponse\"\0000\001BF\n\030io.gitp" +
+      "od.supervisor.apiZ*github.com/gitpod-io/" +
+      "gitpod/supervisor/apib\006proto3"
     };
     descriptor = com.google.protobuf.Descriptors.FileDescriptor
       .internalBuildGeneratedFileFrom(descriptorData,
         new com.google.protobuf.Descriptors.FileDescriptor[] {
           com.google.api.AnnotationsProto.getDescriptor(),
+          com.google.protobuf.TimestampProto.getDescriptor(),
         });
     internal_static_supervisor_TerminalSize_descriptor =
       getDescriptor().getMessageTypes().get(0);
@@ -15426,12 +21130,61 @@ java.lang.String defaultValue);
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_supervisor_UpdateTerminalAnnotationsResponse_descriptor,
         new java.lang.String[] { });
+    internal_static_supervisor_TerminalShare_descriptor =
+      getDescriptor().getMessageTypes().get(19);
+    internal_static_supervisor_TerminalShare_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_TerminalShare_descriptor,
+        new java.lang.String[] { "Id", "Alias", "ExpiresAt", "Viewers", });
+    internal_static_supervisor_ShareTerminalRequest_descriptor =
+      getDescriptor().getMessageTypes().get(20);
+    internal_static_supervisor_ShareTerminalRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ShareTerminalRequest_descriptor,
+        new java.lang.String[] { "Alias", "TtlSeconds", });
+    internal_static_supervisor_ShareTerminalResponse_descriptor =
+      getDescriptor().getMessageTypes().get(21);
+    internal_static_supervisor_ShareTerminalResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ShareTerminalResponse_descriptor,
+        new java.lang.String[] { "Share", "Token", });
+    internal_static_supervisor_UnshareTerminalRequest_descriptor =
+      getDescriptor().getMessageTypes().get(22);
+    internal_static_supervisor_UnshareTerminalRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_UnshareTerminalRequest_descriptor,
+        new java.lang.String[] { "Id", });
+    internal_static_supervisor_UnshareTerminalResponse_descriptor =
+      getDescriptor().getMessageTypes().get(23);
+    internal_static_supervisor_UnshareTerminalResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_UnshareTerminalResponse_descriptor,
+        new java.lang.String[] { });
+    internal_static_supervisor_ListTerminalSharesRequest_descriptor =
+      getDescriptor().getMessageTypes().get(24);
+    internal_static_supervisor_ListTerminalSharesRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ListTerminalSharesRequest_descriptor,
+        new java.lang.String[] { });
+    internal_static_supervisor_ListTerminalSharesResponse_descriptor =
+      getDescriptor().getMessageTypes().get(25);
+    internal_static_supervisor_ListTerminalSharesResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ListTerminalSharesResponse_descriptor,
+        new java.lang.String[] { "Shares", });
+    internal_static_supervisor_ListenSharedTerminalRequest_descriptor =
+      getDescriptor().getMessageTypes().get(26);
+    internal_static_supervisor_ListenSharedTerminalRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ListenSharedTerminalRequest_descriptor,
+        new java.lang.String[] { "Token", "Viewer", });
     com.google.protobuf.ExtensionRegistry registry =
         com.google.protobuf.ExtensionRegistry.newInstance();
     registry.add(com.google.api.AnnotationsProto.http);
     com.google.protobuf.Descriptors.FileDescriptor
         .internalUpdateFileDescriptor(descriptor, registry);
     com.google.api.AnnotationsProto.getDescriptor();
+    com.google.protobuf.TimestampProto.getDescriptor();
   }
 
   // @@protoc_insertion_point(outer_class_scope)
@@ -298,6 +298,130 @@ public final class TerminalServiceGrpc {
     return getUpdateAnnotationsMethod;
   }
 
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest,
+      io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse> getShareMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "Share",
+      requestType = io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest.class,
+      responseType = io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest,
+      io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse> getShareMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest, io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse> getShareMethod;
+    if ((getShareMethod = TerminalServiceGrpc.getShareMethod) == null) {
+      synchronized (TerminalServiceGrpc.class) {
+        if ((getShareMethod = TerminalServiceGrpc.getShareMethod) == null) {
+          TerminalServiceGrpc.getShareMethod = getShareMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest, io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "Share"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new TerminalServiceMethodDescriptorSupplier("Share"))
+              .build();
+        }
+      }
+    }
+    return getShareMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest,
+      io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse> getUnshareMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "Unshare",
+      requestType = io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest.class,
+      responseType = io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest,
+      io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse> getUnshareMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest, io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse> getUnshareMethod;
+    if ((getUnshareMethod = TerminalServiceGrpc.getUnshareMethod) == null) {
+      synchronized (TerminalServiceGrpc.class) {
+        if ((getUnshareMethod = TerminalServiceGrpc.getUnshareMethod) == null) {
+          TerminalServiceGrpc.getUnshareMethod = getUnshareMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest, io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "Unshare"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new TerminalServiceMethodDescriptorSupplier("Unshare"))
+              .build();
+        }
+      }
+    }
+    return getUnshareMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest,
+      io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse> getListSharesMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "ListShares",
+      requestType = io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest.class,
+      responseType = io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest,
+      io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse> getListSharesMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest, io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse> getListSharesMethod;
+    if ((getListSharesMethod = TerminalServiceGrpc.getListSharesMethod) == null) {
+      synchronized (TerminalServiceGrpc.class) {
+        if ((getListSharesMethod = TerminalServiceGrpc.getListSharesMethod) == null) {
+          TerminalServiceGrpc.getListSharesMethod = getListSharesMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest, io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "ListShares"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new TerminalServiceMethodDescriptorSupplier("ListShares"))
+              .build();
+        }
+      }
+    }
+    return getListSharesMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest,
+      io.gitpod.supervisor.api.TerminalOuterClass.ListenTerminalResponse> getListenSharedMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "ListenShared",
+      requestType = io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest.class,
+      responseType = io.gitpod.supervisor.api.TerminalOuterClass.ListenTerminalResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.SERVER_STREAMING)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest,
+      io.gitpod.supervisor.api.TerminalOuterClass.ListenTerminalResponse> getListenSharedMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest, io.gitpod.supervisor.api.TerminalOuterClass.ListenTerminalResponse> getListenSharedMethod;
+    if ((getListenSharedMethod = TerminalServiceGrpc.getListenSharedMethod) == null) {
+      synchronized (TerminalServiceGrpc.class) {
+        if ((getListenSharedMethod = TerminalServiceGrpc.getListenSharedMethod) == null) {
+          TerminalServiceGrpc.getListenSharedMethod = getListenSharedMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest, io.gitpod.supervisor.api.TerminalOuterClass.ListenTerminalResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.SERVER_STREAMING)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "ListenShared"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.TerminalOuterClass.ListenTerminalResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new TerminalServiceMethodDescriptorSupplier("ListenShared"))
+              .build();
+        }
+      }
+    }
+    return getListenSharedMethod;
+  }
+
   /**
    * Creates a new async stub that supports all call types for the service
    */
@@ -437,6 +561,46 @@ public final class TerminalServiceGrpc {
       io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getUpdateAnnotationsMethod(), responseObserver);
     }
 
+    /**
+     * <pre>
+     * Share creates a short-lived, read-only share of a terminal
+     * </pre>
+     */
+    public void share(io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getShareMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * Unshare revokes a terminal share and disconnects all of its viewers
+     * </pre>
+     */
+    public void unshare(io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getUnshareMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * ListShares lists the active terminal shares
+     * </pre>
+     */
+    public void listShares(io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getListSharesMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * ListenShared listens read-only to a shared terminal
+     * </pre>
+     */
+    public void listenShared(io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.TerminalOuterClass.ListenTerminalResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getListenSharedMethod(), responseObserver);
+    }
+
     @java.lang.Override public final io.grpc.ServerServiceDefinition bindService() {
       return io.grpc.ServerServiceDefinition.builder(getServiceDescriptor())
           .addMethod(
@@ -502,6 +666,34 @@ public final class TerminalServiceGrpc {
                 io.gitpod.supervisor.api.TerminalOuterClass.UpdateTerminalAnnotationsRequest,
                 io.gitpod.supervisor.api.TerminalOuterClass.UpdateTerminalAnnotationsResponse>(
                   this, METHODID_UPDATE_ANNOTATIONS)))
+          .addMethod(
+            getShareMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest,
+                io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse>(
+                  this, METHODID_SHARE)))
+          .addMethod(
+            getUnshareMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest,
+                io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse>(
+                  this, METHODID_UNSHARE)))
+          .addMethod(
+            getListSharesMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest,
+                io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse>(
+                  this, METHODID_LIST_SHARES)))
+          .addMethod(
+            getListenSharedMethod(),
+            io.grpc.stub.ServerCalls.asyncServerStreamingCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest,
+                io.gitpod.supervisor.api.TerminalOuterClass.ListenTerminalResponse>(
+                  this, METHODID_LISTEN_SHARED)))
           .build();
     }
   }
@@ -619,6 +811,50 @@ public final class TerminalServiceGrpc {
       io.grpc.stub.ClientCalls.asyncUnaryCall(
           getChannel().newCall(getUpdateAnnotationsMethod(), getCallOptions()), request, responseObserver);
     }
+
+    /**
+     * <pre>
+     * Share creates a short-lived, read-only share of a terminal
+     * </pre>
+     */
+    public void share(io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getShareMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * Unshare revokes a terminal share and disconnects all of its viewers
+     * </pre>
+     */
+    public void unshare(io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getUnshareMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * ListShares lists the active terminal shares
+     * </pre>
+     */
+    public void listShares(io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getListSharesMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * ListenShared listens read-only to a shared terminal
+     * </pre>
+     */
+    public void listenShared(io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.TerminalOuterClass.ListenTerminalResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncServerStreamingCall(
+          getChannel().newCall(getListenSharedMethod(), getCallOptions()), request, responseObserver);
+    }
   }
 
   /**
@@ -726,6 +962,47 @@ public final class TerminalServiceGrpc {
       return io.grpc.stub.ClientCalls.blockingUnaryCall(
           getChannel(), getUpdateAnnotationsMethod(), getCallOptions(), request);
     }
+
+    /**
+     * <pre>
+     * Share creates a short-lived, read-only share of a terminal
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse share(io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getShareMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * Unshare revokes a terminal share and disconnects all of its viewers
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse unshare(io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getUnshareMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * ListShares lists the active terminal shares
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse listShares(io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getListSharesMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * ListenShared listens read-only to a shared terminal
+     * </pre>
+     */
+    public java.util.Iterator<io.gitpod.supervisor.api.TerminalOuterClass.ListenTerminalResponse> listenShared(
+        io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest request) {
+      return io.grpc.stub.ClientCalls.blockingServerStreamingCall(
+          getChannel(), getListenSharedMethod(), getCallOptions(), request);
+    }
   }
 
   /**
@@ -830,6 +1107,39 @@ public final class TerminalServiceGrpc {
       return io.grpc.stub.ClientCalls.futureUnaryCall(
           getChannel().newCall(getUpdateAnnotationsMethod(), getCallOptions()), request);
     }
+
+    /**
+     * <pre>
+     * Share creates a short-lived, read-only share of a terminal
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse> share(
+        io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getShareMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * Unshare revokes a terminal share and disconnects all of its viewers
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse> unshare(
+        io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getUnshareMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * ListShares lists the active terminal shares
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse> listShares(
+        io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getListSharesMethod(), getCallOptions()), request);
+    }
   }
 
   private static final int METHODID_OPEN = 0;
@@ -841,6 +1151,10 @@ public final class TerminalServiceGrpc {
   private static final int METHODID_SET_SIZE = 6;
   private static final int METHODID_SET_TITLE = 7;
   private static final int METHODID_UPDATE_ANNOTATIONS = 8;
+  private static final int METHODID_SHARE = 9;
+  private static final int METHODID_UNSHARE = 10;
+  private static final int METHODID_LIST_SHARES = 11;
+  private static final int METHODID_LISTEN_SHARED = 12;
 
   private static final class MethodHandlers<Req, Resp> implements
       io.grpc.stub.ServerCalls.UnaryMethod<Req, Resp>,
@@ -895,6 +1209,22 @@ public final class TerminalServiceGrpc {
           serviceImpl.updateAnnotations((io.gitpod.supervisor.api.TerminalOuterClass.UpdateTerminalAnnotationsRequest) request,
               (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.TerminalOuterClass.UpdateTerminalAnnotationsResponse>) responseObserver);
           break;
+        case METHODID_SHARE:
+          serviceImpl.share((io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.TerminalOuterClass.ShareTerminalResponse>) responseObserver);
+          break;
+        case METHODID_UNSHARE:
+          serviceImpl.unshare((io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.TerminalOuterClass.UnshareTerminalResponse>) responseObserver);
+          break;
+        case METHODID_LIST_SHARES:
+          serviceImpl.listShares((io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.TerminalOuterClass.ListTerminalSharesResponse>) responseObserver);
+          break;
+        case METHODID_LISTEN_SHARED:
+          serviceImpl.listenShared((io.gitpod.supervisor.api.TerminalOuterClass.ListenSharedTerminalRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.TerminalOuterClass.ListenTerminalResponse>) responseObserver);
+          break;
         default:
           throw new AssertionError();
       }
@@ -965,6 +1295,10 @@ public final class TerminalServiceGrpc {
               .addMethod(getSetSizeMethod())
               .addMethod(getSetTitleMethod())
               .addMethod(getUpdateAnnotationsMethod())
+              .addMethod(getShareMethod())
+              .addMethod(getUnshareMethod())
+              .addMethod(getListSharesMethod())
+              .addMethod(getListenSharedMethod())
               .build();
         }
       }
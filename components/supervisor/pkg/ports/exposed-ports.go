@@ -13,13 +13,14 @@ import (
 	backoff "github.com/cenkalti/backoff/v4"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	gitpod "github.com/gitpod-io/gitpod/gitpod-protocol"
+	"github.com/gitpod-io/gitpod/supervisor/api"
 )
 
 // ExposedPort represents an exposed pprt
 type ExposedPort struct {
-	LocalPort uint32
-	URL       string
-	Public    bool
+	LocalPort  uint32
+	URL        string
+	Visibility api.PortVisibility
 }
 
 // ExposedPortsInterface provides access to port exposure
@@ -34,8 +35,8 @@ type ExposedPortsInterface interface {
 	// Run starts listening to expose port requests.
 	Run(ctx context.Context)
 
-	// Expose exposes a port to the internet. Upon successful execution any Observer will be updated.
-	Expose(ctx context.Context, port uint32, public bool) <-chan error
+	// Expose exposes a port with the given visibility. Upon successful execution any Observer will be updated.
+	Expose(ctx context.Context, port uint32, visibility api.PortVisibility) <-chan error
 }
 
 // NoopExposedPorts implements ExposedPortsInterface but does nothing
@@ -49,8 +50,8 @@ func (*NoopExposedPorts) Observe(ctx context.Context) (<-chan []ExposedPort, <-c
 // Run starts listening to expose port requests.
 func (*NoopExposedPorts) Run(ctx context.Context) {}
 
-// Expose exposes a port to the internet. Upon successful execution any Observer will be updated.
-func (*NoopExposedPorts) Expose(ctx context.Context, local uint32, public bool) <-chan error {
+// Expose exposes a port with the given visibility. Upon successful execution any Observer will be updated.
+func (*NoopExposedPorts) Expose(ctx context.Context, local uint32, visibility api.PortVisibility) <-chan error {
 	done := make(chan error)
 	close(done)
 	return done
@@ -129,17 +130,17 @@ func (g *GitpodExposedPorts) Observe(ctx context.Context) (<-chan []ExposedPort,
 			res := make(map[uint32]ExposedPort)
 			for _, port := range g.localExposedPort {
 				res[port] = ExposedPort{
-					LocalPort: port,
-					Public:    false,
-					URL:       g.getPortUrl(port),
+					LocalPort:  port,
+					Visibility: api.PortVisibility_private,
+					URL:        g.getPortUrl(port),
 				}
 			}
 
 			for _, p := range serverExposePort {
 				res[uint32(p.Port)] = ExposedPort{
-					LocalPort: uint32(p.Port),
-					Public:    p.Visibility == "public",
-					URL:       p.URL,
+					LocalPort:  uint32(p.Port),
+					Visibility: toVisibility(p.Visibility, api.PortVisibility_private),
+					URL:        p.URL,
 				}
 			}
 			exposedPort := make([]ExposedPort, 0, len(res))
@@ -224,9 +225,10 @@ func (g *GitpodExposedPorts) doExpose(req *exposePortRequest) {
 	}
 }
 
-// Expose exposes a port to the internet. Upon successful execution any Observer will be updated.
-func (g *GitpodExposedPorts) Expose(ctx context.Context, local uint32, public bool) <-chan error {
-	if !public {
+// Expose exposes a port with the given visibility. Upon successful execution any Observer will be updated.
+// Private ports are only exposed locally, all other visibilities require the server to open the port.
+func (g *GitpodExposedPorts) Expose(ctx context.Context, local uint32, visibility api.PortVisibility) <-chan error {
+	if visibility == api.PortVisibility_private {
 		if !g.existInLocalExposed(local) {
 			g.localExposedPort = append(g.localExposedPort, local)
 			g.localExposedNotice <- struct{}{}
@@ -238,7 +240,7 @@ func (g *GitpodExposedPorts) Expose(ctx context.Context, local uint32, public bo
 	req := &exposePortRequest{
 		port: &gitpod.WorkspaceInstancePort{
 			Port:       float64(local),
-			Visibility: "public",
+			Visibility: visibility.String(),
 		},
 		ctx:  ctx,
 		done: make(chan error),
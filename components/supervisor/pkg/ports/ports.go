@@ -68,9 +68,9 @@ type localhostProxy struct {
 }
 
 type autoExposure struct {
-	state  api.PortAutoExposure
-	ctx    context.Context
-	public bool
+	state      api.PortAutoExposure
+	ctx        context.Context
+	visibility api.PortVisibility
 }
 
 // Manager brings together served and exposed ports. It keeps track of which port is exposed, which one is served,
@@ -113,6 +113,7 @@ type managedPort struct {
 	OnExposed    api.OnPortExposedAction // deprecated
 	OnOpen       api.PortsStatus_OnOpenAction
 	AutoExposure api.PortAutoExposure
+	Protocol     api.PortsStatus_Protocol
 
 	LocalhostPort uint32
 
@@ -257,7 +258,7 @@ func (pm *Manager) updateState(ctx context.Context, exposed []ExposedPort, serve
 			}
 
 			current, exists := servedMap[port.Port]
-			if !exists || (!port.BoundToLocalhost && current.BoundToLocalhost) {
+			if !exists || preferServedPort(port, current) {
 				servedMap[port.Port] = port
 			}
 		}
@@ -334,13 +335,9 @@ func (pm *Manager) nextState(ctx context.Context) map[uint32]*managedPort {
 		if pm.boundInternally(port) {
 			continue
 		}
-		Visibility := api.PortVisibility_private
-		if exposed.Public {
-			Visibility = api.PortVisibility_public
-		}
 		mp := genManagedPort(port)
 		mp.Exposed = true
-		mp.Visibility = Visibility
+		mp.Visibility = exposed.Visibility
 		mp.URL = exposed.URL
 	}
 
@@ -371,12 +368,8 @@ func (pm *Manager) nextState(ctx context.Context) map[uint32]*managedPort {
 				return
 			}
 
-			mp.Visibility = api.PortVisibility_private
-			if config.Visibility == "public" {
-				mp.Visibility = api.PortVisibility_public
-			}
-			public := mp.Visibility == api.PortVisibility_public
-			mp.AutoExposure = pm.autoExpose(ctx, mp.LocalhostPort, public).state
+			mp.Visibility = toVisibility(config.Visibility, api.PortVisibility_private)
+			mp.AutoExposure = pm.autoExpose(ctx, mp.LocalhostPort, mp.Visibility).state
 		})
 	}
 
@@ -390,28 +383,33 @@ func (pm *Manager) nextState(ctx context.Context) map[uint32]*managedPort {
 		}
 		mp := genManagedPort(port)
 		mp.Served = true
+		mp.Protocol = served.Protocol
 
 		autoExposure, autoExposed := pm.autoExposed[port]
 		if autoExposed {
 			mp.AutoExposure = autoExposure.state
 			continue
 		}
+		if served.Protocol != api.PortsStatus_tcp {
+			// only TCP ports can be exposed
+			continue
+		}
 
-		var public bool
+		visibility := api.PortVisibility_private
 		config, kind, exists := pm.configs.Get(mp.LocalhostPort)
 
 		configured := exists && kind == PortConfigKind
 		if mp.Exposed || configured {
-			public = mp.Visibility == api.PortVisibility_public
-		} else {
-			public = exists && config.Visibility == "public"
+			visibility = mp.Visibility
+		} else if exists {
+			visibility = toVisibility(config.Visibility, api.PortVisibility_private)
 		}
 
-		if mp.Exposed && ((mp.Visibility == api.PortVisibility_public && public) || (mp.Visibility == api.PortVisibility_private && !public)) {
+		if mp.Exposed && mp.Visibility == visibility {
 			continue
 		}
 
-		mp.AutoExposure = pm.autoExpose(ctx, mp.LocalhostPort, public).state
+		mp.AutoExposure = pm.autoExpose(ctx, mp.LocalhostPort, visibility).state
 	}
 
 	var ports []uint32
@@ -431,13 +429,22 @@ func (pm *Manager) nextState(ctx context.Context) map[uint32]*managedPort {
 	return newState
 }
 
+// toVisibility maps the visibility of a port config or of a port exposed by the server to the API representation.
+// Unknown or empty visibilities map to def.
+func toVisibility(visibility string, def api.PortVisibility) api.PortVisibility {
+	if v, ok := api.PortVisibility_value[visibility]; ok {
+		return api.PortVisibility(v)
+	}
+	return def
+}
+
 // clients should guard a call with check whether such port is already exposed or auto exposed
-func (pm *Manager) autoExpose(ctx context.Context, localPort uint32, public bool) *autoExposure {
-	exposing := pm.E.Expose(ctx, localPort, public)
+func (pm *Manager) autoExpose(ctx context.Context, localPort uint32, visibility api.PortVisibility) *autoExposure {
+	exposing := pm.E.Expose(ctx, localPort, visibility)
 	autoExpose := &autoExposure{
-		state:  api.PortAutoExposure_trying,
-		ctx:    ctx,
-		public: public,
+		state:      api.PortAutoExposure_trying,
+		ctx:        ctx,
+		visibility: visibility,
 	}
 	go func() {
 		err := <-exposing
@@ -464,7 +471,7 @@ func (pm *Manager) RetryAutoExpose(ctx context.Context, localPort uint32) {
 	if !autoExposed || autoExpose.state != api.PortAutoExposure_failed || autoExpose.ctx.Err() != nil {
 		return
 	}
-	pm.autoExpose(autoExpose.ctx, localPort, autoExpose.public)
+	pm.autoExpose(autoExpose.ctx, localPort, autoExpose.visibility)
 	pm.forceUpdate()
 }
 
@@ -490,7 +497,7 @@ func (pm *Manager) autoTunnel(ctx context.Context) {
 	}
 	var descs []*PortTunnelDescription
 	for _, served := range pm.served {
-		if pm.boundInternally(served.Port) {
+		if pm.boundInternally(served.Port) || served.Protocol != api.PortsStatus_tcp {
 			continue
 		}
 
@@ -517,6 +524,9 @@ func (pm *Manager) autoTunnel(ctx context.Context) {
 func (pm *Manager) updateProxies() {
 	servedPortMap := map[uint32]bool{}
 	for _, s := range pm.served {
+		if s.Protocol != api.PortsStatus_tcp {
+			continue
+		}
 		servedPortMap[s.Port] = s.BoundToLocalhost
 	}
 
@@ -535,7 +545,7 @@ func (pm *Manager) updateProxies() {
 	for _, served := range pm.served {
 		localPort := served.Port
 		_, exists := pm.proxies[localPort]
-		if exists || !served.BoundToLocalhost {
+		if exists || !served.BoundToLocalhost || served.Protocol != api.PortsStatus_tcp {
 			continue
 		}
 
@@ -553,6 +563,15 @@ func (pm *Manager) updateProxies() {
 	}
 }
 
+// preferServedPort returns true if port should be managed instead of current if both are served on the same port number.
+// TCP ports are preferred over UDP ports, and ports bound to all interfaces over ports bound to localhost.
+func preferServedPort(port, current ServedPort) bool {
+	if port.Protocol != current.Protocol {
+		return port.Protocol == api.PortsStatus_tcp
+	}
+	return !port.BoundToLocalhost && current.BoundToLocalhost
+}
+
 // deprecated
 func getOnExposedAction(config *gitpod.PortConfig, port uint32) api.OnPortExposedAction {
 	if config == nil {
@@ -634,8 +653,12 @@ func (pm *Manager) Expose(ctx context.Context, port uint32) error {
 	pm.mu.RUnlock()
 	unlock = false
 
-	public := exists && config.Visibility != "private"
-	err := <-pm.E.Expose(ctx, port, public)
+	visibility := api.PortVisibility_private
+	if exists {
+		// ports which are configured without a visibility are exposed publicly
+		visibility = toVisibility(config.Visibility, api.PortVisibility_public)
+	}
+	err := <-pm.E.Expose(ctx, port, visibility)
 	if err != nil && err != context.Canceled {
 		log.WithError(err).WithField("port", port).Error("cannot expose port")
 	}
@@ -774,6 +797,7 @@ func (pm *Manager) getPortStatus(port uint32) *api.PortsStatus {
 		Description: mp.Description,
 		Name:        mp.Name,
 		OnOpen:      mp.OnOpen,
+		Protocol:    mp.Protocol,
 	}
 	if mp.Exposed && mp.URL != "" {
 		ps.Exposed = &api.ExposedPortInfo{
@@ -48,10 +48,10 @@ func TestPortsUpdateState(t *testing.T) {
 		{
 			Desc: "basic locally served",
 			Changes: []Change{
-				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true}}},
+				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true, api.PortsStatus_tcp}}},
 				{Exposed: []ExposedPort{{LocalPort: 8080, URL: "foobar"}}},
-				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true}, {net.IPv4zero, 60000, false}}},
-				{Served: []ServedPort{{net.IPv4zero, 60000, false}}},
+				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true, api.PortsStatus_tcp}, {net.IPv4zero, 60000, false, api.PortsStatus_tcp}}},
+				{Served: []ServedPort{{net.IPv4zero, 60000, false, api.PortsStatus_tcp}}},
 				{Served: []ServedPort{}},
 			},
 			ExpectedExposure: []ExposedPort{
@@ -70,7 +70,7 @@ func TestPortsUpdateState(t *testing.T) {
 		{
 			Desc: "basic globally served",
 			Changes: []Change{
-				{Served: []ServedPort{{net.IPv4zero, 8080, false}}},
+				{Served: []ServedPort{{net.IPv4zero, 8080, false, api.PortsStatus_tcp}}},
 				{Served: []ServedPort{}},
 			},
 			ExpectedExposure: []ExposedPort{
@@ -86,8 +86,8 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "basic port publically exposed",
 			Changes: []Change{
 				{Served: []ServedPort{{Port: 8080}}},
-				{Exposed: []ExposedPort{{LocalPort: 8080, Public: true, URL: "foobar"}}},
-				{Exposed: []ExposedPort{{LocalPort: 8080, Public: false, URL: "foobar"}}},
+				{Exposed: []ExposedPort{{LocalPort: 8080, Visibility: api.PortVisibility_public, URL: "foobar"}}},
+				{Exposed: []ExposedPort{{LocalPort: 8080, Visibility: api.PortVisibility_private, URL: "foobar"}}},
 			},
 			ExpectedExposure: ExposureExpectation{
 				{LocalPort: 8080},
@@ -99,12 +99,29 @@ func TestPortsUpdateState(t *testing.T) {
 				[]*api.PortsStatus{{LocalPort: 8080, Served: true, OnOpen: api.PortsStatus_notify_private, Exposed: &api.ExposedPortInfo{Visibility: api.PortVisibility_private, Url: "foobar", OnExposed: api.OnPortExposedAction_notify_private}}},
 			},
 		},
+		{
+			Desc: "udp served",
+			Changes: []Change{
+				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5353, true, api.PortsStatus_udp}}},
+				{Served: []ServedPort{{net.IPv4zero, 5353, false, api.PortsStatus_udp}, {net.IPv4zero, 5353, false, api.PortsStatus_tcp}}},
+				{Served: []ServedPort{}},
+			},
+			ExpectedExposure: []ExposedPort{
+				{LocalPort: 5353},
+			},
+			ExpectedUpdates: UpdateExpectation{
+				{},
+				[]*api.PortsStatus{{LocalPort: 5353, Served: true, OnOpen: api.PortsStatus_notify_private, Protocol: api.PortsStatus_udp}},
+				[]*api.PortsStatus{{LocalPort: 5353, Served: true, OnOpen: api.PortsStatus_notify_private}},
+				{},
+			},
+		},
 		{
 			Desc:          "internal ports served",
 			InternalPorts: []uint32{8080},
 			Changes: []Change{
 				{Served: []ServedPort{}},
-				{Served: []ServedPort{{net.IPv4zero, 8080, false}}},
+				{Served: []ServedPort{{net.IPv4zero, 8080, false, api.PortsStatus_tcp}}},
 			},
 			ExpectedExposure: ExposureExpectation(nil),
 			ExpectedUpdates:  UpdateExpectation{{}},
@@ -120,14 +137,14 @@ func TestPortsUpdateState(t *testing.T) {
 				}},
 				{
 					Exposed: []ExposedPort{
-						{LocalPort: 8080, Public: true, URL: "8080-foobar"},
-						{LocalPort: 9229, Public: false, URL: "9229-foobar"},
+						{LocalPort: 8080, Visibility: api.PortVisibility_public, URL: "8080-foobar"},
+						{LocalPort: 9229, Visibility: api.PortVisibility_private, URL: "9229-foobar"},
 					},
 				},
 				{
 					Served: []ServedPort{
-						{net.IPv4zero, 8080, false},
-						{net.IPv4(127, 0, 0, 1), 9229, true},
+						{net.IPv4zero, 8080, false, api.PortsStatus_tcp},
+						{net.IPv4(127, 0, 0, 1), 9229, true, api.PortsStatus_tcp},
 					},
 				},
 			},
@@ -150,6 +167,38 @@ func TestPortsUpdateState(t *testing.T) {
 				},
 			},
 		},
+		{
+			Desc: "auto expose configured team and token ports",
+			Changes: []Change{
+				{Config: &ConfigChange{
+					workspace: []*gitpod.PortConfig{
+						{Port: 3000, OnOpen: "ignore", Visibility: "team"},
+						{Port: 4000, OnOpen: "ignore", Visibility: "token"},
+					},
+				}},
+				{
+					Exposed: []ExposedPort{
+						{LocalPort: 3000, Visibility: api.PortVisibility_team, URL: "3000-foobar"},
+						{LocalPort: 4000, Visibility: api.PortVisibility_token, URL: "4000-foobar"},
+					},
+				},
+			},
+			ExpectedExposure: []ExposedPort{
+				{LocalPort: 3000, Visibility: api.PortVisibility_team},
+				{LocalPort: 4000, Visibility: api.PortVisibility_token},
+			},
+			ExpectedUpdates: UpdateExpectation{
+				{},
+				[]*api.PortsStatus{
+					{LocalPort: 3000, OnOpen: api.PortsStatus_ignore},
+					{LocalPort: 4000, OnOpen: api.PortsStatus_ignore},
+				},
+				[]*api.PortsStatus{
+					{LocalPort: 3000, OnOpen: api.PortsStatus_ignore, Exposed: &api.ExposedPortInfo{Visibility: api.PortVisibility_team, Url: "3000-foobar", OnExposed: api.OnPortExposedAction_ignore}},
+					{LocalPort: 4000, OnOpen: api.PortsStatus_ignore, Exposed: &api.ExposedPortInfo{Visibility: api.PortVisibility_token, Url: "4000-foobar", OnExposed: api.OnPortExposedAction_ignore}},
+				},
+			},
+		},
 		{
 			Desc: "serving port from the configured port range",
 			Changes: []Change{
@@ -159,9 +208,9 @@ func TestPortsUpdateState(t *testing.T) {
 						Port:   "4000-5000",
 					}},
 				}},
-				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 4040, true}}},
-				{Exposed: []ExposedPort{{LocalPort: 4040, Public: true, URL: "4040-foobar"}}},
-				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 4040, true}, {net.IPv4zero, 60000, false}}},
+				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 4040, true, api.PortsStatus_tcp}}},
+				{Exposed: []ExposedPort{{LocalPort: 4040, Visibility: api.PortVisibility_public, URL: "4040-foobar"}}},
+				{Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 4040, true, api.PortsStatus_tcp}, {net.IPv4zero, 60000, false, api.PortsStatus_tcp}}},
 			},
 			ExpectedExposure: []ExposedPort{
 				{LocalPort: 4040},
@@ -186,29 +235,29 @@ func TestPortsUpdateState(t *testing.T) {
 					}},
 				},
 				{
-					Exposed: []ExposedPort{{LocalPort: 8080, Public: false, URL: "foobar"}},
+					Exposed: []ExposedPort{{LocalPort: 8080, Visibility: api.PortVisibility_private, URL: "foobar"}},
 				},
 				{
-					Exposed: []ExposedPort{{LocalPort: 8080, Public: true, URL: "foobar"}},
+					Exposed: []ExposedPort{{LocalPort: 8080, Visibility: api.PortVisibility_public, URL: "foobar"}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true, api.PortsStatus_tcp}},
 				},
 				{
-					Exposed: []ExposedPort{{LocalPort: 8080, Public: true, URL: "foobar"}},
+					Exposed: []ExposedPort{{LocalPort: 8080, Visibility: api.PortVisibility_public, URL: "foobar"}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true, api.PortsStatus_tcp}},
 				},
 				{
 					Served: []ServedPort{},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, false}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, false, api.PortsStatus_tcp}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
-				{LocalPort: 8080, Public: false},
+				{LocalPort: 8080, Visibility: api.PortVisibility_private},
 			},
 			ExpectedUpdates: UpdateExpectation{
 				{},
@@ -224,7 +273,7 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "starting multiple proxies for the same served event",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true}, {net.IPv4zero, 3000, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 8080, true, api.PortsStatus_tcp}, {net.IPv4zero, 3000, true, api.PortsStatus_tcp}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
@@ -248,10 +297,10 @@ func TestPortsUpdateState(t *testing.T) {
 					}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 8080, false}},
+					Served: []ServedPort{{net.IPv4zero, 8080, false, api.PortsStatus_tcp}},
 				},
 				{
-					Exposed: []ExposedPort{{LocalPort: 8080, Public: false, URL: "foobar"}},
+					Exposed: []ExposedPort{{LocalPort: 8080, Visibility: api.PortVisibility_private, URL: "foobar"}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
@@ -268,13 +317,13 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served locally and then globally too, prefer globally (exposed in between)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, api.PortsStatus_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}, {net.IPv4zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, api.PortsStatus_tcp}, {net.IPv4zero, 5900, false, api.PortsStatus_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
@@ -293,10 +342,10 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served locally and then globally too, prefer globally (exposed after)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, api.PortsStatus_tcp}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}, {net.IPv4zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, api.PortsStatus_tcp}, {net.IPv4zero, 5900, false, api.PortsStatus_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
@@ -318,13 +367,13 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served globally and then locally too, prefer globally (exposed in between)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, api.PortsStatus_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}, {net.IPv4(127, 0, 0, 1), 5900, true}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, api.PortsStatus_tcp}, {net.IPv4(127, 0, 0, 1), 5900, true, api.PortsStatus_tcp}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
@@ -340,10 +389,10 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served globally and then locally too, prefer globally (exposed after)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, api.PortsStatus_tcp}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}, {net.IPv4(127, 0, 0, 1), 5900, true}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, api.PortsStatus_tcp}, {net.IPv4(127, 0, 0, 1), 5900, true, api.PortsStatus_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
@@ -362,13 +411,13 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served locally on ip4 and then locally on ip6 too, prefer first (exposed in between)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, api.PortsStatus_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}, {net.IPv6zero, 5900, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, api.PortsStatus_tcp}, {net.IPv6zero, 5900, true, api.PortsStatus_tcp}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
@@ -384,10 +433,10 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served locally on ip4 and then locally on ip6 too, prefer first (exposed after)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, api.PortsStatus_tcp}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true}, {net.IPv6zero, 5900, true}},
+					Served: []ServedPort{{net.IPv4(127, 0, 0, 1), 5900, true, api.PortsStatus_tcp}, {net.IPv6zero, 5900, true, api.PortsStatus_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
@@ -406,13 +455,13 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served locally on ip4 and then globally on ip6 too, prefer first (exposed in between)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, api.PortsStatus_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}, {net.IPv6zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, api.PortsStatus_tcp}, {net.IPv6zero, 5900, false, api.PortsStatus_tcp}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
@@ -428,10 +477,10 @@ func TestPortsUpdateState(t *testing.T) {
 			Desc: "the same port served locally on ip4 and then globally on ip6 too, prefer first (exposed after)",
 			Changes: []Change{
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, api.PortsStatus_tcp}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 5900, false}, {net.IPv6zero, 5900, false}},
+					Served: []ServedPort{{net.IPv4zero, 5900, false, api.PortsStatus_tcp}, {net.IPv6zero, 5900, false, api.PortsStatus_tcp}},
 				},
 				{
 					Exposed: []ExposedPort{{LocalPort: 5900, URL: "foobar"}},
@@ -455,10 +504,10 @@ func TestPortsUpdateState(t *testing.T) {
 					}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 8080, false}},
+					Served: []ServedPort{{net.IPv4zero, 8080, false, api.PortsStatus_tcp}},
 				},
 				{
-					Exposed: []ExposedPort{{LocalPort: 8080, Public: false, URL: "foobar"}},
+					Exposed: []ExposedPort{{LocalPort: 8080, Visibility: api.PortVisibility_private, URL: "foobar"}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
@@ -480,10 +529,10 @@ func TestPortsUpdateState(t *testing.T) {
 					}},
 				},
 				{
-					Served: []ServedPort{{net.IPv4zero, 3000, false}},
+					Served: []ServedPort{{net.IPv4zero, 3000, false, api.PortsStatus_tcp}},
 				},
 				{
-					Exposed: []ExposedPort{{LocalPort: 3000, Public: false, URL: "foobar"}},
+					Exposed: []ExposedPort{{LocalPort: 3000, Visibility: api.PortVisibility_private, URL: "foobar"}},
 				},
 			},
 			ExpectedExposure: []ExposedPort{
@@ -645,13 +694,13 @@ func (tep *testExposedPorts) Observe(ctx context.Context) (<-chan []ExposedPort,
 func (tep *testExposedPorts) Run(ctx context.Context) {
 }
 
-func (tep *testExposedPorts) Expose(ctx context.Context, local uint32, public bool) <-chan error {
+func (tep *testExposedPorts) Expose(ctx context.Context, local uint32, visibility api.PortVisibility) <-chan error {
 	tep.mu.Lock()
 	defer tep.mu.Unlock()
 
 	tep.Exposures = append(tep.Exposures, ExposedPort{
-		LocalPort: local,
-		Public:    public,
+		LocalPort:  local,
+		Visibility: visibility,
 	})
 	return nil
 }
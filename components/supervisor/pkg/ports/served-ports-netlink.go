@@ -0,0 +1,471 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package ports
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"net"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	api "github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+const (
+	// see linux/sock_diag.h and linux/inet_diag.h
+	sockDiagByFamily = 20
+	inetDiagProtocol = 10
+
+	sknlgrpInetTCPDestroy  = 1
+	sknlgrpInetUDPDestroy  = 2
+	sknlgrpInet6TCPDestroy = 3
+	sknlgrpInet6UDPDestroy = 4
+
+	// see include/net/tcp_states.h
+	tcpEstablished = 1
+	tcpClose       = 7
+	tcpListen      = 10
+
+	sizeofInetDiagReqV2 = 56
+	sizeofInetDiagMsg   = 72
+
+	sockDiagBufferSize = 64 * 1024
+
+	ephemeralPortRangeLocation = "/proc/sys/net/ipv4/ip_local_port_range"
+)
+
+// defaultEphemeralPorts is the kernel's default ip_local_port_range
+var defaultEphemeralPorts = portRange{First: 32768, Last: 60999}
+
+// portRange is an inclusive range of ports
+type portRange struct {
+	First, Last uint16
+}
+
+func (r portRange) Contains(port uint16) bool {
+	return r.First <= port && port <= r.Last
+}
+
+// readEphemeralPorts reads the range the kernel picks ephemeral ports from, falling back to its default
+func readEphemeralPorts() portRange {
+	fc, err := os.ReadFile(ephemeralPortRangeLocation)
+	if err != nil {
+		return defaultEphemeralPorts
+	}
+	segs := strings.Fields(string(fc))
+	if len(segs) != 2 {
+		return defaultEphemeralPorts
+	}
+	first, err := strconv.ParseUint(segs[0], 10, 16)
+	if err != nil {
+		return defaultEphemeralPorts
+	}
+	last, err := strconv.ParseUint(segs[1], 10, 16)
+	if err != nil {
+		return defaultEphemeralPorts
+	}
+	return portRange{First: uint16(first), Last: uint16(last)}
+}
+
+// NetlinkServedPortsObserver polls the listening TCP and UDP sockets of the network namespace
+// it runs in using a netlink sock_diag dump every RefreshInterval. In contrast to reading
+// "/proc/net/tcp*" the kernel filters the sockets by state, which keeps the dumps cheap even
+// with thousands of open connections.
+//
+// The kernel does not notify about new listeners, hence polling is the only way to discover them.
+// Closed listeners are reported without waiting for the next dump by subscribing to the sock_diag
+// destroy notifications. Updates are only sent if the served ports change.
+//
+// UDP has no notion of listening. Client sockets which send without connecting are bound to an
+// ephemeral port by the kernel and are indistinguishable from a server otherwise, hence only UDP
+// sockets bound to a port outside of the ephemeral port range count as served.
+type NetlinkServedPortsObserver struct {
+	RefreshInterval time.Duration
+
+	// Fallback is used to observe the served ports if netlink sock_diag is not available
+	Fallback ServedPortsObserver
+}
+
+// Observe starts observing the served ports until the context is canceled.
+func (p *NetlinkServedPortsObserver) Observe(ctx context.Context) (<-chan []ServedPort, <-chan error) {
+	ephemeral := readEphemeralPorts()
+	diag, err := openSockDiag(ephemeral)
+	if err == nil {
+		// make sure we can actually dump sockets, e.g. seccomp or the kernel might not support all families
+		_, err = diag.ListServedPorts()
+		if err != nil {
+			diag.Close()
+		}
+	}
+	if err != nil {
+		log.WithError(err).Warn("netlink sock_diag is not available, falling back to the served ports fallback observer")
+		return p.Fallback.Observe(ctx)
+	}
+
+	destroyed, err := subscribeListenerDestroy(ctx, ephemeral)
+	if err != nil {
+		// we will still observe closed listeners with the next dump
+		log.WithError(err).Warn("cannot subscribe to sock_diag destroy notifications")
+	}
+
+	var (
+		errchan = make(chan error, 1)
+		reschan = make(chan []ServedPort)
+	)
+	go func() {
+		defer diag.Close()
+		observeServedPorts(ctx, p.RefreshInterval, diag.ListServedPorts, destroyed, reschan, errchan)
+	}()
+	return reschan, errchan
+}
+
+// observeServedPorts lists the served ports on every tick and whenever a listener was destroyed
+// and sends them to reschan if they changed. It closes both channels when the context is canceled.
+func observeServedPorts(ctx context.Context, interval time.Duration, list func() ([]ServedPort, error), destroyed <-chan struct{}, reschan chan<- []ServedPort, errchan chan<- error) {
+	defer close(errchan)
+	defer close(reschan)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last []ServedPort
+	for initial := true; ; initial = false {
+		if !initial {
+			select {
+			case <-ctx.Done():
+				log.Info("Port observer stopped")
+				return
+			case <-ticker.C:
+			case <-destroyed:
+			}
+		}
+
+		ports, err := list()
+		if err != nil {
+			select {
+			case errchan <- err:
+			default:
+			}
+			continue
+		}
+		if !initial && reflect.DeepEqual(last, ports) {
+			continue
+		}
+		last = ports
+
+		select {
+		case reschan <- ports:
+		case <-ctx.Done():
+			log.Info("Port observer stopped")
+			return
+		}
+	}
+}
+
+type sockDiag struct {
+	fd        int
+	seq       uint32
+	ephemeral portRange
+}
+
+func openSockDiag(ephemeral portRange) (*sockDiag, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_SOCK_DIAG)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot open netlink socket: %w", err)
+	}
+	err = unix.Bind(fd, &unix.SockaddrNetlink{Family: unix.AF_NETLINK})
+	if err != nil {
+		unix.Close(fd)
+		return nil, xerrors.Errorf("cannot bind netlink socket: %w", err)
+	}
+	return &sockDiag{fd: fd, ephemeral: ephemeral}, nil
+}
+
+// Close closes the netlink socket
+func (d *sockDiag) Close() error {
+	return unix.Close(d.fd)
+}
+
+// ListServedPorts lists all TCP listeners and unconnected UDP sockets bound to a port outside of the
+// ephemeral port range for IPv4 and IPv6.
+// The result is sorted by protocol, port and address and contains no duplicates.
+func (d *sockDiag) ListServedPorts() ([]ServedPort, error) {
+	var (
+		visited = make(map[string]struct{})
+		ports   []ServedPort
+	)
+	for _, family := range []uint8{unix.AF_INET, unix.AF_INET6} {
+		for _, protocol := range []uint8{unix.IPPROTO_TCP, unix.IPPROTO_UDP} {
+			ps, err := d.dump(family, protocol)
+			if err != nil {
+				return nil, err
+			}
+			for _, port := range ps {
+				key := servedPortKey(port)
+				if _, exists := visited[key]; exists {
+					continue
+				}
+				visited[key] = struct{}{}
+				ports = append(ports, port)
+			}
+		}
+	}
+	sortServedPorts(ports)
+	return ports, nil
+}
+
+func (d *sockDiag) dump(family, protocol uint8) ([]ServedPort, error) {
+	d.seq++
+	seq := d.seq
+
+	states := uint32(1 << tcpListen)
+	if protocol == unix.IPPROTO_UDP {
+		states = 1 << tcpClose
+	}
+	req := newInetDiagRequest(seq, family, protocol, states)
+	err := unix.Sendto(d.fd, req, 0, &unix.SockaddrNetlink{Family: unix.AF_NETLINK})
+	if err != nil {
+		return nil, xerrors.Errorf("cannot send sock_diag request: %w", err)
+	}
+
+	var (
+		ports []ServedPort
+		buf   = make([]byte, sockDiagBufferSize)
+	)
+	for {
+		n, _, err := unix.Recvfrom(d.fd, buf, 0)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot receive sock_diag response: %w", err)
+		}
+		msgs, err := syscall.ParseNetlinkMessage(buf[:n])
+		if err != nil {
+			return nil, xerrors.Errorf("cannot parse sock_diag response: %w", err)
+		}
+		for _, msg := range msgs {
+			if msg.Header.Seq != seq {
+				continue
+			}
+			switch msg.Header.Type {
+			case unix.NLMSG_DONE:
+				return ports, nil
+			case unix.NLMSG_ERROR:
+				return nil, xerrors.Errorf("sock_diag request failed: %w", netlinkError(msg.Data))
+			}
+			port, ok := parseInetDiagMsg(protocol, msg.Data, d.ephemeral)
+			if ok {
+				ports = append(ports, port)
+			}
+		}
+	}
+}
+
+// newInetDiagRequest produces a netlink message with an inet_diag_req_v2 which dumps all sockets
+// of the given family and protocol in one of the given states.
+func newInetDiagRequest(seq uint32, family, protocol uint8, states uint32) []byte {
+	buf := make([]byte, unix.SizeofNlMsghdr+sizeofInetDiagReqV2)
+	hdr := (*unix.NlMsghdr)(unsafe.Pointer(&buf[0]))
+	hdr.Len = uint32(len(buf))
+	hdr.Type = sockDiagByFamily
+	hdr.Flags = unix.NLM_F_REQUEST | unix.NLM_F_DUMP
+	hdr.Seq = seq
+
+	req := buf[unix.SizeofNlMsghdr:]
+	req[0] = family
+	req[1] = protocol
+	nativeEndian.PutUint32(req[4:8], states)
+	return buf
+}
+
+// parseInetDiagMsg parses an inet_diag_msg. It returns false if the socket does not serve a port,
+// i.e. for UDP client sockets or sockets which are not bound to a port.
+func parseInetDiagMsg(protocol uint8, data []byte, ephemeral portRange) (port ServedPort, ok bool) {
+	if len(data) < sizeofInetDiagMsg {
+		return ServedPort{}, false
+	}
+	var (
+		family = data[0]
+		state  = data[1]
+		sport  = binary.BigEndian.Uint16(data[4:6])
+		dport  = binary.BigEndian.Uint16(data[6:8])
+		src    = data[8:24]
+	)
+	if sport == 0 {
+		return ServedPort{}, false
+	}
+
+	var addr net.IP
+	switch family {
+	case unix.AF_INET:
+		addr = make(net.IP, net.IPv4len)
+		copy(addr, src[:net.IPv4len])
+	case unix.AF_INET6:
+		addr = make(net.IP, net.IPv6len)
+		copy(addr, src)
+	default:
+		return ServedPort{}, false
+	}
+
+	switch protocol {
+	case unix.IPPROTO_TCP:
+		if state != tcpListen {
+			return ServedPort{}, false
+		}
+		port.Protocol = api.PortsStatus_tcp
+	case unix.IPPROTO_UDP:
+		// a UDP socket with a peer or on an ephemeral port is a client
+		if state == tcpEstablished || dport != 0 || ephemeral.Contains(sport) {
+			return ServedPort{}, false
+		}
+		port.Protocol = api.PortsStatus_udp
+	default:
+		return ServedPort{}, false
+	}
+
+	port.Address = addr
+	port.Port = uint32(sport)
+	port.BoundToLocalhost = addr.IsLoopback()
+	return port, true
+}
+
+// subscribeListenerDestroy subscribes to the sock_diag destroy notifications and signals the returned
+// channel whenever a TCP listener or a UDP socket which serves a port was closed. Subscribing requires
+// CAP_NET_ADMIN in the network namespace.
+func subscribeListenerDestroy(ctx context.Context, ephemeral portRange) (<-chan struct{}, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC|unix.SOCK_NONBLOCK, unix.NETLINK_SOCK_DIAG)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot open netlink socket: %w", err)
+	}
+	var groups uint32
+	for _, grp := range []uint32{sknlgrpInetTCPDestroy, sknlgrpInetUDPDestroy, sknlgrpInet6TCPDestroy, sknlgrpInet6UDPDestroy} {
+		groups |= 1 << (grp - 1)
+	}
+	err = unix.Bind(fd, &unix.SockaddrNetlink{Family: unix.AF_NETLINK, Groups: groups})
+	if err != nil {
+		unix.Close(fd)
+		return nil, xerrors.Errorf("cannot subscribe to sock_diag destroy notifications: %w", err)
+	}
+
+	// the non-blocking socket is registered with the runtime poller, so that closing the file unblocks reads
+	f := os.NewFile(uintptr(fd), "sock_diag")
+	var closed int32
+	go func() {
+		<-ctx.Done()
+		atomic.StoreInt32(&closed, 1)
+		f.Close()
+	}()
+
+	destroyed := make(chan struct{}, 1)
+	go func() {
+		buf := make([]byte, sockDiagBufferSize)
+		for {
+			n, err := f.Read(buf)
+			if err != nil {
+				if atomic.LoadInt32(&closed) == 0 {
+					log.WithError(err).Warn("cannot receive sock_diag destroy notifications")
+				}
+				return
+			}
+			msgs, err := syscall.ParseNetlinkMessage(buf[:n])
+			if err != nil {
+				continue
+			}
+			var listenerDestroyed bool
+			for _, msg := range msgs {
+				if msg.Header.Type == sockDiagByFamily && isListenerDestroyed(msg.Data, ephemeral) {
+					listenerDestroyed = true
+					break
+				}
+			}
+			if !listenerDestroyed {
+				continue
+			}
+			select {
+			case destroyed <- struct{}{}:
+			default:
+			}
+		}
+	}()
+	return destroyed, nil
+}
+
+// isListenerDestroyed returns true if a destroy notification is about a socket which served a port.
+// In contrast to dumps, destroy notifications carry the protocol in an INET_DIAG_PROTOCOL attribute.
+func isListenerDestroyed(data []byte, ephemeral portRange) bool {
+	if len(data) < sizeofInetDiagMsg {
+		return false
+	}
+	attrs := data[sizeofInetDiagMsg:]
+	for len(attrs) >= unix.SizeofRtAttr {
+		l := int(nativeEndian.Uint16(attrs[0:2]))
+		if l < unix.SizeofRtAttr || l > len(attrs) {
+			return false
+		}
+		if nativeEndian.Uint16(attrs[2:4]) == inetDiagProtocol && l > unix.SizeofRtAttr {
+			protocol := attrs[unix.SizeofRtAttr]
+			if protocol != unix.IPPROTO_TCP && protocol != unix.IPPROTO_UDP {
+				return false
+			}
+			// destroyed sockets are always in the closed state, but only listeners have a port without a peer
+			sport := binary.BigEndian.Uint16(data[4:6])
+			dport := binary.BigEndian.Uint16(data[6:8])
+			if protocol == unix.IPPROTO_UDP && ephemeral.Contains(sport) {
+				return false
+			}
+			return sport != 0 && dport == 0
+		}
+		// attributes are aligned to 4 bytes
+		l = (l + unix.RTA_ALIGNTO - 1) &^ (unix.RTA_ALIGNTO - 1)
+		if l > len(attrs) {
+			return false
+		}
+		attrs = attrs[l:]
+	}
+	return false
+}
+
+func netlinkError(data []byte) error {
+	if len(data) < 4 {
+		return xerrors.Errorf("invalid netlink error message")
+	}
+	errno := -int32(nativeEndian.Uint32(data[:4]))
+	return syscall.Errno(errno)
+}
+
+func servedPortKey(port ServedPort) string {
+	return port.Protocol.String() + "/" + net.JoinHostPort(port.Address.String(), strconv.FormatUint(uint64(port.Port), 10))
+}
+
+func sortServedPorts(ports []ServedPort) {
+	sort.Slice(ports, func(i, j int) bool {
+		if ports[i].Protocol != ports[j].Protocol {
+			return ports[i].Protocol < ports[j].Protocol
+		}
+		if ports[i].Port != ports[j].Port {
+			return ports[i].Port < ports[j].Port
+		}
+		return bytes.Compare(ports[i].Address, ports[j].Address) < 0
+	})
+}
+
+var nativeEndian binary.ByteOrder = func() binary.ByteOrder {
+	i := uint16(1)
+	if *(*byte)(unsafe.Pointer(&i)) == 1 {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}()
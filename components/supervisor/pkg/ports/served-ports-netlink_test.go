@@ -0,0 +1,248 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package ports
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/sys/unix"
+
+	api "github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+func inetDiagMsg(family, state uint8, src net.IP, sport, dport uint16) []byte {
+	msg := make([]byte, sizeofInetDiagMsg)
+	msg[0] = family
+	msg[1] = state
+	binary.BigEndian.PutUint16(msg[4:6], sport)
+	binary.BigEndian.PutUint16(msg[6:8], dport)
+	if ip4 := src.To4(); family == unix.AF_INET && ip4 != nil {
+		copy(msg[8:], ip4)
+	} else {
+		copy(msg[8:], src.To16())
+	}
+	return msg
+}
+
+func TestParseInetDiagMsg(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Protocol    uint8
+		Data        []byte
+		Expectation *ServedPort
+	}{
+		{
+			Name:        "tcp4 listener",
+			Protocol:    unix.IPPROTO_TCP,
+			Data:        inetDiagMsg(unix.AF_INET, tcpListen, net.IPv4zero, 8080, 0),
+			Expectation: &ServedPort{Address: net.IPv4zero.To4(), Port: 8080},
+		},
+		{
+			Name:        "tcp4 localhost listener",
+			Protocol:    unix.IPPROTO_TCP,
+			Data:        inetDiagMsg(unix.AF_INET, tcpListen, net.IPv4(127, 0, 0, 1), 5900, 0),
+			Expectation: &ServedPort{Address: net.IPv4(127, 0, 0, 1).To4(), Port: 5900, BoundToLocalhost: true},
+		},
+		{
+			Name:        "tcp6 listener",
+			Protocol:    unix.IPPROTO_TCP,
+			Data:        inetDiagMsg(unix.AF_INET6, tcpListen, net.IPv6loopback, 3000, 0),
+			Expectation: &ServedPort{Address: net.IPv6loopback, Port: 3000, BoundToLocalhost: true},
+		},
+		{
+			Name:     "tcp established",
+			Protocol: unix.IPPROTO_TCP,
+			Data:     inetDiagMsg(unix.AF_INET, tcpEstablished, net.IPv4zero, 8080, 43210),
+		},
+		{
+			Name:        "udp bound",
+			Protocol:    unix.IPPROTO_UDP,
+			Data:        inetDiagMsg(unix.AF_INET6, tcpClose, net.IPv6zero, 5353, 0),
+			Expectation: &ServedPort{Address: net.IPv6zero, Port: 5353, Protocol: api.PortsStatus_udp},
+		},
+		{
+			Name:     "udp unconnected client",
+			Protocol: unix.IPPROTO_UDP,
+			Data:     inetDiagMsg(unix.AF_INET, tcpClose, net.IPv4zero, 45678, 0),
+		},
+		{
+			Name:     "udp connected",
+			Protocol: unix.IPPROTO_UDP,
+			Data:     inetDiagMsg(unix.AF_INET, tcpEstablished, net.IPv4zero, 40000, 53),
+		},
+		{
+			Name:     "unbound",
+			Protocol: unix.IPPROTO_UDP,
+			Data:     inetDiagMsg(unix.AF_INET, tcpClose, net.IPv4zero, 0, 0),
+		},
+		{
+			Name:     "truncated",
+			Protocol: unix.IPPROTO_TCP,
+			Data:     inetDiagMsg(unix.AF_INET, tcpListen, net.IPv4zero, 8080, 0)[:10],
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			port, ok := parseInetDiagMsg(test.Protocol, test.Data, defaultEphemeralPorts)
+			var act *ServedPort
+			if ok {
+				act = &port
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected parseInetDiagMsg() (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsListenerDestroyed(t *testing.T) {
+	withProtocol := func(msg []byte, protocol uint8) []byte {
+		attr := make([]byte, 8)
+		nativeEndian.PutUint16(attr[0:2], unix.SizeofRtAttr+1)
+		nativeEndian.PutUint16(attr[2:4], inetDiagProtocol)
+		attr[4] = protocol
+		return append(msg, attr...)
+	}
+	shutdown := make([]byte, 8)
+	nativeEndian.PutUint16(shutdown[0:2], unix.SizeofRtAttr+1)
+	nativeEndian.PutUint16(shutdown[2:4], 8)
+
+	tests := []struct {
+		Name        string
+		Data        []byte
+		Expectation bool
+	}{
+		{Name: "tcp listener", Data: withProtocol(inetDiagMsg(unix.AF_INET, tcpClose, net.IPv4zero, 8080, 0), unix.IPPROTO_TCP), Expectation: true},
+		{Name: "tcp connection", Data: withProtocol(inetDiagMsg(unix.AF_INET, tcpClose, net.IPv4zero, 8080, 43210), unix.IPPROTO_TCP)},
+		{Name: "udp bound", Data: withProtocol(inetDiagMsg(unix.AF_INET, tcpClose, net.IPv4zero, 5353, 0), unix.IPPROTO_UDP), Expectation: true},
+		{Name: "udp unconnected client", Data: withProtocol(inetDiagMsg(unix.AF_INET, tcpClose, net.IPv4zero, 45678, 0), unix.IPPROTO_UDP)},
+		{Name: "tcp listener on ephemeral port", Data: withProtocol(inetDiagMsg(unix.AF_INET, tcpClose, net.IPv4zero, 45678, 0), unix.IPPROTO_TCP), Expectation: true},
+		{Name: "udp connected", Data: withProtocol(inetDiagMsg(unix.AF_INET, tcpClose, net.IPv4zero, 40000, 53), unix.IPPROTO_UDP)},
+		{Name: "unbound", Data: withProtocol(inetDiagMsg(unix.AF_INET, tcpClose, net.IPv4zero, 0, 0), unix.IPPROTO_UDP)},
+		{Name: "other protocol", Data: withProtocol(inetDiagMsg(unix.AF_INET, tcpClose, net.IPv4zero, 8080, 0), unix.IPPROTO_SCTP)},
+		{Name: "protocol after other attribute", Data: withProtocol(append(inetDiagMsg(unix.AF_INET, tcpClose, net.IPv4zero, 8080, 0), shutdown...), unix.IPPROTO_TCP), Expectation: true},
+		{Name: "without protocol", Data: inetDiagMsg(unix.AF_INET, tcpClose, net.IPv4zero, 8080, 0)},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if act := isListenerDestroyed(test.Data, defaultEphemeralPorts); act != test.Expectation {
+				t.Errorf("unexpected isListenerDestroyed(): expected %v, got %v", test.Expectation, act)
+			}
+		})
+	}
+}
+
+func TestObserveServedPorts(t *testing.T) {
+	var (
+		tcp  = []ServedPort{{Address: net.IPv4zero, Port: 8080}}
+		both = []ServedPort{{Address: net.IPv4zero, Port: 8080}, {Address: net.IPv4zero, Port: 5353, Protocol: api.PortsStatus_udp}}
+	)
+	listings := make(chan []ServedPort)
+	list := func() ([]ServedPort, error) {
+		return <-listings, nil
+	}
+	destroyed := make(chan struct{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reschan := make(chan []ServedPort)
+	errchan := make(chan error, 1)
+	// an hour long interval makes sure that only the destroy notifications trigger a listing
+	go observeServedPorts(ctx, time.Hour, list, destroyed, reschan, errchan)
+
+	// the initial listing is always sent, even if it is empty
+	listings <- nil
+	if diff := cmp.Diff([]ServedPort(nil), <-reschan); diff != "" {
+		t.Errorf("unexpected initial ports (-want +got):\n%s", diff)
+	}
+
+	for _, step := range []struct {
+		Ports  []ServedPort
+		Update bool
+	}{
+		{Ports: tcp, Update: true},
+		{Ports: tcp},
+		{Ports: both, Update: true},
+		{Ports: both},
+		{Ports: nil, Update: true},
+	} {
+		select {
+		case destroyed <- struct{}{}:
+		case ports := <-reschan:
+			t.Fatalf("unexpected update %v", ports)
+		case <-time.After(5 * time.Second):
+			t.Fatal("observer does not listen for destroy notifications")
+		}
+		listings <- step.Ports
+		if !step.Update {
+			continue
+		}
+		if diff := cmp.Diff(step.Ports, <-reschan); diff != "" {
+			t.Errorf("unexpected update (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestSockDiagListServedPorts(t *testing.T) {
+	ephemeral := readEphemeralPorts()
+	diag, err := openSockDiag(ephemeral)
+	if err != nil {
+		t.Skipf("netlink sock_diag is not available: %v", err)
+	}
+	defer diag.Close()
+
+	tcp, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tcp.Close()
+	// servers bind UDP sockets to a port outside of the ephemeral port range
+	var udp net.PacketConn
+	for port := 20000; port < 20100 && udp == nil; port++ {
+		udp, _ = net.ListenPacket("udp4", fmt.Sprintf("127.0.0.1:%d", port))
+	}
+	if udp == nil {
+		t.Skip("cannot find a free UDP port")
+	}
+	defer udp.Close()
+	client, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ports, err := diag.ListServedPorts()
+	if err != nil {
+		t.Skipf("netlink sock_diag dumps are not available: %v", err)
+	}
+	expectations := []ServedPort{
+		{Address: net.IPv4(127, 0, 0, 1).To4(), Port: uint32(tcp.Addr().(*net.TCPAddr).Port), BoundToLocalhost: true},
+		{Address: net.IPv4(127, 0, 0, 1).To4(), Port: uint32(udp.LocalAddr().(*net.UDPAddr).Port), BoundToLocalhost: true, Protocol: api.PortsStatus_udp},
+	}
+	for _, expectation := range expectations {
+		var found bool
+		for _, port := range ports {
+			if cmp.Equal(expectation, port) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("expected %v to be served, got %v", expectation, ports)
+		}
+	}
+	clientPort := uint32(client.LocalAddr().(*net.UDPAddr).Port)
+	for _, port := range ports {
+		if port.Protocol == api.PortsStatus_udp && port.Port == clientPort && ephemeral.Contains(uint16(clientPort)) {
+			t.Errorf("did not expect the UDP client socket on port %d to be served", clientPort)
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
+	api "github.com/gitpod-io/gitpod/supervisor/api"
 )
 
 // ServedPort describes a port served by a local service.
@@ -26,6 +27,7 @@ type ServedPort struct {
 	Address          net.IP
 	Port             uint32
 	BoundToLocalhost bool
+	Protocol         api.PortsStatus_Protocol
 }
 
 // ServedPortsObserver observes the locally served ports and provides
@@ -46,7 +48,7 @@ const (
 	fnNetTCP6 = "/proc/net/tcp6"
 )
 
-// PollingServedPortsObserver regularly polls "/proc" to observe port changes.
+// PollingServedPortsObserver regularly polls "/proc" to observe port changes. It observes TCP ports only.
 type PollingServedPortsObserver struct {
 	RefreshInterval time.Duration
 
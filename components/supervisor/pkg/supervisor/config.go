@@ -13,6 +13,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	env "github.com/Netflix/go-env"
@@ -258,6 +259,9 @@ type WorkspaceConfig struct {
 	// GitpodTasks is the task configuration of the workspace
 	GitpodTasks string `env:"GITPOD_TASKS"`
 
+	// GitpodServices is the service configuration of the workspace
+	GitpodServices string `env:"GITPOD_SERVICES"`
+
 	// GitpodHeadless controls whether the workspace is running headless
 	GitpodHeadless string `env:"GITPOD_HEADLESS"`
 
@@ -274,12 +278,26 @@ type WorkspaceConfig struct {
 	// the in-workspace epxerience.
 	DotfileRepo string `env:"SUPERVISOR_DOTFILE_REPO"`
 
+	// DotfileRef pins the dotfiles to a branch, tag or commit. If empty, the default branch is installed.
+	DotfileRef string `env:"SUPERVISOR_DOTFILE_REF"`
+
+	// DotfileSigningKeys are ASCII-armored GPG public keys. If set, the installed dotfiles commit must be signed by one of them.
+	DotfileSigningKeys string `env:"SUPERVISOR_DOTFILE_SIGNING_KEYS"`
+
+	// DotfileCacheLocation is where the dotfiles are cached between workspace starts. It must be outside of the
+	// workspace content. If empty, the dotfiles are not cached.
+	DotfileCacheLocation string `env:"SUPERVISOR_DOTFILE_CACHE_LOCATION"`
+
 	// EnvvarOTS points to a URL from which environment variables for child processes can be downloaded from.
 	// This provides a safer means to transport environment variables compared to shipping them on the Kubernetes pod.
 	//
 	// The format of the content downloaded from this URL is expected to be JSON in the form of [{"name":"name", "value":"value"}]
 	EnvvarOTS string `env:"SUPERVISOR_ENVVAR_OTS"`
 
+	// UserEnvvars are the comma-separated names of the user environment variables the workspace was started with.
+	// Supervisor compares the user environment variables of the server against them to notice changes.
+	UserEnvvars *string `env:"SUPERVISOR_USER_ENVVARS"`
+
 	// TerminationGracePeriodSeconds is the max number of seconds the workspace can take to shut down all its processes after SIGTERM was sent.
 	TerminationGracePeriodSeconds *int `env:"GITPOD_TERMINATION_GRACE_PERIOD_SECONDS"`
 }
@@ -292,14 +310,51 @@ type WorkspaceGitpodToken struct {
 
 // TaskConfig defines gitpod task shape.
 type TaskConfig struct {
-	Name     *string                 `json:"name,omitempty"`
-	Before   *string                 `json:"before,omitempty"`
-	Init     *string                 `json:"init,omitempty"`
-	Prebuild *string                 `json:"prebuild,omitempty"`
-	Command  *string                 `json:"command,omitempty"`
-	Env      *map[string]interface{} `json:"env,omitempty"`
-	OpenIn   *string                 `json:"openIn,omitempty"`
-	OpenMode *string                 `json:"openMode,omitempty"`
+	Name      *string                 `json:"name,omitempty"`
+	Before    *string                 `json:"before,omitempty"`
+	Init      *string                 `json:"init,omitempty"`
+	Prebuild  *string                 `json:"prebuild,omitempty"`
+	Command   *string                 `json:"command,omitempty"`
+	Env       *map[string]interface{} `json:"env,omitempty"`
+	OpenIn    *string                 `json:"openIn,omitempty"`
+	OpenMode  *string                 `json:"openMode,omitempty"`
+	DependsOn *[]string               `json:"dependsOn,omitempty"`
+	Readiness *TaskReadiness          `json:"readiness,omitempty"`
+	// RestartPolicy is one of never, on-failure or always
+	RestartPolicy *string `json:"restartPolicy,omitempty"`
+	MaxRestarts   *int    `json:"maxRestarts,omitempty"`
+	// Record records the task's terminal in the asciicast format
+	Record *bool `json:"record,omitempty"`
+}
+
+// ServiceConfig defines the shape of a gitpod service as far as supervisor is concerned.
+// The service containers themselves are run by ws-manager.
+type ServiceConfig struct {
+	Name           string                 `json:"name"`
+	Image          string                 `json:"image"`
+	Ports          []int                  `json:"ports,omitempty"`
+	ReadinessProbe *ServiceReadinessProbe `json:"readinessProbe,omitempty"`
+}
+
+// ServiceReadinessProbe defines when a service is ready. Without a path the port is ready once it accepts connections,
+// with a path once an HTTP GET request succeeds.
+type ServiceReadinessProbe struct {
+	Port          int    `json:"port"`
+	Path          string `json:"path,omitempty"`
+	PeriodSeconds int    `json:"periodSeconds,omitempty"`
+}
+
+// TaskReadiness defines when a task is ready for the tasks depending on it.
+// If several conditions are given, all of them must be met.
+type TaskReadiness struct {
+	// Port is ready once it is open
+	Port int `json:"port,omitempty"`
+	// File is ready once it exists. Relative paths are resolved against the task's working directory.
+	File string `json:"file,omitempty"`
+	// Command is ready once it exits with 0
+	Command string `json:"command,omitempty"`
+	// Log is ready once a line of the task's output matches this regular expression
+	Log string `json:"log,omitempty"`
 }
 
 // Validate validates this configuration.
@@ -316,6 +371,10 @@ func (c WorkspaceConfig) Validate() error {
 		return xerrors.Errorf("logRateLimit must be >= 0")
 	}
 
+	if loc := filepath.Clean(c.DotfileCacheLocation); c.DotfileCacheLocation != "" && (!filepath.IsAbs(loc) || loc == "/workspace" || strings.HasPrefix(loc, "/workspace/")) {
+		return xerrors.Errorf("SUPERVISOR_DOTFILE_CACHE_LOCATION must be an absolute path outside of /workspace")
+	}
+
 	if _, err := c.GetTokens(false); err != nil {
 		return err
 	}
@@ -401,6 +460,33 @@ func (c WorkspaceConfig) getGitpodTasks() (tasks *[]TaskConfig, err error) {
 	return
 }
 
+// getGitpodServices parses gitpod services.
+func (c WorkspaceConfig) getGitpodServices() (services []ServiceConfig, err error) {
+	if c.GitpodServices == "" {
+		return
+	}
+	err = json.Unmarshal([]byte(c.GitpodServices), &services)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse services: %w", err)
+	}
+	return
+}
+
+// getUserEnvvars returns the names of the user environment variables the workspace was started with,
+// or nil if they are unknown.
+func (c WorkspaceConfig) getUserEnvvars() []string {
+	if c.UserEnvvars == nil {
+		return nil
+	}
+	res := []string{}
+	for _, name := range strings.Split(*c.UserEnvvars, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			res = append(res, name)
+		}
+	}
+	return res
+}
+
 // getCommit returns a commit from which this workspace was created.
 func (c WorkspaceConfig) getCommit() (commit *gitpod.Commit, err error) {
 	if c.WorkspaceContext == "" {
@@ -0,0 +1,548 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/content-service/pkg/git"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+const (
+	dotfilesLocation    = "/home/gitpod/.dotfiles"
+	dotfilesLogLocation = "/home/gitpod/.dotfiles.log"
+
+	dotfilesCloneTimeout   = 120 * time.Second
+	dotfilesInstallTimeout = 120 * time.Second
+)
+
+// dotfilesInstallScripts are the installation script candidates in the order in which we look for them
+var dotfilesInstallScripts = []string{
+	"install.sh",
+	"install",
+	"bootstrap.sh",
+	"bootstrap",
+	"script/bootstrap",
+	"setup.sh",
+	"setup",
+	"script/setup",
+}
+
+// dotfilesGitConfig keeps a dotfiles clone, which might be cached, from running any of its own code through Git
+var dotfilesGitConfig = map[string]string{
+	"core.hooksPath": "/dev/null",
+	"core.fsmonitor": "false",
+	"safe.directory": "*",
+}
+
+// dotfilesRefConfig records the ref a clone was checked out for in its Git config
+const dotfilesRefConfig = "gitpod.dotfilesRef"
+
+var commitSHA = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// dotfilesStatus tracks the installation of the dotfiles.
+type dotfilesStatus struct {
+	mu     sync.RWMutex
+	status *api.DotfilesStatusResponse
+	done   chan struct{}
+	once   sync.Once
+}
+
+func newDotfilesStatus() *dotfilesStatus {
+	return &dotfilesStatus{
+		status: &api.DotfilesStatusResponse{},
+		done:   make(chan struct{}),
+	}
+}
+
+// Get returns the current status.
+func (s *dotfilesStatus) Get() *api.DotfilesStatusResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return proto.Clone(s.status).(*api.DotfilesStatusResponse)
+}
+
+// Done is closed once the installation has finished.
+func (s *dotfilesStatus) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *dotfilesStatus) update(doUpdate func(status *api.DotfilesStatusResponse)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	doUpdate(s.status)
+}
+
+func (s *dotfilesStatus) finish(doUpdate func(status *api.DotfilesStatusResponse)) {
+	s.update(doUpdate)
+	s.once.Do(func() {
+		close(s.done)
+	})
+}
+
+// dotfilesInstaller clones a dotfiles repository and installs it into the home directory.
+type dotfilesInstaller struct {
+	// Repo is the clone URL of the dotfiles repository.
+	Repo string
+	// Ref pins the dotfiles to a branch, tag or commit. If empty, the default branch is installed.
+	Ref string
+	// SigningKeys are ASCII-armored GPG public keys. If set, the installed commit must be signed by one of them.
+	SigningKeys string
+	// Location is where the dotfiles are cloned to.
+	Location string
+	// CacheLocation is where a clone of the dotfiles is kept between workspace starts. It must not be writable by
+	// the workspace content, e.g. by a prebuild. If empty, no cache is used.
+	CacheLocation string
+	// Home is the directory the dotfiles are linked into if they have no installation script.
+	Home string
+	// Env is the environment of the installation script.
+	Env []string
+	// Credential is the user the installation script runs as. If nil, it runs as the current user.
+	Credential *syscall.Credential
+	// AuthProvider provides credentials to clone the dotfiles repository. If nil, the repository is cloned anonymously.
+	AuthProvider git.AuthProvider
+	// Status receives the installation progress.
+	Status *dotfilesStatus
+}
+
+func (d *dotfilesInstaller) git() *git.Client {
+	client := &git.Client{
+		Location:      d.Location,
+		RemoteURI:     d.Repo,
+		CommandConfig: dotfilesGitConfig,
+	}
+	if d.AuthProvider != nil {
+		client.AuthProvider = d.AuthProvider
+		client.AuthMethod = git.BasicAuth
+	}
+	return client
+}
+
+// Install installs the dotfiles and writes the installation log to out.
+func (d *dotfilesInstaller) Install(ctx context.Context, out io.Writer) (err error) {
+	d.Status.update(func(status *api.DotfilesStatusResponse) {
+		status.Phase = api.DotfilesPhase_cloning
+		status.Repository = d.Repo
+		status.Ref = d.Ref
+	})
+	defer func() {
+		if err == nil {
+			d.Status.finish(func(status *api.DotfilesStatusResponse) {
+				status.Phase = api.DotfilesPhase_installed
+			})
+			return
+		}
+		fmt.Fprintf(out, "# dotfile init failed: %s\n", err.Error())
+		d.Status.finish(func(status *api.DotfilesStatusResponse) {
+			status.Phase = api.DotfilesPhase_install_failed
+			status.Failure = err.Error()
+		})
+	}()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- d.checkout(ctx, out)
+		close(done)
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			return err
+		}
+	case <-time.After(dotfilesCloneTimeout):
+		return xerrors.Errorf("dotfiles repo clone did not finish within %s", dotfilesCloneTimeout)
+	}
+
+	client := d.git()
+	commit, err := client.GitWithOutput(ctx, nil, "rev-parse", "HEAD")
+	if err != nil {
+		return xerrors.Errorf("cannot resolve the dotfiles commit: %w", err)
+	}
+	err = client.Git(ctx, "config", dotfilesRefConfig, d.Ref)
+	if err != nil {
+		return xerrors.Errorf("cannot record the dotfiles ref: %w", err)
+	}
+	d.Status.update(func(status *api.DotfilesStatusResponse) {
+		status.Commit = strings.TrimSpace(string(commit))
+	})
+	fmt.Fprintf(out, "# checked out %s\n", strings.TrimSpace(string(commit)))
+
+	if d.SigningKeys != "" {
+		d.Status.update(func(status *api.DotfilesStatusResponse) {
+			status.Phase = api.DotfilesPhase_verifying
+		})
+		err = d.verify(ctx, out)
+		if err != nil {
+			return err
+		}
+		d.Status.update(func(status *api.DotfilesStatusResponse) {
+			status.Verified = true
+		})
+	}
+
+	if d.Credential != nil {
+		_ = filepath.Walk(d.Location, func(name string, info os.FileInfo, err error) error {
+			if err == nil {
+				err = os.Chown(name, int(d.Credential.Uid), int(d.Credential.Gid))
+			}
+			return err
+		})
+	}
+
+	d.Status.update(func(status *api.DotfilesStatusResponse) {
+		status.Phase = api.DotfilesPhase_installing
+	})
+	installed, err := d.runInstallScript(out)
+	if installed || err != nil {
+		return err
+	}
+	return d.link(out)
+}
+
+// checkout clones the dotfiles and checks out the pinned ref. If a clone is cached, it is updated instead. A cached
+// clone is used as is if the pinned commit is part of it already, or if it cannot be updated but will be verified.
+func (d *dotfilesInstaller) checkout(ctx context.Context, out io.Writer) error {
+	if d.CacheLocation != "" {
+		cached, err := d.checkoutCached(ctx, out)
+		if err != nil {
+			fmt.Fprintf(out, "# cannot use the cached dotfiles: %s\n", err)
+			_ = os.RemoveAll(d.Location)
+		}
+		if cached {
+			d.Status.update(func(status *api.DotfilesStatusResponse) {
+				status.Cached = true
+			})
+			return nil
+		}
+		if err == nil {
+			return nil
+		}
+	}
+
+	fmt.Fprintf(out, "# cloning %s\n", d.Repo)
+	client := d.git()
+	err := client.Clone(ctx)
+	if err != nil {
+		return err
+	}
+	if d.Ref == "" {
+		return nil
+	}
+	return d.fetchRef(ctx, client, d.Ref)
+}
+
+// checkoutCached copies the cached clone to the location and updates it. It returns true if the cached
+// clone was used without an update.
+func (d *dotfilesInstaller) checkoutCached(ctx context.Context, out io.Writer) (cached bool, err error) {
+	if _, err := os.Stat(d.CacheLocation); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, xerrors.Errorf("no dotfiles are cached")
+		}
+		return false, err
+	}
+	err = copyDir(d.CacheLocation, d.Location)
+	if err != nil {
+		return false, err
+	}
+	client := d.git()
+	remote, err := client.GitWithOutput(ctx, nil, "config", "--get", "remote.origin.url")
+	if err != nil {
+		return false, err
+	}
+	if strings.TrimSpace(string(remote)) != d.Repo {
+		return false, xerrors.Errorf("the cache contains %s", strings.TrimSpace(string(remote)))
+	}
+
+	if commitSHA.MatchString(d.Ref) {
+		if err := client.Git(ctx, "checkout", "--detach", d.Ref); err == nil {
+			fmt.Fprintf(out, "# using the cached commit %s\n", d.Ref)
+			return true, nil
+		}
+	}
+
+	ref := d.Ref
+	if ref == "" {
+		ref = "HEAD"
+	}
+	err = d.fetchRef(ctx, client, ref)
+	if err != nil {
+		// git config fails if the ref is not set, which is the case for the default branch
+		cachedRef, _ := client.GitWithOutput(ctx, nil, "config", "--get", dotfilesRefConfig)
+		if strings.TrimSpace(string(cachedRef)) != d.Ref {
+			return false, err
+		}
+		if d.SigningKeys == "" {
+			// we would run an installation script we cannot vouch for
+			return false, xerrors.Errorf("cannot update the cached dotfiles, and there are no signing keys to verify them: %w", err)
+		}
+		fmt.Fprintf(out, "# cannot update the cached dotfiles, using them as they are once verified: %s\n", err)
+		return true, nil
+	}
+	return false, nil
+}
+
+func (d *dotfilesInstaller) fetchRef(ctx context.Context, client *git.Client, ref string) error {
+	err := client.Git(ctx, "fetch", "--depth=1", "origin", ref)
+	if err != nil {
+		return xerrors.Errorf("cannot fetch %s: %w", ref, err)
+	}
+	err = client.Git(ctx, "checkout", "--detach", "FETCH_HEAD")
+	if err != nil {
+		return xerrors.Errorf("cannot check out %s: %w", ref, err)
+	}
+	return nil
+}
+
+// verify verifies the signature of the checked out commit against the signing keys, which are imported
+// into a keyring of their own to not trust any other key.
+func (d *dotfilesInstaller) verify(ctx context.Context, out io.Writer) error {
+	gnupgHome, err := os.MkdirTemp("", "dotfiles-gnupg")
+	if err != nil {
+		return xerrors.Errorf("cannot create keyring: %w", err)
+	}
+	defer os.RemoveAll(gnupgHome)
+	env := append(os.Environ(), "GNUPGHOME="+gnupgHome)
+
+	importKeys := exec.CommandContext(ctx, "gpg", "--batch", "--import")
+	importKeys.Env = env
+	importKeys.Stdin = strings.NewReader(d.SigningKeys)
+	importKeys.Stdout = out
+	importKeys.Stderr = out
+	err = importKeys.Run()
+	if err != nil {
+		return xerrors.Errorf("cannot import the dotfiles signing keys: %w", err)
+	}
+
+	var args []string
+	for k, v := range dotfilesGitConfig {
+		args = append(args, "-c", k+"="+v)
+	}
+	verifyCommit := exec.CommandContext(ctx, "git", append(args, "verify-commit", "HEAD")...)
+	verifyCommit.Dir = d.Location
+	verifyCommit.Env = env
+	verifyCommit.Stdout = out
+	verifyCommit.Stderr = out
+	err = verifyCommit.Run()
+	if err != nil {
+		return xerrors.Errorf("the dotfiles commit is not signed by a trusted key: %w", err)
+	}
+	return nil
+}
+
+// runInstallScript runs the first installation script candidate which exists. It returns false if there is none.
+func (d *dotfilesInstaller) runInstallScript(out io.Writer) (installed bool, err error) {
+	for _, c := range dotfilesInstallScripts {
+		fn := filepath.Join(d.Location, c)
+		stat, err := os.Stat(fn)
+		if err != nil {
+			_, _ = out.Write([]byte(fmt.Sprintf("# installation script candidate %s is not available\n", fn)))
+			continue
+		}
+		if stat.IsDir() {
+			_, _ = out.Write([]byte(fmt.Sprintf("# installation script candidate %s is a directory\n", fn)))
+			continue
+		}
+		if stat.Mode()&0111 == 0 {
+			_, _ = out.Write([]byte(fmt.Sprintf("# installation script candidate %s is not executable\n", fn)))
+			continue
+		}
+
+		_, _ = out.Write([]byte(fmt.Sprintf("# executing installation script candidate %s\n", fn)))
+		d.Status.update(func(status *api.DotfilesStatusResponse) {
+			status.InstallScript = c
+		})
+
+		// looks like we've found a candidate, let's run it
+		cmd := exec.Command("/bin/sh", "-c", "exec "+fn)
+		cmd.Dir = d.Home
+		cmd.Env = d.Env
+		if d.Credential != nil {
+			// All supervisor children run as gitpod user. The environment variables we produce are also
+			// gitpod user specific.
+			cmd.SysProcAttr = &syscall.SysProcAttr{Credential: d.Credential}
+		}
+		cmd.Stdout = out
+		cmd.Stderr = out
+		err = cmd.Start()
+		if err != nil {
+			return true, err
+		}
+		done := make(chan error, 1)
+		go func() {
+			done <- cmd.Wait()
+			close(done)
+		}()
+
+		select {
+		case err = <-done:
+		case <-time.After(dotfilesInstallTimeout):
+			_ = cmd.Process.Kill()
+			return true, xerrors.Errorf("installation script %s took longer than %s", c, dotfilesInstallTimeout)
+		}
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			d.Status.update(func(status *api.DotfilesStatusResponse) {
+				status.ExitCode = int32(exitErr.ExitCode())
+			})
+			return true, xerrors.Errorf("installation script %s failed with exit code %d", c, exitErr.ExitCode())
+		}
+		return true, err
+	}
+	return false, nil
+}
+
+// link symlinks the dotfiles into the home directory unless the files exist already.
+func (d *dotfilesInstaller) link(out io.Writer) error {
+	return filepath.Walk(d.Location, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if strings.Contains(path, "/.git") {
+			// don't symlink the .git directory or any of its content
+			return nil
+		}
+
+		homeFN := filepath.Join(d.Home, strings.TrimPrefix(path, d.Location))
+		if _, err := os.Stat(homeFN); err == nil {
+			// homeFN exists already - do nothing
+			return nil
+		}
+
+		if info.IsDir() {
+			return os.MkdirAll(homeFN, info.Mode().Perm())
+		}
+
+		// write some feedback to the terminal
+		_, _ = out.Write([]byte(fmt.Sprintf("# echo linking %s -> %s\n", path, homeFN)))
+
+		return os.Symlink(path, homeFN)
+	})
+}
+
+// Cache replaces the cached clone with the installed one.
+func (d *dotfilesInstaller) Cache() error {
+	if d.CacheLocation == "" {
+		return nil
+	}
+	tmp := d.CacheLocation + ".tmp"
+	_ = os.RemoveAll(tmp)
+	err := copyDir(d.Location, tmp)
+	if err != nil {
+		_ = os.RemoveAll(tmp)
+		return err
+	}
+	err = os.RemoveAll(d.CacheLocation)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, d.CacheLocation)
+}
+
+func copyDir(src, dst string) error {
+	err := os.MkdirAll(filepath.Dir(dst), 0755)
+	if err != nil {
+		return err
+	}
+	out, err := exec.Command("cp", "-a", src, dst).CombinedOutput()
+	if err != nil {
+		return xerrors.Errorf("cannot copy %s to %s: %w: %s", src, dst, err, string(out))
+	}
+	return nil
+}
+
+// installDotfiles installs the dotfiles of the user, unless they are installed already. If a cache location is
+// configured, the installed clone is cached there.
+func installDotfiles(ctx context.Context, cfg *Config, tokenService *InMemoryTokenService, childProcEnvvars []string, status *dotfilesStatus) {
+	repo := cfg.DotfileRepo
+	if repo == "" {
+		status.finish(func(status *api.DotfilesStatusResponse) {
+			status.Phase = api.DotfilesPhase_not_configured
+		})
+		return
+	}
+
+	if _, err := os.Stat(dotfilesLocation); err == nil {
+		// dotfile path exists already - nothing to do here
+		status.finish(func(status *api.DotfilesStatusResponse) {
+			status.Phase = api.DotfilesPhase_installed
+			status.Repository = repo
+		})
+		return
+	}
+
+	status.update(func(status *api.DotfilesStatusResponse) {
+		status.LogPath = dotfilesLogLocation
+	})
+	out, err := os.OpenFile(dotfilesLogLocation, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		log.WithError(err).Warn("installing dotfiles failed")
+		status.finish(func(status *api.DotfilesStatusResponse) {
+			status.Phase = api.DotfilesPhase_install_failed
+			status.Repository = repo
+			status.Failure = err.Error()
+		})
+		return
+	}
+	defer out.Close()
+
+	installer := &dotfilesInstaller{
+		Repo:          repo,
+		Ref:           cfg.DotfileRef,
+		SigningKeys:   cfg.DotfileSigningKeys,
+		Location:      dotfilesLocation,
+		CacheLocation: cfg.DotfileCacheLocation,
+		Home:          "/home/gitpod",
+		Env:           childProcEnvvars,
+		Credential: &syscall.Credential{
+			Uid: gitpodUID,
+			Gid: gitpodGID,
+		},
+		Status: status,
+	}
+	if repoURL, err := url.Parse(repo); err == nil {
+		installer.AuthProvider = func() (username string, password string, err error) {
+			resp, err := tokenService.GetToken(ctx, &api.GetTokenRequest{
+				Host: repoURL.Host,
+				Kind: KindGit,
+			})
+			if err != nil {
+				return
+			}
+			username = resp.User
+			password = resp.Token
+			return
+		}
+	}
+	err = installer.Install(ctx, out)
+	if err != nil {
+		log.WithError(err).Warn("installing dotfiles failed")
+		return
+	}
+
+	err = installer.Cache()
+	if err != nil {
+		log.WithError(err).Warn("cannot cache dotfiles")
+	}
+}
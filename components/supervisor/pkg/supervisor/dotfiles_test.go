@@ -0,0 +1,223 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+// newDotfilesRepo creates a repository with a commit which contains a .bashrc and a second one
+// which adds an installation script exiting with exitCode. It returns the URL and both commits.
+func newDotfilesRepo(t *testing.T, exitCode string) (repo string, first string, second string) {
+	dir := t.TempDir()
+	run := func(args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@gitpod.io",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@gitpod.io",
+		)
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %s failed: %v: %s", strings.Join(args, " "), err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+	writeFile := func(name, content string, mode os.FileMode) {
+		err := os.WriteFile(filepath.Join(dir, name), []byte(content), mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	run("init", "-q", "-b", "main")
+	writeFile(".bashrc", "export FOO=bar\n", 0644)
+	run("add", ".")
+	run("commit", "-q", "-m", "bashrc")
+	first = run("rev-parse", "HEAD")
+
+	writeFile("install.sh", "#!/bin/sh\ntouch \"$(dirname \"$0\")/installed\"\nexit "+exitCode+"\n", 0755)
+	run("add", ".")
+	run("commit", "-q", "-m", "install script")
+	second = run("rev-parse", "HEAD")
+
+	return "file://" + dir, first, second
+}
+
+func TestDotfilesInstaller(t *testing.T) {
+	tests := []struct {
+		Name        string
+		ExitCode    string
+		PinFirst    bool
+		Expectation func(repo, first, second string) *api.DotfilesStatusResponse
+		Installed   bool
+		Linked      bool
+	}{
+		{
+			Name:     "default branch",
+			ExitCode: "0",
+			Expectation: func(repo, first, second string) *api.DotfilesStatusResponse {
+				return &api.DotfilesStatusResponse{Phase: api.DotfilesPhase_installed, Repository: repo, Commit: second, InstallScript: "install.sh"}
+			},
+			Installed: true,
+		},
+		{
+			Name:     "pinned commit without install script",
+			ExitCode: "0",
+			PinFirst: true,
+			Expectation: func(repo, first, second string) *api.DotfilesStatusResponse {
+				return &api.DotfilesStatusResponse{Phase: api.DotfilesPhase_installed, Repository: repo, Ref: first, Commit: first}
+			},
+			Linked: true,
+		},
+		{
+			Name:     "failing install script",
+			ExitCode: "3",
+			Expectation: func(repo, first, second string) *api.DotfilesStatusResponse {
+				return &api.DotfilesStatusResponse{
+					Phase:         api.DotfilesPhase_install_failed,
+					Repository:    repo,
+					Commit:        second,
+					InstallScript: "install.sh",
+					ExitCode:      3,
+					Failure:       "installation script install.sh failed with exit code 3",
+				}
+			},
+			Installed: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			repo, first, second := newDotfilesRepo(t, test.ExitCode)
+			home := t.TempDir()
+			installer := &dotfilesInstaller{
+				Repo:     repo,
+				Location: filepath.Join(home, ".dotfiles"),
+				Home:     home,
+				Status:   newDotfilesStatus(),
+			}
+			if test.PinFirst {
+				installer.Ref = first
+			}
+
+			_ = installer.Install(context.Background(), io.Discard)
+
+			select {
+			case <-installer.Status.Done():
+			default:
+				t.Error("installation is not done")
+			}
+			if diff := cmp.Diff(test.Expectation(repo, first, second), installer.Status.Get(), protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected status (-want +got):\n%s", diff)
+			}
+			if _, err := os.Stat(filepath.Join(installer.Location, "installed")); (err == nil) != test.Installed {
+				t.Errorf("expected the install script to run: %v, but got %v", test.Installed, err)
+			}
+			if target, err := os.Readlink(filepath.Join(home, ".bashrc")); (err == nil) != test.Linked {
+				t.Errorf("expected .bashrc to be linked: %v, but got %q, %v", test.Linked, target, err)
+			}
+		})
+	}
+}
+
+func TestDotfilesInstallerCache(t *testing.T) {
+	repo, first, _ := newDotfilesRepo(t, "0")
+	cache := filepath.Join(t.TempDir(), "dotfiles")
+	install := func() *dotfilesInstaller {
+		home := t.TempDir()
+		installer := &dotfilesInstaller{
+			Repo:          repo,
+			Ref:           first,
+			Location:      filepath.Join(home, ".dotfiles"),
+			CacheLocation: cache,
+			Home:          home,
+			Status:        newDotfilesStatus(),
+		}
+		err := installer.Install(context.Background(), io.Discard)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return installer
+	}
+
+	installer := install()
+	if installer.Status.Get().Cached {
+		t.Error("expected the first installation to clone the dotfiles")
+	}
+	err := installer.Cache()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the pinned commit is cached, hence the repository is not needed anymore
+	err = os.RemoveAll(strings.TrimPrefix(repo, "file://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the hooks of a cached clone must not run
+	hookMarker := filepath.Join(t.TempDir(), "hooked")
+	err = os.WriteFile(filepath.Join(cache, ".git", "hooks", "post-checkout"), []byte("#!/bin/sh\ntouch "+hookMarker+"\n"), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := &api.DotfilesStatusResponse{Phase: api.DotfilesPhase_installed, Repository: repo, Ref: first, Commit: first, Cached: true}
+	if diff := cmp.Diff(exp, install().Status.Get(), protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected status (-want +got):\n%s", diff)
+	}
+	if _, err := os.Stat(hookMarker); err == nil {
+		t.Error("expected the hooks of the cached clone not to run")
+	}
+}
+
+func TestDotfilesInstallerStaleCache(t *testing.T) {
+	repo, _, _ := newDotfilesRepo(t, "0")
+	cache := filepath.Join(t.TempDir(), "dotfiles")
+	newInstaller := func() *dotfilesInstaller {
+		home := t.TempDir()
+		return &dotfilesInstaller{
+			Repo:          repo,
+			Ref:           "main",
+			Location:      filepath.Join(home, ".dotfiles"),
+			CacheLocation: cache,
+			Home:          home,
+			Status:        newDotfilesStatus(),
+		}
+	}
+
+	installer := newInstaller()
+	err := installer.Install(context.Background(), io.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = installer.Cache()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the cached branch cannot be updated anymore, and there are no signing keys to verify it
+	err = os.RemoveAll(strings.TrimPrefix(repo, "file://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	installer = newInstaller()
+	err = installer.Install(context.Background(), io.Discard)
+	if err == nil {
+		t.Fatal("expected the installation from a stale cache to fail")
+	}
+	if _, err := os.Stat(filepath.Join(installer.Location, "installed")); err == nil {
+		t.Error("expected the installation script of the stale cache not to run")
+	}
+}
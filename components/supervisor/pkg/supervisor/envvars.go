@@ -0,0 +1,330 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	gitpod "github.com/gitpod-io/gitpod/gitpod-protocol"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+const (
+	// liveEnvFile holds the user environment variables which changed since the workspace was started.
+	// Sourcing it brings a shell which was opened before up to date.
+	liveEnvFile = "/home/gitpod/.gitpod-env"
+	// envVarMinPollInterval and envVarMaxPollInterval bound the interval in which the user environment variables
+	// are fetched from the server, which does not notify us about changes. The interval doubles while nothing
+	// changes, and starts over after a change.
+	envVarMinPollInterval = 15 * time.Second
+	envVarMaxPollInterval = 5 * time.Minute
+)
+
+// forcedEnvvars are set by supervisor itself and cannot be changed by the user
+var forcedEnvvars = map[string]struct{}{
+	"SUPERVISOR_ADDR":   {},
+	"HOME":              {},
+	"USER":              {},
+	"JAVA_TOOL_OPTIONS": {},
+}
+
+var validEnvvarName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// isLiveEnvvar returns true if changes of the user environment variable name are propagated to the running workspace.
+func isLiveEnvvar(name string) bool {
+	if _, forced := forcedEnvvars[name]; forced {
+		return false
+	}
+	return validEnvvarName.MatchString(name) && !isBlacklistedEnvvar(name)
+}
+
+type envVarSource interface {
+	GetEnvVars(ctx context.Context) ([]*gitpod.UserEnvVarValue, error)
+}
+
+// liveEnv keeps the environment of new terminals in sync with the user environment variables
+// which apply to the repository of the workspace.
+type liveEnv struct {
+	// Source provides the user environment variables.
+	Source envVarSource
+	// EnvFile is the file the changed environment variables are written to. If empty, no file is written.
+	EnvFile string
+	// OnChange is called with the names of the environment variables which changed.
+	OnChange func(ctx context.Context, names []string)
+
+	base []string
+
+	mu sync.RWMutex
+	// last are the user environment variables of the last update. It starts with those the workspace was started
+	// with if their names are known, and is nil before the first update otherwise.
+	last map[string]string
+	// changes are the values of all environment variables which changed since the workspace was started,
+	// nil if a variable was deleted
+	changes map[string]*string
+}
+
+// newLiveEnv creates a live environment for the environment base of child processes. userEnvvars are the names of
+// the user environment variables the workspace was started with, nil if they are unknown.
+func newLiveEnv(base []string, userEnvvars []string, source envVarSource) *liveEnv {
+	res := &liveEnv{
+		Source:  source,
+		EnvFile: liveEnvFile,
+		base:    base,
+		changes: make(map[string]*string),
+	}
+	if userEnvvars != nil {
+		values := make(map[string]string, len(base))
+		for _, kv := range base {
+			name, value, _ := strings.Cut(kv, "=")
+			values[name] = value
+		}
+		res.last = make(map[string]string, len(userEnvvars))
+		for _, name := range userEnvvars {
+			value, ok := values[name]
+			if !ok || !isLiveEnvvar(name) {
+				continue
+			}
+			res.last[name] = value
+		}
+	}
+	return res
+}
+
+// Env returns the environment of child processes with the changed user environment variables applied.
+func (e *liveEnv) Env() []string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	res := make([]string, 0, len(e.base)+len(e.changes))
+	seen := make(map[string]struct{}, len(e.changes))
+	for _, kv := range e.base {
+		name, _, _ := strings.Cut(kv, "=")
+		value, changed := e.changes[name]
+		if !changed {
+			res = append(res, kv)
+			continue
+		}
+		seen[name] = struct{}{}
+		if value != nil {
+			res = append(res, name+"="+*value)
+		}
+	}
+	for _, name := range sortedNames(e.changes) {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		if value := e.changes[name]; value != nil {
+			res = append(res, name+"="+*value)
+		}
+	}
+	return res
+}
+
+// Changed returns the names of all environment variables which changed since the workspace was started.
+func (e *liveEnv) Changed() []string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return sortedNames(e.changes)
+}
+
+// Run polls the user environment variables until the context is canceled.
+func (e *liveEnv) Run(ctx context.Context) {
+	interval := envVarMinPollInterval
+	for {
+		if e.poll(ctx) {
+			interval = envVarMinPollInterval
+		} else {
+			interval = nextEnvVarPollInterval(interval)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+func nextEnvVarPollInterval(interval time.Duration) time.Duration {
+	interval *= 2
+	if interval > envVarMaxPollInterval {
+		return envVarMaxPollInterval
+	}
+	return interval
+}
+
+// poll fetches the user environment variables and returns true if any of them changed.
+func (e *liveEnv) poll(ctx context.Context) bool {
+	vars, err := e.Source.GetEnvVars(ctx)
+	if err != nil {
+		log.WithError(err).Debug("cannot fetch user environment variables")
+		return false
+	}
+	changed := e.update(vars)
+	if len(changed) == 0 {
+		return false
+	}
+	log.WithField("envvars", changed).Info("user environment variables changed")
+
+	if e.EnvFile != "" {
+		err = e.writeEnvFile()
+		if err != nil {
+			log.WithError(err).Warn("cannot write live environment file")
+		}
+	}
+	if e.OnChange != nil {
+		e.OnChange(ctx, changed)
+	}
+	return true
+}
+
+// update records the user environment variables fetched from the server and returns the names of those which
+// changed since the last update. If the user environment variables the workspace was started with are unknown,
+// the first update is the baseline.
+func (e *liveEnv) update(vars []*gitpod.UserEnvVarValue) (changed []string) {
+	current := make(map[string]string, len(vars))
+	for _, v := range vars {
+		if v == nil || !isLiveEnvvar(v.Name) {
+			continue
+		}
+		current[v.Name] = v.Value
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.last == nil {
+		e.last = current
+		return nil
+	}
+	for name, value := range current {
+		if last, ok := e.last[name]; ok && last == value {
+			continue
+		}
+		value := value
+		e.changes[name] = &value
+		changed = append(changed, name)
+	}
+	for name := range e.last {
+		if _, ok := current[name]; ok {
+			continue
+		}
+		e.changes[name] = nil
+		changed = append(changed, name)
+	}
+	e.last = current
+	sort.Strings(changed)
+	return changed
+}
+
+// writeEnvFile atomically replaces the env file, which contains secrets and hence is readable by the gitpod user only.
+func (e *liveEnv) writeEnvFile() error {
+	e.mu.RLock()
+	content := renderEnvFile(e.changes)
+	e.mu.RUnlock()
+
+	tmp, err := os.CreateTemp(filepath.Dir(e.EnvFile), filepath.Base(e.EnvFile)+".*")
+	if err != nil {
+		return xerrors.Errorf("cannot create %s: %w", e.EnvFile, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.WriteString(content)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return xerrors.Errorf("cannot write %s: %w", tmp.Name(), err)
+	}
+	// the temp file is created with mode 0600 already
+	_ = os.Chown(tmp.Name(), gitpodUID, gitpodGID)
+	err = os.Rename(tmp.Name(), e.EnvFile)
+	if err != nil {
+		return xerrors.Errorf("cannot replace %s: %w", e.EnvFile, err)
+	}
+	return nil
+}
+
+// renderEnvFile renders the changed environment variables as shell script.
+func renderEnvFile(changes map[string]*string) string {
+	var out strings.Builder
+	out.WriteString("# User environment variables which changed since the workspace was started.\n")
+	out.WriteString("# Run `source " + liveEnvFile + "` to update a terminal which was opened before.\n")
+	for _, name := range sortedNames(changes) {
+		value := changes[name]
+		if value == nil {
+			fmt.Fprintf(&out, "unset %s\n", name)
+			continue
+		}
+		fmt.Fprintf(&out, "export %s='%s'\n", name, strings.ReplaceAll(*value, "'", `'\''`))
+	}
+	return out.String()
+}
+
+func sortedNames(changes map[string]*string) []string {
+	names := make([]string, 0, len(changes))
+	for name := range changes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// envVarRepositoryPattern returns the repository pattern of the user environment variables which apply
+// to this workspace, or an empty string if the workspace was not created from a repository.
+func envVarRepositoryPattern(cfg *Config) string {
+	commit, err := cfg.getCommit()
+	if err != nil {
+		log.WithError(err).Debug("cannot resolve the repository of the workspace")
+		return ""
+	}
+	if commit == nil || commit.Repository == nil || commit.Repository.Owner == "" || commit.Repository.Name == "" {
+		return ""
+	}
+	return commit.Repository.Owner + "/" + commit.Repository.Name
+}
+
+// offerTaskRestart asks the user to restart the running tasks which were started with outdated values of
+// the environment variables after the changed ones changed.
+func offerTaskRestart(ctx context.Context, notifications *NotificationService, tm *tasksManager, env *liveEnv, changed []string) {
+	tasks := tm.tasksWithStaleEnv(env.Env(), env.Changed())
+	if len(tasks) == 0 {
+		return
+	}
+	names := make([]string, 0, len(tasks))
+	for _, t := range tasks {
+		names = append(names, "'"+t.title+"'")
+	}
+
+	const restartAction = "Restart Tasks"
+	resp, err := notifications.Notify(ctx, &api.NotifyRequest{
+		Level: api.NotifyRequest_INFO,
+		Message: fmt.Sprintf("The environment variables %s changed. New terminals use the new values already, while the tasks %s are still running with the previous ones. Do you want to restart them?",
+			strings.Join(changed, ", "), strings.Join(names, ", ")),
+		Actions: []string{restartAction},
+	})
+	if err != nil {
+		if ctx.Err() == nil {
+			log.WithError(err).Warn("cannot offer to restart tasks after environment variables changed")
+		}
+		return
+	}
+	if resp.Action != restartAction {
+		return
+	}
+	tm.restartTasks(ctx, tasks)
+}
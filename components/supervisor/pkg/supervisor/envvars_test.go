@@ -0,0 +1,156 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	gitpod "github.com/gitpod-io/gitpod/gitpod-protocol"
+)
+
+type fakeEnvVarSource struct {
+	vars []*gitpod.UserEnvVarValue
+}
+
+func (s *fakeEnvVarSource) GetEnvVars(ctx context.Context) ([]*gitpod.UserEnvVarValue, error) {
+	return s.vars, nil
+}
+
+func TestLiveEnv(t *testing.T) {
+	type update struct {
+		Vars    map[string]string
+		Changed []string
+		Env     []string
+	}
+	tests := []struct {
+		Desc        string
+		Base        []string
+		UserEnvvars []string
+		Updates     []update
+	}{
+		{
+			Desc: "first update is the baseline",
+			Base: []string{"HOME=/home/gitpod", "FOO=bar"},
+			Updates: []update{
+				{Vars: map[string]string{"FOO": "bar", "NEW": "value"}, Env: []string{"HOME=/home/gitpod", "FOO=bar"}},
+			},
+		},
+		{
+			Desc:        "start environment is the baseline",
+			Base:        []string{"HOME=/home/gitpod", "FOO=bar", "GONE=soon", "PATH=/usr/bin"},
+			UserEnvvars: []string{"FOO", "GONE", "HOME"},
+			Updates: []update{
+				{
+					Vars:    map[string]string{"FOO": "baz", "NEW": "value", "HOME": "/root"},
+					Changed: []string{"FOO", "GONE", "NEW"},
+					Env:     []string{"HOME=/home/gitpod", "FOO=baz", "PATH=/usr/bin", "NEW=value"},
+				},
+			},
+		},
+		{
+			Desc:        "no user environment variables at start",
+			Base:        []string{"HOME=/home/gitpod"},
+			UserEnvvars: []string{},
+			Updates: []update{
+				{Vars: map[string]string{"NEW": "value"}, Changed: []string{"NEW"}, Env: []string{"HOME=/home/gitpod", "NEW=value"}},
+			},
+		},
+		{
+			Desc: "set, change and delete",
+			Base: []string{"HOME=/home/gitpod", "FOO=bar", "GONE=soon"},
+			Updates: []update{
+				{Vars: map[string]string{"FOO": "bar", "GONE": "soon"}, Env: []string{"HOME=/home/gitpod", "FOO=bar", "GONE=soon"}},
+				{
+					Vars:    map[string]string{"FOO": "baz", "NEW": "value"},
+					Changed: []string{"FOO", "GONE", "NEW"},
+					Env:     []string{"HOME=/home/gitpod", "FOO=baz", "NEW=value"},
+				},
+				{Vars: map[string]string{"FOO": "baz", "NEW": "value"}, Env: []string{"HOME=/home/gitpod", "FOO=baz", "NEW=value"}},
+				{
+					Vars:    map[string]string{"FOO": "baz", "NEW": "value", "GONE": "again"},
+					Changed: []string{"GONE"},
+					Env:     []string{"HOME=/home/gitpod", "FOO=baz", "GONE=again", "NEW=value"},
+				},
+			},
+		},
+		{
+			Desc: "supervisor and blacklisted variables are not changed",
+			Base: []string{"HOME=/home/gitpod"},
+			Updates: []update{
+				{Vars: map[string]string{}, Env: []string{"HOME=/home/gitpod"}},
+				{
+					Vars:    map[string]string{"HOME": "/root", "GITPOD_TOKENS": "secret", "KUBERNETES_PORT": "1", "FOO=BAR": "x", "1ST": "x", "OK_1": "yes"},
+					Changed: []string{"OK_1"},
+					Env:     []string{"HOME=/home/gitpod", "OK_1=yes"},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			source := &fakeEnvVarSource{}
+			env := newLiveEnv(test.Base, test.UserEnvvars, source)
+			env.EnvFile = ""
+			var changed []string
+			env.OnChange = func(ctx context.Context, names []string) {
+				changed = names
+			}
+
+			for i, u := range test.Updates {
+				source.vars = nil
+				for name, value := range u.Vars {
+					source.vars = append(source.vars, &gitpod.UserEnvVarValue{Name: name, Value: value})
+				}
+				changed = nil
+				if polled := env.poll(context.Background()); polled != (len(u.Changed) > 0) {
+					t.Errorf("update %d: poll reported change %v", i, polled)
+				}
+
+				if diff := cmp.Diff(u.Changed, changed); diff != "" {
+					t.Errorf("update %d: unexpected changes (-want +got):\n%s", i, diff)
+				}
+				if diff := cmp.Diff(u.Env, env.Env()); diff != "" {
+					t.Errorf("update %d: unexpected env (-want +got):\n%s", i, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestLiveEnvFile(t *testing.T) {
+	source := &fakeEnvVarSource{vars: []*gitpod.UserEnvVarValue{{Name: "GONE", Value: "soon"}}}
+	env := newLiveEnv(nil, nil, source)
+	env.EnvFile = filepath.Join(t.TempDir(), ".gitpod-env")
+	env.poll(context.Background())
+
+	source.vars = []*gitpod.UserEnvVarValue{{Name: "QUOTED", Value: `it's "quoted" $HOME`}}
+	env.poll(context.Background())
+
+	content, err := os.ReadFile(env.EnvFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := "# User environment variables which changed since the workspace was started.\n" +
+		"# Run `source /home/gitpod/.gitpod-env` to update a terminal which was opened before.\n" +
+		"unset GONE\n" +
+		`export QUOTED='it'\''s "quoted" $HOME'` + "\n"
+	if diff := cmp.Diff(exp, string(content)); diff != "" {
+		t.Errorf("unexpected env file (-want +got):\n%s", diff)
+	}
+
+	stat, err := os.Stat(env.EnvFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := stat.Mode().Perm(); perm != 0o600 {
+		t.Errorf("env file must only be readable by its owner, but has mode %v", perm)
+	}
+}
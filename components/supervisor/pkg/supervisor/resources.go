@@ -0,0 +1,305 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	linuxproc "github.com/c9s/goprocinfo/linux"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+const (
+	// topProcessCount is the number of processes reported by CPU and by memory usage each
+	topProcessCount = 10
+	// clockTicks is the kernel's USER_HZ which /proc reports CPU times in. It is 100 on all architectures we run on.
+	clockTicks = 100
+	// maxCommandLength limits the length of the reported process commands
+	maxCommandLength = 256
+)
+
+// taskRef identifies the task a process belongs to
+type taskRef struct {
+	ID   string
+	Name string
+}
+
+// resourceDetails breaks the workspace resources down into processes, disk, IO and network.
+type resourceDetails struct {
+	// WorkspaceLocation is the XFS project quota directory of the workspace.
+	WorkspaceLocation string
+	// Tasks returns the running tasks by the PID of their terminal.
+	Tasks func() map[uint64]taskRef
+
+	procDir         string
+	ioPressurePaths []string
+	processes       processSampler
+	// logUnavailableIO makes sure we log only once that IO pressure information is not available
+	logUnavailableIO sync.Once
+}
+
+func newResourceDetails(workspaceLocation string, tasks func() map[uint64]taskRef) *resourceDetails {
+	return &resourceDetails{
+		WorkspaceLocation: workspaceLocation,
+		Tasks:             tasks,
+		procDir:           "/proc",
+		// prefer the workspace's cgroup (v2) over the whole system
+		ioPressurePaths: []string{"/sys/fs/cgroup/io.pressure", "/proc/pressure/io"},
+		processes:       processSampler{procDir: "/proc", now: time.Now},
+	}
+}
+
+// add adds the breakdown to the resources status. Information which is not available is left empty.
+func (d *resourceDetails) add(res *api.ResourcesStatusResponse) {
+	var tasks map[uint64]taskRef
+	if d.Tasks != nil {
+		tasks = d.Tasks()
+	}
+	processes, err := d.processes.sample(tasks)
+	if err != nil {
+		log.WithError(err).Debug("cannot resolve process status")
+	}
+	res.Processes = processes
+
+	if d.WorkspaceLocation != "" {
+		disk, err := resolveDiskStatus(d.WorkspaceLocation)
+		if err != nil {
+			log.WithError(err).Debug("cannot resolve disk status")
+		}
+		res.Disk = disk
+	}
+
+	ioPressure, err := resolveIOPressure(d.ioPressurePaths...)
+	if err != nil {
+		d.logUnavailableIO.Do(func() {
+			log.WithError(err).Info("IO pressure stall information is not available")
+		})
+	}
+	res.IoPressure = ioPressure
+
+	network, err := resolveNetworkStatus(filepath.Join(d.procDir, "net", "dev"))
+	if err != nil {
+		log.WithError(err).Debug("cannot resolve network status")
+	}
+	res.Network = network
+}
+
+// processSampler computes the CPU usage of processes between two samples.
+type processSampler struct {
+	procDir    string
+	now        func() time.Time
+	lastTicks  map[uint64]uint64
+	lastSample time.Time
+}
+
+// sample returns the processes using the most CPU or memory. The CPU usage is averaged since the last sample
+// and hence zero for the first sample. tasks maps the PIDs of task terminals to their task.
+func (p *processSampler) sample(tasks map[uint64]taskRef) ([]*api.ProcessStatus, error) {
+	entries, err := os.ReadDir(p.procDir)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list processes: %w", err)
+	}
+
+	var (
+		now      = p.now()
+		elapsed  = now.Sub(p.lastSample).Seconds()
+		pageSize = int64(os.Getpagesize())
+		stats    = make(map[uint64]*linuxproc.ProcessStat, len(entries))
+		ticks    = make(map[uint64]uint64, len(entries))
+		procs    = make([]*api.ProcessStatus, 0, len(entries))
+	)
+	for _, e := range entries {
+		pid, err := strconv.ParseUint(e.Name(), 10, 64)
+		if err != nil || !e.IsDir() {
+			continue
+		}
+		stat, err := linuxproc.ReadProcessStat(filepath.Join(p.procDir, e.Name(), "stat"))
+		if err != nil {
+			// the process has exited in the meantime
+			continue
+		}
+		stats[pid] = stat
+		ticks[pid] = stat.Utime + stat.Stime
+
+		var cpu int64
+		if last, ok := p.lastTicks[pid]; ok && elapsed > 0 && ticks[pid] >= last {
+			cpu = int64(float64(ticks[pid]-last) / clockTicks / elapsed * 1000)
+		}
+		procs = append(procs, &api.ProcessStatus{
+			Pid:    int64(pid),
+			Cpu:    cpu,
+			Memory: stat.Rss * pageSize,
+		})
+	}
+	p.lastTicks = ticks
+	p.lastSample = now
+
+	procs = topProcesses(procs, topProcessCount)
+	for _, proc := range procs {
+		pid := uint64(proc.Pid)
+		proc.Command = processCommand(p.procDir, pid, stats[pid].Comm)
+		if task, ok := owningTask(stats, tasks, pid); ok {
+			proc.TaskId = task.ID
+			proc.TaskName = task.Name
+		}
+	}
+	return procs, nil
+}
+
+// topProcesses returns the n processes using the most CPU and the n processes using the most memory,
+// ordered by CPU usage.
+func topProcesses(procs []*api.ProcessStatus, n int) []*api.ProcessStatus {
+	byMemory := func(i, j int) bool {
+		if procs[i].Memory != procs[j].Memory {
+			return procs[i].Memory > procs[j].Memory
+		}
+		return procs[i].Pid < procs[j].Pid
+	}
+	byCPU := func(i, j int) bool {
+		if procs[i].Cpu != procs[j].Cpu {
+			return procs[i].Cpu > procs[j].Cpu
+		}
+		return byMemory(i, j)
+	}
+
+	top := make(map[int64]struct{}, 2*n)
+	sort.Slice(procs, byMemory)
+	for i := 0; i < n && i < len(procs); i++ {
+		top[procs[i].Pid] = struct{}{}
+	}
+	sort.Slice(procs, byCPU)
+	for i := 0; i < n && i < len(procs); i++ {
+		top[procs[i].Pid] = struct{}{}
+	}
+
+	res := make([]*api.ProcessStatus, 0, len(top))
+	for _, proc := range procs {
+		if _, ok := top[proc.Pid]; ok {
+			res = append(res, proc)
+		}
+	}
+	return res
+}
+
+// owningTask finds the task a process belongs to by walking up its parents until a task terminal is found.
+func owningTask(stats map[uint64]*linuxproc.ProcessStat, tasks map[uint64]taskRef, pid uint64) (taskRef, bool) {
+	// the number of steps is bound to guard against cycles caused by PIDs being reused between reads
+	for i := 0; i < len(stats); i++ {
+		if task, ok := tasks[pid]; ok {
+			return task, true
+		}
+		stat, ok := stats[pid]
+		if !ok || stat.Ppid <= 0 {
+			break
+		}
+		pid = uint64(stat.Ppid)
+	}
+	return taskRef{}, false
+}
+
+func processCommand(procDir string, pid uint64, comm string) string {
+	cmd, err := linuxproc.ReadProcessCmdline(filepath.Join(procDir, strconv.FormatUint(pid, 10), "cmdline"))
+	if err != nil || cmd == "" {
+		// kernel threads and zombies have no command line, for which we show their name like ps does
+		cmd = "[" + strings.TrimSuffix(strings.TrimPrefix(comm, "("), ")") + "]"
+	}
+	if r := []rune(cmd); len(r) > maxCommandLength {
+		cmd = string(r[:maxCommandLength])
+	}
+	return cmd
+}
+
+// resolveDiskStatus resolves the disk usage of the workspace. The workspace location is an XFS project quota directory,
+// for which the filesystem reports the quota limit and usage rather than those of the whole filesystem.
+func resolveDiskStatus(location string) (*api.ResourceStatus, error) {
+	disk, err := linuxproc.ReadDisk(location)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot stat %s: %w", location, err)
+	}
+	if disk.All == 0 {
+		return nil, xerrors.Errorf("%s reports no disk space", location)
+	}
+	return &api.ResourceStatus{
+		Used:     int64(disk.Used),
+		Limit:    int64(disk.All),
+		Severity: calcSeverity(int64(float64(disk.Used) / float64(disk.All) * 100)),
+	}, nil
+}
+
+// resolveIOPressure reads the IO pressure stall information from the first of the given files which exists.
+func resolveIOPressure(paths ...string) (*api.PressureStatus, error) {
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, xerrors.Errorf("cannot read %s: %w", path, err)
+		}
+		defer f.Close()
+
+		// the format is documented in https://www.kernel.org/doc/html/latest/accounting/psi.html, e.g.
+		//   some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+		//   full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+		var res api.PressureStatus
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			if len(fields) == 0 {
+				continue
+			}
+			avgs := make(map[string]float64, 3)
+			for _, field := range fields[1:] {
+				key, value, ok := strings.Cut(field, "=")
+				if !ok {
+					continue
+				}
+				v, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return nil, xerrors.Errorf("cannot parse %s of %s: %w", key, path, err)
+				}
+				avgs[key] = v
+			}
+			switch fields[0] {
+			case "some":
+				res.SomeAvg10, res.SomeAvg60, res.SomeAvg300 = avgs["avg10"], avgs["avg60"], avgs["avg300"]
+			case "full":
+				res.FullAvg10, res.FullAvg60, res.FullAvg300 = avgs["avg10"], avgs["avg60"], avgs["avg300"]
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, xerrors.Errorf("cannot read %s: %w", path, err)
+		}
+		return &res, nil
+	}
+	return nil, xerrors.Errorf("none of %s exists", strings.Join(paths, ", "))
+}
+
+// resolveNetworkStatus sums up the traffic of all network interfaces of the workspace except loopback.
+func resolveNetworkStatus(path string) (*api.NetworkStatus, error) {
+	stats, err := linuxproc.ReadNetworkStat(path)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read %s: %w", path, err)
+	}
+	var res api.NetworkStatus
+	for _, stat := range stats {
+		if stat.Iface == "" || stat.Iface == "lo" {
+			continue
+		}
+		res.ReceivedBytes += stat.RxBytes
+		res.SentBytes += stat.TxBytes
+	}
+	return &res, nil
+}
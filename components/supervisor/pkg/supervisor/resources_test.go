@@ -0,0 +1,157 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+type fakeProcess struct {
+	PID     int
+	PPID    int
+	Comm    string
+	Cmdline string
+	Ticks   uint64
+	RSS     int64
+}
+
+func writeFakeProcesses(t *testing.T, procDir string, procs []fakeProcess) {
+	for _, p := range procs {
+		dir := filepath.Join(procDir, strconv.Itoa(p.PID))
+		err := os.MkdirAll(dir, 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stat := fmt.Sprintf("%d (%s) S %d 0 0 0 0 0 0 0 0 0 %d 0 0 0 20 0 1 0 0 0 %d\n", p.PID, p.Comm, p.PPID, p.Ticks, p.RSS)
+		err = os.WriteFile(filepath.Join(dir, "stat"), []byte(stat), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(dir, "cmdline"), []byte(p.Cmdline), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestProcessSampler(t *testing.T) {
+	var (
+		procDir  = t.TempDir()
+		pageSize = int64(os.Getpagesize())
+		now      = time.Now()
+		tasks    = map[uint64]taskRef{10: {ID: "0", Name: "backend"}}
+	)
+	sampler := &processSampler{procDir: procDir, now: func() time.Time { return now }}
+
+	procs := []fakeProcess{
+		{PID: 1, Comm: "supervisor", Cmdline: "/.supervisor/supervisor\x00run\x00", Ticks: 100, RSS: 10},
+		{PID: 10, PPID: 1, Comm: "bash", Cmdline: "/bin/bash\x00", Ticks: 0, RSS: 1},
+		{PID: 11, PPID: 10, Comm: "go", Cmdline: "go\x00run\x00.\x00", Ticks: 1000, RSS: 100},
+		{PID: 12, PPID: 1, Comm: "kworker", Ticks: 0, RSS: 0},
+	}
+	writeFakeProcesses(t, procDir, procs)
+	_, err := sampler.sample(tasks)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// one second later, the go process used half a core and the supervisor a tenth
+	now = now.Add(time.Second)
+	procs[0].Ticks += 10
+	procs[2].Ticks += 50
+	writeFakeProcesses(t, procDir, procs)
+	act, err := sampler.sample(tasks)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := []*api.ProcessStatus{
+		{Pid: 11, Command: "go run .", Cpu: 500, Memory: 100 * pageSize, TaskId: "0", TaskName: "backend"},
+		{Pid: 1, Command: "/.supervisor/supervisor run", Cpu: 100, Memory: 10 * pageSize},
+		{Pid: 10, Command: "/bin/bash", Memory: 1 * pageSize, TaskId: "0", TaskName: "backend"},
+		{Pid: 12, Command: "[kworker]"},
+	}
+	if diff := cmp.Diff(exp, act, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected processes (-want +got):\n%s", diff)
+	}
+}
+
+func TestTopProcesses(t *testing.T) {
+	procs := []*api.ProcessStatus{
+		{Pid: 1, Cpu: 10, Memory: 10},
+		{Pid: 2, Cpu: 500, Memory: 1},
+		{Pid: 3, Cpu: 0, Memory: 1000},
+		{Pid: 4, Cpu: 1, Memory: 2},
+		{Pid: 5, Cpu: 0, Memory: 0},
+	}
+	var act []int64
+	for _, p := range topProcesses(procs, 1) {
+		act = append(act, p.Pid)
+	}
+	// the process using the most CPU and the one using the most memory, ordered by CPU
+	if diff := cmp.Diff([]int64{2, 3}, act); diff != "" {
+		t.Errorf("unexpected top processes (-want +got):\n%s", diff)
+	}
+}
+
+func TestResolveIOPressure(t *testing.T) {
+	dir := t.TempDir()
+	fn := filepath.Join(dir, "io.pressure")
+	err := os.WriteFile(fn, []byte("some avg10=1.50 avg60=0.75 avg300=0.10 total=12345\nfull avg10=0.50 avg60=0.25 avg300=0.00 total=678\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	act, err := resolveIOPressure(filepath.Join(dir, "does-not-exist"), fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := &api.PressureStatus{
+		SomeAvg10:  1.5,
+		SomeAvg60:  0.75,
+		SomeAvg300: 0.1,
+		FullAvg10:  0.5,
+		FullAvg60:  0.25,
+	}
+	if diff := cmp.Diff(exp, act, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected IO pressure (-want +got):\n%s", diff)
+	}
+
+	_, err = resolveIOPressure(filepath.Join(dir, "does-not-exist"))
+	if err == nil {
+		t.Error("expected an error if no pressure information is available")
+	}
+}
+
+func TestResolveNetworkStatus(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "dev")
+	err := os.WriteFile(fn, []byte(`Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo: 1000000     100    0    0    0     0          0         0  1000000     100    0    0    0     0       0          0
+  eth0: 2000       20    0    0    0     0          0         0     3000      30    0    0    0     0       0          0
+  eth1:100 1 0 0 0 0 0 0 200 2 0 0 0 0 0 0
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	act, err := resolveNetworkStatus(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&api.NetworkStatus{ReceivedBytes: 2100, SentBytes: 3200}, act, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected network status (-want +got):\n%s", diff)
+	}
+}
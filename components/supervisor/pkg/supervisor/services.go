@@ -7,6 +7,7 @@ package supervisor
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
 	"os"
@@ -24,6 +25,7 @@ import (
 
 	"github.com/gitpod-io/gitpod/common-go/log"
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/pkg/initializer"
 	"github.com/gitpod-io/gitpod/supervisor/api"
 	"github.com/gitpod-io/gitpod/supervisor/pkg/ports"
 )
@@ -95,9 +97,11 @@ type statusService struct {
 	ContentState    ContentState
 	Ports           *ports.Manager
 	Tasks           *tasksManager
+	Services        *servicesManager
 	ideReady        *ideReadyState
 	desktopIdeReady *ideReadyState
 	topService      *TopService
+	dotfiles        *dotfilesStatus
 
 	api.UnimplementedStatusServiceServer
 }
@@ -188,9 +192,14 @@ func (s *statusService) ContentStatus(ctx context.Context, req *api.ContentStatu
 
 	src, ok := cs.ContentSource()
 	if !ok {
-		return &api.ContentStatusResponse{
+		res := &api.ContentStatusResponse{
 			Available: false,
-		}, nil
+		}
+		if p := cs.ContentProgress(); p != nil {
+			res.ProgressPhase = p.Phase
+			res.ProgressPercent = int32(p.Percent)
+		}
+		return res, nil
 	}
 
 	return &api.ContentStatusResponse{
@@ -199,6 +208,25 @@ func (s *statusService) ContentStatus(ctx context.Context, req *api.ContentStatu
 	}, nil
 }
 
+// DotfilesStatus provides feedback regarding the installation of the dotfiles.
+func (s *statusService) DotfilesStatus(ctx context.Context, req *api.DotfilesStatusRequest) (*api.DotfilesStatusResponse, error) {
+	if s.dotfiles == nil {
+		return &api.DotfilesStatusResponse{}, nil
+	}
+	if req.Wait {
+		select {
+		case <-s.dotfiles.Done():
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return nil, status.Error(codes.Canceled, "Context canceled")
+			}
+
+			return nil, status.Error(codes.DeadlineExceeded, ctx.Err().Error())
+		}
+	}
+	return s.dotfiles.Get(), nil
+}
+
 func (s *statusService) BackupStatus(ctx context.Context, req *api.BackupStatusRequest) (*api.BackupStatusResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "not implemented")
 }
@@ -272,6 +300,35 @@ func (s *statusService) TasksStatus(req *api.TasksStatusRequest, srv api.StatusS
 	}
 }
 
+func (s *statusService) ServicesStatus(req *api.ServicesStatusRequest, srv api.StatusService_ServicesStatusServer) error {
+	if !req.Observe {
+		return srv.Send(&api.ServicesStatusResponse{
+			Services: s.Services.Status(),
+		})
+	}
+
+	sub := s.Services.Subscribe()
+	if sub == nil {
+		return status.Error(codes.ResourceExhausted, "too many subscriptions")
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case update := <-sub.Updates():
+			if update == nil {
+				return nil
+			}
+			err := srv.Send(&api.ServicesStatusResponse{Services: update})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // RegistrableTokenService can register the token service.
 type RegistrableTokenService struct {
 	Service api.TokenServiceServer
@@ -697,6 +754,7 @@ func (is *InfoService) WorkspaceInfo(context.Context, *api.WorkspaceInfoRequest)
 // ControlService implements the supervisor control service.
 type ControlService struct {
 	portsManager *ports.Manager
+	services     *servicesManager
 
 	privateKey string
 	publicKey  string
@@ -715,6 +773,18 @@ func (c *ControlService) ExposePort(ctx context.Context, req *api.ExposePortRequ
 	return &api.ExposePortResponse{}, err
 }
 
+// RestartService restarts a service of the workspace.
+func (c *ControlService) RestartService(ctx context.Context, req *api.RestartServiceRequest) (*api.RestartServiceResponse, error) {
+	if c.services == nil {
+		return nil, status.Errorf(codes.NotFound, "service %s does not exist", req.Name)
+	}
+	err := c.services.Restart(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &api.RestartServiceResponse{}, nil
+}
+
 // CreateSSHKeyPair create a ssh key pair for the workspace.
 func (ss *ControlService) CreateSSHKeyPair(context.Context, *api.CreateSSHKeyPairRequest) (response *api.CreateSSHKeyPairResponse, err error) {
 	home := "/home/gitpod/"
@@ -781,12 +851,14 @@ type ContentState interface {
 	MarkContentReady(src csapi.WorkspaceInitSource)
 	ContentReady() <-chan struct{}
 	ContentSource() (src csapi.WorkspaceInitSource, ok bool)
+	ContentProgress() *csapi.WorkspaceInitProgress
 }
 
 // NewInMemoryContentState creates a new InMemoryContentState.
 func NewInMemoryContentState(checkoutLocation string) *InMemoryContentState {
 	return &InMemoryContentState{
 		checkoutLocation: checkoutLocation,
+		progressFile:     filepath.Join("/workspace", initializer.WorkspaceProgressFile),
 		contentReadyChan: make(chan struct{}),
 	}
 }
@@ -794,6 +866,7 @@ func NewInMemoryContentState(checkoutLocation string) *InMemoryContentState {
 // InMemoryContentState implements the ContentState interface in-memory.
 type InMemoryContentState struct {
 	checkoutLocation string
+	progressFile     string
 
 	contentReadyChan chan struct{}
 	contentSource    csapi.WorkspaceInitSource
@@ -822,6 +895,26 @@ func (state *InMemoryContentState) ContentSource() (src csapi.WorkspaceInitSourc
 	return state.contentSource, true
 }
 
+// ContentProgress returns the progress of the content initialization reported by the initializer,
+// or nil if there is none.
+func (state *InMemoryContentState) ContentProgress() *csapi.WorkspaceInitProgress {
+	if _, ok := state.ContentSource(); ok {
+		return nil
+	}
+
+	b, err := os.ReadFile(state.progressFile)
+	if err != nil {
+		return nil
+	}
+	var p csapi.WorkspaceInitProgress
+	err = json.Unmarshal(b, &p)
+	if err != nil {
+		log.WithError(err).Debug("cannot unmarshal content progress file")
+		return nil
+	}
+	return &p
+}
+
 type portService struct {
 	portsManager *ports.Manager
 
@@ -6,6 +6,8 @@ package supervisor
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/supervisor/api"
 )
 
@@ -384,3 +387,57 @@ type tokenProviderFunc func(ctx context.Context, req *api.GetTokenRequest) (tkn
 func (f tokenProviderFunc) GetToken(ctx context.Context, req *api.GetTokenRequest) (tkn *Token, err error) {
 	return f(ctx, req)
 }
+
+func TestContentStatus(t *testing.T) {
+	tests := []struct {
+		Desc        string
+		Progress    string
+		Ready       bool
+		Expectation *api.ContentStatusResponse
+	}{
+		{
+			Desc:        "no progress",
+			Expectation: &api.ContentStatusResponse{},
+		},
+		{
+			Desc:        "clone in progress",
+			Progress:    `{"phase":"Receiving objects","percent":42}`,
+			Expectation: &api.ContentStatusResponse{ProgressPhase: "Receiving objects", ProgressPercent: 42},
+		},
+		{
+			Desc:        "broken progress file",
+			Progress:    `{"phase":`,
+			Expectation: &api.ContentStatusResponse{},
+		},
+		{
+			Desc:        "content ready",
+			Progress:    `{"phase":"Receiving objects","percent":100}`,
+			Ready:       true,
+			Expectation: &api.ContentStatusResponse{Available: true, Source: api.ContentSource_from_other},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			cs := NewInMemoryContentState("")
+			cs.progressFile = filepath.Join(t.TempDir(), "progress")
+			if test.Progress != "" {
+				err := os.WriteFile(cs.progressFile, []byte(test.Progress), 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			if test.Ready {
+				cs.MarkContentReady(csapi.WorkspaceInitFromOther)
+			}
+
+			svc := &statusService{ContentState: cs}
+			act, err := svc.ContentStatus(context.Background(), &api.ContentStatusRequest{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.Expectation, act, cmpopts.IgnoreUnexported(api.ContentStatusResponse{})); diff != "" {
+				t.Errorf("unexpected content status (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
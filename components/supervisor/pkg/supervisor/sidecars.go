@@ -0,0 +1,419 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+const (
+	// servicesLocation is where ws-manager shares the state and logs of the service containers with the workspace.
+	// Each service has a directory named like the service which contains its state, log and restart files.
+	servicesLocation = "/.services"
+
+	// servicesHost is the address of the pod network namespace as seen from the workspace, i.e. the address
+	// the service containers can be reached at.
+	servicesHost = "10.0.5.1"
+
+	defaultServiceProbePeriod = 5 * time.Second
+)
+
+type servicesSubscription struct {
+	updates chan []*api.ServiceStatus
+	Close   func() error
+
+	// closeLocked closes the subscription, callers are expected to hold the manager's mu
+	closeLocked func()
+}
+
+func (sub *servicesSubscription) Updates() <-chan []*api.ServiceStatus {
+	return sub.updates
+}
+
+type service struct {
+	api.ServiceStatus
+	config    ServiceConfig
+	lastProbe time.Time
+}
+
+// servicesManager reports the status of the service containers ws-manager runs next to the workspace.
+// The containers run their service in a wrapper which writes the state of the service to a file,
+// restarts the service once supervisor places a restart file and stops once supervisor places a stop file.
+type servicesManager struct {
+	// location is the directory the service containers share their state and logs in
+	location string
+	// host is the address the services are probed and forwarded to
+	host string
+	// probeTimeout is the timeout of a single readiness probe
+	probeTimeout time.Duration
+
+	services      []*service
+	subscriptions map[*servicesSubscription]struct{}
+	mu            sync.RWMutex
+}
+
+func newServicesManager(config *Config) *servicesManager {
+	sm := &servicesManager{
+		location:      servicesLocation,
+		host:          servicesHost,
+		probeTimeout:  time.Second,
+		subscriptions: make(map[*servicesSubscription]struct{}),
+	}
+
+	cfgs, err := config.getGitpodServices()
+	if err != nil {
+		log.WithError(err).Error("cannot read services config")
+	}
+	for _, cfg := range cfgs {
+		sm.services = append(sm.services, &service{
+			ServiceStatus: api.ServiceStatus{
+				Name:  cfg.Name,
+				Image: cfg.Image,
+				State: api.ServiceState_pending,
+			},
+			config: cfg,
+		})
+	}
+	return sm
+}
+
+// Run observes the state of the services and forwards their ports to localhost until the context is canceled.
+func (sm *servicesManager) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	if len(sm.services) == 0 {
+		return
+	}
+
+	for _, s := range sm.services {
+		for _, port := range s.config.Ports {
+			go sm.forwardPort(ctx, s.Name, port)
+		}
+	}
+
+	t := time.NewTicker(time.Second)
+	defer t.Stop()
+	for {
+		sm.update(ctx, time.Now())
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// serviceState is the state of a service as written by the service wrapper.
+type serviceState struct {
+	State        api.ServiceState
+	RestartCount int32
+	LastExitCode int32
+}
+
+// update reads the state of all services and probes the readiness of those which are due.
+func (sm *servicesManager) update(ctx context.Context, now time.Time) {
+	type probed struct {
+		ready bool
+		probe bool
+	}
+
+	results := make([]probed, len(sm.services))
+	states := make([]serviceState, len(sm.services))
+	for i, s := range sm.services {
+		sm.mu.RLock()
+		states[i] = serviceState{State: s.State, RestartCount: s.RestartCount, LastExitCode: s.LastExitCode}
+		started, due := s.State == api.ServiceState_started, sm.probeDue(s, now)
+		sm.mu.RUnlock()
+
+		err := readServiceState(filepath.Join(sm.location, s.Name, "state"), &states[i])
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.WithError(err).WithField("service", s.Name).Warn("cannot read service state")
+		}
+		if states[i].State != api.ServiceState_started {
+			continue
+		}
+		if s.config.ReadinessProbe == nil {
+			results[i] = probed{ready: true, probe: true}
+			continue
+		}
+		if !started || due {
+			results[i] = probed{ready: sm.probe(ctx, s.config.ReadinessProbe), probe: true}
+		}
+	}
+
+	sm.updateState(func() (changed bool) {
+		for i, s := range sm.services {
+			st := states[i]
+			if s.State != st.State || s.RestartCount != st.RestartCount || s.LastExitCode != st.LastExitCode {
+				s.State, s.RestartCount, s.LastExitCode = st.State, st.RestartCount, st.LastExitCode
+				changed = true
+			}
+
+			ready := s.Ready
+			if st.State != api.ServiceState_started {
+				ready = false
+			} else if results[i].probe {
+				ready = results[i].ready
+				s.lastProbe = now
+			}
+			if s.Ready != ready {
+				s.Ready = ready
+				changed = true
+			}
+		}
+		return changed
+	})
+}
+
+func (sm *servicesManager) probeDue(s *service, now time.Time) bool {
+	period := defaultServiceProbePeriod
+	if p := s.config.ReadinessProbe; p != nil && p.PeriodSeconds > 0 {
+		period = time.Duration(p.PeriodSeconds) * time.Second
+	}
+	return now.Sub(s.lastProbe) >= period
+}
+
+// probe returns true if the service accepts connections on the probe's port or, if the probe has a path,
+// answers an HTTP GET request successfully.
+func (sm *servicesManager) probe(ctx context.Context, probe *ServiceReadinessProbe) bool {
+	ctx, cancel := context.WithTimeout(ctx, sm.probeTimeout)
+	defer cancel()
+
+	addr := net.JoinHostPort(sm.host, strconv.Itoa(probe.Port))
+	if probe.Path == "" {
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}
+
+	path := probe.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+addr+path, nil)
+	if err != nil {
+		return false
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return resp.StatusCode >= 200 && resp.StatusCode < 400
+}
+
+// readServiceState reads a state file written by the service wrapper of ws-manager. The file contains either
+// "running <restarts> <since>" or "exited <restarts> <exit code> <since>".
+func readServiceState(fn string, st *serviceState) error {
+	content, err := os.ReadFile(fn)
+	if err != nil {
+		return err
+	}
+
+	fields := strings.Fields(string(content))
+	if len(fields) < 2 {
+		// the wrapper may be writing the file at this very moment
+		return nil
+	}
+	restarts, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return xerrors.Errorf("invalid restart count %q", fields[1])
+	}
+	switch fields[0] {
+	case "running":
+		st.State = api.ServiceState_started
+	case "exited":
+		if len(fields) < 3 {
+			return nil
+		}
+		code, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return xerrors.Errorf("invalid exit code %q", fields[2])
+		}
+		st.State = api.ServiceState_exited
+		st.LastExitCode = int32(code)
+	default:
+		return xerrors.Errorf("unknown state %q", fields[0])
+	}
+	st.RestartCount = int32(restarts)
+	return nil
+}
+
+// forwardPort makes a service available on localhost in the workspace, like it would be if the service ran
+// in the workspace itself.
+func (sm *servicesManager) forwardPort(ctx context.Context, name string, port int) {
+	var lc net.ListenConfig
+	l, err := lc.Listen(ctx, "tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		log.WithError(err).WithField("service", name).WithField("port", port).Warn("cannot forward service port to localhost")
+		return
+	}
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
+
+	target := net.JoinHostPort(sm.host, strconv.Itoa(port))
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer conn.Close()
+
+			var d net.Dialer
+			upstream, err := d.DialContext(ctx, "tcp", target)
+			if err != nil {
+				log.WithError(err).WithField("service", name).WithField("port", port).Debug("cannot connect to service")
+				return
+			}
+			defer upstream.Close()
+
+			done := make(chan struct{}, 2)
+			go func() {
+				_, _ = io.Copy(upstream, conn)
+				done <- struct{}{}
+			}()
+			go func() {
+				_, _ = io.Copy(conn, upstream)
+				done <- struct{}{}
+			}()
+			<-done
+		}()
+	}
+}
+
+// Restart asks the wrapper of a service to restart it.
+func (sm *servicesManager) Restart(name string) error {
+	sm.mu.RLock()
+	var found bool
+	for _, s := range sm.services {
+		if s.Name == name {
+			found = true
+			break
+		}
+	}
+	sm.mu.RUnlock()
+	if !found {
+		return status.Errorf(codes.NotFound, "service %s does not exist", name)
+	}
+
+	err := os.WriteFile(filepath.Join(sm.location, name, "restart"), nil, 0666)
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot restart service %s: %v", name, err)
+	}
+	return nil
+}
+
+// Stop asks the wrappers of all services to stop them. The service containers exit thereafter, which lets
+// the pods of headless workspaces complete.
+func (sm *servicesManager) Stop() {
+	if len(sm.services) == 0 {
+		return
+	}
+	err := os.WriteFile(filepath.Join(sm.location, "stop"), nil, 0666)
+	if err != nil {
+		log.WithError(err).Warn("cannot stop services")
+	}
+}
+
+func (sm *servicesManager) Subscribe() *servicesSubscription {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if len(sm.subscriptions) > maxSubscriptions {
+		return nil
+	}
+
+	var once sync.Once
+	sub := &servicesSubscription{updates: make(chan []*api.ServiceStatus, 5)}
+	sub.closeLocked = func() {
+		once.Do(func() {
+			// We can safely close the channel here even though we're not the
+			// producer writing to it, because we're holding mu.
+			close(sub.updates)
+			delete(sm.subscriptions, sub)
+		})
+	}
+	sub.Close = func() error {
+		sm.mu.Lock()
+		defer sm.mu.Unlock()
+
+		sub.closeLocked()
+		return nil
+	}
+	sm.subscriptions[sub] = struct{}{}
+
+	// makes sure that no updates can happen between clients receiving an initial status and subscribing
+	sub.updates <- sm.getStatus()
+	return sub
+}
+
+func (sm *servicesManager) Status() []*api.ServiceStatus {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	return sm.getStatus()
+}
+
+// getStatus produces an API compatible service status list.
+// Callers are expected to hold mu.
+func (sm *servicesManager) getStatus() []*api.ServiceStatus {
+	res := make([]*api.ServiceStatus, 0, len(sm.services))
+	for _, s := range sm.services {
+		res = append(res, &api.ServiceStatus{
+			Name:         s.Name,
+			Image:        s.Image,
+			State:        s.State,
+			Ready:        s.Ready,
+			RestartCount: s.RestartCount,
+			LastExitCode: s.LastExitCode,
+			LogPath:      filepath.Join(sm.location, s.Name, "log"),
+		})
+	}
+	return res
+}
+
+func (sm *servicesManager) updateState(doUpdate func() (changed bool)) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	changed := doUpdate()
+	if !changed {
+		return
+	}
+
+	updates := sm.getStatus()
+	for sub := range sm.subscriptions {
+		select {
+		case sub.updates <- updates:
+		case <-time.After(5 * time.Second):
+			log.Error("services subscription droped out")
+			sub.closeLocked()
+		}
+	}
+}
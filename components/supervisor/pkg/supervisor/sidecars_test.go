@@ -0,0 +1,170 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+func TestReadServiceState(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Content     string
+		Expectation serviceState
+		Error       bool
+	}{
+		{
+			Name:        "running",
+			Content:     "running 0 1666000000\n",
+			Expectation: serviceState{State: api.ServiceState_started, LastExitCode: 1},
+		},
+		{
+			Name:        "restarted",
+			Content:     "running 2 1666000000\n",
+			Expectation: serviceState{State: api.ServiceState_started, RestartCount: 2, LastExitCode: 1},
+		},
+		{
+			Name:        "exited",
+			Content:     "exited 2 137 1666000000\n",
+			Expectation: serviceState{State: api.ServiceState_exited, RestartCount: 2, LastExitCode: 137},
+		},
+		{
+			Name:        "partially written",
+			Content:     "exi",
+			Expectation: serviceState{LastExitCode: 1},
+		},
+		{
+			Name:        "unknown state",
+			Content:     "sleeping 0 1666000000\n",
+			Expectation: serviceState{LastExitCode: 1},
+			Error:       true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			fn := filepath.Join(t.TempDir(), "state")
+			err := os.WriteFile(fn, []byte(test.Content), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the last exit code of a previous run is kept while the service is running
+			act := serviceState{LastExitCode: 1}
+			err = readServiceState(fn, &act)
+			if (err != nil) != test.Error {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected state (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServicesManager(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	port := l.Addr().(*net.TCPAddr).Port
+	closedPort := func() int {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer l.Close()
+		return l.Addr().(*net.TCPAddr).Port
+	}()
+
+	location := t.TempDir()
+	for _, name := range []string{"db", "cache", "queue", "search"} {
+		err := os.MkdirAll(filepath.Join(location, name), 0777)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeState := func(name, content string) {
+		err := os.WriteFile(filepath.Join(location, name, "state"), []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeState("db", "running 0 1666000000")
+	writeState("cache", "running 1 1666000000")
+	writeState("queue", "exited 3 1 1666000000")
+
+	sm := newServicesManager(&Config{WorkspaceConfig: WorkspaceConfig{
+		GitpodServices: `[
+			{"name":"db","image":"postgres:14","readinessProbe":{"port":` + strconv.Itoa(port) + `}},
+			{"name":"cache","image":"redis:7","readinessProbe":{"port":` + strconv.Itoa(closedPort) + `}},
+			{"name":"queue","image":"rabbitmq:3"},
+			{"name":"search","image":"elasticsearch:8"}
+		]`,
+	}})
+	sm.location = location
+	sm.host = "127.0.0.1"
+
+	sub := sm.Subscribe()
+	defer sub.Close()
+	<-sub.Updates()
+
+	sm.update(context.Background(), time.Now())
+	expectation := []*api.ServiceStatus{
+		{Name: "db", Image: "postgres:14", State: api.ServiceState_started, Ready: true, LogPath: filepath.Join(location, "db", "log")},
+		{Name: "cache", Image: "redis:7", State: api.ServiceState_started, RestartCount: 1, LogPath: filepath.Join(location, "cache", "log")},
+		{Name: "queue", Image: "rabbitmq:3", State: api.ServiceState_exited, RestartCount: 3, LastExitCode: 1, LogPath: filepath.Join(location, "queue", "log")},
+		{Name: "search", Image: "elasticsearch:8", State: api.ServiceState_pending, LogPath: filepath.Join(location, "search", "log")},
+	}
+	if diff := cmp.Diff(expectation, sm.Status(), protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected status (-want +got):\n%s", diff)
+	}
+	select {
+	case update := <-sub.Updates():
+		if diff := cmp.Diff(expectation, update, protocmp.Transform()); diff != "" {
+			t.Errorf("unexpected update (-want +got):\n%s", diff)
+		}
+	default:
+		t.Error("expected subscribers to be notified about the change")
+	}
+
+	// a service without a readiness probe is ready once it runs
+	writeState("queue", "running 4 1666000001")
+	sm.update(context.Background(), time.Now())
+	if st := sm.Status()[2]; !st.Ready || st.LastExitCode != 1 {
+		t.Errorf("expected the restarted service to be ready and keep its last exit code, but got %v", st)
+	}
+
+	err = sm.Restart("cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(location, "cache", "restart")); err != nil {
+		t.Errorf("expected a restart file: %v", err)
+	}
+	err = sm.Restart("unknown")
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected restarting an unknown service to fail with NotFound, but got %v", err)
+	}
+
+	sm.Stop()
+	if _, err := os.Stat(filepath.Join(location, "stop")); err != nil {
+		t.Errorf("expected a stop file: %v", err)
+	}
+}
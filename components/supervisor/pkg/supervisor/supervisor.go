@@ -12,7 +12,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"io/fs"
 	"io/ioutil"
 	"math"
 	"net"
@@ -242,34 +241,17 @@ func Run(options ...RunOption) {
 
 	portMgmt := ports.NewManager(
 		createExposedPortsImpl(cfg, gitpodService),
-		&ports.PollingServedPortsObserver{
-			RefreshInterval: 2 * time.Second,
+		&ports.NetlinkServedPortsObserver{
+			RefreshInterval: 500 * time.Millisecond,
+			Fallback: &ports.PollingServedPortsObserver{
+				RefreshInterval: 2 * time.Second,
+			},
 		},
 		ports.NewConfigService(cfg.WorkspaceID, gitpodConfigService, gitpodService),
 		tunneledPortsService,
 		internalPorts...,
 	)
 
-	topService := NewTopService()
-	topService.Observe(ctx)
-
-	var metricsReporter *metrics.GrpcMetricsReporter
-	if opts.RunGP {
-		cstate.MarkContentReady(csapi.WorkspaceInitFromOther)
-	} else {
-		analytics := analytics.NewFromEnvironment()
-		defer analytics.Close()
-		go analyseConfigChanges(ctx, cfg, analytics, gitpodConfigService, gitpodService)
-		go analysePerfChanges(ctx, cfg, analytics, topService, gitpodService)
-
-		_, gitpodHost, err := cfg.GitpodAPIEndpoint()
-		if err != nil {
-			log.WithError(err).Error("supervisor: grpc metrics: failed to parse gitpod host")
-		} else {
-			metricsReporter = metrics.NewGrpcMetricsReporter(gitpodHost)
-		}
-	}
-
 	termMux := terminal.NewMux()
 	termMuxSrv := terminal.NewMuxTerminalService(termMux)
 	termMuxSrv.DefaultWorkdir = cfg.RepoRoot
@@ -286,35 +268,96 @@ func Run(options ...RunOption) {
 		}
 	}
 	termMuxSrv.Env = childProcEnvvars
+	var env *liveEnv
+	if gitpodService != nil && !cfg.isHeadless() && envVarRepositoryPattern(cfg) != "" {
+		env = newLiveEnv(childProcEnvvars, cfg.getUserEnvvars(), gitpodService)
+		termMuxSrv.EnvProvider = env.Env
+	}
 	termMuxSrv.DefaultCreds = &syscall.Credential{
 		Uid: gitpodUID,
 		Gid: gitpodGID,
 	}
 
-	taskManager := newTasksManager(cfg, termMuxSrv, cstate, nil)
+	taskManager := newTasksManager(cfg, termMuxSrv, cstate, nil, notificationService)
+	servicesManager := newServicesManager(cfg)
+	if env != nil {
+		var cancelOffer context.CancelFunc
+		env.OnChange = func(ctx context.Context, changed []string) {
+			// a newer change supersedes the offer to restart tasks for the previous one
+			if cancelOffer != nil {
+				cancelOffer()
+			}
+			var offerCtx context.Context
+			offerCtx, cancelOffer = context.WithCancel(ctx)
+			go offerTaskRestart(offerCtx, notificationService, taskManager, env, changed)
+		}
+		go env.Run(ctx)
+	}
+
+	// /workspace is the XFS project quota directory of the workspace
+	topService := NewTopService("/workspace", taskManager.terminalProcesses)
+	topService.Observe(ctx)
+
+	var metricsReporter *metrics.GrpcMetricsReporter
+	if opts.RunGP {
+		cstate.MarkContentReady(csapi.WorkspaceInitFromOther)
+	} else {
+		analytics := analytics.NewFromEnvironment()
+		defer analytics.Close()
+		go analyseConfigChanges(ctx, cfg, analytics, gitpodConfigService, gitpodService)
+		go analysePerfChanges(ctx, cfg, analytics, topService, gitpodService)
 
+		_, gitpodHost, err := cfg.GitpodAPIEndpoint()
+		if err != nil {
+			log.WithError(err).Error("supervisor: grpc metrics: failed to parse gitpod host")
+		} else {
+			metricsReporter = metrics.NewGrpcMetricsReporter(gitpodHost)
+		}
+	}
+
+	dotfiles := newDotfilesStatus()
+	syncService := NewSyncService(syncBarriersLocation)
+	go func() {
+		<-cstate.ContentReady()
+		err := syncService.Load()
+		if err != nil {
+			log.WithError(err).Warn("cannot load sync barriers")
+		}
+	}()
 	apiServices := []RegisterableService{
 		&statusService{
 			ContentState:    cstate,
 			Ports:           portMgmt,
 			Tasks:           taskManager,
+			Services:        servicesManager,
 			ideReady:        ideReady,
 			desktopIdeReady: desktopIdeReady,
 			topService:      topService,
+			dotfiles:        dotfiles,
 		},
 		termMuxSrv,
 		RegistrableTokenService{Service: tokenService},
 		notificationService,
 		&InfoService{cfg: cfg, ContentState: cstate},
-		&ControlService{portsManager: portMgmt},
+		&ControlService{portsManager: portMgmt, services: servicesManager},
 		&portService{portsManager: portMgmt},
+		syncService,
 	}
 	apiServices = append(apiServices, additionalServices...)
 
+	var (
+		wg       sync.WaitGroup
+		shutdown = make(chan ShutdownReason, 1)
+	)
+	wg.Add(1)
+	go startContentInit(ctx, cfg, &wg, cstate)
+	// the API endpoint is started before the dotfiles are installed to report the installation progress
+	wg.Add(1)
+	go startAPIEndpoint(ctx, cfg, &wg, apiServices, tunneledPortsService, metricsReporter, apiEndpointOpts...)
+
 	if !cfg.isHeadless() {
 		// We need to checkout dotfiles first, because they may be changing the path which affects the IDE.
-		// TODO(cw): provide better feedback if the IDE start fails because of the dotfiles (provide any feedback at all).
-		installDotfiles(ctx, cfg, tokenService, childProcEnvvars)
+		installDotfiles(ctx, cfg, tokenService, childProcEnvvars, dotfiles)
 	}
 
 	var ideWG sync.WaitGroup
@@ -325,20 +368,14 @@ func Run(options ...RunOption) {
 		go startAndWatchIDE(ctx, cfg, cfg.DesktopIDE, childProcEnvvars, &ideWG, cstate, desktopIdeReady, DesktopIDE)
 	}
 
-	var (
-		wg       sync.WaitGroup
-		shutdown = make(chan ShutdownReason, 1)
-	)
-	wg.Add(1)
-	go startContentInit(ctx, cfg, &wg, cstate)
-	wg.Add(1)
-	go startAPIEndpoint(ctx, cfg, &wg, apiServices, tunneledPortsService, metricsReporter, apiEndpointOpts...)
 	wg.Add(1)
 	go startSSHServer(ctx, cfg, &wg, childProcEnvvars)
 	wg.Add(1)
 	tasksSuccessChan := make(chan taskSuccess, 1)
 	go taskManager.Run(ctx, &wg, tasksSuccessChan)
 	wg.Add(1)
+	go servicesManager.Run(ctx, &wg)
+	wg.Add(1)
 	go socketActivationForDocker(ctx, &wg, termMux)
 
 	if cfg.isHeadless() {
@@ -373,6 +410,10 @@ func Run(options ...RunOption) {
 				if !isShallowRepository(repoRoot, childProcEnvvars) {
 					return
 				}
+				if hasExplicitCloneDepth(repoRoot, childProcEnvvars) {
+					log.WithField("repoRoot", repoRoot).Debug("not unshallowing repository cloned with an explicit depth")
+					return
+				}
 
 				cmd := runAsGitpodUser(exec.Command("git", "fetch", "--unshallow", "--tags"))
 				cmd.Env = childProcEnvvars
@@ -407,6 +448,8 @@ func Run(options ...RunOption) {
 	}
 
 	wg.Wait()
+	// the service containers exit once their services are stopped, which completes the pods of headless workspaces
+	servicesManager.Stop()
 }
 
 func isShallowRepository(rootDir string, env []string) bool {
@@ -428,177 +471,18 @@ func isShallowRepository(rootDir string, env []string) bool {
 	return isShallow
 }
 
-func installDotfiles(ctx context.Context, cfg *Config, tokenService *InMemoryTokenService, childProcEnvvars []string) {
-	repo := cfg.DotfileRepo
-	if repo == "" {
-		return
-	}
-
-	const dotfilePath = "/home/gitpod/.dotfiles"
-	if _, err := os.Stat(dotfilePath); err == nil {
-		// dotfile path exists already - nothing to do here
-		return
-	}
-
-	prep := func(cfg *Config, out io.Writer, name string, args ...string) *exec.Cmd {
-		cmd := exec.Command(name, args...)
-		cmd.Dir = "/home/gitpod"
-		cmd.Env = childProcEnvvars
-		cmd.SysProcAttr = &syscall.SysProcAttr{
-			// All supervisor children run as gitpod user. The environment variables we produce are also
-			// gitpod user specific.
-			Credential: &syscall.Credential{
-				Uid: gitpodUID,
-				Gid: gitpodGID,
-			},
-		}
-		cmd.Stdout = out
-		cmd.Stderr = out
-		return cmd
-	}
-
-	err := func() (err error) {
-		out, err := os.OpenFile("/home/gitpod/.dotfiles.log", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
-		if err != nil {
-			return err
-		}
-		defer out.Close()
-
-		defer func() {
-			if err != nil {
-				out.WriteString(fmt.Sprintf("# dotfile init failed: %s\n", err.Error()))
-			}
-		}()
-
-		done := make(chan error, 1)
-		go func() {
-			repoUrl, err := url.Parse(repo)
-			if err != nil {
-				done <- err
-				close(done)
-				return
-			}
-			authProvider := func() (username string, password string, err error) {
-				resp, err := tokenService.GetToken(ctx, &api.GetTokenRequest{
-					Host: repoUrl.Host,
-					Kind: KindGit,
-				})
-				if err != nil {
-					return
-				}
-				username = resp.User
-				password = resp.Token
-				return
-			}
-			client := &git.Client{
-				AuthProvider: authProvider,
-				AuthMethod:   git.BasicAuth,
-				Location:     dotfilePath,
-				RemoteURI:    repo,
-			}
-			done <- client.Clone(ctx)
-			close(done)
-		}()
-		select {
-		case err := <-done:
-			if err != nil {
-				return err
-			}
-		case <-time.After(120 * time.Second):
-			return xerrors.Errorf("dotfiles repo clone did not finish within two minutes")
-		}
-
-		filepath.Walk(dotfilePath, func(name string, info os.FileInfo, err error) error {
-			if err == nil {
-				err = os.Chown(name, gitpodUID, gitpodGID)
-			}
-			return err
-		})
-
-		// at this point we have the dotfile repo cloned, let's try and install it
-		var candidates = []string{
-			"install.sh",
-			"install",
-			"bootstrap.sh",
-			"bootstrap",
-			"script/bootstrap",
-			"setup.sh",
-			"setup",
-			"script/setup",
-		}
-		for _, c := range candidates {
-			fn := filepath.Join(dotfilePath, c)
-			stat, err := os.Stat(fn)
-			if err != nil {
-				_, _ = out.WriteString(fmt.Sprintf("# installation script candidate %s is not available\n", fn))
-				continue
-			}
-			if stat.IsDir() {
-				_, _ = out.WriteString(fmt.Sprintf("# installation script candidate %s is a directory\n", fn))
-				continue
-			}
-			if stat.Mode()&0111 == 0 {
-				_, _ = out.WriteString(fmt.Sprintf("# installation script candidate %s is not executable\n", fn))
-				continue
-			}
-
-			_, _ = out.WriteString(fmt.Sprintf("# executing installation script candidate %s\n", fn))
-
-			// looks like we've found a candidate, let's run it
-			cmd := prep(cfg, out, "/bin/sh", "-c", "exec "+fn)
-			err = cmd.Start()
-			if err != nil {
-				return err
-			}
-			done := make(chan error, 1)
-			go func() {
-				done <- cmd.Wait()
-				close(done)
-			}()
-
-			select {
-			case err = <-done:
-				return err
-			case <-time.After(120 * time.Second):
-				cmd.Process.Kill()
-				return xerrors.Errorf("installation process %s tool longer than 120 seconds", fn)
-			}
-		}
-
-		// no installation script candidate was found, let's try and symlink this stuff
-		err = filepath.Walk(dotfilePath, func(path string, info fs.FileInfo, err error) error {
-			if strings.Contains(path, "/.git") {
-				// don't symlink the .git directory or any of its content
-				return nil
-			}
-
-			homeFN := filepath.Join("/home/gitpod", strings.TrimPrefix(path, dotfilePath))
-			if _, err := os.Stat(homeFN); err == nil {
-				// homeFN exists already - do nothing
-				return nil
-			}
-
-			if info.IsDir() {
-				err = os.MkdirAll(homeFN, info.Mode().Perm())
-				if err != nil {
-					return err
-				}
-				return nil
-			}
-
-			// write some feedback to the terminal
-			out.WriteString(fmt.Sprintf("# echo linking %s -> %s\n", path, homeFN))
-
-			return os.Symlink(path, homeFN)
-		})
-
-		return nil
-	}()
+// hasExplicitCloneDepth returns true if the repository was deliberately cloned with a limited depth
+func hasExplicitCloneDepth(rootDir string, env []string) bool {
+	cmd := runAsGitpodUser(exec.Command("git", "config", "--get", git.CloneDepthConfig))
+	cmd.Env = env
+	cmd.Dir = rootDir
+	out, err := cmd.Output()
 	if err != nil {
-		// installing the dotfiles failed for some reason - we must tell the user
-		// TODO(cw): tell the user
-		log.WithError(err).Warn("installing dotfiles failed")
+		// git config exits with 1 if the key is not set
+		return false
 	}
+
+	return strings.TrimSpace(string(out)) != ""
 }
 
 func createGitpodService(cfg *Config, tknsrv api.TokenServiceServer) *gitpod.APIoverJSONRPC {
@@ -607,15 +491,23 @@ func createGitpodService(cfg *Config, tknsrv api.TokenServiceServer) *gitpod.API
 		log.WithError(err).Fatal("cannot find Gitpod API endpoint")
 		return nil
 	}
+	scopes := []string{
+		"function:getToken",
+		"function:openPort",
+		"function:getOpenPorts",
+		"function:guessGitTokenScopes",
+	}
+	if pattern := envVarRepositoryPattern(cfg); pattern != "" {
+		// allows to keep the user environment variables of the workspace up to date
+		scopes = append(scopes,
+			"function:getEnvVars",
+			"resource:envVar::"+pattern+"::get",
+		)
+	}
 	tknres, err := tknsrv.GetToken(context.Background(), &api.GetTokenRequest{
-		Kind: KindGitpod,
-		Host: host,
-		Scope: []string{
-			"function:getToken",
-			"function:openPort",
-			"function:getOpenPorts",
-			"function:guessGitTokenScopes",
-		},
+		Kind:  KindGitpod,
+		Host:  host,
+		Scope: scopes,
 	})
 	if err != nil {
 		log.WithError(err).Error("cannot get token for Gitpod API")
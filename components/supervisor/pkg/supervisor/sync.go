@@ -0,0 +1,292 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+// syncBarriersLocation is the file the barriers which are done are persisted in. It is part of the workspace
+// content, hence it survives restarts and is handed over from prebuilds.
+const syncBarriersLocation = "/workspace/.gitpod/sync-barriers.json"
+
+// NewSyncService creates a new sync service which persists the barriers which are done in location.
+func NewSyncService(location string) *SyncService {
+	return &SyncService{
+		Location: location,
+		barriers: make(map[string]*syncBarrier),
+		loaded:   make(chan struct{}),
+	}
+}
+
+// SyncService implements the sync service API.
+type SyncService struct {
+	// Location is the file the barriers which are done are persisted in.
+	Location string
+
+	mu       sync.Mutex
+	barriers map[string]*syncBarrier
+	loaded   chan struct{}
+	loadOnce sync.Once
+
+	api.UnimplementedSyncServiceServer
+}
+
+// syncBarrier is a barrier until it is reset. Resetting a barrier replaces it with a new one.
+type syncBarrier struct {
+	// done is closed once the barrier is done. payload and doneTime must not change afterwards.
+	done     chan struct{}
+	payload  string
+	doneTime time.Time
+	waiters  int
+}
+
+func (b *syncBarrier) isDone() bool {
+	select {
+	case <-b.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (b *syncBarrier) toAPI(name string) *api.SyncBarrier {
+	res := &api.SyncBarrier{
+		Name:    name,
+		Waiters: uint32(b.waiters),
+	}
+	if b.isDone() {
+		res.Done = true
+		res.Payload = b.payload
+		res.DoneTime = timestamppb.New(b.doneTime)
+	}
+	return res
+}
+
+// persistedSyncBarrier is a barrier which is done, as persisted in the workspace.
+type persistedSyncBarrier struct {
+	Name     string    `json:"name"`
+	Payload  string    `json:"payload,omitempty"`
+	DoneTime time.Time `json:"doneTime"`
+}
+
+// RegisterGRPC registers a gRPC service.
+func (s *SyncService) RegisterGRPC(srv *grpc.Server) {
+	api.RegisterSyncServiceServer(srv, s)
+}
+
+// RegisterREST registers a REST service.
+func (s *SyncService) RegisterREST(mux *runtime.ServeMux, grpcEndpoint string) error {
+	return api.RegisterSyncServiceHandlerFromEndpoint(context.Background(), mux, grpcEndpoint, []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())})
+}
+
+// Load loads the persisted barriers. It must be called once the workspace content is ready, because the
+// barriers are part of it. Barriers cannot be completed or reset before they were loaded.
+func (s *SyncService) Load() (err error) {
+	s.loadOnce.Do(func() {
+		defer close(s.loaded)
+
+		var content []byte
+		content, err = os.ReadFile(s.Location)
+		if errors.Is(err, fs.ErrNotExist) {
+			err = nil
+			return
+		}
+		if err != nil {
+			err = xerrors.Errorf("cannot read %s: %w", s.Location, err)
+			return
+		}
+		var persisted []persistedSyncBarrier
+		err = json.Unmarshal(content, &persisted)
+		if err != nil {
+			err = xerrors.Errorf("cannot parse %s: %w", s.Location, err)
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for _, p := range persisted {
+			b := s.barrier(p.Name)
+			if b.isDone() {
+				continue
+			}
+			b.payload = p.Payload
+			b.doneTime = p.DoneTime
+			close(b.done)
+		}
+	})
+	return err
+}
+
+func (s *SyncService) waitLoaded(ctx context.Context) error {
+	select {
+	case <-s.loaded:
+		return nil
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// barrier returns the barrier with the given name, which is created if it does not exist.
+// Callers must hold s.mu.
+func (s *SyncService) barrier(name string) *syncBarrier {
+	b, ok := s.barriers[name]
+	if !ok {
+		b = &syncBarrier{done: make(chan struct{})}
+		s.barriers[name] = b
+	}
+	return b
+}
+
+// Done completes a barrier and releases all its waiters.
+func (s *SyncService) Done(ctx context.Context, req *api.SyncDoneRequest) (*api.SyncDoneResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	err := s.waitLoaded(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.barrier(req.Name)
+	if !b.isDone() {
+		b.payload = req.Payload
+		b.doneTime = time.Now()
+		close(b.done)
+
+		err = s.persist()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return &api.SyncDoneResponse{Barrier: b.toAPI(req.Name)}, nil
+}
+
+// Await returns once a barrier is done or the request is canceled.
+func (s *SyncService) Await(ctx context.Context, req *api.SyncAwaitRequest) (*api.SyncAwaitResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	s.mu.Lock()
+	b := s.barrier(req.Name)
+	b.waiters++
+	s.mu.Unlock()
+
+	var err error
+	select {
+	case <-b.done:
+	case <-ctx.Done():
+		err = status.FromContextError(ctx.Err()).Err()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b.waiters--
+	if err != nil {
+		if b.waiters == 0 && !b.isDone() && s.barriers[req.Name] == b {
+			// nobody waits for the barrier anymore
+			delete(s.barriers, req.Name)
+		}
+		return nil, err
+	}
+	return &api.SyncAwaitResponse{Barrier: b.toAPI(req.Name)}, nil
+}
+
+// List lists the barriers which are done or awaited.
+func (s *SyncService) List(ctx context.Context, req *api.ListSyncBarriersRequest) (*api.ListSyncBarriersResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := &api.ListSyncBarriersResponse{}
+	for name, b := range s.barriers {
+		if req.PendingOnly && b.isDone() {
+			continue
+		}
+		res.Barriers = append(res.Barriers, b.toAPI(name))
+	}
+	sort.Slice(res.Barriers, func(i, j int) bool {
+		return res.Barriers[i].Name < res.Barriers[j].Name
+	})
+	return res, nil
+}
+
+// Reset resets a barrier which is done, such that it can be awaited and completed again.
+func (s *SyncService) Reset(ctx context.Context, req *api.ResetSyncBarrierRequest) (*api.ResetSyncBarrierResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	err := s.waitLoaded(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.barriers[req.Name]
+	if !ok || !b.isDone() {
+		return &api.ResetSyncBarrierResponse{}, nil
+	}
+	delete(s.barriers, req.Name)
+	err = s.persist()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.ResetSyncBarrierResponse{}, nil
+}
+
+// persist atomically replaces the persisted barriers with the ones which are done. Callers must hold s.mu.
+func (s *SyncService) persist() error {
+	persisted := make([]persistedSyncBarrier, 0, len(s.barriers))
+	for name, b := range s.barriers {
+		if !b.isDone() {
+			continue
+		}
+		persisted = append(persisted, persistedSyncBarrier{Name: name, Payload: b.payload, DoneTime: b.doneTime})
+	}
+	sort.Slice(persisted, func(i, j int) bool {
+		return persisted[i].Name < persisted[j].Name
+	})
+	content, err := json.MarshalIndent(persisted, "", "  ")
+	if err != nil {
+		return xerrors.Errorf("cannot marshal sync barriers: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(s.Location), 0755)
+	if err != nil {
+		return xerrors.Errorf("cannot create %s: %w", filepath.Dir(s.Location), err)
+	}
+	tmp := s.Location + ".tmp"
+	err = os.WriteFile(tmp, content, 0644)
+	if err != nil {
+		return xerrors.Errorf("cannot write %s: %w", tmp, err)
+	}
+	_ = os.Chown(tmp, gitpodUID, gitpodGID)
+	err = os.Rename(tmp, s.Location)
+	if err != nil {
+		return xerrors.Errorf("cannot replace %s: %w", s.Location, err)
+	}
+	return nil
+}
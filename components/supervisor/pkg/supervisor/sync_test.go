@@ -0,0 +1,146 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+func newLoadedSyncService(t *testing.T, location string) *SyncService {
+	s := NewSyncService(location)
+	err := s.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func listSyncBarriers(t *testing.T, s *SyncService, pendingOnly bool) []*api.SyncBarrier {
+	resp, err := s.List(context.Background(), &api.ListSyncBarriersRequest{PendingOnly: pendingOnly})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range resp.Barriers {
+		b.DoneTime = nil
+	}
+	return resp.Barriers
+}
+
+func TestSyncServiceAwait(t *testing.T) {
+	s := newLoadedSyncService(t, filepath.Join(t.TempDir(), "sync-barriers.json"))
+
+	awaited := make(chan *api.SyncAwaitResponse)
+	go func() {
+		resp, err := s.Await(context.Background(), &api.SyncAwaitRequest{Name: "db"})
+		if err != nil {
+			t.Error(err)
+		}
+		awaited <- resp
+	}()
+
+	for i := 0; ; i++ {
+		if len(listSyncBarriers(t, s, true)) == 1 {
+			break
+		}
+		if i > 100 {
+			t.Fatal("barrier is not awaited")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if diff := cmp.Diff([]*api.SyncBarrier{{Name: "db", Waiters: 1}}, listSyncBarriers(t, s, true), protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected pending barriers (-want +got):\n%s", diff)
+	}
+
+	_, err := s.Done(context.Background(), &api.SyncDoneRequest{Name: "db", Payload: "5432"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case resp := <-awaited:
+		if !resp.Barrier.Done || resp.Barrier.Payload != "5432" || resp.Barrier.DoneTime == nil {
+			t.Errorf("unexpected barrier: %v", resp.Barrier)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("await did not return")
+	}
+
+	// completing a barrier again keeps its payload
+	resp, err := s.Done(context.Background(), &api.SyncDoneRequest{Name: "db", Payload: "other"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Barrier.Payload != "5432" {
+		t.Errorf("expected the payload to be kept, but got %q", resp.Barrier.Payload)
+	}
+	if diff := cmp.Diff([]*api.SyncBarrier{{Name: "db", Done: true, Payload: "5432"}}, listSyncBarriers(t, s, false), protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected barriers (-want +got):\n%s", diff)
+	}
+	if len(listSyncBarriers(t, s, true)) != 0 {
+		t.Error("expected no pending barriers")
+	}
+}
+
+func TestSyncServiceAwaitTimeout(t *testing.T) {
+	s := newLoadedSyncService(t, filepath.Join(t.TempDir(), "sync-barriers.json"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := s.Await(ctx, &api.SyncAwaitRequest{Name: "never"})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("expected deadline exceeded, but got %v", err)
+	}
+	if barriers := listSyncBarriers(t, s, false); len(barriers) != 0 {
+		t.Errorf("expected barriers which are not awaited anymore to be removed, but got %v", barriers)
+	}
+}
+
+func TestSyncServicePersistence(t *testing.T) {
+	location := filepath.Join(t.TempDir(), ".gitpod", "sync-barriers.json")
+
+	// barriers cannot be completed before the workspace content with the persisted ones is ready
+	s := NewSyncService(location)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := s.Done(ctx, &api.SyncDoneRequest{Name: "init"})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("expected deadline exceeded before the barriers were loaded, but got %v", err)
+	}
+
+	s = newLoadedSyncService(t, location)
+	for _, name := range []string{"init", "url"} {
+		_, err = s.Done(context.Background(), &api.SyncDoneRequest{Name: name, Payload: name + "-payload"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err = s.Reset(context.Background(), &api.ResetSyncBarrierRequest{Name: "init"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restarted := newLoadedSyncService(t, location)
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	resp, err := restarted.Await(ctx, &api.SyncAwaitRequest{Name: "url"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Barrier.Payload != "url-payload" {
+		t.Errorf("unexpected payload of a persisted barrier: %q", resp.Barrier.Payload)
+	}
+	if diff := cmp.Diff([]*api.SyncBarrier{{Name: "url", Done: true, Payload: "url-payload"}}, listSyncBarriers(t, restarted, false), protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected barriers after restart (-want +got):\n%s", diff)
+	}
+}
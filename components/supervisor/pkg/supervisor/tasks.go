@@ -11,13 +11,20 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"net"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/gitpod-io/gitpod/common-go/log"
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/pkg/logs"
@@ -70,6 +77,17 @@ type task struct {
 	successChan chan taskSuccess
 	title       string
 	lastOutput  string
+
+	dependsOn []*task
+	// readinessLog is the compiled readiness log condition
+	readinessLog *regexp.Regexp
+	// ready is closed once the task is ready
+	ready     chan struct{}
+	readyOnce sync.Once
+	// closed is closed once the task is closed
+	closed chan struct{}
+	// restartRequested is set if the terminal of the task is closed in order to restart it
+	restartRequested bool
 }
 
 type headlessTaskProgressReporter interface {
@@ -107,17 +125,22 @@ type tasksManager struct {
 	terminalService *terminal.MuxTerminalService
 	contentState    ContentState
 	reporter        headlessTaskProgressReporter
+	notifications   *NotificationService
+	// restartBackoff is the delay before the first restart of a task
+	restartBackoff time.Duration
 }
 
-func newTasksManager(config *Config, terminalService *terminal.MuxTerminalService, contentState ContentState, reporter headlessTaskProgressReporter) *tasksManager {
+func newTasksManager(config *Config, terminalService *terminal.MuxTerminalService, contentState ContentState, reporter headlessTaskProgressReporter, notifications *NotificationService) *tasksManager {
 	return &tasksManager{
 		config:          config,
 		terminalService: terminalService,
 		contentState:    contentState,
 		reporter:        reporter,
+		notifications:   notifications,
 		subscriptions:   make(map[*tasksSubscription]struct{}),
 		ready:           make(chan struct{}),
 		storeLocation:   logs.TerminalStoreLocation,
+		restartBackoff:  initialRestartBackoff,
 	}
 }
 
@@ -138,6 +161,29 @@ func (tm *tasksManager) getStatus() []*api.TaskStatus {
 	return status
 }
 
+// terminalProcesses returns the running tasks by the PID of their terminal's process.
+func (tm *tasksManager) terminalProcesses() map[uint64]taskRef {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	res := make(map[uint64]taskRef, len(tm.tasks))
+	for _, t := range tm.tasks {
+		if t.State != api.TaskState_running || t.Terminal == "" {
+			continue
+		}
+		term, ok := tm.terminalService.Mux.Get(t.Terminal)
+		if !ok || term.Command.Process == nil {
+			continue
+		}
+		ref := taskRef{ID: t.Id}
+		if t.Presentation != nil {
+			ref.Name = t.Presentation.Name
+		}
+		res[uint64(term.Command.Process.Pid)] = ref
+	}
+	return res
+}
+
 func (tm *tasksManager) updateState(doUpdate func() (changed bool)) {
 	tm.mu.Lock()
 	defer tm.mu.Unlock()
@@ -169,6 +215,26 @@ func (tm *tasksManager) setTaskState(t *task, newState api.TaskState) {
 	})
 }
 
+// closeTask records the result of a task. A task which succeeded is ready for the tasks depending on it.
+func (tm *tasksManager) closeTask(t *task, success taskSuccess) {
+	if !success.Failed() {
+		tm.markReady(t)
+	}
+	t.successChan <- success
+	tm.setTaskState(t, api.TaskState_closed)
+	close(t.closed)
+}
+
+func (tm *tasksManager) markReady(t *task) {
+	t.readyOnce.Do(func() {
+		tm.updateState(func() bool {
+			t.Ready = true
+			return true
+		})
+		close(t.ready)
+	})
+}
+
 func (tm *tasksManager) init(ctx context.Context) {
 	defer close(tm.ready)
 
@@ -216,102 +282,143 @@ func (tm *tasksManager) init(ctx context.Context) {
 			config:      config,
 			successChan: make(chan taskSuccess, 1),
 			title:       presentation.Name,
+			ready:       make(chan struct{}),
+			closed:      make(chan struct{}),
 		}
 		task.command = getCommand(task, tm.config.isHeadless(), tm.contentSource, tm.storeLocation)
-		if tm.config.isHeadless() && task.command == "exit" {
-			task.State = api.TaskState_closed
-			task.successChan <- taskSuccessful
-		}
 		tm.tasks = append(tm.tasks, task)
 	}
-}
 
-func (tm *tasksManager) Run(ctx context.Context, wg *sync.WaitGroup, successChan chan taskSuccess) {
-	defer wg.Done()
-	defer log.Debug("tasksManager shutdown")
-
-	tm.init(ctx)
-
-	for _, t := range tm.tasks {
-		if t.State == api.TaskState_closed {
+	invalid := resolveDependencies(tm.tasks)
+	for _, task := range tm.tasks {
+		if _, ok := invalid[task]; ok {
 			continue
 		}
-		taskLog := log.WithField("command", t.command)
-		taskLog.Info("starting a task terminal...")
-		openRequest := &api.OpenTerminalRequest{}
-		if t.config.Env != nil {
-			openRequest.Env = make(map[string]string, len(*t.config.Env))
-			for key, value := range *t.config.Env {
-				// Required check because a string is considered valid JSON (e.g. "hello")
-				// We don't want to marshall basic strings otherwise we get a double quoted environment variable
-				// See: https://github.com/gitpod-io/gitpod/issues/5887
-				if val, ok := value.(string); ok {
-					openRequest.Env[key] = val
-				} else {
-					v, err := json.Marshal(value)
-					if err != nil {
-						taskLog.WithError(err).WithField("key", key).Error("cannot marshal env var")
-					} else {
-						openRequest.Env[key] = string(v)
-					}
-				}
-			}
+		if err := validateRestartPolicy(task.config); err != nil {
+			invalid[task] = err
 		}
-		resp, err := tm.terminalService.OpenWithOptions(ctx, openRequest, terminal.TermOptions{
-			ReadTimeout: 5 * time.Second,
-			Title:       t.title,
-		})
-		if err != nil {
-			taskLog.WithError(err).Error("cannot open new task terminal")
-			t.successChan <- taskFailed("cannot open new task terminal")
-			tm.setTaskState(t, api.TaskState_closed)
+	}
+	for _, task := range tm.tasks {
+		if err, ok := invalid[task]; ok {
+			log.WithError(err).WithField("task", task.title).Error("invalid task configuration")
+			tm.closeTask(task, taskFailed(fmt.Sprintf("invalid task configuration of %s: %v", task.title, err)))
 			continue
 		}
-
-		taskLog = taskLog.WithField("terminal", resp.Terminal.Alias)
-		term, ok := tm.terminalService.Mux.Get(resp.Terminal.Alias)
-		if !ok {
-			taskLog.Error("cannot find a task terminal")
-			t.successChan <- taskFailed("cannot find a task terminal")
-			tm.setTaskState(t, api.TaskState_closed)
+		if tm.config.isHeadless() && task.command == "exit" {
+			tm.closeTask(task, taskSuccessful)
 			continue
 		}
+		if len(task.dependsOn) > 0 {
+			task.State = api.TaskState_waiting
+		}
+	}
+}
 
-		taskLog = taskLog.WithField("pid", term.Command.Process.Pid)
-		taskLog.Info("task terminal has been started")
-		tm.updateState(func() bool {
-			t.Terminal = resp.Terminal.Alias
-			t.State = api.TaskState_running
-			return true
-		})
+// resolveDependencies links the tasks to the tasks they depend on, and compiles their readiness conditions.
+// Tasks with an invalid configuration, e.g. an unknown or cyclic dependency, are returned along with the reason.
+func resolveDependencies(tasks []*task) map[*task]error {
+	var (
+		invalid = make(map[*task]error)
+		byName  = make(map[string][]*task, len(tasks))
+	)
+	for _, t := range tasks {
+		byName[t.Presentation.Name] = append(byName[t.Presentation.Name], t)
+	}
+	for _, t := range tasks {
+		if r := t.config.Readiness; r != nil && r.Log != "" {
+			expr, err := regexp.Compile(r.Log)
+			if err != nil {
+				invalid[t] = xerrors.Errorf("invalid readiness log condition: %w", err)
+			}
+			t.readinessLog = expr
+		}
+		if t.config.DependsOn == nil {
+			continue
+		}
+		for _, name := range *t.config.DependsOn {
+			deps := byName[name]
+			switch len(deps) {
+			case 0:
+				invalid[t] = xerrors.Errorf("unknown dependency %s", name)
+			case 1:
+				t.dependsOn = append(t.dependsOn, deps[0])
+			default:
+				invalid[t] = xerrors.Errorf("ambiguous dependency %s: %d tasks have that name", name, len(deps))
+			}
+		}
+	}
 
-		go func(t *task, term *terminal.Term) {
-			state, err := term.Wait()
-			if state != nil {
-				if state.Success() {
-					t.successChan <- taskSuccessful
-				} else {
-					t.successChan <- taskFailed(state.String())
+	// find cycles using a depth-first search
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	var (
+		state = make(map[*task]int, len(tasks))
+		path  []*task
+		visit func(t *task)
+	)
+	visit = func(t *task) {
+		state[t] = visiting
+		path = append(path, t)
+		for _, dep := range t.dependsOn {
+			switch state[dep] {
+			case unvisited:
+				visit(dep)
+			case visiting:
+				var cycle []*task
+				for i := len(path) - 1; i >= 0; i-- {
+					if path[i] == dep {
+						cycle = path[i:]
+						break
+					}
 				}
-			} else if err != nil {
-				t.successChan <- taskSuccessful
-			} else {
-				msg := "cannot wait for task"
-				if err != nil {
-					msg = err.Error()
+				names := make([]string, 0, len(cycle)+1)
+				for _, c := range cycle {
+					names = append(names, c.Presentation.Name)
+				}
+				names = append(names, dep.Presentation.Name)
+				for _, c := range cycle {
+					invalid[c] = xerrors.Errorf("cyclic dependency %s", strings.Join(names, " -> "))
 				}
-
-				t.successChan <- taskFailed(fmt.Sprintf("%s: %s", msg, t.lastOutput))
 			}
-			taskLog.Info("task terminal has been closed")
-			tm.setTaskState(t, api.TaskState_closed)
-		}(t, term)
+		}
+		path = path[:len(path)-1]
+		state[t] = visited
+	}
+	for _, t := range tasks {
+		if state[t] == unvisited {
+			visit(t)
+		}
+	}
+	return invalid
+}
 
-		tm.watch(t, term)
+func (tm *tasksManager) Run(ctx context.Context, wg *sync.WaitGroup, successChan chan taskSuccess) {
+	defer wg.Done()
+	defer log.Debug("tasksManager shutdown")
+
+	tm.init(ctx)
 
-		if t.command != "" {
-			term.PTY.Write([]byte(t.command + "\n"))
+	for _, t := range tm.tasks {
+		if t.State == api.TaskState_closed {
+			continue
 		}
+		if len(t.dependsOn) == 0 {
+			tm.start(ctx, t, t.command)
+			continue
+		}
+		go func(t *task) {
+			err := awaitDependencies(ctx, t)
+			if err != nil {
+				log.WithError(err).WithField("task", t.title).Info("task is not started")
+				tm.closeTask(t, taskFailed(fmt.Sprintf("%s: %v", t.title, err)))
+				return
+			}
+			tm.setTaskState(t, api.TaskState_opening)
+			tm.start(ctx, t, t.command)
+		}(t)
 	}
 
 	var success taskSuccess
@@ -332,6 +439,326 @@ func (tm *tasksManager) Run(ctx context.Context, wg *sync.WaitGroup, successChan
 	successChan <- success
 }
 
+// start opens the terminal of a task and runs the command
+func (tm *tasksManager) start(ctx context.Context, t *task, command string) {
+	taskLog := log.WithField("command", command)
+	taskLog.Info("starting a task terminal...")
+	openRequest := &api.OpenTerminalRequest{}
+	if t.config.Env != nil {
+		openRequest.Env = make(map[string]string, len(*t.config.Env))
+		for key, value := range *t.config.Env {
+			// Required check because a string is considered valid JSON (e.g. "hello")
+			// We don't want to marshall basic strings otherwise we get a double quoted environment variable
+			// See: https://github.com/gitpod-io/gitpod/issues/5887
+			if val, ok := value.(string); ok {
+				openRequest.Env[key] = val
+			} else {
+				v, err := json.Marshal(value)
+				if err != nil {
+					taskLog.WithError(err).WithField("key", key).Error("cannot marshal env var")
+				} else {
+					openRequest.Env[key] = string(v)
+				}
+			}
+		}
+	}
+	options := terminal.TermOptions{
+		ReadTimeout: 5 * time.Second,
+		Title:       t.title,
+	}
+	if t.config.Record != nil && *t.config.Record {
+		// the recording is named after the task, runs before a restart are kept as rotated files
+		options.Recording = &terminal.RecordingOptions{
+			Dir:  tm.storeLocation,
+			Name: "task-" + t.Id,
+		}
+	}
+	resp, err := tm.terminalService.OpenWithOptions(ctx, openRequest, options)
+	if err != nil {
+		taskLog.WithError(err).Error("cannot open new task terminal")
+		tm.closeTask(t, taskFailed("cannot open new task terminal"))
+		return
+	}
+
+	taskLog = taskLog.WithField("terminal", resp.Terminal.Alias)
+	term, ok := tm.terminalService.Mux.Get(resp.Terminal.Alias)
+	if !ok {
+		taskLog.Error("cannot find a task terminal")
+		tm.closeTask(t, taskFailed("cannot find a task terminal"))
+		return
+	}
+
+	taskLog = taskLog.WithField("pid", term.Command.Process.Pid)
+	taskLog.Info("task terminal has been started")
+	tm.updateState(func() bool {
+		t.Terminal = resp.Terminal.Alias
+		t.State = api.TaskState_running
+		t.Recording = term.RecordingPath()
+		return true
+	})
+
+	go func(t *task, term *terminal.Term) {
+		var success taskSuccess
+		state, err := term.Wait()
+		if state != nil {
+			if state.Success() {
+				success = taskSuccessful
+			} else {
+				success = taskFailed(state.String())
+			}
+			tm.updateState(func() bool {
+				t.LastExitCode = int32(state.ExitCode())
+				return true
+			})
+		} else if err != nil {
+			success = taskSuccessful
+		} else {
+			msg := "cannot wait for task"
+			if err != nil {
+				msg = err.Error()
+			}
+
+			success = taskFailed(fmt.Sprintf("%s: %s", msg, t.lastOutput))
+		}
+		taskLog.Info("task terminal has been closed")
+		if tm.takeRestartRequest(t) && ctx.Err() == nil {
+			tm.updateState(func() bool {
+				t.State = api.TaskState_opening
+				t.LastRestartTime = timestamppb.Now()
+				return true
+			})
+			tm.start(ctx, t, getCommand(t, false, csapi.WorkspaceInitFromBackup, tm.storeLocation))
+			return
+		}
+		// the task is not restarted if its terminal was closed on purpose, e.g. by `gp tasks stop` or on shutdown
+		restart := state != nil && !term.Terminated() && ctx.Err() == nil &&
+			isSupervised(t.config, tm.config.isHeadless()) && shouldRestart(t.config, success)
+		if restart {
+			tm.restart(ctx, t, success)
+			return
+		}
+		tm.closeTask(t, success)
+	}(t, term)
+
+	tm.watch(t, term)
+	tm.watchReadiness(ctx, t, term)
+
+	if command != "" {
+		term.PTY.Write([]byte(command + "\n"))
+	}
+}
+
+const (
+	restartPolicyNever     = "never"
+	restartPolicyOnFailure = "on-failure"
+	restartPolicyAlways    = "always"
+
+	// defaultMaxRestarts is the number of times a task is restarted if its config does not specify maxRestarts
+	defaultMaxRestarts = 5
+	// initialRestartBackoff is the delay before the first restart of a task. The delay doubles with every restart.
+	initialRestartBackoff = 1 * time.Second
+	// maxRestartBackoff caps the delay between two restarts of a task
+	maxRestartBackoff = 1 * time.Minute
+)
+
+func validateRestartPolicy(config TaskConfig) error {
+	if config.RestartPolicy != nil {
+		switch *config.RestartPolicy {
+		case restartPolicyNever, restartPolicyOnFailure, restartPolicyAlways:
+		default:
+			return xerrors.Errorf("unknown restart policy %s", *config.RestartPolicy)
+		}
+	}
+	if config.MaxRestarts != nil && *config.MaxRestarts < 0 {
+		return xerrors.Errorf("maxRestarts must be >= 0")
+	}
+	return nil
+}
+
+// isSupervised returns true if the command of a task is restarted according to a restart policy once it exits.
+// Prebuilds are never restarted.
+func isSupervised(config TaskConfig, isHeadless bool) bool {
+	return !isHeadless && config.RestartPolicy != nil && *config.RestartPolicy != restartPolicyNever
+}
+
+// shouldRestart returns true if the restart policy of a task asks for a restart after a run with the given result.
+// It does not consider the restart budget of the task.
+func shouldRestart(config TaskConfig, success taskSuccess) bool {
+	if config.RestartPolicy == nil {
+		return false
+	}
+	switch *config.RestartPolicy {
+	case restartPolicyAlways:
+		return true
+	case restartPolicyOnFailure:
+		return success.Failed()
+	default:
+		return false
+	}
+}
+
+func maxRestarts(config TaskConfig) int {
+	if config.MaxRestarts == nil {
+		return defaultMaxRestarts
+	}
+	return *config.MaxRestarts
+}
+
+// restartBackoff returns the delay before the next restart of a task which was restarted restartCount times already
+func restartBackoff(initial time.Duration, restartCount int) time.Duration {
+	backoff := initial
+	for i := 0; i < restartCount && backoff < maxRestartBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxRestartBackoff {
+		backoff = maxRestartBackoff
+	}
+	return backoff
+}
+
+// restart starts the command of a task again after an exponential backoff. Once the restart budget
+// of the task is exhausted, the task is closed with the result of its last run and the user is notified.
+func (tm *tasksManager) restart(ctx context.Context, t *task, lastResult taskSuccess) {
+	restartCount := int(t.RestartCount)
+	if restartCount >= maxRestarts(t.config) {
+		log.WithField("task", t.title).WithField("restarts", restartCount).Warn("task exhausted its restart budget")
+		if tm.notifications != nil {
+			_, err := tm.notifications.Notify(ctx, &api.NotifyRequest{
+				Level:   api.NotifyRequest_WARNING,
+				Message: fmt.Sprintf("The task '%s' exited with code %d and is not restarted anymore after %d restarts.", t.title, t.LastExitCode, restartCount),
+			})
+			if err != nil {
+				log.WithError(err).WithField("task", t.title).Warn("cannot notify about exhausted restart budget")
+			}
+		}
+		tm.closeTask(t, lastResult.Fail(fmt.Sprintf("%s: restart budget of %d restarts exhausted", t.title, restartCount)))
+		return
+	}
+
+	backoff := restartBackoff(tm.restartBackoff, restartCount)
+	log.WithField("task", t.title).WithField("backoff", backoff.String()).Info("restarting task")
+	tm.setTaskState(t, api.TaskState_opening)
+	select {
+	case <-ctx.Done():
+		tm.closeTask(t, lastResult)
+		return
+	case <-time.After(backoff):
+	}
+
+	tm.updateState(func() bool {
+		t.RestartCount++
+		t.LastRestartTime = timestamppb.Now()
+		return true
+	})
+	// a restart runs the command only, the workspace is initialized already
+	tm.start(ctx, t, getCommand(t, false, csapi.WorkspaceInitFromBackup, tm.storeLocation))
+}
+
+// tasksWithStaleEnv returns the running tasks whose terminal was started with other values of the given
+// environment variables than env has. Variables which a task sets itself are ignored for that task.
+func (tm *tasksManager) tasksWithStaleEnv(env []string, names []string) []*task {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	current := envMap(env)
+	var res []*task
+	for _, t := range tm.tasks {
+		if t.State != api.TaskState_running || t.Terminal == "" {
+			continue
+		}
+		term, ok := tm.terminalService.Mux.Get(t.Terminal)
+		if !ok {
+			continue
+		}
+		started := envMap(term.Command.Env)
+		for _, name := range names {
+			if t.config.Env != nil {
+				if _, own := (*t.config.Env)[name]; own {
+					continue
+				}
+			}
+			value, ok := current[name]
+			startedValue, startedOk := started[name]
+			if ok != startedOk || value != startedValue {
+				res = append(res, t)
+				break
+			}
+		}
+	}
+	return res
+}
+
+// envMap parses an environment. Like exec.Cmd, the last value of duplicate variables wins.
+func envMap(env []string) map[string]string {
+	res := make(map[string]string, len(env))
+	for _, kv := range env {
+		name, value, ok := strings.Cut(kv, "=")
+		if !ok {
+			continue
+		}
+		res[name] = value
+	}
+	return res
+}
+
+// restartTasksGracePeriod is the time the processes of a task get to stop before they are killed on a restart
+const restartTasksGracePeriod = 10 * time.Second
+
+// restartTasks closes the terminals of running tasks and runs their commands again in new terminals.
+// Unlike restarts after a task exited, these do not count towards the restart budget.
+func (tm *tasksManager) restartTasks(ctx context.Context, tasks []*task) {
+	for _, t := range tasks {
+		tm.mu.Lock()
+		alias := t.Terminal
+		running := t.State == api.TaskState_running
+		if running {
+			t.restartRequested = true
+		}
+		tm.mu.Unlock()
+		if !running {
+			continue
+		}
+
+		log.WithField("task", t.title).Info("restarting task on request")
+		closeCtx, cancel := context.WithTimeout(ctx, restartTasksGracePeriod)
+		err := tm.terminalService.Mux.CloseTerminal(closeCtx, alias)
+		cancel()
+		if err != nil {
+			log.WithError(err).WithField("task", t.title).Warn("cannot close task terminal for restart")
+			tm.takeRestartRequest(t)
+		}
+	}
+}
+
+// takeRestartRequest returns true and resets the request if a restart of the task was requested.
+func (tm *tasksManager) takeRestartRequest(t *task) bool {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	requested := t.restartRequested
+	t.restartRequested = false
+	return requested
+}
+
+// awaitDependencies blocks until all dependencies of a task are ready. It fails if a dependency
+// is closed before it became ready.
+func awaitDependencies(ctx context.Context, t *task) error {
+	for _, dep := range t.dependsOn {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-dep.ready:
+		case <-dep.closed:
+			select {
+			case <-dep.ready:
+			default:
+				return xerrors.Errorf("dependency %s failed", dep.Presentation.Name)
+			}
+		}
+	}
+	return nil
+}
+
 func getCommand(task *task, isHeadless bool, contentSource csapi.WorkspaceInitSource, storeLocation string) string {
 	commands := getCommands(task, isHeadless, contentSource, storeLocation)
 	command := composeCommand(composeCommandOptions{
@@ -349,6 +776,11 @@ func getCommand(task *task, isHeadless bool, contentSource csapi.WorkspaceInitSo
 		return command + "; exit"
 	}
 
+	if isSupervised(task.config, isHeadless) && strings.TrimSpace(command) != "" {
+		// the terminal ends with the command, so that its exit code decides about a restart
+		command += "; exit"
+	}
+
 	histfileCommand := getHistfileCommand(task, commands, contentSource, storeLocation)
 	if strings.TrimSpace(command) == "" {
 		return histfileCommand
@@ -485,6 +917,127 @@ func (tm *tasksManager) watch(task *task, term *terminal.Term) {
 	}()
 }
 
+const (
+	// readinessPollInterval is the interval in which the readiness conditions of a task are checked
+	readinessPollInterval = 1 * time.Second
+	// readinessCommandTimeout is the time a readiness command may take before it is considered to have failed
+	readinessCommandTimeout = 10 * time.Second
+)
+
+// watchReadiness marks a task ready once its readiness conditions are met. Without conditions a task is ready
+// once it is started, or in prebuilds once it succeeded.
+func (tm *tasksManager) watchReadiness(ctx context.Context, t *task, term *terminal.Term) {
+	r := t.config.Readiness
+	if r == nil {
+		if !tm.config.isHeadless() {
+			tm.markReady(t)
+		}
+		return
+	}
+
+	var conditions []func() bool
+	if r.Port != 0 {
+		addr := fmt.Sprintf("localhost:%d", r.Port)
+		conditions = append(conditions, func() bool {
+			conn, err := net.DialTimeout("tcp", addr, readinessPollInterval)
+			if err != nil {
+				return false
+			}
+			conn.Close()
+			return true
+		})
+	}
+	if r.File != "" {
+		fn := r.File
+		if !filepath.IsAbs(fn) {
+			fn = filepath.Join(term.Command.Dir, fn)
+		}
+		conditions = append(conditions, func() bool {
+			_, err := os.Stat(fn)
+			return err == nil
+		})
+	}
+	if r.Command != "" {
+		conditions = append(conditions, func() bool {
+			cctx, cancel := context.WithTimeout(ctx, readinessCommandTimeout)
+			defer cancel()
+
+			// the command runs like the task, i.e. with the same shell, working directory, environment and user
+			cmd := exec.CommandContext(cctx, term.Command.Path, "-c", r.Command)
+			cmd.Dir = term.Command.Dir
+			cmd.Env = term.Command.Env
+			if attr := term.Command.SysProcAttr; attr != nil && attr.Credential != nil {
+				cmd.SysProcAttr = &syscall.SysProcAttr{Credential: attr.Credential}
+			}
+			return cmd.Run() == nil
+		})
+	}
+	if t.readinessLog != nil {
+		matched := make(chan struct{})
+		stdout := term.Stdout.ListenWithOptions(terminal.TermListenOptions{
+			ReadTimeout: terminal.NoTimeout,
+		})
+		go func() {
+			defer stdout.Close()
+
+			scanner := bufio.NewScanner(stdout)
+			for scanner.Scan() {
+				if t.readinessLog.MatchString(visibleLine(scanner.Text())) {
+					close(matched)
+					return
+				}
+			}
+		}()
+		conditions = append(conditions, func() bool {
+			select {
+			case <-matched:
+				return true
+			default:
+				return false
+			}
+		})
+	}
+
+	go func() {
+		ticker := time.NewTicker(readinessPollInterval)
+		defer ticker.Stop()
+		for {
+			ready := true
+			for _, met := range conditions {
+				if !met() {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				log.WithField("task", t.title).Info("task is ready")
+				tm.markReady(t)
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.closed:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+var ansiEscapeSequence = regexp.MustCompile(`\x1b\[[0-?]*[ -/]*[@-~]`)
+
+// visibleLine returns the text a terminal displays for a line of output, i.e. without escape sequences
+// and without the text overwritten after a carriage return
+func visibleLine(line string) string {
+	line = strings.TrimRight(line, "\r")
+	if i := strings.LastIndex(line, "\r"); i >= 0 {
+		line = line[i+1:]
+	}
+	return ansiEscapeSequence.ReplaceAllString(line, "")
+}
+
 func importParentLogAndGetDuration(fn string, out io.Writer) time.Duration {
 	if _, err := os.Stat(fn); err != nil {
 		return 0
@@ -8,9 +8,12 @@ import (
 	"context"
 	"encoding/json"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/sirupsen/logrus"
@@ -47,6 +50,12 @@ var (
 
 func TestTaskManager(t *testing.T) {
 	log.Log.Logger.SetLevel(logrus.FatalLevel)
+	p := func(v string) *string { return &v }
+	dir := t.TempDir()
+	// awaitFile succeeds only if the file is created within a reasonable time
+	awaitFile := func(fn string) string {
+		return "timeout 20 sh -c 'until [ -f " + dir + "/" + fn + " ]; do sleep 0.1; done'"
+	}
 	tests := []struct {
 		Desc        string
 		Headless    bool
@@ -197,6 +206,78 @@ func TestTaskManager(t *testing.T) {
 				Success: true,
 			},
 		},
+		{
+			Desc:     "headless prebuild should start tasks once their dependencies succeeded",
+			Headless: true,
+			Source:   csapi.WorkspaceInitFromOther,
+			GitpodTasks: &[]TaskConfig{
+				{Name: p("test"), Init: p("test -f " + dir + "/installed"), DependsOn: &[]string{"install"}},
+				{Name: p("install"), Init: p("sleep 1 && touch " + dir + "/installed")},
+			},
+
+			ExpectedReporter: testHeadlessTaskProgressReporter{
+				Done:    true,
+				Success: true,
+			},
+		},
+		{
+			Desc:     "headless prebuild should start tasks once the readiness condition of their dependencies is met",
+			Headless: true,
+			Source:   csapi.WorkspaceInitFromOther,
+			GitpodTasks: &[]TaskConfig{
+				// the server succeeds only if the client runs while the server is running
+				{Name: p("server"), Init: p("touch " + dir + "/started && " + awaitFile("client-done")), Readiness: &TaskReadiness{File: dir + "/started"}},
+				{Name: p("client"), Init: p("test -f " + dir + "/started && touch " + dir + "/client-done"), DependsOn: &[]string{"server"}},
+			},
+
+			ExpectedReporter: testHeadlessTaskProgressReporter{
+				Done:    true,
+				Success: true,
+			},
+		},
+		{
+			Desc:     "headless prebuild should start tasks once the output of their dependencies matches",
+			Headless: true,
+			Source:   csapi.WorkspaceInitFromOther,
+			GitpodTasks: &[]TaskConfig{
+				// the arithmetic expansion ensures that the echoed command itself does not match
+				{Name: p("server"), Init: p("echo listening on $((40+2)) && " + awaitFile("log-client-done")), Readiness: &TaskReadiness{Log: "^listening on 42$"}},
+				{Name: p("client"), Init: p("touch " + dir + "/log-client-done"), DependsOn: &[]string{"server"}},
+			},
+
+			ExpectedReporter: testHeadlessTaskProgressReporter{
+				Done:    true,
+				Success: true,
+			},
+		},
+		{
+			Desc:     "headless prebuild should not start tasks of which a dependency failed",
+			Headless: true,
+			Source:   csapi.WorkspaceInitFromOther,
+			GitpodTasks: &[]TaskConfig{
+				{Name: p("install"), Init: &failCommand},
+				{Name: p("test"), Init: &skipCommand, DependsOn: &[]string{"install"}},
+			},
+
+			ExpectedReporter: testHeadlessTaskProgressReporter{
+				Done:    true,
+				Success: false,
+			},
+		},
+		{
+			Desc:     "headless prebuild should fail with cyclic dependencies",
+			Headless: true,
+			Source:   csapi.WorkspaceInitFromOther,
+			GitpodTasks: &[]TaskConfig{
+				{Name: p("a"), Init: &skipCommand, DependsOn: &[]string{"b"}},
+				{Name: p("b"), Init: &skipCommand, DependsOn: &[]string{"a"}},
+			},
+
+			ExpectedReporter: testHeadlessTaskProgressReporter{
+				Done:    true,
+				Success: false,
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.Desc, func(t *testing.T) {
@@ -224,7 +305,7 @@ func TestTaskManager(t *testing.T) {
 						GitpodTasks:    gitpodTasks,
 						GitpodHeadless: strconv.FormatBool(test.Headless),
 					},
-				}, terminalService, contentState, &reporter)
+				}, terminalService, contentState, &reporter, nil)
 			)
 			taskManager.storeLocation = storeLocation
 			contentState.MarkContentReady(test.Source)
@@ -240,6 +321,253 @@ func TestTaskManager(t *testing.T) {
 	}
 }
 
+func TestResolveDependencies(t *testing.T) {
+	p := func(v string) *string { return &v }
+	type Expectation struct {
+		DependsOn map[string][]string
+		Invalid   map[string]string
+	}
+	tests := []struct {
+		Name        string
+		Tasks       []TaskConfig
+		Expectation Expectation
+	}{
+		{
+			Name:  "no dependencies",
+			Tasks: []TaskConfig{{}, {}},
+		},
+		{
+			Name: "chain",
+			Tasks: []TaskConfig{
+				{Name: p("c"), DependsOn: &[]string{"b"}},
+				{Name: p("b"), DependsOn: &[]string{"a"}},
+				{Name: p("a")},
+				{Name: p("d"), DependsOn: &[]string{"a", "c"}},
+			},
+			Expectation: Expectation{
+				DependsOn: map[string][]string{"b": {"a"}, "c": {"b"}, "d": {"a", "c"}},
+			},
+		},
+		{
+			Name: "unknown dependency",
+			Tasks: []TaskConfig{
+				{Name: p("a"), DependsOn: &[]string{"b"}},
+			},
+			Expectation: Expectation{
+				Invalid: map[string]string{"a": "unknown dependency b"},
+			},
+		},
+		{
+			Name: "ambiguous dependency",
+			Tasks: []TaskConfig{
+				{Name: p("a")},
+				{Name: p("a")},
+				{Name: p("b"), DependsOn: &[]string{"a"}},
+			},
+			Expectation: Expectation{
+				Invalid: map[string]string{"b": "ambiguous dependency a: 2 tasks have that name"},
+			},
+		},
+		{
+			Name: "self dependency",
+			Tasks: []TaskConfig{
+				{Name: p("a"), DependsOn: &[]string{"a"}},
+			},
+			Expectation: Expectation{
+				DependsOn: map[string][]string{"a": {"a"}},
+				Invalid:   map[string]string{"a": "cyclic dependency a -> a"},
+			},
+		},
+		{
+			Name: "cycle",
+			Tasks: []TaskConfig{
+				{Name: p("a"), DependsOn: &[]string{"c"}},
+				{Name: p("b"), DependsOn: &[]string{"a"}},
+				{Name: p("c"), DependsOn: &[]string{"b"}},
+				{Name: p("d"), DependsOn: &[]string{"a"}},
+			},
+			Expectation: Expectation{
+				DependsOn: map[string][]string{"a": {"c"}, "b": {"a"}, "c": {"b"}, "d": {"a"}},
+				Invalid: map[string]string{
+					"a": "cyclic dependency a -> c -> b -> a",
+					"b": "cyclic dependency a -> c -> b -> a",
+					"c": "cyclic dependency a -> c -> b -> a",
+				},
+			},
+		},
+		{
+			Name: "invalid readiness log condition",
+			Tasks: []TaskConfig{
+				{Name: p("a"), Readiness: &TaskReadiness{Log: "("}},
+			},
+			Expectation: Expectation{
+				Invalid: map[string]string{"a": "invalid readiness log condition: error parsing regexp: missing closing ): `(`"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var tasks []*task
+			for i, config := range test.Tasks {
+				name := strconv.Itoa(i)
+				if config.Name != nil {
+					name = *config.Name
+				}
+				tasks = append(tasks, &task{config: config, TaskStatus: api.TaskStatus{Presentation: &api.TaskPresentation{Name: name}}})
+			}
+
+			var act Expectation
+			for tsk, err := range resolveDependencies(tasks) {
+				if act.Invalid == nil {
+					act.Invalid = make(map[string]string)
+				}
+				act.Invalid[tsk.Presentation.Name] = err.Error()
+			}
+			for _, tsk := range tasks {
+				if len(tsk.dependsOn) == 0 {
+					continue
+				}
+				if act.DependsOn == nil {
+					act.DependsOn = make(map[string][]string)
+				}
+				for _, dep := range tsk.dependsOn {
+					act.DependsOn[tsk.Presentation.Name] = append(act.DependsOn[tsk.Presentation.Name], dep.Presentation.Name)
+				}
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected resolveDependencies() (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVisibleLine(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Input       string
+		Expectation string
+	}{
+		{Name: "plain", Input: "listening on 42", Expectation: "listening on 42"},
+		{Name: "carriage return line ending", Input: "listening on 42\r", Expectation: "listening on 42"},
+		{Name: "overwritten", Input: "\x1b[?2004l\rlistening on 42", Expectation: "listening on 42"},
+		{Name: "colors", Input: "\x1b[32mlistening\x1b[0m on 42", Expectation: "listening on 42"},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if act := visibleLine(test.Input); act != test.Expectation {
+				t.Errorf("unexpected visibleLine(): expected %q, got %q", test.Expectation, act)
+			}
+		})
+	}
+}
+
+func TestTaskRestart(t *testing.T) {
+	log.Log.Logger.SetLevel(logrus.FatalLevel)
+	p := func(v string) *string { return &v }
+	n := func(v int) *int { return &v }
+	dir := t.TempDir()
+	type Expectation struct {
+		Success      bool
+		Runs         int
+		RestartCount int32
+		LastExitCode int32
+	}
+	tests := []struct {
+		Desc        string
+		Task        TaskConfig
+		Expectation Expectation
+	}{
+		{
+			Desc:        "on-failure restarts until the budget is exhausted",
+			Task:        TaskConfig{Command: p("exit 3"), RestartPolicy: p("on-failure"), MaxRestarts: n(2)},
+			Expectation: Expectation{Runs: 3, RestartCount: 2, LastExitCode: 3},
+		},
+		{
+			Desc:        "on-failure does not restart after success",
+			Task:        TaskConfig{Command: p("true"), RestartPolicy: p("on-failure"), MaxRestarts: n(2)},
+			Expectation: Expectation{Success: true, Runs: 1},
+		},
+		{
+			Desc:        "always restarts after success",
+			Task:        TaskConfig{Command: p("true"), RestartPolicy: p("always"), MaxRestarts: n(1)},
+			Expectation: Expectation{Runs: 2, RestartCount: 1},
+		},
+		{
+			Desc:        "zero max restarts",
+			Task:        TaskConfig{Command: p("exit 1"), RestartPolicy: p("on-failure"), MaxRestarts: n(0)},
+			Expectation: Expectation{Runs: 1, LastExitCode: 1},
+		},
+	}
+	for i, test := range tests {
+		t.Run(test.Desc, func(t *testing.T) {
+			runs := filepath.Join(dir, strconv.Itoa(i))
+			command := "echo run >> " + runs + "; " + *test.Task.Command
+			test.Task.Command = &command
+			gitpodTasks, err := json.Marshal([]TaskConfig{test.Task})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var (
+				terminalService = terminal.NewMuxTerminalService(terminal.NewMux())
+				contentState    = NewInMemoryContentState("")
+				taskManager     = newTasksManager(&Config{
+					WorkspaceConfig: WorkspaceConfig{
+						GitpodTasks:    string(gitpodTasks),
+						GitpodHeadless: "false",
+					},
+				}, terminalService, contentState, nil, nil)
+			)
+			taskManager.storeLocation = t.TempDir()
+			taskManager.restartBackoff = 10 * time.Millisecond
+			contentState.MarkContentReady(csapi.WorkspaceInitFromOther)
+			var wg sync.WaitGroup
+			wg.Add(1)
+			tasksSuccessChan := make(chan taskSuccess, 1)
+			go taskManager.Run(context.Background(), &wg, tasksSuccessChan)
+			wg.Wait()
+
+			content, err := os.ReadFile(runs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			status := taskManager.Status()[0]
+			act := Expectation{
+				Success:      !(<-tasksSuccessChan).Failed(),
+				Runs:         strings.Count(string(content), "run"),
+				RestartCount: status.RestartCount,
+				LastExitCode: status.LastExitCode,
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+			if hasRestarted := status.LastRestartTime != nil; hasRestarted != (status.RestartCount > 0) {
+				t.Errorf("unexpected last restart time %v for %d restarts", status.LastRestartTime, status.RestartCount)
+			}
+		})
+	}
+}
+
+func TestRestartBackoff(t *testing.T) {
+	tests := []struct {
+		RestartCount int
+		Expectation  time.Duration
+	}{
+		{RestartCount: 0, Expectation: 1 * time.Second},
+		{RestartCount: 1, Expectation: 2 * time.Second},
+		{RestartCount: 5, Expectation: 32 * time.Second},
+		{RestartCount: 6, Expectation: maxRestartBackoff},
+		{RestartCount: 100, Expectation: maxRestartBackoff},
+	}
+	for _, test := range tests {
+		t.Run(strconv.Itoa(test.RestartCount), func(t *testing.T) {
+			if act := restartBackoff(initialRestartBackoff, test.RestartCount); act != test.Expectation {
+				t.Errorf("unexpected restartBackoff(): expected %v, got %v", test.Expectation, act)
+			}
+		})
+	}
+}
+
 type testHeadlessTaskProgressReporter struct {
 	Done    bool
 	Success bool
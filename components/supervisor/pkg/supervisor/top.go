@@ -28,12 +28,17 @@ type TopService struct {
 	ready     chan struct{}
 	readyOnce sync.Once
 	top       func(ctx context.Context) (*api.ResourcesStatusResponse, error)
+	// details adds the process, disk, IO and network breakdown if not nil
+	details *resourceDetails
 }
 
-func NewTopService() *TopService {
+// NewTopService creates a new top service. workspaceLocation is the directory whose disk quota is reported,
+// and tasks returns the running tasks by the PID of their terminal to attribute processes to tasks.
+func NewTopService(workspaceLocation string, tasks func() map[uint64]taskRef) *TopService {
 	log.Debug("gitpod top service: initialized")
 	return &TopService{
-		top: Top,
+		top:     Top,
+		details: newResourceDetails(workspaceLocation, tasks),
 	}
 }
 
@@ -55,6 +60,9 @@ func (t *TopService) Observe(ctx context.Context) {
 				log.WithField("error", err).Errorf("failed to retrieve resource status from upstream, trying again in %d seconds...", uint32(delay.Seconds()))
 			} else {
 				delay = minReconnectionDelay
+				if t.details != nil {
+					t.details.add(data)
+				}
 				t.data = data
 
 				t.readyOnce.Do(func() {
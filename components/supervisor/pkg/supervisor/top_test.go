@@ -16,7 +16,7 @@ import (
 func TestTopServiceHappyPath(t *testing.T) {
 	ctx := context.Background()
 
-	topService := NewTopService()
+	topService := NewTopService("", nil)
 	topService.Observe(ctx)
 
 	<-topService.ready
@@ -43,7 +43,7 @@ func TestTopServiceWithUpstreamFailure(t *testing.T) {
 
 	var isFirstRun = true
 
-	topService := NewTopService()
+	topService := NewTopService("", nil)
 	topService.top = func(ctx context.Context) (*api.ResourcesStatusResponse, error) {
 		if isFirstRun {
 			isFirstRun = false
@@ -0,0 +1,232 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package terminal
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/creack/pty"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/content-service/pkg/logs"
+)
+
+const (
+	// RecordAnnotation enables the recording of a terminal if set to "true" when it is opened
+	RecordAnnotation = "gitpod.io/record"
+
+	// DefaultRecordingMaxFileSize is the size in bytes after which a recording is rotated
+	DefaultRecordingMaxFileSize = 10 << 20
+	// DefaultRecordingMaxFiles is the number of rotated files kept per recording
+	DefaultRecordingMaxFiles = 3
+)
+
+// RecordingOptions configures the recording of a terminal in the asciicast v2 format,
+// see https://github.com/asciinema/asciinema/blob/develop/doc/asciicast-v2.md.
+type RecordingOptions struct {
+	// Dir is the directory the recording is written to
+	Dir string
+	// Name identifies the recording in Dir. Use an empty name for the terminal alias.
+	Name string
+	// MaxFileSize is the size in bytes after which the recording is continued in a new file.
+	// Use 0 for DefaultRecordingMaxFileSize.
+	MaxFileSize int64
+	// MaxFiles is the number of rotated files, i.e. <path>.1 to <path>.<MaxFiles>, which are kept.
+	// Use 0 for DefaultRecordingMaxFiles.
+	MaxFiles int
+}
+
+// asciicastHeader is the first line of an asciicast v2 file
+type asciicastHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// asciicastRecorder writes the output and the resize events of a terminal to asciicast v2 files
+type asciicastRecorder struct {
+	path  string
+	opts  RecordingOptions
+	title string
+	env   map[string]string
+
+	mu      sync.Mutex
+	file    *os.File
+	written int64
+	start   time.Time
+	size    pty.Winsize
+	// pending holds the beginning of a UTF-8 sequence which is completed by the next write
+	pending []byte
+}
+
+func newAsciicastRecorder(alias string, opts RecordingOptions, size *pty.Winsize, title string, env map[string]string) (*asciicastRecorder, error) {
+	if opts.Name == "" {
+		opts.Name = alias
+	}
+	if opts.MaxFileSize == 0 {
+		opts.MaxFileSize = DefaultRecordingMaxFileSize
+	}
+	if opts.MaxFiles == 0 {
+		opts.MaxFiles = DefaultRecordingMaxFiles
+	}
+	rec := &asciicastRecorder{
+		path:  logs.TerminalRecordingFileName(opts.Dir, opts.Name),
+		opts:  opts,
+		title: title,
+		env:   env,
+		size:  pty.Winsize{Cols: 80, Rows: 24},
+	}
+	if size != nil {
+		rec.size = *size
+	}
+
+	// a recording of a former terminal with the same name is kept as rotated file
+	err := rec.rotate()
+	if err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// rotate moves the current file to <path>.1, shifts the older files and starts a new file.
+// Callers are expected to hold mu.
+func (rec *asciicastRecorder) rotate() error {
+	if rec.file != nil {
+		err := rec.file.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, err := os.Stat(rec.path); err == nil {
+		_ = os.Remove(fmt.Sprintf("%s.%d", rec.path, rec.opts.MaxFiles))
+		for i := rec.opts.MaxFiles - 1; i > 0; i-- {
+			_ = os.Rename(fmt.Sprintf("%s.%d", rec.path, i), fmt.Sprintf("%s.%d", rec.path, i+1))
+		}
+		err = os.Rename(rec.path, rec.path+".1")
+		if err != nil {
+			return xerrors.Errorf("cannot rotate terminal recording: %w", err)
+		}
+	}
+
+	file, err := os.OpenFile(rec.path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return xerrors.Errorf("cannot create terminal recording: %w", err)
+	}
+	rec.file = file
+	rec.written = 0
+	rec.start = time.Now()
+
+	header, err := json.Marshal(asciicastHeader{
+		Version:   2,
+		Width:     int(rec.size.Cols),
+		Height:    int(rec.size.Rows),
+		Timestamp: rec.start.Unix(),
+		Title:     rec.title,
+		Env:       rec.env,
+	})
+	if err != nil {
+		return err
+	}
+	return rec.writeLine(header)
+}
+
+// Callers are expected to hold mu.
+func (rec *asciicastRecorder) writeLine(line []byte) error {
+	n, err := rec.file.Write(append(line, '\n'))
+	rec.written += int64(n)
+	return err
+}
+
+// Callers are expected to hold mu.
+func (rec *asciicastRecorder) writeEvent(code string, data string) {
+	if rec.file == nil {
+		return
+	}
+	if rec.written >= rec.opts.MaxFileSize {
+		err := rec.rotate()
+		if err != nil {
+			log.WithError(err).WithField("path", rec.path).Warn("stopping terminal recording")
+			rec.file = nil
+			return
+		}
+	}
+
+	event, err := json.Marshal([]interface{}{time.Since(rec.start).Seconds(), code, data})
+	if err != nil {
+		return
+	}
+	err = rec.writeLine(event)
+	if err != nil {
+		log.WithError(err).WithField("path", rec.path).Warn("cannot write terminal recording")
+	}
+}
+
+// Write records terminal output
+func (rec *asciicastRecorder) Write(p []byte) (n int, err error) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	data := append(rec.pending, p...)
+	cut := len(data) - incompleteRuneSuffix(data)
+	rec.pending = append([]byte(nil), data[cut:]...)
+	if cut > 0 {
+		rec.writeEvent("o", string(data[:cut]))
+	}
+	return len(p), nil
+}
+
+// Resize records a change of the terminal size
+func (rec *asciicastRecorder) Resize(size *pty.Winsize) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	rec.size = *size
+	rec.writeEvent("r", fmt.Sprintf("%dx%d", size.Cols, size.Rows))
+}
+
+// Close ends the recording
+func (rec *asciicastRecorder) Close() error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	if rec.file == nil {
+		return nil
+	}
+	if len(rec.pending) > 0 {
+		rec.writeEvent("o", string(rec.pending))
+		rec.pending = nil
+	}
+	err := rec.file.Close()
+	rec.file = nil
+	return err
+}
+
+// incompleteRuneSuffix returns the length of an incomplete UTF-8 sequence at the end of p
+func incompleteRuneSuffix(p []byte) int {
+	for i := 1; i < utf8.UTFMax && i <= len(p); i++ {
+		b := p[len(p)-i]
+		if b < utf8.RuneSelf {
+			// ASCII never continues a sequence
+			return 0
+		}
+		if utf8.RuneStart(b) {
+			if utf8.FullRune(p[len(p)-i:]) {
+				return 0
+			}
+			return i
+		}
+	}
+	return 0
+}
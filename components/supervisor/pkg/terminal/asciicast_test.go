@@ -0,0 +1,125 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package terminal
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/creack/pty"
+	"github.com/google/go-cmp/cmp"
+)
+
+func readAsciicast(t *testing.T, fn string) (header asciicastHeader, events [][]interface{}) {
+	f, err := os.Open(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Scan()
+	err = json.Unmarshal(scanner.Bytes(), &header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for scanner.Scan() {
+		var event []interface{}
+		err = json.Unmarshal(scanner.Bytes(), &event)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the time differs from run to run
+		events = append(events, event[1:])
+	}
+	return
+}
+
+func TestAsciicastRecorder(t *testing.T) {
+	dir := t.TempDir()
+	rec, err := newAsciicastRecorder("alias", RecordingOptions{Dir: dir}, &pty.Winsize{Cols: 100, Rows: 30}, "test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	euro := []byte("€")
+	_, _ = rec.Write([]byte("hello "))
+	// a multi-byte character split across two writes is recorded once it is complete
+	_, _ = rec.Write(euro[:1])
+	_, _ = rec.Write(euro[1:])
+	rec.Resize(&pty.Winsize{Cols: 120, Rows: 40})
+	err = rec.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header, events := readAsciicast(t, filepath.Join(dir, "terminal-recording-alias.cast"))
+	if diff := cmp.Diff(asciicastHeader{Version: 2, Width: 100, Height: 30, Timestamp: header.Timestamp, Title: "test"}, header); diff != "" {
+		t.Errorf("unexpected header (-want +got):\n%s", diff)
+	}
+	expectedEvents := [][]interface{}{
+		{"o", "hello "},
+		{"o", "€"},
+		{"r", "120x40"},
+	}
+	if diff := cmp.Diff(expectedEvents, events); diff != "" {
+		t.Errorf("unexpected events (-want +got):\n%s", diff)
+	}
+}
+
+func TestAsciicastRecorderRotation(t *testing.T) {
+	dir := t.TempDir()
+	opts := RecordingOptions{Dir: dir, Name: "task-0", MaxFileSize: 100, MaxFiles: 2}
+	rec, err := newAsciicastRecorder("alias", opts, nil, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, out := range []string{"first", "second", "third", "fourth"} {
+		// each output exceeds the max file size, so that every write starts a new file
+		_, _ = rec.Write([]byte(out + string(make([]byte, 100))))
+	}
+	err = rec.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fn := filepath.Join(dir, "terminal-recording-task-0.cast")
+	for i, expectation := range map[string]string{fn: "fourth", fn + ".1": "third", fn + ".2": "second"} {
+		header, events := readAsciicast(t, i)
+		if header.Width != 80 || header.Height != 24 {
+			t.Errorf("unexpected size of %s: %dx%d", i, header.Width, header.Height)
+		}
+		if len(events) != 1 || events[0][1].(string)[:len(expectation)] != expectation {
+			t.Errorf("unexpected events of %s: %v", i, events)
+		}
+	}
+	if _, err := os.Stat(fn + ".3"); err == nil {
+		t.Errorf("expected at most %d rotated files", opts.MaxFiles)
+	}
+}
+
+func TestIncompleteRuneSuffix(t *testing.T) {
+	euro := []byte("€")
+	tests := []struct {
+		Name        string
+		Input       []byte
+		Expectation int
+	}{
+		{Name: "empty", Input: nil, Expectation: 0},
+		{Name: "ascii", Input: []byte("foo"), Expectation: 0},
+		{Name: "complete", Input: append([]byte("foo"), euro...), Expectation: 0},
+		{Name: "first byte", Input: append([]byte("foo"), euro[:1]...), Expectation: 1},
+		{Name: "two bytes", Input: append([]byte("foo"), euro[:2]...), Expectation: 2},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if act := incompleteRuneSuffix(test.Input); act != test.Expectation {
+				t.Errorf("unexpected incompleteRuneSuffix(): expected %d, got %d", test.Expectation, act)
+			}
+		})
+	}
+}
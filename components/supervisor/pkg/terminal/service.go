@@ -22,6 +22,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/content-service/pkg/logs"
 	"github.com/gitpod-io/gitpod/supervisor/api"
 )
 
@@ -32,10 +33,11 @@ func NewMuxTerminalService(m *Mux) *MuxTerminalService {
 		shell = "/bin/bash"
 	}
 	return &MuxTerminalService{
-		Mux:            m,
-		DefaultWorkdir: "/workspace",
-		DefaultShell:   shell,
-		Env:            os.Environ(),
+		Mux:               m,
+		DefaultWorkdir:    "/workspace",
+		DefaultShell:      shell,
+		Env:               os.Environ(),
+		RecordingLocation: logs.TerminalStoreLocation,
 	}
 }
 
@@ -50,8 +52,16 @@ type MuxTerminalService struct {
 
 	DefaultShell string
 	Env          []string
+	// EnvProvider allows dynamically to compute the environment of new terminals
+	// if set it takes precedence over Env
+	EnvProvider  func() []string
 	DefaultCreds *syscall.Credential
 
+	// RecordingLocation is the directory of the recordings of terminals opened with the RecordAnnotation
+	RecordingLocation string
+
+	shares shares
+
 	api.UnimplementedTerminalServiceServer
 }
 
@@ -94,13 +104,20 @@ func (srv *MuxTerminalService) OpenWithOptions(ctx context.Context, req *api.Ope
 	if cmd.Dir == "" {
 		cmd.Dir = srv.DefaultWorkdir
 	}
-	cmd.Env = append(srv.Env, "TERM=xterm-256color")
+	env := srv.Env
+	if srv.EnvProvider != nil {
+		env = srv.EnvProvider()
+	}
+	cmd.Env = append(env, "TERM=xterm-256color")
 	for key, value := range req.Env {
 		cmd.Env = append(cmd.Env, fmt.Sprintf("%v=%v", key, value))
 	}
 	for k, v := range req.Annotations {
 		options.Annotations[k] = v
 	}
+	if options.Recording == nil && options.Annotations[RecordAnnotation] == "true" {
+		options.Recording = &RecordingOptions{Dir: srv.RecordingLocation}
+	}
 	if req.Size != nil {
 		options.Size = &pty.Winsize{
 			Cols: uint16(req.Size.Cols),
@@ -218,12 +235,18 @@ func (srv *MuxTerminalService) Listen(req *api.ListenTerminalRequest, resp api.T
 	if !ok {
 		return status.Error(codes.NotFound, "terminal not found")
 	}
-	stdout := term.Stdout.Listen()
-	defer stdout.Close()
 
 	log.WithField("alias", req.Alias).Info("new terminal client")
 	defer log.WithField("alias", req.Alias).Info("terminal client left")
 
+	return listen(resp.Context(), term, resp.Send)
+}
+
+// listen streams the output, title changes and exit code of a terminal until ctx is done or the terminal exits.
+func listen(ctx context.Context, term *Term, send func(*api.ListenTerminalResponse) error) error {
+	stdout := term.Stdout.Listen()
+	defer stdout.Close()
+
 	errchan := make(chan error, 1)
 	messages := make(chan *api.ListenTerminalResponse, 1)
 	go func() {
@@ -257,7 +280,7 @@ func (srv *MuxTerminalService) Listen(req *api.ListenTerminalRequest, resp api.T
 		defer t.Stop()
 		for {
 			select {
-			case <-resp.Context().Done():
+			case <-ctx.Done():
 				return
 			case <-t.C:
 				newTitle, newTitleSource, _ := term.GetTitle()
@@ -274,10 +297,10 @@ func (srv *MuxTerminalService) Listen(req *api.ListenTerminalRequest, resp api.T
 		var err error
 		select {
 		case message := <-messages:
-			err = resp.Send(message)
+			err = send(message)
 		case err = <-errchan:
-		case <-resp.Context().Done():
-			return status.Error(codes.DeadlineExceeded, resp.Context().Err().Error())
+		case <-ctx.Done():
+			return status.Error(codes.DeadlineExceeded, ctx.Err().Error())
 		}
 		if err == io.EOF {
 			// EOF isn't really an error here
@@ -320,7 +343,7 @@ func (srv *MuxTerminalService) SetSize(ctx context.Context, req *api.SetTerminal
 		return nil, status.Error(codes.FailedPrecondition, "wrong token or force not set")
 	}
 
-	err := pty.Setsize(term.PTY, &pty.Winsize{
+	err := term.Resize(&pty.Winsize{
 		Cols: uint16(req.Size.Cols),
 		Rows: uint16(req.Size.Rows),
 		X:    uint16(req.Size.WidthPx),
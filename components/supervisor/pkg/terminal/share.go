@@ -0,0 +1,232 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package terminal
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+const (
+	// DefaultShareTTL is the time until a terminal share expires if the request does not ask for a different one
+	DefaultShareTTL = time.Hour
+	// MaxShareTTL is the longest time a terminal share can be valid for
+	MaxShareTTL = 24 * time.Hour
+)
+
+// shares holds the read-only shares of terminals. Viewers of a share can listen to the terminal's output,
+// but never write to or resize it.
+type shares struct {
+	mu         sync.Mutex
+	shares     map[string]*share
+	nextViewer uint64
+}
+
+type share struct {
+	ID        string
+	Alias     string
+	Token     string
+	ExpiresAt time.Time
+
+	// ctx is canceled when the share expires or is revoked, which disconnects all viewers
+	ctx     context.Context
+	cancel  context.CancelFunc
+	viewers map[uint64]string
+}
+
+func (s *share) toAPI() *api.TerminalShare {
+	viewers := make([]string, 0, len(s.viewers))
+	for _, v := range s.viewers {
+		viewers = append(viewers, v)
+	}
+	sort.Strings(viewers)
+	return &api.TerminalShare{
+		Id:        s.ID,
+		Alias:     s.Alias,
+		ExpiresAt: timestamppb.New(s.ExpiresAt),
+		Viewers:   viewers,
+	}
+}
+
+func (s *shares) add(alias string, ttl time.Duration) (*share, error) {
+	id, err := randomString(8, hex.EncodeToString)
+	if err != nil {
+		return nil, err
+	}
+	token, err := randomString(32, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := time.Now().Add(ttl)
+	ctx, cancel := context.WithDeadline(context.Background(), expiresAt)
+	res := &share{
+		ID:        id,
+		Alias:     alias,
+		Token:     token,
+		ExpiresAt: expiresAt,
+		ctx:       ctx,
+		cancel:    cancel,
+		viewers:   make(map[uint64]string),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.shares == nil {
+		s.shares = make(map[string]*share)
+	}
+	s.shares[id] = res
+	return res, nil
+}
+
+func (s *shares) remove(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sh, ok := s.shares[id]
+	if !ok {
+		return false
+	}
+	sh.cancel()
+	delete(s.shares, id)
+	return true
+}
+
+// list returns the active shares. Shares which expired or whose terminal is gone are removed.
+func (s *shares) list(exists func(alias string) bool) []*api.TerminalShare {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := make([]*api.TerminalShare, 0, len(s.shares))
+	for id, sh := range s.shares {
+		if sh.ctx.Err() != nil || !exists(sh.Alias) {
+			sh.cancel()
+			delete(s.shares, id)
+			continue
+		}
+		res = append(res, sh.toAPI())
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ExpiresAt.AsTime().Before(res[j].ExpiresAt.AsTime()) })
+	return res
+}
+
+// join registers a viewer with the share the token belongs to. The returned function removes the viewer again.
+func (s *shares) join(token, viewer string) (sh *share, leave func(), ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, candidate := range s.shares {
+		if subtle.ConstantTimeCompare([]byte(candidate.Token), []byte(token)) == 1 {
+			sh = candidate
+			break
+		}
+	}
+	if sh == nil || sh.ctx.Err() != nil {
+		return nil, nil, false
+	}
+
+	id := s.nextViewer
+	s.nextViewer++
+	sh.viewers[id] = viewer
+	return sh, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(sh.viewers, id)
+	}, true
+}
+
+func randomString(size int, encode func([]byte) string) (string, error) {
+	b := make([]byte, size)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return encode(b), nil
+}
+
+// Share creates a short-lived, read-only share of a terminal.
+func (srv *MuxTerminalService) Share(ctx context.Context, req *api.ShareTerminalRequest) (*api.ShareTerminalResponse, error) {
+	if _, ok := srv.Mux.Get(req.Alias); !ok {
+		return nil, status.Error(codes.NotFound, "terminal not found")
+	}
+	ttl := DefaultShareTTL
+	if req.TtlSeconds > 0 {
+		ttl = time.Duration(req.TtlSeconds) * time.Second
+	}
+	if ttl > MaxShareTTL {
+		return nil, status.Errorf(codes.InvalidArgument, "terminal shares cannot be valid for longer than %s", MaxShareTTL)
+	}
+
+	sh, err := srv.shares.add(req.Alias, ttl)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	log.WithField("alias", req.Alias).WithField("share", sh.ID).WithField("expiresAt", sh.ExpiresAt).Info("terminal shared")
+	return &api.ShareTerminalResponse{
+		Share: sh.toAPI(),
+		Token: sh.Token,
+	}, nil
+}
+
+// Unshare revokes a terminal share and disconnects all of its viewers.
+func (srv *MuxTerminalService) Unshare(ctx context.Context, req *api.UnshareTerminalRequest) (*api.UnshareTerminalResponse, error) {
+	if !srv.shares.remove(req.Id) {
+		return nil, status.Error(codes.NotFound, "terminal share not found")
+	}
+	log.WithField("share", req.Id).Info("terminal share revoked")
+	return &api.UnshareTerminalResponse{}, nil
+}
+
+// ListShares lists the active terminal shares.
+func (srv *MuxTerminalService) ListShares(ctx context.Context, req *api.ListTerminalSharesRequest) (*api.ListTerminalSharesResponse, error) {
+	return &api.ListTerminalSharesResponse{
+		Shares: srv.shares.list(func(alias string) bool {
+			_, ok := srv.Mux.Get(alias)
+			return ok
+		}),
+	}, nil
+}
+
+// ListenShared listens read-only to a shared terminal.
+func (srv *MuxTerminalService) ListenShared(req *api.ListenSharedTerminalRequest, resp api.TerminalService_ListenSharedServer) error {
+	sh, leave, ok := srv.shares.join(req.Token, req.Viewer)
+	if !ok {
+		return status.Error(codes.PermissionDenied, "terminal share is invalid, expired or was revoked")
+	}
+	defer leave()
+
+	term, ok := srv.Mux.Get(sh.Alias)
+	if !ok {
+		return status.Error(codes.NotFound, "terminal not found")
+	}
+
+	ctx, cancel := context.WithCancel(resp.Context())
+	defer cancel()
+	go func() {
+		select {
+		case <-sh.ctx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	log.WithField("alias", sh.Alias).WithField("share", sh.ID).WithField("viewer", req.Viewer).Info("new terminal share viewer")
+	defer log.WithField("alias", sh.Alias).WithField("share", sh.ID).WithField("viewer", req.Viewer).Info("terminal share viewer left")
+
+	err := listen(ctx, term, resp.Send)
+	if sh.ctx.Err() != nil && resp.Context().Err() == nil {
+		return status.Error(codes.PermissionDenied, "terminal share expired or was revoked")
+	}
+	return err
+}
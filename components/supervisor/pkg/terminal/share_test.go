@@ -0,0 +1,173 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package terminal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+type testShareListener struct {
+	ctx   context.Context
+	resps chan *api.ListenTerminalResponse
+	grpc.ServerStream
+}
+
+func (listener *testShareListener) Send(resp *api.ListenTerminalResponse) error {
+	select {
+	case listener.resps <- resp:
+	case <-listener.ctx.Done():
+	}
+	return nil
+}
+
+func (listener *testShareListener) Context() context.Context {
+	return listener.ctx
+}
+
+func TestShareTerminal(t *testing.T) {
+	terminalService := NewMuxTerminalService(NewMux())
+	terminalService.DefaultWorkdir = t.TempDir()
+
+	term, err := terminalService.Open(context.Background(), &api.OpenTerminalRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	alias := term.Terminal.Alias
+	defer func() {
+		_, _ = terminalService.Write(context.Background(), &api.WriteTerminalRequest{Alias: alias, Stdin: []byte("exit\r\n")})
+	}()
+
+	_, err = terminalService.Share(context.Background(), &api.ShareTerminalRequest{Alias: "unknown"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected sharing an unknown terminal to fail with NotFound, got %v", err)
+	}
+	_, err = terminalService.Share(context.Background(), &api.ShareTerminalRequest{Alias: alias, TtlSeconds: uint32((MaxShareTTL + time.Second).Seconds())})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected sharing beyond the max TTL to fail with InvalidArgument, got %v", err)
+	}
+
+	shared, err := terminalService.Share(context.Background(), &api.ShareTerminalRequest{Alias: alias})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expiresIn := time.Until(shared.Share.ExpiresAt.AsTime()); expiresIn <= 0 || expiresIn > DefaultShareTTL {
+		t.Errorf("expected the share to expire within %s, got %s", DefaultShareTTL, expiresIn)
+	}
+
+	err = terminalService.ListenShared(&api.ListenSharedTerminalRequest{Token: "invalid"}, &testShareListener{ctx: context.Background()})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected listening with an invalid token to fail with PermissionDenied, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	listener := &testShareListener{ctx: ctx, resps: make(chan *api.ListenTerminalResponse)}
+	errchan := make(chan error, 1)
+	go func() {
+		errchan <- terminalService.ListenShared(&api.ListenSharedTerminalRequest{Token: shared.Token, Viewer: "alice"}, listener)
+	}()
+	select {
+	case <-listener.resps:
+	case err := <-errchan:
+		t.Fatalf("viewer stopped listening: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("viewer did not receive any output")
+	}
+	go func() {
+		// keep on consuming the output until the viewer is disconnected
+		for range listener.resps {
+		}
+	}()
+
+	list, err := terminalService.ListShares(context.Background(), &api.ListTerminalSharesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{shared.Share.Id + "/" + alias + ": alice"}, describeShares(list.Shares)); diff != "" {
+		t.Errorf("unexpected shares (-want +got):\n%s", diff)
+	}
+
+	_, err = terminalService.Unshare(context.Background(), &api.UnshareTerminalRequest{Id: shared.Share.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-errchan:
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("expected revoking the share to disconnect the viewer with PermissionDenied, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("revoking the share did not disconnect the viewer")
+	}
+
+	list, err = terminalService.ListShares(context.Background(), &api.ListTerminalSharesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Shares) != 0 {
+		t.Errorf("expected no shares after revoking, got %v", describeShares(list.Shares))
+	}
+	_, err = terminalService.Unshare(context.Background(), &api.UnshareTerminalRequest{Id: shared.Share.Id})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected revoking a share twice to fail with NotFound, got %v", err)
+	}
+}
+
+func TestSharesList(t *testing.T) {
+	var s shares
+	active, err := s.add("active", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.add("expired", -time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.add("closed", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, leave, ok := s.join(active.Token, "bob")
+	if !ok {
+		t.Fatal("cannot join active share")
+	}
+	if _, _, ok := s.join("", "mallory"); ok {
+		t.Error("joined a share without a token")
+	}
+
+	exists := func(alias string) bool { return alias != "closed" }
+	if diff := cmp.Diff([]string{active.ID + "/active: bob"}, describeShares(s.list(exists))); diff != "" {
+		t.Errorf("unexpected shares (-want +got):\n%s", diff)
+	}
+	leave()
+	if diff := cmp.Diff([]string{active.ID + "/active: "}, describeShares(s.list(exists))); diff != "" {
+		t.Errorf("unexpected shares after the viewer left (-want +got):\n%s", diff)
+	}
+}
+
+func describeShares(shares []*api.TerminalShare) []string {
+	res := make([]string, 0, len(shares))
+	for _, sh := range shares {
+		desc := sh.Id + "/" + sh.Alias + ": "
+		for i, v := range sh.Viewers {
+			if i > 0 {
+				desc += ", "
+			}
+			desc += v
+		}
+		res = append(res, desc)
+	}
+	return res
+}
@@ -127,6 +127,13 @@ func (m *Mux) doClose(ctx context.Context, alias string) error {
 
 	log := log.WithField("alias", alias)
 	log.Info("closing terminal")
+	select {
+	case <-term.waitDone:
+	default:
+		term.mu.Lock()
+		term.terminated = true
+		term.mu.Unlock()
+	}
 	if term.Command.Process != nil {
 		err := process.TerminateSync(ctx, term.Command.Process.Pid)
 		if err != nil {
@@ -174,6 +181,15 @@ func newTerm(alias string, pty *os.File, cmd *exec.Cmd, options TermOptions) (*T
 	if timeout == 0 {
 		timeout = NoTimeout
 	}
+	var cast *asciicastRecorder
+	if options.Recording != nil {
+		env := map[string]string{"SHELL": cmd.Path, "TERM": "xterm-256color"}
+		cast, err = newAsciicastRecorder(alias, *options.Recording, options.Size, options.Title, env)
+		if err != nil {
+			log.WithError(err).WithField("alias", alias).Warn("cannot record terminal")
+			cast = nil
+		}
+	}
 	res := &Term{
 		PTY:     pty,
 		Command: cmd,
@@ -181,6 +197,7 @@ func newTerm(alias string, pty *os.File, cmd *exec.Cmd, options TermOptions) (*T
 			timeout:   timeout,
 			listener:  make(map[*multiWriterListener]struct{}),
 			recorder:  recorder,
+			cast:      cast,
 			logStdout: options.LogToStdout,
 			logLabel:  alias,
 		},
@@ -231,6 +248,9 @@ type TermOptions struct {
 
 	// LogToStdout forwards the terminal's stdout to supervisor's stdout
 	LogToStdout bool
+
+	// Recording records the terminal if not nil
+	Recording *RecordingOptions
 }
 
 // Term is a pseudo-terminal.
@@ -243,6 +263,7 @@ type Term struct {
 	annotations  map[string]string
 	defaultTitle string
 	title        string
+	terminated   bool
 
 	Stdout *multiWriter
 
@@ -323,6 +344,34 @@ func (term *Term) Wait() (*os.ProcessState, error) {
 	return term.Command.ProcessState, term.waitErr
 }
 
+// Resize changes the size of the terminal.
+func (term *Term) Resize(size *pty.Winsize) error {
+	err := pty.Setsize(term.PTY, size)
+	if err != nil {
+		return err
+	}
+	if term.Stdout.cast != nil {
+		term.Stdout.cast.Resize(size)
+	}
+	return nil
+}
+
+// RecordingPath returns the file the terminal is recorded to, or an empty string if it is not recorded.
+func (term *Term) RecordingPath() string {
+	if term.Stdout.cast == nil {
+		return ""
+	}
+	return term.Stdout.cast.path
+}
+
+// Terminated returns true if the terminal's process was ended by closing the terminal,
+// as opposed to the process exiting on its own.
+func (term *Term) Terminated() bool {
+	term.mu.RLock()
+	defer term.mu.RUnlock()
+	return term.terminated
+}
+
 // multiWriter is like io.MultiWriter, except that we can listener at runtime.
 type multiWriter struct {
 	timeout  time.Duration
@@ -332,6 +381,8 @@ type multiWriter struct {
 	// ring buffer to record last 256kb of pty output
 	// new listener is initialized with the latest recodring first
 	recorder *RingBuffer
+	// cast records the output in the asciicast format if the terminal is recorded
+	cast *asciicastRecorder
 
 	logStdout bool
 	logLabel  string
@@ -465,6 +516,9 @@ func (mw *multiWriter) Write(p []byte) (n int, err error) {
 	defer mw.mu.Unlock()
 
 	mw.recorder.Write(p)
+	if mw.cast != nil {
+		_, _ = mw.cast.Write(p)
+	}
 	if mw.logStdout {
 		log.WithFields(logrus.Fields{
 			"terminalOutput": true,
@@ -505,6 +559,12 @@ func (mw *multiWriter) Close() error {
 			err = cerr
 		}
 	}
+	if mw.cast != nil {
+		cerr := mw.cast.Close()
+		if cerr != nil {
+			err = cerr
+		}
+	}
 	return err
 }
 
@@ -611,6 +611,7 @@ var (
 		"/dev",
 		"/etc/hostname",
 		"/etc/ssl/certs/gitpod-ca.crt",
+		"/.services",
 	}
 	rejectMountPaths = map[string]struct{}{
 		"/etc/resolv.conf": {},
@@ -184,6 +184,8 @@ export class TakeSnapshotRequest extends jspb.Message {
     setId(value: string): TakeSnapshotRequest;
     getReturnImmediately(): boolean;
     setReturnImmediately(value: boolean): TakeSnapshotRequest;
+    getPrebuild(): boolean;
+    setPrebuild(value: boolean): TakeSnapshotRequest;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): TakeSnapshotRequest.AsObject;
@@ -199,6 +201,7 @@ export namespace TakeSnapshotRequest {
     export type AsObject = {
         id: string,
         returnImmediately: boolean,
+        prebuild: boolean,
     }
 }
 
@@ -229,6 +232,8 @@ export class DisposeWorkspaceRequest extends jspb.Message {
     setBackup(value: boolean): DisposeWorkspaceRequest;
     getBackupLogs(): boolean;
     setBackupLogs(value: boolean): DisposeWorkspaceRequest;
+    getBackupRecordings(): boolean;
+    setBackupRecordings(value: boolean): DisposeWorkspaceRequest;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): DisposeWorkspaceRequest.AsObject;
@@ -245,6 +250,7 @@ export namespace DisposeWorkspaceRequest {
         id: string,
         backup: boolean,
         backupLogs: boolean,
+        backupRecordings: boolean,
     }
 }
 
@@ -1501,7 +1501,8 @@ proto.wsdaemon.TakeSnapshotRequest.prototype.toObject = function(opt_includeInst
 proto.wsdaemon.TakeSnapshotRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    returnImmediately: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+    returnImmediately: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    prebuild: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -1546,6 +1547,10 @@ proto.wsdaemon.TakeSnapshotRequest.deserializeBinaryFromReader = function(msg, r
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setReturnImmediately(value);
       break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setPrebuild(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1589,6 +1594,13 @@ proto.wsdaemon.TakeSnapshotRequest.serializeBinaryToWriter = function(message, w
       f
     );
   }
+  f = message.getPrebuild();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -1628,6 +1640,24 @@ proto.wsdaemon.TakeSnapshotRequest.prototype.setReturnImmediately = function(val
 };
 
 
+/**
+ * optional bool prebuild = 3;
+ * @return {boolean}
+ */
+proto.wsdaemon.TakeSnapshotRequest.prototype.getPrebuild = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsdaemon.TakeSnapshotRequest} returns this
+ */
+proto.wsdaemon.TakeSnapshotRequest.prototype.setPrebuild = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
 
 
 
@@ -1792,7 +1822,8 @@ proto.wsdaemon.DisposeWorkspaceRequest.toObject = function(includeInstance, msg)
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
     backup: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    backupLogs: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+    backupLogs: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    backupRecordings: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
   };
 
   if (includeInstance) {
@@ -1841,6 +1872,10 @@ proto.wsdaemon.DisposeWorkspaceRequest.deserializeBinaryFromReader = function(ms
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setBackupLogs(value);
       break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setBackupRecordings(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1891,6 +1926,13 @@ proto.wsdaemon.DisposeWorkspaceRequest.serializeBinaryToWriter = function(messag
       f
     );
   }
+  f = message.getBackupRecordings();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
 };
 
 
@@ -1948,6 +1990,24 @@ proto.wsdaemon.DisposeWorkspaceRequest.prototype.setBackupLogs = function(value)
 };
 
 
+/**
+ * optional bool backup_recordings = 4;
+ * @return {boolean}
+ */
+proto.wsdaemon.DisposeWorkspaceRequest.prototype.getBackupRecordings = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsdaemon.DisposeWorkspaceRequest} returns this
+ */
+proto.wsdaemon.DisposeWorkspaceRequest.prototype.setBackupRecordings = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
 
 
 
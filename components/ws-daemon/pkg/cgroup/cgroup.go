@@ -6,8 +6,11 @@ package cgroup
 
 import (
 	"context"
+	"reflect"
+	"sync"
 
 	"github.com/gitpod-io/gitpod/common-go/cgroups"
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
 	"github.com/prometheus/client_golang/prometheus"
@@ -35,6 +38,7 @@ func NewPluginHost(cgroupBasePath string, plugins ...Plugin) (*PluginHost, error
 			Name: "cgroup_plugin_activation_total",
 			Help: "counts the total activation of cgroup plugins",
 		}, []string{"plugin", "success"}),
+		limits: make(map[string]map[string]string),
 	}, nil
 }
 
@@ -44,9 +48,57 @@ type PluginHost struct {
 	Plugins        []Plugin
 
 	pluginActivationTotalVec *prometheus.CounterVec
+
+	mu sync.Mutex
+	// limits holds the limit annotations of the workspaces the plugins were applied to, keyed by instance ID
+	limits map[string]map[string]string
+}
+
+// updatableLimitAnnotations are the annotations of a workspace pod which carry limits plugins can change while the workspace runs
+var updatableLimitAnnotations = []string{
+	kubernetes.WorkspaceMemoryLimitAnnotation,
+	kubernetes.WorkspaceIOLimitAnnotation,
+}
+
+// workspaceLimits returns the limit annotations of a workspace pod
+func workspaceLimits(annotations map[string]string) map[string]string {
+	res := make(map[string]string, len(updatableLimitAnnotations))
+	for _, k := range updatableLimitAnnotations {
+		if v, ok := annotations[k]; ok {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// limitsChanged returns the limit annotations of a workspace if they changed since they were last recorded.
+// Workspaces which were not added yet have no recorded limits, because the plugins apply their limits once they are.
+func (host *PluginHost) limitsChanged(instanceID string, annotations map[string]string) (limits map[string]string, changed bool) {
+	host.mu.Lock()
+	defer host.mu.Unlock()
+
+	prev, ok := host.limits[instanceID]
+	if !ok {
+		return nil, false
+	}
+	limits = workspaceLimits(annotations)
+	return limits, !reflect.DeepEqual(prev, limits)
+}
+
+// recordLimits remembers the limit annotations the plugins applied to a workspace
+func (host *PluginHost) recordLimits(instanceID string, limits map[string]string) {
+	host.mu.Lock()
+	defer host.mu.Unlock()
+
+	if _, ok := host.limits[instanceID]; !ok {
+		// the workspace is gone already
+		return
+	}
+	host.limits[instanceID] = limits
 }
 
 var _ dispatch.Listener = &PluginHost{}
+var _ dispatch.UpdateListener = &PluginHost{}
 var _ prometheus.Collector = &PluginHost{}
 
 func (host *PluginHost) Describe(c chan<- *prometheus.Desc) {
@@ -91,6 +143,17 @@ func (host *PluginHost) WorkspaceAdded(ctx context.Context, ws *dispatch.Workspa
 		Annotations: ws.Pod.Annotations,
 	}
 
+	host.mu.Lock()
+	host.limits[ws.InstanceID] = workspaceLimits(ws.Pod.Annotations)
+	host.mu.Unlock()
+	go func() {
+		<-ctx.Done()
+
+		host.mu.Lock()
+		delete(host.limits, ws.InstanceID)
+		host.mu.Unlock()
+	}()
+
 	for _, plg := range host.Plugins {
 		if plg.Type() != host.CGroupVersion {
 			continue
@@ -113,12 +176,69 @@ func (host *PluginHost) WorkspaceAdded(ctx context.Context, ws *dispatch.Workspa
 	return nil
 }
 
+// WorkspaceUpdated passes the changed limit annotations of a workspace on to the plugins which can update their limits,
+// e.g. when the workspace was resized to another class. Pod updates which do not change the limits are ignored.
+func (host *PluginHost) WorkspaceUpdated(ctx context.Context, ws *dispatch.Workspace) (err error) {
+	limits, changed := host.limitsChanged(ws.InstanceID, ws.Pod.Annotations)
+	if !changed {
+		return nil
+	}
+
+	disp := dispatch.GetFromContext(ctx)
+	if disp == nil {
+		return xerrors.Errorf("no dispatch available")
+	}
+
+	cgroupPath, err := disp.Runtime.ContainerCGroupPath(context.Background(), ws.ContainerID)
+	if err != nil {
+		return xerrors.Errorf("cannot get cgroup path for container %s: %w", ws.ContainerID, err)
+	}
+
+	opts := &PluginOptions{
+		BasePath:    host.CGroupBasePath,
+		CgroupPath:  cgroupPath,
+		InstanceId:  ws.InstanceID,
+		Annotations: ws.Pod.Annotations,
+	}
+
+	var failed bool
+	for _, plg := range host.Plugins {
+		if plg.Type() != host.CGroupVersion {
+			continue
+		}
+		uplg, ok := plg.(UpdatablePlugin)
+		if !ok {
+			continue
+		}
+
+		err := uplg.WorkspaceUpdated(ctx, opts)
+		if err != nil {
+			log.WithError(err).WithFields(ws.OWI()).WithField("plugin", plg.Name()).Error("cgroup plugin update failure")
+			failed = true
+		}
+	}
+	if !failed {
+		// failed updates are retried on the next update of the workspace pod
+		host.recordLimits(ws.InstanceID, limits)
+	}
+
+	return nil
+}
+
 type Plugin interface {
 	Name() string
 	Type() Version
 	Apply(ctx context.Context, options *PluginOptions) error
 }
 
+// UpdatablePlugin is a plugin which can change the limits it applied while the workspace is running.
+// WorkspaceUpdated is called when one of the limit annotations of the workspace pod changed, hence plugins
+// must act on changes of the annotations they care about only.
+type UpdatablePlugin interface {
+	Plugin
+	WorkspaceUpdated(ctx context.Context, options *PluginOptions) error
+}
+
 type Version int
 
 const (
@@ -0,0 +1,95 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cgroup
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+)
+
+func TestPluginHostLimitsChanged(t *testing.T) {
+	host := &PluginHost{limits: map[string]map[string]string{
+		"foobar": workspaceLimits(map[string]string{
+			kubernetes.WorkspaceMemoryLimitAnnotation: "4Gi",
+			"gitpod/ready": "true",
+		}),
+	}}
+
+	tests := []struct {
+		Name        string
+		InstanceID  string
+		Annotations map[string]string
+		Changed     bool
+	}{
+		{
+			Name:        "unknown workspace",
+			InstanceID:  "unknown",
+			Annotations: map[string]string{kubernetes.WorkspaceMemoryLimitAnnotation: "8Gi"},
+		},
+		{
+			Name:       "other annotation changed",
+			InstanceID: "foobar",
+			Annotations: map[string]string{
+				kubernetes.WorkspaceMemoryLimitAnnotation: "4Gi",
+				"gitpod/ready": "false",
+			},
+		},
+		{
+			Name:        "memory limit changed",
+			InstanceID:  "foobar",
+			Annotations: map[string]string{kubernetes.WorkspaceMemoryLimitAnnotation: "8Gi"},
+			Changed:     true,
+		},
+		{
+			Name:       "IO limit added",
+			InstanceID: "foobar",
+			Annotations: map[string]string{
+				kubernetes.WorkspaceMemoryLimitAnnotation: "4Gi",
+				kubernetes.WorkspaceIOLimitAnnotation:     `{"readIOPS":1000}`,
+			},
+			Changed: true,
+		},
+		{
+			Name:       "memory limit removed",
+			InstanceID: "foobar",
+			Changed:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			_, changed := host.limitsChanged(test.InstanceID, test.Annotations)
+			if changed != test.Changed {
+				t.Errorf("expected changed to be %v, but got %v", test.Changed, changed)
+			}
+		})
+	}
+
+	// an update which changes no limit must not need the dispatch to find the workspace's cgroup
+	err := host.WorkspaceUpdated(context.Background(), &dispatch.Workspace{
+		InstanceID: "foobar",
+		Pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			kubernetes.WorkspaceMemoryLimitAnnotation: "4Gi",
+		}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	limits, _ := host.limitsChanged("foobar", map[string]string{kubernetes.WorkspaceMemoryLimitAnnotation: "8Gi"})
+	host.recordLimits("foobar", limits)
+	if _, changed := host.limitsChanged("foobar", map[string]string{kubernetes.WorkspaceMemoryLimitAnnotation: "8Gi"}); changed {
+		t.Error("expected recorded limits to be unchanged")
+	}
+	host.recordLimits("unknown", limits)
+	if _, ok := host.limits["unknown"]; ok {
+		t.Error("expected limits of a workspace which is gone to not be recorded")
+	}
+}
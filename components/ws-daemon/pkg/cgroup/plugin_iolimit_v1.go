@@ -6,6 +6,7 @@ package cgroup
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -13,6 +14,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	lru "github.com/hashicorp/golang-lru"
 	"golang.org/x/xerrors"
@@ -25,8 +27,35 @@ type ioLimitOptions struct {
 	ReadIOPS            int64
 }
 
+// ioLimitOverrides holds the limits of workspaces which do not use the limits of the node, keyed by instance ID.
+// ws-manager records those limits on the workspace pod when the class of a workspace limits I/O.
+type ioLimitOverrides map[string]ioLimitOptions
+
+// set updates the limits of a workspace from its annotations and returns true if they changed
+func (o ioLimitOverrides) set(instanceID string, annotations map[string]string) (changed bool, err error) {
+	v, ok := annotations[kubernetes.WorkspaceIOLimitAnnotation]
+	if !ok {
+		_, changed = o[instanceID]
+		delete(o, instanceID)
+		return changed, nil
+	}
+
+	var l kubernetes.WorkspaceIOLimit
+	err = json.Unmarshal([]byte(v), &l)
+	if err != nil {
+		return false, xerrors.Errorf("cannot parse %s: %w", kubernetes.WorkspaceIOLimitAnnotation, err)
+	}
+	limits := buildLimits(l.WriteBytesPerSecond, l.ReadBytesPerSecond, l.WriteIOPS, l.ReadIOPS)
+	if prev, ok := o[instanceID]; ok && prev == limits {
+		return false, nil
+	}
+	o[instanceID] = limits
+	return true, nil
+}
+
 type IOLimiterV1 struct {
-	limits ioLimitOptions
+	limits    ioLimitOptions
+	overrides ioLimitOverrides
 
 	cond  *sync.Cond
 	cache *lru.Cache
@@ -41,10 +70,11 @@ func NewIOLimiterV1(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs
 	}
 
 	limiter := &IOLimiterV1{
-		limits:  buildLimits(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs),
-		cond:    sync.NewCond(&sync.Mutex{}),
-		cache:   cache,
-		devices: buildDevices(),
+		limits:    buildLimits(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs),
+		overrides: make(ioLimitOverrides),
+		cond:      sync.NewCond(&sync.Mutex{}),
+		cache:     cache,
+		devices:   buildDevices(),
 	}
 
 	return limiter, nil
@@ -82,6 +112,38 @@ func (c *IOLimiterV1) Update(writeBytesPerSecond, readBytesPerSecond, writeIOPs,
 	c.cond.Broadcast()
 }
 
+// WorkspaceUpdated applies changes of the I/O limits ws-manager recorded on a workspace pod, e.g. when the
+// workspace was resized
+func (c *IOLimiterV1) WorkspaceUpdated(ctx context.Context, opts *PluginOptions) error {
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	c.cond.L.Lock()
+	defer c.cond.L.Unlock()
+
+	changed, err := c.overrides.set(opts.InstanceId, opts.Annotations)
+	if err != nil {
+		return err
+	}
+	if changed {
+		log.WithField("cgroupPath", opts.CgroupPath).WithField("limits", c.overrides[opts.InstanceId]).Info("updating workspace I/O cgroups v1 limits")
+		c.cond.Broadcast()
+	}
+	return nil
+}
+
+// limitsFor returns the limits which apply to a workspace
+func (c *IOLimiterV1) limitsFor(instanceID string) ioLimitOptions {
+	c.cond.L.Lock()
+	defer c.cond.L.Unlock()
+
+	if l, ok := c.overrides[instanceID]; ok {
+		return l
+	}
+	return c.limits
+}
+
 func (c *IOLimiterV1) Apply(ctx context.Context, opts *PluginOptions) error {
 	baseCgroupPath := filepath.Join(opts.BasePath, "blkio", opts.CgroupPath)
 
@@ -106,6 +168,13 @@ func (c *IOLimiterV1) Apply(ctx context.Context, opts *PluginOptions) error {
 		return nil
 	}
 
+	c.cond.L.Lock()
+	_, err := c.overrides.set(opts.InstanceId, opts.Annotations)
+	c.cond.L.Unlock()
+	if err != nil {
+		log.WithError(err).WithField("cgroupPath", opts.CgroupPath).Warn("cannot use workspace IO limits")
+	}
+
 	update := make(chan struct{}, 1)
 	go func() {
 		// TODO(cw): this Go-routine will leak per workspace, until we update config or restart ws-daemon
@@ -124,7 +193,7 @@ func (c *IOLimiterV1) Apply(ctx context.Context, opts *PluginOptions) error {
 
 	go func() {
 		log.WithField("cgroupPath", opts.CgroupPath).Debug("starting IO limiting")
-		err := writeLimits(c.limits, false)
+		err := writeLimits(c.limitsFor(opts.InstanceId), false)
 		if err != nil {
 			log.WithError(err).WithField("cgroupPath", opts.CgroupPath).Error("cannot write IO limits")
 		}
@@ -132,8 +201,9 @@ func (c *IOLimiterV1) Apply(ctx context.Context, opts *PluginOptions) error {
 		for {
 			select {
 			case <-update:
-				log.WithField("cgroupPath", opts.CgroupPath).WithField("l", c.limits).Debug("writing new IO limiting")
-				err := writeLimits(c.limits, false)
+				l := c.limitsFor(opts.InstanceId)
+				log.WithField("cgroupPath", opts.CgroupPath).WithField("l", l).Debug("writing new IO limiting")
+				err := writeLimits(l, false)
 				if err != nil {
 					log.WithError(err).WithField("cgroupPath", opts.CgroupPath).Error("cannot write IO limits")
 				}
@@ -145,6 +215,9 @@ func (c *IOLimiterV1) Apply(ctx context.Context, opts *PluginOptions) error {
 				if err != nil {
 					log.WithError(err).WithField("cgroupPath", opts.CgroupPath).Error("cannot reset IO limits")
 				}
+				c.cond.L.Lock()
+				delete(c.overrides, opts.InstanceId)
+				c.cond.L.Unlock()
 				log.WithField("cgroupPath", opts.CgroupPath).Debug("stopping IO limiting")
 				return
 			}
@@ -16,7 +16,8 @@ import (
 )
 
 type IOLimiterV2 struct {
-	limits *v2.Resources
+	limits    *v2.Resources
+	overrides ioLimitOverrides
 
 	cond *sync.Cond
 
@@ -27,7 +28,8 @@ func NewIOLimiterV2(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs
 	devices := buildDevices()
 	log.WithField("devices", devices).Debug("io limiting devices")
 	return &IOLimiterV2{
-		limits: buildV2Limits(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs, devices),
+		limits:    buildV2Limits(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs, devices),
+		overrides: make(ioLimitOverrides),
 
 		cond:    sync.NewCond(&sync.Mutex{}),
 		devices: devices,
@@ -38,6 +40,13 @@ func (c *IOLimiterV2) Name() string  { return "iolimiter-v2" }
 func (c *IOLimiterV2) Type() Version { return Version2 }
 
 func (c *IOLimiterV2) Apply(ctx context.Context, opts *PluginOptions) error {
+	c.cond.L.Lock()
+	_, err := c.overrides.set(opts.InstanceId, opts.Annotations)
+	c.cond.L.Unlock()
+	if err != nil {
+		log.WithError(err).WithField("cgroupPath", opts.CgroupPath).Warn("cannot use workspace IO limits")
+	}
+
 	update := make(chan struct{}, 1)
 	go func() {
 		// TODO(cw): this Go-routine will leak per workspace, until we update config or restart ws-daemon
@@ -59,17 +68,19 @@ func (c *IOLimiterV2) Apply(ctx context.Context, opts *PluginOptions) error {
 	go func() {
 		log.WithField("cgroupPath", opts.CgroupPath).Debug("starting io limiting")
 
-		_, err := v2.NewManager(opts.BasePath, filepath.Join("/", opts.CgroupPath), c.limits)
+		limits := c.limitsFor(opts.InstanceId)
+		_, err := v2.NewManager(opts.BasePath, filepath.Join("/", opts.CgroupPath), limits)
 		if err != nil {
-			log.WithError(err).WithField("basePath", opts.BasePath).WithField("cgroupPath", opts.CgroupPath).WithField("limits", c.limits).Error("cannot write IO limits")
+			log.WithError(err).WithField("basePath", opts.BasePath).WithField("cgroupPath", opts.CgroupPath).WithField("limits", limits).Error("cannot write IO limits")
 		}
 
 		for {
 			select {
 			case <-update:
-				_, err := v2.NewManager(opts.BasePath, filepath.Join("/", opts.CgroupPath), c.limits)
+				limits := c.limitsFor(opts.InstanceId)
+				_, err := v2.NewManager(opts.BasePath, filepath.Join("/", opts.CgroupPath), limits)
 				if err != nil {
-					log.WithError(err).WithField("basePath", opts.BasePath).WithField("cgroupPath", opts.CgroupPath).WithField("limits", c.limits).Error("cannot write IO limits")
+					log.WithError(err).WithField("basePath", opts.BasePath).WithField("cgroupPath", opts.CgroupPath).WithField("limits", limits).Error("cannot write IO limits")
 				}
 			case <-ctx.Done():
 				// Prior to shutting down though, we need to reset the IO limits to ensure we don't have
@@ -79,6 +90,9 @@ func (c *IOLimiterV2) Apply(ctx context.Context, opts *PluginOptions) error {
 				if err != nil {
 					log.WithError(err).WithField("cgroupPath", opts.CgroupPath).Error("cannot write IO limits")
 				}
+				c.cond.L.Lock()
+				delete(c.overrides, opts.InstanceId)
+				c.cond.L.Unlock()
 				log.WithField("cgroupPath", opts.CgroupPath).Debug("stopping io limiting")
 				return
 			}
@@ -98,6 +112,38 @@ func (c *IOLimiterV2) Update(writeBytesPerSecond, readBytesPerSecond, writeIOPs,
 	c.cond.Broadcast()
 }
 
+// WorkspaceUpdated applies changes of the I/O limits ws-manager recorded on a workspace pod, e.g. when the
+// workspace was resized
+func (c *IOLimiterV2) WorkspaceUpdated(ctx context.Context, opts *PluginOptions) error {
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	c.cond.L.Lock()
+	defer c.cond.L.Unlock()
+
+	changed, err := c.overrides.set(opts.InstanceId, opts.Annotations)
+	if err != nil {
+		return err
+	}
+	if changed {
+		log.WithField("cgroupPath", opts.CgroupPath).WithField("limits", c.overrides[opts.InstanceId]).Info("updating workspace I/O cgroups v2 limits")
+		c.cond.Broadcast()
+	}
+	return nil
+}
+
+// limitsFor returns the limits which apply to a workspace
+func (c *IOLimiterV2) limitsFor(instanceID string) *v2.Resources {
+	c.cond.L.Lock()
+	defer c.cond.L.Unlock()
+
+	if l, ok := c.overrides[instanceID]; ok {
+		return buildV2Limits(l.WriteBytesPerSecond, l.ReadBytesPerSecond, l.WriteIOPS, l.ReadIOPS, c.devices)
+	}
+	return c.limits
+}
+
 func buildV2Limits(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs int64, devices []string) *v2.Resources {
 	resources := &v2.Resources{
 		IO: &v2.IO{},
@@ -0,0 +1,136 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cgroup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/opencontainers/runc/libcontainer/cgroups"
+
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
+)
+
+func TestMemoryLimiter(t *testing.T) {
+	type limits struct {
+		Pod       string
+		Container string
+	}
+	versions := []struct {
+		Name       string
+		Limiter    func() UpdatablePlugin
+		Controller string
+		File       string
+		Unlimited  string
+	}{
+		{
+			Name:      "v2",
+			Limiter:   func() UpdatablePlugin { return NewMemoryLimiterV2() },
+			File:      "memory.max",
+			Unlimited: "max",
+		},
+		{
+			Name:       "v1",
+			Limiter:    func() UpdatablePlugin { return NewMemoryLimiterV1() },
+			Controller: "memory",
+			File:       "memory.limit_in_bytes",
+			Unlimited:  "-1",
+		},
+	}
+	tests := []struct {
+		Name        string
+		Limit       string
+		Expectation limits
+	}{
+		{
+			Name:        "raises the pod limit",
+			Limit:       "4Gi",
+			Expectation: limits{Pod: "4294967296", Container: "4294967296"},
+		},
+		{
+			Name:        "keeps the pod limit when shrinking",
+			Limit:       "1Gi",
+			Expectation: limits{Pod: "4294967296", Container: "1073741824"},
+		},
+		{
+			Name:        "unchanged limit is not written again",
+			Limit:       "1Gi",
+			Expectation: limits{Pod: "4294967296", Container: "modified"},
+		},
+		{
+			Name:        "removed limit",
+			Expectation: limits{Pod: "4294967296", Container: "unlimited"},
+		},
+	}
+
+	for _, version := range versions {
+		t.Run(version.Name, func(t *testing.T) {
+			basePath := t.TempDir()
+			pod := filepath.Join(basePath, version.Controller, "pod")
+			container := filepath.Join(pod, "container")
+			if err := os.MkdirAll(container, 0o755); err != nil {
+				t.Fatal(err)
+			}
+			if err := cgroups.WriteFile(pod, version.File, "2147483648"); err != nil {
+				t.Fatal(err)
+			}
+			if err := cgroups.WriteFile(container, version.File, "2147483648"); err != nil {
+				t.Fatal(err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			limiter := version.Limiter()
+			for i, test := range tests {
+				t.Run(test.Name, func(t *testing.T) {
+					if test.Expectation.Container == "modified" {
+						if err := cgroups.WriteFile(container, version.File, "modified"); err != nil {
+							t.Fatal(err)
+						}
+					}
+
+					opts := &PluginOptions{
+						BasePath:    basePath,
+						CgroupPath:  "pod/container",
+						InstanceId:  "foobar",
+						Annotations: map[string]string{},
+					}
+					if test.Limit != "" {
+						opts.Annotations[kubernetes.WorkspaceMemoryLimitAnnotation] = test.Limit
+					}
+
+					var err error
+					if i == 0 {
+						err = limiter.Apply(ctx, opts)
+					} else {
+						err = limiter.WorkspaceUpdated(ctx, opts)
+					}
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					var act limits
+					act.Pod, err = cgroups.ReadFile(pod, version.File)
+					if err != nil {
+						t.Fatal(err)
+					}
+					act.Container, err = cgroups.ReadFile(container, version.File)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if act.Container == version.Unlimited {
+						act.Container = "unlimited"
+					}
+					if diff := cmp.Diff(test.Expectation, act); diff != "" {
+						t.Errorf("unexpected limits (-want +got):\n%s", diff)
+					}
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cgroup
+
+import (
+	"context"
+	"path/filepath"
+)
+
+// MemoryLimiterV1 applies the memory limit ws-manager records on a workspace pod to the workspace's memory cgroup
+// on nodes which use cgroup v1. Like MemoryLimiterV2 it raises the limit kubelet gave the pod's cgroup when needed.
+type MemoryLimiterV1 struct {
+	limits *appliedMemoryLimits
+}
+
+func NewMemoryLimiterV1() *MemoryLimiterV1 {
+	return &MemoryLimiterV1{
+		limits: newAppliedMemoryLimits(memoryLimitFile{Name: "memory.limit_in_bytes", Unlimited: "-1"}),
+	}
+}
+
+func (c *MemoryLimiterV1) Name() string  { return "memory-limiter-v1" }
+func (c *MemoryLimiterV1) Type() Version { return Version1 }
+
+func (c *MemoryLimiterV1) Apply(ctx context.Context, opts *PluginOptions) error {
+	return c.limits.Apply(ctx, filepath.Join(opts.BasePath, "memory", opts.CgroupPath), opts)
+}
+
+func (c *MemoryLimiterV1) WorkspaceUpdated(ctx context.Context, opts *PluginOptions) error {
+	return c.limits.WorkspaceUpdated(ctx, filepath.Join(opts.BasePath, "memory", opts.CgroupPath), opts)
+}
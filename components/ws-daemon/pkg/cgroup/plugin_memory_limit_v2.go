@@ -0,0 +1,157 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package cgroup
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/xerrors"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+// MemoryLimiterV2 applies the memory limit ws-manager records on a workspace pod to the workspace's cgroup.
+// Kubelet sets the memory limit of the pod's cgroup once when the workspace starts, hence the limiter raises
+// that limit when a workspace was resized to a class with more memory.
+type MemoryLimiterV2 struct {
+	limits *appliedMemoryLimits
+}
+
+func NewMemoryLimiterV2() *MemoryLimiterV2 {
+	return &MemoryLimiterV2{
+		limits: newAppliedMemoryLimits(memoryLimitFile{Name: "memory.max", Unlimited: "max"}),
+	}
+}
+
+func (c *MemoryLimiterV2) Name() string  { return "memory-limiter-v2" }
+func (c *MemoryLimiterV2) Type() Version { return Version2 }
+
+func (c *MemoryLimiterV2) Apply(ctx context.Context, opts *PluginOptions) error {
+	return c.limits.Apply(ctx, filepath.Join(opts.BasePath, opts.CgroupPath), opts)
+}
+
+func (c *MemoryLimiterV2) WorkspaceUpdated(ctx context.Context, opts *PluginOptions) error {
+	return c.limits.WorkspaceUpdated(ctx, filepath.Join(opts.BasePath, opts.CgroupPath), opts)
+}
+
+// memoryLimitFile is the cgroup file which holds the memory limit of a cgroup
+type memoryLimitFile struct {
+	Name string
+	// Unlimited is the value which removes the limit
+	Unlimited string
+}
+
+// appliedMemoryLimits writes the memory limit annotations of workspaces to their cgroup and remembers the limits it applied
+type appliedMemoryLimits struct {
+	file memoryLimitFile
+
+	mu sync.Mutex
+	// applied holds the memory limit annotation last applied to a workspace, keyed by instance ID
+	applied map[string]string
+}
+
+func newAppliedMemoryLimits(file memoryLimitFile) *appliedMemoryLimits {
+	return &appliedMemoryLimits{
+		file:    file,
+		applied: make(map[string]string),
+	}
+}
+
+func (c *appliedMemoryLimits) Apply(ctx context.Context, cgroupPath string, opts *PluginOptions) error {
+	go func() {
+		<-ctx.Done()
+
+		c.mu.Lock()
+		delete(c.applied, opts.InstanceId)
+		c.mu.Unlock()
+	}()
+
+	return c.update(cgroupPath, opts)
+}
+
+func (c *appliedMemoryLimits) WorkspaceUpdated(ctx context.Context, cgroupPath string, opts *PluginOptions) error {
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	return c.update(cgroupPath, opts)
+}
+
+func (c *appliedMemoryLimits) update(cgroupPath string, opts *PluginOptions) error {
+	limit := opts.Annotations[kubernetes.WorkspaceMemoryLimitAnnotation]
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	prev, known := c.applied[opts.InstanceId]
+	if limit == prev && (known || limit == "") {
+		// workspaces without annotation keep the limit kubelet gave them
+		c.applied[opts.InstanceId] = limit
+		return nil
+	}
+
+	err := writeMemoryLimit(cgroupPath, c.file, limit)
+	if err != nil {
+		return err
+	}
+	c.applied[opts.InstanceId] = limit
+	log.WithField("cgroupPath", opts.CgroupPath).WithField("limit", limit).Info("applied workspace memory limit")
+
+	return nil
+}
+
+// writeMemoryLimit sets the memory limit of a cgroup, raising the limit of its parent first if it is lower.
+// An empty limit removes the limit of the cgroup, so that only the limit of its parent applies.
+func writeMemoryLimit(cgroupPath string, file memoryLimitFile, limit string) error {
+	value := file.Unlimited
+	if limit != "" {
+		q, err := resource.ParseQuantity(limit)
+		if err != nil {
+			return xerrors.Errorf("invalid memory limit %s: %w", limit, err)
+		}
+		value = strconv.FormatInt(q.Value(), 10)
+
+		parent := filepath.Dir(cgroupPath)
+		parentLimit, err := readMemoryLimit(parent, file)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return xerrors.Errorf("cannot read memory limit of %s: %w", parent, err)
+		}
+		if err == nil && parentLimit < q.Value() {
+			err = os.WriteFile(filepath.Join(parent, file.Name), []byte(value), 0644)
+			if err != nil {
+				return xerrors.Errorf("cannot raise memory limit of %s: %w", parent, err)
+			}
+		}
+	}
+
+	err := os.WriteFile(filepath.Join(cgroupPath, file.Name), []byte(value), 0644)
+	if err != nil {
+		return xerrors.Errorf("cannot write memory limit: %w", err)
+	}
+	return nil
+}
+
+func readMemoryLimit(cgroupPath string, file memoryLimitFile) (int64, error) {
+	fc, err := os.ReadFile(filepath.Join(cgroupPath, file.Name))
+	if err != nil {
+		return 0, err
+	}
+
+	s := strings.TrimSpace(string(fc))
+	if s == "max" {
+		return math.MaxInt64, nil
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/containers/storage/pkg/archive"
 	"github.com/containers/storage/pkg/idtools"
+	"github.com/opencontainers/go-digest"
 	"github.com/opentracing/opentracing-go"
 	"golang.org/x/xerrors"
 
@@ -19,8 +20,10 @@ import (
 	carchive "github.com/gitpod-io/gitpod/content-service/pkg/archive"
 )
 
-// BuildTarbal creates an OCI compatible tar file dst from the folder src, expecting the overlay whiteout format
-func BuildTarbal(ctx context.Context, src string, dst string, fullWorkspaceBackup bool, opts ...carchive.TarOption) (err error) {
+// BuildTarbal creates an OCI compatible tar file dst from the folder src, expecting the overlay whiteout format.
+// If a compression is configured the tar stream is compressed on the fly. The returned diffID is the digest of
+// the uncompressed tar stream.
+func BuildTarbal(ctx context.Context, src string, dst string, fullWorkspaceBackup bool, opts ...carchive.TarOption) (diffID digest.Digest, err error) {
 	var cfg carchive.TarConfig
 	for _, opt := range opts {
 		opt(&cfg)
@@ -28,12 +31,12 @@ func BuildTarbal(ctx context.Context, src string, dst string, fullWorkspaceBacku
 
 	//nolint:staticcheck,ineffassign
 	span, ctx := opentracing.StartSpanFromContext(ctx, "buildTarbal")
-	span.LogKV("src", src, "dst", dst)
+	span.LogKV("src", src, "dst", dst, "compression", cfg.Compression)
 	defer tracing.FinishSpan(span, &err)
 
 	// ensure the src actually exists before trying to tar it
 	if _, err := os.Stat(src); err != nil {
-		return xerrors.Errorf("Unable to tar files: %v", err.Error())
+		return "", xerrors.Errorf("Unable to tar files: %v", err.Error())
 	}
 
 	if fullWorkspaceBackup {
@@ -69,13 +72,24 @@ func BuildTarbal(ctx context.Context, src string, dst string, fullWorkspaceBacku
 
 	tarFile, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY, 0755)
 	if err != nil {
-		return xerrors.Errorf("Unable to create tar file: %v", err.Error())
+		return "", xerrors.Errorf("Unable to create tar file: %v", err.Error())
 	}
+	defer tarFile.Close()
 
-	_, err = io.Copy(tarFile, tarReader)
+	compressor, err := carchive.Compress(tarFile, cfg.Compression, cfg.CompressionLevel)
 	if err != nil {
-		return xerrors.Errorf("Unable create tar file: %v", err.Error())
+		return "", xerrors.Errorf("Unable to compress tar file: %v", err.Error())
 	}
 
-	return
+	digester := digest.Canonical.Digester()
+	_, err = io.Copy(io.MultiWriter(compressor, digester.Hash()), tarReader)
+	if err != nil {
+		return "", xerrors.Errorf("Unable create tar file: %v", err.Error())
+	}
+	err = compressor.Close()
+	if err != nil {
+		return "", xerrors.Errorf("Unable to compress tar file: %v", err.Error())
+	}
+
+	return digester.Digest(), nil
 }
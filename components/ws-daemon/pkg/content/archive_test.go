@@ -0,0 +1,82 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	carchive "github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+func TestBuildTarbalCompression(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Compression carchive.Compression
+	}{
+		{Name: "uncompressed", Compression: carchive.Uncompressed},
+		{Name: "gzip", Compression: carchive.Gzip},
+		{Name: "zstd", Compression: carchive.Zstd},
+	}
+
+	src := t.TempDir()
+	err := os.WriteFile(filepath.Join(src, "hello.txt"), []byte("hello world"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var diffIDs []string
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			dst := filepath.Join(t.TempDir(), "backup.tar")
+			diffID, err := BuildTarbal(context.Background(), src, dst, false, carchive.WithCompression(test.Compression, 0))
+			if err != nil {
+				t.Fatalf("cannot build tarbal: %v", err)
+			}
+			diffIDs = append(diffIDs, diffID.String())
+
+			f, err := os.Open(dst)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			act, err := carchive.DetectCompression(bufio.NewReader(f))
+			if err != nil {
+				t.Fatalf("cannot detect compression: %v", err)
+			}
+			if act != test.Compression {
+				t.Errorf("unexpected compression: is %s but expected %s", act, test.Compression)
+			}
+
+			_, err = f.Seek(0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			out := t.TempDir()
+			err = carchive.ExtractTarbal(context.Background(), f, out)
+			if err != nil {
+				t.Fatalf("cannot extract tarbal: %v", err)
+			}
+			content, err := os.ReadFile(filepath.Join(out, "hello.txt"))
+			if err != nil {
+				t.Fatalf("cannot read extracted file: %v", err)
+			}
+			if string(content) != "hello world" {
+				t.Errorf("unexpected content: %s", content)
+			}
+		})
+	}
+
+	for _, id := range diffIDs[1:] {
+		if id != diffIDs[0] {
+			t.Errorf("diffID depends on compression: %v", diffIDs)
+			break
+		}
+	}
+}
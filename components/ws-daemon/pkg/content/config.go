@@ -52,6 +52,22 @@ type BackupConfig struct {
 
 	// Period is the time between regular workspace backups
 	Period util.Duration `json:"period"`
+
+	// Compression is the algorithm backups are compressed with: none, gzip or zstd.
+	// Defaults to none. Restores detect the compression, regardless of this setting.
+	Compression string `json:"compression,omitempty"`
+
+	// CompressionLevel is the algorithm specific compression level. Zero selects the default level.
+	CompressionLevel int `json:"compressionLevel,omitempty"`
+
+	// Incremental enables chunked, content-addressed backups which only upload the chunks that changed
+	// since the previous backup. Once a workspace has an incremental backup, all its subsequent backups are
+	// incremental, regardless of this setting.
+	Incremental bool `json:"incremental,omitempty"`
+
+	// IncrementalGenerations is the number of incremental backup generations we keep in the manifest.
+	// Chunks no longer referenced by any generation are subject to garbage collection. Defaults to 3.
+	IncrementalGenerations int `json:"incrementalGenerations,omitempty"`
 }
 
 type UserNamespacesConfig struct {
@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
@@ -46,6 +47,9 @@ type RunInitializerOpts struct {
 	GID uint32
 
 	OWI OWI
+
+	// Keys unwraps the data keys of encrypted remote content
+	Keys storage.KeyProvider
 }
 
 type OWI struct {
@@ -64,7 +68,7 @@ var (
 	errCannotFindSnapshot = errors.New("cannot find snapshot")
 )
 
-func collectRemoteContent(ctx context.Context, rs storage.DirectAccess, ps storage.PresignedAccess, workspaceOwner string, initializer *csapi.WorkspaceInitializer) (rc map[string]storage.DownloadInfo, err error) {
+func collectRemoteContent(ctx context.Context, rs storage.DirectAccess, ps storage.PresignedAccess, keys storage.KeyProvider, workspaceOwner string, initializer *csapi.WorkspaceInitializer) (rc map[string]storage.DownloadInfo, err error) {
 	rc = make(map[string]storage.DownloadInfo)
 
 	backup, err := ps.SignDownload(ctx, rs.Bucket(workspaceOwner), rs.BackupObject(storage.DefaultBackup), &storage.SignedURLOptions{})
@@ -76,6 +80,19 @@ func collectRemoteContent(ctx context.Context, rs storage.DirectAccess, ps stora
 		rc[storage.DefaultBackup] = *backup
 	}
 
+	incremental, err := ps.SignDownload(ctx, rs.Bucket(workspaceOwner), rs.BackupObject(storage.IncrementalBackupManifest), &storage.SignedURLOptions{})
+	if err == storage.ErrNotFound {
+		// no incremental backup found - that's fine
+	} else if err != nil {
+		return nil, err
+	} else {
+		rc[storage.IncrementalBackupManifest] = *incremental
+		err = collectIncrementalChunks(ctx, ps, keys, rc, storage.IncrementalBackupManifest)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	si := initializer.GetSnapshot()
 	pi := initializer.GetPrebuild()
 	if ci := initializer.GetComposite(); ci != nil {
@@ -102,6 +119,12 @@ func collectRemoteContent(ctx context.Context, rs storage.DirectAccess, ps stora
 		}
 
 		rc[si.Snapshot] = *info
+		if strings.HasSuffix(obj, ".json") {
+			err = collectIncrementalChunks(ctx, ps, keys, rc, si.Snapshot)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 	if pi != nil && pi.Prebuild != nil && pi.Prebuild.Snapshot != "" {
 		bkt, obj, err := storage.ParseSnapshotName(pi.Prebuild.Snapshot)
@@ -115,12 +138,85 @@ func collectRemoteContent(ctx context.Context, rs storage.DirectAccess, ps stora
 			return nil, xerrors.Errorf("cannot find prebuild: %w", err)
 		} else {
 			rc[pi.Prebuild.Snapshot] = *info
+			if strings.HasSuffix(obj, ".json") {
+				err = collectIncrementalChunks(ctx, ps, keys, rc, pi.Prebuild.Snapshot)
+				if err != nil {
+					return nil, err
+				}
+			}
 		}
 	}
 
 	return rc, nil
 }
 
+// collectIncrementalChunks signs the chunks of the latest generation of an incremental backup, if name refers to
+// an incremental backup manifest. The chunks are keyed by their qualified object name.
+func collectIncrementalChunks(ctx context.Context, ps storage.PresignedAccess, keys storage.KeyProvider, rc map[string]storage.DownloadInfo, name string) error {
+	dataKeys, err := unwrapRemoteContentKeys(ctx, keys, map[string]storage.DownloadInfo{name: rc[name]})
+	if err != nil {
+		return err
+	}
+	mf, err := storage.ReadIncrementalManifest(ctx, &remoteContentStorage{RemoteContent: rc, DataKeys: dataKeys}, name)
+	if err != nil {
+		return xerrors.Errorf("cannot read incremental backup manifest %s: %w", name, err)
+	}
+	if mf == nil {
+		return nil
+	}
+	gen := mf.LatestGeneration()
+	if gen == nil {
+		return nil
+	}
+
+	for _, chunk := range gen.Chunks {
+		if _, exists := rc[chunk.Object]; exists {
+			continue
+		}
+
+		bkt, obj, err := storage.ParseSnapshotName(chunk.Object)
+		if err != nil {
+			return err
+		}
+		info, err := ps.SignDownload(ctx, bkt, obj, &storage.SignedURLOptions{})
+		if err != nil {
+			return xerrors.Errorf("cannot sign backup chunk %s: %w", chunk.Object, err)
+		}
+		rc[chunk.Object] = *info
+	}
+	return nil
+}
+
+// unwrapRemoteContentKeys unwraps the data keys of all encrypted remote content, keyed by the remote content name
+func unwrapRemoteContentKeys(ctx context.Context, keys storage.KeyProvider, rc map[string]storage.DownloadInfo) (map[string][]byte, error) {
+	var (
+		res = make(map[string][]byte)
+		// all content of an owner shares the same data key - no need to unwrap it over and over again
+		unwrapped = make(map[string][]byte)
+	)
+	for name, info := range rc {
+		if info.Meta.Encryption == "" {
+			continue
+		}
+		if keys == nil {
+			return nil, xerrors.Errorf("%s is encrypted but no key provider is configured", name)
+		}
+
+		id := info.Meta.KeyOwner + "/" + info.Meta.WrappedKey
+		key, ok := unwrapped[id]
+		if !ok {
+			var err error
+			key, err = storage.UnwrapObjectKey(ctx, keys, &info.Meta)
+			if err != nil {
+				return nil, xerrors.Errorf("cannot unwrap data key of %s: %w", name, err)
+			}
+			unwrapped[id] = key
+		}
+		res[name] = key
+	}
+	return res, nil
+}
+
 // RunInitializer runs a content initializer in a user, PID and mount namespace to isolate it from ws-daemon
 func RunInitializer(ctx context.Context, destination string, initializer *csapi.WorkspaceInitializer, remoteContent map[string]storage.DownloadInfo, opts RunInitializerOpts) (err error) {
 	//nolint:ineffassign,staticcheck
@@ -139,6 +235,11 @@ func RunInitializer(ctx context.Context, destination string, initializer *csapi.
 		return err
 	}
 
+	dataKeys, err := unwrapRemoteContentKeys(ctx, opts.Keys, remoteContent)
+	if err != nil {
+		return err
+	}
+
 	if opts.GID == 0 {
 		opts.GID = wsinit.GitpodGID
 	}
@@ -161,6 +262,7 @@ func RunInitializer(ctx context.Context, destination string, initializer *csapi.
 		Destination:   "/dst",
 		Initializer:   init,
 		RemoteContent: remoteContent,
+		DataKeys:      dataKeys,
 		TraceInfo:     tracing.GetTraceID(span),
 		IDMappings:    opts.IdMappings,
 		GID:           int(opts.GID),
@@ -326,10 +428,13 @@ func RunInitializerChild() (err error) {
 		return err
 	}
 
-	rs := &remoteContentStorage{RemoteContent: initmsg.RemoteContent}
+	rs := &remoteContentStorage{RemoteContent: initmsg.RemoteContent, DataKeys: initmsg.DataKeys}
 
 	dst := initmsg.Destination
-	initializer, err := wsinit.NewFromRequest(ctx, dst, rs, &req, wsinit.NewFromRequestOpts{ForceGitpodUserForGit: false})
+	initializer, err := wsinit.NewFromRequest(ctx, dst, rs, &req, wsinit.NewFromRequestOpts{
+		ForceGitpodUserForGit: false,
+		Progress:              wsinit.WorkspaceProgressReporter(dst),
+	})
 	if err != nil {
 		return err
 	}
@@ -360,10 +465,16 @@ func RunInitializerChild() (err error) {
 	return nil
 }
 
-var _ storage.DirectAccess = &remoteContentStorage{}
+var (
+	_ storage.DirectAccess = &remoteContentStorage{}
+	_ storage.ObjectOpener = &remoteContentStorage{}
+)
 
 type remoteContentStorage struct {
 	RemoteContent map[string]storage.DownloadInfo
+
+	// DataKeys are the unwrapped data keys of encrypted remote content, keyed by name
+	DataKeys map[string][]byte
 }
 
 // Init does nothing
@@ -421,7 +532,15 @@ func (rs *remoteContentStorage) Download(ctx context.Context, destination string
 	defer os.Remove(tempFile.Name())
 	defer tempFile.Close()
 
-	err = archive.ExtractTarbal(ctx, tempFile, destination, archive.WithUIDMapping(mappings), archive.WithGIDMapping(mappings))
+	var src io.Reader = tempFile
+	if key, ok := rs.DataKeys[name]; ok {
+		src, err = storage.NewDecryptingReader(tempFile, key)
+		if err != nil {
+			return true, xerrors.Errorf("cannot decrypt %s: %w", name, err)
+		}
+	}
+
+	err = archive.ExtractTarbal(ctx, src, destination, archive.WithUIDMapping(mappings), archive.WithGIDMapping(mappings))
 	if err != nil {
 		return true, xerrors.Errorf("tar %s: %s", destination, err.Error())
 	}
@@ -434,6 +553,32 @@ func (rs *remoteContentStorage) DownloadSnapshot(ctx context.Context, destinatio
 	return rs.Download(ctx, destination, name, mappings)
 }
 
+// OpenObject opens the remote content with the given name for reading
+func (rs *remoteContentStorage) OpenObject(ctx context.Context, name string) (io.ReadCloser, error) {
+	info, exists := rs.RemoteContent[name]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	rc, err := storage.OpenURL(ctx, info.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok := rs.DataKeys[name]
+	if !ok {
+		return rc, nil
+	}
+	r, err := storage.NewDecryptingReader(rc, key)
+	if err != nil {
+		rc.Close()
+		return nil, xerrors.Errorf("cannot decrypt %s: %w", name, err)
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{r, rc}, nil
+}
+
 // ListObjects returns all objects found with the given prefix. Returns an empty list if the bucket does not exuist (yet).
 func (rs *remoteContentStorage) ListObjects(ctx context.Context, prefix string) (objects []string, err error) {
 	return []string{}, nil
@@ -477,6 +622,7 @@ func (rs *remoteContentStorage) SnapshotObject(name string) string {
 type msgInitContent struct {
 	Destination   string
 	RemoteContent map[string]storage.DownloadInfo
+	DataKeys      map[string][]byte
 	Initializer   []byte
 	UID, GID      int
 	IDMappings    []archive.IDMapping
@@ -14,6 +14,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"syscall"
 	"time"
 
@@ -40,6 +41,17 @@ import (
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/quota"
 )
 
+const (
+	// defaultIncrementalGenerations is the number of incremental backup generations we keep if not configured otherwise
+	defaultIncrementalGenerations = 3
+)
+
+// backupIDMappings map the workspace user IDs to the IDs we store in backups
+var backupIDMappings = []archive.IDMapping{
+	{ContainerID: 0, HostID: wsinit.GitpodUID, Size: 1},
+	{ContainerID: 1, HostID: 100000, Size: 65534},
+}
+
 // Metrics combine custom metrics exported by WorkspaceService
 type metrics struct {
 	BackupWaitingTimeHist       prometheus.Histogram
@@ -55,6 +67,9 @@ type WorkspaceService struct {
 	stopService context.CancelFunc
 	runtime     container.Runtime
 
+	// keys unwraps the data keys of encrypted remote content. Nil if remote storage is not encrypted.
+	keys storage.KeyProvider
+
 	metrics *metrics
 
 	// channel to limit the number of concurrent backups and uploads.
@@ -84,6 +99,14 @@ func NewWorkspaceService(ctx context.Context, cfg Config, kubernetesNamespace st
 		return nil, err
 	}
 
+	var keys storage.KeyProvider
+	if cfg.Storage.Encryption != nil {
+		keys, err = storage.NewKeyProvider(cfg.Storage.Encryption)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create key provider: %w", err)
+		}
+	}
+
 	// read all session json files
 	store, err := session.NewStore(ctx, cfg.WorkingArea, workspaceLifecycleHooks(cfg, kubernetesNamespace, wec, uidmapper, xfs, cgroupMountPoint))
 	if err != nil {
@@ -114,6 +137,7 @@ func NewWorkspaceService(ctx context.Context, cfg Config, kubernetesNamespace st
 		ctx:         ctx,
 		stopService: stopService,
 		runtime:     runtime,
+		keys:        keys,
 
 		metrics: &metrics{
 			BackupWaitingTimeHist:       waitingTimeHist,
@@ -239,7 +263,7 @@ func (s *WorkspaceService) InitWorkspace(ctx context.Context, req *api.InitWorks
 				return nil, status.Error(codes.Internal, "no presigned storage available")
 			}
 
-			remoteContent, err = collectRemoteContent(ctx, rs, ps, workspace.Owner, req.Initializer)
+			remoteContent, err = collectRemoteContent(ctx, rs, ps, s.keys, workspace.Owner, req.Initializer)
 			if err != nil && errors.Is(err, errCannotFindSnapshot) {
 				log.WithError(err).Error("cannot find snapshot")
 				return nil, status.Error(codes.NotFound, "cannot find snapshot")
@@ -275,6 +299,7 @@ func (s *WorkspaceService) InitWorkspace(ctx context.Context, req *api.InitWorks
 				WorkspaceID: req.Metadata.MetaId,
 				InstanceID:  req.Id,
 			},
+			Keys: s.keys,
 		}
 
 		err = RunInitializer(ctx, workspace.Location, req.Initializer, remoteContent, opts)
@@ -396,6 +421,14 @@ func (s *WorkspaceService) DisposeWorkspace(ctx context.Context, req *api.Dispos
 		}
 	}
 
+	if req.BackupRecordings && !sess.RemoteStorageDisabled {
+		err = s.uploadTerminalRecordings(ctx, sess)
+		if err != nil {
+			log.WithError(err).WithFields(sess.OWI()).Error("terminal recording backup failed")
+			// like the logs, the recordings must not keep us from backing up the content
+		}
+	}
+
 	if req.Backup {
 		if sess.RemoteStorageDisabled {
 			return nil, status.Errorf(codes.FailedPrecondition, "workspace has no remote storage")
@@ -409,7 +442,7 @@ func (s *WorkspaceService) DisposeWorkspace(ctx context.Context, req *api.Dispos
 			backupName = fmt.Sprintf(storage.FmtFullWorkspaceBackup, time.Now().UnixNano())
 		}
 
-		err = s.uploadWorkspaceContent(ctx, sess, backupName, mfName)
+		err = s.uploadWorkspaceContent(ctx, sess, backupName, mfName, nil)
 		if err != nil {
 			log.WithError(err).Error("final backup failed")
 			return nil, status.Error(codes.DataLoss, "final backup failed")
@@ -445,7 +478,9 @@ func (s *WorkspaceService) DisposeWorkspace(ctx context.Context, req *api.Dispos
 	return resp, nil
 }
 
-func (s *WorkspaceService) uploadWorkspaceContent(ctx context.Context, sess *session.Workspace, backupName, mfName string) (err error) {
+// uploadWorkspaceContent uploads the workspace content as backupName, and its manifest as mfName if the workspace has one.
+// The annotations are added to both of them.
+func (s *WorkspaceService) uploadWorkspaceContent(ctx context.Context, sess *session.Workspace, backupName, mfName string, annotations map[string]string) (err error) {
 	//nolint:ineffassign
 	span, ctx := opentracing.StartSpanFromContext(ctx, "uploadWorkspaceContent")
 	span.SetTag("workspace", sess.WorkspaceID)
@@ -507,10 +542,31 @@ func (s *WorkspaceService) uploadWorkspaceContent(ctx context.Context, sess *ses
 		return xerrors.Errorf("no remote storage configured")
 	}
 
+	compression, err := archive.ParseCompression(s.config.Backup.Compression)
+	if err != nil {
+		return xerrors.Errorf("invalid backup compression: %w", err)
+	}
+	span.SetTag("compression", compression)
+
+	if !sess.FullWorkspaceBackup {
+		incremental, err := s.usesIncrementalBackups(ctx, sess, rs)
+		if err != nil {
+			return xerrors.Errorf("cannot determine backup mode: %w", err)
+		}
+		if incremental {
+			if backupName == storage.DefaultBackup {
+				// regular backups add a generation to the incremental backup manifest
+				mfName = storage.IncrementalBackupManifest
+			}
+			return s.uploadIncrementalWorkspaceContent(ctx, sess, rs, mfName, compression, annotations)
+		}
+	}
+
 	var (
 		tmpf       *os.File
 		tmpfSize   int64
 		tmpfDigest digest.Digest
+		tmpfDiffID digest.Digest
 	)
 	err = retryIfErr(ctx, s.config.Backup.Attempts, log.WithFields(sess.OWI()).WithField("op", "create archive"), func(ctx context.Context) (err error) {
 		tmpf, err = os.CreateTemp(s.config.TmpDir, fmt.Sprintf("wsbkp-%s-*.tar", sess.InstanceID))
@@ -519,20 +575,17 @@ func (s *WorkspaceService) uploadWorkspaceContent(ctx context.Context, sess *ses
 		}
 		defer tmpf.Close()
 
-		var opts []archive.TarOption
-		opts = append(opts)
+		opts := []archive.TarOption{
+			archive.WithCompression(compression, s.config.Backup.CompressionLevel),
+		}
 		if !sess.FullWorkspaceBackup {
-			mappings := []archive.IDMapping{
-				{ContainerID: 0, HostID: wsinit.GitpodUID, Size: 1},
-				{ContainerID: 1, HostID: 100000, Size: 65534},
-			}
 			opts = append(opts,
-				archive.WithUIDMapping(mappings),
-				archive.WithGIDMapping(mappings),
+				archive.WithUIDMapping(backupIDMappings),
+				archive.WithGIDMapping(backupIDMappings),
 			)
 		}
 
-		err = BuildTarbal(ctx, loc, tmpf.Name(), sess.FullWorkspaceBackup, opts...)
+		tmpfDiffID, err = BuildTarbal(ctx, loc, tmpf.Name(), sess.FullWorkspaceBackup, opts...)
 		if err != nil {
 			return
 		}
@@ -554,7 +607,7 @@ func (s *WorkspaceService) uploadWorkspaceContent(ctx context.Context, sess *ses
 			return
 		}
 		tmpfSize = stat.Size()
-		log.WithField("size", tmpfSize).WithField("location", tmpf.Name()).WithFields(sess.OWI()).Debug("created temp file for workspace backup upload")
+		log.WithField("size", tmpfSize).WithField("compression", compression).WithField("location", tmpf.Name()).WithFields(sess.OWI()).Debug("created temp file for workspace backup upload")
 
 		return
 	})
@@ -573,17 +626,26 @@ func (s *WorkspaceService) uploadWorkspaceContent(ctx context.Context, sess *ses
 		layerObject string
 	)
 	err = retryIfErr(ctx, s.config.Backup.Attempts, log.WithFields(sess.OWI()).WithField("op", "upload layer"), func(ctx context.Context) (err error) {
-		layerUploadOpts := opts
-		if sess.FullWorkspaceBackup {
-			// we deliberately ignore the other opload options here as FWB workspace trailing doesn't make sense
-			layerUploadOpts = []storage.UploadOption{
-				storage.WithAnnotations(map[string]string{
-					storage.ObjectAnnotationDigest:             tmpfDigest.String(),
-					storage.ObjectAnnotationUncompressedDigest: tmpfDigest.String(),
-					storage.ObjectAnnotationOCIContentType:     csapi.MediaTypeUncompressedLayer,
-				}),
-			}
+		// The backup keeps its name regardless of its compression. Restores detect the compression
+		// from the content itself, so that backups made before compression was enabled remain restorable.
+		layerAnnotations := map[string]string{
+			storage.ObjectAnnotationDigest:             tmpfDigest.String(),
+			storage.ObjectAnnotationUncompressedDigest: tmpfDiffID.String(),
+			storage.ObjectAnnotationOCIContentType:     compression.MediaType(),
+			storage.ObjectAnnotationCompression:        string(compression),
 		}
+		for k, v := range annotations {
+			layerAnnotations[k] = v
+		}
+		var layerUploadOpts []storage.UploadOption
+		if !sess.FullWorkspaceBackup {
+			// we deliberately ignore the other opload options for FWB as FWB workspace trailing doesn't make sense
+			layerUploadOpts = append(layerUploadOpts, opts...)
+		}
+		layerUploadOpts = append(layerUploadOpts,
+			storage.WithAnnotations(layerAnnotations),
+			storage.WithContentType(compression.ContentType()),
+		)
 
 		layerBucket, layerObject, err = rs.Upload(ctx, tmpf.Name(), backupName, layerUploadOpts...)
 		if err != nil {
@@ -606,10 +668,10 @@ func (s *WorkspaceService) uploadWorkspaceContent(ctx context.Context, sess *ses
 		ls = append(ls, csapi.WorkspaceContentLayer{
 			Bucket:     layerBucket,
 			Object:     layerObject,
-			DiffID:     tmpfDigest,
+			DiffID:     tmpfDiffID,
 			InstanceID: sess.InstanceID,
 			Descriptor: ociv1.Descriptor{
-				MediaType: csapi.MediaTypeUncompressedLayer,
+				MediaType: compression.MediaType(),
 				Digest:    tmpfDigest,
 				Size:      tmpfSize,
 			},
@@ -638,7 +700,7 @@ func (s *WorkspaceService) uploadWorkspaceContent(ctx context.Context, sess *ses
 		// Upload new manifest without opts as don't want to overwrite the layer trail with the manifest.
 		// We have to make sure we use the right content type s.t. we can identify this as manifest later on,
 		// e.g. when distinguishing between legacy snapshots and new manifests.
-		_, _, err = rs.Upload(ctx, tmpmf.Name(), mfName, storage.WithContentType(csapi.ContentTypeManifest))
+		_, _, err = rs.Upload(ctx, tmpmf.Name(), mfName, storage.WithContentType(csapi.ContentTypeManifest), storage.WithAnnotations(annotations))
 		if err != nil {
 			return err
 		}
@@ -651,6 +713,120 @@ func (s *WorkspaceService) uploadWorkspaceContent(ctx context.Context, sess *ses
 	return nil
 }
 
+// usesIncrementalBackups determines whether the content of a workspace is backed up incrementally.
+// Incremental backups are sticky: restores prefer the incremental backup manifest over a full tar file,
+// hence once a workspace has an incremental backup all its subsequent backups must be incremental, too.
+func (s *WorkspaceService) usesIncrementalBackups(ctx context.Context, sess *session.Workspace, rs storage.DirectAccess) (bool, error) {
+	if sess.FullWorkspaceBackup {
+		return false, nil
+	}
+	if s.config.Backup.Incremental {
+		return true, nil
+	}
+
+	objs, err := rs.ListObjects(ctx, rs.BackupObject(storage.IncrementalBackupManifest))
+	if err != nil {
+		return false, err
+	}
+	return len(objs) > 0, nil
+}
+
+// uploadIncrementalWorkspaceContent uploads the chunks of the workspace content which changed since the last backup,
+// and a manifest listing the chunks. Regular backups add a generation to the incremental backup manifest,
+// snapshots get a manifest of their own.
+func (s *WorkspaceService) uploadIncrementalWorkspaceContent(ctx context.Context, sess *session.Workspace, rs storage.DirectAccess, mfName string, compression archive.Compression, annotations map[string]string) (err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "uploadIncrementalWorkspaceContent")
+	span.SetTag("manifest", mfName)
+	defer tracing.FinishSpan(span, &err)
+
+	opener, ok := rs.(storage.ObjectOpener)
+	if !ok {
+		return xerrors.Errorf("remote storage does not support incremental backups")
+	}
+	previous, err := storage.ReadIncrementalManifest(ctx, opener, storage.IncrementalBackupManifest)
+	if err == storage.ErrNotFound {
+		previous, err = nil, nil
+	}
+	if err != nil {
+		return xerrors.Errorf("cannot read incremental backup manifest: %w", err)
+	}
+
+	var gen *csapi.WorkspaceContentGeneration
+	err = retryIfErr(ctx, s.config.Backup.Attempts, log.WithFields(sess.OWI()).WithField("op", "upload chunks"), func(ctx context.Context) (err error) {
+		tmpf, err := os.CreateTemp(s.config.TmpDir, fmt.Sprintf("wsbkp-%s-*.tar", sess.InstanceID))
+		if err != nil {
+			return err
+		}
+		tmpf.Close()
+		// always remove the archive file to not fill up the node needlessly
+		defer os.Remove(tmpf.Name())
+
+		_, err = BuildTarbal(ctx, sess.Location, tmpf.Name(), false,
+			archive.WithUIDMapping(backupIDMappings),
+			archive.WithGIDMapping(backupIDMappings),
+		)
+		if err != nil {
+			return err
+		}
+
+		tar, err := os.Open(tmpf.Name())
+		if err != nil {
+			return err
+		}
+		defer tar.Close()
+
+		gen, err = storage.UploadIncremental(ctx, rs, s.config.TmpDir, tar, previous, compression, s.config.Backup.CompressionLevel)
+		return err
+	})
+	if err != nil {
+		return xerrors.Errorf("cannot upload workspace content: %w", err)
+	}
+	gen.InstanceID = sess.InstanceID
+
+	mf := csapi.WorkspaceContentManifest{
+		Type:        csapi.TypeIncrementalWorkspaceContentV1,
+		Generations: []csapi.WorkspaceContentGeneration{*gen},
+	}
+	if mfName == storage.IncrementalBackupManifest && previous != nil {
+		keep := s.config.Backup.IncrementalGenerations
+		if keep <= 0 {
+			keep = defaultIncrementalGenerations
+		}
+		mf.Generations = append(previous.Generations, *gen)
+		if len(mf.Generations) > keep {
+			mf.Generations = mf.Generations[len(mf.Generations)-keep:]
+		}
+	}
+	span.LogKV("generation", gen.Generation, "chunks", len(gen.Chunks))
+
+	err = retryIfErr(ctx, s.config.Backup.Attempts, log.WithFields(sess.OWI()).WithField("op", "upload manifest"), func(ctx context.Context) (err error) {
+		fc, err := json.Marshal(mf)
+		if err != nil {
+			return err
+		}
+
+		tmpmf, err := os.CreateTemp(s.config.TmpDir, fmt.Sprintf("mf-%s-*.json", sess.InstanceID))
+		if err != nil {
+			return err
+		}
+		defer os.Remove(tmpmf.Name())
+		_, err = tmpmf.Write(fc)
+		tmpmf.Close()
+		if err != nil {
+			return err
+		}
+
+		_, _, err = rs.Upload(ctx, tmpmf.Name(), mfName, storage.WithContentType(csapi.ContentTypeManifest), storage.WithAnnotations(annotations))
+		return err
+	})
+	if err != nil {
+		return xerrors.Errorf("cannot upload workspace content manifest: %w", err)
+	}
+
+	return nil
+}
+
 func (s *WorkspaceService) uploadWorkspaceLogs(ctx context.Context, sess *session.Workspace) (err error) {
 	rs, ok := sess.NonPersistentAttrs[session.AttrRemoteStorage].(storage.DirectAccess)
 	if rs == nil || !ok {
@@ -661,7 +837,7 @@ func (s *WorkspaceService) uploadWorkspaceLogs(ctx context.Context, sess *sessio
 	if sess.PersistentVolumeClaim {
 		logLocation = filepath.Join(sess.ServiceLocDaemon, "prestophookdata")
 	}
-	// currently we're only uploading prebuild log files
+	// we're uploading prebuild log files
 	logFiles, err := logs.ListPrebuildLogFiles(ctx, logLocation)
 	if err != nil {
 		return err
@@ -685,7 +861,34 @@ func (s *WorkspaceService) uploadWorkspaceLogs(ctx context.Context, sess *sessio
 			return xerrors.Errorf("cannot upload workspace content: %w", err)
 		}
 	}
-	return err
+	return nil
+}
+
+// uploadTerminalRecordings uploads the terminal recordings of a workspace, which exist for any workspace type
+func (s *WorkspaceService) uploadTerminalRecordings(ctx context.Context, sess *session.Workspace) (err error) {
+	rs, ok := sess.NonPersistentAttrs[session.AttrRemoteStorage].(storage.DirectAccess)
+	if rs == nil || !ok {
+		return xerrors.Errorf("no remote storage configured")
+	}
+
+	location := sess.Location
+	if sess.PersistentVolumeClaim {
+		location = filepath.Join(sess.ServiceLocDaemon, "prestophookdata")
+	}
+	recordings, err := logs.ListTerminalRecordingFiles(ctx, location)
+	if err != nil {
+		return err
+	}
+	for _, absRecordingPath := range recordings {
+		err = retryIfErr(ctx, s.config.Backup.Attempts, log.WithFields(sess.OWI()).WithField("op", "upload terminal recording"), func(ctx context.Context) (err error) {
+			_, _, err = rs.UploadInstance(ctx, absRecordingPath, logs.UploadedTerminalRecordingPath(filepath.Base(absRecordingPath)))
+			return
+		})
+		if err != nil {
+			return xerrors.Errorf("cannot upload terminal recording: %w", err)
+		}
+	}
+	return nil
 }
 
 func retryIfErr(ctx context.Context, attempts int, log *logrus.Entry, op func(ctx context.Context) error) (err error) {
@@ -812,22 +1015,30 @@ func (s *WorkspaceService) TakeSnapshot(ctx context.Context, req *api.TakeSnapsh
 		mfName       = baseName + ".mf.json"
 		snapshotName string
 	)
-	if sess.FullWorkspaceBackup {
+	incremental, err := s.usesIncrementalBackups(ctx, sess, rs)
+	if err != nil {
+		log.WithFields(sess.OWI()).WithError(err).Error("cannot determine backup mode")
+		return nil, status.Error(codes.Internal, "cannot determine backup mode")
+	}
+	if sess.FullWorkspaceBackup || incremental {
 		snapshotName = rs.Qualify(mfName)
 	} else {
 		snapshotName = rs.Qualify(backupName)
 	}
 
+	// retention never prunes prebuild snapshots by age, as prebuilt workspaces keep starting from them
+	annotations := map[string]string{storage.ObjectAnnotationPrebuild: strconv.FormatBool(req.Prebuild)}
+
 	if req.ReturnImmediately {
 		go func() {
 			ctx := context.Background()
-			err := s.uploadWorkspaceContent(ctx, sess, backupName, mfName)
+			err := s.uploadWorkspaceContent(ctx, sess, backupName, mfName, annotations)
 			if err != nil {
 				log.WithError(err).WithField("workspaceId", req.Id).Error("snapshot upload failed")
 			}
 		}()
 	} else {
-		err = s.uploadWorkspaceContent(ctx, sess, backupName, mfName)
+		err = s.uploadWorkspaceContent(ctx, sess, backupName, mfName, annotations)
 		if err != nil {
 			log.WithError(err).WithField("workspaceId", req.Id).Error("snapshot upload failed")
 			return nil, status.Error(codes.Internal, "cannot upload snapshot")
@@ -879,16 +1090,25 @@ func (s *WorkspaceService) BackupWorkspace(ctx context.Context, req *api.BackupW
 	}
 	log.WithField("workspaceId", sess.WorkspaceID).WithField("instanceID", sess.InstanceID).WithField("backupName", backupName).Info("backing up")
 
-	err = s.uploadWorkspaceContent(ctx, sess, backupName, mfName)
+	err = s.uploadWorkspaceContent(ctx, sess, backupName, mfName, nil)
 	if err != nil {
 		log.WithError(err).WithFields(sess.OWI()).Error("final backup failed")
 		return nil, status.Error(codes.DataLoss, "final backup failed")
 	}
 
+	incremental, err := s.usesIncrementalBackups(ctx, sess, rs)
+	if err != nil {
+		log.WithError(err).WithFields(sess.OWI()).Error("cannot determine backup mode")
+		return nil, status.Error(codes.Internal, "cannot determine backup mode")
+	}
+
 	var qualifiedName string
-	if sess.FullWorkspaceBackup {
+	switch {
+	case sess.FullWorkspaceBackup:
 		qualifiedName = rs.Qualify(mfName)
-	} else {
+	case incremental:
+		qualifiedName = rs.Qualify(storage.IncrementalBackupManifest)
+	default:
 		qualifiedName = rs.Qualify(backupName)
 	}
 	return &api.BackupWorkspaceResponse{
@@ -49,6 +49,10 @@ func (basePath CgroupV1CFSController) SetLimit(limit Bandwidth) (changed bool, e
 		return false, nil
 	}
 
+	err = basePath.ensureParentQuota(limit)
+	if err != nil {
+		return false, xerrors.Errorf("cannot raise parent CFS quota: %w", err)
+	}
 	err = os.WriteFile(filepath.Join(string(basePath), "cpu.cfs_quota_us"), []byte(strconv.FormatInt(target.Microseconds(), 10)), 0644)
 	if err != nil {
 		return false, xerrors.Errorf("cannot set CFS quota of %d (period is %d, parent quota is %d): %w",
@@ -57,6 +61,31 @@ func (basePath CgroupV1CFSController) SetLimit(limit Bandwidth) (changed bool, e
 	return true, nil
 }
 
+// ensureParentQuota raises the quota of the parent cgroup if it would not allow for the limit.
+// Kubelet sets the quota of a pod's cgroup once when the pod starts, hence a workspace which was
+// resized to a larger class would otherwise remain bound by the limits of its original class.
+// cgroup v1 refuses a quota larger than that of the parent.
+func (basePath CgroupV1CFSController) ensureParentQuota(limit Bandwidth) error {
+	parent := CgroupV1CFSController(filepath.Dir(string(basePath)))
+	period, err := parent.readCfsPeriod()
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	quota, err := parent.readCfsQuota()
+	if err != nil {
+		return err
+	}
+
+	target := limit.Quota(period)
+	if quota >= target {
+		return nil
+	}
+	return os.WriteFile(filepath.Join(string(parent), "cpu.cfs_quota_us"), []byte(strconv.FormatInt(target.Microseconds(), 10)), 0644)
+}
+
 func (basePath CgroupV1CFSController) readParentQuota() time.Duration {
 	parent := CgroupV1CFSController(filepath.Dir(string(basePath)))
 	pq, err := parent.readCfsQuota()
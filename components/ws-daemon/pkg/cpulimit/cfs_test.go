@@ -87,6 +87,61 @@ func TestCfsSetLimit(t *testing.T) {
 	}
 }
 
+func TestCfsSetLimitParentQuota(t *testing.T) {
+	type test struct {
+		parentCfsQuotaUs int
+		bandWidth        Bandwidth
+		expectQuotaUs    int
+	}
+	tests := []test{
+		{
+			parentCfsQuotaUs: 20000,
+			bandWidth:        Bandwidth(4000),
+			expectQuotaUs:    40000,
+		},
+		{
+			parentCfsQuotaUs: 60000,
+			bandWidth:        Bandwidth(4000),
+			expectQuotaUs:    60000,
+		},
+		{
+			parentCfsQuotaUs: -1,
+			bandWidth:        Bandwidth(4000),
+			expectQuotaUs:    -1,
+		},
+	}
+	for _, tc := range tests {
+		parent := createTempDir(t, "cpu")
+		child := filepath.Join(parent, "container")
+		if err := os.Mkdir(child, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		for fn, v := range map[string]string{
+			filepath.Join(parent, "cpu.cfs_period_us"): "10000",
+			filepath.Join(parent, "cpu.cfs_quota_us"):  strconv.Itoa(tc.parentCfsQuotaUs),
+			filepath.Join(child, "cpu.cfs_period_us"):  "10000",
+			filepath.Join(child, "cpu.cfs_quota_us"):   "10000",
+		} {
+			err := cgroups.WriteFile(filepath.Dir(fn), filepath.Base(fn), v)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		_, err := CgroupV1CFSController(child).SetLimit(tc.bandWidth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		quota, err := cgroups.ReadFile(parent, "cpu.cfs_quota_us")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if quota != strconv.Itoa(tc.expectQuotaUs) {
+			t.Fatalf("unexpected error: parent cfsQuotaUs is '%v' but expected '%v'", quota, tc.expectQuotaUs)
+		}
+	}
+}
+
 func TestReadCfsQuota(t *testing.T) {
 	type test struct {
 		value  int
@@ -40,6 +40,10 @@ func (basePath CgroupV2CFSController) SetLimit(limit Bandwidth) (changed bool, e
 		return false, nil
 	}
 
+	err = basePath.ensureParentQuota(limit)
+	if err != nil {
+		return false, xerrors.Errorf("cannot raise parent CFS quota: %w", err)
+	}
 	err = basePath.writeQuota(target)
 	if err != nil {
 		return false, xerrors.Errorf("cannot set CFS quota of %d (period is %d, parent quota is %d): %w",
@@ -93,6 +97,26 @@ func (basePath CgroupV2CFSController) writeQuota(quota time.Duration) error {
 	return os.WriteFile(cpuMaxPath, []byte(strconv.FormatInt(quota.Microseconds(), 10)), 0644)
 }
 
+// ensureParentQuota raises the quota of the parent cgroup if it would not allow for the limit.
+// Kubelet sets the quota of a pod's cgroup once when the pod starts, hence a workspace which was
+// resized to a larger class would otherwise remain bound by the limits of its original class.
+func (basePath CgroupV2CFSController) ensureParentQuota(limit Bandwidth) error {
+	parent := CgroupV2CFSController(filepath.Dir(string(basePath)))
+	quota, period, err := parent.readCpuMax()
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	target := limit.Quota(period)
+	if quota >= target {
+		return nil
+	}
+	return parent.writeQuota(target)
+}
+
 func (basePath CgroupV2CFSController) readParentQuota() time.Duration {
 	parent := CgroupV2CFSController(filepath.Dir(string(basePath)))
 	quota, _, err := parent.readCpuMax()
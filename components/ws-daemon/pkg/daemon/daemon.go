@@ -87,6 +87,8 @@ func NewDaemon(config Config, reg prometheus.Registerer) (*Daemon, error) {
 			},
 		},
 		procV2Plugin,
+		cgroup.NewMemoryLimiterV1(),
+		cgroup.NewMemoryLimiterV2(),
 		cgroup.NewPSIMetrics(reg),
 	)
 	if err != nil {
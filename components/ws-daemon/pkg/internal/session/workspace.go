@@ -334,6 +334,11 @@ func toGitStatus(s *git.Status) *csapi.GitStatus {
 		TotalUntrackedFiles:  int64(len(s.UntrackedFiles)),
 		UnpushedCommits:      limit(s.UnpushedCommits),
 		TotalUnpushedCommits: int64(len(s.UnpushedCommits)),
+
+		UnpushedLfsObjects:      limit(s.UnpushedLFSObjects),
+		TotalUnpushedLfsObjects: int64(len(s.UnpushedLFSObjects)),
+		MissingLfsObjects:       limit(s.MissingLFSObjects),
+		TotalMissingLfsObjects:  int64(len(s.MissingLFSObjects)),
 	}
 }
 
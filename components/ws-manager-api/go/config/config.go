@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/yaml"
 
 	"github.com/gitpod-io/gitpod/common-go/grpc"
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
 	"github.com/gitpod-io/gitpod/common-go/util"
 	cntntcfg "github.com/gitpod-io/gitpod/content-service/api/config"
 )
@@ -95,6 +96,9 @@ type Configuration struct {
 	// - `WorkspacePort` which is the workspace port
 	// - `IngressPort` which is the publicly accessile port
 	WorkspacePortURLTemplate string `json:"portUrlTemplate"`
+	// PortShareLinkMaxExpiry is the longest time a share link of a port with token visibility can be valid for.
+	// Defaults to 24 hours.
+	PortShareLinkMaxExpiry util.Duration `json:"portShareLinkMaxExpiry,omitempty"`
 	// HostPath is the path on the node where workspace data resides (ideally this is an SSD)
 	WorkspaceHostPath string `json:"workspaceHostPath"`
 	// HeartbeatInterval is the time in seconds in which Theia sends a heartbeat if the user is active
@@ -117,6 +121,22 @@ type Configuration struct {
 	WorkspaceClasses map[string]*WorkspaceClass `json:"workspaceClass"`
 	// DebugWorkspacePod adds extra finalizer to workspace to prevent it from shutting down. Helps to debug.
 	DebugWorkspacePod bool `json:"debugWorkspacePod,omitempty"`
+	// Admission configures quotas for concurrently running workspaces
+	Admission AdmissionConfiguration `json:"admission,omitempty"`
+	// InitImage provides busybox to the init containers of workspace pods, e.g. the one which installs the wrapper
+	// of service containers. It should be referenced by digest. Defaults to DefaultInitImage.
+	InitImage string `json:"initImage,omitempty"`
+}
+
+// DefaultInitImage is the image the init containers of workspace pods run if none is configured
+const DefaultInitImage = "docker.io/library/busybox:1.35.0@sha256:20246233b52de844fa516f8c51234f1441e55e71ecdd1a1d91ebb252e1fd4603"
+
+// GetInitImage returns the image the init containers of workspace pods run
+func (c *Configuration) GetInitImage() string {
+	if c.InitImage == "" {
+		return DefaultInitImage
+	}
+	return c.InitImage
 }
 
 type WorkspaceClass struct {
@@ -124,6 +144,94 @@ type WorkspaceClass struct {
 	Container ContainerConfiguration            `json:"container"`
 	Templates WorkspacePodTemplateConfiguration `json:"templates"`
 	PVC       PVCConfiguration                  `json:"pvc"`
+	// WarmPool configures a pool of placeholder pods which keeps nodes ready for workspaces of this class
+	WarmPool *WarmPoolConfiguration `json:"warmPool,omitempty"`
+}
+
+// WarmPoolConfiguration configures the pool of pre-scheduled placeholder pods of a workspace class.
+// A starting workspace claims a placeholder and is started on its node, which has the images pulled already.
+type WarmPoolConfiguration struct {
+	// Size is the number of placeholder pods kept in the pool
+	Size int `json:"size"`
+	// TTL is the time after which an unclaimed placeholder pod is replaced, e.g. to pick up new image versions
+	TTL util.Duration `json:"ttl"`
+	// Images are pulled by the placeholder pods, e.g. the workspace, IDE and supervisor images
+	Images []string `json:"images"`
+	// PriorityClassName is the priority class of the placeholder pods. It should be lower than the one of
+	// workspaces such that placeholders are preempted instead of workspaces.
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// Image provides the static busybox at /bin/busybox the placeholder containers run instead of the binaries of
+	// the pulled images. It should be referenced by digest. Defaults to DefaultWarmPoolImage.
+	Image string `json:"image,omitempty"`
+	// NodeLabel is the label of the nodes placeholder pods are scheduled on. Defaults to DefaultWarmPoolNodeLabel.
+	NodeLabel string `json:"nodeLabel,omitempty"`
+}
+
+const (
+	// DefaultWarmPoolImage is the image placeholder pods copy their static busybox from if none is configured
+	DefaultWarmPoolImage = "docker.io/library/busybox:1.35.0@sha256:20246233b52de844fa516f8c51234f1441e55e71ecdd1a1d91ebb252e1fd4603"
+	// DefaultWarmPoolNodeLabel is the label of the nodes of regular workspaces
+	DefaultWarmPoolNodeLabel = "gitpod.io/workload_workspace_regular"
+)
+
+// Validate validates a warm pool configuration
+func (c *WarmPoolConfiguration) Validate() error {
+	if c.Size < 0 {
+		return xerrors.Errorf("size must not be negative")
+	}
+	if c.Size == 0 {
+		return nil
+	}
+	return ozzo.ValidateStruct(c,
+		ozzo.Field(&c.TTL, ozzo.Required),
+		ozzo.Field(&c.Images, ozzo.Required),
+	)
+}
+
+// AdmissionConfiguration configures quotas for concurrently running workspaces. Starts which would exceed a quota
+// are queued, and admitted by priority (regular > prebuild > imagebuild) and weighted fair share once there is room.
+type AdmissionConfiguration struct {
+	// Cluster limits all workspaces of the cluster
+	Cluster AdmissionQuota `json:"cluster,omitempty"`
+	// PerOwner limits the workspaces of each owner
+	PerOwner AdmissionQuota `json:"perOwner,omitempty"`
+	// PerTeam limits the workspaces of each team
+	PerTeam AdmissionQuota `json:"perTeam,omitempty"`
+	// TeamWeights are the shares of teams relative to the default weight of 1.
+	// Workspaces which do not belong to a team share by owner.
+	TeamWeights map[string]float64 `json:"teamWeights,omitempty"`
+}
+
+// Enabled returns true if any quota is configured
+func (c *AdmissionConfiguration) Enabled() bool {
+	return !c.Cluster.unlimited() || !c.PerOwner.unlimited() || !c.PerTeam.unlimited()
+}
+
+// Validate validates an admission configuration
+func (c *AdmissionConfiguration) Validate() error {
+	for name, q := range map[string]AdmissionQuota{"cluster": c.Cluster, "perOwner": c.PerOwner, "perTeam": c.PerTeam} {
+		if q.Workspaces < 0 || q.CPU.Sign() < 0 {
+			return xerrors.Errorf("%s: quota must not be negative", name)
+		}
+	}
+	for team, w := range c.TeamWeights {
+		if w <= 0 {
+			return xerrors.Errorf("weight of team %s must be positive", team)
+		}
+	}
+	return nil
+}
+
+// AdmissionQuota limits concurrently running workspaces. Zero values are unlimited.
+type AdmissionQuota struct {
+	// Workspaces is the number of workspaces
+	Workspaces int `json:"workspaces,omitempty"`
+	// CPU is the sum of the CPU requests of the workspaces
+	CPU resource.Quantity `json:"cpu,omitempty"`
+}
+
+func (q AdmissionQuota) unlimited() bool {
+	return q.Workspaces == 0 && q.CPU.IsZero()
 }
 
 // WorkspaceTimeoutConfiguration configures the timeout behaviour of workspaces
@@ -220,6 +328,11 @@ func (c *Configuration) Validate() error {
 		return err
 	}
 
+	err = c.Admission.Validate()
+	if err != nil {
+		return xerrors.Errorf("admission: %w", err)
+	}
+
 	if _, ok := c.WorkspaceClasses[DefaultWorkspaceClass]; !ok {
 		return xerrors.Errorf("missing \"%s\" workspace class", DefaultWorkspaceClass)
 	}
@@ -230,6 +343,11 @@ func (c *Configuration) Validate() error {
 		if err := class.Container.Validate(); err != nil {
 			return xerrors.Errorf("workspace class %s: %w", name, err)
 		}
+		if class.WarmPool != nil {
+			if err := class.WarmPool.Validate(); err != nil {
+				return xerrors.Errorf("workspace class %s: warm pool: %w", name, err)
+			}
+		}
 
 		err = ozzo.ValidateStruct(&class.Templates,
 			ozzo.Field(&class.Templates.DefaultPath, validPodTemplate),
@@ -376,6 +494,12 @@ var validResourceLimitConfig = ozzo.By(func(o interface{}) error {
 			return xerrors.Errorf("cannot parse Storage quantity: %w", err)
 		}
 	}
+	if rc.IO != nil {
+		_, err := rc.IO.WorkspaceIOLimit()
+		if err != nil {
+			return xerrors.Errorf("cannot parse IO limit: %w", err)
+		}
+	}
 	return nil
 })
 
@@ -508,6 +632,8 @@ type ResourceLimitConfiguration struct {
 	Memory           string            `json:"memory"`
 	EphemeralStorage string            `json:"ephemeral-storage"`
 	Storage          string            `json:"storage,omitempty"`
+	// IO limits the disk IO of workspaces of a class. If not set, ws-daemon applies the IO limits it applies to all workspaces.
+	IO *IOResourceLimit `json:"io,omitempty"`
 }
 
 func (r *ResourceLimitConfiguration) ResourceList() (corev1.ResourceList, error) {
@@ -554,3 +680,33 @@ type CpuResourceLimit struct {
 	MinLimit   string `json:"min"`
 	BurstLimit string `json:"burst"`
 }
+
+type IOResourceLimit struct {
+	WriteBandwidthPerSecond string `json:"writeBandwidthPerSecond,omitempty"`
+	ReadBandwidthPerSecond  string `json:"readBandwidthPerSecond,omitempty"`
+	WriteIOPS               int64  `json:"writeIOPS,omitempty"`
+	ReadIOPS                int64  `json:"readIOPS,omitempty"`
+}
+
+// WorkspaceIOLimit produces the IO limit ws-daemon applies to a workspace
+func (r *IOResourceLimit) WorkspaceIOLimit() (*kubernetes.WorkspaceIOLimit, error) {
+	res := &kubernetes.WorkspaceIOLimit{
+		WriteIOPS: r.WriteIOPS,
+		ReadIOPS:  r.ReadIOPS,
+	}
+	if r.WriteBandwidthPerSecond != "" {
+		q, err := resource.ParseQuantity(r.WriteBandwidthPerSecond)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot parse write bandwidth: %w", err)
+		}
+		res.WriteBytesPerSecond = q.Value()
+	}
+	if r.ReadBandwidthPerSecond != "" {
+		q, err := resource.ParseQuantity(r.ReadBandwidthPerSecond)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot parse read bandwidth: %w", err)
+		}
+		res.ReadBytesPerSecond = q.Value()
+	}
+	return res, nil
+}
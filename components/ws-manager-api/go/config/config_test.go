@@ -65,6 +65,33 @@ func TestValidate(t *testing.T) {
 			}),
 			Expectation: `workspace class name "not/a/valid/name" is invalid: [a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')]`,
 		},
+		{
+			Name: "negative admission quota",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.Admission.PerTeam.Workspaces = -1
+			}),
+			Expectation: `admission: perTeam: quota must not be negative`,
+		},
+		{
+			Name: "zero team weight",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.Admission.TeamWeights = map[string]float64{"team": 0}
+			}),
+			Expectation: `admission: weight of team team must be positive`,
+		},
+		{
+			Name: "warm pool without images",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].WarmPool = &WarmPoolConfiguration{Size: 2, TTL: util.Duration(time.Hour)}
+			}),
+			Expectation: `workspace class default: warm pool: images: cannot be blank.`,
+		},
+		{
+			Name: "disabled warm pool",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].WarmPool = &WarmPoolConfiguration{}
+			}),
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
@@ -5,7 +5,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.2.0
-// - protoc             v3.20.1
+// - protoc             (unknown)
 // source: core.proto
 
 package api
@@ -54,6 +54,10 @@ type WorkspaceManagerClient interface {
 	UpdateSSHKey(ctx context.Context, in *UpdateSSHKeyRequest, opts ...grpc.CallOption) (*UpdateSSHKeyResponse, error)
 	// describeCluster provides information about the cluster
 	DescribeCluster(ctx context.Context, in *DescribeClusterRequest, opts ...grpc.CallOption) (*DescribeClusterResponse, error)
+	// setSchedule attaches a start and stop schedule to a workspace or to all workspaces of an owner
+	SetSchedule(ctx context.Context, in *SetScheduleRequest, opts ...grpc.CallOption) (*SetScheduleResponse, error)
+	// resizeWorkspace moves a running workspace to another workspace class without restarting it
+	ResizeWorkspace(ctx context.Context, in *ResizeWorkspaceRequest, opts ...grpc.CallOption) (*ResizeWorkspaceResponse, error)
 }
 
 type workspaceManagerClient struct {
@@ -213,6 +217,24 @@ func (c *workspaceManagerClient) DescribeCluster(ctx context.Context, in *Descri
 	return out, nil
 }
 
+func (c *workspaceManagerClient) SetSchedule(ctx context.Context, in *SetScheduleRequest, opts ...grpc.CallOption) (*SetScheduleResponse, error) {
+	out := new(SetScheduleResponse)
+	err := c.cc.Invoke(ctx, "/wsman.WorkspaceManager/SetSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceManagerClient) ResizeWorkspace(ctx context.Context, in *ResizeWorkspaceRequest, opts ...grpc.CallOption) (*ResizeWorkspaceResponse, error) {
+	out := new(ResizeWorkspaceResponse)
+	err := c.cc.Invoke(ctx, "/wsman.WorkspaceManager/ResizeWorkspace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkspaceManagerServer is the server API for WorkspaceManager service.
 // All implementations must embed UnimplementedWorkspaceManagerServer
 // for forward compatibility
@@ -245,6 +267,10 @@ type WorkspaceManagerServer interface {
 	UpdateSSHKey(context.Context, *UpdateSSHKeyRequest) (*UpdateSSHKeyResponse, error)
 	// describeCluster provides information about the cluster
 	DescribeCluster(context.Context, *DescribeClusterRequest) (*DescribeClusterResponse, error)
+	// setSchedule attaches a start and stop schedule to a workspace or to all workspaces of an owner
+	SetSchedule(context.Context, *SetScheduleRequest) (*SetScheduleResponse, error)
+	// resizeWorkspace moves a running workspace to another workspace class without restarting it
+	ResizeWorkspace(context.Context, *ResizeWorkspaceRequest) (*ResizeWorkspaceResponse, error)
 	mustEmbedUnimplementedWorkspaceManagerServer()
 }
 
@@ -294,6 +320,12 @@ func (UnimplementedWorkspaceManagerServer) UpdateSSHKey(context.Context, *Update
 func (UnimplementedWorkspaceManagerServer) DescribeCluster(context.Context, *DescribeClusterRequest) (*DescribeClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeCluster not implemented")
 }
+func (UnimplementedWorkspaceManagerServer) SetSchedule(context.Context, *SetScheduleRequest) (*SetScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSchedule not implemented")
+}
+func (UnimplementedWorkspaceManagerServer) ResizeWorkspace(context.Context, *ResizeWorkspaceRequest) (*ResizeWorkspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResizeWorkspace not implemented")
+}
 func (UnimplementedWorkspaceManagerServer) mustEmbedUnimplementedWorkspaceManagerServer() {}
 
 // UnsafeWorkspaceManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -562,6 +594,42 @@ func _WorkspaceManager_DescribeCluster_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceManager_SetSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceManagerServer).SetSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.WorkspaceManager/SetSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceManagerServer).SetSchedule(ctx, req.(*SetScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceManager_ResizeWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceManagerServer).ResizeWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.WorkspaceManager/ResizeWorkspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceManagerServer).ResizeWorkspace(ctx, req.(*ResizeWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkspaceManager_ServiceDesc is the grpc.ServiceDesc for WorkspaceManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -621,6 +689,14 @@ var WorkspaceManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeCluster",
 			Handler:    _WorkspaceManager_DescribeCluster_Handler,
 		},
+		{
+			MethodName: "SetSchedule",
+			Handler:    _WorkspaceManager_SetSchedule_Handler,
+		},
+		{
+			MethodName: "ResizeWorkspace",
+			Handler:    _WorkspaceManager_ResizeWorkspace_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -280,6 +280,36 @@ func (mr *MockWorkspaceManagerServerMockRecorder) MarkActive(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkActive", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).MarkActive), arg0, arg1)
 }
 
+// ResizeWorkspace mocks base method.
+func (m *MockWorkspaceManagerServer) ResizeWorkspace(arg0 context.Context, arg1 *api.ResizeWorkspaceRequest) (*api.ResizeWorkspaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeWorkspace", arg0, arg1)
+	ret0, _ := ret[0].(*api.ResizeWorkspaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResizeWorkspace indicates an expected call of ResizeWorkspace.
+func (mr *MockWorkspaceManagerServerMockRecorder) ResizeWorkspace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeWorkspace", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).ResizeWorkspace), arg0, arg1)
+}
+
+// SetSchedule mocks base method.
+func (m *MockWorkspaceManagerServer) SetSchedule(arg0 context.Context, arg1 *api.SetScheduleRequest) (*api.SetScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*api.SetScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSchedule indicates an expected call of SetSchedule.
+func (mr *MockWorkspaceManagerServerMockRecorder) SetSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSchedule", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).SetSchedule), arg0, arg1)
+}
+
 // SetTimeout mocks base method.
 func (m *MockWorkspaceManagerServer) SetTimeout(arg0 context.Context, arg1 *api.SetTimeoutRequest) (*api.SetTimeoutResponse, error) {
 	m.ctrl.T.Helper()
@@ -687,6 +717,46 @@ func (mr *MockWorkspaceManagerClientMockRecorder) MarkActive(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkActive", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).MarkActive), varargs...)
 }
 
+// ResizeWorkspace mocks base method.
+func (m *MockWorkspaceManagerClient) ResizeWorkspace(arg0 context.Context, arg1 *api.ResizeWorkspaceRequest, arg2 ...grpc.CallOption) (*api.ResizeWorkspaceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResizeWorkspace", varargs...)
+	ret0, _ := ret[0].(*api.ResizeWorkspaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResizeWorkspace indicates an expected call of ResizeWorkspace.
+func (mr *MockWorkspaceManagerClientMockRecorder) ResizeWorkspace(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeWorkspace", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).ResizeWorkspace), varargs...)
+}
+
+// SetSchedule mocks base method.
+func (m *MockWorkspaceManagerClient) SetSchedule(arg0 context.Context, arg1 *api.SetScheduleRequest, arg2 ...grpc.CallOption) (*api.SetScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetSchedule", varargs...)
+	ret0, _ := ret[0].(*api.SetScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSchedule indicates an expected call of SetSchedule.
+func (mr *MockWorkspaceManagerClientMockRecorder) SetSchedule(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSchedule", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).SetSchedule), varargs...)
+}
+
 // SetTimeout mocks base method.
 func (m *MockWorkspaceManagerClient) SetTimeout(arg0 context.Context, arg1 *api.SetTimeoutRequest, arg2 ...grpc.CallOption) (*api.SetTimeoutResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,78 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+// PortShareTokenParam is the query parameter which carries the token of a share link for ports with token visibility
+const PortShareTokenParam = "gitpod_port_token"
+
+// SignPortShareToken produces a share token for a port with token visibility which was issued at issued and is valid
+// until expiry. The token is signed with the owner token of the workspace, so that only the workspace owner can issue
+// share links and changing the owner token invalidates all of them.
+func SignPortShareToken(ownerToken, instanceID string, port uint32, issued, expiry time.Time) string {
+	claims := strconv.FormatInt(issued.UnixMilli(), 10) + "." + strconv.FormatInt(expiry.Unix(), 10)
+	return claims + "." + portShareSignature(ownerToken, instanceID, port, claims)
+}
+
+// VerifyPortShareToken checks that a share token was issued for the port of a workspace instance, was not issued before
+// notBefore, i.e. was not revoked, and has not expired yet. It returns the expiry of the token.
+func VerifyPortShareToken(ownerToken, instanceID string, port uint32, token string, notBefore, now time.Time) (expiry time.Time, err error) {
+	if ownerToken == "" {
+		return time.Time{}, xerrors.Errorf("workspace has no owner token")
+	}
+	segs := strings.Split(token, ".")
+	if len(segs) != 3 {
+		return time.Time{}, xerrors.Errorf("invalid share token")
+	}
+	iat, exp, signature := segs[0], segs[1], segs[2]
+	if !hmac.Equal([]byte(signature), []byte(portShareSignature(ownerToken, instanceID, port, iat+"."+exp))) {
+		return time.Time{}, xerrors.Errorf("invalid share token signature")
+	}
+
+	issuedMilli, err := strconv.ParseInt(iat, 10, 64)
+	if err != nil {
+		return time.Time{}, xerrors.Errorf("invalid share token issue time: %w", err)
+	}
+	if issued := time.UnixMilli(issuedMilli); issued.Before(notBefore) {
+		return time.Time{}, xerrors.Errorf("share token was revoked")
+	}
+	unix, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil {
+		return time.Time{}, xerrors.Errorf("invalid share token expiry: %w", err)
+	}
+	expiry = time.Unix(unix, 0)
+	if !now.Before(expiry) {
+		return time.Time{}, xerrors.Errorf("share token expired at %s", expiry.UTC().Format(time.RFC3339))
+	}
+	return expiry, nil
+}
+
+// DeriveTeamToken derives the team token of a workspace from its owner token. The team token grants access to ports
+// with team visibility, but does not reveal the owner token.
+func DeriveTeamToken(ownerToken string) string {
+	if ownerToken == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, []byte(ownerToken))
+	mac.Write([]byte("team"))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func portShareSignature(ownerToken, instanceID string, port uint32, claims string) string {
+	mac := hmac.New(sha256.New, []byte(ownerToken))
+	fmt.Fprintf(mac, "%s:%d:%s", instanceID, port, claims)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -30,6 +30,8 @@ interface IWorkspaceManagerService extends grpc.ServiceDefinition<grpc.UntypedSe
     deleteVolumeSnapshot: IWorkspaceManagerService_IDeleteVolumeSnapshot;
     updateSSHKey: IWorkspaceManagerService_IUpdateSSHKey;
     describeCluster: IWorkspaceManagerService_IDescribeCluster;
+    setSchedule: IWorkspaceManagerService_ISetSchedule;
+    resizeWorkspace: IWorkspaceManagerService_IResizeWorkspace;
 }
 
 interface IWorkspaceManagerService_IGetWorkspaces extends grpc.MethodDefinition<core_pb.GetWorkspacesRequest, core_pb.GetWorkspacesResponse> {
@@ -158,6 +160,24 @@ interface IWorkspaceManagerService_IDescribeCluster extends grpc.MethodDefinitio
     responseSerialize: grpc.serialize<core_pb.DescribeClusterResponse>;
     responseDeserialize: grpc.deserialize<core_pb.DescribeClusterResponse>;
 }
+interface IWorkspaceManagerService_ISetSchedule extends grpc.MethodDefinition<core_pb.SetScheduleRequest, core_pb.SetScheduleResponse> {
+    path: "/wsman.WorkspaceManager/SetSchedule";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<core_pb.SetScheduleRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.SetScheduleRequest>;
+    responseSerialize: grpc.serialize<core_pb.SetScheduleResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.SetScheduleResponse>;
+}
+interface IWorkspaceManagerService_IResizeWorkspace extends grpc.MethodDefinition<core_pb.ResizeWorkspaceRequest, core_pb.ResizeWorkspaceResponse> {
+    path: "/wsman.WorkspaceManager/ResizeWorkspace";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<core_pb.ResizeWorkspaceRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.ResizeWorkspaceRequest>;
+    responseSerialize: grpc.serialize<core_pb.ResizeWorkspaceResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.ResizeWorkspaceResponse>;
+}
 
 export const WorkspaceManagerService: IWorkspaceManagerService;
 
@@ -176,6 +196,8 @@ export interface IWorkspaceManagerServer extends grpc.UntypedServiceImplementati
     deleteVolumeSnapshot: grpc.handleUnaryCall<core_pb.DeleteVolumeSnapshotRequest, core_pb.DeleteVolumeSnapshotResponse>;
     updateSSHKey: grpc.handleUnaryCall<core_pb.UpdateSSHKeyRequest, core_pb.UpdateSSHKeyResponse>;
     describeCluster: grpc.handleUnaryCall<core_pb.DescribeClusterRequest, core_pb.DescribeClusterResponse>;
+    setSchedule: grpc.handleUnaryCall<core_pb.SetScheduleRequest, core_pb.SetScheduleResponse>;
+    resizeWorkspace: grpc.handleUnaryCall<core_pb.ResizeWorkspaceRequest, core_pb.ResizeWorkspaceResponse>;
 }
 
 export interface IWorkspaceManagerClient {
@@ -220,6 +242,12 @@ export interface IWorkspaceManagerClient {
     describeCluster(request: core_pb.DescribeClusterRequest, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
     describeCluster(request: core_pb.DescribeClusterRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
     describeCluster(request: core_pb.DescribeClusterRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
+    setSchedule(request: core_pb.SetScheduleRequest, callback: (error: grpc.ServiceError | null, response: core_pb.SetScheduleResponse) => void): grpc.ClientUnaryCall;
+    setSchedule(request: core_pb.SetScheduleRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.SetScheduleResponse) => void): grpc.ClientUnaryCall;
+    setSchedule(request: core_pb.SetScheduleRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.SetScheduleResponse) => void): grpc.ClientUnaryCall;
+    resizeWorkspace(request: core_pb.ResizeWorkspaceRequest, callback: (error: grpc.ServiceError | null, response: core_pb.ResizeWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    resizeWorkspace(request: core_pb.ResizeWorkspaceRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.ResizeWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    resizeWorkspace(request: core_pb.ResizeWorkspaceRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.ResizeWorkspaceResponse) => void): grpc.ClientUnaryCall;
 }
 
 export class WorkspaceManagerClient extends grpc.Client implements IWorkspaceManagerClient {
@@ -265,4 +293,10 @@ export class WorkspaceManagerClient extends grpc.Client implements IWorkspaceMan
     public describeCluster(request: core_pb.DescribeClusterRequest, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
     public describeCluster(request: core_pb.DescribeClusterRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
     public describeCluster(request: core_pb.DescribeClusterRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
+    public setSchedule(request: core_pb.SetScheduleRequest, callback: (error: grpc.ServiceError | null, response: core_pb.SetScheduleResponse) => void): grpc.ClientUnaryCall;
+    public setSchedule(request: core_pb.SetScheduleRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.SetScheduleResponse) => void): grpc.ClientUnaryCall;
+    public setSchedule(request: core_pb.SetScheduleRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.SetScheduleResponse) => void): grpc.ClientUnaryCall;
+    public resizeWorkspace(request: core_pb.ResizeWorkspaceRequest, callback: (error: grpc.ServiceError | null, response: core_pb.ResizeWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    public resizeWorkspace(request: core_pb.ResizeWorkspaceRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.ResizeWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    public resizeWorkspace(request: core_pb.ResizeWorkspaceRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.ResizeWorkspaceResponse) => void): grpc.ClientUnaryCall;
 }
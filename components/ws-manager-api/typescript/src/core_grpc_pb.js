@@ -188,6 +188,50 @@ function deserialize_wsman_MarkActiveResponse(buffer_arg) {
   return core_pb.MarkActiveResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_wsman_ResizeWorkspaceRequest(arg) {
+  if (!(arg instanceof core_pb.ResizeWorkspaceRequest)) {
+    throw new Error('Expected argument of type wsman.ResizeWorkspaceRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_ResizeWorkspaceRequest(buffer_arg) {
+  return core_pb.ResizeWorkspaceRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_ResizeWorkspaceResponse(arg) {
+  if (!(arg instanceof core_pb.ResizeWorkspaceResponse)) {
+    throw new Error('Expected argument of type wsman.ResizeWorkspaceResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_ResizeWorkspaceResponse(buffer_arg) {
+  return core_pb.ResizeWorkspaceResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_SetScheduleRequest(arg) {
+  if (!(arg instanceof core_pb.SetScheduleRequest)) {
+    throw new Error('Expected argument of type wsman.SetScheduleRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_SetScheduleRequest(buffer_arg) {
+  return core_pb.SetScheduleRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_SetScheduleResponse(arg) {
+  if (!(arg instanceof core_pb.SetScheduleResponse)) {
+    throw new Error('Expected argument of type wsman.SetScheduleResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_SetScheduleResponse(buffer_arg) {
+  return core_pb.SetScheduleResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_wsman_SetTimeoutRequest(arg) {
   if (!(arg instanceof core_pb.SetTimeoutRequest)) {
     throw new Error('Expected argument of type wsman.SetTimeoutRequest');
@@ -490,6 +534,30 @@ describeCluster: {
     responseSerialize: serialize_wsman_DescribeClusterResponse,
     responseDeserialize: deserialize_wsman_DescribeClusterResponse,
   },
+  // setSchedule attaches a start and stop schedule to a workspace or to all workspaces of an owner
+setSchedule: {
+    path: '/wsman.WorkspaceManager/SetSchedule',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.SetScheduleRequest,
+    responseType: core_pb.SetScheduleResponse,
+    requestSerialize: serialize_wsman_SetScheduleRequest,
+    requestDeserialize: deserialize_wsman_SetScheduleRequest,
+    responseSerialize: serialize_wsman_SetScheduleResponse,
+    responseDeserialize: deserialize_wsman_SetScheduleResponse,
+  },
+  // resizeWorkspace moves a running workspace to another workspace class without restarting it
+resizeWorkspace: {
+    path: '/wsman.WorkspaceManager/ResizeWorkspace',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.ResizeWorkspaceRequest,
+    responseType: core_pb.ResizeWorkspaceResponse,
+    requestSerialize: serialize_wsman_ResizeWorkspaceRequest,
+    requestDeserialize: deserialize_wsman_ResizeWorkspaceRequest,
+    responseSerialize: serialize_wsman_ResizeWorkspaceResponse,
+    responseDeserialize: deserialize_wsman_ResizeWorkspaceResponse,
+  },
 };
 
 exports.WorkspaceManagerClient = grpc.makeGenericClientConstructor(WorkspaceManagerService);
@@ -130,6 +130,8 @@ export class StartWorkspaceResponse extends jspb.Message {
     setUrl(value: string): StartWorkspaceResponse;
     getOwnerToken(): string;
     setOwnerToken(value: string): StartWorkspaceResponse;
+    getQueued(): boolean;
+    setQueued(value: boolean): StartWorkspaceResponse;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): StartWorkspaceResponse.AsObject;
@@ -145,6 +147,7 @@ export namespace StartWorkspaceResponse {
     export type AsObject = {
         url: string,
         ownerToken: string,
+        queued: boolean,
     }
 }
 
@@ -217,6 +220,11 @@ export class DescribeWorkspaceResponse extends jspb.Message {
     getLastactivity(): string;
     setLastactivity(value: string): DescribeWorkspaceResponse;
 
+    hasSchedule(): boolean;
+    clearSchedule(): void;
+    getSchedule(): WorkspaceScheduleStatus | undefined;
+    setSchedule(value?: WorkspaceScheduleStatus): DescribeWorkspaceResponse;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): DescribeWorkspaceResponse.AsObject;
     static toObject(includeInstance: boolean, msg: DescribeWorkspaceResponse): DescribeWorkspaceResponse.AsObject;
@@ -231,6 +239,7 @@ export namespace DescribeWorkspaceResponse {
     export type AsObject = {
         status?: WorkspaceStatus.AsObject,
         lastactivity: string,
+        schedule?: WorkspaceScheduleStatus.AsObject,
     }
 }
 
@@ -267,6 +276,11 @@ export class SubscribeResponse extends jspb.Message {
     getHeaderMap(): jspb.Map<string, string>;
     clearHeaderMap(): void;
 
+    hasScheduledStart(): boolean;
+    clearScheduledStart(): void;
+    getScheduledStart(): ScheduledStart | undefined;
+    setScheduledStart(value?: ScheduledStart): SubscribeResponse;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): SubscribeResponse.AsObject;
     static toObject(includeInstance: boolean, msg: SubscribeResponse): SubscribeResponse.AsObject;
@@ -282,6 +296,36 @@ export namespace SubscribeResponse {
         status?: WorkspaceStatus.AsObject,
 
         headerMap: Array<[string, string]>,
+        scheduledStart?: ScheduledStart.AsObject,
+    }
+}
+
+export class ScheduledStart extends jspb.Message {
+
+    hasMetadata(): boolean;
+    clearMetadata(): void;
+    getMetadata(): WorkspaceMetadata | undefined;
+    setMetadata(value?: WorkspaceMetadata): ScheduledStart;
+
+    hasTime(): boolean;
+    clearTime(): void;
+    getTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setTime(value?: google_protobuf_timestamp_pb.Timestamp): ScheduledStart;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ScheduledStart.AsObject;
+    static toObject(includeInstance: boolean, msg: ScheduledStart): ScheduledStart.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ScheduledStart, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ScheduledStart;
+    static deserializeBinaryFromReader(message: ScheduledStart, reader: jspb.BinaryReader): ScheduledStart;
+}
+
+export namespace ScheduledStart {
+    export type AsObject = {
+        metadata?: WorkspaceMetadata.AsObject,
+        time?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     }
 }
 
@@ -375,6 +419,10 @@ export class ControlPortRequest extends jspb.Message {
     clearSpec(): void;
     getSpec(): PortSpec | undefined;
     setSpec(value?: PortSpec): ControlPortRequest;
+    getShareLinkExpiry(): string;
+    setShareLinkExpiry(value: string): ControlPortRequest;
+    getRevokeShareLinks(): boolean;
+    setRevokeShareLinks(value: boolean): ControlPortRequest;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): ControlPortRequest.AsObject;
@@ -391,6 +439,8 @@ export namespace ControlPortRequest {
         id: string,
         expose: boolean,
         spec?: PortSpec.AsObject,
+        shareLinkExpiry: string,
+        revokeShareLinks: boolean,
     }
 }
 
@@ -625,6 +675,46 @@ export namespace UpdateSSHKeyResponse {
     }
 }
 
+export class ResizeWorkspaceRequest extends jspb.Message {
+    getId(): string;
+    setId(value: string): ResizeWorkspaceRequest;
+    getClass(): string;
+    setClass(value: string): ResizeWorkspaceRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ResizeWorkspaceRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ResizeWorkspaceRequest): ResizeWorkspaceRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ResizeWorkspaceRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ResizeWorkspaceRequest;
+    static deserializeBinaryFromReader(message: ResizeWorkspaceRequest, reader: jspb.BinaryReader): ResizeWorkspaceRequest;
+}
+
+export namespace ResizeWorkspaceRequest {
+    export type AsObject = {
+        id: string,
+        pb_class: string,
+    }
+}
+
+export class ResizeWorkspaceResponse extends jspb.Message {
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ResizeWorkspaceResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ResizeWorkspaceResponse): ResizeWorkspaceResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ResizeWorkspaceResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ResizeWorkspaceResponse;
+    static deserializeBinaryFromReader(message: ResizeWorkspaceResponse, reader: jspb.BinaryReader): ResizeWorkspaceResponse;
+}
+
+export namespace ResizeWorkspaceResponse {
+    export type AsObject = {
+    }
+}
+
 export class WorkspaceStatus extends jspb.Message {
     getId(): string;
     setId(value: string): WorkspaceStatus;
@@ -664,6 +754,8 @@ export class WorkspaceStatus extends jspb.Message {
     clearAuth(): void;
     getAuth(): WorkspaceAuthentication | undefined;
     setAuth(value?: WorkspaceAuthentication): WorkspaceStatus;
+    getQueuePosition(): number;
+    setQueuePosition(value: number): WorkspaceStatus;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): WorkspaceStatus.AsObject;
@@ -687,6 +779,7 @@ export namespace WorkspaceStatus {
         repo?: content_service_api_initializer_pb.GitStatus.AsObject,
         runtime?: WorkspaceRuntimeInfo.AsObject,
         auth?: WorkspaceAuthentication.AsObject,
+        queuePosition: number,
     }
 }
 
@@ -781,6 +874,11 @@ export class PortSpec extends jspb.Message {
     getUrl(): string;
     setUrl(value: string): PortSpec;
 
+    hasShareLinksNotBefore(): boolean;
+    clearShareLinksNotBefore(): void;
+    getShareLinksNotBefore(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setShareLinksNotBefore(value?: google_protobuf_timestamp_pb.Timestamp): PortSpec;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): PortSpec.AsObject;
     static toObject(includeInstance: boolean, msg: PortSpec): PortSpec.AsObject;
@@ -796,6 +894,7 @@ export namespace PortSpec {
         port: number,
         visibility: PortVisibility,
         url: string,
+        shareLinksNotBefore?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     }
 }
 
@@ -854,6 +953,13 @@ export class WorkspaceConditions extends jspb.Message {
     getAborted(): WorkspaceConditionBool;
     setAborted(value: WorkspaceConditionBool): WorkspaceConditions;
 
+    hasResized(): boolean;
+    clearResized(): void;
+    getResized(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setResized(value?: google_protobuf_timestamp_pb.Timestamp): WorkspaceConditions;
+    getServicesFailed(): string;
+    setServicesFailed(value: string): WorkspaceConditions;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): WorkspaceConditions.AsObject;
     static toObject(includeInstance: boolean, msg: WorkspaceConditions): WorkspaceConditions.AsObject;
@@ -878,6 +984,8 @@ export namespace WorkspaceConditions {
         stoppedByRequest: WorkspaceConditionBool,
         volumeSnapshot?: VolumeSnapshotInfo.AsObject,
         aborted: WorkspaceConditionBool,
+        resized?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+        servicesFailed: string,
     }
 }
 
@@ -958,6 +1066,8 @@ export class WorkspaceAuthentication extends jspb.Message {
     setAdmission(value: AdmissionLevel): WorkspaceAuthentication;
     getOwnerToken(): string;
     setOwnerToken(value: string): WorkspaceAuthentication;
+    getTeamToken(): string;
+    setTeamToken(value: string): WorkspaceAuthentication;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): WorkspaceAuthentication.AsObject;
@@ -973,6 +1083,7 @@ export namespace WorkspaceAuthentication {
     export type AsObject = {
         admission: AdmissionLevel,
         ownerToken: string,
+        teamToken: string,
     }
 }
 
@@ -1033,6 +1144,10 @@ export class StartWorkspaceSpec extends jspb.Message {
     getIdeImageLayersList(): Array<string>;
     setIdeImageLayersList(value: Array<string>): StartWorkspaceSpec;
     addIdeImageLayers(value: string, index?: number): string;
+    clearServicesList(): void;
+    getServicesList(): Array<ServiceSpec>;
+    setServicesList(value: Array<ServiceSpec>): StartWorkspaceSpec;
+    addServices(value?: ServiceSpec, index?: number): ServiceSpec;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): StartWorkspaceSpec.AsObject;
@@ -1062,6 +1177,51 @@ export namespace StartWorkspaceSpec {
         sshPublicKeysList: Array<string>,
         sysEnvvarsList: Array<EnvironmentVariable.AsObject>,
         ideImageLayersList: Array<string>,
+        servicesList: Array<ServiceSpec.AsObject>,
+    }
+}
+
+export class ServiceSpec extends jspb.Message {
+    getName(): string;
+    setName(value: string): ServiceSpec;
+    getImage(): string;
+    setImage(value: string): ServiceSpec;
+    clearCommandList(): void;
+    getCommandList(): Array<string>;
+    setCommandList(value: Array<string>): ServiceSpec;
+    addCommand(value: string, index?: number): string;
+    clearEnvList(): void;
+    getEnvList(): Array<EnvironmentVariable>;
+    setEnvList(value: Array<EnvironmentVariable>): ServiceSpec;
+    addEnv(value?: EnvironmentVariable, index?: number): EnvironmentVariable;
+    clearPortsList(): void;
+    getPortsList(): Array<number>;
+    setPortsList(value: Array<number>): ServiceSpec;
+    addPorts(value: number, index?: number): number;
+    getCpuLimit(): string;
+    setCpuLimit(value: string): ServiceSpec;
+    getMemoryLimit(): string;
+    setMemoryLimit(value: string): ServiceSpec;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ServiceSpec.AsObject;
+    static toObject(includeInstance: boolean, msg: ServiceSpec): ServiceSpec.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ServiceSpec, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ServiceSpec;
+    static deserializeBinaryFromReader(message: ServiceSpec, reader: jspb.BinaryReader): ServiceSpec;
+}
+
+export namespace ServiceSpec {
+    export type AsObject = {
+        name: string,
+        image: string,
+        commandList: Array<string>,
+        envList: Array<EnvironmentVariable.AsObject>,
+        portsList: Array<number>,
+        cpuLimit: string,
+        memoryLimit: string,
     }
 }
 
@@ -1208,6 +1368,10 @@ export class DescribeClusterResponse extends jspb.Message {
     getWorkspaceclassesList(): Array<WorkspaceClass>;
     setWorkspaceclassesList(value: Array<WorkspaceClass>): DescribeClusterResponse;
     addWorkspaceclasses(value?: WorkspaceClass, index?: number): WorkspaceClass;
+    clearAdmissionqueueList(): void;
+    getAdmissionqueueList(): Array<QueuedWorkspace>;
+    setAdmissionqueueList(value: Array<QueuedWorkspace>): DescribeClusterResponse;
+    addAdmissionqueue(value?: QueuedWorkspace, index?: number): QueuedWorkspace;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): DescribeClusterResponse.AsObject;
@@ -1222,6 +1386,45 @@ export class DescribeClusterResponse extends jspb.Message {
 export namespace DescribeClusterResponse {
     export type AsObject = {
         workspaceclassesList: Array<WorkspaceClass.AsObject>,
+        admissionqueueList: Array<QueuedWorkspace.AsObject>,
+    }
+}
+
+export class QueuedWorkspace extends jspb.Message {
+    getId(): string;
+    setId(value: string): QueuedWorkspace;
+
+    hasMetadata(): boolean;
+    clearMetadata(): void;
+    getMetadata(): WorkspaceMetadata | undefined;
+    setMetadata(value?: WorkspaceMetadata): QueuedWorkspace;
+    getType(): WorkspaceType;
+    setType(value: WorkspaceType): QueuedWorkspace;
+    getPosition(): number;
+    setPosition(value: number): QueuedWorkspace;
+
+    hasQueuedSince(): boolean;
+    clearQueuedSince(): void;
+    getQueuedSince(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setQueuedSince(value?: google_protobuf_timestamp_pb.Timestamp): QueuedWorkspace;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): QueuedWorkspace.AsObject;
+    static toObject(includeInstance: boolean, msg: QueuedWorkspace): QueuedWorkspace.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: QueuedWorkspace, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): QueuedWorkspace;
+    static deserializeBinaryFromReader(message: QueuedWorkspace, reader: jspb.BinaryReader): QueuedWorkspace;
+}
+
+export namespace QueuedWorkspace {
+    export type AsObject = {
+        id: string,
+        metadata?: WorkspaceMetadata.AsObject,
+        type: WorkspaceType,
+        position: number,
+        queuedSince?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     }
 }
 
@@ -1248,6 +1451,148 @@ export namespace WorkspaceClass {
     }
 }
 
+export class SetScheduleRequest extends jspb.Message {
+    getOwner(): string;
+    setOwner(value: string): SetScheduleRequest;
+    getMetaId(): string;
+    setMetaId(value: string): SetScheduleRequest;
+
+    hasSchedule(): boolean;
+    clearSchedule(): void;
+    getSchedule(): WorkspaceSchedule | undefined;
+    setSchedule(value?: WorkspaceSchedule): SetScheduleRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): SetScheduleRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: SetScheduleRequest): SetScheduleRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: SetScheduleRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): SetScheduleRequest;
+    static deserializeBinaryFromReader(message: SetScheduleRequest, reader: jspb.BinaryReader): SetScheduleRequest;
+}
+
+export namespace SetScheduleRequest {
+    export type AsObject = {
+        owner: string,
+        metaId: string,
+        schedule?: WorkspaceSchedule.AsObject,
+    }
+}
+
+export class SetScheduleResponse extends jspb.Message {
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): SetScheduleResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: SetScheduleResponse): SetScheduleResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: SetScheduleResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): SetScheduleResponse;
+    static deserializeBinaryFromReader(message: SetScheduleResponse, reader: jspb.BinaryReader): SetScheduleResponse;
+}
+
+export namespace SetScheduleResponse {
+    export type AsObject = {
+    }
+}
+
+export class WorkspaceSchedule extends jspb.Message {
+    getTimezone(): string;
+    setTimezone(value: string): WorkspaceSchedule;
+    clearPreStartList(): void;
+    getPreStartList(): Array<string>;
+    setPreStartList(value: Array<string>): WorkspaceSchedule;
+    addPreStart(value: string, index?: number): string;
+    clearStopWindowsList(): void;
+    getStopWindowsList(): Array<ScheduleWindow>;
+    setStopWindowsList(value: Array<ScheduleWindow>): WorkspaceSchedule;
+    addStopWindows(value?: ScheduleWindow, index?: number): ScheduleWindow;
+    clearWorkingHoursList(): void;
+    getWorkingHoursList(): Array<ScheduleWindow>;
+    setWorkingHoursList(value: Array<ScheduleWindow>): WorkspaceSchedule;
+    addWorkingHours(value?: ScheduleWindow, index?: number): ScheduleWindow;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): WorkspaceSchedule.AsObject;
+    static toObject(includeInstance: boolean, msg: WorkspaceSchedule): WorkspaceSchedule.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: WorkspaceSchedule, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): WorkspaceSchedule;
+    static deserializeBinaryFromReader(message: WorkspaceSchedule, reader: jspb.BinaryReader): WorkspaceSchedule;
+}
+
+export namespace WorkspaceSchedule {
+    export type AsObject = {
+        timezone: string,
+        preStartList: Array<string>,
+        stopWindowsList: Array<ScheduleWindow.AsObject>,
+        workingHoursList: Array<ScheduleWindow.AsObject>,
+    }
+}
+
+export class ScheduleWindow extends jspb.Message {
+    getStart(): string;
+    setStart(value: string): ScheduleWindow;
+    getDuration(): string;
+    setDuration(value: string): ScheduleWindow;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ScheduleWindow.AsObject;
+    static toObject(includeInstance: boolean, msg: ScheduleWindow): ScheduleWindow.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ScheduleWindow, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ScheduleWindow;
+    static deserializeBinaryFromReader(message: ScheduleWindow, reader: jspb.BinaryReader): ScheduleWindow;
+}
+
+export namespace ScheduleWindow {
+    export type AsObject = {
+        start: string,
+        duration: string,
+    }
+}
+
+export class WorkspaceScheduleStatus extends jspb.Message {
+
+    hasSchedule(): boolean;
+    clearSchedule(): void;
+    getSchedule(): WorkspaceSchedule | undefined;
+    setSchedule(value?: WorkspaceSchedule): WorkspaceScheduleStatus;
+    getWorkingHours(): boolean;
+    setWorkingHours(value: boolean): WorkspaceScheduleStatus;
+
+    hasNextStop(): boolean;
+    clearNextStop(): void;
+    getNextStop(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setNextStop(value?: google_protobuf_timestamp_pb.Timestamp): WorkspaceScheduleStatus;
+
+    hasNextPreStart(): boolean;
+    clearNextPreStart(): void;
+    getNextPreStart(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setNextPreStart(value?: google_protobuf_timestamp_pb.Timestamp): WorkspaceScheduleStatus;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): WorkspaceScheduleStatus.AsObject;
+    static toObject(includeInstance: boolean, msg: WorkspaceScheduleStatus): WorkspaceScheduleStatus.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: WorkspaceScheduleStatus, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): WorkspaceScheduleStatus;
+    static deserializeBinaryFromReader(message: WorkspaceScheduleStatus, reader: jspb.BinaryReader): WorkspaceScheduleStatus;
+}
+
+export namespace WorkspaceScheduleStatus {
+    export type AsObject = {
+        schedule?: WorkspaceSchedule.AsObject,
+        workingHours: boolean,
+        nextStop?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+        nextPreStart?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    }
+}
+
 export enum StopWorkspacePolicy {
     NORMALLY = 0,
     IMMEDIATELY = 1,
@@ -1262,6 +1607,8 @@ export enum AdmissionLevel {
 export enum PortVisibility {
     PORT_VISIBILITY_PRIVATE = 0,
     PORT_VISIBILITY_PUBLIC = 1,
+    PORT_VISIBILITY_TEAM = 2,
+    PORT_VISIBILITY_TOKEN = 3,
 }
 
 export enum WorkspaceConditionBool {
@@ -50,7 +50,15 @@ goog.exportSymbol('proto.wsman.MarkActiveResponse', null, global);
 goog.exportSymbol('proto.wsman.MetadataFilter', null, global);
 goog.exportSymbol('proto.wsman.PortSpec', null, global);
 goog.exportSymbol('proto.wsman.PortVisibility', null, global);
+goog.exportSymbol('proto.wsman.QueuedWorkspace', null, global);
+goog.exportSymbol('proto.wsman.ResizeWorkspaceRequest', null, global);
+goog.exportSymbol('proto.wsman.ResizeWorkspaceResponse', null, global);
 goog.exportSymbol('proto.wsman.SSHPublicKeys', null, global);
+goog.exportSymbol('proto.wsman.ScheduleWindow', null, global);
+goog.exportSymbol('proto.wsman.ScheduledStart', null, global);
+goog.exportSymbol('proto.wsman.ServiceSpec', null, global);
+goog.exportSymbol('proto.wsman.SetScheduleRequest', null, global);
+goog.exportSymbol('proto.wsman.SetScheduleResponse', null, global);
 goog.exportSymbol('proto.wsman.SetTimeoutRequest', null, global);
 goog.exportSymbol('proto.wsman.SetTimeoutResponse', null, global);
 goog.exportSymbol('proto.wsman.StartWorkspaceRequest', null, global);
@@ -74,6 +82,8 @@ goog.exportSymbol('proto.wsman.WorkspaceFeatureFlag', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceMetadata', null, global);
 goog.exportSymbol('proto.wsman.WorkspacePhase', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceRuntimeInfo', null, global);
+goog.exportSymbol('proto.wsman.WorkspaceSchedule', null, global);
+goog.exportSymbol('proto.wsman.WorkspaceScheduleStatus', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceSpec', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceStatus', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceType', null, global);
@@ -308,6 +318,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsman.SubscribeResponse.displayName = 'proto.wsman.SubscribeResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.ScheduledStart = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.ScheduledStart, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.ScheduledStart.displayName = 'proto.wsman.ScheduledStart';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -644,6 +675,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsman.UpdateSSHKeyResponse.displayName = 'proto.wsman.UpdateSSHKeyResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.ResizeWorkspaceRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.ResizeWorkspaceRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.ResizeWorkspaceRequest.displayName = 'proto.wsman.ResizeWorkspaceRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.ResizeWorkspaceResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.ResizeWorkspaceResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.ResizeWorkspaceResponse.displayName = 'proto.wsman.ResizeWorkspaceResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -854,6 +927,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsman.StartWorkspaceSpec.displayName = 'proto.wsman.StartWorkspaceSpec';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.ServiceSpec = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.ServiceSpec.repeatedFields_, null);
+};
+goog.inherits(proto.wsman.ServiceSpec, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.ServiceSpec.displayName = 'proto.wsman.ServiceSpec';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -1001,6 +1095,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsman.DescribeClusterResponse.displayName = 'proto.wsman.DescribeClusterResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.QueuedWorkspace = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.QueuedWorkspace, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.QueuedWorkspace.displayName = 'proto.wsman.QueuedWorkspace';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -1022,6 +1137,111 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsman.WorkspaceClass.displayName = 'proto.wsman.WorkspaceClass';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.SetScheduleRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.SetScheduleRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.SetScheduleRequest.displayName = 'proto.wsman.SetScheduleRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.SetScheduleResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.SetScheduleResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.SetScheduleResponse.displayName = 'proto.wsman.SetScheduleResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.WorkspaceSchedule = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.WorkspaceSchedule.repeatedFields_, null);
+};
+goog.inherits(proto.wsman.WorkspaceSchedule, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.WorkspaceSchedule.displayName = 'proto.wsman.WorkspaceSchedule';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.ScheduleWindow = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.ScheduleWindow, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.ScheduleWindow.displayName = 'proto.wsman.ScheduleWindow';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.WorkspaceScheduleStatus = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.WorkspaceScheduleStatus, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.WorkspaceScheduleStatus.displayName = 'proto.wsman.WorkspaceScheduleStatus';
+}
 
 
 
@@ -1851,7 +2071,8 @@ proto.wsman.StartWorkspaceResponse.prototype.toObject = function(opt_includeInst
 proto.wsman.StartWorkspaceResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
     url: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    ownerToken: jspb.Message.getFieldWithDefault(msg, 2, "")
+    ownerToken: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    queued: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -1896,6 +2117,10 @@ proto.wsman.StartWorkspaceResponse.deserializeBinaryFromReader = function(msg, r
       var value = /** @type {string} */ (reader.readString());
       msg.setOwnerToken(value);
       break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setQueued(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1939,6 +2164,13 @@ proto.wsman.StartWorkspaceResponse.serializeBinaryToWriter = function(message, w
       f
     );
   }
+  f = message.getQueued();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -1978,6 +2210,24 @@ proto.wsman.StartWorkspaceResponse.prototype.setOwnerToken = function(value) {
 };
 
 
+/**
+ * optional bool queued = 3;
+ * @return {boolean}
+ */
+proto.wsman.StartWorkspaceResponse.prototype.getQueued = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsman.StartWorkspaceResponse} returns this
+ */
+proto.wsman.StartWorkspaceResponse.prototype.setQueued = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
 
 
 
@@ -2402,7 +2652,8 @@ proto.wsman.DescribeWorkspaceResponse.prototype.toObject = function(opt_includeI
 proto.wsman.DescribeWorkspaceResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
     status: (f = msg.getStatus()) && proto.wsman.WorkspaceStatus.toObject(includeInstance, f),
-    lastactivity: jspb.Message.getFieldWithDefault(msg, 2, "")
+    lastactivity: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    schedule: (f = msg.getSchedule()) && proto.wsman.WorkspaceScheduleStatus.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2448,6 +2699,11 @@ proto.wsman.DescribeWorkspaceResponse.deserializeBinaryFromReader = function(msg
       var value = /** @type {string} */ (reader.readString());
       msg.setLastactivity(value);
       break;
+    case 3:
+      var value = new proto.wsman.WorkspaceScheduleStatus;
+      reader.readMessage(value,proto.wsman.WorkspaceScheduleStatus.deserializeBinaryFromReader);
+      msg.setSchedule(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2492,6 +2748,14 @@ proto.wsman.DescribeWorkspaceResponse.serializeBinaryToWriter = function(message
       f
     );
   }
+  f = message.getSchedule();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.wsman.WorkspaceScheduleStatus.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -2550,6 +2814,43 @@ proto.wsman.DescribeWorkspaceResponse.prototype.setLastactivity = function(value
 };
 
 
+/**
+ * optional WorkspaceScheduleStatus schedule = 3;
+ * @return {?proto.wsman.WorkspaceScheduleStatus}
+ */
+proto.wsman.DescribeWorkspaceResponse.prototype.getSchedule = function() {
+  return /** @type{?proto.wsman.WorkspaceScheduleStatus} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceScheduleStatus, 3));
+};
+
+
+/**
+ * @param {?proto.wsman.WorkspaceScheduleStatus|undefined} value
+ * @return {!proto.wsman.DescribeWorkspaceResponse} returns this
+*/
+proto.wsman.DescribeWorkspaceResponse.prototype.setSchedule = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.DescribeWorkspaceResponse} returns this
+ */
+proto.wsman.DescribeWorkspaceResponse.prototype.clearSchedule = function() {
+  return this.setSchedule(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.DescribeWorkspaceResponse.prototype.hasSchedule = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
 
 
 
@@ -2734,7 +3035,8 @@ proto.wsman.SubscribeResponse.prototype.toObject = function(opt_includeInstance)
 proto.wsman.SubscribeResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
     status: (f = msg.getStatus()) && proto.wsman.WorkspaceStatus.toObject(includeInstance, f),
-    headerMap: (f = msg.getHeaderMap()) ? f.toObject(includeInstance, undefined) : []
+    headerMap: (f = msg.getHeaderMap()) ? f.toObject(includeInstance, undefined) : [],
+    scheduledStart: (f = msg.getScheduledStart()) && proto.wsman.ScheduledStart.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2782,6 +3084,11 @@ proto.wsman.SubscribeResponse.deserializeBinaryFromReader = function(msg, reader
         jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
          });
       break;
+    case 4:
+      var value = new proto.wsman.ScheduledStart;
+      reader.readMessage(value,proto.wsman.ScheduledStart.deserializeBinaryFromReader);
+      msg.setScheduledStart(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2823,6 +3130,14 @@ proto.wsman.SubscribeResponse.serializeBinaryToWriter = function(message, writer
   if (f && f.getLength() > 0) {
     f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
   }
+  f = message.getScheduledStart();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.wsman.ScheduledStart.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -2885,12 +3200,251 @@ proto.wsman.SubscribeResponse.prototype.clearHeaderMap = function() {
   return this;};
 
 
+/**
+ * optional ScheduledStart scheduled_start = 4;
+ * @return {?proto.wsman.ScheduledStart}
+ */
+proto.wsman.SubscribeResponse.prototype.getScheduledStart = function() {
+  return /** @type{?proto.wsman.ScheduledStart} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.ScheduledStart, 4));
+};
+
 
+/**
+ * @param {?proto.wsman.ScheduledStart|undefined} value
+ * @return {!proto.wsman.SubscribeResponse} returns this
+*/
+proto.wsman.SubscribeResponse.prototype.setScheduledStart = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.SubscribeResponse} returns this
+ */
+proto.wsman.SubscribeResponse.prototype.clearScheduledStart = function() {
+  return this.setScheduledStart(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.SubscribeResponse.prototype.hasScheduledStart = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.ScheduledStart.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ScheduledStart.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.ScheduledStart} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ScheduledStart.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    metadata: (f = msg.getMetadata()) && proto.wsman.WorkspaceMetadata.toObject(includeInstance, f),
+    time: (f = msg.getTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.ScheduledStart}
+ */
+proto.wsman.ScheduledStart.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.ScheduledStart;
+  return proto.wsman.ScheduledStart.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.ScheduledStart} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.ScheduledStart}
+ */
+proto.wsman.ScheduledStart.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.wsman.WorkspaceMetadata;
+      reader.readMessage(value,proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader);
+      msg.setMetadata(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTime(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.ScheduledStart.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.ScheduledStart.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.ScheduledStart} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ScheduledStart.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMetadata();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.wsman.WorkspaceMetadata.serializeBinaryToWriter
+    );
+  }
+  f = message.getTime();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional WorkspaceMetadata metadata = 1;
+ * @return {?proto.wsman.WorkspaceMetadata}
+ */
+proto.wsman.ScheduledStart.prototype.getMetadata = function() {
+  return /** @type{?proto.wsman.WorkspaceMetadata} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceMetadata, 1));
+};
+
+
+/**
+ * @param {?proto.wsman.WorkspaceMetadata|undefined} value
+ * @return {!proto.wsman.ScheduledStart} returns this
+*/
+proto.wsman.ScheduledStart.prototype.setMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.ScheduledStart} returns this
+ */
+proto.wsman.ScheduledStart.prototype.clearMetadata = function() {
+  return this.setMetadata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.ScheduledStart.prototype.hasMetadata = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp time = 2;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsman.ScheduledStart.prototype.getTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.ScheduledStart} returns this
+*/
+proto.wsman.ScheduledStart.prototype.setTime = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.ScheduledStart} returns this
+ */
+proto.wsman.ScheduledStart.prototype.clearTime = function() {
+  return this.setTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.ScheduledStart.prototype.hasTime = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
  * Field names that are reserved in JavaScript and will be renamed to pb_name.
  * Optional fields that are not set will be set to undefined.
  * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
@@ -3441,7 +3995,9 @@ proto.wsman.ControlPortRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
     expose: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    spec: (f = msg.getSpec()) && proto.wsman.PortSpec.toObject(includeInstance, f)
+    spec: (f = msg.getSpec()) && proto.wsman.PortSpec.toObject(includeInstance, f),
+    shareLinkExpiry: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    revokeShareLinks: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
   };
 
   if (includeInstance) {
@@ -3491,6 +4047,14 @@ proto.wsman.ControlPortRequest.deserializeBinaryFromReader = function(msg, reade
       reader.readMessage(value,proto.wsman.PortSpec.deserializeBinaryFromReader);
       msg.setSpec(value);
       break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setShareLinkExpiry(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRevokeShareLinks(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -3542,6 +4106,20 @@ proto.wsman.ControlPortRequest.serializeBinaryToWriter = function(message, write
       proto.wsman.PortSpec.serializeBinaryToWriter
     );
   }
+  f = message.getShareLinkExpiry();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getRevokeShareLinks();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -3618,6 +4196,42 @@ proto.wsman.ControlPortRequest.prototype.hasSpec = function() {
 };
 
 
+/**
+ * optional string share_link_expiry = 4;
+ * @return {string}
+ */
+proto.wsman.ControlPortRequest.prototype.getShareLinkExpiry = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.ControlPortRequest} returns this
+ */
+proto.wsman.ControlPortRequest.prototype.setShareLinkExpiry = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional bool revoke_share_links = 5;
+ * @return {boolean}
+ */
+proto.wsman.ControlPortRequest.prototype.getRevokeShareLinks = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsman.ControlPortRequest} returns this
+ */
+proto.wsman.ControlPortRequest.prototype.setRevokeShareLinks = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
 
 
 
@@ -5183,8 +5797,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.WorkspaceStatus.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.WorkspaceStatus.toObject(opt_includeInstance, this);
+proto.wsman.ResizeWorkspaceRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ResizeWorkspaceRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5193,22 +5807,14 @@ proto.wsman.WorkspaceStatus.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.WorkspaceStatus} msg The msg instance to transform.
+ * @param {!proto.wsman.ResizeWorkspaceRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceStatus.toObject = function(includeInstance, msg) {
+proto.wsman.ResizeWorkspaceRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    statusVersion: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    metadata: (f = msg.getMetadata()) && proto.wsman.WorkspaceMetadata.toObject(includeInstance, f),
-    spec: (f = msg.getSpec()) && proto.wsman.WorkspaceSpec.toObject(includeInstance, f),
-    phase: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    conditions: (f = msg.getConditions()) && proto.wsman.WorkspaceConditions.toObject(includeInstance, f),
-    message: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    repo: (f = msg.getRepo()) && content$service$api_initializer_pb.GitStatus.toObject(includeInstance, f),
-    runtime: (f = msg.getRuntime()) && proto.wsman.WorkspaceRuntimeInfo.toObject(includeInstance, f),
-    auth: (f = msg.getAuth()) && proto.wsman.WorkspaceAuthentication.toObject(includeInstance, f)
+    pb_class: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -5222,23 +5828,23 @@ proto.wsman.WorkspaceStatus.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.WorkspaceStatus}
+ * @return {!proto.wsman.ResizeWorkspaceRequest}
  */
-proto.wsman.WorkspaceStatus.deserializeBinary = function(bytes) {
+proto.wsman.ResizeWorkspaceRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.WorkspaceStatus;
-  return proto.wsman.WorkspaceStatus.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.ResizeWorkspaceRequest;
+  return proto.wsman.ResizeWorkspaceRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.WorkspaceStatus} msg The message object to deserialize into.
+ * @param {!proto.wsman.ResizeWorkspaceRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.WorkspaceStatus}
+ * @return {!proto.wsman.ResizeWorkspaceRequest}
  */
-proto.wsman.WorkspaceStatus.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.ResizeWorkspaceRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5249,10 +5855,280 @@ proto.wsman.WorkspaceStatus.deserializeBinaryFromReader = function(msg, reader)
       var value = /** @type {string} */ (reader.readString());
       msg.setId(value);
       break;
-    case 10:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setStatusVersion(value);
-      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClass(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.ResizeWorkspaceRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.ResizeWorkspaceRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.ResizeWorkspaceRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ResizeWorkspaceRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getClass();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.ResizeWorkspaceRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.ResizeWorkspaceRequest} returns this
+ */
+proto.wsman.ResizeWorkspaceRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string class = 2;
+ * @return {string}
+ */
+proto.wsman.ResizeWorkspaceRequest.prototype.getClass = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.ResizeWorkspaceRequest} returns this
+ */
+proto.wsman.ResizeWorkspaceRequest.prototype.setClass = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.ResizeWorkspaceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ResizeWorkspaceResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.ResizeWorkspaceResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ResizeWorkspaceResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.ResizeWorkspaceResponse}
+ */
+proto.wsman.ResizeWorkspaceResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.ResizeWorkspaceResponse;
+  return proto.wsman.ResizeWorkspaceResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.ResizeWorkspaceResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.ResizeWorkspaceResponse}
+ */
+proto.wsman.ResizeWorkspaceResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.ResizeWorkspaceResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.ResizeWorkspaceResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.ResizeWorkspaceResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ResizeWorkspaceResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.WorkspaceStatus.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceStatus.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.WorkspaceStatus} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceStatus.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    statusVersion: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    metadata: (f = msg.getMetadata()) && proto.wsman.WorkspaceMetadata.toObject(includeInstance, f),
+    spec: (f = msg.getSpec()) && proto.wsman.WorkspaceSpec.toObject(includeInstance, f),
+    phase: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    conditions: (f = msg.getConditions()) && proto.wsman.WorkspaceConditions.toObject(includeInstance, f),
+    message: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    repo: (f = msg.getRepo()) && content$service$api_initializer_pb.GitStatus.toObject(includeInstance, f),
+    runtime: (f = msg.getRuntime()) && proto.wsman.WorkspaceRuntimeInfo.toObject(includeInstance, f),
+    auth: (f = msg.getAuth()) && proto.wsman.WorkspaceAuthentication.toObject(includeInstance, f),
+    queuePosition: jspb.Message.getFieldWithDefault(msg, 11, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.WorkspaceStatus}
+ */
+proto.wsman.WorkspaceStatus.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.WorkspaceStatus;
+  return proto.wsman.WorkspaceStatus.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.WorkspaceStatus} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.WorkspaceStatus}
+ */
+proto.wsman.WorkspaceStatus.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setStatusVersion(value);
+      break;
     case 2:
       var value = new proto.wsman.WorkspaceMetadata;
       reader.readMessage(value,proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader);
@@ -5291,6 +6167,10 @@ proto.wsman.WorkspaceStatus.deserializeBinaryFromReader = function(msg, reader)
       reader.readMessage(value,proto.wsman.WorkspaceAuthentication.deserializeBinaryFromReader);
       msg.setAuth(value);
       break;
+    case 11:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setQueuePosition(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -5396,6 +6276,13 @@ proto.wsman.WorkspaceStatus.serializeBinaryToWriter = function(message, writer)
       proto.wsman.WorkspaceAuthentication.serializeBinaryToWriter
     );
   }
+  f = message.getQueuePosition();
+  if (f !== 0) {
+    writer.writeUint32(
+      11,
+      f
+    );
+  }
 };
 
 
@@ -5693,9 +6580,27 @@ proto.wsman.WorkspaceStatus.prototype.hasAuth = function() {
 };
 
 
+/**
+ * optional uint32 queue_position = 11;
+ * @return {number}
+ */
+proto.wsman.WorkspaceStatus.prototype.getQueuePosition = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
+ */
+proto.wsman.WorkspaceStatus.prototype.setQueuePosition = function(value) {
+  return jspb.Message.setProto3IntField(this, 11, value);
+};
+
+
+
 
 
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -6417,7 +7322,8 @@ proto.wsman.PortSpec.toObject = function(includeInstance, msg) {
   var f, obj = {
     port: jspb.Message.getFieldWithDefault(msg, 1, 0),
     visibility: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    url: jspb.Message.getFieldWithDefault(msg, 4, "")
+    url: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    shareLinksNotBefore: (f = msg.getShareLinksNotBefore()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -6466,6 +7372,11 @@ proto.wsman.PortSpec.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {string} */ (reader.readString());
       msg.setUrl(value);
       break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setShareLinksNotBefore(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -6516,6 +7427,14 @@ proto.wsman.PortSpec.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getShareLinksNotBefore();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -6573,6 +7492,43 @@ proto.wsman.PortSpec.prototype.setUrl = function(value) {
 };
 
 
+/**
+ * optional google.protobuf.Timestamp share_links_not_before = 5;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsman.PortSpec.prototype.getShareLinksNotBefore = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.PortSpec} returns this
+*/
+proto.wsman.PortSpec.prototype.setShareLinksNotBefore = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.PortSpec} returns this
+ */
+proto.wsman.PortSpec.prototype.clearShareLinksNotBefore = function() {
+  return this.setShareLinksNotBefore(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.PortSpec.prototype.hasShareLinksNotBefore = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
 
 
 
@@ -6776,7 +7732,9 @@ proto.wsman.WorkspaceConditions.toObject = function(includeInstance, msg) {
     headlessTaskFailed: jspb.Message.getFieldWithDefault(msg, 10, ""),
     stoppedByRequest: jspb.Message.getFieldWithDefault(msg, 11, 0),
     volumeSnapshot: (f = msg.getVolumeSnapshot()) && proto.wsman.VolumeSnapshotInfo.toObject(includeInstance, f),
-    aborted: jspb.Message.getFieldWithDefault(msg, 13, 0)
+    aborted: jspb.Message.getFieldWithDefault(msg, 13, 0),
+    resized: (f = msg.getResized()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    servicesFailed: jspb.Message.getFieldWithDefault(msg, 15, "")
   };
 
   if (includeInstance) {
@@ -6863,6 +7821,15 @@ proto.wsman.WorkspaceConditions.deserializeBinaryFromReader = function(msg, read
       var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
       msg.setAborted(value);
       break;
+    case 14:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setResized(value);
+      break;
+    case 15:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServicesFailed(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -6978,6 +7945,21 @@ proto.wsman.WorkspaceConditions.serializeBinaryToWriter = function(message, writ
       f
     );
   }
+  f = message.getResized();
+  if (f != null) {
+    writer.writeMessage(
+      14,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getServicesFailed();
+  if (f.length > 0) {
+    writer.writeString(
+      15,
+      f
+    );
+  }
 };
 
 
@@ -7235,6 +8217,61 @@ proto.wsman.WorkspaceConditions.prototype.setAborted = function(value) {
 };
 
 
+/**
+ * optional google.protobuf.Timestamp resized = 14;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsman.WorkspaceConditions.prototype.getResized = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 14));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+*/
+proto.wsman.WorkspaceConditions.prototype.setResized = function(value) {
+  return jspb.Message.setWrapperField(this, 14, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+ */
+proto.wsman.WorkspaceConditions.prototype.clearResized = function() {
+  return this.setResized(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceConditions.prototype.hasResized = function() {
+  return jspb.Message.getField(this, 14) != null;
+};
+
+
+/**
+ * optional string services_failed = 15;
+ * @return {string}
+ */
+proto.wsman.WorkspaceConditions.prototype.getServicesFailed = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 15, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+ */
+proto.wsman.WorkspaceConditions.prototype.setServicesFailed = function(value) {
+  return jspb.Message.setProto3StringField(this, 15, value);
+};
+
+
 
 
 
@@ -7798,7 +8835,8 @@ proto.wsman.WorkspaceAuthentication.prototype.toObject = function(opt_includeIns
 proto.wsman.WorkspaceAuthentication.toObject = function(includeInstance, msg) {
   var f, obj = {
     admission: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    ownerToken: jspb.Message.getFieldWithDefault(msg, 2, "")
+    ownerToken: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    teamToken: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -7843,6 +8881,10 @@ proto.wsman.WorkspaceAuthentication.deserializeBinaryFromReader = function(msg,
       var value = /** @type {string} */ (reader.readString());
       msg.setOwnerToken(value);
       break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTeamToken(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -7886,6 +8928,13 @@ proto.wsman.WorkspaceAuthentication.serializeBinaryToWriter = function(message,
       f
     );
   }
+  f = message.getTeamToken();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -7925,13 +8974,31 @@ proto.wsman.WorkspaceAuthentication.prototype.setOwnerToken = function(value) {
 };
 
 
+/**
+ * optional string team_token = 3;
+ * @return {string}
+ */
+proto.wsman.WorkspaceAuthentication.prototype.getTeamToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceAuthentication} returns this
+ */
+proto.wsman.WorkspaceAuthentication.prototype.setTeamToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.wsman.StartWorkspaceSpec.repeatedFields_ = [3,5,6,15,16,17];
+proto.wsman.StartWorkspaceSpec.repeatedFields_ = [3,5,6,15,16,17,18];
 
 
 
@@ -7982,7 +9049,9 @@ proto.wsman.StartWorkspaceSpec.toObject = function(includeInstance, msg) {
     sshPublicKeysList: (f = jspb.Message.getRepeatedField(msg, 15)) == null ? undefined : f,
     sysEnvvarsList: jspb.Message.toObjectList(msg.getSysEnvvarsList(),
     proto.wsman.EnvironmentVariable.toObject, includeInstance),
-    ideImageLayersList: (f = jspb.Message.getRepeatedField(msg, 17)) == null ? undefined : f
+    ideImageLayersList: (f = jspb.Message.getRepeatedField(msg, 17)) == null ? undefined : f,
+    servicesList: jspb.Message.toObjectList(msg.getServicesList(),
+    proto.wsman.ServiceSpec.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -8092,6 +9161,11 @@ proto.wsman.StartWorkspaceSpec.deserializeBinaryFromReader = function(msg, reade
       var value = /** @type {string} */ (reader.readString());
       msg.addIdeImageLayers(value);
       break;
+    case 18:
+      var value = new proto.wsman.ServiceSpec;
+      reader.readMessage(value,proto.wsman.ServiceSpec.deserializeBinaryFromReader);
+      msg.addServices(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -8240,6 +9314,14 @@ proto.wsman.StartWorkspaceSpec.serializeBinaryToWriter = function(message, write
       f
     );
   }
+  f = message.getServicesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      18,
+      f,
+      proto.wsman.ServiceSpec.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -8724,6 +9806,51 @@ proto.wsman.StartWorkspaceSpec.prototype.clearIdeImageLayersList = function() {
 };
 
 
+/**
+ * repeated ServiceSpec services = 18;
+ * @return {!Array<!proto.wsman.ServiceSpec>}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getServicesList = function() {
+  return /** @type{!Array<!proto.wsman.ServiceSpec>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.ServiceSpec, 18));
+};
+
+
+/**
+ * @param {!Array<!proto.wsman.ServiceSpec>} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+*/
+proto.wsman.StartWorkspaceSpec.prototype.setServicesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 18, value);
+};
+
+
+/**
+ * @param {!proto.wsman.ServiceSpec=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.ServiceSpec}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.addServices = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 18, opt_value, proto.wsman.ServiceSpec, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.clearServicesList = function() {
+  return this.setServicesList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.ServiceSpec.repeatedFields_ = [3,4,5];
 
 
 
@@ -8740,8 +9867,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.GitSpec.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.GitSpec.toObject(opt_includeInstance, this);
+proto.wsman.ServiceSpec.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ServiceSpec.toObject(opt_includeInstance, this);
 };
 
 
@@ -8750,14 +9877,20 @@ proto.wsman.GitSpec.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.GitSpec} msg The msg instance to transform.
+ * @param {!proto.wsman.ServiceSpec} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.GitSpec.toObject = function(includeInstance, msg) {
+proto.wsman.ServiceSpec.toObject = function(includeInstance, msg) {
   var f, obj = {
-    username: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    email: jspb.Message.getFieldWithDefault(msg, 2, "")
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    image: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    commandList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    envList: jspb.Message.toObjectList(msg.getEnvList(),
+    proto.wsman.EnvironmentVariable.toObject, includeInstance),
+    portsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
+    cpuLimit: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    memoryLimit: jspb.Message.getFieldWithDefault(msg, 7, "")
   };
 
   if (includeInstance) {
@@ -8771,23 +9904,23 @@ proto.wsman.GitSpec.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.GitSpec}
+ * @return {!proto.wsman.ServiceSpec}
  */
-proto.wsman.GitSpec.deserializeBinary = function(bytes) {
+proto.wsman.ServiceSpec.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.GitSpec;
-  return proto.wsman.GitSpec.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.ServiceSpec;
+  return proto.wsman.ServiceSpec.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.GitSpec} msg The message object to deserialize into.
+ * @param {!proto.wsman.ServiceSpec} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.GitSpec}
+ * @return {!proto.wsman.ServiceSpec}
  */
-proto.wsman.GitSpec.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.ServiceSpec.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8796,11 +9929,34 @@ proto.wsman.GitSpec.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUsername(value);
+      msg.setName(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setEmail(value);
+      msg.setImage(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCommand(value);
+      break;
+    case 4:
+      var value = new proto.wsman.EnvironmentVariable;
+      reader.readMessage(value,proto.wsman.EnvironmentVariable.deserializeBinaryFromReader);
+      msg.addEnv(value);
+      break;
+    case 5:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedUint32() : [reader.readUint32()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addPorts(values[i]);
+      }
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCpuLimit(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMemoryLimit(value);
       break;
     default:
       reader.skipField();
@@ -8815,9 +9971,9 @@ proto.wsman.GitSpec.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.GitSpec.prototype.serializeBinary = function() {
+proto.wsman.ServiceSpec.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.GitSpec.serializeBinaryToWriter(this, writer);
+  proto.wsman.ServiceSpec.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8825,65 +9981,249 @@ proto.wsman.GitSpec.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.GitSpec} message
+ * @param {!proto.wsman.ServiceSpec} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.GitSpec.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.ServiceSpec.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUsername();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getEmail();
+  f = message.getImage();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
+  f = message.getCommandList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+  f = message.getEnvList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.wsman.EnvironmentVariable.serializeBinaryToWriter
+    );
+  }
+  f = message.getPortsList();
+  if (f.length > 0) {
+    writer.writePackedUint32(
+      5,
+      f
+    );
+  }
+  f = message.getCpuLimit();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getMemoryLimit();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string username = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.wsman.GitSpec.prototype.getUsername = function() {
+proto.wsman.ServiceSpec.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.GitSpec} returns this
+ * @return {!proto.wsman.ServiceSpec} returns this
  */
-proto.wsman.GitSpec.prototype.setUsername = function(value) {
+proto.wsman.ServiceSpec.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string email = 2;
+ * optional string image = 2;
  * @return {string}
  */
-proto.wsman.GitSpec.prototype.getEmail = function() {
+proto.wsman.ServiceSpec.prototype.getImage = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.GitSpec} returns this
+ * @return {!proto.wsman.ServiceSpec} returns this
  */
-proto.wsman.GitSpec.prototype.setEmail = function(value) {
+proto.wsman.ServiceSpec.prototype.setImage = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+/**
+ * repeated string command = 3;
+ * @return {!Array<string>}
+ */
+proto.wsman.ServiceSpec.prototype.getCommandList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.wsman.ServiceSpec} returns this
+ */
+proto.wsman.ServiceSpec.prototype.setCommandList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.ServiceSpec} returns this
+ */
+proto.wsman.ServiceSpec.prototype.addCommand = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.ServiceSpec} returns this
+ */
+proto.wsman.ServiceSpec.prototype.clearCommandList = function() {
+  return this.setCommandList([]);
+};
+
+
+/**
+ * repeated EnvironmentVariable env = 4;
+ * @return {!Array<!proto.wsman.EnvironmentVariable>}
+ */
+proto.wsman.ServiceSpec.prototype.getEnvList = function() {
+  return /** @type{!Array<!proto.wsman.EnvironmentVariable>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.EnvironmentVariable, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.wsman.EnvironmentVariable>} value
+ * @return {!proto.wsman.ServiceSpec} returns this
+*/
+proto.wsman.ServiceSpec.prototype.setEnvList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.wsman.EnvironmentVariable=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.EnvironmentVariable}
+ */
+proto.wsman.ServiceSpec.prototype.addEnv = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.wsman.EnvironmentVariable, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.ServiceSpec} returns this
+ */
+proto.wsman.ServiceSpec.prototype.clearEnvList = function() {
+  return this.setEnvList([]);
+};
+
+
+/**
+ * repeated uint32 ports = 5;
+ * @return {!Array<number>}
+ */
+proto.wsman.ServiceSpec.prototype.getPortsList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.wsman.ServiceSpec} returns this
+ */
+proto.wsman.ServiceSpec.prototype.setPortsList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.ServiceSpec} returns this
+ */
+proto.wsman.ServiceSpec.prototype.addPorts = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.ServiceSpec} returns this
+ */
+proto.wsman.ServiceSpec.prototype.clearPortsList = function() {
+  return this.setPortsList([]);
+};
+
+
+/**
+ * optional string cpu_limit = 6;
+ * @return {string}
+ */
+proto.wsman.ServiceSpec.prototype.getCpuLimit = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.ServiceSpec} returns this
+ */
+proto.wsman.ServiceSpec.prototype.setCpuLimit = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string memory_limit = 7;
+ * @return {string}
+ */
+proto.wsman.ServiceSpec.prototype.getMemoryLimit = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.ServiceSpec} returns this
+ */
+proto.wsman.ServiceSpec.prototype.setMemoryLimit = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
 
 
 
@@ -8900,8 +10240,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.EnvironmentVariable.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.EnvironmentVariable.toObject(opt_includeInstance, this);
+proto.wsman.GitSpec.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.GitSpec.toObject(opt_includeInstance, this);
 };
 
 
@@ -8910,15 +10250,14 @@ proto.wsman.EnvironmentVariable.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.EnvironmentVariable} msg The msg instance to transform.
+ * @param {!proto.wsman.GitSpec} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.EnvironmentVariable.toObject = function(includeInstance, msg) {
+proto.wsman.GitSpec.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    value: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    secret: (f = msg.getSecret()) && proto.wsman.EnvironmentVariable.SecretKeyRef.toObject(includeInstance, f)
+    username: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    email: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -8932,23 +10271,23 @@ proto.wsman.EnvironmentVariable.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.EnvironmentVariable}
+ * @return {!proto.wsman.GitSpec}
  */
-proto.wsman.EnvironmentVariable.deserializeBinary = function(bytes) {
+proto.wsman.GitSpec.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.EnvironmentVariable;
-  return proto.wsman.EnvironmentVariable.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.GitSpec;
+  return proto.wsman.GitSpec.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.EnvironmentVariable} msg The message object to deserialize into.
+ * @param {!proto.wsman.GitSpec} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.EnvironmentVariable}
+ * @return {!proto.wsman.GitSpec}
  */
-proto.wsman.EnvironmentVariable.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.GitSpec.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8957,16 +10296,11 @@ proto.wsman.EnvironmentVariable.deserializeBinaryFromReader = function(msg, read
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setUsername(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setValue(value);
-      break;
-    case 3:
-      var value = new proto.wsman.EnvironmentVariable.SecretKeyRef;
-      reader.readMessage(value,proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinaryFromReader);
-      msg.setSecret(value);
+      msg.setEmail(value);
       break;
     default:
       reader.skipField();
@@ -8981,9 +10315,9 @@ proto.wsman.EnvironmentVariable.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.EnvironmentVariable.prototype.serializeBinary = function() {
+proto.wsman.GitSpec.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.EnvironmentVariable.serializeBinaryToWriter(this, writer);
+  proto.wsman.GitSpec.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8991,34 +10325,62 @@ proto.wsman.EnvironmentVariable.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.EnvironmentVariable} message
+ * @param {!proto.wsman.GitSpec} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.EnvironmentVariable.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.GitSpec.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getUsername();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getValue();
+  f = message.getEmail();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getSecret();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.wsman.EnvironmentVariable.SecretKeyRef.serializeBinaryToWriter
-    );
-  }
+};
+
+
+/**
+ * optional string username = 1;
+ * @return {string}
+ */
+proto.wsman.GitSpec.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.GitSpec} returns this
+ */
+proto.wsman.GitSpec.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string email = 2;
+ * @return {string}
+ */
+proto.wsman.GitSpec.prototype.getEmail = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.GitSpec} returns this
+ */
+proto.wsman.GitSpec.prototype.setEmail = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -9038,8 +10400,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.EnvironmentVariable.SecretKeyRef.toObject(opt_includeInstance, this);
+proto.wsman.EnvironmentVariable.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.EnvironmentVariable.toObject(opt_includeInstance, this);
 };
 
 
@@ -9048,14 +10410,15 @@ proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.toObject = function(opt_i
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.EnvironmentVariable.SecretKeyRef} msg The msg instance to transform.
+ * @param {!proto.wsman.EnvironmentVariable} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.EnvironmentVariable.SecretKeyRef.toObject = function(includeInstance, msg) {
+proto.wsman.EnvironmentVariable.toObject = function(includeInstance, msg) {
   var f, obj = {
-    secretName: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    key: jspb.Message.getFieldWithDefault(msg, 2, "")
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    value: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    secret: (f = msg.getSecret()) && proto.wsman.EnvironmentVariable.SecretKeyRef.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -9069,23 +10432,23 @@ proto.wsman.EnvironmentVariable.SecretKeyRef.toObject = function(includeInstance
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.EnvironmentVariable.SecretKeyRef}
+ * @return {!proto.wsman.EnvironmentVariable}
  */
-proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinary = function(bytes) {
+proto.wsman.EnvironmentVariable.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.EnvironmentVariable.SecretKeyRef;
-  return proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.EnvironmentVariable;
+  return proto.wsman.EnvironmentVariable.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.EnvironmentVariable.SecretKeyRef} msg The message object to deserialize into.
+ * @param {!proto.wsman.EnvironmentVariable} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.EnvironmentVariable.SecretKeyRef}
+ * @return {!proto.wsman.EnvironmentVariable}
  */
-proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.EnvironmentVariable.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9094,11 +10457,16 @@ proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinaryFromReader = funct
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSecretName(value);
+      msg.setName(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setKey(value);
+      msg.setValue(value);
+      break;
+    case 3:
+      var value = new proto.wsman.EnvironmentVariable.SecretKeyRef;
+      reader.readMessage(value,proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinaryFromReader);
+      msg.setSecret(value);
       break;
     default:
       reader.skipField();
@@ -9113,9 +10481,9 @@ proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinaryFromReader = funct
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.serializeBinary = function() {
+proto.wsman.EnvironmentVariable.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.EnvironmentVariable.SecretKeyRef.serializeBinaryToWriter(this, writer);
+  proto.wsman.EnvironmentVariable.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9123,31 +10491,163 @@ proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.serializeBinary = functio
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.EnvironmentVariable.SecretKeyRef} message
+ * @param {!proto.wsman.EnvironmentVariable} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.EnvironmentVariable.SecretKeyRef.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.EnvironmentVariable.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSecretName();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getKey();
+  f = message.getValue();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-};
-
-
-/**
- * optional string secret_name = 1;
+  f = message.getSecret();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.wsman.EnvironmentVariable.SecretKeyRef.serializeBinaryToWriter
+    );
+  }
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.EnvironmentVariable.SecretKeyRef.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.EnvironmentVariable.SecretKeyRef} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    secretName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    key: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.EnvironmentVariable.SecretKeyRef}
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.EnvironmentVariable.SecretKeyRef;
+  return proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.EnvironmentVariable.SecretKeyRef} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.EnvironmentVariable.SecretKeyRef}
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSecretName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setKey(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.EnvironmentVariable.SecretKeyRef.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.EnvironmentVariable.SecretKeyRef} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSecretName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getKey();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string secret_name = 1;
  * @return {string}
  */
 proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.getSecretName = function() {
@@ -9165,84 +10665,1377 @@ proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.setSecretName = function(
 
 
 /**
- * optional string key = 2;
- * @return {string}
+ * optional string key = 2;
+ * @return {string}
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.getKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.EnvironmentVariable.SecretKeyRef} returns this
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.setKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.wsman.EnvironmentVariable.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.EnvironmentVariable} returns this
+ */
+proto.wsman.EnvironmentVariable.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string value = 2;
+ * @return {string}
+ */
+proto.wsman.EnvironmentVariable.prototype.getValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.EnvironmentVariable} returns this
+ */
+proto.wsman.EnvironmentVariable.prototype.setValue = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional SecretKeyRef secret = 3;
+ * @return {?proto.wsman.EnvironmentVariable.SecretKeyRef}
+ */
+proto.wsman.EnvironmentVariable.prototype.getSecret = function() {
+  return /** @type{?proto.wsman.EnvironmentVariable.SecretKeyRef} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.EnvironmentVariable.SecretKeyRef, 3));
+};
+
+
+/**
+ * @param {?proto.wsman.EnvironmentVariable.SecretKeyRef|undefined} value
+ * @return {!proto.wsman.EnvironmentVariable} returns this
+*/
+proto.wsman.EnvironmentVariable.prototype.setSecret = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.EnvironmentVariable} returns this
+ */
+proto.wsman.EnvironmentVariable.prototype.clearSecret = function() {
+  return this.setSecret(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.EnvironmentVariable.prototype.hasSecret = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.ExposedPorts.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.ExposedPorts.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ExposedPorts.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.ExposedPorts} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ExposedPorts.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    portsList: jspb.Message.toObjectList(msg.getPortsList(),
+    proto.wsman.PortSpec.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.ExposedPorts}
+ */
+proto.wsman.ExposedPorts.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.ExposedPorts;
+  return proto.wsman.ExposedPorts.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.ExposedPorts} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.ExposedPorts}
+ */
+proto.wsman.ExposedPorts.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.wsman.PortSpec;
+      reader.readMessage(value,proto.wsman.PortSpec.deserializeBinaryFromReader);
+      msg.addPorts(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.ExposedPorts.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.ExposedPorts.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.ExposedPorts} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ExposedPorts.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPortsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.wsman.PortSpec.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated PortSpec ports = 1;
+ * @return {!Array<!proto.wsman.PortSpec>}
+ */
+proto.wsman.ExposedPorts.prototype.getPortsList = function() {
+  return /** @type{!Array<!proto.wsman.PortSpec>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.PortSpec, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.wsman.PortSpec>} value
+ * @return {!proto.wsman.ExposedPorts} returns this
+*/
+proto.wsman.ExposedPorts.prototype.setPortsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.wsman.PortSpec=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.PortSpec}
+ */
+proto.wsman.ExposedPorts.prototype.addPorts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.wsman.PortSpec, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.ExposedPorts} returns this
+ */
+proto.wsman.ExposedPorts.prototype.clearPortsList = function() {
+  return this.setPortsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.SSHPublicKeys.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.SSHPublicKeys.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.SSHPublicKeys.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.SSHPublicKeys} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.SSHPublicKeys.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    keysList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.SSHPublicKeys}
+ */
+proto.wsman.SSHPublicKeys.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.SSHPublicKeys;
+  return proto.wsman.SSHPublicKeys.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.SSHPublicKeys} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.SSHPublicKeys}
+ */
+proto.wsman.SSHPublicKeys.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addKeys(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.SSHPublicKeys.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.SSHPublicKeys.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.SSHPublicKeys} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.SSHPublicKeys.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getKeysList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string keys = 1;
+ * @return {!Array<string>}
+ */
+proto.wsman.SSHPublicKeys.prototype.getKeysList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.wsman.SSHPublicKeys} returns this
+ */
+proto.wsman.SSHPublicKeys.prototype.setKeysList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.SSHPublicKeys} returns this
+ */
+proto.wsman.SSHPublicKeys.prototype.addKeys = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.SSHPublicKeys} returns this
+ */
+proto.wsman.SSHPublicKeys.prototype.clearKeysList = function() {
+  return this.setKeysList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.DescribeClusterRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.DescribeClusterRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.DescribeClusterRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.DescribeClusterRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.DescribeClusterRequest}
+ */
+proto.wsman.DescribeClusterRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.DescribeClusterRequest;
+  return proto.wsman.DescribeClusterRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.DescribeClusterRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.DescribeClusterRequest}
+ */
+proto.wsman.DescribeClusterRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.DescribeClusterRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.DescribeClusterRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.DescribeClusterRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.DescribeClusterRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.DescribeClusterResponse.repeatedFields_ = [1,2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.DescribeClusterResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.DescribeClusterResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.DescribeClusterResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.DescribeClusterResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    workspaceclassesList: jspb.Message.toObjectList(msg.getWorkspaceclassesList(),
+    proto.wsman.WorkspaceClass.toObject, includeInstance),
+    admissionqueueList: jspb.Message.toObjectList(msg.getAdmissionqueueList(),
+    proto.wsman.QueuedWorkspace.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.DescribeClusterResponse}
+ */
+proto.wsman.DescribeClusterResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.DescribeClusterResponse;
+  return proto.wsman.DescribeClusterResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.DescribeClusterResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.DescribeClusterResponse}
+ */
+proto.wsman.DescribeClusterResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.wsman.WorkspaceClass;
+      reader.readMessage(value,proto.wsman.WorkspaceClass.deserializeBinaryFromReader);
+      msg.addWorkspaceclasses(value);
+      break;
+    case 2:
+      var value = new proto.wsman.QueuedWorkspace;
+      reader.readMessage(value,proto.wsman.QueuedWorkspace.deserializeBinaryFromReader);
+      msg.addAdmissionqueue(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.DescribeClusterResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.DescribeClusterResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.DescribeClusterResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.DescribeClusterResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getWorkspaceclassesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.wsman.WorkspaceClass.serializeBinaryToWriter
+    );
+  }
+  f = message.getAdmissionqueueList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.wsman.QueuedWorkspace.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated WorkspaceClass WorkspaceClasses = 1;
+ * @return {!Array<!proto.wsman.WorkspaceClass>}
+ */
+proto.wsman.DescribeClusterResponse.prototype.getWorkspaceclassesList = function() {
+  return /** @type{!Array<!proto.wsman.WorkspaceClass>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.WorkspaceClass, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.wsman.WorkspaceClass>} value
+ * @return {!proto.wsman.DescribeClusterResponse} returns this
+*/
+proto.wsman.DescribeClusterResponse.prototype.setWorkspaceclassesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.wsman.WorkspaceClass=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.WorkspaceClass}
+ */
+proto.wsman.DescribeClusterResponse.prototype.addWorkspaceclasses = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.wsman.WorkspaceClass, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.DescribeClusterResponse} returns this
+ */
+proto.wsman.DescribeClusterResponse.prototype.clearWorkspaceclassesList = function() {
+  return this.setWorkspaceclassesList([]);
+};
+
+
+/**
+ * repeated QueuedWorkspace AdmissionQueue = 2;
+ * @return {!Array<!proto.wsman.QueuedWorkspace>}
+ */
+proto.wsman.DescribeClusterResponse.prototype.getAdmissionqueueList = function() {
+  return /** @type{!Array<!proto.wsman.QueuedWorkspace>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.QueuedWorkspace, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.wsman.QueuedWorkspace>} value
+ * @return {!proto.wsman.DescribeClusterResponse} returns this
+*/
+proto.wsman.DescribeClusterResponse.prototype.setAdmissionqueueList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.wsman.QueuedWorkspace=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.QueuedWorkspace}
+ */
+proto.wsman.DescribeClusterResponse.prototype.addAdmissionqueue = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.wsman.QueuedWorkspace, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.DescribeClusterResponse} returns this
+ */
+proto.wsman.DescribeClusterResponse.prototype.clearAdmissionqueueList = function() {
+  return this.setAdmissionqueueList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.QueuedWorkspace.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.QueuedWorkspace.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.QueuedWorkspace} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.QueuedWorkspace.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    metadata: (f = msg.getMetadata()) && proto.wsman.WorkspaceMetadata.toObject(includeInstance, f),
+    type: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    position: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    queuedSince: (f = msg.getQueuedSince()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.QueuedWorkspace}
+ */
+proto.wsman.QueuedWorkspace.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.QueuedWorkspace;
+  return proto.wsman.QueuedWorkspace.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.QueuedWorkspace} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.QueuedWorkspace}
+ */
+proto.wsman.QueuedWorkspace.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = new proto.wsman.WorkspaceMetadata;
+      reader.readMessage(value,proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader);
+      msg.setMetadata(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.wsman.WorkspaceType} */ (reader.readEnum());
+      msg.setType(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPosition(value);
+      break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setQueuedSince(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.QueuedWorkspace.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.QueuedWorkspace.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.QueuedWorkspace} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.QueuedWorkspace.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getMetadata();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.wsman.WorkspaceMetadata.serializeBinaryToWriter
+    );
+  }
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = message.getPosition();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getQueuedSince();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.QueuedWorkspace.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.QueuedWorkspace} returns this
+ */
+proto.wsman.QueuedWorkspace.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional WorkspaceMetadata metadata = 2;
+ * @return {?proto.wsman.WorkspaceMetadata}
+ */
+proto.wsman.QueuedWorkspace.prototype.getMetadata = function() {
+  return /** @type{?proto.wsman.WorkspaceMetadata} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceMetadata, 2));
+};
+
+
+/**
+ * @param {?proto.wsman.WorkspaceMetadata|undefined} value
+ * @return {!proto.wsman.QueuedWorkspace} returns this
+*/
+proto.wsman.QueuedWorkspace.prototype.setMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.QueuedWorkspace} returns this
+ */
+proto.wsman.QueuedWorkspace.prototype.clearMetadata = function() {
+  return this.setMetadata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.QueuedWorkspace.prototype.hasMetadata = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional WorkspaceType type = 3;
+ * @return {!proto.wsman.WorkspaceType}
+ */
+proto.wsman.QueuedWorkspace.prototype.getType = function() {
+  return /** @type {!proto.wsman.WorkspaceType} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.WorkspaceType} value
+ * @return {!proto.wsman.QueuedWorkspace} returns this
+ */
+proto.wsman.QueuedWorkspace.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 position = 4;
+ * @return {number}
+ */
+proto.wsman.QueuedWorkspace.prototype.getPosition = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsman.QueuedWorkspace} returns this
+ */
+proto.wsman.QueuedWorkspace.prototype.setPosition = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp queued_since = 5;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsman.QueuedWorkspace.prototype.getQueuedSince = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.QueuedWorkspace} returns this
+*/
+proto.wsman.QueuedWorkspace.prototype.setQueuedSince = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.QueuedWorkspace} returns this
+ */
+proto.wsman.QueuedWorkspace.prototype.clearQueuedSince = function() {
+  return this.setQueuedSince(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.QueuedWorkspace.prototype.hasQueuedSince = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.WorkspaceClass.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceClass.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.WorkspaceClass} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceClass.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    displayname: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.WorkspaceClass}
+ */
+proto.wsman.WorkspaceClass.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.WorkspaceClass;
+  return proto.wsman.WorkspaceClass.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.WorkspaceClass} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.WorkspaceClass}
+ */
+proto.wsman.WorkspaceClass.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDisplayname(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.WorkspaceClass.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.WorkspaceClass.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.WorkspaceClass} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceClass.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDisplayname();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string Id = 1;
+ * @return {string}
+ */
+proto.wsman.WorkspaceClass.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceClass} returns this
+ */
+proto.wsman.WorkspaceClass.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string DisplayName = 2;
+ * @return {string}
+ */
+proto.wsman.WorkspaceClass.prototype.getDisplayname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceClass} returns this
+ */
+proto.wsman.WorkspaceClass.prototype.setDisplayname = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.SetScheduleRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.SetScheduleRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.SetScheduleRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.SetScheduleRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    owner: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    metaId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    schedule: (f = msg.getSchedule()) && proto.wsman.WorkspaceSchedule.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.SetScheduleRequest}
+ */
+proto.wsman.SetScheduleRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.SetScheduleRequest;
+  return proto.wsman.SetScheduleRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.SetScheduleRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.SetScheduleRequest}
+ */
+proto.wsman.SetScheduleRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwner(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMetaId(value);
+      break;
+    case 3:
+      var value = new proto.wsman.WorkspaceSchedule;
+      reader.readMessage(value,proto.wsman.WorkspaceSchedule.deserializeBinaryFromReader);
+      msg.setSchedule(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.getKey = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.wsman.SetScheduleRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.SetScheduleRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.EnvironmentVariable.SecretKeyRef} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.SetScheduleRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.EnvironmentVariable.SecretKeyRef.prototype.setKey = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.wsman.SetScheduleRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getOwner();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getMetaId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getSchedule();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.wsman.WorkspaceSchedule.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string name = 1;
+ * optional string owner = 1;
  * @return {string}
  */
-proto.wsman.EnvironmentVariable.prototype.getName = function() {
+proto.wsman.SetScheduleRequest.prototype.getOwner = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.EnvironmentVariable} returns this
+ * @return {!proto.wsman.SetScheduleRequest} returns this
  */
-proto.wsman.EnvironmentVariable.prototype.setName = function(value) {
+proto.wsman.SetScheduleRequest.prototype.setOwner = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string value = 2;
+ * optional string meta_id = 2;
  * @return {string}
  */
-proto.wsman.EnvironmentVariable.prototype.getValue = function() {
+proto.wsman.SetScheduleRequest.prototype.getMetaId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.EnvironmentVariable} returns this
+ * @return {!proto.wsman.SetScheduleRequest} returns this
  */
-proto.wsman.EnvironmentVariable.prototype.setValue = function(value) {
+proto.wsman.SetScheduleRequest.prototype.setMetaId = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional SecretKeyRef secret = 3;
- * @return {?proto.wsman.EnvironmentVariable.SecretKeyRef}
+ * optional WorkspaceSchedule schedule = 3;
+ * @return {?proto.wsman.WorkspaceSchedule}
  */
-proto.wsman.EnvironmentVariable.prototype.getSecret = function() {
-  return /** @type{?proto.wsman.EnvironmentVariable.SecretKeyRef} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.EnvironmentVariable.SecretKeyRef, 3));
+proto.wsman.SetScheduleRequest.prototype.getSchedule = function() {
+  return /** @type{?proto.wsman.WorkspaceSchedule} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceSchedule, 3));
 };
 
 
 /**
- * @param {?proto.wsman.EnvironmentVariable.SecretKeyRef|undefined} value
- * @return {!proto.wsman.EnvironmentVariable} returns this
+ * @param {?proto.wsman.WorkspaceSchedule|undefined} value
+ * @return {!proto.wsman.SetScheduleRequest} returns this
 */
-proto.wsman.EnvironmentVariable.prototype.setSecret = function(value) {
+proto.wsman.SetScheduleRequest.prototype.setSchedule = function(value) {
   return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.wsman.EnvironmentVariable} returns this
+ * @return {!proto.wsman.SetScheduleRequest} returns this
  */
-proto.wsman.EnvironmentVariable.prototype.clearSecret = function() {
-  return this.setSecret(undefined);
+proto.wsman.SetScheduleRequest.prototype.clearSchedule = function() {
+  return this.setSchedule(undefined);
 };
 
 
@@ -9250,19 +12043,12 @@ proto.wsman.EnvironmentVariable.prototype.clearSecret = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.wsman.EnvironmentVariable.prototype.hasSecret = function() {
+proto.wsman.SetScheduleRequest.prototype.hasSchedule = function() {
   return jspb.Message.getField(this, 3) != null;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.wsman.ExposedPorts.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -9278,8 +12064,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.ExposedPorts.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.ExposedPorts.toObject(opt_includeInstance, this);
+proto.wsman.SetScheduleResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.SetScheduleResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -9288,14 +12074,13 @@ proto.wsman.ExposedPorts.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.ExposedPorts} msg The msg instance to transform.
+ * @param {!proto.wsman.SetScheduleResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.ExposedPorts.toObject = function(includeInstance, msg) {
+proto.wsman.SetScheduleResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    portsList: jspb.Message.toObjectList(msg.getPortsList(),
-    proto.wsman.PortSpec.toObject, includeInstance)
+
   };
 
   if (includeInstance) {
@@ -9309,34 +12094,29 @@ proto.wsman.ExposedPorts.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.ExposedPorts}
+ * @return {!proto.wsman.SetScheduleResponse}
  */
-proto.wsman.ExposedPorts.deserializeBinary = function(bytes) {
+proto.wsman.SetScheduleResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.ExposedPorts;
-  return proto.wsman.ExposedPorts.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.SetScheduleResponse;
+  return proto.wsman.SetScheduleResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.ExposedPorts} msg The message object to deserialize into.
+ * @param {!proto.wsman.SetScheduleResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.ExposedPorts}
+ * @return {!proto.wsman.SetScheduleResponse}
  */
-proto.wsman.ExposedPorts.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.SetScheduleResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = new proto.wsman.PortSpec;
-      reader.readMessage(value,proto.wsman.PortSpec.deserializeBinaryFromReader);
-      msg.addPorts(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -9350,9 +12130,9 @@ proto.wsman.ExposedPorts.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.ExposedPorts.prototype.serializeBinary = function() {
+proto.wsman.SetScheduleResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.ExposedPorts.serializeBinaryToWriter(this, writer);
+  proto.wsman.SetScheduleResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9360,58 +12140,12 @@ proto.wsman.ExposedPorts.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.ExposedPorts} message
+ * @param {!proto.wsman.SetScheduleResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.ExposedPorts.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.SetScheduleResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPortsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      proto.wsman.PortSpec.serializeBinaryToWriter
-    );
-  }
-};
-
-
-/**
- * repeated PortSpec ports = 1;
- * @return {!Array<!proto.wsman.PortSpec>}
- */
-proto.wsman.ExposedPorts.prototype.getPortsList = function() {
-  return /** @type{!Array<!proto.wsman.PortSpec>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.wsman.PortSpec, 1));
-};
-
-
-/**
- * @param {!Array<!proto.wsman.PortSpec>} value
- * @return {!proto.wsman.ExposedPorts} returns this
-*/
-proto.wsman.ExposedPorts.prototype.setPortsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.wsman.PortSpec=} opt_value
- * @param {number=} opt_index
- * @return {!proto.wsman.PortSpec}
- */
-proto.wsman.ExposedPorts.prototype.addPorts = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.wsman.PortSpec, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.wsman.ExposedPorts} returns this
- */
-proto.wsman.ExposedPorts.prototype.clearPortsList = function() {
-  return this.setPortsList([]);
 };
 
 
@@ -9421,7 +12155,7 @@ proto.wsman.ExposedPorts.prototype.clearPortsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.wsman.SSHPublicKeys.repeatedFields_ = [1];
+proto.wsman.WorkspaceSchedule.repeatedFields_ = [2,3,4];
 
 
 
@@ -9438,8 +12172,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.SSHPublicKeys.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.SSHPublicKeys.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceSchedule.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceSchedule.toObject(opt_includeInstance, this);
 };
 
 
@@ -9448,13 +12182,18 @@ proto.wsman.SSHPublicKeys.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.SSHPublicKeys} msg The msg instance to transform.
+ * @param {!proto.wsman.WorkspaceSchedule} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.SSHPublicKeys.toObject = function(includeInstance, msg) {
+proto.wsman.WorkspaceSchedule.toObject = function(includeInstance, msg) {
   var f, obj = {
-    keysList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+    timezone: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    preStartList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    stopWindowsList: jspb.Message.toObjectList(msg.getStopWindowsList(),
+    proto.wsman.ScheduleWindow.toObject, includeInstance),
+    workingHoursList: jspb.Message.toObjectList(msg.getWorkingHoursList(),
+    proto.wsman.ScheduleWindow.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -9468,23 +12207,23 @@ proto.wsman.SSHPublicKeys.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.SSHPublicKeys}
+ * @return {!proto.wsman.WorkspaceSchedule}
  */
-proto.wsman.SSHPublicKeys.deserializeBinary = function(bytes) {
+proto.wsman.WorkspaceSchedule.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.SSHPublicKeys;
-  return proto.wsman.SSHPublicKeys.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.WorkspaceSchedule;
+  return proto.wsman.WorkspaceSchedule.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.SSHPublicKeys} msg The message object to deserialize into.
+ * @param {!proto.wsman.WorkspaceSchedule} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.SSHPublicKeys}
+ * @return {!proto.wsman.WorkspaceSchedule}
  */
-proto.wsman.SSHPublicKeys.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.WorkspaceSchedule.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9493,7 +12232,21 @@ proto.wsman.SSHPublicKeys.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.addKeys(value);
+      msg.setTimezone(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addPreStart(value);
+      break;
+    case 3:
+      var value = new proto.wsman.ScheduleWindow;
+      reader.readMessage(value,proto.wsman.ScheduleWindow.deserializeBinaryFromReader);
+      msg.addStopWindows(value);
+      break;
+    case 4:
+      var value = new proto.wsman.ScheduleWindow;
+      reader.readMessage(value,proto.wsman.ScheduleWindow.deserializeBinaryFromReader);
+      msg.addWorkingHours(value);
       break;
     default:
       reader.skipField();
@@ -9508,9 +12261,9 @@ proto.wsman.SSHPublicKeys.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.SSHPublicKeys.prototype.serializeBinary = function() {
+proto.wsman.WorkspaceSchedule.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.SSHPublicKeys.serializeBinaryToWriter(this, writer);
+  proto.wsman.WorkspaceSchedule.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9518,168 +12271,177 @@ proto.wsman.SSHPublicKeys.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.SSHPublicKeys} message
+ * @param {!proto.wsman.WorkspaceSchedule} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.SSHPublicKeys.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.WorkspaceSchedule.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getKeysList();
+  f = message.getTimezone();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       1,
       f
     );
   }
+  f = message.getPreStartList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getStopWindowsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.wsman.ScheduleWindow.serializeBinaryToWriter
+    );
+  }
+  f = message.getWorkingHoursList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.wsman.ScheduleWindow.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * repeated string keys = 1;
+ * optional string timezone = 1;
+ * @return {string}
+ */
+proto.wsman.WorkspaceSchedule.prototype.getTimezone = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceSchedule} returns this
+ */
+proto.wsman.WorkspaceSchedule.prototype.setTimezone = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated string pre_start = 2;
  * @return {!Array<string>}
  */
-proto.wsman.SSHPublicKeys.prototype.getKeysList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.wsman.WorkspaceSchedule.prototype.getPreStartList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
  * @param {!Array<string>} value
- * @return {!proto.wsman.SSHPublicKeys} returns this
+ * @return {!proto.wsman.WorkspaceSchedule} returns this
  */
-proto.wsman.SSHPublicKeys.prototype.setKeysList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.wsman.WorkspaceSchedule.prototype.setPreStartList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
  * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.wsman.SSHPublicKeys} returns this
+ * @return {!proto.wsman.WorkspaceSchedule} returns this
  */
-proto.wsman.SSHPublicKeys.prototype.addKeys = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.wsman.WorkspaceSchedule.prototype.addPreStart = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.wsman.SSHPublicKeys} returns this
+ * @return {!proto.wsman.WorkspaceSchedule} returns this
  */
-proto.wsman.SSHPublicKeys.prototype.clearKeysList = function() {
-  return this.setKeysList([]);
+proto.wsman.WorkspaceSchedule.prototype.clearPreStartList = function() {
+  return this.setPreStartList([]);
 };
 
 
+/**
+ * repeated ScheduleWindow stop_windows = 3;
+ * @return {!Array<!proto.wsman.ScheduleWindow>}
+ */
+proto.wsman.WorkspaceSchedule.prototype.getStopWindowsList = function() {
+  return /** @type{!Array<!proto.wsman.ScheduleWindow>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.ScheduleWindow, 3));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.wsman.DescribeClusterRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.DescribeClusterRequest.toObject(opt_includeInstance, this);
+ * @param {!Array<!proto.wsman.ScheduleWindow>} value
+ * @return {!proto.wsman.WorkspaceSchedule} returns this
+*/
+proto.wsman.WorkspaceSchedule.prototype.setStopWindowsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.wsman.DescribeClusterRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {!proto.wsman.ScheduleWindow=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.ScheduleWindow}
  */
-proto.wsman.DescribeClusterRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
+proto.wsman.WorkspaceSchedule.prototype.addStopWindows = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.wsman.ScheduleWindow, opt_index);
+};
 
-  };
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.WorkspaceSchedule} returns this
+ */
+proto.wsman.WorkspaceSchedule.prototype.clearStopWindowsList = function() {
+  return this.setStopWindowsList([]);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.DescribeClusterRequest}
+ * repeated ScheduleWindow working_hours = 4;
+ * @return {!Array<!proto.wsman.ScheduleWindow>}
  */
-proto.wsman.DescribeClusterRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.DescribeClusterRequest;
-  return proto.wsman.DescribeClusterRequest.deserializeBinaryFromReader(msg, reader);
+proto.wsman.WorkspaceSchedule.prototype.getWorkingHoursList = function() {
+  return /** @type{!Array<!proto.wsman.ScheduleWindow>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.ScheduleWindow, 4));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.wsman.DescribeClusterRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.DescribeClusterRequest}
- */
-proto.wsman.DescribeClusterRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+ * @param {!Array<!proto.wsman.ScheduleWindow>} value
+ * @return {!proto.wsman.WorkspaceSchedule} returns this
+*/
+proto.wsman.WorkspaceSchedule.prototype.setWorkingHoursList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {!proto.wsman.ScheduleWindow=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.ScheduleWindow}
  */
-proto.wsman.DescribeClusterRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.wsman.DescribeClusterRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.wsman.WorkspaceSchedule.prototype.addWorkingHours = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.wsman.ScheduleWindow, opt_index);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.DescribeClusterRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.WorkspaceSchedule} returns this
  */
-proto.wsman.DescribeClusterRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+proto.wsman.WorkspaceSchedule.prototype.clearWorkingHoursList = function() {
+  return this.setWorkingHoursList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.wsman.DescribeClusterResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -9695,8 +12457,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.DescribeClusterResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.DescribeClusterResponse.toObject(opt_includeInstance, this);
+proto.wsman.ScheduleWindow.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ScheduleWindow.toObject(opt_includeInstance, this);
 };
 
 
@@ -9705,14 +12467,14 @@ proto.wsman.DescribeClusterResponse.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.DescribeClusterResponse} msg The msg instance to transform.
+ * @param {!proto.wsman.ScheduleWindow} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.DescribeClusterResponse.toObject = function(includeInstance, msg) {
+proto.wsman.ScheduleWindow.toObject = function(includeInstance, msg) {
   var f, obj = {
-    workspaceclassesList: jspb.Message.toObjectList(msg.getWorkspaceclassesList(),
-    proto.wsman.WorkspaceClass.toObject, includeInstance)
+    start: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    duration: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -9726,23 +12488,23 @@ proto.wsman.DescribeClusterResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.DescribeClusterResponse}
+ * @return {!proto.wsman.ScheduleWindow}
  */
-proto.wsman.DescribeClusterResponse.deserializeBinary = function(bytes) {
+proto.wsman.ScheduleWindow.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.DescribeClusterResponse;
-  return proto.wsman.DescribeClusterResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.ScheduleWindow;
+  return proto.wsman.ScheduleWindow.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.DescribeClusterResponse} msg The message object to deserialize into.
+ * @param {!proto.wsman.ScheduleWindow} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.DescribeClusterResponse}
+ * @return {!proto.wsman.ScheduleWindow}
  */
-proto.wsman.DescribeClusterResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.ScheduleWindow.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9750,9 +12512,12 @@ proto.wsman.DescribeClusterResponse.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.wsman.WorkspaceClass;
-      reader.readMessage(value,proto.wsman.WorkspaceClass.deserializeBinaryFromReader);
-      msg.addWorkspaceclasses(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStart(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDuration(value);
       break;
     default:
       reader.skipField();
@@ -9767,9 +12532,9 @@ proto.wsman.DescribeClusterResponse.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.DescribeClusterResponse.prototype.serializeBinary = function() {
+proto.wsman.ScheduleWindow.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.DescribeClusterResponse.serializeBinaryToWriter(this, writer);
+  proto.wsman.ScheduleWindow.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9777,58 +12542,62 @@ proto.wsman.DescribeClusterResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.DescribeClusterResponse} message
+ * @param {!proto.wsman.ScheduleWindow} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.DescribeClusterResponse.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.ScheduleWindow.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getWorkspaceclassesList();
+  f = message.getStart();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.wsman.WorkspaceClass.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getDuration();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
     );
   }
 };
 
 
 /**
- * repeated WorkspaceClass WorkspaceClasses = 1;
- * @return {!Array<!proto.wsman.WorkspaceClass>}
+ * optional string start = 1;
+ * @return {string}
  */
-proto.wsman.DescribeClusterResponse.prototype.getWorkspaceclassesList = function() {
-  return /** @type{!Array<!proto.wsman.WorkspaceClass>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.wsman.WorkspaceClass, 1));
+proto.wsman.ScheduleWindow.prototype.getStart = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.wsman.WorkspaceClass>} value
- * @return {!proto.wsman.DescribeClusterResponse} returns this
-*/
-proto.wsman.DescribeClusterResponse.prototype.setWorkspaceclassesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.wsman.ScheduleWindow} returns this
+ */
+proto.wsman.ScheduleWindow.prototype.setStart = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceClass=} opt_value
- * @param {number=} opt_index
- * @return {!proto.wsman.WorkspaceClass}
+ * optional string duration = 2;
+ * @return {string}
  */
-proto.wsman.DescribeClusterResponse.prototype.addWorkspaceclasses = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.wsman.WorkspaceClass, opt_index);
+proto.wsman.ScheduleWindow.prototype.getDuration = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.wsman.DescribeClusterResponse} returns this
+ * @param {string} value
+ * @return {!proto.wsman.ScheduleWindow} returns this
  */
-proto.wsman.DescribeClusterResponse.prototype.clearWorkspaceclassesList = function() {
-  return this.setWorkspaceclassesList([]);
+proto.wsman.ScheduleWindow.prototype.setDuration = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -9848,8 +12617,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.WorkspaceClass.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.WorkspaceClass.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceScheduleStatus.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceScheduleStatus.toObject(opt_includeInstance, this);
 };
 
 
@@ -9858,14 +12627,16 @@ proto.wsman.WorkspaceClass.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.WorkspaceClass} msg The msg instance to transform.
+ * @param {!proto.wsman.WorkspaceScheduleStatus} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceClass.toObject = function(includeInstance, msg) {
+proto.wsman.WorkspaceScheduleStatus.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    displayname: jspb.Message.getFieldWithDefault(msg, 2, "")
+    schedule: (f = msg.getSchedule()) && proto.wsman.WorkspaceSchedule.toObject(includeInstance, f),
+    workingHours: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    nextStop: (f = msg.getNextStop()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    nextPreStart: (f = msg.getNextPreStart()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -9879,23 +12650,23 @@ proto.wsman.WorkspaceClass.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.WorkspaceClass}
+ * @return {!proto.wsman.WorkspaceScheduleStatus}
  */
-proto.wsman.WorkspaceClass.deserializeBinary = function(bytes) {
+proto.wsman.WorkspaceScheduleStatus.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.WorkspaceClass;
-  return proto.wsman.WorkspaceClass.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.WorkspaceScheduleStatus;
+  return proto.wsman.WorkspaceScheduleStatus.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.WorkspaceClass} msg The message object to deserialize into.
+ * @param {!proto.wsman.WorkspaceScheduleStatus} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.WorkspaceClass}
+ * @return {!proto.wsman.WorkspaceScheduleStatus}
  */
-proto.wsman.WorkspaceClass.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.WorkspaceScheduleStatus.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9903,12 +12674,23 @@ proto.wsman.WorkspaceClass.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = new proto.wsman.WorkspaceSchedule;
+      reader.readMessage(value,proto.wsman.WorkspaceSchedule.deserializeBinaryFromReader);
+      msg.setSchedule(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDisplayname(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setWorkingHours(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setNextStop(value);
+      break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setNextPreStart(value);
       break;
     default:
       reader.skipField();
@@ -9923,9 +12705,9 @@ proto.wsman.WorkspaceClass.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceClass.prototype.serializeBinary = function() {
+proto.wsman.WorkspaceScheduleStatus.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.WorkspaceClass.serializeBinaryToWriter(this, writer);
+  proto.wsman.WorkspaceScheduleStatus.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9933,62 +12715,172 @@ proto.wsman.WorkspaceClass.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.WorkspaceClass} message
+ * @param {!proto.wsman.WorkspaceScheduleStatus} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceClass.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.WorkspaceScheduleStatus.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getSchedule();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.wsman.WorkspaceSchedule.serializeBinaryToWriter
     );
   }
-  f = message.getDisplayname();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getWorkingHours();
+  if (f) {
+    writer.writeBool(
       2,
       f
     );
   }
+  f = message.getNextStop();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getNextPreStart();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string Id = 1;
- * @return {string}
+ * optional WorkspaceSchedule schedule = 1;
+ * @return {?proto.wsman.WorkspaceSchedule}
  */
-proto.wsman.WorkspaceClass.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.wsman.WorkspaceScheduleStatus.prototype.getSchedule = function() {
+  return /** @type{?proto.wsman.WorkspaceSchedule} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceSchedule, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceClass} returns this
+ * @param {?proto.wsman.WorkspaceSchedule|undefined} value
+ * @return {!proto.wsman.WorkspaceScheduleStatus} returns this
+*/
+proto.wsman.WorkspaceScheduleStatus.prototype.setSchedule = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceScheduleStatus} returns this
  */
-proto.wsman.WorkspaceClass.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.wsman.WorkspaceScheduleStatus.prototype.clearSchedule = function() {
+  return this.setSchedule(undefined);
 };
 
 
 /**
- * optional string DisplayName = 2;
- * @return {string}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.WorkspaceClass.prototype.getDisplayname = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.wsman.WorkspaceScheduleStatus.prototype.hasSchedule = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceClass} returns this
+ * optional bool working_hours = 2;
+ * @return {boolean}
  */
-proto.wsman.WorkspaceClass.prototype.setDisplayname = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.wsman.WorkspaceScheduleStatus.prototype.getWorkingHours = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsman.WorkspaceScheduleStatus} returns this
+ */
+proto.wsman.WorkspaceScheduleStatus.prototype.setWorkingHours = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp next_stop = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsman.WorkspaceScheduleStatus.prototype.getNextStop = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.WorkspaceScheduleStatus} returns this
+*/
+proto.wsman.WorkspaceScheduleStatus.prototype.setNextStop = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceScheduleStatus} returns this
+ */
+proto.wsman.WorkspaceScheduleStatus.prototype.clearNextStop = function() {
+  return this.setNextStop(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceScheduleStatus.prototype.hasNextStop = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp next_pre_start = 4;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsman.WorkspaceScheduleStatus.prototype.getNextPreStart = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.WorkspaceScheduleStatus} returns this
+*/
+proto.wsman.WorkspaceScheduleStatus.prototype.setNextPreStart = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceScheduleStatus} returns this
+ */
+proto.wsman.WorkspaceScheduleStatus.prototype.clearNextPreStart = function() {
+  return this.setNextPreStart(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceScheduleStatus.prototype.hasNextPreStart = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
@@ -10014,7 +12906,9 @@ proto.wsman.AdmissionLevel = {
  */
 proto.wsman.PortVisibility = {
   PORT_VISIBILITY_PRIVATE: 0,
-  PORT_VISIBILITY_PUBLIC: 1
+  PORT_VISIBILITY_PUBLIC: 1,
+  PORT_VISIBILITY_TEAM: 2,
+  PORT_VISIBILITY_TOKEN: 3
 };
 
 /**
@@ -15,6 +15,7 @@ import {
 } from "@gitpod/gitpod-protocol";
 import {
     WorkspaceStatus,
+    ScheduledStart,
     WorkspacePhase,
     WorkspaceConditionBool,
     PortVisibility as WsManPortVisibility,
@@ -150,7 +151,33 @@ export class WorkspaceManagerBridge implements Disposable {
                 (ctx, s) => this.handleStatusUpdate(ctx, s, writeToDB),
             );
         };
-        await subscriber.subscribe({ onReconnect, onStatusUpdate }, logPayload);
+        // only the bridge which governs the cluster forwards scheduled starts, so that every start is forwarded once
+        const onScheduledStart = writeToDB
+            ? (ctx: TraceContext, s: ScheduledStart) => {
+                  this.handleScheduledStart(ctx, s).catch((err) =>
+                      log.error("Cannot forward scheduled start", err, logPayload),
+                  );
+              }
+            : undefined;
+        await subscriber.subscribe({ onReconnect, onStatusUpdate, onScheduledStart }, logPayload);
+    }
+
+    protected async handleScheduledStart(ctx: TraceContext, s: ScheduledStart): Promise<void> {
+        const metadata = s.getMetadata();
+        if (!metadata || !metadata.getMetaId()) {
+            log.warn("Received scheduled start without workspace", { cluster: this.cluster.name });
+            return;
+        }
+
+        const start = {
+            ownerId: metadata.getOwner(),
+            workspaceId: metadata.getMetaId(),
+            time: (s.getTime() || new Timestamp()).toDate().toISOString(),
+        };
+        log.info({ userId: start.ownerId, workspaceId: start.workspaceId }, "Forwarding scheduled workspace start", {
+            time: start.time,
+        });
+        await this.messagebus.notifyScheduledStart(ctx, start);
     }
 
     protected serializeMessagesByInstanceId<M>(
@@ -300,11 +327,13 @@ export class WorkspaceManagerBridge implements Disposable {
             );
             instance.status.conditions.headlessTaskFailed = status.conditions.headlessTaskFailed;
             instance.status.conditions.stoppedByRequest = toBool(status.conditions.stoppedByRequest);
+            instance.status.conditions.servicesFailed = status.conditions.servicesFailed || undefined;
             instance.status.message = status.message;
             instance.status.nodeName = instance.status.nodeName || status.runtime?.nodeName;
             instance.status.podName = instance.status.podName || status.runtime?.podName;
             instance.status.nodeIp = instance.status.nodeIp || status.runtime?.nodeIp;
             instance.status.ownerToken = status.auth!.ownerToken;
+            instance.status.teamToken = status.auth!.teamToken || undefined;
 
             if (status.repo) {
                 const r = status.repo;
@@ -319,6 +348,10 @@ export class WorkspaceManagerBridge implements Disposable {
                     totalUntrackedFiles: r.totalUntrackedFiles,
                     untrackedFiles: undefinedIfEmpty(r.untrackedFilesList),
                     totalUnpushedCommits: r.totalUnpushedCommits,
+                    unpushedLfsObjects: undefinedIfEmpty(r.unpushedLfsObjectsList),
+                    totalUnpushedLfsObjects: r.totalUnpushedLfsObjects,
+                    missingLfsObjects: undefinedIfEmpty(r.missingLfsObjectsList),
+                    totalMissingLfsObjects: r.totalMissingLfsObjects,
                 };
             }
 
@@ -454,6 +487,10 @@ const mapPortVisibility = (visibility: WsManPortVisibility | undefined): PortVis
             return "private";
         case WsManPortVisibility.PORT_VISIBILITY_PUBLIC:
             return "public";
+        case WsManPortVisibility.PORT_VISIBILITY_TEAM:
+            return "team";
+        case WsManPortVisibility.PORT_VISIBILITY_TOKEN:
+            return "token";
     }
 };
 
@@ -11,6 +11,7 @@ import {
     WorkspaceInstance,
     HeadlessWorkspaceEvent,
     PrebuildWithStatus,
+    ScheduledWorkspaceStart,
 } from "@gitpod/gitpod-protocol";
 import { TraceContext } from "@gitpod/gitpod-protocol/lib/util/tracing";
 
@@ -80,6 +81,19 @@ export class MessageBusIntegration extends AbstractMessageBusIntegration {
         }
     }
 
+    async notifyScheduledStart(ctx: TraceContext, start: ScheduledWorkspaceStart) {
+        if (!this.channel) {
+            throw new Error("Not connected to message bus");
+        }
+
+        // Scheduled starts use a single queue so that exactly one server starts the workspace.
+        await MessageBusHelperImpl.assertScheduledWorkspaceStartQueue(this.channel);
+        await super.publishToQueue(MessageBusHelperImpl.SCHEDULED_START_QUEUE, Buffer.from(JSON.stringify(start)), {
+            persistent: true,
+            trace: ctx,
+        });
+    }
+
     async disconnect(): Promise<void> {
         if (this.channel) {
             this.channel.close();
@@ -6,6 +6,7 @@
 
 import {
     WorkspaceStatus,
+    ScheduledStart,
     SubscribeRequest,
     SubscribeResponse,
     GetWorkspacesRequest,
@@ -29,6 +30,7 @@ export class WsmanSubscriber implements Disposable {
         callbacks: {
             onStatusUpdate: (ctx: TraceContext, s: WorkspaceStatus) => void;
             onReconnect: (ctx: TraceContext, s: WorkspaceStatus[]) => void;
+            onScheduledStart?: (ctx: TraceContext, s: ScheduledStart) => void;
         },
         logPayload?: LogPayload,
     ) {
@@ -75,6 +77,15 @@ export class WsmanSubscriber implements Disposable {
                                 }
                             }
                         }
+
+                        const scheduledStart = incoming.getScheduledStart();
+                        if (!!scheduledStart && !!callbacks.onScheduledStart) {
+                            try {
+                                callbacks.onScheduledStart({}, scheduledStart);
+                            } catch (err) {
+                                log.error("Error handling onScheduledStart", err, payload);
+                            }
+                        }
                     });
                     this.sub.on("end", function () {
                         resolve();
@@ -0,0 +1,652 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
+)
+
+const (
+	// queuedStartLabel marks the secrets which persist queued workspace starts. The start requests contain
+	// the workspace's env vars and tokens, hence they are kept in secrets.
+	queuedStartLabel = "gitpod.io/queuedStart"
+
+	// queuedStartRequestKey is the key of the serialized start request in a queued start secret
+	queuedStartRequestKey = "request"
+)
+
+// admissionRequest is a workspace as far as admission is concerned
+type admissionRequest struct {
+	ID    string
+	Owner string
+	Team  string
+	Type  api.WorkspaceType
+	// MilliCPU is the CPU request of the workspace
+	MilliCPU int64
+}
+
+// shareKey identifies who a workspace is accounted to when sharing fairly
+func (r admissionRequest) shareKey() string {
+	if r.Team != "" {
+		return "team:" + r.Team
+	}
+	return "owner:" + r.Owner
+}
+
+// admissionPriority orders workspace types: regular workspaces are admitted before prebuilds, which are admitted
+// before image builds.
+func admissionPriority(tpe api.WorkspaceType) int {
+	switch tpe {
+	case api.WorkspaceType_REGULAR:
+		return 0
+	case api.WorkspaceType_PREBUILD:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// queuedStart is a workspace start which waits for admission
+type queuedStart struct {
+	admissionRequest
+
+	Request  *api.StartWorkspaceRequest
+	Since    time.Time
+	Position int
+
+	seq uint64
+	// admitted is closed when a start is admitted while Admit still waits for it
+	admitted chan struct{}
+	// detached is true once Admit returned the queued start, which is then started through OnAdmit
+	detached bool
+
+	// persistMu serializes persisting and forgetting the start. Once the start was admitted or cancelled
+	// it is forgotten, and must not be persisted anymore.
+	persistMu sync.Mutex
+	forgotten bool
+}
+
+// admissionQueue admits workspace starts within the configured quotas and queues the ones which exceed a quota.
+// The usage is computed from the workspace pods plus the starts which were admitted but whose pod might not exist yet.
+// Queued starts are kept in memory; the manager persists them such that they survive a restart of ws-manager.
+type admissionQueue struct {
+	Config config.AdmissionConfiguration
+
+	// Usage returns the workspaces which currently exist, indexed by their ID
+	Usage func(ctx context.Context) (map[string]admissionRequest, error)
+	// OnQueueChange is called when the position of a queued start changes
+	OnQueueChange func(qs *queuedStart)
+	// OnAdmit is called when a queued start was admitted. The start counts against the quotas until Release is called.
+	OnAdmit func(qs *queuedStart)
+
+	dispatchMu sync.Mutex
+
+	mu       sync.Mutex
+	seq      uint64
+	waiting  []*queuedStart
+	admitted map[string]admissionRequest
+}
+
+func newAdmissionQueue(cfg config.AdmissionConfiguration) *admissionQueue {
+	return &admissionQueue{
+		Config:   cfg,
+		admitted: make(map[string]admissionRequest),
+	}
+}
+
+// Admit admits a workspace start if it fits the quotas right away, and queues it otherwise. If the start was queued,
+// it is returned and started through OnAdmit once it is admitted. Admitted starts count against the quotas until
+// Release is called, after which their pod does. Starts of a workspace which is admitted or queued already are rejected.
+func (q *admissionQueue) Admit(ctx context.Context, req *api.StartWorkspaceRequest, ar admissionRequest) (*queuedStart, error) {
+	if !q.Config.Enabled() {
+		return nil, nil
+	}
+
+	qs, err := q.enqueue(req, ar, time.Now(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	q.Dispatch(ctx)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	select {
+	case <-qs.admitted:
+		return nil, nil
+	default:
+	}
+	qs.detached = true
+	return qs, nil
+}
+
+// Restore queues a start which was queued before ws-manager restarted. It is started through OnAdmit once admitted.
+func (q *admissionQueue) Restore(req *api.StartWorkspaceRequest, ar admissionRequest, since time.Time) error {
+	_, err := q.enqueue(req, ar, since, true)
+	return err
+}
+
+func (q *admissionQueue) enqueue(req *api.StartWorkspaceRequest, ar admissionRequest, since time.Time, detached bool) (*queuedStart, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, ok := q.admitted[ar.ID]; ok {
+		return nil, status.Error(codes.AlreadyExists, "workspace instance is starting already")
+	}
+	for _, qs := range q.waiting {
+		if qs.ID == ar.ID {
+			return nil, status.Error(codes.AlreadyExists, "workspace instance is queued already")
+		}
+	}
+	q.seq++
+	qs := &queuedStart{
+		admissionRequest: ar,
+		Request:          req,
+		Since:            since,
+		seq:              q.seq,
+		admitted:         make(chan struct{}),
+		detached:         detached,
+	}
+	q.waiting = append(q.waiting, qs)
+	return qs, nil
+}
+
+// Cancel removes a queued start from the queue and returns it, or nil if the workspace is not queued
+func (q *admissionQueue) Cancel(id string) *queuedStart {
+	q.mu.Lock()
+	var res *queuedStart
+	for _, qs := range q.waiting {
+		if qs.ID == id {
+			res = qs
+			break
+		}
+	}
+	if res != nil {
+		q.remove(res)
+	}
+	q.mu.Unlock()
+
+	if res != nil {
+		// the starts queued behind this one moved up
+		go q.Dispatch(context.Background())
+	}
+	return res
+}
+
+// Get returns the queued start of a workspace, or nil if the workspace is not queued
+func (q *admissionQueue) Get(id string) *queuedStart {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, qs := range q.waiting {
+		if qs.ID == id {
+			return qs
+		}
+	}
+	return nil
+}
+
+// Release stops counting an admitted start against the quotas
+func (q *admissionQueue) Release(id string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.admitted, id)
+}
+
+// remove removes a start from the waiting list. Callers must hold q.mu.
+func (q *admissionQueue) remove(qs *queuedStart) {
+	for i, w := range q.waiting {
+		if w == qs {
+			q.waiting = append(q.waiting[:i], q.waiting[i+1:]...)
+			return
+		}
+	}
+}
+
+// Dispatch admits the queued starts which fit the quotas. It is called whenever capacity might have been freed.
+func (q *admissionQueue) Dispatch(ctx context.Context) {
+	q.dispatchMu.Lock()
+	defer q.dispatchMu.Unlock()
+
+	q.mu.Lock()
+	empty := len(q.waiting) == 0
+	q.mu.Unlock()
+	if empty {
+		return
+	}
+
+	running, err := q.Usage(ctx)
+	if err != nil {
+		log.WithError(err).Warn("cannot compute workspace usage - not admitting queued workspace starts")
+		return
+	}
+
+	q.mu.Lock()
+	for id, r := range q.admitted {
+		if _, exists := running[id]; !exists {
+			running[id] = r
+		}
+	}
+	admit, queued := q.schedule(running, q.waiting)
+	var detached []*queuedStart
+	for _, qs := range admit {
+		q.admitted[qs.ID] = qs.admissionRequest
+		if qs.detached {
+			detached = append(detached, qs)
+			continue
+		}
+		close(qs.admitted)
+	}
+	q.waiting = queued
+	var changed []*queuedStart
+	for i, qs := range queued {
+		if qs.Position != i+1 {
+			qs.Position = i + 1
+			changed = append(changed, qs)
+		}
+	}
+	q.mu.Unlock()
+
+	if q.OnAdmit != nil {
+		for _, qs := range detached {
+			q.OnAdmit(qs)
+		}
+	}
+	if q.OnQueueChange == nil {
+		return
+	}
+	for _, qs := range changed {
+		q.OnQueueChange(qs)
+	}
+}
+
+// Queue returns the queued starts in the order they will be admitted
+func (q *admissionQueue) Queue() []*queuedStart {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	res := make([]*queuedStart, len(q.waiting))
+	copy(res, q.waiting)
+	return res
+}
+
+type admissionUsage struct {
+	Workspaces int
+	MilliCPU   int64
+}
+
+func (u admissionUsage) fits(q config.AdmissionQuota, r admissionRequest) bool {
+	if q.Workspaces > 0 && u.Workspaces+1 > q.Workspaces {
+		return false
+	}
+	if !q.CPU.IsZero() && u.MilliCPU+r.MilliCPU > q.CPU.MilliValue() {
+		return false
+	}
+	return true
+}
+
+func (u *admissionUsage) add(r admissionRequest) {
+	u.Workspaces++
+	u.MilliCPU += r.MilliCPU
+}
+
+// schedule decides which of the waiting starts are admitted given the running workspaces. It returns the admitted
+// starts and the ones which remain queued, in the order they will be admitted.
+//
+// Waiting starts are ordered by priority, then by the usage of their team (or owner) relative to its weight, then
+// first come first served. Starts which exceed their owner's or team's quota are skipped, such that they do not
+// block others. Once a start exceeds the cluster quota nothing is admitted anymore, such that starts which need less
+// capacity cannot overtake it forever.
+func (q *admissionQueue) schedule(running map[string]admissionRequest, waiting []*queuedStart) (admit, queued []*queuedStart) {
+	var (
+		cluster admissionUsage
+		owners  = make(map[string]*admissionUsage)
+		teams   = make(map[string]*admissionUsage)
+		shares  = make(map[string]int)
+	)
+	usage := func(idx map[string]*admissionUsage, key string) *admissionUsage {
+		u, ok := idx[key]
+		if !ok {
+			u = &admissionUsage{}
+			idx[key] = u
+		}
+		return u
+	}
+	account := func(r admissionRequest) {
+		cluster.add(r)
+		usage(owners, r.Owner).add(r)
+		if r.Team != "" {
+			usage(teams, r.Team).add(r)
+		}
+		shares[r.shareKey()]++
+	}
+	for _, r := range running {
+		account(r)
+	}
+
+	weight := func(r admissionRequest) float64 {
+		if w, ok := q.Config.TeamWeights[r.Team]; ok && r.Team != "" {
+			return w
+		}
+		return 1
+	}
+	order := func(qs []*queuedStart) {
+		sort.SliceStable(qs, func(i, j int) bool {
+			a, b := qs[i], qs[j]
+			if pa, pb := admissionPriority(a.Type), admissionPriority(b.Type); pa != pb {
+				return pa < pb
+			}
+			if sa, sb := float64(shares[a.shareKey()])/weight(a.admissionRequest), float64(shares[b.shareKey()])/weight(b.admissionRequest); sa != sb {
+				return sa < sb
+			}
+			return a.seq < b.seq
+		})
+	}
+
+	queued = make([]*queuedStart, len(waiting))
+	copy(queued, waiting)
+	for {
+		order(queued)
+
+		next := -1
+	candidates:
+		for i, qs := range queued {
+			switch {
+			case !usage(owners, qs.Owner).fits(q.Config.PerOwner, qs.admissionRequest):
+				continue
+			case qs.Team != "" && !usage(teams, qs.Team).fits(q.Config.PerTeam, qs.admissionRequest):
+				continue
+			case !cluster.fits(q.Config.Cluster, qs.admissionRequest):
+				break candidates
+			}
+			next = i
+			break
+		}
+		if next < 0 {
+			break
+		}
+
+		qs := queued[next]
+		queued = append(queued[:next], queued[next+1:]...)
+		admit = append(admit, qs)
+		account(qs.admissionRequest)
+	}
+
+	return admit, queued
+}
+
+// newAdmissionRequest describes a workspace start for admission
+func (m *Manager) newAdmissionRequest(req *api.StartWorkspaceRequest) (admissionRequest, error) {
+	class, ok := m.Config.WorkspaceClasses[req.Spec.Class]
+	if !ok {
+		class = m.Config.WorkspaceClasses[config.DefaultWorkspaceClass]
+	}
+	var cpu resource.Quantity
+	if class != nil && class.Container.Requests != nil && class.Container.Requests.CPU != "" {
+		var err error
+		cpu, err = resource.ParseQuantity(class.Container.Requests.CPU)
+		if err != nil {
+			return admissionRequest{}, xerrors.Errorf("cannot parse CPU request of workspace class: %w", err)
+		}
+	}
+
+	return admissionRequest{
+		ID:       req.Id,
+		Owner:    req.Metadata.Owner,
+		Team:     req.Metadata.GetTeam(),
+		Type:     req.Type,
+		MilliCPU: cpu.MilliValue(),
+	}, nil
+}
+
+// getAdmissionUsage returns the workspaces which currently exist as far as admission is concerned
+func (m *Manager) getAdmissionUsage(ctx context.Context) (map[string]admissionRequest, error) {
+	var pods corev1.PodList
+	err := m.Clientset.List(ctx, &pods, workspaceObjectListOptions(m.Config.Namespace))
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list workspaces: %w", err)
+	}
+
+	res := make(map[string]admissionRequest, len(pods.Items))
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			// terminated pods do not consume any resources anymore
+			continue
+		}
+		id, ok := pod.Annotations[workspaceIDAnnotation]
+		if !ok {
+			continue
+		}
+
+		r := admissionRequest{
+			ID:    id,
+			Owner: pod.Labels[wsk8s.OwnerLabel],
+			Team:  pod.Labels[wsk8s.TeamLabel],
+			Type:  api.WorkspaceType(api.WorkspaceType_value[strings.ToUpper(pod.Labels[wsk8s.TypeLabel])]),
+		}
+		// resized workspaces count with the requests of their new class
+		reqs, err := m.workspaceRequests(pod)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot compute requests of workspace %s: %w", id, err)
+		}
+		r.MilliCPU = reqs.Cpu().MilliValue()
+		res[id] = r
+	}
+	return res, nil
+}
+
+// getQueuedWorkspaceStatus produces the status of a workspace start which waits for admission
+func (m *Manager) getQueuedWorkspaceStatus(qs *queuedStart) *api.WorkspaceStatus {
+	req := qs.Request
+	return &api.WorkspaceStatus{
+		Id:            req.Id,
+		StatusVersion: m.clock.Tick(),
+		Metadata: &api.WorkspaceMetadata{
+			Owner:       req.Metadata.Owner,
+			MetaId:      req.Metadata.MetaId,
+			StartedAt:   timestamppb.New(qs.Since),
+			Annotations: req.Metadata.Annotations,
+			Team:        req.Metadata.Team,
+			Project:     req.Metadata.Project,
+		},
+		Spec: &api.WorkspaceSpec{
+			Headless:       req.Type != api.WorkspaceType_REGULAR,
+			WorkspaceImage: req.Spec.WorkspaceImage,
+			IdeImage:       req.Spec.IdeImage,
+			Type:           req.Type,
+			Timeout:        req.Spec.Timeout,
+			Class:          req.Spec.Class,
+		},
+		Phase:         api.WorkspacePhase_PENDING,
+		Conditions:    &api.WorkspaceConditions{},
+		Message:       fmt.Sprintf("workspace start is queued at position %d because it exceeds a quota", qs.Position),
+		QueuePosition: uint32(qs.Position),
+	}
+}
+
+// getFinishedQueuedWorkspaceStatus produces the status of a queued workspace start which was cancelled or which
+// failed after it was admitted
+func (m *Manager) getFinishedQueuedWorkspaceStatus(qs *queuedStart, failure string) *api.WorkspaceStatus {
+	res := m.getQueuedWorkspaceStatus(qs)
+	res.Phase = api.WorkspacePhase_STOPPED
+	res.QueuePosition = 0
+	res.Conditions.Failed = failure
+	if failure != "" {
+		res.Message = "workspace start failed"
+	} else {
+		res.Message = "queued workspace start was cancelled"
+	}
+	return res
+}
+
+// queueWorkspaceStart persists a queued workspace start such that it survives a restart of ws-manager, and tells
+// the caller that the start was queued.
+func (m *Manager) queueWorkspaceStart(ctx context.Context, qs *queuedStart) (*api.StartWorkspaceResponse, error) {
+	err := m.persistQueuedStart(ctx, qs)
+	if err != nil && m.admission.Cancel(qs.ID) != nil {
+		return nil, status.Errorf(codes.Unavailable, "cannot queue workspace start: %v", err)
+	}
+
+	url, err := config.RenderWorkspaceURL(m.Config.WorkspaceURLTemplate, qs.Request.Id, qs.Request.ServicePrefix, m.Config.GitpodHostURL)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot get workspace URL: %w", err)
+	}
+	return &api.StartWorkspaceResponse{
+		Url:    url,
+		Queued: true,
+	}, nil
+}
+
+// startQueuedWorkspace starts a workspace whose queued start was admitted
+func (m *Manager) startQueuedWorkspace(qs *queuedStart) {
+	defer m.admission.Release(qs.ID)
+
+	ctx := context.WithValue(context.Background(), ctxKeyAdmitted{}, true)
+	_, err := m.StartWorkspace(ctx, qs.Request)
+	if err != nil && status.Code(err) != codes.AlreadyExists {
+		log.WithError(err).WithFields(log.OWI(qs.Owner, qs.Request.Metadata.MetaId, qs.ID)).Error("cannot start queued workspace")
+		m.OnChange(context.Background(), m.getFinishedQueuedWorkspaceStatus(qs, err.Error()))
+	}
+	m.forgetQueuedStart(context.Background(), qs)
+}
+
+// cancelQueuedStart removes a workspace start from the admission queue. It returns false if the workspace is not queued.
+func (m *Manager) cancelQueuedStart(ctx context.Context, id string) bool {
+	qs := m.admission.Cancel(id)
+	if qs == nil {
+		return false
+	}
+	m.forgetQueuedStart(ctx, qs)
+	m.OnChange(ctx, m.getFinishedQueuedWorkspaceStatus(qs, ""))
+	return true
+}
+
+func queuedStartSecretName(id string) string {
+	return "queued-start-" + id
+}
+
+// persistQueuedStart stores a queued workspace start in a secret, unless the start was forgotten already
+func (m *Manager) persistQueuedStart(ctx context.Context, qs *queuedStart) error {
+	qs.persistMu.Lock()
+	defer qs.persistMu.Unlock()
+	if qs.forgotten {
+		// the start was admitted or cancelled before we got to persist it
+		return nil
+	}
+
+	req, err := proto.Marshal(qs.Request)
+	if err != nil {
+		return xerrors.Errorf("cannot serialize start request: %w", err)
+	}
+
+	err = m.Clientset.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      queuedStartSecretName(qs.ID),
+			Namespace: m.Config.Namespace,
+			Labels: map[string]string{
+				queuedStartLabel:       "true",
+				wsk8s.WorkspaceIDLabel: qs.Request.Metadata.MetaId,
+				wsk8s.OwnerLabel:       qs.Owner,
+			},
+		},
+		Data: map[string][]byte{
+			queuedStartRequestKey: req,
+		},
+	})
+	if err != nil && !k8serr.IsAlreadyExists(err) {
+		return xerrors.Errorf("cannot persist queued start: %w", err)
+	}
+	return nil
+}
+
+// forgetQueuedStart deletes the secret of a queued workspace start and prevents it from being persisted afterwards
+func (m *Manager) forgetQueuedStart(ctx context.Context, qs *queuedStart) {
+	qs.persistMu.Lock()
+	defer qs.persistMu.Unlock()
+	qs.forgotten = true
+	m.deleteQueuedStart(ctx, qs.ID)
+}
+
+// deleteQueuedStart deletes the secret of a queued workspace start
+func (m *Manager) deleteQueuedStart(ctx context.Context, id string) {
+	err := m.Clientset.Delete(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      queuedStartSecretName(id),
+			Namespace: m.Config.Namespace,
+		},
+	})
+	if err != nil && !k8serr.IsNotFound(err) {
+		log.WithError(err).WithFields(log.OWI("", "", id)).Warn("cannot delete queued workspace start")
+	}
+}
+
+// restoreQueuedStarts queues the workspace starts which were queued before ws-manager restarted
+func (m *Manager) restoreQueuedStarts(ctx context.Context) error {
+	var secrets corev1.SecretList
+	err := m.Clientset.List(ctx, &secrets,
+		client.InNamespace(m.Config.Namespace),
+		client.MatchingLabels{queuedStartLabel: "true"},
+	)
+	if err != nil {
+		return xerrors.Errorf("cannot list queued workspace starts: %w", err)
+	}
+
+	for _, secret := range secrets.Items {
+		var req api.StartWorkspaceRequest
+		err := proto.Unmarshal(secret.Data[queuedStartRequestKey], &req)
+		if err != nil || req.Metadata == nil || req.Spec == nil {
+			log.WithError(err).WithField("secret", secret.Name).Warn("cannot restore queued workspace start")
+			continue
+		}
+		exists, err := m.workspaceExists(ctx, req.Id)
+		if err != nil {
+			return err
+		}
+		if exists {
+			// the start was admitted before the restart
+			m.deleteQueuedStart(ctx, req.Id)
+			continue
+		}
+
+		ar, err := m.newAdmissionRequest(&req)
+		if err != nil {
+			log.WithError(err).WithField("secret", secret.Name).Warn("cannot restore queued workspace start")
+			continue
+		}
+		since := secret.CreationTimestamp.Time
+		if since.IsZero() {
+			since = time.Now()
+		}
+		err = m.admission.Restore(&req, ar, since)
+		if err != nil && status.Code(err) != codes.AlreadyExists {
+			return err
+		}
+	}
+
+	m.admission.Dispatch(ctx)
+	return nil
+}
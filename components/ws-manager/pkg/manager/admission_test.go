@@ -0,0 +1,348 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package manager
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
+	"github.com/gitpod-io/gitpod/ws-manager/pkg/clock"
+)
+
+func TestAdmissionSchedule(t *testing.T) {
+	ws := func(id, owner, team string, tpe api.WorkspaceType) admissionRequest {
+		return admissionRequest{ID: id, Owner: owner, Team: team, Type: tpe, MilliCPU: 1000}
+	}
+
+	tests := []struct {
+		Name     string
+		Config   config.AdmissionConfiguration
+		Running  []admissionRequest
+		Waiting  []admissionRequest
+		Admitted []string
+		Queued   []string
+	}{
+		{
+			Name:     "no quota",
+			Waiting:  []admissionRequest{ws("a", "alice", "", api.WorkspaceType_REGULAR)},
+			Admitted: []string{"a"},
+		},
+		{
+			Name:    "owner quota",
+			Config:  config.AdmissionConfiguration{PerOwner: config.AdmissionQuota{Workspaces: 2}},
+			Running: []admissionRequest{ws("r1", "alice", "", api.WorkspaceType_REGULAR)},
+			Waiting: []admissionRequest{
+				ws("a1", "alice", "", api.WorkspaceType_REGULAR),
+				ws("a2", "alice", "", api.WorkspaceType_REGULAR),
+				ws("b1", "bob", "", api.WorkspaceType_REGULAR),
+			},
+			Admitted: []string{"b1", "a1"},
+			Queued:   []string{"a2"},
+		},
+		{
+			Name:    "team CPU quota",
+			Config:  config.AdmissionConfiguration{PerTeam: config.AdmissionQuota{CPU: resource.MustParse("2")}},
+			Running: []admissionRequest{ws("r1", "alice", "team-a", api.WorkspaceType_REGULAR)},
+			Waiting: []admissionRequest{
+				ws("a1", "bob", "team-a", api.WorkspaceType_PREBUILD),
+				ws("a2", "bob", "team-a", api.WorkspaceType_PREBUILD),
+				ws("c1", "carol", "", api.WorkspaceType_PREBUILD),
+			},
+			Admitted: []string{"c1", "a1"},
+			Queued:   []string{"a2"},
+		},
+		{
+			Name:   "priority",
+			Config: config.AdmissionConfiguration{Cluster: config.AdmissionQuota{Workspaces: 1}},
+			Waiting: []admissionRequest{
+				ws("image", "alice", "", api.WorkspaceType_IMAGEBUILD),
+				ws("prebuild", "alice", "", api.WorkspaceType_PREBUILD),
+				ws("regular", "alice", "", api.WorkspaceType_REGULAR),
+			},
+			Admitted: []string{"regular"},
+			Queued:   []string{"prebuild", "image"},
+		},
+		{
+			Name:    "fair share",
+			Config:  config.AdmissionConfiguration{Cluster: config.AdmissionQuota{Workspaces: 4}},
+			Running: []admissionRequest{ws("r1", "alice", "team-a", api.WorkspaceType_PREBUILD)},
+			Waiting: []admissionRequest{
+				ws("a1", "alice", "team-a", api.WorkspaceType_PREBUILD),
+				ws("a2", "alice", "team-a", api.WorkspaceType_PREBUILD),
+				ws("a3", "alice", "team-a", api.WorkspaceType_PREBUILD),
+				ws("b1", "bob", "team-b", api.WorkspaceType_PREBUILD),
+				ws("b2", "bob", "team-b", api.WorkspaceType_PREBUILD),
+			},
+			Admitted: []string{"b1", "a1", "b2"},
+			Queued:   []string{"a2", "a3"},
+		},
+		{
+			Name: "weighted fair share",
+			Config: config.AdmissionConfiguration{
+				Cluster:     config.AdmissionQuota{Workspaces: 3},
+				TeamWeights: map[string]float64{"team-a": 3},
+			},
+			Waiting: []admissionRequest{
+				ws("b1", "bob", "team-b", api.WorkspaceType_PREBUILD),
+				ws("b2", "bob", "team-b", api.WorkspaceType_PREBUILD),
+				ws("a1", "alice", "team-a", api.WorkspaceType_PREBUILD),
+				ws("a2", "alice", "team-a", api.WorkspaceType_PREBUILD),
+			},
+			Admitted: []string{"b1", "a1", "a2"},
+			Queued:   []string{"b2"},
+		},
+		{
+			Name:   "cluster quota is not overtaken",
+			Config: config.AdmissionConfiguration{Cluster: config.AdmissionQuota{CPU: resource.MustParse("2")}},
+			Running: []admissionRequest{
+				ws("r1", "alice", "", api.WorkspaceType_REGULAR),
+			},
+			Waiting: []admissionRequest{
+				{ID: "big", Owner: "bob", Type: api.WorkspaceType_REGULAR, MilliCPU: 2000},
+				{ID: "small", Owner: "carol", Type: api.WorkspaceType_REGULAR, MilliCPU: 500},
+			},
+			Queued: []string{"big", "small"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			q := newAdmissionQueue(test.Config)
+			running := make(map[string]admissionRequest)
+			for _, r := range test.Running {
+				running[r.ID] = r
+			}
+			var waiting []*queuedStart
+			for i, r := range test.Waiting {
+				waiting = append(waiting, &queuedStart{admissionRequest: r, seq: uint64(i)})
+			}
+
+			admit, queued := q.schedule(running, waiting)
+
+			ids := func(qs []*queuedStart) []string {
+				var res []string
+				for _, s := range qs {
+					res = append(res, s.ID)
+				}
+				return res
+			}
+			if diff := cmp.Diff(test.Admitted, ids(admit)); diff != "" {
+				t.Errorf("unexpected admitted starts (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.Queued, ids(queued)); diff != "" {
+				t.Errorf("unexpected queued starts (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAdmissionQueue(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		running = map[string]admissionRequest{
+			"r1": {ID: "r1", Owner: "alice"},
+		}
+		positions = make(map[string]int)
+	)
+	q := newAdmissionQueue(config.AdmissionConfiguration{PerOwner: config.AdmissionQuota{Workspaces: 1}})
+	q.Usage = func(ctx context.Context) (map[string]admissionRequest, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		res := make(map[string]admissionRequest, len(running))
+		for k, v := range running {
+			res[k] = v
+		}
+		return res, nil
+	}
+	q.OnQueueChange = func(qs *queuedStart) {
+		mu.Lock()
+		defer mu.Unlock()
+		positions[qs.ID] = qs.Position
+	}
+
+	admitted := make(chan *queuedStart, 1)
+	q.OnAdmit = func(qs *queuedStart) {
+		admitted <- qs
+	}
+
+	req := &api.StartWorkspaceRequest{Id: "a1", Metadata: &api.WorkspaceMetadata{Owner: "alice"}}
+	qs, err := q.Admit(context.Background(), req, admissionRequest{ID: "a1", Owner: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qs == nil || qs.ID != "a1" {
+		t.Fatalf("expected the start over quota to be queued, but got %v", qs)
+	}
+	mu.Lock()
+	if diff := cmp.Diff(map[string]int{"a1": 1}, positions); diff != "" {
+		t.Errorf("unexpected queue positions (-want +got):\n%s", diff)
+	}
+	mu.Unlock()
+	if q.Get("a1") != qs {
+		t.Errorf("expected the queued start to be found")
+	}
+
+	_, err = q.Admit(context.Background(), req, admissionRequest{ID: "a1", Owner: "alice"})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("expected a start which is queued already to be rejected, but got %v", err)
+	}
+
+	mu.Lock()
+	delete(running, "r1")
+	mu.Unlock()
+	q.Dispatch(context.Background())
+	select {
+	case act := <-admitted:
+		if act != qs {
+			t.Errorf("expected the queued start to be admitted, but got %v", act)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("workspace start was not admitted")
+	}
+	if len(q.Queue()) != 0 {
+		t.Errorf("expected an admitted start to be removed from the queue")
+	}
+
+	// the admitted start counts against the quota until it is released, and cannot be started twice
+	_, err = q.Admit(context.Background(), req, admissionRequest{ID: "a1", Owner: "alice"})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("expected a start which is starting already to be rejected, but got %v", err)
+	}
+	qs, err = q.Admit(context.Background(), &api.StartWorkspaceRequest{Id: "a2"}, admissionRequest{ID: "a2", Owner: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qs == nil {
+		t.Fatal("expected a start over quota to be queued")
+	}
+	if act := q.Cancel("a2"); act != qs {
+		t.Errorf("expected the queued start to be cancelled, but got %v", act)
+	}
+	if q.Get("a2") != nil || q.Cancel("a2") != nil {
+		t.Errorf("expected a cancelled start to be removed from the queue")
+	}
+
+	q.Release("a1")
+	qs, err = q.Admit(context.Background(), &api.StartWorkspaceRequest{Id: "a3"}, admissionRequest{ID: "a3", Owner: "alice"})
+	if err != nil || qs != nil {
+		t.Errorf("expected a start within the quota to be admitted, but got %v, %v", qs, err)
+	}
+}
+
+func TestRestoreQueuedStarts(t *testing.T) {
+	newManager := func(clientset client.Client) *Manager {
+		cfg := forTestingOnlyManagerConfig()
+		cfg.Admission = config.AdmissionConfiguration{PerOwner: config.AdmissionQuota{Workspaces: 1}}
+		m := &Manager{
+			Config:    cfg,
+			Clientset: clientset,
+			clock:     clock.System(),
+			OnChange:  func(context.Context, *api.WorkspaceStatus) {},
+		}
+		m.admission = newAdmissionQueue(cfg.Admission)
+		m.admission.Usage = func(ctx context.Context) (map[string]admissionRequest, error) {
+			return map[string]admissionRequest{"r1": {ID: "r1", Owner: "alice"}}, nil
+		}
+		return m
+	}
+	startRequest := func(id string) *api.StartWorkspaceRequest {
+		return &api.StartWorkspaceRequest{
+			Id:            id,
+			ServicePrefix: id,
+			Metadata:      &api.WorkspaceMetadata{Owner: "alice", MetaId: "meta-" + id},
+			Spec: &api.StartWorkspaceSpec{
+				WorkspaceImage:    "image",
+				WorkspaceLocation: "/workspace",
+				Initializer: &csapi.WorkspaceInitializer{
+					Spec: &csapi.WorkspaceInitializer_Empty{Empty: &csapi.EmptyInitializer{}},
+				},
+			},
+		}
+	}
+
+	clientset := fake.NewClientBuilder().Build()
+	m := newManager(clientset)
+	for _, id := range []string{"a1", "a2"} {
+		resp, err := m.StartWorkspace(context.Background(), startRequest(id))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !resp.Queued {
+			t.Errorf("expected start of %s to be queued", id)
+		}
+	}
+	_, err := m.StartWorkspace(context.Background(), startRequest("a1"))
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("expected a start which is queued already to be rejected, but got %v", err)
+	}
+
+	_, err = m.StopWorkspace(context.Background(), &api.StopWorkspaceRequest{Id: "a2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// ws-manager restarts
+	m = newManager(clientset)
+	err = m.restoreQueuedStarts(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var act []string
+	for _, qs := range m.admission.Queue() {
+		act = append(act, qs.ID)
+		if qs.Request.Metadata.MetaId != "meta-"+qs.ID {
+			t.Errorf("unexpected start request of %s: %v", qs.ID, qs.Request)
+		}
+	}
+	if diff := cmp.Diff([]string{"a1"}, act); diff != "" {
+		t.Errorf("unexpected queue after restart (-want +got):\n%s", diff)
+	}
+
+	desc, err := m.DescribeWorkspace(context.Background(), &api.DescribeWorkspaceRequest{Id: "a1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.Status.QueuePosition != 1 {
+		t.Errorf("expected the restored start to be queued at position 1, but got %d", desc.Status.QueuePosition)
+	}
+}
+
+func TestPersistForgottenQueuedStart(t *testing.T) {
+	clientset := fake.NewClientBuilder().Build()
+	m := &Manager{Config: forTestingOnlyManagerConfig(), Clientset: clientset}
+	qs := &queuedStart{
+		admissionRequest: admissionRequest{ID: "a1", Owner: "alice"},
+		Request:          &api.StartWorkspaceRequest{Id: "a1", Metadata: &api.WorkspaceMetadata{Owner: "alice", MetaId: "meta-a1"}},
+	}
+
+	// the start was admitted and started before Admit's caller persisted it
+	m.forgetQueuedStart(context.Background(), qs)
+	err := m.persistQueuedStart(context.Background(), qs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var secrets corev1.SecretList
+	err = clientset.List(context.Background(), &secrets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(secrets.Items) != 0 {
+		t.Errorf("expected a forgotten start not to be persisted, but found %d secrets", len(secrets.Items))
+	}
+}
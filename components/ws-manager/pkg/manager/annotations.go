@@ -85,6 +85,9 @@ const (
 
 	// attemptingToCreatePodAnnotation is set when ws-manager is trying to create pod and is removed when pod is successfully scheduled on the node
 	attemptingToCreatePodAnnotation = "gitpod.io/attemptingToCreate"
+
+	// workspaceResizedAnnotation contains the time a workspace was last resized to another class using ResizeWorkspace
+	workspaceResizedAnnotation = "gitpod.io/resized"
 )
 
 // markWorkspaceAsReady adds annotations to a workspace pod
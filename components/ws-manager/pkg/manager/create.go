@@ -24,6 +24,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
@@ -595,7 +596,7 @@ func (m *Manager) createDefiniteWorkspacePod(startContext *startWorkspaceContext
 			// add init container to chown workspace subpath, so that it is owned by gitpod user (there is no k8s native way of doing this as of right now)
 			pod.Spec.InitContainers = append(pod.Spec.InitContainers, corev1.Container{
 				Name:            "chown-workspace",
-				Image:           "busybox",
+				Image:           m.Config.GetInitImage(),
 				ImagePullPolicy: corev1.PullIfNotPresent,
 				Command:         []string{"chown", "133332:133332", "/workspace"},
 				VolumeMounts: []corev1.VolumeMount{
@@ -608,15 +609,12 @@ func (m *Manager) createDefiniteWorkspacePod(startContext *startWorkspaceContext
 			})
 
 		case api.WorkspaceFeatureFlag_WORKSPACE_CLASS_LIMITING:
-			limits := startContext.Class.Container.Limits
-			if limits != nil && limits.CPU != nil {
-				if limits.CPU.MinLimit != "" {
-					annotations[kubernetes.WorkspaceCpuMinLimitAnnotation] = limits.CPU.MinLimit
-				}
-
-				if limits.CPU.BurstLimit != "" {
-					annotations[kubernetes.WorkspaceCpuBurstLimitAnnotation] = limits.CPU.BurstLimit
-				}
+			limits, err := classLimitAnnotations(startContext.Class)
+			if err != nil {
+				return nil, xerrors.Errorf("cannot produce workspace class limits: %w", err)
+			}
+			for k, v := range limits {
+				annotations[k] = v
 			}
 
 		case api.WorkspaceFeatureFlag_WORKSPACE_CONNECTION_LIMITING:
@@ -630,6 +628,13 @@ func (m *Manager) createDefiniteWorkspacePod(startContext *startWorkspaceContext
 		}
 	}
 
+	if len(req.Spec.Services) > 0 && req.Type != api.WorkspaceType_IMAGEBUILD {
+		err = addServiceContainers(&pod, req.Spec.Services, m.Config.GetInitImage())
+		if err != nil {
+			return nil, xerrors.Errorf("cannot add service containers: %w", err)
+		}
+	}
+
 	if req.Type == api.WorkspaceType_IMAGEBUILD {
 		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
 			Name: "gitpod-ca-certificate",
@@ -675,6 +680,142 @@ func setProtectedSecrets(pod *corev1.Pod, req *api.StartWorkspaceRequest) {
 	}
 }
 
+const (
+	// servicesPath is where the workspace and its service containers share the service logs and state.
+	// Supervisor reads the state and logs from there and requests restarts by placing files there.
+	servicesPath       = "/.services"
+	servicesVolumeName = "gitpod-services"
+
+	// serviceContainerPrefix prefixes the name of each service container in the workspace pod.
+	serviceContainerPrefix = "service-"
+
+	// serviceUID is the user service containers run as. It is the gitpod user (33333) of the workspace container
+	// as seen from outside its user namespace, such that supervisor can access what the services share.
+	serviceUID = int64(133332)
+
+	// serviceWrapperScript runs a service command in a loop. The workspace pod does not restart its containers,
+	// hence the wrapper restarts the command itself when it exits or when supervisor asks for a restart.
+	// It exits once supervisor signals the workspace is stopping, so that headless workspace pods can complete.
+	serviceWrapperScript = `dir=/.services/$0
+bb=/.services/bin/busybox
+restarts=0
+stop=
+trap 'stop=1' TERM INT
+while [ -z "$stop" ] && [ ! -e /.services/stop ]; do
+	if [ -f "$dir/log" ] && [ "$($bb wc -c <"$dir/log")" -gt 10485760 ]; then
+		$bb mv "$dir/log" "$dir/log.1"
+	fi
+	"$@" >>"$dir/log" 2>&1 &
+	pid=$!
+	echo "running $restarts $($bb date +%s)" >"$dir/state"
+	requested=
+	while kill -0 $pid 2>/dev/null; do
+		if [ -n "$stop" ] || [ -e /.services/stop ]; then
+			kill $pid
+		elif [ -e "$dir/restart" ]; then
+			$bb rm -f "$dir/restart"
+			requested=1
+			kill $pid
+		fi
+		$bb sleep 1
+	done
+	wait $pid
+	echo "exited $restarts $? $($bb date +%s)" >"$dir/state"
+	restarts=$((restarts+1))
+	if [ -z "$requested" ] && [ -z "$stop" ] && [ ! -e /.services/stop ]; then
+		delay=$((restarts*2))
+		[ $delay -gt 30 ] && delay=30
+		$bb sleep $delay
+	fi
+done
+exit 0
+`
+)
+
+// addServiceContainers adds a container for each service to the workspace pod. The containers share the pod's
+// network namespace, hence the services are available to the workspace on their ports. The init container which
+// installs the service wrapper runs initImage.
+func addServiceContainers(pod *corev1.Pod, services []*api.ServiceSpec, initImage string) error {
+	mount := corev1.VolumeMount{Name: servicesVolumeName, MountPath: servicesPath}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name:         servicesVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+
+	// service images need not have a shell, hence the wrapper runs on a static busybox
+	install := []string{"mkdir -p " + servicesPath + "/bin", "cp /bin/busybox " + servicesPath + "/bin/busybox"}
+	for _, svc := range services {
+		install = append(install, fmt.Sprintf("mkdir -p %s/%s", servicesPath, svc.Name))
+	}
+	// only the services and the workspace user can access what the services share
+	install = append(install,
+		fmt.Sprintf("chown -R %d:%d %s", serviceUID, serviceUID, servicesPath),
+		"chmod -R u=rwX,go= "+servicesPath,
+	)
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, corev1.Container{
+		Name:            "install-services",
+		Image:           initImage,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command:         []string{"sh", "-c", strings.Join(install, " && ")},
+		VolumeMounts:    []corev1.VolumeMount{mount},
+	})
+
+	uid := serviceUID
+	for _, svc := range services {
+		limits := make(corev1.ResourceList)
+		if svc.CpuLimit != "" {
+			q, err := resource.ParseQuantity(svc.CpuLimit)
+			if err != nil {
+				return xerrors.Errorf("service %s: cannot parse CPU limit: %w", svc.Name, err)
+			}
+			limits[corev1.ResourceCPU] = q
+		}
+		if svc.MemoryLimit != "" {
+			q, err := resource.ParseQuantity(svc.MemoryLimit)
+			if err != nil {
+				return xerrors.Errorf("service %s: cannot parse memory limit: %w", svc.Name, err)
+			}
+			limits[corev1.ResourceMemory] = q
+		}
+
+		env := make([]corev1.EnvVar, 0, len(svc.Env))
+		for _, e := range svc.Env {
+			env = append(env, corev1.EnvVar{Name: e.Name, Value: e.Value})
+		}
+		ports := make([]corev1.ContainerPort, 0, len(svc.Ports))
+		for _, p := range svc.Ports {
+			ports = append(ports, corev1.ContainerPort{ContainerPort: int32(p)})
+		}
+
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{
+			Name:            serviceContainerPrefix + svc.Name,
+			Image:           svc.Image,
+			ImagePullPolicy: corev1.PullIfNotPresent,
+			Command:         append([]string{servicesPath + "/bin/busybox", "sh", "-c", serviceWrapperScript, svc.Name}, svc.Command...),
+			Env:             env,
+			Ports:           ports,
+			Resources: corev1.ResourceRequirements{
+				Limits: limits,
+			},
+			VolumeMounts: []corev1.VolumeMount{mount},
+			// Services must not render the workspace pod unready, which would take the workspace offline.
+			// Supervisor probes their readiness instead.
+			SecurityContext: &corev1.SecurityContext{
+				RunAsUser:                &uid,
+				RunAsGroup:               &uid,
+				RunAsNonRoot:             &boolTrue,
+				AllowPrivilegeEscalation: &boolFalse,
+				Capabilities: &corev1.Capabilities{
+					Drop: []corev1.Capability{"ALL"},
+				},
+			},
+		})
+	}
+
+	pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, mount)
+	return nil
+}
+
 func removeVolume(pod *corev1.Pod, name string) {
 	var vols []corev1.Volume
 	for _, v := range pod.Spec.Volumes {
@@ -836,6 +977,7 @@ func (m *Manager) createWorkspaceEnvironment(startContext *startWorkspaceContext
 			case "GITPOD_WORKSPACE_CONTEXT",
 				"GITPOD_WORKSPACE_CONTEXT_URL",
 				"GITPOD_TASKS",
+				"GITPOD_SERVICES",
 				"GITPOD_RESOLVED_EXTENSIONS",
 				"GITPOD_EXTERNAL_EXTENSIONS",
 				"GITPOD_WORKSPACE_CLASS_INFO",
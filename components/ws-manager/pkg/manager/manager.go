@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"sync"
 	"time"
@@ -27,13 +28,16 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
@@ -81,6 +85,11 @@ type Manager struct {
 
 	metrics *metrics
 
+	admission *admissionQueue
+
+	schedules     *workspaceSchedules
+	schedulesLock sync.RWMutex
+
 	eventRecorder record.EventRecorder
 
 	api.UnimplementedWorkspaceManagerServer
@@ -158,6 +167,14 @@ func New(config config.Configuration, client client.Client, rawClient kubernetes
 	}
 	m.metrics = newMetrics(m)
 	m.OnChange = m.onChange
+	m.admission = newAdmissionQueue(config.Admission)
+	m.admission.Usage = m.getAdmissionUsage
+	m.admission.OnQueueChange = func(qs *queuedStart) {
+		m.OnChange(context.Background(), m.getQueuedWorkspaceStatus(qs))
+	}
+	m.admission.OnAdmit = func(qs *queuedStart) {
+		go m.startQueuedWorkspace(qs)
+	}
 	return m, nil
 }
 
@@ -175,6 +192,8 @@ func (m *Manager) Close() {
 
 type (
 	ctxKeyRemainingTime struct{}
+	// ctxKeyAdmitted marks starts which were admitted already, i.e. retries and starts which were queued
+	ctxKeyAdmitted struct{}
 )
 
 // StartWorkspace creates a new running workspace within the manager's cluster
@@ -192,6 +211,7 @@ func (m *Manager) StartWorkspace(ctx context.Context, req *api.StartWorkspaceReq
 	if remainingTime, ok := ctx.Value(ctxKeyRemainingTime{}).(time.Duration); ok {
 		startWorkspaceTimeout = remainingTime
 	}
+	_, admitted := ctx.Value(ctxKeyAdmitted{}).(bool)
 
 	ctx, cancel := context.WithTimeout(context.Background(), startWorkspaceTimeout)
 	defer cancel()
@@ -245,6 +265,23 @@ func (m *Manager) StartWorkspace(ctx context.Context, req *api.StartWorkspaceReq
 		return nil, status.Errorf(codes.InvalidArgument, "invalid start workspace request: %v", err)
 	}
 	span.LogKV("event", "validated workspace start request")
+
+	// queue the start if the workspace does not fit the quotas of its owner, team and the cluster
+	if !admitted {
+		admissionReq, err := m.newAdmissionRequest(req)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot start workspace: %w", err)
+		}
+		qs, err := m.admission.Admit(ctx, req, admissionReq)
+		if err != nil {
+			return nil, err
+		}
+		if qs != nil {
+			return m.queueWorkspaceStart(ctx, qs)
+		}
+		span.LogKV("event", "admitted workspace start")
+	}
+	defer m.admission.Release(req.Id)
 	// create the objects required to start the workspace pod/service
 	startContext, err := m.newStartWorkspaceContext(ctx, req)
 	if err != nil {
@@ -260,6 +297,28 @@ func (m *Manager) StartWorkspace(ctx context.Context, req *api.StartWorkspaceReq
 	}
 	span.LogKV("event", "pod created", "name", pod.Name, "namespace", pod.Namespace)
 
+	// placeholders are kept on nodes for regular workloads only
+	var placeholder *corev1.Pod
+	if !startContext.Headless {
+		placeholder, err = m.claimWarmPoolPlaceholder(ctx, startContext.Labels[workspaceClassLabel])
+		if err != nil {
+			clog.WithError(err).Warn("cannot claim warm pool placeholder")
+		}
+		if placeholder != nil {
+			preferNode(pod, placeholder.Spec.NodeName)
+			span.LogKV("event", "claimed warm pool placeholder", "node", placeholder.Spec.NodeName)
+		}
+	}
+	defer func() {
+		// the placeholder is freed once the workspace pod exists, otherwise it goes back to the pool - even if the
+		// start timed out
+		if placeholder != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), kubernetesOperationTimeout)
+			defer cancel()
+			m.releaseWarmPoolPlaceholder(ctx, placeholder)
+		}
+	}()
+
 	var (
 		createPVC          bool
 		pvc                *corev1.PersistentVolumeClaim
@@ -350,6 +409,10 @@ func (m *Manager) StartWorkspace(ctx context.Context, req *api.StartWorkspaceReq
 		clog.WithError(err).WithField("pod", string(safePod)).Warn("was unable to create workspace pod")
 		return nil, err
 	}
+	if placeholder != nil {
+		m.freeWarmPoolPlaceholder(ctx, placeholder)
+		placeholder = nil
+	}
 
 	// if we reach this point the pod is created
 	err = wait.PollImmediateWithContext(ctx, 100*time.Millisecond, 7*time.Minute, podRunning(m.Clientset, pod.Name, pod.Namespace))
@@ -375,6 +438,7 @@ func (m *Manager) StartWorkspace(ctx context.Context, req *api.StartWorkspaceReq
 			ctx := context.Background()
 			remainingTime := startWorkspaceTimeout - time.Since(startWorkspaceTime)
 			ctx = context.WithValue(ctx, ctxKeyRemainingTime{}, remainingTime)
+			ctx = context.WithValue(ctx, ctxKeyAdmitted{}, true)
 			return m.StartWorkspace(ctx, req)
 		}
 
@@ -592,6 +656,7 @@ func validateStartWorkspaceRequest(req *api.StartWorkspaceRequest) error {
 		validation.Field(&req.Spec.Ports, validation.By(areValidPorts)),
 		validation.Field(&req.Spec.Initializer, validation.Required),
 		validation.Field(&req.Spec.FeatureFlags, validation.By(areValidFeatureFlags)),
+		validation.Field(&req.Spec.Services, validation.By(areValidServices)),
 	)
 	if err != nil {
 		return xerrors.Errorf("invalid request: %w", err)
@@ -651,6 +716,41 @@ func areValidPorts(value interface{}) error {
 	return nil
 }
 
+func areValidServices(value interface{}) error {
+	s, ok := value.([]*api.ServiceSpec)
+	if !ok {
+		return xerrors.Errorf("value is not a service spec list")
+	}
+
+	idx := make(map[string]struct{})
+	for _, svc := range s {
+		if errs := k8svalidation.IsDNS1123Label(svc.Name); len(errs) > 0 {
+			return xerrors.Errorf("service name %q is invalid: %s", svc.Name, strings.Join(errs, ", "))
+		}
+		if _, exists := idx[svc.Name]; exists {
+			return xerrors.Errorf("service %s is not unique", svc.Name)
+		}
+		idx[svc.Name] = struct{}{}
+
+		if svc.Image == "" {
+			return xerrors.Errorf("service %s has no image", svc.Name)
+		}
+		if len(svc.Command) == 0 {
+			return xerrors.Errorf("service %s has no command", svc.Name)
+		}
+		for _, q := range []string{svc.CpuLimit, svc.MemoryLimit} {
+			if q == "" {
+				continue
+			}
+			if _, err := resource.ParseQuantity(q); err != nil {
+				return xerrors.Errorf("service %s has invalid resource limit %q: %w", svc.Name, q, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func podRunning(clientset client.Client, podName, namespace string) wait.ConditionWithContextFunc {
 	return func(ctx context.Context) (bool, error) {
 		var pod corev1.Pod
@@ -758,6 +858,11 @@ func (m *Manager) StopWorkspace(ctx context.Context, req *api.StopWorkspaceReque
 	clog := log.WithFields(owi)
 	clog.Info("StopWorkspace")
 
+	// a start which waits for admission has no pod yet
+	if m.cancelQueuedStart(ctx, req.Id) {
+		return &api.StopWorkspaceResponse{}, nil
+	}
+
 	gracePeriod := stopWorkspaceNormallyGracePeriod
 	if req.Policy == api.StopWorkspacePolicy_IMMEDIATELY {
 		span.LogKV("policy", "immediately")
@@ -992,6 +1097,11 @@ func (m *Manager) ControlPort(ctx context.Context, req *api.ControlPortRequest)
 	// dunno why in k8s IP ports are int32 not uint16
 	port := req.Spec.Port
 
+	shareLinkExpiry, err := m.portShareLinkExpiry(req.ShareLinkExpiry)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	err = retry.RetryOnConflict(retry.DefaultBackoff, func() (err error) {
 		pod, err := m.findWorkspacePod(ctx, req.Id)
 		if err != nil {
@@ -1016,8 +1126,14 @@ func (m *Manager) ControlPort(ctx context.Context, req *api.ControlPortRequest)
 			return xerrors.Errorf("workspace pod %s has no service prefix annotation", pod.Name)
 		}
 
-		if req.Expose && existingPortSpecIdx < 0 {
-			// port is not exposed yet - patch the pod
+		if req.Expose {
+			// share tokens are issued with millisecond precision and must not predate their own revocation
+			now := time.Now().Truncate(time.Millisecond)
+			var notBefore *timestamppb.Timestamp
+			if req.RevokeShareLinks {
+				notBefore = timestamppb.New(now)
+			}
+
 			url, err := config.RenderWorkspacePortURL(m.Config.WorkspacePortURLTemplate, config.PortURLContext{
 				Host:          m.Config.GitpodHostURL,
 				ID:            req.Id,
@@ -1028,20 +1144,32 @@ func (m *Manager) ControlPort(ctx context.Context, req *api.ControlPortRequest)
 			if err != nil {
 				return xerrors.Errorf("cannot render public URL for %d: %w", port, err)
 			}
-
-			portSpec := &api.PortSpec{
-				Port:       uint32(port),
-				Visibility: req.Spec.Visibility,
-				Url:        url,
+			if req.Spec.Visibility == api.PortVisibility_PORT_VISIBILITY_TOKEN {
+				url, err = addPortShareToken(url, pod.Annotations[wsk8s.OwnerTokenAnnotation], req.Id, port, now, now.Add(shareLinkExpiry))
+				if err != nil {
+					return xerrors.Errorf("cannot produce share link for %d: %w", port, err)
+				}
 			}
 
-			exposedPorts.Ports = append(exposedPorts.Ports, portSpec)
-		} else if req.Expose && existingPortSpecIdx >= 0 {
-			exposedPorts.Ports[existingPortSpecIdx].Visibility = req.Spec.Visibility
-		} else if !req.Expose && existingPortSpecIdx < 0 {
+			if existingPortSpecIdx < 0 {
+				// port is not exposed yet - patch the pod
+				exposedPorts.Ports = append(exposedPorts.Ports, &api.PortSpec{
+					Port:                uint32(port),
+					Visibility:          req.Spec.Visibility,
+					Url:                 url,
+					ShareLinksNotBefore: notBefore,
+				})
+			} else {
+				exposedPorts.Ports[existingPortSpecIdx].Visibility = req.Spec.Visibility
+				exposedPorts.Ports[existingPortSpecIdx].Url = url
+				if notBefore != nil {
+					exposedPorts.Ports[existingPortSpecIdx].ShareLinksNotBefore = notBefore
+				}
+			}
+		} else if existingPortSpecIdx < 0 {
 			// port isn't exposed already - we're done here
 			return nil
-		} else if !req.Expose && existingPortSpecIdx >= 0 {
+		} else {
 			// port is exposed but shouldn't be - remove it from the port list
 			exposedPorts.Ports = append(exposedPorts.Ports[:existingPortSpecIdx], exposedPorts.Ports[existingPortSpecIdx+1:]...)
 		}
@@ -1085,6 +1213,49 @@ func (m *Manager) ControlPort(ctx context.Context, req *api.ControlPortRequest)
 	return &api.ControlPortResponse{}, err
 }
 
+// defaultPortShareLinkMaxExpiry is the longest time share links of ports with token visibility are valid for,
+// unless configured otherwise. Changing the visibility of a port to token again issues a new share link.
+const defaultPortShareLinkMaxExpiry = 24 * time.Hour
+
+// portShareLinkExpiry returns how long a share link requested with expiry is valid for. Share links are valid
+// for the configured maximum unless the request asks for less.
+func (m *Manager) portShareLinkExpiry(expiry string) (time.Duration, error) {
+	max := time.Duration(m.Config.PortShareLinkMaxExpiry)
+	if max <= 0 {
+		max = defaultPortShareLinkMaxExpiry
+	}
+	if expiry == "" {
+		return max, nil
+	}
+
+	d, err := time.ParseDuration(expiry)
+	if err != nil {
+		return 0, xerrors.Errorf("invalid share link expiry: %w", err)
+	}
+	if d <= 0 {
+		return 0, xerrors.Errorf("share link expiry must be positive")
+	}
+	if d > max {
+		return 0, xerrors.Errorf("share link expiry must not exceed %s", max)
+	}
+	return d, nil
+}
+
+// addPortShareToken turns the URL of a port into a share link which was issued at issued and is valid until expiry
+func addPortShareToken(portURL string, ownerToken string, instanceID string, port uint32, issued, expiry time.Time) (string, error) {
+	if ownerToken == "" {
+		return "", xerrors.Errorf("workspace has no owner token")
+	}
+	u, err := url.Parse(portURL)
+	if err != nil {
+		return "", err
+	}
+	query := u.Query()
+	query.Set(api.PortShareTokenParam, api.SignPortShareToken(ownerToken, instanceID, port, issued, expiry))
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
 // DescribeWorkspace investigates a workspace and returns its status, and configuration
 func (m *Manager) DescribeWorkspace(ctx context.Context, req *api.DescribeWorkspaceRequest) (res *api.DescribeWorkspaceResponse, err error) {
 	//nolint:ineffassign
@@ -1097,6 +1268,9 @@ func (m *Manager) DescribeWorkspace(ctx context.Context, req *api.DescribeWorksp
 
 	pod, err := m.findWorkspacePod(ctx, req.Id)
 	if isKubernetesObjNotFoundError(err) {
+		if qs := m.admission.Get(req.Id); qs != nil {
+			return &api.DescribeWorkspaceResponse{Status: m.getQueuedWorkspaceStatus(qs)}, nil
+		}
 		// TODO: make 404 status error
 		return nil, status.Errorf(codes.NotFound, "workspace %s does not exist", req.Id)
 	}
@@ -1124,6 +1298,7 @@ func (m *Manager) DescribeWorkspace(ctx context.Context, req *api.DescribeWorksp
 	if lastActivity != nil {
 		result.LastActivity = lastActivity.UTC().Format(time.RFC3339Nano)
 	}
+	result.Schedule = m.getWorkspaceSchedule(pod).Status(time.Now())
 	return result, nil
 }
 
@@ -1182,8 +1357,22 @@ func (m *Manager) DescribeCluster(ctx context.Context, req *api.DescribeClusterR
 		i += 1
 	}
 
+	queue := m.admission.Queue()
+	admissionQueue := make([]*api.QueuedWorkspace, len(queue))
+	for i, qs := range queue {
+		status := m.getQueuedWorkspaceStatus(qs)
+		admissionQueue[i] = &api.QueuedWorkspace{
+			Id:          qs.ID,
+			Metadata:    status.Metadata,
+			Type:        qs.Type,
+			Position:    uint32(i + 1),
+			QueuedSince: status.Metadata.StartedAt,
+		}
+	}
+
 	return &api.DescribeClusterResponse{
 		WorkspaceClasses: classes,
+		AdmissionQueue:   admissionQueue,
 	}, nil
 }
 
@@ -1226,6 +1415,8 @@ func (f *filteringSubscriber) Send(resp *api.SubscribeResponse) error {
 	var md *api.WorkspaceMetadata
 	if sts := resp.GetStatus(); sts != nil {
 		md = sts.Metadata
+	} else if ss := resp.GetScheduledStart(); ss != nil {
+		md = ss.Metadata
 	}
 	if md == nil {
 		// no metadata, no forwarding
@@ -1407,6 +1598,15 @@ func (m *Manager) GetWorkspaces(ctx context.Context, req *api.GetWorkspacesReque
 		result = append(result, status)
 	}
 
+	// workspaces which wait for admission have no pod yet
+	for _, qs := range m.admission.Queue() {
+		status := m.getQueuedWorkspaceStatus(qs)
+		if !matchesMetadataFilter(req.MustMatch, status.Metadata) {
+			continue
+		}
+		result = append(result, status)
+	}
+
 	return &api.GetWorkspacesResponse{Status: result}, nil
 }
 
@@ -6,12 +6,15 @@ package manager
 
 import (
 	"context"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
 
 	ctesting "github.com/gitpod-io/gitpod/common-go/testing"
+	"github.com/gitpod-io/gitpod/common-go/util"
 	"github.com/gitpod-io/gitpod/ws-manager/api"
+	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
 	"github.com/gitpod-io/gitpod/ws-manager/pkg/manager/internal/grpcpool"
 	"google.golang.org/grpc"
 	corev1 "k8s.io/api/core/v1"
@@ -128,6 +131,79 @@ func TestControlPort(t *testing.T) {
 	test.Run()
 }
 
+func TestAddPortShareToken(t *testing.T) {
+	var (
+		issued = time.Now().Truncate(time.Millisecond)
+		expiry = time.Now().Add(time.Hour).Truncate(time.Second)
+		port   = uint32(8080)
+	)
+	shareLink, err := addPortShareToken("https://8080-ws-foo.gitpod.io/", "owner-token", "instance-id", port, issued, expiry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(shareLink)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Host != "8080-ws-foo.gitpod.io" || u.Path != "/" {
+		t.Errorf("share link %s does not point to the port URL", shareLink)
+	}
+
+	token := u.Query().Get(api.PortShareTokenParam)
+	act, err := api.VerifyPortShareToken("owner-token", "instance-id", port, token, issued, time.Now())
+	if err != nil {
+		t.Fatalf("cannot verify share token: %v", err)
+	}
+	if !act.Equal(expiry) {
+		t.Errorf("unexpected expiry: expected %v, got %v", expiry, act)
+	}
+	_, err = api.VerifyPortShareToken("owner-token", "instance-id", port, token, issued.Add(time.Millisecond), time.Now())
+	if err == nil {
+		t.Errorf("expected share token issued before its revocation to be rejected")
+	}
+
+	_, err = addPortShareToken("https://8080-ws-foo.gitpod.io/", "", "instance-id", port, issued, expiry)
+	if err == nil {
+		t.Errorf("expected an error for workspaces without owner token")
+	}
+}
+
+func TestPortShareLinkExpiry(t *testing.T) {
+	tests := []struct {
+		Name        string
+		MaxExpiry   time.Duration
+		Expiry      string
+		Expectation time.Duration
+		Error       string
+	}{
+		{Name: "default", Expectation: defaultPortShareLinkMaxExpiry},
+		{Name: "configured maximum", MaxExpiry: time.Hour, Expectation: time.Hour},
+		{Name: "requested", MaxExpiry: time.Hour, Expiry: "30m", Expectation: 30 * time.Minute},
+		{Name: "exceeds maximum", MaxExpiry: time.Hour, Expiry: "2h", Error: "must not exceed 1h0m0s"},
+		{Name: "negative", Expiry: "-1h", Error: "must be positive"},
+		{Name: "invalid", Expiry: "tomorrow", Error: "invalid share link expiry"},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			m := &Manager{Config: config.Configuration{PortShareLinkMaxExpiry: util.Duration(test.MaxExpiry)}}
+			act, err := m.portShareLinkExpiry(test.Expiry)
+			var msg string
+			if err != nil {
+				msg = err.Error()
+			}
+			if test.Error == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.Contains(msg, test.Error) {
+				t.Fatalf("expected error containing %q, but got %q", test.Error, msg)
+			}
+			if act != test.Expectation {
+				t.Errorf("unexpected expiry: expected %v, got %v", test.Expectation, act)
+			}
+		})
+	}
+}
+
 func TestGetWorkspaces(t *testing.T) {
 	t.Skipf("skipping flaky getWorkspaces_podOnly test")
 
@@ -54,9 +54,11 @@ type metrics struct {
 	totalUnintentionalWorkspaceStopCounterVec *prometheus.CounterVec
 	totalMountDeviceFailedVec                 *prometheus.CounterVec
 	totalCannotMountVolumeVec                 *prometheus.CounterVec
+	totalWarmPoolClaimsCounterVec             *prometheus.CounterVec
 
 	// Gauge
 	totalOpenPortGauge prometheus.GaugeFunc
+	warmPoolSizeGauge  *prometheus.GaugeVec
 
 	mu         sync.Mutex
 	phaseState map[string]api.WorkspacePhase
@@ -156,6 +158,18 @@ func newMetrics(m *Manager) *metrics {
 			Name:      "workspace_cannot_mount_volume",
 			Help:      "total number of workspace cannot mount volume",
 		}, []string{"type", "class"}),
+		totalWarmPoolClaimsCounterVec: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsWorkspaceSubsystem,
+			Name:      "warm_pool_claims_total",
+			Help:      "total number of workspace starts which tried to claim a placeholder pod of a warm pool",
+		}, []string{"class", "result"}),
+		warmPoolSizeGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsWorkspaceSubsystem,
+			Name:      "warm_pool_placeholders",
+			Help:      "current number of placeholder pods per warm pool",
+		}, []string{"class", "state"}),
 		totalOpenPortGauge: prometheus.NewGaugeFunc(prometheus.GaugeOpts{
 			Namespace: metricsNamespace,
 			Subsystem: metricsWorkspaceSubsystem,
@@ -221,7 +235,9 @@ func (m *metrics) Register(reg prometheus.Registerer) error {
 		m.totalUnintentionalWorkspaceStopCounterVec,
 		m.totalMountDeviceFailedVec,
 		m.totalCannotMountVolumeVec,
+		m.totalWarmPoolClaimsCounterVec,
 		m.totalOpenPortGauge,
+		m.warmPoolSizeGauge,
 	}
 	for _, c := range collectors {
 		err := reg.Register(c)
@@ -244,6 +260,26 @@ func (m *metrics) OnWorkspaceStarted(tpe api.WorkspaceType, class string) {
 	counter.Inc()
 }
 
+// OnWarmPoolClaim counts a workspace start which tried to claim a placeholder pod. The hit rate of a warm pool is
+// the ratio of hits to all claims.
+func (m *metrics) OnWarmPoolClaim(class string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	counter, err := m.totalWarmPoolClaimsCounterVec.GetMetricWithLabelValues(class, result)
+	if err != nil {
+		log.WithError(err).WithField("class", class).Warn("cannot get counter for warm pool claim metric")
+		return
+	}
+	counter.Inc()
+}
+
+func (m *metrics) OnWarmPoolReconciled(class string, ready, pending int) {
+	m.warmPoolSizeGauge.WithLabelValues(class, "ready").Set(float64(ready))
+	m.warmPoolSizeGauge.WithLabelValues(class, "pending").Set(float64(pending))
+}
+
 func (m *metrics) OnChange(status *api.WorkspaceStatus) {
 	var removeFromState bool
 	tpe := api.WorkspaceType_name[int32(status.Spec.Type)]
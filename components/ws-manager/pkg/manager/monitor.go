@@ -81,6 +81,9 @@ type Monitor struct {
 
 	act actingManager
 
+	// lastScheduleRun is the time schedules were last evaluated at
+	lastScheduleRun time.Time
+
 	OnError func(error)
 
 	eventRecorder record.EventRecorder
@@ -99,6 +102,8 @@ func (m *Manager) CreateMonitor() (*Monitor, error) {
 		ticker:   time.NewTicker(monitorInterval),
 		probeMap: make(map[string]context.CancelFunc),
 
+		lastScheduleRun: time.Now(),
+
 		OnError: func(err error) {
 			log.WithError(err).Error("workspace monitor error")
 		},
@@ -130,6 +135,11 @@ func (m *Monitor) Start() error {
 		log.WithError(err).Warn("cannot mark all existing workspaces active - this will wrongly time out user's workspaces")
 	}
 
+	err = m.manager.restoreQueuedStarts(context.Background())
+	if err != nil {
+		log.WithError(err).Error("cannot restore queued workspace starts")
+	}
+
 	go func() {
 		for range m.ticker.C {
 			m.doHousekeeping(context.Background())
@@ -243,6 +253,13 @@ func actOnPodEvent(ctx context.Context, m actingManager, manager *Manager, statu
 	}
 	span.LogKV("phase", status.Phase.String())
 
+	if manager.countWarmPoolClaim(pod, status.Phase) {
+		err = m.markWorkspace(ctx, workspaceID, deleteMark(warmPoolNodeAnnotation))
+		if err != nil {
+			log.WithError(err).Warn("cannot remove warm pool node annotation")
+		}
+	}
+
 	if status.Phase == api.WorkspacePhase_STOPPING || status.Phase == api.WorkspacePhase_STOPPED {
 		// Beware: do not else-if this condition with the other phases as we don't want the stop
 		//         login in any other phase, too.
@@ -496,7 +513,8 @@ func (m *Monitor) clearInitializerFromMap(podName string) {
 	m.initializerMap.Delete(podName)
 }
 
-// doHouskeeping is called regularly by the monitor and removes timed out or dangling workspaces/services
+// doHouskeeping is called regularly by the monitor and removes timed out or dangling workspaces/services.
+// It also keeps the warm pools of placeholder pods topped up and admits queued workspace starts.
 func (m *Monitor) doHousekeeping(ctx context.Context) {
 	span, ctx := tracing.FromContext(ctx, "doHousekeeping")
 	defer tracing.FinishSpan(span, nil)
@@ -505,6 +523,18 @@ func (m *Monitor) doHousekeeping(ctx context.Context) {
 	if err != nil {
 		m.OnError(err)
 	}
+
+	err = m.markScheduledWorkspaces(ctx)
+	if err != nil {
+		m.OnError(err)
+	}
+
+	err = m.manager.reconcileWarmPools(ctx)
+	if err != nil {
+		m.OnError(err)
+	}
+
+	m.manager.admission.Dispatch(ctx)
 }
 
 // writeEventTraceLog writes an event trace log if one is configured. This function is written in
@@ -1191,7 +1221,7 @@ func (m *Monitor) finalizeWorkspaceContent(ctx context.Context, wso *workspaceOb
 		} else if doSnapshot {
 			// if this is a prebuild take a snapshot and mark the workspace
 			var res *wsdaemon.TakeSnapshotResponse
-			res, err = snc.TakeSnapshot(ctx, &wsdaemon.TakeSnapshotRequest{Id: workspaceID})
+			res, err = snc.TakeSnapshot(ctx, &wsdaemon.TakeSnapshotRequest{Id: workspaceID, Prebuild: true})
 			if err != nil {
 				tracing.LogError(span, err)
 				log.WithError(err).Error("cannot take snapshot")
@@ -1222,6 +1252,8 @@ func (m *Monitor) finalizeWorkspaceContent(ctx context.Context, wso *workspaceOb
 			Id:         workspaceID,
 			Backup:     doBackup && !pvcFeatureEnabled,
 			BackupLogs: doBackupLogs,
+			// terminals are recorded in workspaces of any type
+			BackupRecordings: true,
 		})
 		if resp != nil {
 			gitStatus = resp.GitStatus
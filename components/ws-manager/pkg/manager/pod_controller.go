@@ -33,7 +33,8 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 	var pod corev1.Pod
 	err := r.Client.Get(context.Background(), req.NamespacedName, &pod)
 	if errors.IsNotFound(err) {
-		// pod is gone - that's ok
+		// pod is gone - that's ok, and might free room for queued workspace starts
+		go r.Monitor.manager.admission.Dispatch(context.Background())
 		return reconcile.Result{}, nil
 	}
 
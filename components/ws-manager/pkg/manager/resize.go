@@ -0,0 +1,408 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
+)
+
+// workspaceContainerName is the name of the container the workspace runs in
+const workspaceContainerName = "workspace"
+
+const (
+	// resizeGuardWorkspaceKey, resizeGuardClassKey and resizeGuardTimeKey record the last resize on a node in its resize guard
+	resizeGuardWorkspaceKey = "workspace"
+	resizeGuardClassKey     = "class"
+	resizeGuardTimeKey      = "time"
+)
+
+// classLimitAnnotations produces the annotations by which ws-daemon limits the resources of a workspace of a class
+func classLimitAnnotations(cls *config.WorkspaceClass) (map[string]string, error) {
+	res := make(map[string]string)
+	limits := cls.Container.Limits
+	if limits == nil {
+		return res, nil
+	}
+
+	if limits.CPU != nil {
+		if limits.CPU.MinLimit != "" {
+			res[wsk8s.WorkspaceCpuMinLimitAnnotation] = limits.CPU.MinLimit
+		}
+		if limits.CPU.BurstLimit != "" {
+			res[wsk8s.WorkspaceCpuBurstLimitAnnotation] = limits.CPU.BurstLimit
+		}
+	}
+	if limits.Memory != "" {
+		res[wsk8s.WorkspaceMemoryLimitAnnotation] = limits.Memory
+	}
+	if limits.IO != nil {
+		io, err := limits.IO.WorkspaceIOLimit()
+		if err != nil {
+			return nil, err
+		}
+		b, err := json.Marshal(io)
+		if err != nil {
+			return nil, err
+		}
+		res[wsk8s.WorkspaceIOLimitAnnotation] = string(b)
+	}
+	return res, nil
+}
+
+// ResizeWorkspace moves a running workspace to another workspace class without restarting it. ws-manager records the
+// new class and its limits on the workspace pod, from where ws-daemon applies them to the workspace's cgroups.
+// Kubernetes cannot change the resources of a running pod, hence the pod spec keeps the resources the workspace was
+// started with. ws-manager accounts for the requests of the recorded class when it checks the capacity of a node and
+// when it admits workspace starts, but kube-scheduler still sees the requests of the pod spec.
+func (m *Manager) ResizeWorkspace(ctx context.Context, req *api.ResizeWorkspaceRequest) (res *api.ResizeWorkspaceResponse, err error) {
+	span, ctx := tracing.FromContext(ctx, "ResizeWorkspace")
+	tracing.ApplyOWI(span, log.OWI("", "", req.Id))
+	tracing.LogRequestSafe(span, req)
+	defer tracing.FinishSpan(span, &err)
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	cls, ok := m.Config.WorkspaceClasses[req.Class]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "workspace class \"%s\" is unknown", req.Class)
+	}
+	limits, err := classLimitAnnotations(cls)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "workspace class %s has invalid limits: %v", req.Class, err)
+	}
+
+	pod, err := m.findWorkspacePod(ctx, req.Id)
+	if isKubernetesObjNotFoundError(err) {
+		return nil, status.Errorf(codes.NotFound, "workspace %s does not exist", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get workspace pod: %q", err)
+	}
+	tracing.ApplyOWI(span, wsk8s.GetOWIFromObject(&pod.ObjectMeta))
+
+	if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning || pod.Spec.NodeName == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "workspace %s is not running", req.Id)
+	}
+	current := pod.Labels[workspaceClassLabel]
+	if current == req.Class {
+		return &api.ResizeWorkspaceResponse{}, nil
+	}
+	if cur, ok := m.Config.WorkspaceClasses[current]; ok {
+		err = checkStorageUnchanged(cur, cls, pod)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot resize workspace %s from class %s to %s: %v", req.Id, current, req.Class, err)
+		}
+	}
+	previousLimits := make(map[string]string)
+	for _, a := range classLimitAnnotationNames {
+		if v, ok := pod.Annotations[a]; ok {
+			previousLimits[a] = v
+		}
+	}
+	previousResize := pod.Annotations[workspaceResizedAnnotation]
+
+	// Resizes of workspaces on the same node are serialised through the resize guard of the node, which every resize
+	// updates after it changed the workspace pod. If another resize updated the guard while we checked the capacity
+	// of the node, our resize is reverted and checked again.
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		guard, err := m.getResizeGuard(ctx, pod.Spec.NodeName)
+		if err != nil {
+			return err
+		}
+		err = m.checkNodeCapacity(ctx, pod, req.Class, cls)
+		if err != nil {
+			return err
+		}
+		span.LogKV("event", "capacity checked", "from", current, "to", req.Class, "node", pod.Spec.NodeName)
+
+		now := time.Now().UTC().Format(time.RFC3339Nano)
+		err = m.setWorkspaceClass(ctx, req.Id, current, req.Class, limits, now)
+		if err != nil {
+			return err
+		}
+
+		guard.Data = map[string]string{
+			resizeGuardWorkspaceKey: req.Id,
+			resizeGuardClassKey:     req.Class,
+			resizeGuardTimeKey:      now,
+		}
+		err = m.Clientset.Update(ctx, guard)
+		if !k8serr.IsConflict(err) {
+			return err
+		}
+
+		span.LogKV("event", "concurrent resize on node", "node", pod.Spec.NodeName)
+		rerr := m.setWorkspaceClass(ctx, req.Id, req.Class, current, previousLimits, previousResize)
+		if rerr != nil {
+			return status.Errorf(codes.Internal, "cannot revert resize of workspace %s after a concurrent resize: %v", req.Id, rerr)
+		}
+		return err
+	})
+	if _, ok := status.FromError(err); ok && err != nil {
+		return nil, err
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot resize workspace %s: %v", req.Id, err)
+	}
+
+	log.WithFields(wsk8s.GetOWIFromObject(&pod.ObjectMeta)).WithField("from", current).WithField("to", req.Class).Info("resized workspace")
+	return &api.ResizeWorkspaceResponse{}, nil
+}
+
+// classLimitAnnotationNames are the annotations produced by classLimitAnnotations
+var classLimitAnnotationNames = []string{
+	wsk8s.WorkspaceCpuMinLimitAnnotation,
+	wsk8s.WorkspaceCpuBurstLimitAnnotation,
+	wsk8s.WorkspaceMemoryLimitAnnotation,
+	wsk8s.WorkspaceIOLimitAnnotation,
+}
+
+// setWorkspaceClass records a workspace class, its limits and the time of the resize on a workspace pod, provided
+// the pod still has the class from. An empty resizedAt removes the time of the resize from the pod.
+func (m *Manager) setWorkspaceClass(ctx context.Context, id, from, to string, limits map[string]string, resizedAt string) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		pod, err := m.findWorkspacePod(ctx, id)
+		if err != nil {
+			return err
+		}
+		if pod.Labels[workspaceClassLabel] != from {
+			return status.Errorf(codes.Aborted, "workspace %s was resized concurrently", id)
+		}
+
+		pod.Labels[workspaceClassLabel] = to
+		for _, a := range classLimitAnnotationNames {
+			delete(pod.Annotations, a)
+		}
+		for k, v := range limits {
+			pod.Annotations[k] = v
+		}
+		if resizedAt == "" {
+			delete(pod.Annotations, workspaceResizedAnnotation)
+		} else {
+			pod.Annotations[workspaceResizedAnnotation] = resizedAt
+		}
+
+		// do not wrap error so we don't break the retry mechanism
+		return m.Clientset.Update(ctx, pod)
+	})
+}
+
+// resizeGuardName is the name of the config map which serialises the resizes of the workspaces on a node
+func resizeGuardName(node string) string {
+	return "ws-resize-" + node
+}
+
+// getResizeGuard returns the resize guard of a node, creating it if it does not exist yet. The guard is owned by
+// the node, such that it is removed together with the node.
+func (m *Manager) getResizeGuard(ctx context.Context, nodeName string) (*corev1.ConfigMap, error) {
+	var guard corev1.ConfigMap
+	err := m.Clientset.Get(ctx, types.NamespacedName{Namespace: m.Config.Namespace, Name: resizeGuardName(nodeName)}, &guard)
+	if err == nil {
+		return &guard, nil
+	}
+	if !k8serr.IsNotFound(err) {
+		return nil, status.Errorf(codes.Internal, "cannot get resize guard of node %s: %v", nodeName, err)
+	}
+
+	node, err := m.RawClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get node %s: %v", nodeName, err)
+	}
+	guard = corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resizeGuardName(nodeName),
+			Namespace: m.Config.Namespace,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "v1",
+				Kind:       "Node",
+				Name:       node.Name,
+				UID:        node.UID,
+			}},
+		},
+	}
+	err = m.Clientset.Create(ctx, &guard)
+	if k8serr.IsAlreadyExists(err) {
+		// another resize created the guard in the meantime - we try again with that one
+		return nil, k8serr.NewConflict(corev1.Resource("configmaps"), guard.Name, err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot create resize guard of node %s: %v", nodeName, err)
+	}
+	return &guard, nil
+}
+
+// checkStorageUnchanged returns an error if a workspace would get a different storage when moving between two classes.
+// ws-daemon sets up the storage quota or volume of a workspace when it starts, and cannot change it thereafter.
+func checkStorageUnchanged(from, to *config.WorkspaceClass, pod *corev1.Pod) error {
+	fromStorage, err := classStorageQuantity(from)
+	if err != nil {
+		return err
+	}
+	toStorage, err := classStorageQuantity(to)
+	if err != nil {
+		return err
+	}
+	if fromStorage.Cmp(toStorage) != 0 {
+		return xerrors.Errorf("the storage of a running workspace cannot be resized from %s to %s", fromStorage.String(), toStorage.String())
+	}
+
+	if _, ok := pod.Labels[pvcWorkspaceFeatureLabel]; ok {
+		if from.PVC.Size.Cmp(to.PVC.Size) != 0 || from.PVC.StorageClass != to.PVC.StorageClass || from.PVC.SnapshotClass != to.PVC.SnapshotClass {
+			return xerrors.Errorf("the persistent volume of a running workspace cannot be changed")
+		}
+	}
+	return nil
+}
+
+func classStorageQuantity(cls *config.WorkspaceClass) (resource.Quantity, error) {
+	if cls.Container.Limits == nil {
+		return resource.Quantity{}, nil
+	}
+	return cls.Container.Limits.StorageQuantity()
+}
+
+// checkNodeCapacity returns a ResourceExhausted error if the node of a workspace pod cannot accommodate the requests
+// of a workspace class in addition to the requests of all other pods on the node.
+func (m *Manager) checkNodeCapacity(ctx context.Context, pod *corev1.Pod, class string, cls *config.WorkspaceClass) error {
+	nodeName := pod.Spec.NodeName
+	node, err := m.RawClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot get node %s: %v", nodeName, err)
+	}
+	pods, err := m.RawClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot list pods of node %s: %v", nodeName, err)
+	}
+
+	required, err := cls.Container.Requests.ResourceList()
+	if err != nil {
+		return status.Errorf(codes.Internal, "workspace class has invalid requests: %v", err)
+	}
+	var (
+		others    = make(corev1.ResourceList)
+		current   corev1.ResourceList
+		requested corev1.ResourceList
+	)
+	for i := range pods.Items {
+		p := &pods.Items[i]
+		if p.Status.Phase == corev1.PodSucceeded || p.Status.Phase == corev1.PodFailed {
+			continue
+		}
+
+		reqs, err := m.podRequests(p, nil)
+		if err != nil {
+			return status.Errorf(codes.Internal, "cannot compute requests of pod %s: %v", p.Name, err)
+		}
+		if p.UID != pod.UID {
+			addResources(others, reqs)
+			continue
+		}
+
+		current = reqs
+		requested, err = m.podRequests(p, required)
+		if err != nil {
+			return status.Errorf(codes.Internal, "cannot compute requests of pod %s: %v", p.Name, err)
+		}
+	}
+	if requested == nil {
+		// the pod was not listed, e.g. because it was just deleted
+		return status.Errorf(codes.FailedPrecondition, "workspace pod %s is not running on node %s", pod.Name, nodeName)
+	}
+
+	var missing []string
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory, corev1.ResourceEphemeralStorage} {
+		r, c := requested[name], current[name]
+		if r.Cmp(c) <= 0 {
+			// shrinking always fits, even if the node is overcommitted already
+			continue
+		}
+		allocatable, ok := node.Status.Allocatable[name]
+		if !ok {
+			continue
+		}
+
+		available := allocatable.DeepCopy()
+		available.Sub(others[name])
+		if r.Cmp(available) <= 0 {
+			continue
+		}
+		if available.Sign() < 0 {
+			available = resource.Quantity{}
+		}
+		missing = append(missing, fmt.Sprintf("%s %s requested, but only %s available", name, r.String(), available.String()))
+	}
+	if len(missing) > 0 {
+		return status.Errorf(codes.ResourceExhausted, "workspace class %s does not fit on node %s: %s", class, nodeName, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// podRequests returns the resources requested by the containers of a pod. The workspace container of workspace pods
+// requests the resources of the class recorded on the pod, which differ from the pod spec once the workspace was
+// resized. If override is not nil, it replaces the requests of the workspace container.
+func (m *Manager) podRequests(pod *corev1.Pod, override corev1.ResourceList) (corev1.ResourceList, error) {
+	isWorkspace := pod.Namespace == m.Config.Namespace && pod.Labels[markerLabel] == "true"
+	if isWorkspace && override == nil {
+		reqs, err := m.workspaceRequests(pod)
+		if err != nil {
+			return nil, err
+		}
+		override = reqs
+	}
+
+	res := make(corev1.ResourceList)
+	for _, c := range pod.Spec.Containers {
+		if isWorkspace && c.Name == workspaceContainerName && override != nil {
+			addResources(res, override)
+			continue
+		}
+		addResources(res, c.Resources.Requests)
+	}
+	return res, nil
+}
+
+// workspaceRequests returns the resources requested by the workspace container of a workspace pod, which are those
+// of the class recorded on the pod rather than those of the pod spec once the workspace was resized.
+func (m *Manager) workspaceRequests(pod *corev1.Pod) (corev1.ResourceList, error) {
+	if cls, ok := m.Config.WorkspaceClasses[pod.Labels[workspaceClassLabel]]; ok {
+		return cls.Container.Requests.ResourceList()
+	}
+	if c := getContainer(pod, workspaceContainerName); c != nil {
+		return c.Resources.Requests, nil
+	}
+	return nil, nil
+}
+
+func addResources(dst, src corev1.ResourceList) {
+	for name, q := range src {
+		v := dst[name]
+		v.Add(q)
+		dst[name] = v
+	}
+}
@@ -0,0 +1,320 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package manager
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
+)
+
+func TestResizeWorkspace(t *testing.T) {
+	classes := map[string]*config.WorkspaceClass{
+		config.DefaultWorkspaceClass: {
+			Container: config.ContainerConfiguration{
+				Requests: &config.ResourceRequestConfiguration{CPU: "1", Memory: "2Gi"},
+				Limits: &config.ResourceLimitConfiguration{
+					CPU:    &config.CpuResourceLimit{MinLimit: "1", BurstLimit: "2"},
+					Memory: "4Gi",
+				},
+			},
+		},
+		"large": {
+			Container: config.ContainerConfiguration{
+				Requests: &config.ResourceRequestConfiguration{CPU: "2", Memory: "4Gi"},
+				Limits: &config.ResourceLimitConfiguration{
+					CPU:    &config.CpuResourceLimit{MinLimit: "2", BurstLimit: "4"},
+					Memory: "8Gi",
+					IO:     &config.IOResourceLimit{WriteBandwidthPerSecond: "100Mi", ReadIOPS: 1000},
+				},
+			},
+		},
+		"huge": {
+			Container: config.ContainerConfiguration{
+				Requests: &config.ResourceRequestConfiguration{CPU: "6", Memory: "4Gi"},
+			},
+		},
+		"small": {
+			Container: config.ContainerConfiguration{
+				Requests: &config.ResourceRequestConfiguration{CPU: "500m", Memory: "1Gi"},
+			},
+		},
+		"more-storage": {
+			Container: config.ContainerConfiguration{
+				Requests: &config.ResourceRequestConfiguration{CPU: "1", Memory: "2Gi"},
+				Limits:   &config.ResourceLimitConfiguration{Storage: "50Gi"},
+			},
+		},
+	}
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("5"),
+				corev1.ResourceMemory: resource.MustParse("16Gi"),
+			},
+		},
+	}
+	workspacePod := func(id, class string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ws-" + id,
+				Namespace: "default",
+				UID:       types.UID("uid-" + id),
+				Labels: map[string]string{
+					markerLabel:            "true",
+					wsk8s.WorkspaceIDLabel: id,
+					workspaceClassLabel:    class,
+				},
+				Annotations: map[string]string{
+					workspaceIDAnnotation:                "id",
+					wsk8s.WorkspaceCpuMinLimitAnnotation: "1",
+				},
+			},
+			Spec: corev1.PodSpec{
+				NodeName: node.Name,
+				Containers: []corev1.Container{
+					{
+						Name: workspaceContainerName,
+						Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("1"),
+							corev1.ResourceMemory: resource.MustParse("2Gi"),
+						}},
+					},
+				},
+			},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+	// another workspace which was resized to the large class before and a pod which is not a workspace
+	otherWorkspace := workspacePod("other", "large", corev1.PodRunning)
+	daemon := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "ws-daemon", Namespace: "kube-system", UID: "uid-daemon"},
+		Spec: corev1.PodSpec{
+			NodeName: node.Name,
+			Containers: []corev1.Container{{
+				Name:      workspaceContainerName,
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")}},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+
+	type Expectation struct {
+		Code        codes.Code
+		Message     string
+		Class       string
+		Annotations map[string]string
+	}
+	tests := []struct {
+		Name        string
+		Pod         *corev1.Pod
+		Class       string
+		Expectation Expectation
+	}{
+		{
+			Name:  "grow",
+			Pod:   workspacePod("ws", config.DefaultWorkspaceClass, corev1.PodRunning),
+			Class: "large",
+			Expectation: Expectation{
+				Class: "large",
+				Annotations: map[string]string{
+					wsk8s.WorkspaceCpuMinLimitAnnotation:   "2",
+					wsk8s.WorkspaceCpuBurstLimitAnnotation: "4",
+					wsk8s.WorkspaceMemoryLimitAnnotation:   "8Gi",
+					wsk8s.WorkspaceIOLimitAnnotation:       `{"writeBytesPerSecond":104857600,"readIOPS":1000}`,
+				},
+			},
+		},
+		{
+			Name:  "shrink removes limits the class does not have",
+			Pod:   workspacePod("ws", config.DefaultWorkspaceClass, corev1.PodRunning),
+			Class: "small",
+			Expectation: Expectation{
+				Class:       "small",
+				Annotations: map[string]string{},
+			},
+		},
+		{
+			Name:  "same class",
+			Pod:   workspacePod("ws", config.DefaultWorkspaceClass, corev1.PodRunning),
+			Class: config.DefaultWorkspaceClass,
+			Expectation: Expectation{
+				Class:       config.DefaultWorkspaceClass,
+				Annotations: map[string]string{wsk8s.WorkspaceCpuMinLimitAnnotation: "1"},
+			},
+		},
+		{
+			Name:  "does not fit",
+			Pod:   workspacePod("ws", config.DefaultWorkspaceClass, corev1.PodRunning),
+			Class: "huge",
+			Expectation: Expectation{
+				Code:    codes.ResourceExhausted,
+				Message: "workspace class huge does not fit on node node-1: cpu 6 requested, but only 2500m available",
+			},
+		},
+		{
+			Name:  "storage differs",
+			Pod:   workspacePod("ws", config.DefaultWorkspaceClass, corev1.PodRunning),
+			Class: "more-storage",
+			Expectation: Expectation{
+				Code:    codes.FailedPrecondition,
+				Message: "the storage of a running workspace cannot be resized",
+			},
+		},
+		{
+			Name:  "not running",
+			Pod:   workspacePod("ws", config.DefaultWorkspaceClass, corev1.PodPending),
+			Class: "large",
+			Expectation: Expectation{
+				Code:    codes.FailedPrecondition,
+				Message: "workspace ws is not running",
+			},
+		},
+		{
+			Name:  "unknown class",
+			Pod:   workspacePod("ws", config.DefaultWorkspaceClass, corev1.PodRunning),
+			Class: "unknown",
+			Expectation: Expectation{
+				Code:    codes.InvalidArgument,
+				Message: "workspace class \"unknown\" is unknown",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			cfg := forTestingOnlyManagerConfig()
+			cfg.WorkspaceClasses = classes
+			m := &Manager{
+				Config:    cfg,
+				Clientset: fake.NewClientBuilder().WithObjects(test.Pod, otherWorkspace).Build(),
+				RawClient: k8sfake.NewSimpleClientset(node, test.Pod, otherWorkspace, daemon),
+			}
+
+			ctx := context.Background()
+			_, err := m.ResizeWorkspace(ctx, &api.ResizeWorkspaceRequest{Id: "ws", Class: test.Class})
+
+			var act Expectation
+			if err != nil {
+				act.Code = status.Code(err)
+				act.Message = status.Convert(err).Message()
+				if strings.Contains(act.Message, test.Expectation.Message) {
+					act.Message = test.Expectation.Message
+				}
+			} else {
+				var pod corev1.Pod
+				err = m.Clientset.Get(ctx, client.ObjectKeyFromObject(test.Pod), &pod)
+				if err != nil {
+					t.Fatal(err)
+				}
+				act.Class = pod.Labels[workspaceClassLabel]
+				act.Annotations = make(map[string]string)
+				for k, v := range pod.Annotations {
+					if k == workspaceIDAnnotation {
+						continue
+					}
+					if k == workspaceResizedAnnotation {
+						if test.Class == test.Pod.Labels[workspaceClassLabel] {
+							t.Errorf("did not expect a resize to the same class to be recorded")
+						}
+						continue
+					}
+					act.Annotations[k] = v
+				}
+			}
+
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("concurrent resize on the node", func(t *testing.T) {
+		cfg := forTestingOnlyManagerConfig()
+		cfg.WorkspaceClasses = classes
+		pod := workspacePod("ws", config.DefaultWorkspaceClass, corev1.PodRunning)
+		clientset := &concurrentResizeClient{Client: fake.NewClientBuilder().WithObjects(pod, otherWorkspace).Build()}
+		m := &Manager{
+			Config:    cfg,
+			Clientset: clientset,
+			RawClient: k8sfake.NewSimpleClientset(node, pod, otherWorkspace, daemon),
+		}
+
+		ctx := context.Background()
+		_, err := m.ResizeWorkspace(ctx, &api.ResizeWorkspaceRequest{Id: "ws", Class: "large"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if clientset.Reverted != 1 {
+			t.Errorf("expected the resize to be reverted once, but it was reverted %d times", clientset.Reverted)
+		}
+
+		var guard corev1.ConfigMap
+		err = m.Clientset.Get(ctx, types.NamespacedName{Namespace: cfg.Namespace, Name: resizeGuardName(node.Name)}, &guard)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if guard.Data[resizeGuardWorkspaceKey] != "ws" || guard.Data[resizeGuardClassKey] != "large" {
+			t.Errorf("unexpected resize guard: %v", guard.Data)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		m := &Manager{
+			Config:    forTestingOnlyManagerConfig(),
+			Clientset: fake.NewClientBuilder().Build(),
+			RawClient: k8sfake.NewSimpleClientset(node),
+		}
+		_, err := m.ResizeWorkspace(context.Background(), &api.ResizeWorkspaceRequest{Id: "ws", Class: config.DefaultWorkspaceClass})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("expected NotFound, but got %v", err)
+		}
+	})
+}
+
+// concurrentResizeClient updates the resize guard behind the back of the first resize which updates it, and counts
+// how often a workspace pod is moved back to the default class
+type concurrentResizeClient struct {
+	client.Client
+
+	updatedGuard bool
+	Reverted     int
+}
+
+func (c *concurrentResizeClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	switch o := obj.(type) {
+	case *corev1.ConfigMap:
+		if !c.updatedGuard {
+			c.updatedGuard = true
+			other := o.DeepCopy()
+			other.Data = map[string]string{resizeGuardWorkspaceKey: "other"}
+			err := c.Client.Update(ctx, other)
+			if err != nil {
+				return err
+			}
+		}
+	case *corev1.Pod:
+		if o.Labels[workspaceClassLabel] == config.DefaultWorkspaceClass {
+			c.Reverted++
+		}
+	}
+	return c.Client.Update(ctx, obj, opts...)
+}
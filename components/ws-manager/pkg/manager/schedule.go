@@ -0,0 +1,499 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+)
+
+const (
+	// workspaceScheduleLabel marks the config maps in which ws-manager persists workspace schedules. Every schedule
+	// has a config map of its own so that concurrent changes to different schedules don't conflict.
+	workspaceScheduleLabel = "gitpod.io/workspaceSchedule"
+
+	// workspaceScheduleKey is the key of the serialized schedule in a schedule config map
+	workspaceScheduleKey = "schedule"
+)
+
+// workspaceSchedule is a parsed schedule of a workspace or owner
+type workspaceSchedule struct {
+	Owner  string
+	MetaID string
+	Spec   *api.WorkspaceSchedule
+
+	location     *time.Location
+	preStart     []cron.Schedule
+	stopWindows  []scheduleWindow
+	workingHours []scheduleWindow
+}
+
+// scheduleWindow is a recurring period of time which opens at Start and lasts for Duration
+type scheduleWindow struct {
+	Start    cron.Schedule
+	Duration time.Duration
+}
+
+// parseWorkspaceSchedule validates a schedule and parses its cron expressions
+func parseWorkspaceSchedule(owner, metaID string, spec *api.WorkspaceSchedule) (*workspaceSchedule, error) {
+	res := &workspaceSchedule{
+		Owner:    owner,
+		MetaID:   metaID,
+		Spec:     spec,
+		location: time.UTC,
+	}
+	if tz := spec.GetTimezone(); tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid timezone %s: %w", tz, err)
+		}
+		res.location = loc
+	}
+
+	if metaID == "" && len(spec.GetPreStart()) > 0 {
+		return nil, xerrors.Errorf("pre-starts require a workspace")
+	}
+	for _, spec := range spec.GetPreStart() {
+		sched, err := cron.ParseStandard(spec)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid pre-start %q: %w", spec, err)
+		}
+		res.preStart = append(res.preStart, sched)
+	}
+
+	parseWindows := func(name string, windows []*api.ScheduleWindow) ([]scheduleWindow, error) {
+		var res []scheduleWindow
+		for _, w := range windows {
+			sched, err := cron.ParseStandard(w.Start)
+			if err != nil {
+				return nil, xerrors.Errorf("invalid %s start %q: %w", name, w.Start, err)
+			}
+			dur, err := time.ParseDuration(w.Duration)
+			if err != nil {
+				return nil, xerrors.Errorf("invalid %s duration %q: %w", name, w.Duration, err)
+			}
+			if dur <= 0 {
+				return nil, xerrors.Errorf("invalid %s duration %q: must be positive", name, w.Duration)
+			}
+			res = append(res, scheduleWindow{Start: sched, Duration: dur})
+		}
+		return res, nil
+	}
+	var err error
+	res.stopWindows, err = parseWindows("stop window", spec.GetStopWindows())
+	if err != nil {
+		return nil, err
+	}
+	res.workingHours, err = parseWindows("working hours", spec.GetWorkingHours())
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// isEmptySchedule returns true if a schedule has no effect
+func isEmptySchedule(spec *api.WorkspaceSchedule) bool {
+	return len(spec.GetPreStart()) == 0 && len(spec.GetStopWindows()) == 0 && len(spec.GetWorkingHours()) == 0
+}
+
+// activeWindow returns the end of the window in which t lies, if any
+func (s *workspaceSchedule) activeWindow(windows []scheduleWindow, t time.Time) (end time.Time, ok bool) {
+	t = t.In(s.location)
+	for _, w := range windows {
+		// the window is open if it started within the last Duration
+		start := w.Start.Next(t.Add(-w.Duration))
+		if start.IsZero() || start.After(t) {
+			continue
+		}
+		if e := start.Add(w.Duration); e.After(end) {
+			end = e
+			ok = true
+		}
+	}
+	return
+}
+
+// InStopWindow returns true and the time until which the workspace must not run if t lies in a stop window
+func (s *workspaceSchedule) InStopWindow(t time.Time) (until time.Time, ok bool) {
+	if s == nil {
+		return
+	}
+	return s.activeWindow(s.stopWindows, t)
+}
+
+// InWorkingHours returns true if t lies within the working hours of the schedule
+func (s *workspaceSchedule) InWorkingHours(t time.Time) bool {
+	if s == nil {
+		return false
+	}
+	_, ok := s.activeWindow(s.workingHours, t)
+	return ok
+}
+
+// nextOf returns the earliest time after t at which one of the schedules fires, or the zero time if none does
+func (s *workspaceSchedule) nextOf(scheds []cron.Schedule, t time.Time) (res time.Time) {
+	t = t.In(s.location)
+	for _, sched := range scheds {
+		next := sched.Next(t)
+		if next.IsZero() {
+			continue
+		}
+		if res.IsZero() || next.Before(res) {
+			res = next
+		}
+	}
+	return res
+}
+
+// NextPreStart returns the next time after t at which the workspace should be pre-started
+func (s *workspaceSchedule) NextPreStart(t time.Time) time.Time {
+	if s == nil {
+		return time.Time{}
+	}
+	return s.nextOf(s.preStart, t)
+}
+
+// NextStop returns the next time after t at which a stop window opens
+func (s *workspaceSchedule) NextStop(t time.Time) time.Time {
+	if s == nil {
+		return time.Time{}
+	}
+	starts := make([]cron.Schedule, len(s.stopWindows))
+	for i, w := range s.stopWindows {
+		starts[i] = w.Start
+	}
+	return s.nextOf(starts, t)
+}
+
+// PreStartDue returns true if a pre-start was scheduled in (from, to]
+func (s *workspaceSchedule) PreStartDue(from, to time.Time) bool {
+	next := s.NextPreStart(from)
+	return !next.IsZero() && !next.After(to)
+}
+
+// Status describes the schedule at time t
+func (s *workspaceSchedule) Status(t time.Time) *api.WorkspaceScheduleStatus {
+	if s == nil {
+		return nil
+	}
+	res := &api.WorkspaceScheduleStatus{
+		Schedule:     s.Spec,
+		WorkingHours: s.InWorkingHours(t),
+	}
+	if next := s.NextStop(t); !next.IsZero() {
+		res.NextStop = timestamppb.New(next)
+	}
+	if next := s.NextPreStart(t); !next.IsZero() {
+		res.NextPreStart = timestamppb.New(next)
+	}
+	return res
+}
+
+// workspaceSchedules are all schedules known to ws-manager
+type workspaceSchedules struct {
+	Owner     map[string]*workspaceSchedule
+	Workspace map[string]*workspaceSchedule
+}
+
+// For returns the schedule which applies to a workspace. Schedules of the workspace take precedence over those of its owner.
+func (s *workspaceSchedules) For(owner, metaID string) *workspaceSchedule {
+	if s == nil {
+		return nil
+	}
+	if res, ok := s.Workspace[metaID]; ok && metaID != "" {
+		return res
+	}
+	return s.Owner[owner]
+}
+
+// With returns a copy of the schedules in which the schedule of a workspace or owner is replaced.
+// A nil schedule removes it.
+func (s *workspaceSchedules) With(owner, metaID string, sched *workspaceSchedule) *workspaceSchedules {
+	res := newWorkspaceSchedules()
+	if s != nil {
+		for k, v := range s.Owner {
+			res.Owner[k] = v
+		}
+		for k, v := range s.Workspace {
+			res.Workspace[k] = v
+		}
+	}
+
+	idx, key := res.Owner, owner
+	if metaID != "" {
+		idx, key = res.Workspace, metaID
+	}
+	if sched == nil {
+		delete(idx, key)
+	} else {
+		idx[key] = sched
+	}
+	return res
+}
+
+func newWorkspaceSchedules() *workspaceSchedules {
+	return &workspaceSchedules{
+		Owner:     make(map[string]*workspaceSchedule),
+		Workspace: make(map[string]*workspaceSchedule),
+	}
+}
+
+// scheduleConfigMapName returns the name of the config map a schedule is persisted in
+func scheduleConfigMapName(owner, metaID string) string {
+	if metaID != "" {
+		return "ws-schedule-workspace-" + metaID
+	}
+	return "ws-schedule-owner-" + owner
+}
+
+// parseScheduleConfigMap parses a schedule persisted in a config map
+func parseScheduleConfigMap(cm *corev1.ConfigMap) (*workspaceSchedule, error) {
+	var req api.SetScheduleRequest
+	err := protojson.Unmarshal([]byte(cm.Data[workspaceScheduleKey]), &req)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot unmarshal schedule: %w", err)
+	}
+	return parseWorkspaceSchedule(req.Owner, req.MetaId, req.Schedule)
+}
+
+// loadSchedules reads the persisted schedules and updates the manager's view on them
+func (m *Manager) loadSchedules(ctx context.Context) (*workspaceSchedules, error) {
+	var cms corev1.ConfigMapList
+	err := m.Clientset.List(ctx, &cms,
+		client.InNamespace(m.Config.Namespace),
+		client.MatchingLabels{workspaceScheduleLabel: "true"},
+	)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot load workspace schedules: %w", err)
+	}
+
+	res := newWorkspaceSchedules()
+	for i := range cms.Items {
+		cm := &cms.Items[i]
+		sched, err := parseScheduleConfigMap(cm)
+		if err != nil {
+			log.WithError(err).WithField("configMap", cm.Name).Warn("persisted workspace schedule is invalid - ignoring it")
+			continue
+		}
+		if sched.MetaID != "" {
+			res.Workspace[sched.MetaID] = sched
+		} else {
+			res.Owner[sched.Owner] = sched
+		}
+	}
+
+	m.setSchedules(res)
+	return res, nil
+}
+
+func (m *Manager) setSchedules(s *workspaceSchedules) {
+	m.schedulesLock.Lock()
+	defer m.schedulesLock.Unlock()
+	m.schedules = s
+}
+
+// getSchedules returns the last known schedules. This function does not do any I/O and can be used from within
+// functions which must not talk to Kubernetes.
+func (m *Manager) getSchedules() *workspaceSchedules {
+	m.schedulesLock.RLock()
+	defer m.schedulesLock.RUnlock()
+	return m.schedules
+}
+
+// getWorkspaceSchedule returns the last known schedule which applies to a workspace pod
+func (m *Manager) getWorkspaceSchedule(pod *corev1.Pod) *workspaceSchedule {
+	return m.getSchedules().For(pod.Labels[wsk8s.OwnerLabel], pod.Labels[wsk8s.MetaIDLabel])
+}
+
+// SetSchedule attaches a start and stop schedule to a workspace or to all workspaces of an owner
+func (m *Manager) SetSchedule(ctx context.Context, req *api.SetScheduleRequest) (res *api.SetScheduleResponse, err error) {
+	span, ctx := tracing.FromContext(ctx, "SetSchedule")
+	tracing.ApplyOWI(span, log.OWI(req.Owner, req.MetaId, ""))
+	defer tracing.FinishSpan(span, &err)
+
+	if req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner is required")
+	}
+
+	name := scheduleConfigMapName(req.Owner, req.MetaId)
+	if isEmptySchedule(req.Schedule) {
+		err = m.Clientset.Delete(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: m.Config.Namespace,
+			},
+		})
+		if err != nil && !k8serr.IsNotFound(err) {
+			return nil, status.Errorf(codes.Internal, "cannot remove schedule: %v", err)
+		}
+
+		m.setSchedules(m.getSchedules().With(req.Owner, req.MetaId, nil))
+		return &api.SetScheduleResponse{}, nil
+	}
+
+	sched, err := parseWorkspaceSchedule(req.Owner, req.MetaId, req.Schedule)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schedule: %v", err)
+	}
+	value, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot serialise schedule: %v", err)
+	}
+	labels := map[string]string{
+		workspaceScheduleLabel: "true",
+		wsk8s.OwnerLabel:       req.Owner,
+	}
+	if req.MetaId != "" {
+		labels[wsk8s.MetaIDLabel] = req.MetaId
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() (err error) {
+		var cm corev1.ConfigMap
+		err = m.Clientset.Get(ctx, types.NamespacedName{Namespace: m.Config.Namespace, Name: name}, &cm)
+		if k8serr.IsNotFound(err) {
+			err = m.Clientset.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: m.Config.Namespace,
+					Labels:    labels,
+				},
+				Data: map[string]string{workspaceScheduleKey: string(value)},
+			})
+			if k8serr.IsAlreadyExists(err) {
+				// someone else created the config map in the meantime - try again and update it
+				return k8serr.NewConflict(corev1.Resource("configmaps"), name, err)
+			}
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		cm.Labels = labels
+		cm.Data = map[string]string{workspaceScheduleKey: string(value)}
+		// do not wrap error so we don't break the retry mechanism
+		return m.Clientset.Update(ctx, &cm)
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot persist schedule: %v", err)
+	}
+
+	// the client reads from a cache which might not have seen the update yet
+	m.setSchedules(m.getSchedules().With(req.Owner, req.MetaId, sched))
+
+	return &api.SetScheduleResponse{}, nil
+}
+
+// markScheduledWorkspaces stops workspaces which run during one of their stop windows and announces the workspaces
+// whose pre-start is due to subscribers, so that server starts them. The pre-starts which were due since the last
+// successful run are considered, hence an announcement is repeated if marking a workspace failed. Workspaces which
+// run already are not announced again.
+func (m *Monitor) markScheduledWorkspaces(ctx context.Context) (err error) {
+	span, ctx := tracing.FromContext(ctx, "markScheduledWorkspaces")
+	defer tracing.FinishSpan(span, &err)
+
+	now := time.Now()
+	from := m.lastScheduleRun
+
+	schedules, err := m.manager.loadSchedules(ctx)
+	if err != nil {
+		return err
+	}
+	if len(schedules.Owner) == 0 && len(schedules.Workspace) == 0 {
+		m.lastScheduleRun = now
+		return nil
+	}
+
+	var pods corev1.PodList
+	err = m.manager.Clientset.List(ctx, &pods, workspaceObjectListOptions(m.manager.Config.Namespace))
+	if err != nil {
+		return xerrors.Errorf("markScheduledWorkspaces: %w", err)
+	}
+
+	var (
+		errs          []string
+		runningMetaID = make(map[string]struct{})
+	)
+	for _, pod := range pods.Items {
+		workspaceID, ok := pod.Annotations[workspaceIDAnnotation]
+		if !ok {
+			continue
+		}
+		if pod.Labels[wsk8s.TypeLabel] != strings.ToLower(api.WorkspaceType_REGULAR.String()) {
+			continue
+		}
+		owner, metaID := pod.Labels[wsk8s.OwnerLabel], pod.Labels[wsk8s.MetaIDLabel]
+		runningMetaID[metaID] = struct{}{}
+
+		if _, timedout := pod.Annotations[workspaceTimedOutAnnotation]; timedout || isPodBeingDeleted(&pod) {
+			continue
+		}
+		until, ok := schedules.For(owner, metaID).InStopWindow(now)
+		if !ok {
+			continue
+		}
+		reason := fmt.Sprintf("workspace stopped by its schedule until %s", until.UTC().Format(time.RFC3339))
+		err = m.manager.markWorkspace(ctx, workspaceID, addMark(workspaceTimedOutAnnotation, reason))
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("workspaceId=%s: %q", workspaceID, err))
+		}
+	}
+
+	// only workspace schedules can have pre-starts
+	for metaID, sched := range schedules.Workspace {
+		if _, running := runningMetaID[metaID]; running {
+			continue
+		}
+		if from.IsZero() || !sched.PreStartDue(from, now) {
+			continue
+		}
+		if _, ok := sched.InStopWindow(now); ok {
+			continue
+		}
+		m.manager.announceScheduledStart(ctx, sched, sched.NextPreStart(from))
+	}
+
+	if len(errs) > 0 {
+		return xerrors.Errorf("error during periodic run:\n%s", strings.Join(errs, "\n\t"))
+	}
+	m.lastScheduleRun = now
+	return nil
+}
+
+// announceScheduledStart tells subscribers that the pre-start of a workspace is due. ws-manager does not start the
+// workspace itself: server creates the workspace instance and starts it like any other.
+func (m *Manager) announceScheduledStart(ctx context.Context, sched *workspaceSchedule, at time.Time) {
+	log.WithFields(log.OWI(sched.Owner, sched.MetaID, "")).WithField("time", at).Info("pre-start of workspace is due")
+	m.publishToSubscribers(ctx, &api.SubscribeResponse{
+		ScheduledStart: &api.ScheduledStart{
+			Metadata: &api.WorkspaceMetadata{
+				Owner:  sched.Owner,
+				MetaId: sched.MetaID,
+			},
+			Time: timestamppb.New(at),
+		},
+	})
+}
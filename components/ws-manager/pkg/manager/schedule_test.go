@@ -0,0 +1,380 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package manager
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
+	"github.com/gitpod-io/gitpod/ws-manager/pkg/clock"
+)
+
+func TestParseWorkspaceSchedule(t *testing.T) {
+	tests := []struct {
+		Name     string
+		MetaID   string
+		Schedule *api.WorkspaceSchedule
+		Error    string
+	}{
+		{
+			Name:   "valid",
+			MetaID: "ws",
+			Schedule: &api.WorkspaceSchedule{
+				Timezone:     "Europe/Berlin",
+				PreStart:     []string{"30 8 * * 1-5"},
+				StopWindows:  []*api.ScheduleWindow{{Start: "0 22 * * *", Duration: "8h"}},
+				WorkingHours: []*api.ScheduleWindow{{Start: "0 9 * * 1-5", Duration: "8h"}},
+			},
+		},
+		{
+			Name:     "invalid timezone",
+			Schedule: &api.WorkspaceSchedule{Timezone: "Mars/Olympus"},
+			Error:    "invalid timezone",
+		},
+		{
+			Name:     "invalid pre-start",
+			MetaID:   "ws",
+			Schedule: &api.WorkspaceSchedule{PreStart: []string{"every morning"}},
+			Error:    "invalid pre-start",
+		},
+		{
+			Name:     "owner pre-start",
+			Schedule: &api.WorkspaceSchedule{PreStart: []string{"30 8 * * 1-5"}},
+			Error:    "pre-starts require a workspace",
+		},
+		{
+			Name:     "invalid window start",
+			Schedule: &api.WorkspaceSchedule{StopWindows: []*api.ScheduleWindow{{Start: "0 25 * * *", Duration: "1h"}}},
+			Error:    "invalid stop window start",
+		},
+		{
+			Name:     "invalid window duration",
+			Schedule: &api.WorkspaceSchedule{WorkingHours: []*api.ScheduleWindow{{Start: "0 9 * * *", Duration: "all day"}}},
+			Error:    "invalid working hours duration",
+		},
+		{
+			Name:     "negative window duration",
+			Schedule: &api.WorkspaceSchedule{WorkingHours: []*api.ScheduleWindow{{Start: "0 9 * * *", Duration: "-1h"}}},
+			Error:    "must be positive",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			_, err := parseWorkspaceSchedule("owner", test.MetaID, test.Schedule)
+			var msg string
+			if err != nil {
+				msg = err.Error()
+			}
+			if test.Error == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			} else if !strings.Contains(msg, test.Error) {
+				t.Errorf("expected error containing %q, but got %q", test.Error, msg)
+			}
+		})
+	}
+}
+
+func TestWorkspaceScheduleWindows(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sched, err := parseWorkspaceSchedule("owner", "ws", &api.WorkspaceSchedule{
+		Timezone:     "Europe/Berlin",
+		PreStart:     []string{"30 8 * * 1-5"},
+		StopWindows:  []*api.ScheduleWindow{{Start: "0 22 * * *", Duration: "8h"}},
+		WorkingHours: []*api.ScheduleWindow{{Start: "0 9 * * 1-5", Duration: "8h"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type result struct {
+		WorkingHours bool
+		Stopped      bool
+		StoppedUntil time.Time
+		NextStop     time.Time
+		NextPreStart time.Time
+	}
+	// 2022-10-17 is a Monday
+	at := func(day, hour, min int) time.Time { return time.Date(2022, 10, day, hour, min, 0, 0, berlin) }
+	tests := []struct {
+		Name        string
+		Time        time.Time
+		Expectation result
+	}{
+		{
+			Name: "monday morning",
+			Time: at(17, 10, 0),
+			Expectation: result{
+				WorkingHours: true,
+				NextStop:     at(17, 22, 0),
+				NextPreStart: at(18, 8, 30),
+			},
+		},
+		{
+			Name: "monday night",
+			Time: at(17, 23, 0),
+			Expectation: result{
+				Stopped:      true,
+				StoppedUntil: at(18, 6, 0),
+				NextStop:     at(18, 22, 0),
+				NextPreStart: at(18, 8, 30),
+			},
+		},
+		{
+			Name: "friday evening",
+			Time: at(21, 17, 30),
+			Expectation: result{
+				NextStop:     at(21, 22, 0),
+				NextPreStart: at(24, 8, 30),
+			},
+		},
+		{
+			Name: "saturday",
+			Time: at(22, 10, 0),
+			Expectation: result{
+				NextStop:     at(22, 22, 0),
+				NextPreStart: at(24, 8, 30),
+			},
+		},
+		{
+			Name: "evaluated in UTC",
+			Time: at(17, 10, 0).UTC(),
+			Expectation: result{
+				WorkingHours: true,
+				NextStop:     at(17, 22, 0),
+				NextPreStart: at(18, 8, 30),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var act result
+			act.WorkingHours = sched.InWorkingHours(test.Time)
+			act.StoppedUntil, act.Stopped = sched.InStopWindow(test.Time)
+			act.NextStop = sched.NextStop(test.Time)
+			act.NextPreStart = sched.NextPreStart(test.Time)
+
+			if diff := cmp.Diff(test.Expectation, act, cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) })); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if !sched.PreStartDue(at(17, 8, 25), at(17, 8, 35)) {
+		t.Error("expected pre-start to be due")
+	}
+	if sched.PreStartDue(at(17, 8, 30), at(17, 8, 40)) {
+		t.Error("expected pre-start which was due before to not be due again")
+	}
+
+	var nilSched *workspaceSchedule
+	if nilSched.InWorkingHours(at(17, 10, 0)) || nilSched.Status(at(17, 10, 0)) != nil {
+		t.Error("expected a missing schedule to have no effect")
+	}
+}
+
+func TestSetSchedule(t *testing.T) {
+	m := &Manager{
+		Config:    forTestingOnlyManagerConfig(),
+		Clientset: fake.NewClientBuilder().Build(),
+	}
+	ctx := context.Background()
+
+	ownerSchedule := &api.WorkspaceSchedule{StopWindows: []*api.ScheduleWindow{{Start: "0 22 * * *", Duration: "8h"}}}
+	workspaceSchedule := &api.WorkspaceSchedule{
+		PreStart:     []string{"30 8 * * 1-5"},
+		WorkingHours: []*api.ScheduleWindow{{Start: "0 9 * * *", Duration: "8h"}},
+	}
+	for _, req := range []*api.SetScheduleRequest{
+		{Owner: "alice", Schedule: ownerSchedule},
+		{Owner: "alice", MetaId: "ws-a", Schedule: workspaceSchedule},
+		{Owner: "alice", MetaId: "ws-a", Schedule: workspaceSchedule},
+		{Owner: "bob", Schedule: ownerSchedule},
+		{Owner: "bob"},
+	} {
+		_, err := m.SetSchedule(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, test := range []struct {
+		Name string
+		Req  *api.SetScheduleRequest
+	}{
+		{"without owner", &api.SetScheduleRequest{MetaId: "ws-a", Schedule: workspaceSchedule}},
+		{"invalid schedule", &api.SetScheduleRequest{Owner: "alice", Schedule: &api.WorkspaceSchedule{PreStart: []string{"never"}}}},
+		{"with owner pre-starts", &api.SetScheduleRequest{Owner: "alice", Schedule: workspaceSchedule}},
+	} {
+		_, err := m.SetSchedule(ctx, test.Req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected a schedule %s to be rejected, but got %v", test.Name, err)
+		}
+	}
+
+	// every schedule is persisted in a config map of its own
+	var cms corev1.ConfigMapList
+	err := m.Clientset.List(ctx, &cms, client.MatchingLabels{workspaceScheduleLabel: "true"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, cm := range cms.Items {
+		names = append(names, cm.Name)
+	}
+	if diff := cmp.Diff([]string{"ws-schedule-owner-alice", "ws-schedule-workspace-ws-a"}, names); diff != "" {
+		t.Errorf("unexpected schedule config maps (-want +got):\n%s", diff)
+	}
+
+	// a fresh manager must see the persisted schedules
+	m2 := &Manager{Config: m.Config, Clientset: m.Clientset}
+	schedules, err := m2.loadSchedules(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := schedules.For("alice", "ws-a"); s == nil || s.MetaID != "ws-a" {
+		t.Errorf("expected the workspace schedule to take precedence, but got %v", s)
+	}
+	if s := schedules.For("alice", "ws-b"); s == nil || s.MetaID != "" {
+		t.Errorf("expected the owner schedule to apply, but got %v", s)
+	}
+	if s := schedules.For("bob", "ws-c"); s != nil {
+		t.Errorf("expected the removed schedule to not apply, but got %v", s)
+	}
+	if m.getSchedules().For("alice", "ws-a") == nil || m.getSchedules().For("bob", "ws-c") != nil {
+		t.Error("expected the manager which set the schedules to know them")
+	}
+}
+
+func TestMarkScheduledWorkspaces(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ws-running",
+			Namespace: "default",
+			Labels: map[string]string{
+				markerLabel:       "true",
+				"workspaceID":     "running",
+				wsk8s.OwnerLabel:  "alice",
+				wsk8s.MetaIDLabel: "ws-running",
+				wsk8s.TypeLabel:   "regular",
+			},
+			Annotations: map[string]string{
+				workspaceIDAnnotation: "running",
+			},
+		},
+	}
+	newManager := func(clientset client.Client) *Manager {
+		m := &Manager{
+			Config:    forTestingOnlyManagerConfig(),
+			Clientset: clientset,
+			clock:     clock.System(),
+			admission: newAdmissionQueue(config.AdmissionConfiguration{}),
+		}
+		return m
+	}
+	m := newManager(fake.NewClientBuilder().WithObjects(pod).Build())
+	sub := make(chan *api.SubscribeResponse, 10)
+	m.subscribers = map[string]chan *api.SubscribeResponse{"test": sub}
+
+	ctx := context.Background()
+	always := &api.WorkspaceSchedule{PreStart: []string{"* * * * *"}}
+	for _, req := range []*api.SetScheduleRequest{
+		// alice's running workspace is in a stop window
+		{Owner: "alice", MetaId: "ws-running", Schedule: &api.WorkspaceSchedule{StopWindows: []*api.ScheduleWindow{{Start: "* * * * *", Duration: "2m"}}}},
+		{Owner: "alice", MetaId: "ws-stopped", Schedule: always},
+		{Owner: "bob", MetaId: "ws-bob", Schedule: always},
+		// bob's workspace is in a stop window, hence it is not pre-started
+		{Owner: "bob", MetaId: "ws-bob-stopped", Schedule: &api.WorkspaceSchedule{
+			PreStart:    []string{"* * * * *"},
+			StopWindows: []*api.ScheduleWindow{{Start: "* * * * *", Duration: "2m"}},
+		}},
+	} {
+		_, err := m.SetSchedule(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// schedules which cannot be loaded are evaluated again in the next run
+	lastRun := time.Now().Add(-2 * time.Minute)
+	mon := &Monitor{manager: newManager(&failingListClient{m.Clientset}), lastScheduleRun: lastRun}
+	err := mon.markScheduledWorkspaces(ctx)
+	if err == nil {
+		t.Error("expected an error when the schedules cannot be loaded")
+	}
+	if !mon.lastScheduleRun.Equal(lastRun) {
+		t.Error("expected the monitor to not advance its last run when the schedules could not be loaded")
+	}
+
+	mon = &Monitor{manager: m, lastScheduleRun: lastRun}
+	err = mon.markScheduledWorkspaces(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var updated corev1.Pod
+	err = m.Clientset.Get(ctx, client.ObjectKeyFromObject(pod), &updated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reason := updated.Annotations[workspaceTimedOutAnnotation]; !strings.HasPrefix(reason, "workspace stopped by its schedule") {
+		t.Errorf("expected the workspace to be stopped by its schedule, but got %q", reason)
+	}
+
+	// due pre-starts of workspaces which do not run are announced, but not started by ws-manager
+	var announced []string
+	for len(sub) > 0 {
+		resp := <-sub
+		ss := resp.GetScheduledStart()
+		if ss == nil {
+			continue
+		}
+		announced = append(announced, ss.GetMetadata().GetOwner()+"/"+ss.GetMetadata().GetMetaId())
+	}
+	sort.Strings(announced)
+	if diff := cmp.Diff([]string{"alice/ws-stopped", "bob/ws-bob"}, announced); diff != "" {
+		t.Errorf("unexpected scheduled starts (-want +got):\n%s", diff)
+	}
+	var pods corev1.PodList
+	err = m.Clientset.List(ctx, &pods, workspaceObjectListOptions(m.Config.Namespace))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pods.Items) != 1 {
+		t.Errorf("expected ws-manager to not start scheduled workspaces itself, but got %d pods", len(pods.Items))
+	}
+
+	if time.Since(mon.lastScheduleRun) > time.Minute {
+		t.Error("expected the monitor to remember when it last evaluated the schedules")
+	}
+}
+
+// failingListClient is a Kubernetes client which cannot list objects
+type failingListClient struct {
+	client.Client
+}
+
+func (c *failingListClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return xerrors.Errorf("cannot list objects")
+}
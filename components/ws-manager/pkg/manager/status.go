@@ -271,6 +271,7 @@ func (m *Manager) getWorkspaceStatus(wso workspaceObjects) (*api.WorkspaceStatus
 		Auth: &api.WorkspaceAuthentication{
 			Admission:  admission,
 			OwnerToken: ownerToken,
+			TeamToken:  api.DeriveTeamToken(ownerToken),
 		},
 	}
 
@@ -314,6 +315,14 @@ func (m *Manager) extractStatusFromPod(result *api.WorkspaceStatus, wso workspac
 
 	result.Spec.ExposedPorts = extractExposedPorts(pod).Ports
 
+	if resized, ok := pod.Annotations[workspaceResizedAnnotation]; ok {
+		t, err := time.Parse(time.RFC3339Nano, resized)
+		if err != nil {
+			return xerrors.Errorf("cannot parse resized: %w", err)
+		}
+		result.Conditions.Resized = timestamppb.New(t)
+	}
+
 	// check failure states, i.e. determine value of result.Failed
 	failure, phase := extractFailure(wso, m.metrics)
 	result.Conditions.Failed = failure
@@ -400,8 +409,15 @@ func (m *Manager) extractStatusFromPod(result *api.WorkspaceStatus, wso workspac
 		return nil
 	}
 
+	result.Conditions.ServicesFailed = extractServiceFailures(pod)
+
 	status := pod.Status
-	if status.Phase == corev1.PodPending {
+	podPhase := status.Phase
+	if podPhase == corev1.PodPending && onlyServicesPending(pod) {
+		// Service containers which cannot start keep the pod pending, but must not keep the workspace from running.
+		podPhase = corev1.PodRunning
+	}
+	if podPhase == corev1.PodPending {
 		// check if any container is still pulling images
 		for _, cs := range status.ContainerStatuses {
 			if cs.State.Waiting != nil {
@@ -427,7 +443,7 @@ func (m *Manager) extractStatusFromPod(result *api.WorkspaceStatus, wso workspac
 		result.Phase = api.WorkspacePhase_PENDING
 		result.Message = "pod is pending"
 		return nil
-	} else if status.Phase == corev1.PodRunning {
+	} else if podPhase == corev1.PodRunning {
 		if firstUserActivity, ok := wso.Pod.Annotations[firstUserActivityAnnotation]; ok {
 			t, err := time.Parse(time.RFC3339Nano, firstUserActivity)
 			if err != nil {
@@ -438,6 +454,9 @@ func (m *Manager) extractStatusFromPod(result *api.WorkspaceStatus, wso workspac
 		}
 
 		for _, cs := range status.ContainerStatuses {
+			if isServiceContainer(cs.Name) {
+				continue
+			}
 			// containers that were terminated are not ready, but may have been
 			if cs.State.Terminated != nil && cs.State.Terminated.ExitCode == containerUnknownExitCode {
 				// the container was stopped for an unknown reason.
@@ -524,6 +543,41 @@ func (m *Manager) extractStatusFromPod(result *api.WorkspaceStatus, wso workspac
 	return nil
 }
 
+// isServiceContainer returns true if a container of a workspace pod runs a service of the workspace
+func isServiceContainer(name string) bool {
+	return strings.HasPrefix(name, serviceContainerPrefix)
+}
+
+// onlyServicesPending returns true if all containers of a pending pod which are not services run already.
+func onlyServicesPending(pod *corev1.Pod) bool {
+	var services bool
+	for _, cs := range pod.Status.ContainerStatuses {
+		if isServiceContainer(cs.Name) {
+			services = true
+			continue
+		}
+		if cs.State.Running == nil {
+			return false
+		}
+	}
+	return services
+}
+
+// extractServiceFailures returns why service containers failed to start, or an empty string if none did.
+func extractServiceFailures(pod *corev1.Pod) string {
+	var failures []string
+	for _, cs := range pod.Status.ContainerStatuses {
+		if !isServiceContainer(cs.Name) || cs.State.Waiting == nil {
+			continue
+		}
+		switch cs.State.Waiting.Reason {
+		case "ImagePullBackOff", "ErrImagePull", "InvalidImageName", "CreateContainerConfigError", "CreateContainerError", "RunContainerError":
+			failures = append(failures, fmt.Sprintf("%s: %s: %s", strings.TrimPrefix(cs.Name, serviceContainerPrefix), cs.State.Waiting.Reason, cs.State.Waiting.Message))
+		}
+	}
+	return strings.Join(failures, "; ")
+}
+
 // extractFailure returns a pod failure reason and possibly a phase. If phase is nil then
 // one should extract the phase themselves. If the pod has not failed, this function returns "", nil.
 func extractFailure(wso workspaceObjects, metrics *metrics) (string, *api.WorkspacePhase) {
@@ -545,6 +599,10 @@ func extractFailure(wso workspaceObjects, metrics *metrics) (string, *api.Worksp
 	}
 
 	for _, cs := range status.ContainerStatuses {
+		// service failures are reported by extractServiceFailures and do not fail the workspace
+		if isServiceContainer(cs.Name) {
+			continue
+		}
 		if cs.State.Waiting != nil {
 			if cs.State.Waiting.Reason == "ImagePullBackOff" || cs.State.Waiting.Reason == "ErrImagePull" {
 				// If the image pull failed we were definitely in the api.WorkspacePhase_CREATING phase,
@@ -770,6 +828,10 @@ func (m *Manager) isWorkspaceTimedOut(wso workspaceObjects) (reason string, err
 				log.WithError(err).WithField("customTimeout", ctv).WithFields(wsk8s.GetOWIFromObject(&wso.Pod.ObjectMeta)).Warn("pod had custom timeout annotation set, but could not parse its value. Defaulting to ws-manager config.")
 			}
 		}
+		if !wso.IsWorkspaceHeadless() && m.getWorkspaceSchedule(wso.Pod).InWorkingHours(time.Now()) {
+			// workspaces do not time out when idle during their working hours
+			return "", nil
+		}
 		return decide(*lastActivity, timeout, activity)
 
 	case api.WorkspacePhase_INTERRUPTED:
@@ -15,6 +15,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
 	ctesting "github.com/gitpod-io/gitpod/common-go/testing"
 	"github.com/gitpod-io/gitpod/common-go/util"
 	"github.com/gitpod-io/gitpod/ws-manager/api"
@@ -29,6 +30,7 @@ func TestIsWorkspaceTimedout(t *testing.T) {
 		WSO                workspaceObjects `json:"wso"`
 		CreationDelta      string           `json:"creationDelta,omitempty"`
 		StoppingSinceDelta string           `json:"stoppingSinceDelta,omitempty"`
+		WorkingHours       bool             `json:"workingHours,omitempty"`
 	}
 	type gold struct {
 		Reason string `json:"reason,omitempty"`
@@ -73,6 +75,17 @@ func TestIsWorkspaceTimedout(t *testing.T) {
 				manager.activity.Store(workspaceID, &delta)
 			}
 
+			if fixture.WorkingHours && fixture.WSO.Pod != nil {
+				sched, err := parseWorkspaceSchedule(fixture.WSO.Pod.Labels[wsk8s.OwnerLabel], "", &api.WorkspaceSchedule{
+					WorkingHours: []*api.ScheduleWindow{{Start: "* * * * *", Duration: "2m"}},
+				})
+				if err != nil {
+					t.Errorf("cannot parse working hours: %v", err)
+					return nil
+				}
+				manager.setSchedules(newWorkspaceSchedules().With(sched.Owner, "", sched))
+			}
+
 			if fixture.CreationDelta != "" && fixture.WSO.Pod != nil {
 				dt, err := time.ParseDuration(fixture.CreationDelta)
 				if err != nil {
@@ -0,0 +1,426 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"golang.org/x/xerrors"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
+)
+
+// The warm pool keeps placeholder pods per workspace class which are scheduled and have pulled the workspace
+// images already. Pod specs (env, volumes, secrets, ...) are immutable once a pod was created, hence a placeholder
+// cannot turn into the workspace pod itself. Instead a starting workspace claims a placeholder, its pod is created
+// the regular way with all of its identity, env, secrets and initializer, and prefers the node of the placeholder.
+// The placeholder is deleted once the workspace pod was created, such that the workspace can take over the capacity
+// it reserved. If the workspace cannot be created, the claim is released and the placeholder stays in the pool.
+// The node is merely preferred because other pods might take the freed capacity first - the workspace is then
+// scheduled elsewhere instead of not at all. A claim counts as hit once the workspace pod was scheduled onto that node.
+const (
+	// warmPoolLabel is set on placeholder pods and contains the workspace class they are kept for
+	warmPoolLabel = "gitpod.io/warmPool"
+
+	// warmPoolStateLabel marks placeholder pods as available or claimed
+	warmPoolStateLabel = "gitpod.io/warmPoolState"
+
+	// warmPoolClaimedAnnotation contains the time a placeholder pod was claimed at
+	warmPoolClaimedAnnotation = "gitpod.io/warmPoolClaimed"
+
+	// warmPoolNodeAnnotation is set on workspace pods which claimed a placeholder and contains the node of the
+	// placeholder. It is removed once the claim was counted as hit or miss.
+	warmPoolNodeAnnotation = "gitpod.io/warmPoolNode"
+
+	warmPoolStateAvailable = "available"
+	warmPoolStateClaimed   = "claimed"
+
+	// warmPoolClaimTimeout is the time after which a claimed placeholder which was neither released nor deleted by
+	// its claimer is considered left behind
+	warmPoolClaimTimeout = time.Minute
+
+	// warmPoolBinPath is where the placeholder containers find the static busybox they run instead of the
+	// image's own binaries, which not all images (e.g. IDE images) have.
+	warmPoolBinPath = "/.warmpool"
+)
+
+// claimWarmPoolPlaceholder claims an available placeholder pod of a workspace class. The claimer must either delete
+// the placeholder using freeWarmPoolPlaceholder once the workspace pod was created, or release the claim using
+// releaseWarmPoolPlaceholder. If no placeholder is available, nil is returned and the claim is counted as miss.
+func (m *Manager) claimWarmPoolPlaceholder(ctx context.Context, class string) (placeholder *corev1.Pod, err error) {
+	cls, ok := m.Config.WorkspaceClasses[class]
+	if !ok || cls.WarmPool == nil || cls.WarmPool.Size == 0 {
+		return nil, nil
+	}
+
+	span, ctx := tracing.FromContext(ctx, "claimWarmPoolPlaceholder")
+	defer tracing.FinishSpan(span, &err)
+
+	defer func() {
+		if placeholder == nil {
+			m.metrics.OnWarmPoolClaim(class, false)
+		}
+	}()
+
+	var pods corev1.PodList
+	err = m.Clientset.List(ctx, &pods,
+		client.InNamespace(m.Config.Namespace),
+		client.MatchingLabels{warmPoolLabel: class, warmPoolStateLabel: warmPoolStateAvailable},
+	)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot list placeholder pods: %w", err)
+	}
+	// prefer the oldest placeholders, they are the next to expire
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[i].CreationTimestamp.Before(&pods.Items[j].CreationTimestamp)
+	})
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !isPlaceholderReady(pod) {
+			continue
+		}
+
+		// The update fails with a conflict if somebody else claimed or deleted the placeholder in the meantime.
+		pod.Labels[warmPoolStateLabel] = warmPoolStateClaimed
+		if pod.Annotations == nil {
+			pod.Annotations = make(map[string]string)
+		}
+		pod.Annotations[warmPoolClaimedAnnotation] = time.Now().UTC().Format(time.RFC3339)
+		err = m.Clientset.Update(ctx, pod)
+		if k8serr.IsConflict(err) || k8serr.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, xerrors.Errorf("cannot claim placeholder pod %s: %w", pod.Name, err)
+		}
+
+		span.LogKV("placeholder", pod.Name, "node", pod.Spec.NodeName)
+		return pod, nil
+	}
+
+	return nil, nil
+}
+
+// freeWarmPoolPlaceholder deletes a claimed placeholder pod such that the workspace which claimed it can take over
+// its capacity. This is best effort - reconcileWarmPools removes claimed placeholders which are left behind.
+func (m *Manager) freeWarmPoolPlaceholder(ctx context.Context, placeholder *corev1.Pod) {
+	err := m.Clientset.Delete(ctx, placeholder, client.GracePeriodSeconds(0))
+	if err != nil && !k8serr.IsNotFound(err) {
+		log.WithError(err).WithField("pod", placeholder.Name).Warn("cannot delete claimed placeholder pod")
+	}
+}
+
+// releaseWarmPoolPlaceholder returns a claimed placeholder pod to its pool, e.g. because the workspace which claimed
+// it could not be created. If that fails, reconcileWarmPools removes the placeholder eventually.
+func (m *Manager) releaseWarmPoolPlaceholder(ctx context.Context, placeholder *corev1.Pod) {
+	placeholder.Labels[warmPoolStateLabel] = warmPoolStateAvailable
+	delete(placeholder.Annotations, warmPoolClaimedAnnotation)
+	// the update is conditional on the version we claimed, hence it fails if the placeholder was removed meanwhile
+	err := m.Clientset.Update(ctx, placeholder)
+	if err != nil && !k8serr.IsNotFound(err) && !k8serr.IsConflict(err) {
+		log.WithError(err).WithField("pod", placeholder.Name).Warn("cannot release claimed placeholder pod")
+	}
+}
+
+// isPlaceholderReady returns true if a placeholder pod runs and has pulled all images.
+func isPlaceholderReady(pod *corev1.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Spec.NodeName == "" || pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// preferNode makes the scheduler prefer the node of a claimed placeholder for a workspace pod, because that node
+// has pulled the images already, and records the node such that the claim is counted once the pod was scheduled.
+func preferNode(pod *corev1.Pod, node string) {
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &corev1.Affinity{}
+	}
+	if pod.Spec.Affinity.NodeAffinity == nil {
+		pod.Spec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	na := pod.Spec.Affinity.NodeAffinity
+	na.PreferredDuringSchedulingIgnoredDuringExecution = append(na.PreferredDuringSchedulingIgnoredDuringExecution, corev1.PreferredSchedulingTerm{
+		Weight: 100,
+		Preference: corev1.NodeSelectorTerm{
+			MatchFields: []corev1.NodeSelectorRequirement{
+				{
+					Key:      "metadata.name",
+					Operator: corev1.NodeSelectorOpIn,
+					Values:   []string{node},
+				},
+			},
+		},
+	})
+
+	if pod.Annotations == nil {
+		pod.Annotations = make(map[string]string)
+	}
+	pod.Annotations[warmPoolNodeAnnotation] = node
+}
+
+// countWarmPoolClaim counts the claim of a placeholder as hit if the workspace pod was scheduled onto the node of the
+// placeholder, and as miss if it was not scheduled at all before it stopped. It returns false while the claim is
+// undecided.
+func (m *Manager) countWarmPoolClaim(pod *corev1.Pod, phase api.WorkspacePhase) bool {
+	node, claimed := pod.Annotations[warmPoolNodeAnnotation]
+	if !claimed {
+		return false
+	}
+	if pod.Spec.NodeName == "" && phase != api.WorkspacePhase_STOPPING && phase != api.WorkspacePhase_STOPPED {
+		return false
+	}
+	m.metrics.OnWarmPoolClaim(pod.Labels[workspaceClassLabel], pod.Spec.NodeName == node)
+	return true
+}
+
+// reconcileWarmPools tops up the warm pools and removes placeholder pods which expired, failed, were left behind
+// after a claim or belong to a pool which is no longer configured.
+func (m *Manager) reconcileWarmPools(ctx context.Context) (err error) {
+	span, ctx := tracing.FromContext(ctx, "reconcileWarmPools")
+	defer tracing.FinishSpan(span, &err)
+
+	selector, err := labels.Parse(warmPoolLabel)
+	if err != nil {
+		return err
+	}
+	var pods corev1.PodList
+	err = m.Clientset.List(ctx, &pods, &client.ListOptions{Namespace: m.Config.Namespace, LabelSelector: selector})
+	if err != nil {
+		return xerrors.Errorf("cannot list placeholder pods: %w", err)
+	}
+
+	now := time.Now()
+	live := make(map[string][]*corev1.Pod)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		class := pod.Labels[warmPoolLabel]
+		if pod.Labels[warmPoolStateLabel] == warmPoolStateClaimed {
+			// the claimer deletes or releases a placeholder once it created the workspace, unless it failed to do so
+			claimed, err := time.Parse(time.RFC3339, pod.Annotations[warmPoolClaimedAnnotation])
+			if err != nil || now.Sub(claimed) > warmPoolClaimTimeout {
+				m.deletePlaceholder(ctx, pod, "left behind after claim")
+			}
+			continue
+		}
+
+		cls, ok := m.Config.WorkspaceClasses[class]
+		switch {
+		case !ok || cls.WarmPool == nil || cls.WarmPool.Size == 0:
+			m.deletePlaceholder(ctx, pod, "warm pool is not configured")
+		case pod.Status.Phase == corev1.PodFailed || pod.Status.Phase == corev1.PodSucceeded:
+			m.deletePlaceholder(ctx, pod, "stopped")
+		case now.Sub(pod.CreationTimestamp.Time) > time.Duration(cls.WarmPool.TTL):
+			m.deletePlaceholder(ctx, pod, "expired")
+		default:
+			live[class] = append(live[class], pod)
+		}
+	}
+
+	for class, cls := range m.Config.WorkspaceClasses {
+		if cls.WarmPool == nil {
+			continue
+		}
+
+		pool := live[class]
+		// keep the ready placeholders and the older ones if the pool shrunk
+		sort.Slice(pool, func(i, j int) bool {
+			ri, rj := isPlaceholderReady(pool[i]), isPlaceholderReady(pool[j])
+			if ri != rj {
+				return ri
+			}
+			return pool[i].CreationTimestamp.Before(&pool[j].CreationTimestamp)
+		})
+		for len(pool) > cls.WarmPool.Size {
+			m.deletePlaceholder(ctx, pool[len(pool)-1], "warm pool shrunk")
+			pool = pool[:len(pool)-1]
+		}
+
+		var ready int
+		for _, pod := range pool {
+			if isPlaceholderReady(pod) {
+				ready++
+			}
+		}
+		m.metrics.OnWarmPoolReconciled(class, ready, len(pool)-ready)
+
+		for i := len(pool); i < cls.WarmPool.Size; i++ {
+			pod, err := m.createPlaceholderPod(class, cls)
+			if err != nil {
+				return xerrors.Errorf("cannot create placeholder pod for class %s: %w", class, err)
+			}
+			err = m.Clientset.Create(ctx, pod)
+			if err != nil {
+				return xerrors.Errorf("cannot create placeholder pod for class %s: %w", class, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// deletePlaceholder deletes an unclaimed placeholder pod. The deletion is conditional on the version of the pod
+// we looked at, such that a placeholder which was claimed in the meantime is never deleted from under its claimer.
+func (m *Manager) deletePlaceholder(ctx context.Context, pod *corev1.Pod, reason string) {
+	rv := pod.ResourceVersion
+	err := m.Clientset.Delete(ctx, pod,
+		client.GracePeriodSeconds(0),
+		client.Preconditions{ResourceVersion: &rv},
+	)
+	if k8serr.IsNotFound(err) || k8serr.IsConflict(err) {
+		return
+	}
+	if err != nil {
+		log.WithError(err).WithField("pod", pod.Name).Warn("cannot delete placeholder pod")
+		return
+	}
+	log.WithField("pod", pod.Name).WithField("reason", reason).Debug("deleted placeholder pod")
+}
+
+// createPlaceholderPod produces a placeholder pod for the warm pool of a workspace class. The placeholder is scheduled
+// like a regular workspace, reserves the resources of the class and pulls the images of the pool.
+func (m *Manager) createPlaceholderPod(class string, cls *config.WorkspaceClass) (*corev1.Pod, error) {
+	requests, err := cls.Container.Requests.ResourceList()
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse resource requests: %w", err)
+	}
+
+	// all placeholder containers run a static busybox, hence we need the images to be pulled only
+	const binVolumeName = "warmpool-bin"
+	image := cls.WarmPool.Image
+	if image == "" {
+		image = config.DefaultWarmPoolImage
+	}
+	nodeLabel := cls.WarmPool.NodeLabel
+	if nodeLabel == "" {
+		nodeLabel = config.DefaultWarmPoolNodeLabel
+	}
+	sleep := []string{warmPoolBinPath + "/busybox", "sleep", "2147483647"}
+	binMount := corev1.VolumeMount{Name: binVolumeName, MountPath: warmPoolBinPath}
+
+	containers := make([]corev1.Container, 0, len(cls.WarmPool.Images))
+	for i, img := range cls.WarmPool.Images {
+		c := corev1.Container{
+			Name:            fmt.Sprintf("pull-%d", i),
+			Image:           img,
+			ImagePullPolicy: corev1.PullIfNotPresent,
+			Command:         sleep,
+			VolumeMounts:    []corev1.VolumeMount{binMount},
+			ReadinessProbe: &corev1.Probe{
+				ProbeHandler: corev1.ProbeHandler{
+					Exec: &corev1.ExecAction{Command: []string{warmPoolBinPath + "/busybox", "true"}},
+				},
+			},
+		}
+		if i == 0 {
+			// the first container reserves the capacity the workspace takes over
+			c.Resources.Requests = requests
+		}
+		containers = append(containers, c)
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "warmpool-",
+			Namespace:    m.Config.Namespace,
+			Labels: map[string]string{
+				warmPoolLabel:      class,
+				warmPoolStateLabel: warmPoolStateAvailable,
+			},
+			Annotations: map[string]string{
+				// placeholders must not prevent nodes from being scaled down
+				"cluster-autoscaler.kubernetes.io/safe-to-evict": "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			AutomountServiceAccountToken: &boolFalse,
+			EnableServiceLinks:           &boolFalse,
+			SchedulerName:                m.Config.SchedulerName,
+			PriorityClassName:            cls.WarmPool.PriorityClassName,
+			Affinity: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{
+								MatchExpressions: []corev1.NodeSelectorRequirement{
+									{
+										Key:      nodeLabel,
+										Operator: corev1.NodeSelectorOpExists,
+									},
+									{
+										Key:      "gitpod.io/ws-daemon_ready_ns_" + m.Config.Namespace,
+										Operator: corev1.NodeSelectorOpExists,
+									},
+									{
+										Key:      "gitpod.io/registry-facade_ready_ns_" + m.Config.Namespace,
+										Operator: corev1.NodeSelectorOpExists,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			InitContainers: []corev1.Container{
+				{
+					Name:            "install-busybox",
+					Image:           image,
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					Command:         []string{"cp", "/bin/busybox", warmPoolBinPath + "/busybox"},
+					VolumeMounts:    []corev1.VolumeMount{binMount},
+				},
+			},
+			Containers:    containers,
+			RestartPolicy: corev1.RestartPolicyNever,
+			Volumes: []corev1.Volume{
+				{
+					Name:         binVolumeName,
+					VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+				},
+			},
+		},
+	}
+
+	// placeholders must land on the nodes the workspaces of the class land on
+	for _, p := range []string{cls.Templates.DefaultPath, cls.Templates.RegularPath} {
+		tpl, err := config.GetWorkspacePodTemplate(p)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot read pod template - this is a configuration problem: %w", err)
+		}
+		if tpl == nil {
+			continue
+		}
+		pod.Spec.Tolerations = append(pod.Spec.Tolerations, tpl.Spec.Tolerations...)
+		for k, v := range tpl.Spec.NodeSelector {
+			if pod.Spec.NodeSelector == nil {
+				pod.Spec.NodeSelector = make(map[string]string)
+			}
+			pod.Spec.NodeSelector[k] = v
+		}
+	}
+
+	return pod, nil
+}
@@ -0,0 +1,278 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package manager
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
+)
+
+func forTestingOnlyWarmPoolManager(objs ...client.Object) *Manager {
+	cfg := forTestingOnlyManagerConfig()
+	cfg.WorkspaceClasses[config.DefaultWorkspaceClass].WarmPool = &config.WarmPoolConfiguration{
+		Size:   2,
+		TTL:    util.Duration(time.Hour),
+		Images: []string{"gitpod/workspace-full", "gitpod/openvscode"},
+	}
+	m := &Manager{
+		Config:    cfg,
+		Clientset: fake.NewClientBuilder().WithObjects(objs...).Build(),
+	}
+	m.metrics = newMetrics(m)
+	return m
+}
+
+func placeholderPod(name, class, state string, created time.Time, ready bool) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(created),
+			Labels: map[string]string{
+				warmPoolLabel:      class,
+				warmPoolStateLabel: state,
+			},
+		},
+		Spec: corev1.PodSpec{NodeName: "node-" + name},
+	}
+	if ready {
+		pod.Status.Phase = corev1.PodRunning
+		pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+	}
+	return pod
+}
+
+func TestClaimWarmPoolPlaceholder(t *testing.T) {
+	now := time.Now()
+	m := forTestingOnlyWarmPoolManager(
+		placeholderPod("pending", config.DefaultWorkspaceClass, warmPoolStateAvailable, now.Add(-2*time.Minute), false),
+		placeholderPod("young", config.DefaultWorkspaceClass, warmPoolStateAvailable, now.Add(-1*time.Minute), true),
+		placeholderPod("old", config.DefaultWorkspaceClass, warmPoolStateAvailable, now.Add(-10*time.Minute), true),
+	)
+
+	var claimed []*corev1.Pod
+	for i := 0; i < 3; i++ {
+		placeholder, err := m.claimWarmPoolPlaceholder(context.Background(), config.DefaultWorkspaceClass)
+		if err != nil {
+			t.Fatal(err)
+		}
+		claimed = append(claimed, placeholder)
+	}
+	var nodes []string
+	for _, p := range claimed {
+		if p == nil {
+			nodes = append(nodes, "")
+			continue
+		}
+		nodes = append(nodes, p.Spec.NodeName)
+	}
+	if diff := cmp.Diff([]string{"node-old", "node-young", ""}, nodes); diff != "" {
+		t.Errorf("unexpected claimed nodes (-want +got):\n%s", diff)
+	}
+
+	// the claimer frees one placeholder because it created its workspace, and releases the other one
+	m.freeWarmPoolPlaceholder(context.Background(), claimed[0])
+	m.releaseWarmPoolPlaceholder(context.Background(), claimed[1])
+
+	var pods corev1.PodList
+	err := m.Clientset.List(context.Background(), &pods)
+	if err != nil {
+		t.Fatal(err)
+	}
+	states := make(map[string]string)
+	for _, p := range pods.Items {
+		states[p.Name] = p.Labels[warmPoolStateLabel]
+	}
+	if diff := cmp.Diff(map[string]string{"pending": warmPoolStateAvailable, "young": warmPoolStateAvailable}, states); diff != "" {
+		t.Errorf("unexpected placeholders (-want +got):\n%s", diff)
+	}
+
+	// a released placeholder can be claimed again
+	placeholder, err := m.claimWarmPoolPlaceholder(context.Background(), config.DefaultWorkspaceClass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if placeholder == nil || placeholder.Name != "young" {
+		t.Errorf("expected the released placeholder to be claimed again, but got %v", placeholder)
+	}
+
+	// hits are counted once the workspace pods were scheduled
+	if hits := testutil.ToFloat64(m.metrics.totalWarmPoolClaimsCounterVec.WithLabelValues(config.DefaultWorkspaceClass, "hit")); hits != 0 {
+		t.Errorf("expected no hits, but got %v", hits)
+	}
+	if misses := testutil.ToFloat64(m.metrics.totalWarmPoolClaimsCounterVec.WithLabelValues(config.DefaultWorkspaceClass, "miss")); misses != 1 {
+		t.Errorf("expected 1 miss, but got %v", misses)
+	}
+}
+
+func TestReconcileWarmPools(t *testing.T) {
+	now := time.Now()
+	leftBehind := placeholderPod("left-behind", config.DefaultWorkspaceClass, warmPoolStateClaimed, now.Add(-5*time.Minute), true)
+	leftBehind.Annotations = map[string]string{warmPoolClaimedAnnotation: now.Add(-5 * time.Minute).UTC().Format(time.RFC3339)}
+	justClaimed := placeholderPod("just-claimed", config.DefaultWorkspaceClass, warmPoolStateClaimed, now.Add(-time.Minute), true)
+	justClaimed.Annotations = map[string]string{warmPoolClaimedAnnotation: now.UTC().Format(time.RFC3339)}
+
+	m := forTestingOnlyWarmPoolManager(
+		placeholderPod("ready", config.DefaultWorkspaceClass, warmPoolStateAvailable, now.Add(-time.Minute), true),
+		placeholderPod("expired", config.DefaultWorkspaceClass, warmPoolStateAvailable, now.Add(-2*time.Hour), true),
+		placeholderPod("unknown-class", "removed", warmPoolStateAvailable, now.Add(-time.Minute), true),
+		leftBehind,
+		justClaimed,
+		// not a placeholder at all
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "ws-foo", Namespace: "default"}},
+	)
+
+	err := m.reconcileWarmPools(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pods corev1.PodList
+	err = m.Clientset.List(context.Background(), &pods)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		names   []string
+		created []*corev1.Pod
+	)
+	for i, pod := range pods.Items {
+		if pod.GenerateName != "" {
+			created = append(created, &pods.Items[i])
+			continue
+		}
+		names = append(names, pod.Name)
+	}
+	sort.Strings(names)
+	if diff := cmp.Diff([]string{"just-claimed", "ready", "ws-foo"}, names); diff != "" {
+		t.Errorf("unexpected remaining pods (-want +got):\n%s", diff)
+	}
+
+	if len(created) != 1 {
+		t.Fatalf("expected the pool to be topped up with one placeholder, but got %d", len(created))
+	}
+	pod := created[0]
+	if diff := cmp.Diff(map[string]string{warmPoolLabel: config.DefaultWorkspaceClass, warmPoolStateLabel: warmPoolStateAvailable}, pod.Labels); diff != "" {
+		t.Errorf("unexpected placeholder labels (-want +got):\n%s", diff)
+	}
+	var images []string
+	for _, c := range pod.Spec.Containers {
+		images = append(images, c.Image)
+	}
+	if diff := cmp.Diff([]string{"gitpod/workspace-full", "gitpod/openvscode"}, images); diff != "" {
+		t.Errorf("unexpected placeholder images (-want +got):\n%s", diff)
+	}
+	if img := pod.Spec.InitContainers[0].Image; img != config.DefaultWarmPoolImage {
+		t.Errorf("expected the placeholder to copy busybox from the default image, but it uses %s", img)
+	}
+	if key := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions[0].Key; key != config.DefaultWarmPoolNodeLabel {
+		t.Errorf("expected the placeholder to require the default node label, but it requires %s", key)
+	}
+	if cpu := pod.Spec.Containers[0].Resources.Requests.Cpu().String(); cpu != "899m" {
+		t.Errorf("expected the placeholder to reserve the class' resources, but it requests %s CPU", cpu)
+	}
+
+	if ready := testutil.ToFloat64(m.metrics.warmPoolSizeGauge.WithLabelValues(config.DefaultWorkspaceClass, "ready")); ready != 1 {
+		t.Errorf("expected 1 ready placeholder, but got %v", ready)
+	}
+}
+
+func TestPreferNode(t *testing.T) {
+	nodeName := corev1.NodeSelectorRequirement{Key: "metadata.name", Operator: corev1.NodeSelectorOpIn, Values: []string{"node-a"}}
+	workload := corev1.NodeSelectorRequirement{Key: "gitpod.io/workload_workspace_regular", Operator: corev1.NodeSelectorOpExists}
+	required := &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{workload}}}}
+	tests := []struct {
+		Name        string
+		Affinity    *corev1.Affinity
+		Expectation *corev1.NodeAffinity
+	}{
+		{
+			Name: "no affinity",
+			Expectation: &corev1.NodeAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{
+					{Weight: 100, Preference: corev1.NodeSelectorTerm{MatchFields: []corev1.NodeSelectorRequirement{nodeName}}},
+				},
+			},
+		},
+		{
+			Name:     "required terms stay as they are",
+			Affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{RequiredDuringSchedulingIgnoredDuringExecution: required}},
+			Expectation: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: required,
+				PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{
+					{Weight: 100, Preference: corev1.NodeSelectorTerm{MatchFields: []corev1.NodeSelectorRequirement{nodeName}}},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			pod := &corev1.Pod{Spec: corev1.PodSpec{Affinity: test.Affinity}}
+			preferNode(pod, "node-a")
+
+			if diff := cmp.Diff(test.Expectation, pod.Spec.Affinity.NodeAffinity); diff != "" {
+				t.Errorf("unexpected node affinity (-want +got):\n%s", diff)
+			}
+			if node := pod.Annotations[warmPoolNodeAnnotation]; node != "node-a" {
+				t.Errorf("expected the node of the placeholder to be recorded, but got %q", node)
+			}
+		})
+	}
+}
+
+func TestCountWarmPoolClaim(t *testing.T) {
+	tests := []struct {
+		Name      string
+		Claimed   bool
+		NodeName  string
+		Phase     api.WorkspacePhase
+		Counted   bool
+		Hit, Miss float64
+	}{
+		{Name: "no claim", NodeName: "node-a", Phase: api.WorkspacePhase_RUNNING},
+		{Name: "not scheduled yet", Claimed: true, Phase: api.WorkspacePhase_PENDING},
+		{Name: "scheduled onto the node", Claimed: true, NodeName: "node-a", Phase: api.WorkspacePhase_PENDING, Counted: true, Hit: 1},
+		{Name: "scheduled onto another node", Claimed: true, NodeName: "node-b", Phase: api.WorkspacePhase_CREATING, Counted: true, Miss: 1},
+		{Name: "stopped before it was scheduled", Claimed: true, Phase: api.WorkspacePhase_STOPPED, Counted: true, Miss: 1},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			m := forTestingOnlyWarmPoolManager()
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{workspaceClassLabel: config.DefaultWorkspaceClass}},
+				Spec:       corev1.PodSpec{NodeName: test.NodeName},
+			}
+			if test.Claimed {
+				pod.Annotations = map[string]string{warmPoolNodeAnnotation: "node-a"}
+			}
+
+			counted := m.countWarmPoolClaim(pod, test.Phase)
+
+			act := []float64{
+				testutil.ToFloat64(m.metrics.totalWarmPoolClaimsCounterVec.WithLabelValues(config.DefaultWorkspaceClass, "hit")),
+				testutil.ToFloat64(m.metrics.totalWarmPoolClaimsCounterVec.WithLabelValues(config.DefaultWorkspaceClass, "miss")),
+			}
+			if counted != test.Counted {
+				t.Errorf("expected counted to be %v, but got %v", test.Counted, counted)
+			}
+			if diff := cmp.Diff([]float64{test.Hit, test.Miss}, act); diff != "" {
+				t.Errorf("unexpected hits and misses (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
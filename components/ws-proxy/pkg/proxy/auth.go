@@ -5,11 +5,13 @@
 package proxy
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -55,10 +57,12 @@ func WorkspaceAuthHandler(domain string, info WorkspaceInfoProvider) mux.Middlew
 			}
 
 			if port != "" {
-				// this is a workspace port request and ports can be public or private.
-				// For public ports no tokens or cookies matter, private ports are subject
+				// this is a workspace port request and ports can be public, team, token or private.
+				// For public ports no tokens or cookies matter, team ports admit members of the workspace's
+				// team, token ports admit everyone with a valid share link. Private ports are subject
 				// to the same access policies as the workspace itself is.
-				var isPublic bool
+				visibility := api.PortVisibility_PORT_VISIBILITY_PRIVATE
+				var spec *api.PortSpec
 
 				prt, err := strconv.ParseUint(port, 10, 16)
 				if err != nil {
@@ -66,21 +70,68 @@ func WorkspaceAuthHandler(domain string, info WorkspaceInfoProvider) mux.Middlew
 				} else {
 					for _, p := range ws.Ports {
 						if p.Port == uint32(prt) {
-							isPublic = p.Visibility == api.PortVisibility_PORT_VISIBILITY_PUBLIC
+							visibility = p.Visibility
+							spec = p
 
 							break
 						}
 					}
 				}
 
-				if isPublic {
+				switch visibility {
+				case api.PortVisibility_PORT_VISIBILITY_PUBLIC:
 					// workspace port is free for all - no tokens or cookies matter
 					h.ServeHTTP(resp, req)
 
 					return
+				case api.PortVisibility_PORT_VISIBILITY_TEAM:
+					if hasTeamToken(req, cookiePrefix, ws) {
+						h.ServeHTTP(resp, req)
+
+						return
+					}
+				case api.PortVisibility_PORT_VISIBILITY_TOKEN:
+					cn := fmt.Sprintf("%s%s_port_%d_token_", cookiePrefix, ws.InstanceID, prt)
+					if tkn := req.URL.Query().Get(api.PortShareTokenParam); tkn != "" {
+						expiry, err := verifyPortShareToken(ws, spec, tkn)
+						if err != nil {
+							log.WithError(err).Warn("invalid port share token")
+							resp.WriteHeader(http.StatusForbidden)
+
+							return
+						}
+
+						// we move the share token into a cookie and redirect, so that the token neither ends up
+						// in the workspace nor in the browser history.
+						http.SetCookie(resp, &http.Cookie{
+							Name:     cn,
+							Value:    tkn,
+							Path:     "/",
+							Expires:  expiry,
+							HttpOnly: true,
+							Secure:   true,
+							SameSite: http.SameSiteLaxMode,
+						})
+						q := req.URL.Query()
+						q.Del(api.PortShareTokenParam)
+						target := *req.URL
+						target.RawQuery = q.Encode()
+						http.Redirect(resp, req, target.RequestURI(), http.StatusTemporaryRedirect)
+
+						return
+					}
+					if c, err := req.Cookie(cn); err == nil {
+						if _, err := verifyPortShareToken(ws, spec, c.Value); err == nil {
+							h.ServeHTTP(resp, req)
+
+							return
+						}
+						log.WithField("cookieName", cn).WithError(err).Debug("invalid port share cookie")
+					}
 				}
 
-				// port seems to be private - subject it to the same access policy as the workspace itself
+				// port seems to be private or the request has no team/share credentials - subject it to
+				// the same access policy as the workspace itself
 			}
 
 			tkn := req.Header.Get("x-gitpod-owner-token")
@@ -115,3 +166,32 @@ func WorkspaceAuthHandler(domain string, info WorkspaceInfoProvider) mux.Middlew
 		})
 	}
 }
+
+// hasTeamToken checks if a request carries the team token of the workspace, either as header or as cookie.
+func hasTeamToken(req *http.Request, cookiePrefix string, ws *WorkspaceInfo) bool {
+	if ws.Auth == nil || ws.Auth.TeamToken == "" {
+		return false
+	}
+
+	tkn := req.Header.Get("x-gitpod-team-token")
+	if tkn == "" {
+		c, err := req.Cookie(fmt.Sprintf("%s%s_team_", cookiePrefix, ws.InstanceID))
+		if err != nil {
+			return false
+		}
+		tkn = c.Value
+	}
+	return subtle.ConstantTimeCompare([]byte(tkn), []byte(ws.Auth.TeamToken)) == 1
+}
+
+func verifyPortShareToken(ws *WorkspaceInfo, port *api.PortSpec, tkn string) (expiry time.Time, err error) {
+	var ownerToken string
+	if ws.Auth != nil {
+		ownerToken = ws.Auth.OwnerToken
+	}
+	var notBefore time.Time
+	if port.ShareLinksNotBefore != nil {
+		notBefore = port.ShareLinksNotBefore.AsTime()
+	}
+	return api.VerifyPortShareToken(ownerToken, ws.InstanceID, port.Port, tkn, notBefore, time.Now())
+}
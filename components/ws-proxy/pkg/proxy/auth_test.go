@@ -10,10 +10,12 @@ import (
 	"net/http/httptest"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/ws-manager/api"
@@ -31,6 +33,7 @@ func TestWorkspaceAuthHandler(t *testing.T) {
 		workspaceID = "workspac-65f4-43c9-bf46-3541b89dca85"
 		instanceID  = "instance-fce1-4ff6-9364-cf6dff0c4ecf"
 		ownerToken  = "owner-token"
+		teamToken   = "team-token"
 		testPort    = 8080
 	)
 	var (
@@ -56,6 +59,47 @@ func TestWorkspaceAuthHandler(t *testing.T) {
 				Ports: []*api.PortSpec{{Port: testPort, Visibility: api.PortVisibility_PORT_VISIBILITY_PUBLIC}},
 			},
 		}
+		teamPortInfos = map[string]*WorkspaceInfo{
+			workspaceID: {
+				WorkspaceID: workspaceID,
+				InstanceID:  instanceID,
+				Auth: &api.WorkspaceAuthentication{
+					Admission:  api.AdmissionLevel_ADMIT_OWNER_ONLY,
+					OwnerToken: ownerToken,
+					TeamToken:  teamToken,
+				},
+				Ports: []*api.PortSpec{{Port: testPort, Visibility: api.PortVisibility_PORT_VISIBILITY_TEAM}},
+			},
+		}
+		tokenPortInfos = map[string]*WorkspaceInfo{
+			workspaceID: {
+				WorkspaceID: workspaceID,
+				InstanceID:  instanceID,
+				Auth: &api.WorkspaceAuthentication{
+					Admission:  api.AdmissionLevel_ADMIT_OWNER_ONLY,
+					OwnerToken: ownerToken,
+				},
+				Ports: []*api.PortSpec{{Port: testPort, Visibility: api.PortVisibility_PORT_VISIBILITY_TOKEN}},
+			},
+		}
+		revokedPortInfos = map[string]*WorkspaceInfo{
+			workspaceID: {
+				WorkspaceID: workspaceID,
+				InstanceID:  instanceID,
+				Auth: &api.WorkspaceAuthentication{
+					Admission:  api.AdmissionLevel_ADMIT_OWNER_ONLY,
+					OwnerToken: ownerToken,
+				},
+				Ports: []*api.PortSpec{{
+					Port:                testPort,
+					Visibility:          api.PortVisibility_PORT_VISIBILITY_TOKEN,
+					ShareLinksNotBefore: timestamppb.New(time.Now().Add(time.Minute)),
+				}},
+			},
+		}
+		shareToken         = api.SignPortShareToken(ownerToken, instanceID, testPort, time.Now(), time.Now().Add(time.Hour))
+		expiredShareToken  = api.SignPortShareToken(ownerToken, instanceID, testPort, time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour))
+		otherPortToken     = api.SignPortShareToken(ownerToken, instanceID, testPort+1, time.Now(), time.Now().Add(time.Hour))
 		admitEveryoneInfos = map[string]*WorkspaceInfo{
 			workspaceID: {
 				WorkspaceID: workspaceID,
@@ -65,12 +109,15 @@ func TestWorkspaceAuthHandler(t *testing.T) {
 		}
 	)
 	tests := []struct {
-		Name        string
-		Infos       map[string]*WorkspaceInfo
-		OwnerCookie string
-		WorkspaceID string
-		Port        string
-		Expected    testResult
+		Name            string
+		Infos           map[string]*WorkspaceInfo
+		OwnerCookie     string
+		TeamCookie      string
+		PortShareCookie string
+		PortShareToken  string
+		WorkspaceID     string
+		Port            string
+		Expected        testResult
 	}{
 		{
 			Name:        "workspace not found",
@@ -218,6 +265,138 @@ func TestWorkspaceAuthHandler(t *testing.T) {
 				StatusCode:    http.StatusUnauthorized,
 			},
 		},
+		{
+			Name:        "team port with owner cookie",
+			Infos:       teamPortInfos,
+			WorkspaceID: workspaceID,
+			OwnerCookie: ownerToken,
+			Port:        strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: true,
+				StatusCode:    http.StatusOK,
+			},
+		},
+		{
+			Name:        "team port with team cookie",
+			Infos:       teamPortInfos,
+			WorkspaceID: workspaceID,
+			TeamCookie:  teamToken,
+			Port:        strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: true,
+				StatusCode:    http.StatusOK,
+			},
+		},
+		{
+			Name:        "team port with wrong team cookie",
+			Infos:       teamPortInfos,
+			WorkspaceID: workspaceID,
+			TeamCookie:  teamToken + "-this-is-wrong",
+			Port:        strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusUnauthorized,
+			},
+		},
+		{
+			Name:        "private port with team cookie",
+			Infos:       ownerOnlyInfos,
+			WorkspaceID: workspaceID,
+			TeamCookie:  teamToken,
+			Port:        strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusUnauthorized,
+			},
+		},
+		{
+			Name:           "token port with share token",
+			Infos:          tokenPortInfos,
+			WorkspaceID:    workspaceID,
+			PortShareToken: shareToken,
+			Port:           strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusTemporaryRedirect,
+			},
+		},
+		{
+			Name:           "token port with expired share token",
+			Infos:          tokenPortInfos,
+			WorkspaceID:    workspaceID,
+			PortShareToken: expiredShareToken,
+			Port:           strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:           "token port with share token of another port",
+			Infos:          tokenPortInfos,
+			WorkspaceID:    workspaceID,
+			PortShareToken: otherPortToken,
+			Port:           strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:           "token port with revoked share token",
+			Infos:          revokedPortInfos,
+			WorkspaceID:    workspaceID,
+			PortShareToken: shareToken,
+			Port:           strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusForbidden,
+			},
+		},
+		{
+			Name:            "token port with revoked share cookie",
+			Infos:           revokedPortInfos,
+			WorkspaceID:     workspaceID,
+			PortShareCookie: shareToken,
+			Port:            strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusUnauthorized,
+			},
+		},
+		{
+			Name:            "token port with share cookie",
+			Infos:           tokenPortInfos,
+			WorkspaceID:     workspaceID,
+			PortShareCookie: shareToken,
+			Port:            strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: true,
+				StatusCode:    http.StatusOK,
+			},
+		},
+		{
+			Name:            "token port with expired share cookie",
+			Infos:           tokenPortInfos,
+			WorkspaceID:     workspaceID,
+			PortShareCookie: expiredShareToken,
+			Port:            strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: false,
+				StatusCode:    http.StatusUnauthorized,
+			},
+		},
+		{
+			Name:        "token port with owner cookie",
+			Infos:       tokenPortInfos,
+			WorkspaceID: workspaceID,
+			OwnerCookie: ownerToken,
+			Port:        strconv.Itoa(testPort),
+			Expected: testResult{
+				HandlerCalled: true,
+				StatusCode:    http.StatusOK,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -229,10 +408,20 @@ func TestWorkspaceAuthHandler(t *testing.T) {
 			}))
 
 			rr := httptest.NewRecorder()
-			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://%s/", domain), nil)
+			target := fmt.Sprintf("http://%s/", domain)
+			if test.PortShareToken != "" {
+				target += "?" + api.PortShareTokenParam + "=" + test.PortShareToken
+			}
+			req := httptest.NewRequest(http.MethodGet, target, nil)
 			if test.OwnerCookie != "" {
 				setOwnerTokenCookie(req, instanceID, test.OwnerCookie)
 			}
+			if test.TeamCookie != "" {
+				req.AddCookie(&http.Cookie{Name: "_test_domain_com_ws_" + instanceID + "_team_", Value: test.TeamCookie})
+			}
+			if test.PortShareCookie != "" {
+				req.AddCookie(&http.Cookie{Name: fmt.Sprintf("_test_domain_com_ws_%s_port_%d_token_", instanceID, testPort), Value: test.PortShareCookie})
+			}
 			vars := map[string]string{
 				workspaceIDIdentifier: test.WorkspaceID,
 			}
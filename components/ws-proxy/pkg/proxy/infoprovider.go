@@ -162,7 +162,7 @@ func mapPodToWorkspaceInfo(pod *corev1.Pod) *WorkspaceInfo {
 		SupervisorImage: imageSpec.SupervisorRef,
 		IPAddress:       pod.Status.PodIP,
 		Ports:           extractExposedPorts(pod).Ports,
-		Auth:            &wsapi.WorkspaceAuthentication{Admission: admission, OwnerToken: ownerToken},
+		Auth:            &wsapi.WorkspaceAuthentication{Admission: admission, OwnerToken: ownerToken, TeamToken: wsapi.DeriveTeamToken(ownerToken)},
 		StartedAt:       pod.CreationTimestamp.Time,
 		OwnerUserId:     pod.Labels[kubernetes.OwnerLabel],
 		SSHPublicKeys:   extractUserSSHPublicKeys(pod),
@@ -70,7 +70,7 @@ func NewRouteHandlerConfig(config *Config, opts ...RouteHandlerConfigOpt) (*Rout
 type RouteHandler = func(r *mux.Router, config *RouteHandlerConfig)
 
 // installWorkspaceRoutes configures routing of workspace and IDE requests.
-func installWorkspaceRoutes(r *mux.Router, config *RouteHandlerConfig, ip WorkspaceInfoProvider, hostKeyList []ssh.Signer) {
+func installWorkspaceRoutes(r *mux.Router, config *RouteHandlerConfig, ip WorkspaceInfoProvider, hostKeyList []ssh.Signer) error {
 	r.Use(logHandler)
 
 	// Note: the order of routes defines their priority.
@@ -96,6 +96,13 @@ func installWorkspaceRoutes(r *mux.Router, config *RouteHandlerConfig, ip Worksp
 
 	routes.HandleSupervisorFrontendRoute(enableCompression(r).PathPrefix("/_supervisor/frontend"))
 
+	// terminal shares are authorized by their share token and must not require the owner's credentials
+	err := routes.HandleTerminalShareRoute(r.Path(fmt.Sprintf("/_supervisor/terminal-share/{%s}", terminalShareTokenIdentifier)))
+	if err != nil {
+		return err
+	}
+	routes.HandleTerminalShareAssetsRoute(r.PathPrefix(terminalShareAssetsPath))
+
 	routes.HandleDirectSupervisorRoute(r.PathPrefix("/_supervisor/v1/status/supervisor"), false)
 	routes.HandleDirectSupervisorRoute(r.PathPrefix("/_supervisor/v1/status/ide"), false)
 	routes.HandleDirectSupervisorRoute(r.PathPrefix("/_supervisor/v1"), true)
@@ -113,6 +120,7 @@ func installWorkspaceRoutes(r *mux.Router, config *RouteHandlerConfig, ip Worksp
 		})
 	})
 	routes.HandleRoot(rootRouter.NewRoute())
+	return nil
 }
 
 func enableCompression(r *mux.Router) *mux.Router {
@@ -603,6 +611,14 @@ func removeSensitiveCookies(cookies []*http.Cookie, domain string) []*http.Cooki
 			// skip owner token
 			continue
 		}
+		if strings.HasPrefix(c.Name, hostnamePrefix) && strings.HasSuffix(c.Name, "_team_") {
+			// skip team token
+			continue
+		}
+		if strings.HasPrefix(c.Name, hostnamePrefix) && strings.HasSuffix(c.Name, "_token_") {
+			// skip port share token
+			continue
+		}
 		log.WithField("hostnamePrefix", hostnamePrefix).WithField("name", c.Name).Debug("keeping cookie")
 		cookies[n] = c
 		n++
@@ -727,15 +743,25 @@ func (t *blobserveTransport) asBlobserveURL(image string, path string) string {
 // endregion
 
 const (
-	builtinPagePortNotFound = "port-not-found.html"
+	builtinPagePortNotFound  = "port-not-found.html"
+	builtinPageTerminalShare = "terminal-share.html"
+
+	// builtinTerminalShareAssets is the directory next to the builtin pages which holds the xterm.js assets
+	// of the terminal share viewer. They are bundled at build time so that the viewer works without internet access.
+	builtinTerminalShareAssets = "terminal-share"
 )
 
-func servePortNotFoundPage(config *Config) (http.Handler, error) {
-	fn := filepath.Join(config.BuiltinPages.Location, builtinPagePortNotFound)
+// readBuiltinPage reads a page served directly by ws-proxy.
+func readBuiltinPage(config *Config, name string) ([]byte, error) {
+	fn := filepath.Join(config.BuiltinPages.Location, name)
 	if tp := os.Getenv("TELEPRESENCE_ROOT"); tp != "" {
 		fn = filepath.Join(tp, fn)
 	}
-	page, err := os.ReadFile(fn)
+	return os.ReadFile(fn)
+}
+
+func servePortNotFoundPage(config *Config) (http.Handler, error) {
+	page, err := readBuiltinPage(config, builtinPagePortNotFound)
 	if err != nil {
 		return nil, err
 	}
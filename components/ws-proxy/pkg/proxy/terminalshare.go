@@ -0,0 +1,207 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+const (
+	terminalShareTokenIdentifier = "terminalShareToken"
+	terminalShareAssetsPath      = "/_supervisor/terminal-share-assets/"
+	terminalShareWriteTimeout    = 10 * time.Second
+	maxTerminalShareViewerLength = 64
+)
+
+// terminalShareCSP isolates the viewer page from the workspace origin it is served from: viewers are not
+// the workspace owner, and the page must never act with the owner's cookies.
+// The sandboxed page has an opaque origin, hence the origin the xterm.js assets are loaded from is explicit.
+func terminalShareCSP(assetOrigin string) string {
+	return "sandbox allow-scripts allow-modals; default-src 'none'; " +
+		fmt.Sprintf("script-src 'unsafe-inline' %s; style-src 'unsafe-inline' %s; ", assetOrigin, assetOrigin) +
+		"img-src https://gitpod.io; connect-src wss: ws:"
+}
+
+// HandleTerminalShareRoute serves read-only shares of workspace terminals, i.e. a minimal viewer page and the WebSocket
+// it streams the terminal output from. Viewers are authorized by the share token in the path rather than the owner token,
+// and can only ever listen to a terminal, never write to or resize it.
+func (ir *ideRoutes) HandleTerminalShareRoute(route *mux.Route) error {
+	page, err := readBuiltinPage(ir.Config.Config, builtinPageTerminalShare)
+	if err != nil {
+		return err
+	}
+
+	upgrader := websocket.Upgrader{
+		// viewers are authorized by the share token, not by cookies, hence the origin does not matter
+		CheckOrigin: func(r *http.Request) bool { return true },
+	}
+
+	r := route.Subrouter()
+	r.Use(logRouteHandlerHandler("HandleTerminalShareRoute"))
+	r.Use(ir.workspaceMustExistHandler)
+	r.NewRoute().HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		// the share token is part of the path and must neither be cached nor leak through the referrer
+		resp.Header().Set("Cache-Control", "no-store")
+		resp.Header().Set("Referrer-Policy", "no-referrer")
+
+		if !websocket.IsWebSocketUpgrade(req) {
+			resp.Header().Set("Content-Security-Policy", terminalShareCSP(fmt.Sprintf("%s://%s", ir.Config.Config.GitpodInstallation.Scheme, req.Host)))
+			resp.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = resp.Write(page)
+			return
+		}
+
+		info := getWorkspaceInfoFromContext(req.Context())
+		serveTerminalShare(resp, req, &upgrader, net.JoinHostPort(info.IPAddress, fmt.Sprint(ir.Config.Config.WorkspacePodConfig.SupervisorPort)))
+	})
+	return nil
+}
+
+// HandleTerminalShareAssetsRoute serves the xterm.js assets the terminal share viewer page loads.
+func (ir *ideRoutes) HandleTerminalShareAssetsRoute(route *mux.Route) {
+	dir := filepath.Join(ir.Config.Config.BuiltinPages.Location, builtinTerminalShareAssets)
+	if tp := os.Getenv("TELEPRESENCE_ROOT"); tp != "" {
+		dir = filepath.Join(tp, dir)
+	}
+
+	r := route.Subrouter()
+	r.Use(logRouteHandlerHandler("HandleTerminalShareAssetsRoute"))
+	r.NewRoute().Handler(http.StripPrefix(terminalShareAssetsPath, http.FileServer(http.Dir(dir))))
+}
+
+// serveTerminalShare streams a shared terminal from supervisor to a viewer's WebSocket.
+// Terminal output is sent as binary messages, title changes as text messages. Messages sent by the viewer are discarded.
+func serveTerminalShare(resp http.ResponseWriter, req *http.Request, upgrader *websocket.Upgrader, supervisorAddr string) {
+	log := getLog(req.Context())
+	ctx, cancel := context.WithCancel(req.Context())
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, supervisorAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.WithError(err).Error("cannot connect to supervisor")
+		http.Error(resp, "cannot connect to the workspace", http.StatusBadGateway)
+		return
+	}
+	defer conn.Close()
+
+	stream, err := supervisor.NewTerminalServiceClient(conn).ListenShared(ctx, &supervisor.ListenSharedTerminalRequest{
+		Token:  mux.Vars(req)[terminalShareTokenIdentifier],
+		Viewer: terminalShareViewer(req),
+	})
+	var msg *supervisor.ListenTerminalResponse
+	if err == nil {
+		// supervisor sends the terminal title first, which tells us whether the share is valid before we upgrade
+		msg, err = stream.Recv()
+	}
+	if err != nil {
+		switch status.Code(err) {
+		case codes.PermissionDenied:
+			http.Error(resp, "terminal share is invalid, expired or was revoked", http.StatusForbidden)
+		case codes.NotFound:
+			http.Error(resp, "terminal not found", http.StatusNotFound)
+		default:
+			log.WithError(err).Error("cannot listen to shared terminal")
+			http.Error(resp, "cannot listen to the terminal", http.StatusBadGateway)
+		}
+		return
+	}
+
+	ws, err := upgrader.Upgrade(resp, req, nil)
+	if err != nil {
+		log.WithError(err).Warn("cannot upgrade terminal share to WebSocket")
+		return
+	}
+	defer ws.Close()
+
+	go func() {
+		// viewers are read-only: we only read to handle control messages and to notice when they leave
+		defer cancel()
+		for {
+			if _, _, err := ws.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	closeWith := func(code int, reason string) {
+		_ = ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(terminalShareWriteTimeout))
+	}
+	for {
+		_ = ws.SetWriteDeadline(time.Now().Add(terminalShareWriteTimeout))
+		switch output := msg.Output.(type) {
+		case *supervisor.ListenTerminalResponse_Data:
+			err = ws.WriteMessage(websocket.BinaryMessage, output.Data)
+		case *supervisor.ListenTerminalResponse_Title:
+			err = ws.WriteMessage(websocket.TextMessage, []byte(output.Title))
+		case *supervisor.ListenTerminalResponse_ExitCode:
+			closeWith(websocket.CloseNormalClosure, fmt.Sprintf("terminal exited with code %d", output.ExitCode))
+			return
+		}
+		if err != nil {
+			return
+		}
+
+		msg, err = stream.Recv()
+		if ctx.Err() != nil {
+			// the viewer left
+			return
+		}
+		if status.Code(err) == codes.PermissionDenied {
+			closeWith(websocket.ClosePolicyViolation, "terminal share expired or was revoked")
+			return
+		}
+		if err != nil {
+			closeWith(websocket.CloseGoingAway, "terminal is not available anymore")
+			return
+		}
+	}
+}
+
+// terminalShareViewer describes a viewer to the owner of a terminal share by the name they chose and their address.
+// The name is printed in the owner's terminal, hence it must not contain control characters. The address is the one
+// the proxy in front of ws-proxy saw, which it appends to X-Forwarded-For - any earlier entry is up to the client.
+func terminalShareViewer(req *http.Request) string {
+	name := []rune(strings.TrimSpace(strings.Map(func(r rune) rune {
+		if !unicode.IsPrint(r) {
+			return -1
+		}
+		return r
+	}, req.URL.Query().Get("viewer"))))
+	if len(name) > maxTerminalShareViewerLength {
+		name = name[:maxTerminalShareViewerLength]
+	}
+	if len(name) == 0 {
+		name = []rune("anonymous")
+	}
+
+	addr := req.RemoteAddr
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	if fwd := req.Header.Values("X-Forwarded-For"); len(fwd) > 0 {
+		hops := strings.Split(fwd[len(fwd)-1], ",")
+		if hop := strings.TrimSpace(hops[len(hops)-1]); hop != "" {
+			addr = hop
+		}
+	}
+	return fmt.Sprintf("%s (%s)", string(name), addr)
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2022 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	supervisor "github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+type fakeTerminalShareService struct {
+	viewers chan string
+
+	supervisor.UnimplementedTerminalServiceServer
+}
+
+func (srv *fakeTerminalShareService) ListenShared(req *supervisor.ListenSharedTerminalRequest, resp supervisor.TerminalService_ListenSharedServer) error {
+	if req.Token != "valid-token" {
+		return status.Error(codes.PermissionDenied, "terminal share is invalid, expired or was revoked")
+	}
+	srv.viewers <- req.Viewer
+	for _, msg := range []*supervisor.ListenTerminalResponse{
+		{Output: &supervisor.ListenTerminalResponse_Title{Title: "bash"}},
+		{Output: &supervisor.ListenTerminalResponse_Data{Data: []byte("hello world")}},
+		{Output: &supervisor.ListenTerminalResponse_ExitCode{ExitCode: 1}},
+	} {
+		err := resp.Send(msg)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestTerminalShareRoute(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := &fakeTerminalShareService{viewers: make(chan string, 1)}
+	grpcServer := grpc.NewServer()
+	supervisor.RegisterTerminalServiceServer(grpcServer, service)
+	go func() {
+		_ = grpcServer.Serve(l)
+	}()
+	defer grpcServer.Stop()
+
+	// the xterm.js assets are bundled at build time, hence the test serves the viewer page with fake ones
+	pages := t.TempDir()
+	for _, name := range []string{builtinPagePortNotFound, builtinPageTerminalShare} {
+		page, err := os.ReadFile(filepath.Join(config.BuiltinPages.Location, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(pages, name), page, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(pages, builtinTerminalShareAssets), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(pages, builtinTerminalShareAssets, "xterm.js"), []byte("var Terminal;"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := config
+	cfg.BuiltinPages.Location = pages
+	podCfg := *config.WorkspacePodConfig
+	podCfg.SupervisorPort = uint16(l.Addr().(*net.TCPAddr).Port)
+	cfg.WorkspacePodConfig = &podCfg
+	info := workspaces[0]
+	info.IPAddress = "127.0.0.1"
+
+	proxy := NewWorkspaceProxy(HostBasedIngressConfig{}, cfg, HostBasedRouter(hostBasedHeader, wsHostSuffix, wsHostNameRegex), &fakeWsInfoProvider{infos: []WorkspaceInfo{info}}, nil)
+	handler, err := proxy.Handler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	header := http.Header{}
+	header.Set(hostBasedHeader, strings.TrimPrefix(strings.TrimSuffix(info.URL, "/"), "https://"))
+	shareURL := srv.URL + "/_supervisor/terminal-share/"
+
+	t.Run("viewer page", func(t *testing.T) {
+		req, err := http.NewRequest("GET", shareURL+"valid-token", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header = header
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		act := map[string]interface{}{
+			"status":   resp.StatusCode,
+			"csp":      resp.Header.Get("Content-Security-Policy"),
+			"referrer": resp.Header.Get("Referrer-Policy"),
+		}
+		exp := map[string]interface{}{
+			"status":   http.StatusOK,
+			"csp":      terminalShareCSP(cfg.GitpodInstallation.Scheme + "://" + strings.TrimPrefix(srv.URL, "http://")),
+			"referrer": "no-referrer",
+		}
+		if diff := cmp.Diff(exp, act); diff != "" {
+			t.Errorf("unexpected response (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("viewer assets", func(t *testing.T) {
+		req, err := http.NewRequest("GET", srv.URL+terminalShareAssetsPath+"xterm.js", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header = header
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK || string(body) != "var Terminal;" {
+			t.Errorf("unexpected response %d: %s", resp.StatusCode, body)
+		}
+	})
+
+	wsURL := "ws" + strings.TrimPrefix(shareURL, "http")
+	t.Run("invalid token", func(t *testing.T) {
+		_, resp, err := websocket.DefaultDialer.Dial(wsURL+"invalid-token", header)
+		if err == nil {
+			t.Fatal("expected the WebSocket handshake to fail")
+		}
+		if resp == nil || resp.StatusCode != http.StatusForbidden {
+			t.Errorf("expected status %d, got %v", http.StatusForbidden, resp)
+		}
+	})
+
+	t.Run("read-only stream", func(t *testing.T) {
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL+"valid-token?viewer=alice", header)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+
+		type message struct {
+			Type int
+			Data string
+		}
+		var act []message
+		for {
+			tpe, data, err := conn.ReadMessage()
+			if err != nil {
+				if diff := cmp.Diff(&websocket.CloseError{Code: websocket.CloseNormalClosure, Text: "terminal exited with code 1"}, err); diff != "" {
+					t.Errorf("unexpected close (-want +got):\n%s", diff)
+				}
+				break
+			}
+			act = append(act, message{Type: tpe, Data: string(data)})
+		}
+		exp := []message{
+			{Type: websocket.TextMessage, Data: "bash"},
+			{Type: websocket.BinaryMessage, Data: "hello world"},
+		}
+		if diff := cmp.Diff(exp, act); diff != "" {
+			t.Errorf("unexpected messages (-want +got):\n%s", diff)
+		}
+		if viewer := <-service.viewers; viewer != "alice (127.0.0.1)" {
+			t.Errorf("unexpected viewer %q", viewer)
+		}
+	})
+}
+
+func TestTerminalShareViewer(t *testing.T) {
+	tests := []struct {
+		Name         string
+		Viewer       string
+		RemoteAddr   string
+		ForwardedFor string
+		Expectation  string
+	}{
+		{Name: "named viewer", Viewer: "alice", RemoteAddr: "10.0.0.1:1234", Expectation: "alice (10.0.0.1)"},
+		{Name: "anonymous viewer", Viewer: "  ", RemoteAddr: "10.0.0.1:1234", Expectation: "anonymous (10.0.0.1)"},
+		{Name: "forwarded", Viewer: "bob", RemoteAddr: "10.0.0.1:1234", ForwardedFor: "203.0.113.7", Expectation: "bob (203.0.113.7)"},
+		{Name: "spoofed forwarded", Viewer: "bob", RemoteAddr: "10.0.0.1:1234", ForwardedFor: "192.0.2.1, 203.0.113.7", Expectation: "bob (203.0.113.7)"},
+		{Name: "control characters", Viewer: "eve\x1b]0;pwned\x07\r\n", RemoteAddr: "10.0.0.1:1234", Expectation: "eve]0;pwned (10.0.0.1)"},
+		{Name: "long name", Viewer: strings.Repeat("ä", 100), RemoteAddr: "10.0.0.1:1234", Expectation: strings.Repeat("ä", maxTerminalShareViewerLength) + " (10.0.0.1)"},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/_supervisor/terminal-share/token?viewer="+url.QueryEscape(test.Viewer), nil)
+			req.RemoteAddr = test.RemoteAddr
+			if test.ForwardedFor != "" {
+				req.Header.Set("X-Forwarded-For", test.ForwardedFor)
+			}
+			if act := terminalShareViewer(req); act != test.Expectation {
+				t.Errorf("unexpected viewer: want %q, got %q", test.Expectation, act)
+			}
+		})
+	}
+}
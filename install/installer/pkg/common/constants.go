@@ -15,6 +15,8 @@ import (
 const (
 	AppName                     = "gitpod"
 	BlobServeServicePort        = 4000
+	BusyboxImage                = "library/busybox"
+	BusyboxTag                  = "1.35.0@sha256:20246233b52de844fa516f8c51234f1441e55e71ecdd1a1d91ebb252e1fd4603"
 	CertManagerCAIssuer         = "ca-issuer"
 	DockerRegistryURL           = "docker.io"
 	DockerRegistryName          = "registry"
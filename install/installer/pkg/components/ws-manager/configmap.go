@@ -15,6 +15,7 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/grpc"
 	"github.com/gitpod-io/gitpod/common-go/util"
 	storageconfig "github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/installer/pkg/cluster"
 	"github.com/gitpod-io/gitpod/installer/pkg/common"
 	configv1 "github.com/gitpod-io/gitpod/installer/pkg/config/v1"
 	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
@@ -129,6 +130,16 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 				Templates: tplsCfg,
 				PVC:       config.PVCConfiguration(c.PVC),
 			}
+			if c.WarmPool != nil {
+				classes[k].WarmPool = &config.WarmPoolConfiguration{
+					Size:              c.WarmPool.Size,
+					TTL:               c.WarmPool.TTL,
+					Images:            c.WarmPool.Images,
+					PriorityClassName: c.WarmPool.PriorityClassName,
+					Image:             ctx.ImageName(common.ThirdPartyContainerRepo(ctx.Config.Repository, common.DockerRegistryURL), common.BusyboxImage, common.BusyboxTag),
+					NodeLabel:         cluster.AffinityLabelWorkspacesRegular,
+				}
+			}
 			for tmpl_n, tmpl_v := range ctpls {
 				if _, ok := tpls[tmpl_n]; ok {
 					return fmt.Errorf("duplicate workspace template %q in workspace class %q", tmpl_n, k)
@@ -35,6 +35,17 @@ func role(ctx *common.RenderContext) ([]runtime.Object, error) {
 						"watch",
 					},
 				},
+				{
+					// ResizeWorkspace needs the requests of all pods on a workspace's node
+					APIGroups: []string{""},
+					Resources: []string{
+						"pods",
+					},
+					Verbs: []string{
+						"get",
+						"list",
+					},
+				},
 				{
 					APIGroups: []string{"snapshot.storage.k8s.io"},
 					Resources: []string{
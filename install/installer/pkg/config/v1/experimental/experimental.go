@@ -15,6 +15,7 @@ import (
 
 	agentSmith "github.com/gitpod-io/gitpod/agent-smith/pkg/config"
 	"github.com/gitpod-io/gitpod/common-go/grpc"
+	"github.com/gitpod-io/gitpod/common-go/util"
 	"github.com/gitpod-io/gitpod/usage/pkg/db"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cpulimit"
 	corev1 "k8s.io/api/core/v1"
@@ -141,6 +142,14 @@ type WorkspaceClass struct {
 	Resources WorkspaceResources    `json:"resources" validate:"required"`
 	Templates WorkspaceTemplates    `json:"templates,omitempty"`
 	PVC       PersistentVolumeClaim `json:"pvc" validate:"required"`
+	WarmPool  *WarmPool             `json:"warmPool,omitempty"`
+}
+
+type WarmPool struct {
+	Size              int           `json:"size"`
+	TTL               util.Duration `json:"ttl"`
+	Images            []string      `json:"images"`
+	PriorityClassName string        `json:"priorityClassName,omitempty"`
 }
 
 type WorkspaceResources struct {